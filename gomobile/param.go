@@ -94,6 +94,8 @@ func InitParamCreatePrivacyTx(args string) (*transaction.TxPrivacyInitParamsForA
 		paymentInfoTmp.PaymentAddress = keyWallet.KeySet.PaymentAddress
 		paymentInfoTmp.Amount = amount
 		paymentInfoTmp.Message = msgBytes
+		paymentInfoTmp.EncryptMessage, _ = tmp["encryptMessage"].(bool)
+		paymentInfoTmp.AuthenticateCoinDetails, _ = tmp["authenticateCoinDetails"].(bool)
 		paymentInfo = append(paymentInfo, paymentInfoTmp)
 	}
 
@@ -335,6 +337,8 @@ func InitParamCreatePrivacyTokenTx(args string) (*transaction.TxPrivacyTokenInit
 		paymentInfoTmp.PaymentAddress = keyWallet.KeySet.PaymentAddress
 		paymentInfoTmp.Amount = amount
 		paymentInfoTmp.Message = msgBytes
+		paymentInfoTmp.EncryptMessage, _ = tmp["encryptMessage"].(bool)
+		paymentInfoTmp.AuthenticateCoinDetails, _ = tmp["authenticateCoinDetails"].(bool)
 		paymentInfo = append(paymentInfo, paymentInfoTmp)
 	}
 
@@ -647,6 +651,8 @@ func InitParamCreatePrivacyTokenTx(args string) (*transaction.TxPrivacyTokenInit
 		println("PK receiver token: ", paymentInfoTmp.PaymentAddress.Pk)
 		paymentInfoTmp.Amount = amount
 		paymentInfoTmp.Message = msgBytes
+		paymentInfoTmp.EncryptMessage, _ = tmp["encryptMessage"].(bool)
+		paymentInfoTmp.AuthenticateCoinDetails, _ = tmp["authenticateCoinDetails"].(bool)
 		paymentInfoForPToken = append(paymentInfoForPToken, paymentInfoTmp)
 	}
 
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"github.com/0xkraken/incognito-wasm/incognito/common"
 	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/0xkraken/incognito-wasm/incognito/pdex"
 	"github.com/0xkraken/incognito-wasm/incognito/transaction"
 	"github.com/pkg/errors"
 	"math/big"
+	"strconv"
 )
 
 func InitPEDContributionMetadataFromParam(metaDataParam map[string]interface{}) (*metadata.PDEContribution, error) {
@@ -158,6 +160,65 @@ func InitPTokenContributionTx(args string, serverTime int64) (string, error) {
 	return B64Res, nil
 }
 
+// setMinAcceptableAmountBySlippage sets the MinAcceptableAmount param of a trade from the slippage percentage
+// metaData.Slippage and pdeState, the result of the getpdestate RPC, when the trade gives no MinAcceptableAmount.
+// A PDETradeRequest is quoted in the pool of the pair, a PDECrossPoolTradeRequest through PRV
+func setMinAcceptableAmountBySlippage(paramMaps map[string]interface{}, metaDataParam map[string]interface{}) error {
+	if metaDataParam["MinAcceptableAmount"] != nil || metaDataParam["Slippage"] == nil {
+		return nil
+	}
+	slippage, ok := metaDataParam["Slippage"].(float64)
+	if !ok {
+		println("Invalid meta data slippage param")
+		return errors.New("Invalid meta data slippage param")
+	}
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return errors.New("Invalid meta data type param")
+	}
+	pdeState, err := initPoolStateFromParam(paramMaps)
+	if err != nil {
+		return err
+	}
+
+	tokenIDToBuyStr, ok := metaDataParam["TokenIDToBuyStr"].(string)
+	if !ok {
+		println("Invalid meta data token id to buy param")
+		return errors.New("Invalid meta data token id to buy param")
+	}
+	tokenIDToSellStr, ok := metaDataParam["TokenIDToSellStr"].(string)
+	if !ok {
+		println("Invalid meta data token id to sell param")
+		return errors.New("Invalid meta data token id to sell param")
+	}
+	sellAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["SellAmount"])
+	if err != nil {
+		println("Invalid meta data sell amount param")
+		return errors.New("Invalid meta data sell amount param")
+	}
+
+	var quote *pdex.Quote
+	switch int(metaDataType) {
+	case metadata.PDETradeRequestMeta:
+		quote, err = pdeState.QuotePool(tokenIDToSellStr, tokenIDToBuyStr, sellAmount)
+	case metadata.PDECrossPoolTradeRequestMeta:
+		quote, err = pdeState.Quote(tokenIDToSellStr, tokenIDToBuyStr, sellAmount)
+	default:
+		println("Invalid meta data type param")
+		return errors.New("Invalid meta data type param")
+	}
+	if err != nil {
+		return err
+	}
+	minAcceptableAmount, err := quote.MinAcceptableAmount(slippage)
+	if err != nil {
+		return err
+	}
+	metaDataParam["MinAcceptableAmount"] = strconv.FormatUint(minAcceptableAmount, 10)
+	return nil
+}
+
 func InitPEDTradeRequestMetadataFromParam(metaDataParam map[string]interface{}) (*metadata.PDETradeRequest, error) {
 	metaDataType, ok := metaDataParam["Type"].(float64)
 	if !ok {
@@ -228,6 +289,11 @@ func InitPRVTradeTx(args string, serverTime int64) (string, error) {
 		return "", errors.New("Invalid meta data param")
 	}
 
+	err = setMinAcceptableAmountBySlippage(paramMaps, metaDataParam)
+	if err != nil {
+		return "", err
+	}
+
 	metaData, err := InitPEDTradeRequestMetadataFromParam(metaDataParam)
 	if err != nil {
 		return "", err
@@ -283,6 +349,11 @@ func InitPTokenTradeTx(args string, serverTime int64) (string, error) {
 		return "", errors.New("Invalid meta data param")
 	}
 
+	err = setMinAcceptableAmountBySlippage(paramMaps, metaDataParam)
+	if err != nil {
+		return "", err
+	}
+
 	metaData, err := InitPEDTradeRequestMetadataFromParam(metaDataParam)
 	if err != nil {
 		return "", err
@@ -401,3 +472,284 @@ func WithdrawDexTx(args string, serverTime int64) (string, error) {
 
 	return B64Res, nil
 }
+
+// WithdrawDexFeeTx creates a tx withdrawing the trading fees earned by a contributor of the pair
+func WithdrawDexFeeTx(args string, serverTime int64) (string, error) {
+	// parse meta data
+	bytes := []byte(args)
+	println("Bytes: %v\n", bytes)
+
+	paramMaps := make(map[string]interface{})
+
+	err := json.Unmarshal(bytes, &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+
+	println("paramMaps:", paramMaps)
+
+	metaDataParam, ok := paramMaps["metaData"].(map[string]interface{})
+	if !ok {
+		return "", errors.New("Invalid meta data param")
+	}
+
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return "", errors.New("Invalid meta data type param")
+	}
+
+	withdrawerAddressStr, ok := metaDataParam["WithdrawerAddressStr"].(string)
+	if !ok {
+		println("Invalid meta data withdrawerAddressStr param")
+		return "", errors.New("Invalid meta data withdrawerAddressStr param")
+	}
+	withdrawalToken1IDStr, ok := metaDataParam["WithdrawalToken1IDStr"].(string)
+	if !ok {
+		println("Invalid meta data withdrawalToken1IDStr param")
+		return "", errors.New("Invalid meta data withdrawalToken1IDStr param")
+	}
+	withdrawalToken2IDStr, ok := metaDataParam["WithdrawalToken2IDStr"].(string)
+	if !ok {
+		println("Invalid meta data withdrawalToken2IDStr param")
+		return "", errors.New("Invalid meta data withdrawalToken2IDStr param")
+	}
+	withdrawalFeeAmt, err := common.AssertAndConvertStrToNumber(metaDataParam["WithdrawalFeeAmt"])
+	if err != nil {
+		println("Invalid meta data withdrawalFeeAmt param")
+		return "", errors.New("Invalid meta data withdrawalFeeAmt param")
+	}
+	metaData, err := metadata.NewPDEFeeWithdrawalRequest(
+		withdrawerAddressStr, withdrawalToken1IDStr,
+		withdrawalToken2IDStr, uint64(withdrawalFeeAmt), int(metaDataType),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	paramCreateTx, err := InitParamCreatePrivacyTx(args)
+	if err != nil {
+		return "", err
+	}
+
+	paramCreateTx.SetMetaData(metaData)
+
+	tx := new(transaction.Tx)
+	err = tx.InitForASM(paramCreateTx, serverTime)
+
+	if err != nil {
+		println("Can not create tx: ", err)
+		return "", err
+	}
+
+	// serialize tx json
+	txJson, err := json.Marshal(tx)
+	if err != nil {
+		println("Can not marshal tx: ", err)
+		return "", err
+	}
+
+	lockTimeBytes := common.AddPaddingBigInt(new(big.Int).SetInt64(tx.LockTime), 8)
+	resBytes := append(txJson, lockTimeBytes...)
+
+	B64Res := base64.StdEncoding.EncodeToString(resBytes)
+
+	return B64Res, nil
+}
+
+func InitPDECrossPoolTradeRequestMetadataFromParam(metaDataParam map[string]interface{}) (*metadata.PDECrossPoolTradeRequest, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+
+	tokenIDToBuyStr, ok := metaDataParam["TokenIDToBuyStr"].(string)
+	if !ok {
+		println("Invalid meta data token id to buy param")
+		return nil, errors.New("Invalid meta data token id to buy param")
+	}
+	tokenIDToSellStr, ok := metaDataParam["TokenIDToSellStr"].(string)
+	if !ok {
+		println("Invalid meta data token id to sell param")
+		return nil, errors.New("Invalid meta data token id to sell param")
+	}
+	if tokenIDToBuyStr == tokenIDToSellStr {
+		println("Invalid meta data token id to buy param, must differ from token id to sell")
+		return nil, errors.New("Invalid meta data token id to buy param, must differ from token id to sell")
+	}
+	sellAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["SellAmount"])
+	if err != nil {
+		println("Invalid meta data sell amount param")
+		return nil, errors.New("Invalid meta data sell amount param")
+	}
+
+	minAcceptableAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["MinAcceptableAmount"])
+	if err != nil {
+		println("Invalid meta data min acceptable amount param")
+		return nil, errors.New("Invalid meta data min acceptable amount param")
+	}
+
+	tradingFee, err := common.AssertAndConvertStrToNumber(metaDataParam["TradingFee"])
+	if err != nil {
+		println("Invalid meta data trading fee param")
+		return nil, errors.New("Invalid meta data trading fee param")
+	}
+	traderAddressStr, ok := metaDataParam["TraderAddressStr"].(string)
+	if !ok {
+		println("Invalid meta data trader address string param")
+		return nil, errors.New("Invalid meta data trader address string param")
+	}
+	// sub trader address is optional
+	subTraderAddressStr := ""
+	if metaDataParam["SubTraderAddressStr"] != nil {
+		subTraderAddressStr, ok = metaDataParam["SubTraderAddressStr"].(string)
+		if !ok {
+			println("Invalid meta data sub trader address string param")
+			return nil, errors.New("Invalid meta data sub trader address string param")
+		}
+	}
+
+	metaData, err := metadata.NewPDECrossPoolTradeRequest(
+		tokenIDToBuyStr, tokenIDToSellStr, uint64(sellAmount), uint64(minAcceptableAmount), uint64(tradingFee),
+		traderAddressStr, subTraderAddressStr, int(metaDataType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaData, nil
+}
+
+// InitPRVCrossPoolTradeTx creates a cross pool trade selling PRV,
+// the tx sends SellAmount + TradingFee PRV to the burning address
+func InitPRVCrossPoolTradeTx(args string, serverTime int64) (string, error) {
+	// parse meta data
+	bytes := []byte(args)
+	println("Bytes: %v\n", bytes)
+
+	paramMaps := make(map[string]interface{})
+
+	err := json.Unmarshal(bytes, &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+
+	println("paramMaps:", paramMaps)
+
+	metaDataParam, ok := paramMaps["metaData"].(map[string]interface{})
+	if !ok {
+		return "", errors.New("Invalid meta data param")
+	}
+
+	err = setMinAcceptableAmountBySlippage(paramMaps, metaDataParam)
+	if err != nil {
+		return "", err
+	}
+
+	metaData, err := InitPDECrossPoolTradeRequestMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	if metaData.TokenIDToSellStr != common.PRVCoinID.String() {
+		println("Invalid meta data token id to sell param, must be PRV")
+		return "", errors.New("Invalid meta data token id to sell param, must be PRV")
+	}
+
+	paramCreateTx, err := InitParamCreatePrivacyTx(args)
+	if err != nil {
+		return "", err
+	}
+
+	paramCreateTx.SetMetaData(metaData)
+
+	tx := new(transaction.Tx)
+	err = tx.InitForASM(paramCreateTx, serverTime)
+
+	if err != nil {
+		println("Can not create tx: ", err)
+		return "", err
+	}
+
+	// serialize tx json
+	txJson, err := json.Marshal(tx)
+	if err != nil {
+		println("Can not marshal tx: ", err)
+		return "", err
+	}
+
+	lockTimeBytes := common.AddPaddingBigInt(new(big.Int).SetInt64(tx.LockTime), 8)
+	resBytes := append(txJson, lockTimeBytes...)
+
+	B64Res := base64.StdEncoding.EncodeToString(resBytes)
+
+	return B64Res, nil
+}
+
+// InitPTokenCrossPoolTradeTx creates a cross pool trade selling a token,
+// the tx sends SellAmount of the token and TradingFee PRV to the burning address
+func InitPTokenCrossPoolTradeTx(args string, serverTime int64) (string, error) {
+	// parse meta data
+	bytes := []byte(args)
+	println("Bytes: %v\n", bytes)
+
+	paramMaps := make(map[string]interface{})
+
+	err := json.Unmarshal(bytes, &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+
+	println("paramMaps:", paramMaps)
+
+	metaDataParam, ok := paramMaps["metaData"].(map[string]interface{})
+	if !ok {
+		return "", errors.New("Invalid meta data param")
+	}
+
+	err = setMinAcceptableAmountBySlippage(paramMaps, metaDataParam)
+	if err != nil {
+		return "", err
+	}
+
+	metaData, err := InitPDECrossPoolTradeRequestMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	if metaData.TokenIDToSellStr == common.PRVCoinID.String() {
+		println("Invalid meta data token id to sell param, must not be PRV")
+		return "", errors.New("Invalid meta data token id to sell param, must not be PRV")
+	}
+
+	paramCreateTx, err := InitParamCreatePrivacyTokenTx(args)
+	if err != nil {
+		return "", err
+	}
+
+	paramCreateTx.SetMetaData(metaData)
+
+	tx := new(transaction.TxCustomTokenPrivacy)
+	err = tx.InitForASM(paramCreateTx, serverTime)
+
+	if err != nil {
+		println("Can not create tx: ", err)
+		return "", err
+	}
+
+	// serialize tx json
+	txJson, err := json.Marshal(tx)
+	if err != nil {
+		println("Can not marshal tx: ", err)
+		return "", err
+	}
+
+	lockTimeBytes := common.AddPaddingBigInt(new(big.Int).SetInt64(tx.LockTime), 8)
+	resBytes := append(txJson, lockTimeBytes...)
+
+	B64Res := base64.StdEncoding.EncodeToString(resBytes)
+
+	return B64Res, nil
+}
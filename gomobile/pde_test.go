@@ -0,0 +1,116 @@
+package gomobile
+
+import (
+	"encoding/json"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/0xkraken/incognito-wasm/incognito/pdex"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestSetMinAcceptableAmountBySlippage(t *testing.T) {
+	tokenIDStr := common.HashH([]byte("token")).String()
+	args := `{
+		"pdeState": {"PDEPoolPairs": {"pdepool-1": {
+			"Token1IDStr": "` + common.PRVCoinID.String() + `", "Token1PoolValue": 1000,
+			"Token2IDStr": "` + tokenIDStr + `", "Token2PoolValue": 3000}}},
+		"metaData": {"TokenIDToSellStr": "` + common.PRVCoinID.String() + `", "TokenIDToBuyStr": "` + tokenIDStr + `",
+			"SellAmount": "100", "Slippage": 1, "Type": 205}
+	}`
+	paramMaps := make(map[string]interface{})
+	assert.Equal(t, nil, json.Unmarshal([]byte(args), &paramMaps))
+	metaDataParam := paramMaps["metaData"].(map[string]interface{})
+
+	// 272 expected, 1% less
+	assert.Equal(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+	assert.Equal(t, "269", metaDataParam["MinAcceptableAmount"])
+
+	// a given minimum is kept
+	metaDataParam["MinAcceptableAmount"] = "1"
+	assert.Equal(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+	assert.Equal(t, "1", metaDataParam["MinAcceptableAmount"])
+
+	// the pool of the pair is the PRV pool for both trade types
+	delete(metaDataParam, "MinAcceptableAmount")
+	metaDataParam["Type"] = float64(metadata.PDETradeRequestMeta)
+	assert.Equal(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+	assert.Equal(t, "269", metaDataParam["MinAcceptableAmount"])
+
+	delete(metaDataParam, "MinAcceptableAmount")
+	metaDataParam["Type"] = float64(metadata.PDEContributionMeta)
+	assert.NotEqual(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+	delete(metaDataParam, "Type")
+	assert.NotEqual(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+
+	metaDataParam["Type"] = float64(metadata.PDECrossPoolTradeRequestMeta)
+	delete(paramMaps, "pdeState")
+	assert.NotEqual(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+}
+
+func TestSetMinAcceptableAmountBySlippageTokenPair(t *testing.T) {
+	tokenAStr := common.HashH([]byte("token A")).String()
+	tokenBStr := common.HashH([]byte("token B")).String()
+	args := `{
+		"pdeState": {"PDEPoolPairs": {
+			"pdepool-1": {"Token1IDStr": "` + common.PRVCoinID.String() + `", "Token1PoolValue": 1000000,
+				"Token2IDStr": "` + tokenAStr + `", "Token2PoolValue": 2000000},
+			"pdepool-2": {"Token1IDStr": "` + tokenBStr + `", "Token1PoolValue": 500000,
+				"Token2IDStr": "` + common.PRVCoinID.String() + `", "Token2PoolValue": 5000000},
+			"pdepool-3": {"Token1IDStr": "` + tokenAStr + `", "Token1PoolValue": 200000,
+				"Token2IDStr": "` + tokenBStr + `", "Token2PoolValue": 10000}}},
+		"metaData": {"TokenIDToSellStr": "` + tokenAStr + `", "TokenIDToBuyStr": "` + tokenBStr + `",
+			"SellAmount": "20000", "Slippage": 0}
+	}`
+	paramMaps := make(map[string]interface{})
+	assert.Equal(t, nil, json.Unmarshal([]byte(args), &paramMaps))
+	metaDataParam := paramMaps["metaData"].(map[string]interface{})
+	pdeState, err := initPoolStateFromParam(paramMaps)
+	assert.Equal(t, nil, err)
+
+	// a PDETradeRequest only trades in the A-B pool
+	metaDataParam["Type"] = float64(metadata.PDETradeRequestMeta)
+	assert.Equal(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+	poolAmount, err := pdex.TradeValue(*pdeState.PDEPoolPairs["pdepool-3"], tokenAStr, 20000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, strconv.FormatUint(poolAmount, 10), metaDataParam["MinAcceptableAmount"])
+
+	// a PDECrossPoolTradeRequest goes through PRV
+	delete(metaDataParam, "MinAcceptableAmount")
+	metaDataParam["Type"] = float64(metadata.PDECrossPoolTradeRequestMeta)
+	assert.Equal(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+	quote, err := pdeState.Quote(tokenAStr, tokenBStr, 20000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{tokenAStr, common.PRVCoinID.String(), tokenBStr}, quote.Route)
+	assert.Equal(t, strconv.FormatUint(quote.ReceiveAmount, 10), metaDataParam["MinAcceptableAmount"])
+	assert.NotEqual(t, quote.ReceiveAmount, poolAmount)
+
+	// without the A-B pool a PDETradeRequest can not be quoted
+	delete(metaDataParam, "MinAcceptableAmount")
+	metaDataParam["Type"] = float64(metadata.PDETradeRequestMeta)
+	delete(paramMaps["pdeState"].(map[string]interface{})["PDEPoolPairs"].(map[string]interface{}), "pdepool-3")
+	assert.NotEqual(t, nil, setMinAcceptableAmountBySlippage(paramMaps, metaDataParam))
+}
+
+func TestInitPDEContributionTxs(t *testing.T) {
+	tokenIDStr := common.HashH([]byte("token")).String()
+	plan := `{"PDEContributionPairID": "pdecontribution-1", "ContributorAddressStr": "alice",
+		"Token1IDStr": "` + common.PRVCoinID.String() + `", "Token1Amount": 1000,
+		"Token2IDStr": "` + tokenIDStr + `", "Token2Amount": 2000}`
+
+	// the token leg must be a privacy token tx of the contributed token
+	args := `{"plan": ` + plan + `, "token1Tx": {}, "token2Tx": {"privacyTokenParam": {"propertyID": "` + common.PRVCoinID.String() + `"}}}`
+	_, err := InitPDEContributionTxs(args, 0)
+	assert.NotEqual(t, nil, err)
+
+	contribution, err := metadata.NewPDEContribution("pdecontribution-1", "alice", 2000, tokenIDStr, metadata.PDEContributionMeta)
+	assert.Equal(t, nil, err)
+	_, err = initContributionTx(map[string]interface{}{}, contribution, 0)
+	assert.Equal(t, "Invalid privacy token param", err.Error())
+	_, err = initContributionTx(map[string]interface{}{"privacyTokenParam": map[string]interface{}{"propertyID": "other"}}, contribution, 0)
+	assert.Equal(t, "Privacy token param is not the contributed token", err.Error())
+
+	_, err = InitPDEContributionTxs(`{"token1Tx": {}, "token2Tx": {}}`, 0)
+	assert.Equal(t, "Invalid contribution plan param", err.Error())
+}
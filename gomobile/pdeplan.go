@@ -0,0 +1,212 @@
+package gomobile
+
+import (
+	"encoding/json"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/0xkraken/incognito-wasm/incognito/pdex"
+	"github.com/pkg/errors"
+	"strconv"
+)
+
+func initPoolStateFromParam(paramMaps map[string]interface{}) (*pdex.PoolState, error) {
+	pdeStateParam, ok := paramMaps["pdeState"].(map[string]interface{})
+	if !ok {
+		println("Invalid pde state param")
+		return nil, errors.New("Invalid pde state param")
+	}
+	pdeStateBytes, err := json.Marshal(pdeStateParam)
+	if err != nil {
+		return nil, err
+	}
+	return pdex.NewPoolStateFromJSON(pdeStateBytes)
+}
+
+// PlanPDEContribution plans a pair of contributions with a new pair ID
+// args: {"pdeState": the result of getpdestate, "contributorAddressStr": string,
+// "token1IDStr": string, "amount1": string, "token2IDStr": string, "amount2": string, optional}
+// returns the plan, without amount2 it contributes the matched amount of token 2 at the price of the pool,
+// PDEContributionPairID is the pair ID of both InitPRVContributionTx and InitPTokenContributionTx,
+// InitPDEContributionTxs creates both txs from the plan
+func PlanPDEContribution(args string) (string, error) {
+	paramMaps := make(map[string]interface{})
+	err := json.Unmarshal([]byte(args), &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+
+	pdeState, err := initPoolStateFromParam(paramMaps)
+	if err != nil {
+		return "", err
+	}
+	contributorAddressStr, ok := paramMaps["contributorAddressStr"].(string)
+	if !ok {
+		return "", errors.New("Invalid contributor address param")
+	}
+	token1IDStr, ok := paramMaps["token1IDStr"].(string)
+	if !ok {
+		return "", errors.New("Invalid token 1 id param")
+	}
+	token2IDStr, ok := paramMaps["token2IDStr"].(string)
+	if !ok {
+		return "", errors.New("Invalid token 2 id param")
+	}
+	amount1, err := common.AssertAndConvertStrToNumber(paramMaps["amount1"])
+	if err != nil {
+		return "", errors.New("Invalid amount 1 param")
+	}
+	amount2 := uint64(0)
+	if paramMaps["amount2"] != nil {
+		amount2, err = common.AssertAndConvertStrToNumber(paramMaps["amount2"])
+		if err != nil {
+			return "", errors.New("Invalid amount 2 param")
+		}
+	}
+
+	plan, err := pdeState.PlanContribution(contributorAddressStr, token1IDStr, amount1, token2IDStr, amount2)
+	if err != nil {
+		return "", err
+	}
+	res, err := json.Marshal(plan)
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
+// InitPDEContributionTxs creates the txs of both contributions of a plan, they share its pair ID
+// args: {"plan": the result of PlanPDEContribution, "metaDataType1": int, optional, "metaDataType2": int, optional,
+// "token1Tx": the args of InitPrivacyTx or InitPrivacyTokenTx, "token2Tx": the same for token 2}
+// a contribution of PRV goes with a PRV tx and any other token with a privacy token tx,
+// the metadata types are PDEPRVRequiredContributionRequestMeta unless given
+// returns a json array of both txs, serialized as InitPrivacyTx and InitPrivacyTokenTx do
+func InitPDEContributionTxs(args string, serverTime int64) (string, error) {
+	paramMaps := make(map[string]interface{})
+	err := json.Unmarshal([]byte(args), &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+
+	planParam, ok := paramMaps["plan"].(map[string]interface{})
+	if !ok {
+		println("Invalid contribution plan param")
+		return "", errors.New("Invalid contribution plan param")
+	}
+	planBytes, err := json.Marshal(planParam)
+	if err != nil {
+		return "", err
+	}
+	plan := new(pdex.ContributionPlan)
+	err = json.Unmarshal(planBytes, plan)
+	if err != nil {
+		return "", errors.New("Invalid contribution plan param")
+	}
+
+	metaTypes := []int{metadata.PDEPRVRequiredContributionRequestMeta, metadata.PDEPRVRequiredContributionRequestMeta}
+	for i, key := range []string{"metaDataType1", "metaDataType2"} {
+		if paramMaps[key] == nil {
+			continue
+		}
+		metaType, ok := paramMaps[key].(float64)
+		if !ok {
+			println("Invalid meta data type param")
+			return "", errors.New("Invalid meta data type param")
+		}
+		metaTypes[i] = int(metaType)
+	}
+	contributions, err := plan.Contributions(metaTypes[0], metaTypes[1])
+	if err != nil {
+		return "", err
+	}
+
+	res := []string{}
+	for i, key := range []string{"token1Tx", "token2Tx"} {
+		txParam, ok := paramMaps[key].(map[string]interface{})
+		if !ok {
+			println("Invalid contribution tx param")
+			return "", errors.New("Invalid contribution tx param")
+		}
+		tx, err := initContributionTx(txParam, contributions[i], serverTime)
+		if err != nil {
+			return "", err
+		}
+		res = append(res, tx)
+	}
+	resBytes, err := json.Marshal(res)
+	if err != nil {
+		return "", err
+	}
+	return string(resBytes), nil
+}
+
+// initContributionTx creates the tx of a contribution, a PRV tx when it contributes PRV and
+// a privacy token tx of the contributed token otherwise
+func initContributionTx(txParam map[string]interface{}, contribution *metadata.PDEContribution, serverTime int64) (string, error) {
+	txArgs, err := json.Marshal(txParam)
+	if err != nil {
+		return "", err
+	}
+	if contribution.TokenIDStr == common.PRVCoinID.String() {
+		return initTxWithMetaData(string(txArgs), serverTime, contribution)
+	}
+
+	pTokenParam, ok := txParam["privacyTokenParam"].(map[string]interface{})
+	if !ok {
+		println("Invalid privacy token param")
+		return "", errors.New("Invalid privacy token param")
+	}
+	if pTokenParam["propertyID"] != contribution.TokenIDStr {
+		println("Privacy token param is not the contributed token")
+		return "", errors.New("Privacy token param is not the contributed token")
+	}
+	return initPrivacyTokenTxWithMetaData(string(txArgs), serverTime, contribution)
+}
+
+// GetPDEWithdrawalAmounts returns the amounts a share withdrawal redeems
+// args: {"pdeState": the result of getpdestate, "withdrawerAddressStr": string,
+// "withdrawalToken1IDStr": string, "withdrawalToken2IDStr": string, "withdrawalShareAmt": string}
+// returns {"Token1Amount": string, "Token2Amount": string}
+func GetPDEWithdrawalAmounts(args string) (string, error) {
+	paramMaps := make(map[string]interface{})
+	err := json.Unmarshal([]byte(args), &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+
+	pdeState, err := initPoolStateFromParam(paramMaps)
+	if err != nil {
+		return "", err
+	}
+	withdrawerAddressStr, ok := paramMaps["withdrawerAddressStr"].(string)
+	if !ok {
+		return "", errors.New("Invalid withdrawer address param")
+	}
+	token1IDStr, ok := paramMaps["withdrawalToken1IDStr"].(string)
+	if !ok {
+		return "", errors.New("Invalid withdrawal token 1 id param")
+	}
+	token2IDStr, ok := paramMaps["withdrawalToken2IDStr"].(string)
+	if !ok {
+		return "", errors.New("Invalid withdrawal token 2 id param")
+	}
+	withdrawalShareAmt, err := common.AssertAndConvertStrToNumber(paramMaps["withdrawalShareAmt"])
+	if err != nil {
+		return "", errors.New("Invalid withdrawal share amount param")
+	}
+
+	amount1, amount2, err := pdeState.WithdrawalAmounts(token1IDStr, token2IDStr, withdrawerAddressStr, withdrawalShareAmt)
+	if err != nil {
+		return "", err
+	}
+	res, err := json.Marshal(map[string]string{
+		"Token1Amount": strconv.FormatUint(amount1, 10),
+		"Token2Amount": strconv.FormatUint(amount2, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
@@ -0,0 +1,309 @@
+package gomobile
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/0xkraken/incognito-wasm/incognito/transaction"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+func initMetaDataParam(args string) (map[string]interface{}, error) {
+	// parse meta data
+	bytes := []byte(args)
+	println("Bytes: %v\n", bytes)
+
+	paramMaps := make(map[string]interface{})
+
+	err := json.Unmarshal(bytes, &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return nil, err
+	}
+
+	println("paramMaps:", paramMaps)
+
+	metaDataParam, ok := paramMaps["metaData"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("Invalid meta data param")
+	}
+	return metaDataParam, nil
+}
+
+// initTxWithMetaData creates a PRV tx with metaData and returns it serialized as InitPrivacyTx
+func initTxWithMetaData(args string, serverTime int64, metaData metadata.Metadata) (string, error) {
+	paramCreateTx, err := InitParamCreatePrivacyTx(args)
+	if err != nil {
+		return "", err
+	}
+
+	paramCreateTx.SetMetaData(metaData)
+
+	tx := new(transaction.Tx)
+	err = tx.InitForASM(paramCreateTx, serverTime)
+
+	if err != nil {
+		println("Can not create tx: ", err)
+		return "", err
+	}
+
+	// serialize tx json
+	txJson, err := json.Marshal(tx)
+	if err != nil {
+		println("Can not marshal tx: ", err)
+		return "", err
+	}
+
+	lockTimeBytes := common.AddPaddingBigInt(new(big.Int).SetInt64(tx.LockTime), 8)
+	resBytes := append(txJson, lockTimeBytes...)
+
+	B64Res := base64.StdEncoding.EncodeToString(resBytes)
+
+	return B64Res, nil
+}
+
+// initPrivacyTokenTxWithMetaData creates a privacy token tx with metaData and returns it serialized as InitPrivacyTokenTx
+func initPrivacyTokenTxWithMetaData(args string, serverTime int64, metaData metadata.Metadata) (string, error) {
+	paramCreateTx, err := InitParamCreatePrivacyTokenTx(args)
+	if err != nil {
+		return "", err
+	}
+
+	paramCreateTx.SetMetaData(metaData)
+
+	tx := new(transaction.TxCustomTokenPrivacy)
+	err = tx.InitForASM(paramCreateTx, serverTime)
+
+	if err != nil {
+		println("Can not create tx: ", err)
+		return "", err
+	}
+
+	// serialize tx json
+	txJson, err := json.Marshal(tx)
+	if err != nil {
+		println("Can not marshal tx: ", err)
+		return "", err
+	}
+
+	tokenIDBytes := tx.TxPrivacyTokenData.PropertyID.GetBytes()
+
+	lockTimeBytes := common.AddPaddingBigInt(new(big.Int).SetInt64(tx.LockTime), 8)
+	resBytes := append(txJson, lockTimeBytes...)
+	resBytes = append(resBytes, tokenIDBytes...)
+
+	B64Res := base64.StdEncoding.EncodeToString(resBytes)
+
+	return B64Res, nil
+}
+
+func InitPortalUserRegisterMetadataFromParam(metaDataParam map[string]interface{}) (*metadata.PortalUserRegister, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+	uniqueRegisterId, ok := metaDataParam["UniqueRegisterId"].(string)
+	if !ok {
+		println("Invalid meta data unique register id param")
+		return nil, errors.New("Invalid meta data unique register id param")
+	}
+	incogAddressStr, ok := metaDataParam["IncogAddressStr"].(string)
+	if !ok {
+		println("Invalid meta data incognito address param")
+		return nil, errors.New("Invalid meta data incognito address param")
+	}
+	pTokenId, ok := metaDataParam["PTokenId"].(string)
+	if !ok {
+		println("Invalid meta data ptoken id param")
+		return nil, errors.New("Invalid meta data ptoken id param")
+	}
+	registerAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["RegisterAmount"])
+	if err != nil {
+		println("Invalid meta data register amount param")
+		return nil, errors.New("Invalid meta data register amount param")
+	}
+	portingFee, err := common.AssertAndConvertStrToNumber(metaDataParam["PortingFee"])
+	if err != nil {
+		println("Invalid meta data porting fee param")
+		return nil, errors.New("Invalid meta data porting fee param")
+	}
+
+	return metadata.NewPortalUserRegister(uniqueRegisterId, incogAddressStr, pTokenId, registerAmount, portingFee, int(metaDataType))
+}
+
+func InitPortalRequestPTokensMetadataFromParam(metaDataParam map[string]interface{}) (*metadata.PortalRequestPTokens, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+	uniquePortingID, ok := metaDataParam["UniquePortingID"].(string)
+	if !ok {
+		println("Invalid meta data unique porting id param")
+		return nil, errors.New("Invalid meta data unique porting id param")
+	}
+	tokenID, ok := metaDataParam["TokenID"].(string)
+	if !ok {
+		println("Invalid meta data token id param")
+		return nil, errors.New("Invalid meta data token id param")
+	}
+	incogAddressStr, ok := metaDataParam["IncogAddressStr"].(string)
+	if !ok {
+		println("Invalid meta data incognito address param")
+		return nil, errors.New("Invalid meta data incognito address param")
+	}
+	portingAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["PortingAmount"])
+	if err != nil {
+		println("Invalid meta data porting amount param")
+		return nil, errors.New("Invalid meta data porting amount param")
+	}
+	portingProof, ok := metaDataParam["PortingProof"].(string)
+	if !ok {
+		println("Invalid meta data porting proof param")
+		return nil, errors.New("Invalid meta data porting proof param")
+	}
+
+	return metadata.NewPortalRequestPTokens(uniquePortingID, tokenID, incogAddressStr, portingAmount, portingProof, int(metaDataType))
+}
+
+func InitPortalRedeemRequestMetadataFromParam(metaDataParam map[string]interface{}) (*metadata.PortalRedeemRequest, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+	uniqueRedeemID, ok := metaDataParam["UniqueRedeemID"].(string)
+	if !ok {
+		println("Invalid meta data unique redeem id param")
+		return nil, errors.New("Invalid meta data unique redeem id param")
+	}
+	tokenID, ok := metaDataParam["TokenID"].(string)
+	if !ok {
+		println("Invalid meta data token id param")
+		return nil, errors.New("Invalid meta data token id param")
+	}
+	redeemAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["RedeemAmount"])
+	if err != nil {
+		println("Invalid meta data redeem amount param")
+		return nil, errors.New("Invalid meta data redeem amount param")
+	}
+	redeemerIncAddressStr, ok := metaDataParam["RedeemerIncAddressStr"].(string)
+	if !ok {
+		println("Invalid meta data redeemer incognito address param")
+		return nil, errors.New("Invalid meta data redeemer incognito address param")
+	}
+	remoteAddress, ok := metaDataParam["RemoteAddress"].(string)
+	if !ok {
+		println("Invalid meta data remote address param")
+		return nil, errors.New("Invalid meta data remote address param")
+	}
+	redeemFee, err := common.AssertAndConvertStrToNumber(metaDataParam["RedeemFee"])
+	if err != nil {
+		println("Invalid meta data redeem fee param")
+		return nil, errors.New("Invalid meta data redeem fee param")
+	}
+	// the external address of the redeemer is only in v3
+	redeemerExternalAddress := ""
+	if int(metaDataType) == metadata.PortalRedeemRequestMetaV3 {
+		redeemerExternalAddress, ok = metaDataParam["RedeemerExternalAddress"].(string)
+		if !ok {
+			println("Invalid meta data redeemer external address param")
+			return nil, errors.New("Invalid meta data redeemer external address param")
+		}
+	}
+
+	return metadata.NewPortalRedeemRequest(
+		uniqueRedeemID, tokenID, redeemAmount, redeemerIncAddressStr, remoteAddress, redeemFee, redeemerExternalAddress, int(metaDataType),
+	)
+}
+
+func InitPortalRedeemFromLiquidationPoolMetadataFromParam(metaDataParam map[string]interface{}) (*metadata.PortalRedeemFromLiquidationPool, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+	tokenID, ok := metaDataParam["TokenID"].(string)
+	if !ok {
+		println("Invalid meta data token id param")
+		return nil, errors.New("Invalid meta data token id param")
+	}
+	redeemAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["RedeemAmount"])
+	if err != nil {
+		println("Invalid meta data redeem amount param")
+		return nil, errors.New("Invalid meta data redeem amount param")
+	}
+	redeemerIncAddressStr, ok := metaDataParam["RedeemerIncAddressStr"].(string)
+	if !ok {
+		println("Invalid meta data redeemer incognito address param")
+		return nil, errors.New("Invalid meta data redeemer incognito address param")
+	}
+	// the external address of the redeemer is only in v3
+	redeemerExtAddressStr := ""
+	if int(metaDataType) == metadata.PortalRedeemFromLiquidationPoolMetaV3 {
+		redeemerExtAddressStr, ok = metaDataParam["RedeemerExtAddressStr"].(string)
+		if !ok {
+			println("Invalid meta data redeemer external address param")
+			return nil, errors.New("Invalid meta data redeemer external address param")
+		}
+	}
+
+	return metadata.NewPortalRedeemFromLiquidationPool(tokenID, redeemAmount, redeemerIncAddressStr, redeemerExtAddressStr, int(metaDataType))
+}
+
+// InitPortalPortingRequestTx creates a porting request, the tx pays the porting fee to the burning address
+func InitPortalPortingRequestTx(args string, serverTime int64) (string, error) {
+	metaDataParam, err := initMetaDataParam(args)
+	if err != nil {
+		return "", err
+	}
+	metaData, err := InitPortalUserRegisterMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	return initTxWithMetaData(args, serverTime, metaData)
+}
+
+// InitPortalRequestPTokenTx creates a request of the ptokens of a porting request with the proof of the external transfer
+func InitPortalRequestPTokenTx(args string, serverTime int64) (string, error) {
+	metaDataParam, err := initMetaDataParam(args)
+	if err != nil {
+		return "", err
+	}
+	metaData, err := InitPortalRequestPTokensMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	return initTxWithMetaData(args, serverTime, metaData)
+}
+
+// InitPortalRedeemRequestTx creates a redeem request to an external BTC or BNB address,
+// the tx burns the redeem amount of the ptoken and pays the redeem fee in PRV
+func InitPortalRedeemRequestTx(args string, serverTime int64) (string, error) {
+	metaDataParam, err := initMetaDataParam(args)
+	if err != nil {
+		return "", err
+	}
+	metaData, err := InitPortalRedeemRequestMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	return initPrivacyTokenTxWithMetaData(args, serverTime, metaData)
+}
+
+// InitPortalRedeemFromLiquidationPoolTx creates a redeem request of the collateral of liquidated custodians,
+// the tx burns the redeem amount of the ptoken
+func InitPortalRedeemFromLiquidationPoolTx(args string, serverTime int64) (string, error) {
+	metaDataParam, err := initMetaDataParam(args)
+	if err != nil {
+		return "", err
+	}
+	metaData, err := InitPortalRedeemFromLiquidationPoolMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	return initPrivacyTokenTxWithMetaData(args, serverTime, metaData)
+}
@@ -0,0 +1,280 @@
+package gomobile
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// initEVMProofFromParam reads the shielding proof of EVM collateral: BlockHash, TxIndex and ProofStrs
+func initEVMProofFromParam(metaDataParam map[string]interface{}) (rCommon.Hash, uint, []string, error) {
+	blockHashStr, ok := metaDataParam["BlockHash"].(string)
+	if !ok {
+		println("Invalid meta data block hash param")
+		return rCommon.Hash{}, 0, nil, errors.New("Invalid meta data block hash param")
+	}
+	txIdx, ok := metaDataParam["TxIndex"].(float64)
+	if !ok {
+		println("Invalid meta data tx index param")
+		return rCommon.Hash{}, 0, nil, errors.New("Invalid meta data tx index param")
+	}
+	proofsRaw, ok := metaDataParam["ProofStrs"].([]interface{})
+	if !ok {
+		println("Invalid meta data ProofStrs param, must be an array of string")
+		return rCommon.Hash{}, 0, nil, errors.New("Invalid meta data ProofStrs param, must be an array of string")
+	}
+	proofStrs := []string{}
+	for _, item := range proofsRaw {
+		proofStr, ok := item.(string)
+		if !ok {
+			println("Invalid meta data ProofStrs param, must be an array of string")
+			return rCommon.Hash{}, 0, nil, errors.New("Invalid meta data ProofStrs param, must be an array of string")
+		}
+		proofStrs = append(proofStrs, proofStr)
+	}
+	return rCommon.HexToHash(blockHashStr), uint(txIdx), proofStrs, nil
+}
+
+func initRemoteAddressesFromParam(metaDataParam map[string]interface{}) (map[string]string, error) {
+	remoteAddressesRaw, ok := metaDataParam["RemoteAddresses"].(map[string]interface{})
+	if !ok || len(remoteAddressesRaw) == 0 {
+		println("Invalid meta data remote addresses param")
+		return nil, errors.New("Invalid meta data remote addresses param")
+	}
+	remoteAddresses := map[string]string{}
+	for tokenID, item := range remoteAddressesRaw {
+		remoteAddress, ok := item.(string)
+		if !ok {
+			println("Invalid meta data remote addresses param")
+			return nil, errors.New("Invalid meta data remote addresses param")
+		}
+		remoteAddresses[tokenID] = remoteAddress
+	}
+	return remoteAddresses, nil
+}
+
+func InitPortalCustodianDepositMetadataFromParam(metaDataParam map[string]interface{}) (metadata.Metadata, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+	remoteAddresses, err := initRemoteAddressesFromParam(metaDataParam)
+	if err != nil {
+		return nil, err
+	}
+
+	switch int(metaDataType) {
+	case metadata.PortalCustodianDepositMeta:
+		incAddressStr, ok := metaDataParam["IncogAddressStr"].(string)
+		if !ok {
+			println("Invalid meta data incognito address param")
+			return nil, errors.New("Invalid meta data incognito address param")
+		}
+		depositedAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["DepositedAmount"])
+		if err != nil {
+			println("Invalid meta data deposited amount param")
+			return nil, errors.New("Invalid meta data deposited amount param")
+		}
+		return metadata.NewPortalCustodianDeposit(incAddressStr, remoteAddresses, depositedAmount, int(metaDataType))
+	case metadata.PortalCustodianDepositMetaV3:
+		blockHash, txIdx, proofStrs, err := initEVMProofFromParam(metaDataParam)
+		if err != nil {
+			return nil, err
+		}
+		return metadata.NewPortalCustodianDepositV3(remoteAddresses, blockHash, txIdx, proofStrs, int(metaDataType))
+	default:
+		return nil, errors.New("Invalid meta data type param")
+	}
+}
+
+func InitPortalCustodianWithdrawRequestMetadataFromParam(metaDataParam map[string]interface{}) (metadata.Metadata, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+	amount, err := common.AssertAndConvertStrToNumber(metaDataParam["Amount"])
+	if err != nil {
+		println("Invalid meta data amount param")
+		return nil, errors.New("Invalid meta data amount param")
+	}
+
+	switch int(metaDataType) {
+	case metadata.PortalCustodianWithdrawRequestMeta:
+		paymentAddress, ok := metaDataParam["PaymentAddress"].(string)
+		if !ok {
+			println("Invalid meta data payment address param")
+			return nil, errors.New("Invalid meta data payment address param")
+		}
+		return metadata.NewPortalCustodianWithdrawRequest(paymentAddress, amount, int(metaDataType))
+	case metadata.PortalCustodianWithdrawRequestMetaV3:
+		custodianIncAddress, ok := metaDataParam["CustodianIncAddress"].(string)
+		if !ok {
+			println("Invalid meta data custodian incognito address param")
+			return nil, errors.New("Invalid meta data custodian incognito address param")
+		}
+		custodianExtAddress, ok := metaDataParam["CustodianExtAddress"].(string)
+		if !ok || !rCommon.IsHexAddress(custodianExtAddress) {
+			println("Invalid meta data custodian external address param")
+			return nil, errors.New("Invalid meta data custodian external address param")
+		}
+		extTokenID, ok := metaDataParam["ExtTokenID"].(string)
+		if !ok || !rCommon.IsHexAddress(extTokenID) {
+			println("Invalid meta data external token id param")
+			return nil, errors.New("Invalid meta data external token id param")
+		}
+		return metadata.NewPortalCustodianWithdrawRequestV3(custodianIncAddress, custodianExtAddress, extTokenID, amount, int(metaDataType))
+	default:
+		return nil, errors.New("Invalid meta data type param")
+	}
+}
+
+func InitPortalCustodianTopupMetadataFromParam(metaDataParam map[string]interface{}) (metadata.Metadata, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+	incAddressStr, ok := metaDataParam["IncogAddressStr"].(string)
+	if !ok {
+		println("Invalid meta data incognito address param")
+		return nil, errors.New("Invalid meta data incognito address param")
+	}
+	pTokenID, ok := metaDataParam["PTokenID"].(string)
+	if !ok {
+		println("Invalid meta data ptoken id param")
+		return nil, errors.New("Invalid meta data ptoken id param")
+	}
+	depositedAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["DepositedAmount"])
+	if err != nil {
+		println("Invalid meta data deposited amount param")
+		return nil, errors.New("Invalid meta data deposited amount param")
+	}
+	freeCollateralAmount, err := common.AssertAndConvertStrToNumber(metaDataParam["FreeCollateralAmount"])
+	if err != nil {
+		println("Invalid meta data free collateral amount param")
+		return nil, errors.New("Invalid meta data free collateral amount param")
+	}
+	// a porting ID tops up the collateral of a waiting porting request
+	portingID, _ := metaDataParam["PortingID"].(string)
+
+	switch int(metaDataType) {
+	case metadata.PortalCustodianTopupMetaV2:
+		return metadata.NewPortalLiquidationCustodianDepositV2(incAddressStr, pTokenID, depositedAmount, freeCollateralAmount, int(metaDataType))
+	case metadata.PortalTopUpWaitingPortingRequestMeta:
+		if portingID == "" {
+			println("Invalid meta data porting id param")
+			return nil, errors.New("Invalid meta data porting id param")
+		}
+		return metadata.NewPortalTopUpWaitingPortingRequest(incAddressStr, portingID, pTokenID, depositedAmount, freeCollateralAmount, int(metaDataType))
+	case metadata.PortalCustodianTopupMetaV3, metadata.PortalTopUpWaitingPortingRequestMetaV3:
+		collateralTokenID, ok := metaDataParam["CollateralTokenID"].(string)
+		if !ok || !rCommon.IsHexAddress(collateralTokenID) {
+			println("Invalid meta data collateral token id param")
+			return nil, errors.New("Invalid meta data collateral token id param")
+		}
+		// the deposit comes with a shielding proof, the top up may only use free collateral
+		blockHash, txIdx, proofStrs := rCommon.Hash{}, uint(0), []string{}
+		if depositedAmount > 0 {
+			blockHash, txIdx, proofStrs, err = initEVMProofFromParam(metaDataParam)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if int(metaDataType) == metadata.PortalCustodianTopupMetaV3 {
+			return metadata.NewPortalLiquidationCustodianDepositV3(
+				incAddressStr, pTokenID, collateralTokenID, depositedAmount, freeCollateralAmount, blockHash, txIdx, proofStrs, int(metaDataType),
+			)
+		}
+		if portingID == "" {
+			println("Invalid meta data porting id param")
+			return nil, errors.New("Invalid meta data porting id param")
+		}
+		return metadata.NewPortalTopUpWaitingPortingRequestV3(
+			incAddressStr, portingID, pTokenID, collateralTokenID, depositedAmount, freeCollateralAmount, blockHash, txIdx, proofStrs, int(metaDataType),
+		)
+	default:
+		return nil, errors.New("Invalid meta data type param")
+	}
+}
+
+func InitPortalRequestWithdrawRewardMetadataFromParam(metaDataParam map[string]interface{}) (*metadata.PortalRequestWithdrawReward, error) {
+	metaDataType, ok := metaDataParam["Type"].(float64)
+	if !ok {
+		println("Invalid meta data type param")
+		return nil, errors.New("Invalid meta data type param")
+	}
+	custodianAddressStr, ok := metaDataParam["CustodianAddressStr"].(string)
+	if !ok {
+		println("Invalid meta data custodian address param")
+		return nil, errors.New("Invalid meta data custodian address param")
+	}
+	tokenIDStr, ok := metaDataParam["TokenID"].(string)
+	if !ok {
+		println("Invalid meta data token id param")
+		return nil, errors.New("Invalid meta data token id param")
+	}
+	tokenID, err := common.Hash{}.NewHashFromStr(tokenIDStr)
+	if err != nil {
+		println("Invalid meta data token id param")
+		return nil, errors.New("Invalid meta data token id param")
+	}
+	return metadata.NewPortalRequestWithdrawReward(custodianAddressStr, *tokenID, int(metaDataType))
+}
+
+// InitPortalCustodianDepositTx creates a custodian deposit: PortalCustodianDepositMeta deposits
+// the PRV the tx burns, PortalCustodianDepositMetaV3 the ETH or ERC20 of the shielding proof
+func InitPortalCustodianDepositTx(args string, serverTime int64) (string, error) {
+	metaDataParam, err := initMetaDataParam(args)
+	if err != nil {
+		return "", err
+	}
+	metaData, err := InitPortalCustodianDepositMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	return initTxWithMetaData(args, serverTime, metaData)
+}
+
+// InitPortalCustodianWithdrawRequestTx creates a withdrawal of free PRV (PortalCustodianWithdrawRequestMeta)
+// or ETH/ERC20 (PortalCustodianWithdrawRequestMetaV3) collateral
+func InitPortalCustodianWithdrawRequestTx(args string, serverTime int64) (string, error) {
+	metaDataParam, err := initMetaDataParam(args)
+	if err != nil {
+		return "", err
+	}
+	metaData, err := InitPortalCustodianWithdrawRequestMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	return initTxWithMetaData(args, serverTime, metaData)
+}
+
+// InitPortalCustodianTopupTx creates a top up of the collateral of a custodian (PortalCustodianTopupMetaV2/V3)
+// or of its collateral for a waiting porting request (PortalTopUpWaitingPortingRequestMeta/V3)
+func InitPortalCustodianTopupTx(args string, serverTime int64) (string, error) {
+	metaDataParam, err := initMetaDataParam(args)
+	if err != nil {
+		return "", err
+	}
+	metaData, err := InitPortalCustodianTopupMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	return initTxWithMetaData(args, serverTime, metaData)
+}
+
+// InitPortalRequestWithdrawRewardTx creates a withdrawal of the portal rewards of a custodian
+func InitPortalRequestWithdrawRewardTx(args string, serverTime int64) (string, error) {
+	metaDataParam, err := initMetaDataParam(args)
+	if err != nil {
+		return "", err
+	}
+	metaData, err := InitPortalRequestWithdrawRewardMetadataFromParam(metaDataParam)
+	if err != nil {
+		return "", err
+	}
+	return initTxWithMetaData(args, serverTime, metaData)
+}
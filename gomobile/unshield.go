@@ -0,0 +1,60 @@
+package gomobile
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/pkg/errors"
+)
+
+type unshieldCalldataResult struct {
+	Calldata string
+	Token    string
+	To       string
+	Amount   string
+	TxID     string
+}
+
+type unshieldCalldataParam struct {
+	Proof  *metadata.BurnProof `json:"proof"`
+	Method string              `json:"method"`
+}
+
+// GenerateUnshieldCalldata encodes the vault call submitting the burn proof of a burning request tx
+// args: {"proof": the result of getburnproof or getbscburnproof, "method": "withdraw" or "submitBurnProof"}
+// returns {"Calldata", "Token", "To", "Amount", "TxID"}, Calldata is the 0x-prefixed data of the tx to the vault
+func GenerateUnshieldCalldata(args string) (string, error) {
+	var param unshieldCalldataParam
+	err := json.Unmarshal([]byte(args), &param)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+	if param.Proof == nil {
+		return "", errors.New("Invalid proof param")
+	}
+	if param.Method == "" {
+		param.Method = metadata.VaultWithdrawMethod
+	}
+
+	proof, err := param.Proof.Decode()
+	if err != nil {
+		return "", err
+	}
+	calldata, err := proof.Calldata(param.Method)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := json.Marshal(unshieldCalldataResult{
+		Calldata: "0x" + hex.EncodeToString(calldata),
+		Token:    proof.Inst.Token.Hex(),
+		To:       proof.Inst.To.Hex(),
+		Amount:   proof.Inst.Amount.String(),
+		TxID:     proof.Inst.TxID.Hex(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
@@ -0,0 +1,29 @@
+package gomobile
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestGenerateUnshieldCalldata(t *testing.T) {
+	proof, err := ioutil.ReadFile("../incognito/metadata/testdata/burnproof.json")
+	assert.Equal(t, nil, err)
+	golden, err := ioutil.ReadFile("../incognito/metadata/testdata/submitburnproof.golden")
+	assert.Equal(t, nil, err)
+
+	res, err := GenerateUnshieldCalldata(`{"proof": ` + string(proof) + `, "method": "submitBurnProof"}`)
+	assert.Equal(t, nil, err)
+	var calldata unshieldCalldataResult
+	assert.Equal(t, nil, json.Unmarshal([]byte(res), &calldata))
+	assert.Equal(t, "0x"+strings.TrimSpace(string(golden)), calldata.Calldata)
+	assert.Equal(t, "0xE722D8B71DCc0152D47c3521BaA9aE3c71e9B4A3", calldata.To)
+	assert.Equal(t, "123456789", calldata.Amount)
+
+	_, err = GenerateUnshieldCalldata(`{"method": "withdraw"}`)
+	assert.NotEqual(t, nil, err)
+	_, err = GenerateUnshieldCalldata(`{"proof": ` + string(proof) + `, "method": "deposit"}`)
+	assert.NotEqual(t, nil, err)
+}
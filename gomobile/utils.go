@@ -154,12 +154,22 @@ func RandomScalars(n string) (string, error) {
 }
 
 // plaintextB64Encode = base64Encode(public key bytes || msg)
-// returns base64Encode(ciphertextBytes)
+// returns base64Encode(ciphertextBytes) of a privacy.HybridCipherTextVersion1 ciphertext
 func HybridEncryptionASM(dataB64Encode string) (string, error) {
+	return HybridEncryptionASMWithVersion(dataB64Encode, privacy.HybridCipherTextVersion1)
+}
+
+// plaintextB64Encode = base64Encode(public key bytes || msg)
+// version is privacy.HybridCipherTextVersion1 (legacy) or privacy.HybridCipherTextVersion2
+// returns base64Encode(ciphertextBytes)
+func HybridEncryptionASMWithVersion(dataB64Encode string, version int) (string, error) {
 	data, err := base64.StdEncoding.DecodeString(dataB64Encode)
 	if err != nil {
 		return "", nil
 	}
+	if len(data) < privacy.Ed25519KeySize {
+		return "", errors.New("Invalid data length")
+	}
 
 	publicKeyBytes := data[0:privacy.Ed25519KeySize]
 	publicKeyPoint, err := new(privacy.Point).FromBytesS(publicKeyBytes)
@@ -169,7 +179,7 @@ func HybridEncryptionASM(dataB64Encode string) (string, error) {
 
 	msgBytes := data[privacy.Ed25519KeySize:]
 
-	ciphertext, err := privacy.HybridEncrypt(msgBytes, publicKeyPoint)
+	ciphertext, err := privacy.HybridEncryptWithVersion(msgBytes, publicKeyPoint, version)
 	if err != nil {
 		return "", err
 	}
@@ -178,19 +188,26 @@ func HybridEncryptionASM(dataB64Encode string) (string, error) {
 }
 
 // plaintextB64Encode = base64Encode(private key || ciphertext)
+// the ciphertext may be of either version
 // returns base64Encode(plaintextBytes)
 func HybridDecryptionASM(dataB64Encode string) (string, error) {
 	data, err := base64.StdEncoding.DecodeString(dataB64Encode)
 	if err != nil {
 		return "", nil
 	}
+	if len(data) < privacy.Ed25519KeySize {
+		return "", errors.New("Invalid data length")
+	}
 
 	privateKeyBytes := data[0:privacy.Ed25519KeySize]
 	privateKeyScalar := new(privacy.Scalar).FromBytesS(privateKeyBytes)
 
 	ciphertextBytes := data[privacy.Ed25519KeySize:]
 	ciphertext := new(privacy.HybridCipherText)
-	ciphertext.SetBytes(ciphertextBytes)
+	err = ciphertext.SetBytes(ciphertextBytes)
+	if err != nil {
+		return "", err
+	}
 
 	plaintextBytes, err := privacy.HybridDecrypt(ciphertext, privateKeyScalar)
 	if err != nil {
@@ -200,6 +217,27 @@ func HybridDecryptionASM(dataB64Encode string) (string, error) {
 	return res, nil
 }
 
+// dataB64Encode = base64Encode(private receiving key || coin info)
+// the coin info may hold a plaintext or an encrypted memo
+// returns base64Encode(memoBytes)
+func DecryptMemo(dataB64Encode string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(dataB64Encode)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < privacy.Ed25519KeySize {
+		return "", errors.New("Invalid data length")
+	}
+
+	viewingKey := privacy.ViewingKey{Rk: data[0:privacy.Ed25519KeySize]}
+	memo, err := privacy.DecryptMemo(data[privacy.Ed25519KeySize:], viewingKey)
+	if err != nil {
+		return "", err
+	}
+	res := base64.StdEncoding.EncodeToString(memo)
+	return res, nil
+}
+
 func ParseNativeRawTx(b64RawTx string) (string, error) {
 	 rawTxWithLockTime, err := base64.StdEncoding.DecodeString(b64RawTx)
 	 if err != nil {
@@ -1,8 +1,12 @@
 package gomobile
 
 import (
+	"encoding/base64"
 	"fmt"
 	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/privacy"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestParsePrivacyTokenRawTx(t *testing.T) {
@@ -18,3 +22,20 @@ func TestParseNativeRawTxx(t *testing.T) {
 	fmt.Printf("TxId: %v\n", txId)
 	fmt.Printf("err: %v\n", err)
 }
+
+func TestDecryptMemo(t *testing.T) {
+	privateKey := privacy.GeneratePrivateKey([]byte("memo"))
+	paymentAddress := privacy.GeneratePaymentAddress(privateKey)
+	receivingKey := privacy.GenerateReceivingKey(privateKey)
+
+	info, err := privacy.EncryptMemo([]byte("invoice 42"), paymentAddress.Tk)
+	assert.Equal(t, nil, err)
+	res, err := DecryptMemo(base64.StdEncoding.EncodeToString(append(append([]byte{}, receivingKey...), info...)))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("invoice 42")), res)
+
+	// plaintext memos are returned as they are
+	res, err = DecryptMemo(base64.StdEncoding.EncodeToString(append(append([]byte{}, receivingKey...), []byte("hello")...)))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("hello")), res)
+}
@@ -0,0 +1,102 @@
+package gomobile
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"github.com/0xkraken/incognito-wasm/incognito/base58"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+	"github.com/0xkraken/incognito-wasm/incognito/signatureschemes/bridgesig"
+	"github.com/0xkraken/incognito-wasm/incognito/wallet"
+	"github.com/pkg/errors"
+)
+
+type validatorKeyResult struct {
+	ValidatorKey       string
+	CommitteePublicKey string
+	BLSPublicKey       string
+	BridgePublicKey    string
+	BridgeAddress      string
+}
+
+func newValidatorKeyResult(validatorKey *incognitokey.ValidatorKey) (string, error) {
+	committeePublicKey, err := validatorKey.CommitteePublicKeyBase58()
+	if err != nil {
+		return "", err
+	}
+	bridgeAddress, err := bridgesig.PKBytesToETHAddress(validatorKey.BridgePublicKey)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := json.Marshal(validatorKeyResult{
+		ValidatorKey:       validatorKey.ToBase58(),
+		CommitteePublicKey: committeePublicKey,
+		BLSPublicKey:       base58.Base58Check{}.Encode(validatorKey.BLSPublicKey, common.Base58Version),
+		BridgePublicKey:    hex.EncodeToString(validatorKey.BridgePublicKey),
+		BridgeAddress:      bridgeAddress.Hex(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
+// GenerateValidatorKey derives the validator key of a private key
+// args: {"privateKey": string}
+// returns {"ValidatorKey", "CommitteePublicKey", "BLSPublicKey", "BridgePublicKey", "BridgeAddress"},
+// CommitteePublicKey is the CommitteePublicKey param of Staking
+func GenerateValidatorKey(args string) (string, error) {
+	paramMaps := make(map[string]interface{})
+	err := json.Unmarshal([]byte(args), &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+
+	privateKey, ok := paramMaps["privateKey"].(string)
+	if !ok {
+		return "", errors.New("Invalid private key param")
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(privateKey)
+	if err != nil {
+		return "", errors.New("Invalid private key param")
+	}
+
+	validatorKey, err := incognitokey.NewValidatorKeyFromPrivateKey(keyWallet.KeySet.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+	return newValidatorKeyResult(validatorKey)
+}
+
+// GetValidatorKeyInfo returns the keys of a validator key string staked for a candidate payment address
+// args: {"validatorKey": string, "paymentAddress": string}
+// returns the same as GenerateValidatorKey
+func GetValidatorKeyInfo(args string) (string, error) {
+	paramMaps := make(map[string]interface{})
+	err := json.Unmarshal([]byte(args), &paramMaps)
+	if err != nil {
+		println("Error can not unmarshal data : %v\n", err)
+		return "", err
+	}
+
+	validatorKeyStr, ok := paramMaps["validatorKey"].(string)
+	if !ok {
+		return "", errors.New("Invalid validator key param")
+	}
+	paymentAddress, ok := paramMaps["paymentAddress"].(string)
+	if !ok {
+		return "", errors.New("Invalid payment address param")
+	}
+	keyWallet, err := wallet.Base58CheckDeserialize(paymentAddress)
+	if err != nil {
+		return "", errors.New("Invalid payment address param")
+	}
+
+	validatorKey, err := incognitokey.NewValidatorKeyFromBase58(validatorKeyStr, keyWallet.KeySet.PaymentAddress.Pk)
+	if err != nil {
+		return "", err
+	}
+	return newValidatorKeyResult(validatorKey)
+}
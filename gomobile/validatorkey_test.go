@@ -0,0 +1,36 @@
+package gomobile
+
+import (
+	"encoding/json"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+	"github.com/0xkraken/incognito-wasm/incognito/wallet"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGenerateValidatorKey(t *testing.T) {
+	keyWallet, err := wallet.NewMasterKey([]byte("validator key seed"))
+	assert.Equal(t, nil, err)
+	privateKey := keyWallet.Base58CheckSerialize(wallet.PriKeyType)
+	paymentAddress := keyWallet.Base58CheckSerialize(wallet.PaymentAddressType)
+
+	res, err := GenerateValidatorKey(`{"privateKey": "` + privateKey + `"}`)
+	assert.Equal(t, nil, err)
+	var generated validatorKeyResult
+	assert.Equal(t, nil, json.Unmarshal([]byte(res), &generated))
+
+	committeePubKey := new(incognitokey.CommitteePublicKey)
+	assert.Equal(t, nil, committeePubKey.FromString(generated.CommitteePublicKey))
+	assert.Equal(t, true, committeePubKey.CheckSanityData())
+	assert.Equal(t, []byte(keyWallet.KeySet.PaymentAddress.Pk), []byte(committeePubKey.IncPubKey))
+
+	// the validator key string and the candidate payment address give the same keys
+	res2, err := GetValidatorKeyInfo(`{"validatorKey": "` + generated.ValidatorKey + `", "paymentAddress": "` + paymentAddress + `"}`)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, res, res2)
+
+	_, err = GenerateValidatorKey(`{"privateKey": "invalid"}`)
+	assert.NotEqual(t, nil, err)
+	_, err = GetValidatorKeyInfo(`{"validatorKey": "invalid", "paymentAddress": "` + paymentAddress + `"}`)
+	assert.NotEqual(t, nil, err)
+}
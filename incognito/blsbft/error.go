@@ -0,0 +1,48 @@
+package blsbft
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	UnExpectedError = iota
+	InvalidValidationDataErr
+	InvalidCommitteeErr
+	InvalidEpochErr
+	InvalidValidatorsIdxErr
+	NotEnoughSignatureErr
+	InvalidSignatureErr
+)
+
+var ErrCodeMessage = map[int]struct {
+	Code    int
+	Message string
+}{
+	UnExpectedError:          {-1300, "Unexpected error"},
+	InvalidValidationDataErr: {-1301, "Validation data is invalid"},
+	InvalidCommitteeErr:      {-1302, "Committee is invalid"},
+	InvalidEpochErr:          {-1303, "Committee epoch does not match block epoch"},
+	InvalidValidatorsIdxErr:  {-1304, "Validators index list is invalid"},
+	NotEnoughSignatureErr:    {-1305, "Not enough validators signed the block"},
+	InvalidSignatureErr:      {-1306, "Aggregated signature is invalid"},
+}
+
+type BLSBFTError struct {
+	Code    int
+	Message string
+	err     error
+}
+
+func (e BLSBFTError) Error() string {
+	return fmt.Sprintf("%d: %s \n %+v", e.Code, e.Message, e.err)
+}
+
+func NewBLSBFTError(key int, err error) error {
+	return &BLSBFTError{
+		Code:    ErrCodeMessage[key].Code,
+		Message: ErrCodeMessage[key].Message,
+		err:     errors.Wrap(err, ErrCodeMessage[key].Message),
+	}
+}
@@ -0,0 +1,199 @@
+package blsbft
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+)
+
+// BeaconHeader is the header of a beacon block, as the chain serializes it
+type BeaconHeader struct {
+	Version           int         `json:"Version"`
+	Height            uint64      `json:"Height"`
+	Epoch             uint64      `json:"Epoch"`
+	Round             int         `json:"Round"`
+	Timestamp         int64       `json:"Timestamp"`
+	PreviousBlockHash common.Hash `json:"PreviousBlockHash"`
+	InstructionHash   common.Hash `json:"InstructionHash"`
+	ShardStateHash    common.Hash `json:"ShardStateHash"`
+	// Merkle root of all instructions (using Keccak256 hash func) to relay to Ethereum
+	InstructionMerkleRoot           common.Hash `json:"InstructionMerkleRoot"`
+	BeaconCommitteeAndValidatorRoot common.Hash `json:"BeaconCommitteeAndValidatorRoot"`
+	BeaconCandidateRoot             common.Hash `json:"BeaconCandidateRoot"`
+	ShardCandidateRoot              common.Hash `json:"ShardCandidateRoot"`
+	ShardCommitteeAndValidatorRoot  common.Hash `json:"ShardCommitteeAndValidatorRoot"`
+	AutoStakingRoot                 common.Hash `json:"AutoStakingRoot"`
+	ConsensusType                   string      `json:"ConsensusType"`
+	Producer                        string      `json:"Producer"`
+	ProducerPubKeyStr               string      `json:"ProducerPubKeyStr"`
+	// for version 2
+	Proposer    string `json:"Proposer"`
+	ProposeTime int64  `json:"ProposeTime"`
+}
+
+func (header BeaconHeader) toString() string {
+	res := common.EmptyString
+	res += fmt.Sprintf("%v", header.Version)
+	res += fmt.Sprintf("%v", header.Height)
+	res += fmt.Sprintf("%v", header.Epoch)
+	res += fmt.Sprintf("%v", header.Round)
+	res += fmt.Sprintf("%v", header.Timestamp)
+	res += header.PreviousBlockHash.String()
+	res += header.BeaconCommitteeAndValidatorRoot.String()
+	res += header.BeaconCandidateRoot.String()
+	res += header.ShardCandidateRoot.String()
+	res += header.ShardCommitteeAndValidatorRoot.String()
+	res += header.AutoStakingRoot.String()
+	res += header.ShardStateHash.String()
+	res += header.InstructionHash.String()
+	res += header.Producer
+	if header.Version == 2 {
+		res += header.Proposer
+		res += fmt.Sprintf("%v", header.ProposeTime)
+	}
+	return res
+}
+
+// MetaHash is the Keccak256 hash of the header fields except the instruction merkle root
+func (header BeaconHeader) MetaHash() common.Hash {
+	return common.Keccak256([]byte(header.toString()))
+}
+
+// Hash is the block hash the beacon committee signs, Keccak256 so it can be checked on Ethereum
+func (header BeaconHeader) Hash() common.Hash {
+	blkMetaHash := header.MetaHash()
+	blkInstHash := header.InstructionMerkleRoot
+	combined := append(blkMetaHash[:], blkInstHash[:]...)
+	return common.Keccak256(combined)
+}
+
+func (header BeaconHeader) GetEpoch() uint64 {
+	return header.Epoch
+}
+
+func (header BeaconHeader) GetChainID() int {
+	return incognitokey.BeaconChainID
+}
+
+func (header BeaconHeader) isBlockHeader() {}
+
+// ShardHeader is the header of a shard block, as the chain serializes it
+type ShardHeader struct {
+	Producer              string                 `json:"Producer"`
+	ProducerPubKeyStr     string                 `json:"ProducerPubKeyStr"`
+	ShardID               byte                   `json:"ShardID"`
+	Version               int                    `json:"Version"`
+	PreviousBlockHash     common.Hash            `json:"PreviousBlockHash"`
+	Height                uint64                 `json:"Height"`
+	Round                 int                    `json:"Round"`
+	Epoch                 uint64                 `json:"Epoch"`
+	CrossShardBitMap      []byte                 `json:"CrossShardBitMap"`
+	BeaconHeight          uint64                 `json:"BeaconHeight"`
+	BeaconHash            common.Hash            `json:"BeaconHash"`
+	TotalTxsFee           map[common.Hash]uint64 `json:"TotalTxsFee"`
+	ConsensusType         string                 `json:"ConsensusType"`
+	Timestamp             int64                  `json:"Timestamp"`
+	TxRoot                common.Hash            `json:"TxRoot"`
+	ShardTxRoot           common.Hash            `json:"ShardTxRoot"`
+	CrossTransactionRoot  common.Hash            `json:"CrossTransactionRoot"`
+	InstructionsRoot      common.Hash            `json:"InstructionsRoot"`
+	CommitteeRoot         common.Hash            `json:"CommitteeRoot"`
+	PendingValidatorRoot  common.Hash            `json:"PendingValidatorRoot"`
+	StakingTxRoot         common.Hash            `json:"StakingTxRoot"`
+	InstructionMerkleRoot common.Hash            `json:"InstructionMerkleRoot"`
+	// for version 2
+	Proposer    string `json:"Proposer"`
+	ProposeTime int64  `json:"ProposeTime"`
+}
+
+// UnmarshalJSON decodes the token IDs of TotalTxsFee from their hex strings,
+// common.Hash.UnmarshalText can not set a map key
+func (header *ShardHeader) UnmarshalJSON(data []byte) error {
+	type shardHeader ShardHeader
+	tmp := struct {
+		*shardHeader
+		TotalTxsFee map[string]uint64 `json:"TotalTxsFee"`
+	}{shardHeader: (*shardHeader)(header)}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+	header.TotalTxsFee = nil
+	if tmp.TotalTxsFee != nil {
+		header.TotalTxsFee = make(map[common.Hash]uint64, len(tmp.TotalTxsFee))
+		for tokenIDStr, fee := range tmp.TotalTxsFee {
+			tokenID, err := common.Hash{}.NewHashFromStr(tokenIDStr)
+			if err != nil {
+				return err
+			}
+			header.TotalTxsFee[*tokenID] = fee
+		}
+	}
+	return nil
+}
+
+func (header ShardHeader) String() string {
+	res := common.EmptyString
+	res += header.Producer
+	res += fmt.Sprintf("%v", header.ShardID)
+	res += fmt.Sprintf("%v", header.Version)
+	res += header.PreviousBlockHash.String()
+	res += fmt.Sprintf("%v", header.Height)
+	res += fmt.Sprintf("%v", header.Round)
+	res += fmt.Sprintf("%v", header.Epoch)
+	res += fmt.Sprintf("%v", header.Timestamp)
+	res += header.TxRoot.String()
+	res += header.ShardTxRoot.String()
+	res += header.CrossTransactionRoot.String()
+	res += header.InstructionsRoot.String()
+	res += header.CommitteeRoot.String()
+	res += header.PendingValidatorRoot.String()
+	res += header.BeaconHash.String()
+	res += header.StakingTxRoot.String()
+	res += fmt.Sprintf("%v", header.BeaconHeight)
+	tokenIDs := make([]common.Hash, 0)
+	for tokenID := range header.TotalTxsFee {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Slice(tokenIDs, func(i int, j int) bool {
+		res, _ := tokenIDs[i].Cmp(&tokenIDs[j])
+		return res == -1
+	})
+	for _, tokenID := range tokenIDs {
+		res += fmt.Sprintf("%v~%v", tokenID.String(), header.TotalTxsFee[tokenID])
+	}
+	for _, value := range header.CrossShardBitMap {
+		res += string(value)
+	}
+	if header.Version == 2 {
+		res += header.Proposer
+		res += fmt.Sprintf("%v", header.ProposeTime)
+	}
+	return res
+}
+
+// MetaHash is the Keccak256 hash of the header fields except the instruction merkle root
+func (header ShardHeader) MetaHash() common.Hash {
+	return common.Keccak256([]byte(header.String()))
+}
+
+// Hash is the block hash the shard committee signs, Keccak256 so it can be checked on Ethereum
+func (header ShardHeader) Hash() common.Hash {
+	blkMetaHash := header.MetaHash()
+	blkInstHash := header.InstructionMerkleRoot
+	combined := append(blkMetaHash[:], blkInstHash[:]...)
+	return common.Keccak256(combined)
+}
+
+func (header ShardHeader) GetEpoch() uint64 {
+	return header.Epoch
+}
+
+func (header ShardHeader) GetChainID() int {
+	return int(header.ShardID)
+}
+
+func (header ShardHeader) isBlockHeader() {}
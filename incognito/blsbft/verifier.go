@@ -0,0 +1,134 @@
+package blsbft
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+	"github.com/0xkraken/incognito-wasm/incognito/signatureschemes/blsmultisig"
+	"github.com/pkg/errors"
+)
+
+// BlockHeader is a beacon or shard block header as the light verifier needs it,
+// the committee of the chain signs Hash() with BLS.
+// Only BeaconHeader and ShardHeader implement it, so the hash is always computed from the header fields
+type BlockHeader interface {
+	Hash() common.Hash
+	GetEpoch() uint64
+	// GetChainID returns the shard ID, or incognitokey.BeaconChainID for beacon blocks
+	GetChainID() int
+	isBlockHeader()
+}
+
+// ValidationData is the validation data of a block, as the chain serializes it
+type ValidationData struct {
+	ProducerBLSSig []byte
+	ProducerBriSig []byte
+	ValidatiorsIdx []int
+	AggSig         []byte
+	BridgeSig      [][]byte
+}
+
+// DecodeValidationData parses the validation data string of a block
+func DecodeValidationData(data string) (*ValidationData, error) {
+	var valData ValidationData
+	err := json.Unmarshal([]byte(data), &valData)
+	if err != nil {
+		return nil, NewBLSBFTError(InvalidValidationDataErr, err)
+	}
+	return &valData, nil
+}
+
+// CommitteeBLSKeys returns the BLS mining keys of a committee
+func CommitteeBLSKeys(committee []incognitokey.CommitteePublicKey) ([]blsmultisig.PublicKey, error) {
+	res := make([]blsmultisig.PublicKey, len(committee))
+	for i, member := range committee {
+		miningKeyBytes, err := member.GetMiningKey(common.BlsConsensus)
+		if err != nil {
+			return nil, NewBLSBFTError(InvalidCommitteeErr, err)
+		}
+		miningKey := map[string][]byte{}
+		err = json.Unmarshal(miningKeyBytes, &miningKey)
+		if err != nil {
+			return nil, NewBLSBFTError(InvalidCommitteeErr, err)
+		}
+		if len(miningKey[common.BlsConsensus]) != common.BLSPublicKeySize {
+			return nil, NewBLSBFTError(InvalidCommitteeErr, fmt.Errorf("BLS key of committee member %v is invalid", i))
+		}
+		res[i] = miningKey[common.BlsConsensus]
+	}
+	return res, nil
+}
+
+// chainCommittee returns the committee of the chain in a ChainCommittee snapshot
+func chainCommittee(chainID int, cc *incognitokey.ChainCommittee) ([]incognitokey.CommitteePublicKey, error) {
+	if cc == nil {
+		return nil, NewBLSBFTError(InvalidCommitteeErr, errors.New("chain committee is nil"))
+	}
+	var committee []incognitokey.CommitteePublicKey
+	if chainID == incognitokey.BeaconChainID {
+		committee = cc.BeaconCommittee
+	} else if chainID >= 0 && chainID < 256 {
+		committee = cc.AllShardCommittee[byte(chainID)]
+	}
+	if len(committee) == 0 {
+		return nil, NewBLSBFTError(InvalidCommitteeErr, fmt.Errorf("no committee for chain %v", chainID))
+	}
+	return committee, nil
+}
+
+// MinSigners returns the number of signatures a block needs from a committee, 2/3 of it plus 1
+func MinSigners(committeeSize int) int {
+	return committeeSize*2/3 + 1
+}
+
+// VerifyAggregatedSig checks that more than 2/3 of the committee signed blockHash,
+// validatorsIdx are the increasing indices of the signers in the committee
+func VerifyAggregatedSig(blockHash common.Hash, aggSig []byte, validatorsIdx []int, committee []incognitokey.CommitteePublicKey) error {
+	if len(committee) == 0 {
+		return NewBLSBFTError(InvalidCommitteeErr, errors.New("committee is empty"))
+	}
+	for i, idx := range validatorsIdx {
+		if idx < 0 || idx >= len(committee) || (i > 0 && idx <= validatorsIdx[i-1]) {
+			return NewBLSBFTError(InvalidValidatorsIdxErr, fmt.Errorf("validators index list %v is invalid", validatorsIdx))
+		}
+	}
+	if len(validatorsIdx) < MinSigners(len(committee)) {
+		return NewBLSBFTError(NotEnoughSignatureErr, fmt.Errorf("%v of %v validators signed, need %v", len(validatorsIdx), len(committee), MinSigners(len(committee))))
+	}
+
+	blsKeys, err := CommitteeBLSKeys(committee)
+	if err != nil {
+		return err
+	}
+	valid, err := blsmultisig.Verify(aggSig, blockHash.GetBytes(), validatorsIdx, blsKeys)
+	if err != nil {
+		return NewBLSBFTError(InvalidSignatureErr, err)
+	}
+	if !valid {
+		return NewBLSBFTError(InvalidSignatureErr, errors.New("aggregated signature does not match the committee"))
+	}
+	return nil
+}
+
+// VerifyHeader checks the aggregated signature of a header with the committee of its epoch
+func VerifyHeader(header BlockHeader, aggSig []byte, validatorsIdx []int, cc *incognitokey.ChainCommittee) error {
+	committee, err := chainCommittee(header.GetChainID(), cc)
+	if err != nil {
+		return err
+	}
+	if header.GetEpoch() != cc.Epoch {
+		return NewBLSBFTError(InvalidEpochErr, fmt.Errorf("block epoch %v, committee epoch %v", header.GetEpoch(), cc.Epoch))
+	}
+	return VerifyAggregatedSig(header.Hash(), aggSig, validatorsIdx, committee)
+}
+
+// VerifyHeaderValidationData is VerifyHeader with the validation data string of the block
+func VerifyHeaderValidationData(header BlockHeader, validationData string, cc *incognitokey.ChainCommittee) error {
+	valData, err := DecodeValidationData(validationData)
+	if err != nil {
+		return err
+	}
+	return VerifyHeader(header, valData.AggSig, valData.ValidatiorsIdx, cc)
+}
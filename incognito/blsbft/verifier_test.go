@@ -0,0 +1,188 @@
+package blsbft
+
+import (
+	"encoding/json"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+	"github.com/0xkraken/incognito-wasm/incognito/signatureschemes/blsmultisig"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// testCommittee is a committee with the BLS secret keys of its members
+type testCommittee struct {
+	keys      []incognitokey.CommitteePublicKey
+	blsSKs    [][]byte
+	blsPKList []blsmultisig.PublicKey
+}
+
+func newTestCommittee(t *testing.T, chainID int, size int) *testCommittee {
+	committee := &testCommittee{}
+	for i := 0; i < size; i++ {
+		seed := common.HashB([]byte{byte(chainID + 1), byte(i)})
+		keySet := new(incognitokey.KeySet).GenerateKey(seed)
+		key, err := incognitokey.NewCommitteeKeyFromSeed(seed, keySet.PaymentAddress.Pk)
+		assert.Equal(t, nil, err)
+		blsSK, _ := blsmultisig.KeyGen(seed)
+		committee.keys = append(committee.keys, key)
+		committee.blsSKs = append(committee.blsSKs, blsmultisig.SKBytes(blsSK))
+		committee.blsPKList = append(committee.blsPKList, key.MiningPubKey[common.BlsConsensus])
+	}
+	return committee
+}
+
+// sign returns the aggregated signature of the signers on blockHash
+func (committee *testCommittee) sign(t *testing.T, blockHash common.Hash, signers []int) []byte {
+	sigs := [][]byte{}
+	for _, idx := range signers {
+		sig, err := blsmultisig.Sign(blockHash.GetBytes(), committee.blsSKs[idx], idx, committee.blsPKList)
+		assert.Equal(t, nil, err)
+		sigs = append(sigs, sig)
+	}
+	aggSig, err := blsmultisig.Combine(sigs)
+	assert.Equal(t, nil, err)
+	return aggSig
+}
+
+func TestVerifyHeader(t *testing.T) {
+	beacon := newTestCommittee(t, incognitokey.BeaconChainID, 4)
+	shard := newTestCommittee(t, 0, 6)
+	cc := &incognitokey.ChainCommittee{
+		Epoch:             10,
+		BeaconCommittee:   beacon.keys,
+		AllShardCommittee: map[byte][]incognitokey.CommitteePublicKey{0: shard.keys},
+	}
+
+	prvID := common.Hash{4}
+	beaconHeader := BeaconHeader{
+		Version:               1,
+		Height:                1000,
+		Epoch:                 10,
+		Timestamp:             1590000000,
+		PreviousBlockHash:     common.HashH([]byte("previous beacon block")),
+		InstructionHash:       common.HashH([]byte("beacon instructions")),
+		InstructionMerkleRoot: common.Keccak256([]byte("beacon instructions")),
+		ConsensusType:         common.BlsConsensus,
+		Producer:              "beacon producer",
+	}
+	shardHeader := ShardHeader{
+		Producer:              "shard producer",
+		ShardID:               0,
+		Version:               2,
+		PreviousBlockHash:     common.HashH([]byte("previous shard block")),
+		Height:                5000,
+		Epoch:                 10,
+		CrossShardBitMap:      []byte{1, 3},
+		BeaconHeight:          1000,
+		BeaconHash:            beaconHeader.Hash(),
+		TotalTxsFee:           map[common.Hash]uint64{prvID: 100, common.HashH([]byte("token")): 5},
+		ConsensusType:         common.BlsConsensus,
+		Timestamp:             1590000040,
+		TxRoot:                common.HashH([]byte("txs")),
+		InstructionMerkleRoot: common.Keccak256([]byte("shard instructions")),
+		Proposer:              "shard proposer",
+		ProposeTime:           1590000030,
+	}
+
+	// 3 of 4 and 5 of 6 are enough
+	beaconSigners := []int{0, 1, 3}
+	beaconSig := beacon.sign(t, beaconHeader.Hash(), beaconSigners)
+	assert.Equal(t, nil, VerifyHeader(beaconHeader, beaconSig, beaconSigners, cc))
+
+	shardSigners := []int{0, 1, 2, 4, 5}
+	shardSig := shard.sign(t, shardHeader.Hash(), shardSigners)
+	assert.Equal(t, nil, VerifyHeader(shardHeader, shardSig, shardSigners, cc))
+
+	// through the validation data of the block
+	valData, err := json.Marshal(ValidationData{AggSig: shardSig, ValidatiorsIdx: shardSigners})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, VerifyHeaderValidationData(shardHeader, string(valData), cc))
+	assert.NotEqual(t, nil, VerifyHeaderValidationData(shardHeader, "invalid", cc))
+
+	// a header decoded from its JSON has the same hash
+	headerBytes, err := json.Marshal(shardHeader)
+	assert.Equal(t, nil, err)
+	var decodedShardHeader ShardHeader
+	assert.Equal(t, nil, json.Unmarshal(headerBytes, &decodedShardHeader))
+	assert.Equal(t, shardHeader, decodedShardHeader)
+	assert.Equal(t, nil, VerifyHeader(decodedShardHeader, shardSig, shardSigners, cc))
+	headerBytes, err = json.Marshal(beaconHeader)
+	assert.Equal(t, nil, err)
+	var decodedBeaconHeader BeaconHeader
+	assert.Equal(t, nil, json.Unmarshal(headerBytes, &decodedBeaconHeader))
+	assert.Equal(t, nil, VerifyHeader(decodedBeaconHeader, beaconSig, beaconSigners, cc))
+
+	// the committee of another chain
+	otherChain := shardHeader
+	otherChain.ShardID = 1
+	assert.NotEqual(t, nil, VerifyHeader(otherChain, shardSig, shardSigners, cc))
+
+	// the committee of another epoch
+	otherEpoch := shardHeader
+	otherEpoch.Epoch = 11
+	assert.NotEqual(t, nil, VerifyHeader(otherEpoch, shardSig, shardSigners, cc))
+
+	// any changed field changes the signed hash
+	otherBlock := shardHeader
+	otherBlock.Height++
+	assert.NotEqual(t, nil, VerifyHeader(otherBlock, shardSig, shardSigners, cc))
+	otherBlock = shardHeader
+	otherBlock.InstructionMerkleRoot = common.Keccak256([]byte("other shard instructions"))
+	assert.NotEqual(t, nil, VerifyHeader(otherBlock, shardSig, shardSigners, cc))
+	otherBlock = shardHeader
+	otherBlock.TotalTxsFee = map[common.Hash]uint64{prvID: 101, common.HashH([]byte("token")): 5}
+	assert.NotEqual(t, nil, VerifyHeader(otherBlock, shardSig, shardSigners, cc))
+	otherBlock = shardHeader
+	otherBlock.ProposeTime++
+	assert.NotEqual(t, nil, VerifyHeader(otherBlock, shardSig, shardSigners, cc))
+	otherBeaconBlock := beaconHeader
+	otherBeaconBlock.ShardStateHash = common.HashH([]byte("shard states"))
+	assert.NotEqual(t, nil, VerifyHeader(otherBeaconBlock, beaconSig, beaconSigners, cc))
+	otherBeaconBlock = beaconHeader
+	otherBeaconBlock.InstructionMerkleRoot = common.Hash{}
+	assert.NotEqual(t, nil, VerifyHeader(otherBeaconBlock, beaconSig, beaconSigners, cc))
+	// the version 2 fields only count for version 2 beacon blocks
+	otherBeaconBlock = beaconHeader
+	otherBeaconBlock.Proposer = "beacon proposer"
+	assert.Equal(t, beaconHeader.Hash(), otherBeaconBlock.Hash())
+	otherBeaconBlock.Version = 2
+	assert.NotEqual(t, beaconHeader.Hash(), otherBeaconBlock.Hash())
+
+	// a shard signature is not a beacon signature
+	assert.NotEqual(t, nil, VerifyHeader(beaconHeader, shardSig, shardSigners, cc))
+}
+
+func TestVerifyAggregatedSigThreshold(t *testing.T) {
+	committee := newTestCommittee(t, 0, 7)
+	blockHash := common.HashH([]byte("block"))
+	assert.Equal(t, 5, MinSigners(7))
+	assert.Equal(t, 3, MinSigners(4))
+
+	// 4 of 7 is not enough even with a valid signature
+	signers := []int{0, 2, 4, 6}
+	aggSig := committee.sign(t, blockHash, signers)
+	err := VerifyAggregatedSig(blockHash, aggSig, signers, committee.keys)
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, ErrCodeMessage[NotEnoughSignatureErr].Code, err.(*BLSBFTError).Code)
+
+	signers = []int{0, 1, 2, 4, 6}
+	aggSig = committee.sign(t, blockHash, signers)
+	assert.Equal(t, nil, VerifyAggregatedSig(blockHash, aggSig, signers, committee.keys))
+
+	// the index list must match the signers
+	err = VerifyAggregatedSig(blockHash, aggSig, []int{0, 1, 2, 4, 5}, committee.keys)
+	assert.Equal(t, ErrCodeMessage[InvalidSignatureErr].Code, err.(*BLSBFTError).Code)
+	// and be increasing in the committee
+	err = VerifyAggregatedSig(blockHash, aggSig, []int{0, 1, 2, 6, 4}, committee.keys)
+	assert.Equal(t, ErrCodeMessage[InvalidValidatorsIdxErr].Code, err.(*BLSBFTError).Code)
+	err = VerifyAggregatedSig(blockHash, aggSig, []int{0, 1, 2, 4, 4, 6}, committee.keys)
+	assert.Equal(t, ErrCodeMessage[InvalidValidatorsIdxErr].Code, err.(*BLSBFTError).Code)
+	err = VerifyAggregatedSig(blockHash, aggSig, []int{0, 1, 2, 4, 7}, committee.keys)
+	assert.Equal(t, ErrCodeMessage[InvalidValidatorsIdxErr].Code, err.(*BLSBFTError).Code)
+
+	// a committee member without BLS key
+	keys := append([]incognitokey.CommitteePublicKey{}, committee.keys...)
+	keys[3] = incognitokey.CommitteePublicKey{IncPubKey: keys[3].IncPubKey, MiningPubKey: map[string][]byte{}}
+	err = VerifyAggregatedSig(blockHash, aggSig, signers, keys)
+	assert.Equal(t, ErrCodeMessage[InvalidCommitteeErr].Code, err.(*BLSBFTError).Code)
+}
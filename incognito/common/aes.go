@@ -11,6 +11,8 @@ import (
 var PlainTextIsEmptyErr = errors.New("plaintext is empty")
 var CipherTextIsEmptyErr = errors.New("ciphertext is empty")
 var InvalidAESKeyErr = errors.New("aes key is invalid")
+var CipherTextIsInvalidErr = errors.New("ciphertext is invalid")
+var CipherTextAuthenticationErr = errors.New("ciphertext authentication failed")
 
 type AES struct {
 	Key []byte
@@ -42,6 +44,9 @@ func (aesObj *AES) Decrypt(ciphertext []byte) ([]byte, error) {
 	if len(ciphertext) == 0 {
 		return []byte{}, CipherTextIsEmptyErr
 	}
+	if len(ciphertext) < aes.BlockSize {
+		return nil, CipherTextIsInvalidErr
+	}
 
 	plaintext := make([]byte, len(ciphertext[aes.BlockSize:]))
 
@@ -56,3 +61,58 @@ func (aesObj *AES) Decrypt(ciphertext []byte) ([]byte, error) {
 
 	return plaintext, nil
 }
+
+// AESGCM is the authenticated counterpart of AES,
+// the ciphertext is nonce || sealed plaintext and decryption fails if it has been modified
+type AESGCM struct {
+	Key []byte
+}
+
+// Encrypt seals plaintext with a random nonce, additionalData is authenticated but not encrypted
+func (aesObj *AESGCM) Encrypt(plaintext []byte, additionalData []byte) ([]byte, error) {
+	if len(plaintext) == 0 {
+		return []byte{}, PlainTextIsEmptyErr
+	}
+
+	aead, err := aesObj.newAEAD()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Decrypt opens a ciphertext returned by Encrypt with the same additionalData
+func (aesObj *AESGCM) Decrypt(ciphertext []byte, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) == 0 {
+		return []byte{}, CipherTextIsEmptyErr
+	}
+
+	aead, err := aesObj.newAEAD()
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize()+aead.Overhead() {
+		return nil, CipherTextIsInvalidErr
+	}
+
+	nonce := ciphertext[:aead.NonceSize()]
+	plaintext, err := aead.Open(nil, nonce, ciphertext[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, CipherTextAuthenticationErr
+	}
+	return plaintext, nil
+}
+
+func (aesObj *AESGCM) newAEAD() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(aesObj.Key)
+	if err != nil {
+		return nil, InvalidAESKeyErr
+	}
+	return cipher.NewGCM(block)
+}
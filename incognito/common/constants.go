@@ -69,6 +69,7 @@ const (
 	ShardStakingMeta    = 63
 	StopAutoStakingMeta = 127
 	BeaconStakingMeta   = 64
+	UnStakingMeta       = 210
 )
 
 const (
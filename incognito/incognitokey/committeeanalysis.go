@@ -0,0 +1,220 @@
+package incognitokey
+
+import (
+	"bytes"
+	"sort"
+)
+
+const (
+	// BeaconChainID is the chain ID of the beacon in committee positions and changes
+	BeaconChainID = -1
+
+	CommitteeRole = "committee"
+	PendingRole   = "pending"
+)
+
+// CommitteePosition is where a key is in a ChainCommittee,
+// Index is the position in the committee or in the pending queue of the chain
+type CommitteePosition struct {
+	ChainID int
+	Role    string
+	Index   int
+}
+
+// IsBeacon checks whether the position is in the beacon chain
+func (position CommitteePosition) IsBeacon() bool {
+	return position.ChainID == BeaconChainID
+}
+
+// CommitteeChange is the change of the committee and the pending queue of a chain between two epochs
+type CommitteeChange struct {
+	ChainID int
+	// CommitteeIn joined the committee, CommitteeOut left it
+	CommitteeIn  []CommitteePublicKey
+	CommitteeOut []CommitteePublicKey
+	// SwappedIn joined the committee from the pending queue of the chain, they are also in CommitteeIn
+	SwappedIn []CommitteePublicKey
+	// PendingIn joined the pending queue, PendingOut left it without joining the committee
+	PendingIn  []CommitteePublicKey
+	PendingOut []CommitteePublicKey
+}
+
+// IsEmpty checks whether nothing changed in the chain
+func (change CommitteeChange) IsEmpty() bool {
+	return len(change.CommitteeIn) == 0 && len(change.CommitteeOut) == 0 &&
+		len(change.PendingIn) == 0 && len(change.PendingOut) == 0
+}
+
+// committeeKeyID identifies a committee public key by its incognito public key and mining keys
+func committeeKeyID(key CommitteePublicKey) string {
+	schemes := make([]string, 0, len(key.MiningPubKey))
+	for scheme := range key.MiningPubKey {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+
+	id := append([]byte{}, key.IncPubKey...)
+	for _, scheme := range schemes {
+		id = append(id, scheme...)
+		id = append(id, key.MiningPubKey[scheme]...)
+	}
+	return string(id)
+}
+
+func committeeKeySet(keys []CommitteePublicKey) map[string]bool {
+	res := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		res[committeeKeyID(key)] = true
+	}
+	return res
+}
+
+// keysNotIn returns the keys which are not in excluded, in order
+func keysNotIn(keys []CommitteePublicKey, excluded ...map[string]bool) []CommitteePublicKey {
+	res := []CommitteePublicKey{}
+	for _, key := range keys {
+		id := committeeKeyID(key)
+		found := false
+		for _, set := range excluded {
+			if set[id] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
+// chainIDs returns the beacon and the sorted shard IDs of the snapshots
+func chainIDs(committees ...*ChainCommittee) []int {
+	seen := map[int]bool{}
+	for _, cc := range committees {
+		if cc == nil {
+			continue
+		}
+		for shardID := range cc.AllShardCommittee {
+			seen[int(shardID)] = true
+		}
+		for shardID := range cc.AllShardPending {
+			seen[int(shardID)] = true
+		}
+	}
+	res := []int{BeaconChainID}
+	for chainID := range seen {
+		res = append(res, chainID)
+	}
+	sort.Ints(res)
+	return res
+}
+
+func (cc *ChainCommittee) committee(chainID int) []CommitteePublicKey {
+	if cc == nil {
+		return nil
+	}
+	if chainID == BeaconChainID {
+		return cc.BeaconCommittee
+	}
+	return cc.AllShardCommittee[byte(chainID)]
+}
+
+func (cc *ChainCommittee) pending(chainID int) []CommitteePublicKey {
+	if cc == nil || chainID == BeaconChainID {
+		return nil
+	}
+	return cc.AllShardPending[byte(chainID)]
+}
+
+// CompareChainCommittees returns the changes from the snapshot prev to the snapshot cur
+// of the chains which changed, the beacon first then the shards by ID
+func CompareChainCommittees(prev, cur *ChainCommittee) []CommitteeChange {
+	res := []CommitteeChange{}
+	for _, chainID := range chainIDs(prev, cur) {
+		prevCommittee := committeeKeySet(prev.committee(chainID))
+		prevPending := committeeKeySet(prev.pending(chainID))
+		curCommittee := committeeKeySet(cur.committee(chainID))
+		curPending := committeeKeySet(cur.pending(chainID))
+
+		change := CommitteeChange{
+			ChainID:      chainID,
+			CommitteeIn:  keysNotIn(cur.committee(chainID), prevCommittee),
+			CommitteeOut: keysNotIn(prev.committee(chainID), curCommittee),
+			PendingIn:    keysNotIn(cur.pending(chainID), prevPending),
+			PendingOut:   keysNotIn(prev.pending(chainID), curPending, curCommittee),
+		}
+		change.SwappedIn = []CommitteePublicKey{}
+		for _, key := range change.CommitteeIn {
+			if prevPending[committeeKeyID(key)] {
+				change.SwappedIn = append(change.SwappedIn, key)
+			}
+		}
+		if !change.IsEmpty() {
+			res = append(res, change)
+		}
+	}
+	return res
+}
+
+// findKey returns the position of the first key matching in the committees then in the pending queues
+func (cc *ChainCommittee) findKey(match func(key CommitteePublicKey) bool) (CommitteePosition, bool) {
+	chains := chainIDs(cc)
+	for _, role := range []string{CommitteeRole, PendingRole} {
+		for _, chainID := range chains {
+			keys := cc.committee(chainID)
+			if role == PendingRole {
+				keys = cc.pending(chainID)
+			}
+			for i, key := range keys {
+				if match(key) {
+					return CommitteePosition{ChainID: chainID, Role: role, Index: i}, true
+				}
+			}
+		}
+	}
+	return CommitteePosition{}, false
+}
+
+// FindCommitteeKey returns where key is in the snapshot, false when it is neither in a committee nor pending
+func (cc *ChainCommittee) FindCommitteeKey(key CommitteePublicKey) (CommitteePosition, bool) {
+	id := committeeKeyID(key)
+	return cc.findKey(func(k CommitteePublicKey) bool {
+		return committeeKeyID(k) == id
+	})
+}
+
+// FindMiningKey returns where the key with miningKey of schemeName is in the snapshot
+func (cc *ChainCommittee) FindMiningKey(schemeName string, miningKey []byte) (CommitteePosition, bool) {
+	if len(miningKey) == 0 {
+		return CommitteePosition{}, false
+	}
+	return cc.findKey(func(k CommitteePublicKey) bool {
+		return bytes.Equal(k.MiningPubKey[schemeName], miningKey)
+	})
+}
+
+// FindMiningKeyBase58 is FindMiningKey with the mining key as GetMiningKeyBase58 returns it
+func (cc *ChainCommittee) FindMiningKeyBase58(schemeName string, miningKey string) (CommitteePosition, bool) {
+	if miningKey == "" {
+		return CommitteePosition{}, false
+	}
+	return cc.findKey(func(k CommitteePublicKey) bool {
+		return k.GetMiningKeyBase58(schemeName) == miningKey
+	})
+}
+
+// PendingPositions returns the positions of the keys in the pending queues, by base58 committee public key
+func (cc *ChainCommittee) PendingPositions() (map[string]CommitteePosition, error) {
+	res := map[string]CommitteePosition{}
+	for _, chainID := range chainIDs(cc) {
+		for i, key := range cc.pending(chainID) {
+			keyStr, err := key.ToBase58()
+			if err != nil {
+				return nil, err
+			}
+			res[keyStr] = CommitteePosition{ChainID: chainID, Role: PendingRole, Index: i}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,122 @@
+package incognitokey
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestCommitteeKeys(n int) []CommitteePublicKey {
+	keys := make([]CommitteePublicKey, n)
+	for i := range keys {
+		keySet := new(KeySet).GenerateKey([]byte{byte(i), 'c', 'k'})
+		keys[i], _ = NewCommitteeKeyFromSeed(common.HashB(keySet.PrivateKey), keySet.PaymentAddress.Pk)
+	}
+	return keys
+}
+
+func TestCompareChainCommittees(t *testing.T) {
+	k := newTestCommitteeKeys(10)
+	prev := &ChainCommittee{
+		Epoch:           1,
+		BeaconCommittee: []CommitteePublicKey{k[0], k[1]},
+		AllShardCommittee: map[byte][]CommitteePublicKey{
+			0: {k[2], k[3]},
+			1: {k[4], k[5]},
+		},
+		AllShardPending: map[byte][]CommitteePublicKey{
+			0: {k[6], k[7]},
+		},
+	}
+	cur := &ChainCommittee{
+		Epoch:           2,
+		BeaconCommittee: []CommitteePublicKey{k[0], k[1]},
+		AllShardCommittee: map[byte][]CommitteePublicKey{
+			0: {k[3], k[6]},
+			1: {k[4], k[5]},
+		},
+		AllShardPending: map[byte][]CommitteePublicKey{
+			0: {k[8]},
+		},
+	}
+
+	changes := CompareChainCommittees(prev, cur)
+	assert.Equal(t, 1, len(changes))
+	change := changes[0]
+	assert.Equal(t, 0, change.ChainID)
+	assert.Equal(t, []CommitteePublicKey{k[6]}, change.CommitteeIn)
+	assert.Equal(t, []CommitteePublicKey{k[2]}, change.CommitteeOut)
+	assert.Equal(t, []CommitteePublicKey{k[6]}, change.SwappedIn)
+	assert.Equal(t, []CommitteePublicKey{k[8]}, change.PendingIn)
+	// k[6] left the pending queue to join the committee, k[7] just left
+	assert.Equal(t, []CommitteePublicKey{k[7]}, change.PendingOut)
+
+	assert.Equal(t, 0, len(CompareChainCommittees(cur, cur)))
+
+	// a new shard and a beacon change
+	cur.AllShardCommittee[2] = []CommitteePublicKey{k[9]}
+	cur.BeaconCommittee = []CommitteePublicKey{k[0]}
+	changes = CompareChainCommittees(prev, cur)
+	assert.Equal(t, 3, len(changes))
+	assert.Equal(t, BeaconChainID, changes[0].ChainID)
+	assert.Equal(t, []CommitteePublicKey{k[1]}, changes[0].CommitteeOut)
+	assert.Equal(t, 0, changes[1].ChainID)
+	assert.Equal(t, 2, changes[2].ChainID)
+	assert.Equal(t, []CommitteePublicKey{k[9]}, changes[2].CommitteeIn)
+	assert.Equal(t, 0, len(changes[2].SwappedIn))
+
+	// from nothing
+	changes = CompareChainCommittees(nil, prev)
+	assert.Equal(t, 3, len(changes))
+}
+
+func TestFindCommitteeKey(t *testing.T) {
+	k := newTestCommitteeKeys(8)
+	cc := &ChainCommittee{
+		BeaconCommittee: []CommitteePublicKey{k[0]},
+		AllShardCommittee: map[byte][]CommitteePublicKey{
+			0: {k[1], k[2]},
+			1: {k[3]},
+		},
+		AllShardPending: map[byte][]CommitteePublicKey{
+			1: {k[4], k[5], k[6]},
+		},
+	}
+
+	// the snapshot survives serialization
+	data, err := cc.ToByte()
+	assert.Equal(t, nil, err)
+	cc, err = ChainCommitteeFromByte(data)
+	assert.Equal(t, nil, err)
+
+	position, ok := cc.FindCommitteeKey(k[0])
+	assert.Equal(t, true, ok)
+	assert.Equal(t, true, position.IsBeacon())
+	assert.Equal(t, CommitteeRole, position.Role)
+
+	position, ok = cc.FindCommitteeKey(k[2])
+	assert.Equal(t, true, ok)
+	assert.Equal(t, CommitteePosition{ChainID: 0, Role: CommitteeRole, Index: 1}, position)
+
+	position, ok = cc.FindMiningKey(common.BlsConsensus, k[5].MiningPubKey[common.BlsConsensus])
+	assert.Equal(t, true, ok)
+	assert.Equal(t, CommitteePosition{ChainID: 1, Role: PendingRole, Index: 1}, position)
+
+	position, ok = cc.FindMiningKeyBase58(common.BlsConsensus, k[3].GetMiningKeyBase58(common.BlsConsensus))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, CommitteePosition{ChainID: 1, Role: CommitteeRole, Index: 0}, position)
+
+	_, ok = cc.FindCommitteeKey(k[7])
+	assert.Equal(t, false, ok)
+	_, ok = cc.FindMiningKey(common.BlsConsensus, nil)
+	assert.Equal(t, false, ok)
+
+	positions, err := cc.PendingPositions()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(positions))
+	for i, key := range []CommitteePublicKey{k[4], k[5], k[6]} {
+		keyStr, err := key.ToBase58()
+		assert.Equal(t, nil, err)
+		assert.Equal(t, CommitteePosition{ChainID: 1, Role: PendingRole, Index: i}, positions[keyStr])
+	}
+}
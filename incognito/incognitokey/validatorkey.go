@@ -0,0 +1,86 @@
+package incognitokey
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/base58"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/privacy"
+	"github.com/0xkraken/incognito-wasm/incognito/signatureschemes/blsmultisig"
+	"github.com/0xkraken/incognito-wasm/incognito/signatureschemes/bridgesig"
+	"github.com/pkg/errors"
+)
+
+// ValidatorKey is the mining seed of a validator and the consensus keys derived from it.
+// The validator key string given to the node is the base58 check encoding of the mining seed
+type ValidatorKey struct {
+	MiningSeed       []byte
+	IncPubKey        privacy.PublicKey
+	BLSPrivateKey    []byte
+	BLSPublicKey     []byte
+	BridgePrivateKey []byte
+	BridgePublicKey  []byte
+}
+
+// MiningSeedFromPrivateKey derives the mining seed of the validator owned by privateKey
+func MiningSeedFromPrivateKey(privateKey privacy.PrivateKey) []byte {
+	return common.HashB(common.HashB(privateKey))
+}
+
+// NewValidatorKey builds the consensus keys of miningSeed for the candidate incPubKey
+func NewValidatorKey(miningSeed []byte, incPubKey []byte) (*ValidatorKey, error) {
+	if len(miningSeed) != common.HashSize {
+		return nil, NewCashecError(InvalidPrivateKeyErr, errors.New("mining seed must be 32 bytes"))
+	}
+	if len(incPubKey) != common.PublicKeySize {
+		return nil, NewCashecError(InvalidVerificationKeyErr, errors.New("incognito public key is invalid"))
+	}
+
+	blsSK, blsPK := blsmultisig.KeyGen(miningSeed)
+	briSK, briPK := bridgesig.KeyGen(miningSeed)
+	return &ValidatorKey{
+		MiningSeed:       append([]byte{}, miningSeed...),
+		IncPubKey:        append([]byte{}, incPubKey...),
+		BLSPrivateKey:    blsmultisig.SKBytes(blsSK),
+		BLSPublicKey:     blsmultisig.PKBytes(blsPK),
+		BridgePrivateKey: bridgesig.SKBytes(&briSK),
+		BridgePublicKey:  bridgesig.PKBytes(&briPK),
+	}, nil
+}
+
+// NewValidatorKeyFromPrivateKey builds the validator key owned by privateKey, the candidate is its public key
+func NewValidatorKeyFromPrivateKey(privateKey privacy.PrivateKey) (*ValidatorKey, error) {
+	if len(privateKey) != common.PrivateKeySize {
+		return nil, NewCashecError(InvalidPrivateKeyErr, errors.New(ErrCodeMessage[InvalidPrivateKeyErr].Message))
+	}
+	return NewValidatorKey(MiningSeedFromPrivateKey(privateKey), privacy.GeneratePublicKey(privateKey))
+}
+
+// NewValidatorKeyFromBase58 builds the validator key of a validator key string for the candidate incPubKey
+func NewValidatorKeyFromBase58(validatorKey string, incPubKey []byte) (*ValidatorKey, error) {
+	miningSeed, ver, err := base58.Base58Check{}.Decode(validatorKey)
+	if (ver != common.ZeroByte) || (err != nil) {
+		return nil, NewCashecError(DecodeFromStringErr, errors.New("validator key is invalid"))
+	}
+	return NewValidatorKey(miningSeed, incPubKey)
+}
+
+// ToBase58 returns the validator key string
+func (validatorKey *ValidatorKey) ToBase58() string {
+	return base58.Base58Check{}.Encode(validatorKey.MiningSeed, common.ZeroByte)
+}
+
+// CommitteePublicKey returns the committee public key to stake with
+func (validatorKey *ValidatorKey) CommitteePublicKey() (CommitteePublicKey, error) {
+	return NewCommitteeKeyFromSeed(validatorKey.MiningSeed, validatorKey.IncPubKey)
+}
+
+// CommitteePublicKeyBase58 returns the committee public key as the CommitteePublicKey param of staking metadata
+func (validatorKey *ValidatorKey) CommitteePublicKeyBase58() (string, error) {
+	committeePubKey, err := validatorKey.CommitteePublicKey()
+	if err != nil {
+		return "", err
+	}
+	if !committeePubKey.CheckSanityData() {
+		return "", NewCashecError(InvalidVerificationKeyErr, errors.New("committee public key is invalid"))
+	}
+	return committeePubKey.ToBase58()
+}
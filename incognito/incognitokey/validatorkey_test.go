@@ -0,0 +1,60 @@
+package incognitokey
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/signatureschemes/blsmultisig"
+	"github.com/0xkraken/incognito-wasm/incognito/signatureschemes/bridgesig"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidatorKey(t *testing.T) {
+	keySet := new(KeySet).GenerateKey([]byte("validator key"))
+
+	validatorKey, err := NewValidatorKeyFromPrivateKey(keySet.PrivateKey)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, common.HashB(common.HashB(keySet.PrivateKey)), validatorKey.MiningSeed)
+	assert.Equal(t, []byte(keySet.PaymentAddress.Pk), []byte(validatorKey.IncPubKey))
+
+	// the committee public key is the one built from the mining seed
+	committeePubKey, err := validatorKey.CommitteePublicKey()
+	assert.Equal(t, nil, err)
+	expected, err := NewCommitteeKeyFromSeed(validatorKey.MiningSeed, keySet.PaymentAddress.Pk)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, committeePubKey.IsEqual(expected))
+	assert.Equal(t, validatorKey.BLSPublicKey, committeePubKey.MiningPubKey[common.BlsConsensus])
+	assert.Equal(t, validatorKey.BridgePublicKey, committeePubKey.MiningPubKey[common.BridgeConsensus])
+
+	committeePubKeyStr, err := validatorKey.CommitteePublicKeyBase58()
+	assert.Equal(t, nil, err)
+	committeePubKey2 := new(CommitteePublicKey)
+	assert.Equal(t, nil, committeePubKey2.FromString(committeePubKeyStr))
+	assert.Equal(t, true, committeePubKey2.IsEqual(expected))
+
+	// the validator key string round-trips
+	validatorKey2, err := NewValidatorKeyFromBase58(validatorKey.ToBase58(), keySet.PaymentAddress.Pk)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, validatorKey, validatorKey2)
+
+	// the private keys sign for the public keys
+	data := common.HashB([]byte("vote"))
+	blsSig, err := blsmultisig.Sign(data, validatorKey.BLSPrivateKey, 0, []blsmultisig.PublicKey{validatorKey.BLSPublicKey})
+	assert.Equal(t, nil, err)
+	valid, err := blsmultisig.Verify(blsSig, data, []int{0}, []blsmultisig.PublicKey{validatorKey.BLSPublicKey})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, valid)
+	briSig, err := bridgesig.Sign(validatorKey.BridgePrivateKey, data)
+	assert.Equal(t, nil, err)
+	valid, err = bridgesig.Verify(validatorKey.BridgePublicKey, data, briSig)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, valid)
+
+	_, err = NewValidatorKeyFromBase58("invalid", keySet.PaymentAddress.Pk)
+	assert.NotEqual(t, nil, err)
+	_, err = NewValidatorKey(validatorKey.MiningSeed[:31], keySet.PaymentAddress.Pk)
+	assert.NotEqual(t, nil, err)
+	_, err = NewValidatorKey(validatorKey.MiningSeed, keySet.PaymentAddress.Pk[:31])
+	assert.NotEqual(t, nil, err)
+	_, err = NewValidatorKeyFromPrivateKey(nil)
+	assert.NotEqual(t, nil, err)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/0xkraken/incognito-wasm/incognito/privacy"
 	"github.com/0xkraken/incognito-wasm/incognito/common"
 	"strconv"
+	"github.com/pkg/errors"
 )
 
 // whoever can send this type of tx
@@ -54,3 +55,24 @@ func (bReq BurningRequest) Hash() *common.Hash {
 func (bReq *BurningRequest) CalculateSize() uint64 {
 	return calculateSize(bReq)
 }
+
+func (bReq BurningRequest) ValidateSanityData() error {
+	if err := checkMetaType(bReq.Type, BurningRequestMeta, BurningRequestMetaV2, BurningPBSCRequestMeta,
+		BurningForDepositToSCRequestMeta, BurningForDepositToSCRequestMetaV2); err != nil {
+		return err
+	}
+	if len(bReq.BurnerAddress.Pk) == 0 {
+		return errors.New("burner address is empty")
+	}
+	if err := checkPositive(bReq.BurningAmount, "burning amount"); err != nil {
+		return err
+	}
+	if bReq.TokenID == common.PRVCoinID {
+		return errors.New("PRV can not be burned to a remote chain")
+	}
+	return checkHexRemoteAddress(bReq.RemoteAddress)
+}
+
+func (bReq BurningRequest) BurnedAmounts() map[common.Hash]uint64 {
+	return map[common.Hash]uint64{bReq.TokenID: bReq.BurningAmount}
+}
@@ -0,0 +1,206 @@
+package metadata
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// vault methods taking a burn proof
+const (
+	VaultWithdrawMethod        = "withdraw"
+	VaultSubmitBurnProofMethod = "submitBurnProof"
+)
+
+// EVMVaultBurnProofABI is the withdraw and submitBurnProof methods of the Ethereum and BSC vault contracts
+const EVMVaultBurnProofABI = `[{"constant":false,"inputs":[{"name":"inst","type":"bytes"},{"name":"heights","type":"uint256"},{"name":"instPaths","type":"bytes32[]"},{"name":"instPathIsLefts","type":"bool[]"},{"name":"instRoots","type":"bytes32"},{"name":"blkData","type":"bytes32"},{"name":"sigIdxs","type":"uint256[]"},{"name":"sigVs","type":"uint8[]"},{"name":"sigRs","type":"bytes32[]"},{"name":"sigSs","type":"bytes32[]"}],"name":"withdraw","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"inst","type":"bytes"},{"name":"heights","type":"uint256"},{"name":"instPaths","type":"bytes32[]"},{"name":"instPathIsLefts","type":"bool[]"},{"name":"instRoots","type":"bytes32"},{"name":"blkData","type":"bytes32"},{"name":"sigIdxs","type":"uint256[]"},{"name":"sigVs","type":"uint8[]"},{"name":"sigRs","type":"bytes32[]"},{"name":"sigSs","type":"bytes32[]"}],"name":"submitBurnProof","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+
+// BurnConfirmInstLen is the length of a flattened burning confirm instruction:
+// meta type, shard ID and 6 words of 32 bytes
+const BurnConfirmInstLen = 2 + 6*32
+
+const bridgeSigLen = 65
+
+// BurnProof is the result of the getburnproof and getbscburnproof RPCs of a burning request tx,
+// byte fields and BeaconHeight are hex-encoded
+type BurnProof struct {
+	Instruction          string
+	BeaconHeight         string
+	BeaconInstPath       []string
+	BeaconInstPathIsLeft []bool
+	BeaconInstRoot       string
+	BeaconBlkData        string
+	BeaconSigs           []string
+	BeaconSigIdxs        []int
+}
+
+// BurnConfirmInst is the burning confirm instruction the beacon committee signed for a BurningRequest
+type BurnConfirmInst struct {
+	Meta       int
+	ShardID    byte
+	Token      rCommon.Address
+	To         rCommon.Address
+	Amount     *big.Int
+	TxID       rCommon.Hash
+	IncTokenID rCommon.Hash
+	Height     *big.Int
+}
+
+// DecodedBurnProof is a BurnProof in the types of the vault methods,
+// each beacon signature is split in its V, R and S
+type DecodedBurnProof struct {
+	Inst            *BurnConfirmInst
+	RawInst         []byte
+	Heights         *big.Int
+	InstPaths       [][32]byte
+	InstPathIsLefts []bool
+	InstRoots       [32]byte
+	BlkData         [32]byte
+	SigIdxs         []*big.Int
+	SigVs           []uint8
+	SigRs           [][32]byte
+	SigSs           [][32]byte
+}
+
+// BurningConfirmMetaOf returns the meta type of the confirm instruction of a burning request meta type
+func BurningConfirmMetaOf(burningMeta int) (int, error) {
+	switch burningMeta {
+	case BurningRequestMeta:
+		return BurningConfirmMeta, nil
+	case BurningRequestMetaV2:
+		return BurningConfirmMetaV2, nil
+	case BurningPBSCRequestMeta:
+		return BurningBSCConfirmMeta, nil
+	default:
+		return 0, errors.Errorf("meta type %v is not a burning request to a vault", burningMeta)
+	}
+}
+
+// DecodeBurnConfirmInst parses a flattened burning confirm instruction
+func DecodeBurnConfirmInst(inst []byte) (*BurnConfirmInst, error) {
+	if len(inst) != BurnConfirmInstLen {
+		return nil, errors.Errorf("burning confirm instruction has length %v, want %v", len(inst), BurnConfirmInstLen)
+	}
+	meta := int(inst[0])
+	if meta != BurningConfirmMeta && meta != BurningConfirmMetaV2 && meta != BurningBSCConfirmMeta {
+		return nil, errors.Errorf("instruction meta type %v is not a burning confirm", meta)
+	}
+	word := func(i int) []byte {
+		return inst[2+i*32 : 2+(i+1)*32]
+	}
+	return &BurnConfirmInst{
+		Meta:       meta,
+		ShardID:    inst[1],
+		Token:      rCommon.BytesToAddress(word(0)),
+		To:         rCommon.BytesToAddress(word(1)),
+		Amount:     new(big.Int).SetBytes(word(2)),
+		TxID:       rCommon.BytesToHash(word(3)),
+		IncTokenID: rCommon.BytesToHash(word(4)),
+		Height:     new(big.Int).SetBytes(word(5)),
+	}, nil
+}
+
+func decodeHexBytes32(str string) ([32]byte, error) {
+	var res [32]byte
+	b, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+	if err != nil {
+		return res, err
+	}
+	if len(b) != 32 {
+		return res, errors.Errorf("%v is not 32 bytes", str)
+	}
+	copy(res[:], b)
+	return res, nil
+}
+
+// Decode checks the shape of the proof and converts it to the vault method types
+func (proof BurnProof) Decode() (*DecodedBurnProof, error) {
+	rawInst, err := hex.DecodeString(strings.TrimPrefix(proof.Instruction, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "can not decode instruction")
+	}
+	inst, err := DecodeBurnConfirmInst(rawInst)
+	if err != nil {
+		return nil, err
+	}
+	heights, ok := new(big.Int).SetString(strings.TrimPrefix(proof.BeaconHeight, "0x"), 16)
+	if !ok || heights.Sign() <= 0 {
+		return nil, errors.Errorf("beacon height %v is invalid", proof.BeaconHeight)
+	}
+	res := &DecodedBurnProof{
+		Inst:            inst,
+		RawInst:         rawInst,
+		Heights:         heights,
+		InstPathIsLefts: proof.BeaconInstPathIsLeft,
+	}
+
+	if len(proof.BeaconInstPath) != len(proof.BeaconInstPathIsLeft) {
+		return nil, errors.Errorf("instruction path has %v nodes and %v sides", len(proof.BeaconInstPath), len(proof.BeaconInstPathIsLeft))
+	}
+	for _, node := range proof.BeaconInstPath {
+		b, err := decodeHexBytes32(node)
+		if err != nil {
+			return nil, errors.Wrap(err, "can not decode instruction path")
+		}
+		res.InstPaths = append(res.InstPaths, b)
+	}
+	res.InstRoots, err = decodeHexBytes32(proof.BeaconInstRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not decode instruction root")
+	}
+	res.BlkData, err = decodeHexBytes32(proof.BeaconBlkData)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not decode block data")
+	}
+
+	if len(proof.BeaconSigs) == 0 || len(proof.BeaconSigs) != len(proof.BeaconSigIdxs) {
+		return nil, errors.Errorf("proof has %v signatures and %v signer indices", len(proof.BeaconSigs), len(proof.BeaconSigIdxs))
+	}
+	for i, sigStr := range proof.BeaconSigs {
+		sig, err := hex.DecodeString(strings.TrimPrefix(sigStr, "0x"))
+		if err != nil || len(sig) != bridgeSigLen {
+			return nil, errors.Errorf("signature %v is invalid", i)
+		}
+		var r, s [32]byte
+		copy(r[:], sig[:32])
+		copy(s[:], sig[32:64])
+		v := sig[64]
+		// the chain signs with V in {0, 1}, the vault uses ecrecover
+		if v < 27 {
+			v += 27
+		}
+		res.SigRs = append(res.SigRs, r)
+		res.SigSs = append(res.SigSs, s)
+		res.SigVs = append(res.SigVs, v)
+		res.SigIdxs = append(res.SigIdxs, big.NewInt(int64(proof.BeaconSigIdxs[i])))
+	}
+	return res, nil
+}
+
+// Calldata ABI-encodes the call of the vault method, VaultWithdrawMethod or VaultSubmitBurnProofMethod, with the proof
+func (proof DecodedBurnProof) Calldata(method string) ([]byte, error) {
+	if method != VaultWithdrawMethod && method != VaultSubmitBurnProofMethod {
+		return nil, errors.Errorf("vault has no burn proof method %v", method)
+	}
+	vaultABI, err := abi.JSON(strings.NewReader(EVMVaultBurnProofABI))
+	if err != nil {
+		return nil, err
+	}
+	return vaultABI.Pack(
+		method,
+		proof.RawInst,
+		proof.Heights,
+		proof.InstPaths,
+		proof.InstPathIsLefts,
+		proof.InstRoots,
+		proof.BlkData,
+		proof.SigIdxs,
+		proof.SigVs,
+		proof.SigRs,
+		proof.SigSs,
+	)
+}
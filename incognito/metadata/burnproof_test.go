@@ -0,0 +1,114 @@
+package metadata
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+func loadTestBurnProof(t *testing.T) BurnProof {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "burnproof.json"))
+	assert.Equal(t, nil, err)
+	var proof BurnProof
+	assert.Equal(t, nil, json.Unmarshal(data, &proof))
+	return proof
+}
+
+func TestBurnProofCalldata(t *testing.T) {
+	decoded, err := loadTestBurnProof(t).Decode()
+	assert.Equal(t, nil, err)
+
+	for _, method := range []string{VaultWithdrawMethod, VaultSubmitBurnProofMethod} {
+		calldata, err := decoded.Calldata(method)
+		assert.Equal(t, nil, err)
+
+		golden := filepath.Join("testdata", strings.ToLower(method)+".golden")
+		if *updateGolden {
+			assert.Equal(t, nil, ioutil.WriteFile(golden, []byte(hex.EncodeToString(calldata)+"\n"), 0644))
+		}
+		want, err := ioutil.ReadFile(golden)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, strings.TrimSpace(string(want)), hex.EncodeToString(calldata))
+	}
+
+	_, err = decoded.Calldata("deposit")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestBurnProofDecode(t *testing.T) {
+	proof := loadTestBurnProof(t)
+	decoded, err := proof.Decode()
+	assert.Equal(t, nil, err)
+
+	inst := decoded.Inst
+	assert.Equal(t, BurningConfirmMeta, inst.Meta)
+	assert.Equal(t, byte(1), inst.ShardID)
+	assert.Equal(t, rCommon.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), inst.Token)
+	assert.Equal(t, rCommon.HexToAddress("0xe722d8b71dcc0152d47c3521baa9ae3c71e9b4a3"), inst.To)
+	assert.Equal(t, int64(123456789), inst.Amount.Int64())
+	assert.Equal(t, int64(1234567), inst.Height.Int64())
+	assert.Equal(t, int64(1234569), decoded.Heights.Int64())
+	assert.Equal(t, []uint8{27, 28, 27}, decoded.SigVs)
+	assert.Equal(t, 3, len(decoded.SigIdxs))
+	assert.Equal(t, int64(3), decoded.SigIdxs[2].Int64())
+
+	confirmMeta, err := BurningConfirmMetaOf(BurningPBSCRequestMeta)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, BurningBSCConfirmMeta, confirmMeta)
+	_, err = BurningConfirmMetaOf(BurningForDepositToSCRequestMeta)
+	assert.NotEqual(t, nil, err)
+
+	// malformed proofs
+	bad := proof
+	bad.BeaconSigIdxs = bad.BeaconSigIdxs[1:]
+	_, err = bad.Decode()
+	assert.NotEqual(t, nil, err)
+
+	bad = proof
+	bad.BeaconInstPathIsLeft = bad.BeaconInstPathIsLeft[1:]
+	_, err = bad.Decode()
+	assert.NotEqual(t, nil, err)
+
+	bad = proof
+	bad.BeaconSigs = append([]string{"00"}, bad.BeaconSigs[1:]...)
+	_, err = bad.Decode()
+	assert.NotEqual(t, nil, err)
+
+	// the node encodes the height in hex
+	bad = proof
+	bad.BeaconHeight = "1234569"
+	decoded, err = bad.Decode()
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, int64(1234569), decoded.Heights.Int64())
+	for _, height := range []string{"", "0x", "12g689", "-12d689", "0"} {
+		bad.BeaconHeight = height
+		_, err = bad.Decode()
+		assert.NotEqual(t, nil, err)
+	}
+	assert.NotEqual(t, nil, json.Unmarshal([]byte(`{"BeaconHeight": 1234569}`), &bad))
+
+	bad = proof
+	bad.BeaconInstRoot = "0x00"
+	_, err = bad.Decode()
+	assert.NotEqual(t, nil, err)
+
+	bad = proof
+	bad.Instruction = proof.Instruction[:len(proof.Instruction)-2]
+	_, err = bad.Decode()
+	assert.NotEqual(t, nil, err)
+
+	// an instruction which is not a burning confirm
+	bad = proof
+	bad.Instruction = "5b" + proof.Instruction[2:]
+	_, err = bad.Decode()
+	assert.NotEqual(t, nil, err)
+}
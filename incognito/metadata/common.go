@@ -2,9 +2,12 @@ package metadata
 
 import (
 	"encoding/json"
+	"math"
+
 	"github.com/pkg/errors"
 )
 
+// ParseMetadata unmarshals a metadata into the type registered for its Type field
 func ParseMetadata(meta interface{}) (Metadata, error) {
 	if meta == nil {
 		return nil, nil
@@ -13,51 +16,26 @@ func ParseMetadata(meta interface{}) (Metadata, error) {
 	mtTemp := map[string]interface{}{}
 	metaInBytes, err := json.Marshal(meta)
 	if err != nil {
-		return nil, err
+		return nil, NewMetadataError(ParseMetadataErr, err)
 	}
 	err = json.Unmarshal(metaInBytes, &mtTemp)
 	if err != nil {
-		return nil, err
+		return nil, NewMetadataError(ParseMetadataErr, err)
+	}
+	typeFloat, ok := mtTemp["Type"].(float64)
+	if !ok || typeFloat != math.Trunc(typeFloat) {
+		return nil, NewMetadataError(InvalidMetadataTypeErr, errors.Errorf("metadata type %v is invalid", mtTemp["Type"]))
 	}
-	var md Metadata
-	switch int(mtTemp["Type"].(float64)) {
-	case IssuingETHRequestMeta:
-		md = &IssuingEVMRequest{}
-	case IssuingBSCRequestMeta:
-		md = &IssuingEVMRequest{}
-	case BurningRequestMeta:
-		md = &BurningRequest{}
-	case BurningRequestMetaV2:
-		md = &BurningRequest{}
-	case BurningPBSCRequestMeta:
-		md = &BurningRequest{}
-	case ShardStakingMeta:
-		md = &StakingMetadata{}
-	case BeaconStakingMeta:
-		md = &StakingMetadata{}
-	case WithDrawRewardRequestMeta:
-		md = &WithDrawRewardRequest{}
-	case StopAutoStakingMeta:
-		md = &StopAutoStakingMetadata{}
-	case PDEContributionMeta:
-		md = &PDEContribution{}
-	case PDEPRVRequiredContributionRequestMeta:
-		md = &PDEContribution{}
-	case PDETradeRequestMeta:
-		md = &PDETradeRequest{}
-	case PDEWithdrawalRequestMeta:
-		md = &PDEWithdrawalRequest{}
-	case BurningForDepositToSCRequestMeta:
-		md = &BurningRequest{}
-	case BurningForDepositToSCRequestMetaV2:
-		md = &BurningRequest{}
-	default:
-		return nil, errors.Errorf("Could not parse metadata with type: %d", int(mtTemp["Type"].(float64)))
+	metaType := int(typeFloat)
+	factory, ok := getFactory(metaType)
+	if !ok {
+		return nil, NewMetadataError(UnregisteredMetadataTypeErr, errors.Errorf("Could not parse metadata with type: %d", metaType))
 	}
 
-	err = json.Unmarshal(metaInBytes, &md)
+	md := factory()
+	err = json.Unmarshal(metaInBytes, md)
 	if err != nil {
-		return nil, err
+		return nil, NewMetadataError(ParseMetadataErr, err)
 	}
 	return md, nil
 }
@@ -120,3 +120,9 @@ const (
 	BurningPBSCRequestMeta = 252
 	BurningBSCConfirmMeta  = 253
 )
+
+// ShardStakingAmount is the stake, in nano PRV, a staking tx declares in StakingAmountShard
+const ShardStakingAmount = uint64(1750 * 1e9)
+
+// BurningAddress is the payment address without private key which txs pay to burn coins
+const BurningAddress = "12RxahVABnAVCGP3LGwCn8jkQxgw7z1x14wztHzn455TTVpi1wBq9YGwkRMQg3J4e657AbAnCvYCJSdA9czBUNuCKwGSRQt55Xwz8WA"
@@ -0,0 +1,42 @@
+package metadata
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	UnExpectedError = iota
+	InvalidMetadataTypeErr
+	UnregisteredMetadataTypeErr
+	ParseMetadataErr
+)
+
+var ErrCodeMessage = map[int]struct {
+	Code    int
+	Message string
+}{
+	UnExpectedError:             {-1400, "Unexpected error"},
+	InvalidMetadataTypeErr:      {-1401, "Metadata type is missing or is not an integer"},
+	UnregisteredMetadataTypeErr: {-1402, "Metadata type is not registered"},
+	ParseMetadataErr:            {-1403, "Can not parse metadata"},
+}
+
+type MetadataError struct {
+	Code    int
+	Message string
+	err     error
+}
+
+func (e MetadataError) Error() string {
+	return fmt.Sprintf("%d: %s \n %+v", e.Code, e.Message, e.err)
+}
+
+func NewMetadataError(key int, err error) error {
+	return &MetadataError{
+		Code:    ErrCodeMessage[key].Code,
+		Message: ErrCodeMessage[key].Message,
+		err:     errors.Wrap(err, ErrCodeMessage[key].Message),
+	}
+}
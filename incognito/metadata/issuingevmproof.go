@@ -0,0 +1,293 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+)
+
+// EVMVaultDepositABI is the Deposit event the vault contract emits when shielding
+const EVMVaultDepositABI = `[{"anonymous":false,"inputs":[{"indexed":false,"name":"token","type":"address"},{"indexed":false,"name":"incognitoAddress","type":"string"},{"indexed":false,"name":"amount","type":"uint256"}],"name":"Deposit","type":"event"}]`
+
+// EVMDeposit is the Deposit event of the vault in the receipt of an IssuingEVMRequest,
+// Token is the zero address for the native coin and Amount is in the token's smallest unit
+type EVMDeposit struct {
+	Token            rCommon.Address
+	IncognitoAddress string
+	Amount           *big.Int
+}
+
+// EVMLog is a log of an EVM receipt
+type EVMLog struct {
+	Address rCommon.Address
+	Topics  []rCommon.Hash
+	Data    []byte
+}
+
+// EVMReceipt is an EVM receipt in its consensus encoding
+type EVMReceipt struct {
+	PostStateOrStatus []byte
+	CumulativeGasUsed uint64
+	Bloom             []byte
+	Logs              []*EVMLog
+}
+
+// proofNodes is the set of Merkle-Patricia trie nodes of a proof, by node hash
+type proofNodes map[rCommon.Hash][]byte
+
+// VerifyReceiptProof checks ProofStrs against the receipts root of the block BlockHash
+// and returns the receipt of the tx at TxIndex, it fails when the tx failed
+func (iReq IssuingEVMRequest) VerifyReceiptProof(receiptsRoot rCommon.Hash) (*EVMReceipt, error) {
+	if len(iReq.ProofStrs) == 0 {
+		return nil, errors.New("ProofStrs is empty")
+	}
+	nodes := proofNodes{}
+	for _, proofStr := range iReq.ProofStrs {
+		node, err := base64.StdEncoding.DecodeString(proofStr)
+		if err != nil {
+			return nil, errors.Wrap(err, "can not decode proof")
+		}
+		nodes[crypto.Keccak256Hash(node)] = node
+	}
+
+	key, err := rlp.EncodeToBytes(iReq.TxIndex)
+	if err != nil {
+		return nil, err
+	}
+	value, err := nodes.verify(receiptsRoot, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// typed receipts (EIP-2718) are the type byte followed by the legacy encoding
+	if len(value) > 0 && value[0] <= 0x7f {
+		value = value[1:]
+	}
+	receipt := new(EVMReceipt)
+	err = rlp.DecodeBytes(value, receipt)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not decode receipt")
+	}
+	if !bytes.Equal(receipt.PostStateOrStatus, []byte{1}) {
+		return nil, errors.New("the deposit tx failed")
+	}
+	return receipt, nil
+}
+
+// ParseDeposit verifies the receipt proof and returns the Deposit event of vaultAddress in it
+func (iReq IssuingEVMRequest) ParseDeposit(receiptsRoot rCommon.Hash, vaultAddress rCommon.Address) (*EVMDeposit, error) {
+	receipt, err := iReq.VerifyReceiptProof(receiptsRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	vaultABI, err := abi.JSON(strings.NewReader(EVMVaultDepositABI))
+	if err != nil {
+		return nil, err
+	}
+	depositEvent := vaultABI.Events["Deposit"]
+	for _, log := range receipt.Logs {
+		if log.Address != vaultAddress || len(log.Topics) == 0 || log.Topics[0] != depositEvent.Id() {
+			continue
+		}
+		var deposit struct {
+			Token            rCommon.Address
+			IncognitoAddress string
+			Amount           *big.Int
+		}
+		err = vaultABI.Unpack(&deposit, "Deposit", log.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "can not parse Deposit event")
+		}
+		return &EVMDeposit{Token: deposit.Token, IncognitoAddress: deposit.IncognitoAddress, Amount: deposit.Amount}, nil
+	}
+	return nil, errors.New("the receipt has no Deposit event of the vault")
+}
+
+// VerifyDeposit checks that the request shields a deposit of externalTokenID, the EVM token of IncTokenID,
+// to paymentAddress and returns it
+func (iReq IssuingEVMRequest) VerifyDeposit(
+	receiptsRoot rCommon.Hash,
+	vaultAddress rCommon.Address,
+	externalTokenID rCommon.Address,
+	paymentAddress string,
+) (*EVMDeposit, error) {
+	deposit, err := iReq.ParseDeposit(receiptsRoot, vaultAddress)
+	if err != nil {
+		return nil, err
+	}
+	if deposit.Token != externalTokenID {
+		return nil, errors.Errorf("deposited token %v is not the token %v of IncTokenID %v", deposit.Token.Hex(), externalTokenID.Hex(), iReq.IncTokenID.String())
+	}
+	if deposit.IncognitoAddress != paymentAddress {
+		return nil, errors.Errorf("deposit is for %v, not for %v", deposit.IncognitoAddress, paymentAddress)
+	}
+	if deposit.Amount == nil || deposit.Amount.Sign() <= 0 {
+		return nil, errors.New("deposited amount is zero")
+	}
+	return deposit, nil
+}
+
+// verify returns the value of key in the trie of root, as trie.VerifyProof
+func (nodes proofNodes) verify(root rCommon.Hash, key []byte) ([]byte, error) {
+	key = keybytesToHex(key)
+	wantHash := root
+	for i := 0; ; i++ {
+		buf, ok := nodes[wantHash]
+		if !ok {
+			return nil, errors.Errorf("proof node %d (hash %064x) missing", i, wantHash)
+		}
+		n, err := decodeTrieNode(buf)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bad proof node %d", i)
+		}
+		keyrest, child := getTrieNode(n, key)
+		switch child := child.(type) {
+		case nil:
+			return nil, errors.New("the receipt is not in the trie")
+		case trieHashNode:
+			key = keyrest
+			copy(wantHash[:], child)
+		case trieValueNode:
+			return child, nil
+		}
+	}
+}
+
+type (
+	trieFullNode  [17]interface{}
+	trieShortNode struct {
+		Key []byte
+		Val interface{}
+	}
+	trieHashNode  []byte
+	trieValueNode []byte
+)
+
+func getTrieNode(tn interface{}, key []byte) ([]byte, interface{}) {
+	for {
+		switch n := tn.(type) {
+		case *trieShortNode:
+			if len(key) < len(n.Key) || !bytes.Equal(n.Key, key[:len(n.Key)]) {
+				return nil, nil
+			}
+			tn = n.Val
+			key = key[len(n.Key):]
+		case *trieFullNode:
+			if len(key) == 0 {
+				return nil, nil
+			}
+			tn = n[key[0]]
+			key = key[1:]
+		case trieHashNode:
+			return key, n
+		case trieValueNode:
+			return nil, n
+		default:
+			return nil, nil
+		}
+	}
+}
+
+func decodeTrieNode(buf []byte) (interface{}, error) {
+	elems, _, err := rlp.SplitList(buf)
+	if err != nil {
+		return nil, err
+	}
+	switch c, _ := rlp.CountValues(elems); c {
+	case 2:
+		kbuf, rest, err := rlp.SplitString(elems)
+		if err != nil {
+			return nil, err
+		}
+		key := compactToHex(kbuf)
+		if len(key) > 0 && key[len(key)-1] == 16 {
+			// leaf node
+			val, _, err := rlp.SplitString(rest)
+			if err != nil {
+				return nil, err
+			}
+			return &trieShortNode{Key: key, Val: trieValueNode(val)}, nil
+		}
+		ref, _, err := decodeTrieRef(rest)
+		if err != nil {
+			return nil, err
+		}
+		return &trieShortNode{Key: key, Val: ref}, nil
+	case 17:
+		n := new(trieFullNode)
+		for i := 0; i < 16; i++ {
+			var ref interface{}
+			ref, elems, err = decodeTrieRef(elems)
+			if err != nil {
+				return nil, err
+			}
+			n[i] = ref
+		}
+		val, _, err := rlp.SplitString(elems)
+		if err != nil {
+			return nil, err
+		}
+		if len(val) > 0 {
+			n[16] = trieValueNode(val)
+		}
+		return n, nil
+	default:
+		return nil, errors.Errorf("invalid number of list elements: %v", c)
+	}
+}
+
+func decodeTrieRef(buf []byte) (interface{}, []byte, error) {
+	kind, val, rest, err := rlp.Split(buf)
+	if err != nil {
+		return nil, buf, err
+	}
+	switch {
+	case kind == rlp.List:
+		// embedded node, smaller than a hash
+		size := len(buf) - len(rest)
+		if size > rCommon.HashLength {
+			return nil, buf, errors.Errorf("oversized embedded node (size is %d bytes, want size < %d)", size, rCommon.HashLength)
+		}
+		n, err := decodeTrieNode(buf[:size])
+		return n, rest, err
+	case kind == rlp.String && len(val) == 0:
+		return nil, rest, nil
+	case kind == rlp.String && len(val) == rCommon.HashLength:
+		return trieHashNode(val), rest, nil
+	default:
+		return nil, nil, errors.Errorf("invalid RLP string size %d (want 0 or 32)", len(val))
+	}
+}
+
+func keybytesToHex(str []byte) []byte {
+	l := len(str)*2 + 1
+	nibbles := make([]byte, l)
+	for i, b := range str {
+		nibbles[i*2] = b / 16
+		nibbles[i*2+1] = b % 16
+	}
+	nibbles[l-1] = 16
+	return nibbles
+}
+
+func compactToHex(compact []byte) []byte {
+	if len(compact) == 0 {
+		return compact
+	}
+	base := keybytesToHex(compact)
+	// delete terminator flag
+	if base[0] < 2 {
+		base = base[:len(base)-1]
+	}
+	// apply odd flag
+	chop := 2 - base[0]&1
+	return base[chop:]
+}
@@ -0,0 +1,141 @@
+package metadata
+
+import (
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testVaultAddress = rCommon.HexToAddress("0x97875355ef55ae35613029df8b1c8cf8f89c9066")
+	testTokenAddress = rCommon.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7")
+	testIncAddress   = "12S5Lrs1XeQLbqN4ySyKtjAjd2d7sBP2tjFijzmp6avrrkQCNFMpkXm3FPzj2Wcu2ZNqJEmh9JriVuRErVwhuQnLmWSaggobEWsBEci"
+)
+
+func newTestReceipt(t *testing.T, status byte, logAddress rCommon.Address, token rCommon.Address, amount int64) []byte {
+	vaultABI, err := abi.JSON(strings.NewReader(EVMVaultDepositABI))
+	assert.Equal(t, nil, err)
+	depositEvent := vaultABI.Events["Deposit"]
+	data, err := depositEvent.Inputs.Pack(token, testIncAddress, big.NewInt(amount))
+	assert.Equal(t, nil, err)
+
+	receipt := EVMReceipt{
+		PostStateOrStatus: []byte{status},
+		CumulativeGasUsed: 21000,
+		Bloom:             make([]byte, 256),
+		Logs: []*EVMLog{
+			{Address: logAddress, Topics: []rCommon.Hash{crypto.Keccak256Hash([]byte("Other()"))}},
+			{Address: logAddress, Topics: []rCommon.Hash{depositEvent.Id()}, Data: data},
+		},
+	}
+	if status == 0 {
+		receipt.PostStateOrStatus = []byte{}
+	}
+	res, err := rlp.EncodeToBytes(receipt)
+	assert.Equal(t, nil, err)
+	return res
+}
+
+// newTestReceiptTrie builds the receipts trie of a block with receipts of tx 0 and tx 1:
+// a branch node at the root with the leaves of keys rlp(1) = 0x01 at nibble 0 and rlp(0) = 0x80 at nibble 8
+func newTestReceiptTrie(t *testing.T, receipt0, receipt1 []byte) (rCommon.Hash, [][]byte) {
+	leaf0, err := rlp.EncodeToBytes([]interface{}{[]byte{0x30}, receipt0})
+	assert.Equal(t, nil, err)
+	leaf1, err := rlp.EncodeToBytes([]interface{}{[]byte{0x31}, receipt1})
+	assert.Equal(t, nil, err)
+
+	branch := make([]interface{}, 17)
+	for i := range branch {
+		branch[i] = []byte{}
+	}
+	branch[0] = crypto.Keccak256(leaf1)
+	branch[8] = crypto.Keccak256(leaf0)
+	root, err := rlp.EncodeToBytes(branch)
+	assert.Equal(t, nil, err)
+	return crypto.Keccak256Hash(root), [][]byte{root, leaf0, leaf1}
+}
+
+func newTestIssuingEVMRequest(txIndex uint, nodes ...[]byte) IssuingEVMRequest {
+	proofStrs := []string{}
+	for _, node := range nodes {
+		proofStrs = append(proofStrs, base64.StdEncoding.EncodeToString(node))
+	}
+	req, _ := NewIssuingEVMRequest(rCommon.Hash{}, txIndex, proofStrs, common.Hash{}, IssuingETHRequestMeta)
+	return *req
+}
+
+func TestIssuingEVMRequestVerifyDeposit(t *testing.T) {
+	receipt0 := newTestReceipt(t, 1, testVaultAddress, testTokenAddress, 1000)
+	// tx 1 is a typed (EIP-1559) receipt of a native coin deposit
+	receipt1 := append([]byte{0x02}, newTestReceipt(t, 1, testVaultAddress, rCommon.Address{}, 5)...)
+	receiptsRoot, nodes := newTestReceiptTrie(t, receipt0, receipt1)
+
+	req := newTestIssuingEVMRequest(0, nodes[0], nodes[1])
+	deposit, err := req.VerifyDeposit(receiptsRoot, testVaultAddress, testTokenAddress, testIncAddress)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, testTokenAddress, deposit.Token)
+	assert.Equal(t, testIncAddress, deposit.IncognitoAddress)
+	assert.Equal(t, int64(1000), deposit.Amount.Int64())
+
+	req = newTestIssuingEVMRequest(1, nodes[0], nodes[2])
+	deposit, err = req.VerifyDeposit(receiptsRoot, testVaultAddress, rCommon.Address{}, testIncAddress)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(5), deposit.Amount.Int64())
+
+	// the deposit does not match the request
+	req = newTestIssuingEVMRequest(0, nodes...)
+	_, err = req.VerifyDeposit(receiptsRoot, testVaultAddress, rCommon.Address{}, testIncAddress)
+	assert.NotEqual(t, nil, err)
+	_, err = req.VerifyDeposit(receiptsRoot, testVaultAddress, testTokenAddress, "other address")
+	assert.NotEqual(t, nil, err)
+	_, err = req.VerifyDeposit(receiptsRoot, rCommon.HexToAddress("0x01"), testTokenAddress, testIncAddress)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestIssuingEVMRequestVerifyReceiptProof(t *testing.T) {
+	receipt0 := newTestReceipt(t, 1, testVaultAddress, testTokenAddress, 1000)
+	failedReceipt := newTestReceipt(t, 0, testVaultAddress, testTokenAddress, 1000)
+	receiptsRoot, nodes := newTestReceiptTrie(t, receipt0, failedReceipt)
+
+	// the receipt of a failed tx
+	req := newTestIssuingEVMRequest(1, nodes[0], nodes[2])
+	_, err := req.VerifyReceiptProof(receiptsRoot)
+	assert.NotEqual(t, nil, err)
+
+	// another receipts root
+	req = newTestIssuingEVMRequest(0, nodes[0], nodes[1])
+	_, err = req.VerifyReceiptProof(rCommon.Hash{1})
+	assert.NotEqual(t, nil, err)
+
+	// a missing node
+	req = newTestIssuingEVMRequest(0, nodes[0])
+	_, err = req.VerifyReceiptProof(receiptsRoot)
+	assert.NotEqual(t, nil, err)
+
+	// a tampered receipt does not hash to the node in the branch
+	tampered := append([]byte{}, nodes[1]...)
+	tampered[len(tampered)-1] ^= 1
+	req = newTestIssuingEVMRequest(0, nodes[0], tampered)
+	_, err = req.VerifyReceiptProof(receiptsRoot)
+	assert.NotEqual(t, nil, err)
+
+	// a tx which is not in the block
+	req = newTestIssuingEVMRequest(2, nodes...)
+	_, err = req.VerifyReceiptProof(receiptsRoot)
+	assert.NotEqual(t, nil, err)
+
+	req = newTestIssuingEVMRequest(0)
+	_, err = req.VerifyReceiptProof(receiptsRoot)
+	assert.NotEqual(t, nil, err)
+	req.ProofStrs = []string{"invalid base64 !"}
+	_, err = req.VerifyReceiptProof(receiptsRoot)
+	assert.NotEqual(t, nil, err)
+}
@@ -3,6 +3,7 @@ package metadata
 import (
 	"github.com/0xkraken/incognito-wasm/incognito/common"
 	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 )
 
 // whoever can send this type of tx
@@ -52,3 +53,13 @@ func (iReq IssuingEVMRequest) Hash() *common.Hash {
 func (iReq *IssuingEVMRequest) CalculateSize() uint64 {
 	return calculateSize(iReq)
 }
+
+func (iReq IssuingEVMRequest) ValidateSanityData() error {
+	if err := checkMetaType(iReq.Type, IssuingETHRequestMeta, IssuingBSCRequestMeta); err != nil {
+		return err
+	}
+	if iReq.IncTokenID == (common.Hash{}) {
+		return errors.New("incognito token ID is empty")
+	}
+	return checkEVMProof(iReq.BlockHash, iReq.ProofStrs)
+}
@@ -10,6 +10,8 @@ type Metadata interface {
 	GetType() int
 	Hash() *common.Hash
 	CalculateSize() uint64
+	// ValidateSanityData checks the metadata by itself, as the stateless checks of the fullnode
+	ValidateSanityData() error
 }
 
 // This is tx struct which is really saved in tx mempool
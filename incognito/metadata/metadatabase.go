@@ -30,6 +30,11 @@ func (mb MetadataBase) Hash() *common.Hash {
 	return &hash
 }
 
+// ValidateSanityData of a metadata without fields to check
+func (mb MetadataBase) ValidateSanityData() error {
+	return nil
+}
+
 func calculateSize(meta Metadata) uint64 {
 	metaBytes, err := json.Marshal(meta)
 	if err != nil {
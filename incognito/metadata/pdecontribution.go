@@ -101,3 +101,28 @@ func (pc PDEContribution) Hash() *common.Hash {
 func (pc *PDEContribution) CalculateSize() uint64 {
 	return calculateSize(pc)
 }
+
+func (pc PDEContribution) ValidateSanityData() error {
+	if err := checkMetaType(pc.Type, PDEContributionMeta, PDEPRVRequiredContributionRequestMeta); err != nil {
+		return err
+	}
+	if err := checkNotEmpty(pc.PDEContributionPairID, "contribution pair ID"); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(pc.ContributorAddressStr, "contributor address"); err != nil {
+		return err
+	}
+	if err := checkPositive(pc.ContributedAmount, "contributed amount"); err != nil {
+		return err
+	}
+	_, err := checkTokenID(pc.TokenIDStr, "contributed token")
+	return err
+}
+
+func (pc PDEContribution) BurnedAmounts() map[common.Hash]uint64 {
+	tokenID, err := common.Hash{}.NewHashFromStr(pc.TokenIDStr)
+	if err != nil {
+		return nil
+	}
+	return map[common.Hash]uint64{*tokenID: pc.ContributedAmount}
+}
@@ -0,0 +1,105 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/pkg/errors"
+)
+
+// PDECrossPoolTradeRequest - privacy dex trade through the PRV pools of both tokens,
+// TradingFee is in PRV for both PRV-sell and token-sell trades
+type PDECrossPoolTradeRequest struct {
+	TokenIDToBuyStr     string
+	TokenIDToSellStr    string
+	SellAmount          uint64 // must be equal to vout value
+	MinAcceptableAmount uint64
+	TradingFee          uint64
+	TraderAddressStr    string
+	SubTraderAddressStr string // receives the refunds, TraderAddressStr if empty
+	MetadataBase
+}
+
+func NewPDECrossPoolTradeRequest(
+	tokenIDToBuyStr string,
+	tokenIDToSellStr string,
+	sellAmount uint64,
+	minAcceptableAmount uint64,
+	tradingFee uint64,
+	traderAddressStr string,
+	subTraderAddressStr string,
+	metaType int,
+) (*PDECrossPoolTradeRequest, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	pdeCrossPoolTradeRequest := &PDECrossPoolTradeRequest{
+		TokenIDToBuyStr:     tokenIDToBuyStr,
+		TokenIDToSellStr:    tokenIDToSellStr,
+		SellAmount:          sellAmount,
+		MinAcceptableAmount: minAcceptableAmount,
+		TradingFee:          tradingFee,
+		TraderAddressStr:    traderAddressStr,
+		SubTraderAddressStr: subTraderAddressStr,
+	}
+	pdeCrossPoolTradeRequest.MetadataBase = metadataBase
+	return pdeCrossPoolTradeRequest, nil
+}
+
+func (pc PDECrossPoolTradeRequest) Hash() *common.Hash {
+	record := pc.MetadataBase.Hash().String()
+	record += pc.TokenIDToBuyStr
+	record += pc.TokenIDToSellStr
+	record += pc.TraderAddressStr
+	if len(pc.SubTraderAddressStr) > 0 {
+		record += pc.SubTraderAddressStr
+	}
+	record += strconv.FormatUint(pc.SellAmount, 10)
+	record += strconv.FormatUint(pc.MinAcceptableAmount, 10)
+	record += strconv.FormatUint(pc.TradingFee, 10)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (pc *PDECrossPoolTradeRequest) CalculateSize() uint64 {
+	return calculateSize(pc)
+}
+
+func (pc PDECrossPoolTradeRequest) ValidateSanityData() error {
+	if err := checkMetaType(pc.Type, PDECrossPoolTradeRequestMeta); err != nil {
+		return err
+	}
+	if _, err := checkTradeTokens(pc.TokenIDToBuyStr, pc.TokenIDToSellStr); err != nil {
+		return err
+	}
+	if err := checkPositive(pc.SellAmount, "sell amount"); err != nil {
+		return err
+	}
+	if pc.SellAmount+pc.TradingFee < pc.SellAmount {
+		return errors.New("sell amount and trading fee overflow")
+	}
+	if err := checkPaymentAddress(pc.TraderAddressStr, "trader address"); err != nil {
+		return err
+	}
+	if pc.SubTraderAddressStr != "" {
+		return checkPaymentAddress(pc.SubTraderAddressStr, "sub trader address")
+	}
+	return nil
+}
+
+// BurnedAmounts of a cross pool trade, the trading fee is paid in PRV
+func (pc PDECrossPoolTradeRequest) BurnedAmounts() map[common.Hash]uint64 {
+	tokenIDToSell, err := common.Hash{}.NewHashFromStr(pc.TokenIDToSellStr)
+	if err != nil {
+		return nil
+	}
+	if *tokenIDToSell == common.PRVCoinID {
+		return map[common.Hash]uint64{common.PRVCoinID: pc.SellAmount + pc.TradingFee}
+	}
+	res := map[common.Hash]uint64{*tokenIDToSell: pc.SellAmount}
+	if pc.TradingFee > 0 {
+		res[common.PRVCoinID] = pc.TradingFee
+	}
+	return res
+}
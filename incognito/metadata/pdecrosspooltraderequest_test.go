@@ -0,0 +1,27 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPDECrossPoolTradeRequest(t *testing.T) {
+	tokenID := common.HashH([]byte("token")).String()
+	req, err := NewPDECrossPoolTradeRequest(tokenID, common.PRVCoinID.String(), 1000, 900, 10, testIncAddress, "", PDECrossPoolTradeRequestMeta)
+	assert.Equal(t, nil, err)
+
+	md, err := ParseMetadata(req)
+	assert.Equal(t, nil, err)
+	parsed, ok := md.(*PDECrossPoolTradeRequest)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, *req, *parsed)
+	assert.Equal(t, req.Hash(), parsed.Hash())
+
+	// the sub trader address changes the hash
+	withSubTrader := *req
+	withSubTrader.SubTraderAddressStr = testIncAddress
+	assert.NotEqual(t, req.Hash(), withSubTrader.Hash())
+	assert.Equal(t, true, withSubTrader.CalculateSize() > req.CalculateSize())
+}
@@ -0,0 +1,66 @@
+package metadata
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"strconv"
+)
+
+// PDEFeeWithdrawalRequest - privacy dex trading fee withdrawal request
+type PDEFeeWithdrawalRequest struct {
+	WithdrawerAddressStr  string
+	WithdrawalToken1IDStr string
+	WithdrawalToken2IDStr string
+	WithdrawalFeeAmt      uint64
+	MetadataBase
+}
+
+type PDEFeeWithdrawalRequestAction struct {
+	Meta    PDEFeeWithdrawalRequest
+	TxReqID common.Hash
+	ShardID byte
+}
+
+func NewPDEFeeWithdrawalRequest(
+	withdrawerAddressStr string,
+	withdrawalToken1IDStr string,
+	withdrawalToken2IDStr string,
+	withdrawalFeeAmt uint64,
+	metaType int,
+) (*PDEFeeWithdrawalRequest, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	pdeFeeWithdrawalRequest := &PDEFeeWithdrawalRequest{
+		WithdrawerAddressStr:  withdrawerAddressStr,
+		WithdrawalToken1IDStr: withdrawalToken1IDStr,
+		WithdrawalToken2IDStr: withdrawalToken2IDStr,
+		WithdrawalFeeAmt:      withdrawalFeeAmt,
+	}
+	pdeFeeWithdrawalRequest.MetadataBase = metadataBase
+	return pdeFeeWithdrawalRequest, nil
+}
+
+func (pc PDEFeeWithdrawalRequest) Hash() *common.Hash {
+	record := pc.MetadataBase.Hash().String()
+	record += pc.WithdrawerAddressStr
+	record += pc.WithdrawalToken1IDStr
+	record += pc.WithdrawalToken2IDStr
+	record += strconv.FormatUint(pc.WithdrawalFeeAmt, 10)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (pc *PDEFeeWithdrawalRequest) CalculateSize() uint64 {
+	return calculateSize(pc)
+}
+
+func (pc PDEFeeWithdrawalRequest) ValidateSanityData() error {
+	if err := checkMetaType(pc.Type, PDEFeeWithdrawalRequestMeta); err != nil {
+		return err
+	}
+	if err := checkWithdrawalPair(pc.WithdrawerAddressStr, pc.WithdrawalToken1IDStr, pc.WithdrawalToken2IDStr); err != nil {
+		return err
+	}
+	return checkPositive(pc.WithdrawalFeeAmt, "withdrawal fee amount")
+}
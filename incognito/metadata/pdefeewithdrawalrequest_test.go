@@ -0,0 +1,25 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPDEFeeWithdrawalRequest(t *testing.T) {
+	tokenID := common.HashH([]byte("token")).String()
+	req, err := NewPDEFeeWithdrawalRequest(testIncAddress, common.PRVCoinID.String(), tokenID, 500, PDEFeeWithdrawalRequestMeta)
+	assert.Equal(t, nil, err)
+
+	md, err := ParseMetadata(req)
+	assert.Equal(t, nil, err)
+	parsed, ok := md.(*PDEFeeWithdrawalRequest)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, *req, *parsed)
+
+	// a share withdrawal of the same amount is another request
+	shareReq, err := NewPDEWithdrawalRequest(testIncAddress, common.PRVCoinID.String(), tokenID, 500, PDEWithdrawalRequestMeta)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, shareReq.Hash(), req.Hash())
+}
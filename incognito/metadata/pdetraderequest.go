@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/pkg/errors"
 )
 
 // PDETradeRequest - privacy dex trade
@@ -81,3 +82,44 @@ func (pc PDETradeRequest) Hash() *common.Hash {
 func (pc *PDETradeRequest) CalculateSize() uint64 {
 	return calculateSize(pc)
 }
+
+// checkTradeTokens checks the token IDs of a trade, returning the token to sell
+func checkTradeTokens(tokenIDToBuyStr string, tokenIDToSellStr string) (*common.Hash, error) {
+	tokenIDToBuy, err := checkTokenID(tokenIDToBuyStr, "token to buy")
+	if err != nil {
+		return nil, err
+	}
+	tokenIDToSell, err := checkTokenID(tokenIDToSellStr, "token to sell")
+	if err != nil {
+		return nil, err
+	}
+	if *tokenIDToBuy == *tokenIDToSell {
+		return nil, errors.New("token to buy is the token to sell")
+	}
+	return tokenIDToSell, nil
+}
+
+func (pc PDETradeRequest) ValidateSanityData() error {
+	if err := checkMetaType(pc.Type, PDETradeRequestMeta); err != nil {
+		return err
+	}
+	if _, err := checkTradeTokens(pc.TokenIDToBuyStr, pc.TokenIDToSellStr); err != nil {
+		return err
+	}
+	if err := checkPositive(pc.SellAmount, "sell amount"); err != nil {
+		return err
+	}
+	if pc.SellAmount+pc.TradingFee < pc.SellAmount {
+		return errors.New("sell amount and trading fee overflow")
+	}
+	return checkPaymentAddress(pc.TraderAddressStr, "trader address")
+}
+
+// BurnedAmounts of a trade, the trading fee is paid in the token to sell
+func (pc PDETradeRequest) BurnedAmounts() map[common.Hash]uint64 {
+	tokenIDToSell, err := common.Hash{}.NewHashFromStr(pc.TokenIDToSellStr)
+	if err != nil {
+		return nil
+	}
+	return map[common.Hash]uint64{*tokenIDToSell: pc.SellAmount + pc.TradingFee}
+}
@@ -2,6 +2,7 @@ package metadata
 
 import (
 	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/pkg/errors"
 	"strconv"
 )
 
@@ -65,3 +66,32 @@ func (pc PDEWithdrawalRequest) Hash() *common.Hash {
 func (pc *PDEWithdrawalRequest) CalculateSize() uint64 {
 	return calculateSize(pc)
 }
+
+// checkWithdrawalPair checks the withdrawer and the token IDs of a pDEX pool pair
+func checkWithdrawalPair(withdrawerAddressStr string, token1IDStr string, token2IDStr string) error {
+	if err := checkPaymentAddress(withdrawerAddressStr, "withdrawer address"); err != nil {
+		return err
+	}
+	token1ID, err := checkTokenID(token1IDStr, "withdrawal token 1")
+	if err != nil {
+		return err
+	}
+	token2ID, err := checkTokenID(token2IDStr, "withdrawal token 2")
+	if err != nil {
+		return err
+	}
+	if *token1ID == *token2ID {
+		return errors.New("withdrawal tokens are the same")
+	}
+	return nil
+}
+
+func (pc PDEWithdrawalRequest) ValidateSanityData() error {
+	if err := checkMetaType(pc.Type, PDEWithdrawalRequestMeta); err != nil {
+		return err
+	}
+	if err := checkWithdrawalPair(pc.WithdrawerAddressStr, pc.WithdrawalToken1IDStr, pc.WithdrawalToken2IDStr); err != nil {
+		return err
+	}
+	return checkPositive(pc.WithdrawalShareAmt, "withdrawal share amount")
+}
@@ -0,0 +1,39 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPortalBTCTokenID = "b832e5d3b1f01a4f0623f7fe91d6673461e1f5d37d91fe78c5c2e6183ff39696"
+
+func TestParsePortalUserMetadata(t *testing.T) {
+	porting, _ := NewPortalUserRegister("porting-1", testIncAddress, testPortalBTCTokenID, 100000, 50, PortalRequestPortingMetaV3)
+	reqPToken, _ := NewPortalRequestPTokens("porting-1", testPortalBTCTokenID, testIncAddress, 100000, "proof", PortalUserRequestPTokenMeta)
+	redeem, _ := NewPortalRedeemRequest("redeem-1", testPortalBTCTokenID, 100000, testIncAddress, "btc address", 50, testVaultAddress.Hex(), PortalRedeemRequestMetaV3)
+	liquidation, _ := NewPortalRedeemFromLiquidationPool(testPortalBTCTokenID, 100000, testIncAddress, testVaultAddress.Hex(), PortalRedeemFromLiquidationPoolMetaV3)
+
+	for _, md := range []Metadata{porting, reqPToken, redeem, liquidation} {
+		parsed, err := ParseMetadata(md)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, md, parsed)
+		assert.Equal(t, md.Hash(), parsed.Hash())
+	}
+}
+
+func TestPortalRedeemRequestHash(t *testing.T) {
+	redeem, _ := NewPortalRedeemRequest("redeem-1", testPortalBTCTokenID, 100000, testIncAddress, "btc address", 50, testVaultAddress.Hex(), PortalRedeemRequestMetaV3)
+	other := *redeem
+	other.RedeemerExternalAddress = ""
+	assert.NotEqual(t, redeem.Hash(), other.Hash())
+
+	// v2 requests have no external address
+	redeem.Type, other.Type = PortalRedeemRequestMeta, PortalRedeemRequestMeta
+	assert.Equal(t, redeem.Hash(), other.Hash())
+
+	liquidation, _ := NewPortalRedeemFromLiquidationPool(testPortalBTCTokenID, 100000, testIncAddress, testVaultAddress.Hex(), PortalRedeemFromLiquidationPoolMetaV3)
+	otherLiquidation := *liquidation
+	otherLiquidation.RedeemerExtAddressStr = ""
+	assert.NotEqual(t, liquidation.Hash(), otherLiquidation.Hash())
+}
@@ -0,0 +1,142 @@
+package metadata
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPortalCustodianMetadata() map[string]Metadata {
+	remoteAddresses := map[string]string{
+		testPortalBTCTokenID: "tb1qnrnnm5jsvcmjlqy6m0ycv0jq9km59lfuyxxxqn",
+		"b2655152784e8639fa19521a7035f331eea1f1e911b2f3200a507ebb4554387b": "tbnb1fau9kq605jwkyfea2knw495we8cpa47r9r6uxv",
+	}
+	proofStrs := []string{"+QIxoJ5Qlf0Q", "+QJxIIIBbPkCaw"}
+	blockHash := rCommon.HexToHash("0x7d7a3a6e3a6e8d9b5b0a1f4ed0dbf0c9d3b1b7d9c5e4b8f1d3d5c5f6e7a8b9c0")
+	ethTokenID := "0x0000000000000000000000000000000000000000"
+	usdtTokenID := "0xdac17f958d2ee523a2206206994597c13d831ec7"
+
+	deposit, _ := NewPortalCustodianDeposit(testIncAddress, remoteAddresses, 5000000000, PortalCustodianDepositMeta)
+	depositV3, _ := NewPortalCustodianDepositV3(remoteAddresses, blockHash, 12, proofStrs, PortalCustodianDepositMetaV3)
+	withdraw, _ := NewPortalCustodianWithdrawRequest(testIncAddress, 1000000000, PortalCustodianWithdrawRequestMeta)
+	withdrawV3, _ := NewPortalCustodianWithdrawRequestV3(testIncAddress, testVaultAddress.Hex(), usdtTokenID, 1000000, PortalCustodianWithdrawRequestMetaV3)
+	topup, _ := NewPortalLiquidationCustodianDepositV2(testIncAddress, testPortalBTCTokenID, 100000000, 20000000, PortalCustodianTopupMetaV2)
+	topupV3, _ := NewPortalLiquidationCustodianDepositV3(testIncAddress, testPortalBTCTokenID, ethTokenID, 100000000, 0, blockHash, 3, proofStrs, PortalCustodianTopupMetaV3)
+	topupPorting, _ := NewPortalTopUpWaitingPortingRequest(testIncAddress, "porting-1", testPortalBTCTokenID, 100000000, 0, PortalTopUpWaitingPortingRequestMeta)
+	topupPortingV3, _ := NewPortalTopUpWaitingPortingRequestV3(testIncAddress, "porting-1", testPortalBTCTokenID, usdtTokenID, 0, 500, rCommon.Hash{}, 0, []string{}, PortalTopUpWaitingPortingRequestMetaV3)
+	withdrawReward, _ := NewPortalRequestWithdrawReward(testIncAddress, common.PRVCoinID, PortalRequestWithdrawRewardMeta)
+
+	return map[string]Metadata{
+		"PortalCustodianDeposit":              deposit,
+		"PortalCustodianDepositV3":            depositV3,
+		"PortalCustodianWithdrawRequest":      withdraw,
+		"PortalCustodianWithdrawRequestV3":    withdrawV3,
+		"PortalLiquidationCustodianDepositV2": topup,
+		"PortalLiquidationCustodianDepositV3": topupV3,
+		"PortalTopUpWaitingPortingRequest":    topupPorting,
+		"PortalTopUpWaitingPortingRequestV3":  topupPortingV3,
+		"PortalRequestWithdrawReward":         withdrawReward,
+	}
+}
+
+// TestPortalCustodianMetadataHash checks the hash and size of each custodian metadata type against
+// the records and json of the chain's definitions, a difference in either makes the chain reject the txs
+func TestPortalCustodianMetadataHash(t *testing.T) {
+	metaHash := func(metaType int) string {
+		return common.HashH([]byte(strconv.Itoa(metaType))).String()
+	}
+	bnbTokenID := "b2655152784e8639fa19521a7035f331eea1f1e911b2f3200a507ebb4554387b"
+	bnbAddress := "tbnb1fau9kq605jwkyfea2knw495we8cpa47r9r6uxv"
+	btcAddress := "tb1qnrnnm5jsvcmjlqy6m0ycv0jq9km59lfuyxxxqn"
+	blockHash := "0x7d7a3a6e3a6e8d9b5b0a1f4ed0dbf0c9d3b1b7d9c5e4b8f1d3d5c5f6e7a8b9c0"
+	ethTokenID := "0x0000000000000000000000000000000000000000"
+	usdtTokenID := "0xdac17f958d2ee523a2206206994597c13d831ec7"
+	vaultAddress := testVaultAddress.Hex()
+	// remote addresses are recorded in the order of their token IDs, the tx index in decimal
+	remoteRecord := bnbTokenID + bnbAddress + testPortalBTCTokenID + btcAddress
+	remoteJSON := `{"` + bnbTokenID + `":"` + bnbAddress + `","` + testPortalBTCTokenID + `":"` + btcAddress + `"}`
+	proofRecord := "+QIxoJ5Qlf0Q+QJxIIIBbPkCaw"
+	proofJSON := `["+QIxoJ5Qlf0Q","+QJxIIIBbPkCaw"]`
+
+	expected := map[string]struct {
+		record string
+		json   string
+	}{
+		"PortalCustodianDeposit": {
+			metaHash(PortalCustodianDepositMeta) + testIncAddress + remoteRecord + "5000000000",
+			`{"Type":100,"IncogAddressStr":"` + testIncAddress + `","RemoteAddresses":` + remoteJSON + `,"DepositedAmount":5000000000}`,
+		},
+		"PortalCustodianDepositV3": {
+			metaHash(PortalCustodianDepositMetaV3) + remoteRecord + blockHash + "12" + proofRecord,
+			`{"Type":131,"RemoteAddresses":` + remoteJSON + `,"BlockHash":"` + blockHash + `","TxIndex":12,"ProofStrs":` + proofJSON + `}`,
+		},
+		"PortalCustodianWithdrawRequest": {
+			metaHash(PortalCustodianWithdrawRequestMeta) + testIncAddress + "1000000000",
+			`{"Type":110,"PaymentAddress":"` + testIncAddress + `","Amount":1000000000}`,
+		},
+		"PortalCustodianWithdrawRequestV3": {
+			metaHash(PortalCustodianWithdrawRequestMetaV3) + testIncAddress + vaultAddress + usdtTokenID + "1000000",
+			`{"Type":132,"CustodianIncAddress":"` + testIncAddress + `","CustodianExtAddress":"` + vaultAddress +
+				`","ExtTokenID":"` + usdtTokenID + `","Amount":1000000}`,
+		},
+		"PortalLiquidationCustodianDepositV2": {
+			metaHash(PortalCustodianTopupMetaV2) + testIncAddress + testPortalBTCTokenID + "100000000" + "20000000",
+			`{"Type":129,"IncogAddressStr":"` + testIncAddress + `","PTokenId":"` + testPortalBTCTokenID +
+				`","DepositedAmount":100000000,"FreeCollateralAmount":20000000}`,
+		},
+		"PortalLiquidationCustodianDepositV3": {
+			metaHash(PortalCustodianTopupMetaV3) + testIncAddress + testPortalBTCTokenID + ethTokenID + "100000000" + "0" +
+				blockHash + "3" + proofRecord,
+			`{"Type":139,"IncognitoAddress":"` + testIncAddress + `","PortalTokenID":"` + testPortalBTCTokenID +
+				`","CollateralTokenID":"` + ethTokenID + `","DepositAmount":100000000,"FreeTokenCollateralAmount":0,"BlockHash":"` +
+				blockHash + `","TxIndex":3,"ProofStrs":` + proofJSON + `}`,
+		},
+		"PortalTopUpWaitingPortingRequest": {
+			metaHash(PortalTopUpWaitingPortingRequestMeta) + testIncAddress + "porting-1" + testPortalBTCTokenID + "100000000" + "0",
+			`{"Type":202,"IncogAddressStr":"` + testIncAddress + `","PortingID":"porting-1","PTokenID":"` + testPortalBTCTokenID +
+				`","DepositedAmount":100000000,"FreeCollateralAmount":0}`,
+		},
+		"PortalTopUpWaitingPortingRequestV3": {
+			metaHash(PortalTopUpWaitingPortingRequestMetaV3) + testIncAddress + "porting-1" + testPortalBTCTokenID + usdtTokenID + "0" + "500" +
+				rCommon.Hash{}.String() + "0",
+			`{"Type":140,"IncognitoAddress":"` + testIncAddress + `","PortingID":"porting-1","PortalTokenID":"` + testPortalBTCTokenID +
+				`","CollateralTokenID":"` + usdtTokenID + `","DepositAmount":0,"FreeTokenCollateralAmount":500,"BlockHash":"` +
+				rCommon.Hash{}.String() + `","TxIndex":0,"ProofStrs":[]}`,
+		},
+		"PortalRequestWithdrawReward": {
+			metaHash(PortalRequestWithdrawRewardMeta) + testIncAddress + common.PRVCoinID.String(),
+			`{"Type":118,"CustodianAddressStr":"` + testIncAddress + `","TokenID":"` + common.PRVCoinID.String() + `"}`,
+		},
+	}
+
+	mds := newTestPortalCustodianMetadata()
+	assert.Equal(t, len(expected), len(mds))
+	for name, md := range mds {
+		assert.Equal(t, common.HashH([]byte(expected[name].record)).String(), md.Hash().String(), name)
+		assert.Equal(t, uint64(len(expected[name].json)), md.CalculateSize(), name)
+		mdJSON, err := json.Marshal(md)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, expected[name].json, string(mdJSON), name)
+
+		parsed, err := ParseMetadata(md)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, md, parsed)
+	}
+}
+
+func TestPortalCustodianDepositHashOrder(t *testing.T) {
+	// the hash does not depend on the order of the map
+	deposit := newTestPortalCustodianMetadata()["PortalCustodianDeposit"].(*PortalCustodianDeposit)
+	for i := 0; i < 10; i++ {
+		copied := *deposit
+		copied.RemoteAddresses = map[string]string{}
+		for tokenID, remoteAddress := range deposit.RemoteAddresses {
+			copied.RemoteAddresses[tokenID] = remoteAddress
+		}
+		assert.Equal(t, deposit.Hash(), copied.Hash())
+	}
+}
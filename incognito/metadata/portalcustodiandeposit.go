@@ -0,0 +1,160 @@
+package metadata
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// PortalCustodianDeposit - portal custodian deposits PRV collateral,
+// RemoteAddresses are the addresses of the custodian on the external chains by portal token ID
+type PortalCustodianDeposit struct {
+	MetadataBase
+	IncogAddressStr string
+	RemoteAddresses map[string]string
+	DepositedAmount uint64
+}
+
+// PortalCustodianDepositV3 - portal custodian deposits ETH or ERC20 collateral,
+// the proof is the shielding proof of the deposit to the portal contract as in IssuingEVMRequest,
+// the incognito address of the custodian is the one of the deposit event so the metadata does not carry it
+type PortalCustodianDepositV3 struct {
+	MetadataBase
+	RemoteAddresses map[string]string
+	BlockHash       rCommon.Hash
+	TxIndex         uint
+	ProofStrs       []string
+}
+
+// hashRemoteAddresses returns the remote addresses in the order of their token IDs
+func hashRemoteAddresses(remoteAddresses map[string]string) string {
+	tokenIDs := make([]string, 0, len(remoteAddresses))
+	for tokenID := range remoteAddresses {
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	sort.Strings(tokenIDs)
+	record := ""
+	for _, tokenID := range tokenIDs {
+		record += tokenID
+		record += remoteAddresses[tokenID]
+	}
+	return record
+}
+
+// hashEVMProof returns the record of the shielding proof of EVM collateral,
+// unlike IssuingEVMRequest the portal records the tx index in decimal
+func hashEVMProof(blockHash rCommon.Hash, txIndex uint, proofStrs []string) string {
+	record := blockHash.String()
+	record += strconv.FormatUint(uint64(txIndex), 10)
+	for _, proofStr := range proofStrs {
+		record += proofStr
+	}
+	return record
+}
+
+func NewPortalCustodianDeposit(
+	incogAddressStr string,
+	remoteAddresses map[string]string,
+	depositedAmount uint64,
+	metaType int,
+) (*PortalCustodianDeposit, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	custodianDeposit := &PortalCustodianDeposit{
+		IncogAddressStr: incogAddressStr,
+		RemoteAddresses: remoteAddresses,
+		DepositedAmount: depositedAmount,
+	}
+	custodianDeposit.MetadataBase = metadataBase
+	return custodianDeposit, nil
+}
+
+func (custodianDeposit PortalCustodianDeposit) Hash() *common.Hash {
+	record := custodianDeposit.MetadataBase.Hash().String()
+	record += custodianDeposit.IncogAddressStr
+	record += hashRemoteAddresses(custodianDeposit.RemoteAddresses)
+	record += strconv.FormatUint(custodianDeposit.DepositedAmount, 10)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (custodianDeposit *PortalCustodianDeposit) CalculateSize() uint64 {
+	return calculateSize(custodianDeposit)
+}
+
+func NewPortalCustodianDepositV3(
+	remoteAddresses map[string]string,
+	blockHash rCommon.Hash,
+	txIndex uint,
+	proofStrs []string,
+	metaType int,
+) (*PortalCustodianDepositV3, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	custodianDeposit := &PortalCustodianDepositV3{
+		RemoteAddresses: remoteAddresses,
+		BlockHash:       blockHash,
+		TxIndex:         txIndex,
+		ProofStrs:       proofStrs,
+	}
+	custodianDeposit.MetadataBase = metadataBase
+	return custodianDeposit, nil
+}
+
+func (custodianDeposit PortalCustodianDepositV3) Hash() *common.Hash {
+	record := custodianDeposit.MetadataBase.Hash().String()
+	record += hashRemoteAddresses(custodianDeposit.RemoteAddresses)
+	record += hashEVMProof(custodianDeposit.BlockHash, custodianDeposit.TxIndex, custodianDeposit.ProofStrs)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (custodianDeposit *PortalCustodianDepositV3) CalculateSize() uint64 {
+	return calculateSize(custodianDeposit)
+}
+
+func checkRemoteAddresses(remoteAddresses map[string]string) error {
+	if len(remoteAddresses) == 0 {
+		return errors.New("remote addresses are empty")
+	}
+	for tokenID, remoteAddress := range remoteAddresses {
+		if tokenID == "" || remoteAddress == "" {
+			return errors.Errorf("remote address %v of token %v is invalid", remoteAddress, tokenID)
+		}
+	}
+	return nil
+}
+
+func (custodianDeposit PortalCustodianDeposit) ValidateSanityData() error {
+	if err := checkMetaType(custodianDeposit.Type, PortalCustodianDepositMeta); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(custodianDeposit.IncogAddressStr, "custodian address"); err != nil {
+		return err
+	}
+	if err := checkRemoteAddresses(custodianDeposit.RemoteAddresses); err != nil {
+		return err
+	}
+	return checkPositive(custodianDeposit.DepositedAmount, "deposited amount")
+}
+
+func (custodianDeposit PortalCustodianDeposit) BurnedAmounts() map[common.Hash]uint64 {
+	return map[common.Hash]uint64{common.PRVCoinID: custodianDeposit.DepositedAmount}
+}
+
+func (custodianDeposit PortalCustodianDepositV3) ValidateSanityData() error {
+	if err := checkMetaType(custodianDeposit.Type, PortalCustodianDepositMetaV3); err != nil {
+		return err
+	}
+	if err := checkRemoteAddresses(custodianDeposit.RemoteAddresses); err != nil {
+		return err
+	}
+	return checkEVMProof(custodianDeposit.BlockHash, custodianDeposit.ProofStrs)
+}
@@ -0,0 +1,160 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// PortalLiquidationCustodianDepositV2 - portal custodian tops up the PRV collateral of PTokenId,
+// with DepositedAmount from the tx and FreeCollateralAmount from its free collateral
+type PortalLiquidationCustodianDepositV2 struct {
+	MetadataBase
+	IncogAddressStr      string
+	PTokenId             string
+	DepositedAmount      uint64
+	FreeCollateralAmount uint64
+}
+
+// PortalLiquidationCustodianDepositV3 - portal custodian tops up the ETH or ERC20 collateral of PortalTokenID,
+// with DepositAmount shielded by the proof and FreeTokenCollateralAmount from its free collateral
+type PortalLiquidationCustodianDepositV3 struct {
+	MetadataBase
+	IncognitoAddress          string
+	PortalTokenID             string
+	CollateralTokenID         string
+	DepositAmount             uint64
+	FreeTokenCollateralAmount uint64
+	BlockHash                 rCommon.Hash
+	TxIndex                   uint
+	ProofStrs                 []string
+}
+
+func NewPortalLiquidationCustodianDepositV2(
+	incogAddressStr string,
+	pTokenId string,
+	depositedAmount uint64,
+	freeCollateralAmount uint64,
+	metaType int,
+) (*PortalLiquidationCustodianDepositV2, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	custodianDeposit := &PortalLiquidationCustodianDepositV2{
+		IncogAddressStr:      incogAddressStr,
+		PTokenId:             pTokenId,
+		DepositedAmount:      depositedAmount,
+		FreeCollateralAmount: freeCollateralAmount,
+	}
+	custodianDeposit.MetadataBase = metadataBase
+	return custodianDeposit, nil
+}
+
+func (custodianDeposit PortalLiquidationCustodianDepositV2) Hash() *common.Hash {
+	record := custodianDeposit.MetadataBase.Hash().String()
+	record += custodianDeposit.IncogAddressStr
+	record += custodianDeposit.PTokenId
+	record += strconv.FormatUint(custodianDeposit.DepositedAmount, 10)
+	record += strconv.FormatUint(custodianDeposit.FreeCollateralAmount, 10)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (custodianDeposit *PortalLiquidationCustodianDepositV2) CalculateSize() uint64 {
+	return calculateSize(custodianDeposit)
+}
+
+func NewPortalLiquidationCustodianDepositV3(
+	incognitoAddress string,
+	portalTokenID string,
+	collateralTokenID string,
+	depositAmount uint64,
+	freeTokenCollateralAmount uint64,
+	blockHash rCommon.Hash,
+	txIndex uint,
+	proofStrs []string,
+	metaType int,
+) (*PortalLiquidationCustodianDepositV3, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	custodianDeposit := &PortalLiquidationCustodianDepositV3{
+		IncognitoAddress:          incognitoAddress,
+		PortalTokenID:             portalTokenID,
+		CollateralTokenID:         collateralTokenID,
+		DepositAmount:             depositAmount,
+		FreeTokenCollateralAmount: freeTokenCollateralAmount,
+		BlockHash:                 blockHash,
+		TxIndex:                   txIndex,
+		ProofStrs:                 proofStrs,
+	}
+	custodianDeposit.MetadataBase = metadataBase
+	return custodianDeposit, nil
+}
+
+func (custodianDeposit PortalLiquidationCustodianDepositV3) Hash() *common.Hash {
+	record := custodianDeposit.MetadataBase.Hash().String()
+	record += custodianDeposit.IncognitoAddress
+	record += custodianDeposit.PortalTokenID
+	record += custodianDeposit.CollateralTokenID
+	record += strconv.FormatUint(custodianDeposit.DepositAmount, 10)
+	record += strconv.FormatUint(custodianDeposit.FreeTokenCollateralAmount, 10)
+	record += hashEVMProof(custodianDeposit.BlockHash, custodianDeposit.TxIndex, custodianDeposit.ProofStrs)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (custodianDeposit *PortalLiquidationCustodianDepositV3) CalculateSize() uint64 {
+	return calculateSize(custodianDeposit)
+}
+
+// checkTopupAmounts checks a top up deposits or frees some collateral
+func checkTopupAmounts(depositAmount uint64, freeCollateralAmount uint64) error {
+	if depositAmount == 0 && freeCollateralAmount == 0 {
+		return errors.New("deposit amount and free collateral amount are zero")
+	}
+	return nil
+}
+
+func (custodianDeposit PortalLiquidationCustodianDepositV2) ValidateSanityData() error {
+	if err := checkMetaType(custodianDeposit.Type, PortalCustodianTopupMetaV2); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(custodianDeposit.IncogAddressStr, "custodian address"); err != nil {
+		return err
+	}
+	if _, err := checkTokenID(custodianDeposit.PTokenId, "portal token ID"); err != nil {
+		return err
+	}
+	return checkTopupAmounts(custodianDeposit.DepositedAmount, custodianDeposit.FreeCollateralAmount)
+}
+
+func (custodianDeposit PortalLiquidationCustodianDepositV2) BurnedAmounts() map[common.Hash]uint64 {
+	return map[common.Hash]uint64{common.PRVCoinID: custodianDeposit.DepositedAmount}
+}
+
+func (custodianDeposit PortalLiquidationCustodianDepositV3) ValidateSanityData() error {
+	if err := checkMetaType(custodianDeposit.Type, PortalCustodianTopupMetaV3); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(custodianDeposit.IncognitoAddress, "custodian address"); err != nil {
+		return err
+	}
+	if _, err := checkTokenID(custodianDeposit.PortalTokenID, "portal token ID"); err != nil {
+		return err
+	}
+	if err := checkEVMAddress(custodianDeposit.CollateralTokenID, "collateral token ID"); err != nil {
+		return err
+	}
+	if err := checkTopupAmounts(custodianDeposit.DepositAmount, custodianDeposit.FreeTokenCollateralAmount); err != nil {
+		return err
+	}
+	if custodianDeposit.DepositAmount > 0 {
+		return checkEVMProof(custodianDeposit.BlockHash, custodianDeposit.ProofStrs)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+)
+
+// PortalCustodianWithdrawRequest - portal custodian withdraws free PRV collateral
+type PortalCustodianWithdrawRequest struct {
+	MetadataBase
+	PaymentAddress string
+	Amount         uint64
+}
+
+// PortalCustodianWithdrawRequestV3 - portal custodian withdraws free ETH or ERC20 collateral of ExtTokenID
+// to CustodianExtAddress, the beacon confirms it with an instruction to submit to the portal contract
+type PortalCustodianWithdrawRequestV3 struct {
+	MetadataBase
+	CustodianIncAddress string
+	CustodianExtAddress string
+	ExtTokenID          string
+	Amount              uint64
+}
+
+func NewPortalCustodianWithdrawRequest(paymentAddress string, amount uint64, metaType int) (*PortalCustodianWithdrawRequest, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	withdrawReq := &PortalCustodianWithdrawRequest{
+		PaymentAddress: paymentAddress,
+		Amount:         amount,
+	}
+	withdrawReq.MetadataBase = metadataBase
+	return withdrawReq, nil
+}
+
+func (withdrawReq PortalCustodianWithdrawRequest) Hash() *common.Hash {
+	record := withdrawReq.MetadataBase.Hash().String()
+	record += withdrawReq.PaymentAddress
+	record += strconv.FormatUint(withdrawReq.Amount, 10)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (withdrawReq *PortalCustodianWithdrawRequest) CalculateSize() uint64 {
+	return calculateSize(withdrawReq)
+}
+
+func NewPortalCustodianWithdrawRequestV3(
+	custodianIncAddress string,
+	custodianExtAddress string,
+	extTokenID string,
+	amount uint64,
+	metaType int,
+) (*PortalCustodianWithdrawRequestV3, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	withdrawReq := &PortalCustodianWithdrawRequestV3{
+		CustodianIncAddress: custodianIncAddress,
+		CustodianExtAddress: custodianExtAddress,
+		ExtTokenID:          extTokenID,
+		Amount:              amount,
+	}
+	withdrawReq.MetadataBase = metadataBase
+	return withdrawReq, nil
+}
+
+func (withdrawReq PortalCustodianWithdrawRequestV3) Hash() *common.Hash {
+	record := withdrawReq.MetadataBase.Hash().String()
+	record += withdrawReq.CustodianIncAddress
+	record += withdrawReq.CustodianExtAddress
+	record += withdrawReq.ExtTokenID
+	record += strconv.FormatUint(withdrawReq.Amount, 10)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (withdrawReq *PortalCustodianWithdrawRequestV3) CalculateSize() uint64 {
+	return calculateSize(withdrawReq)
+}
+
+func (withdrawReq PortalCustodianWithdrawRequest) ValidateSanityData() error {
+	if err := checkMetaType(withdrawReq.Type, PortalCustodianWithdrawRequestMeta); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(withdrawReq.PaymentAddress, "custodian address"); err != nil {
+		return err
+	}
+	return checkPositive(withdrawReq.Amount, "withdrawal amount")
+}
+
+func (withdrawReq PortalCustodianWithdrawRequestV3) ValidateSanityData() error {
+	if err := checkMetaType(withdrawReq.Type, PortalCustodianWithdrawRequestMetaV3); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(withdrawReq.CustodianIncAddress, "custodian incognito address"); err != nil {
+		return err
+	}
+	if err := checkEVMAddress(withdrawReq.CustodianExtAddress, "custodian external address"); err != nil {
+		return err
+	}
+	if err := checkEVMAddress(withdrawReq.ExtTokenID, "collateral token ID"); err != nil {
+		return err
+	}
+	return checkPositive(withdrawReq.Amount, "withdrawal amount")
+}
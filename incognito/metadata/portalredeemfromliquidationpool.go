@@ -0,0 +1,81 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+)
+
+// PortalRedeemFromLiquidationPool - portal user burns ptokens for the collateral of liquidated custodians,
+// the collateral is in PRV (v2) or in ETH/ERC20 sent to RedeemerExtAddressStr (v3)
+type PortalRedeemFromLiquidationPool struct {
+	MetadataBase
+	TokenID               string
+	RedeemAmount          uint64
+	RedeemerIncAddressStr string
+	RedeemerExtAddressStr string
+}
+
+func NewPortalRedeemFromLiquidationPool(
+	tokenID string,
+	redeemAmount uint64,
+	redeemerIncAddressStr string,
+	redeemerExtAddressStr string,
+	metaType int,
+) (*PortalRedeemFromLiquidationPool, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	redeemReq := &PortalRedeemFromLiquidationPool{
+		TokenID:               tokenID,
+		RedeemAmount:          redeemAmount,
+		RedeemerIncAddressStr: redeemerIncAddressStr,
+		RedeemerExtAddressStr: redeemerExtAddressStr,
+	}
+	redeemReq.MetadataBase = metadataBase
+	return redeemReq, nil
+}
+
+func (redeemReq PortalRedeemFromLiquidationPool) Hash() *common.Hash {
+	record := redeemReq.MetadataBase.Hash().String()
+	record += redeemReq.TokenID
+	record += strconv.FormatUint(redeemReq.RedeemAmount, 10)
+	record += redeemReq.RedeemerIncAddressStr
+	if redeemReq.Type == PortalRedeemFromLiquidationPoolMetaV3 {
+		record += redeemReq.RedeemerExtAddressStr
+	}
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (redeemReq *PortalRedeemFromLiquidationPool) CalculateSize() uint64 {
+	return calculateSize(redeemReq)
+}
+
+func (redeemReq PortalRedeemFromLiquidationPool) ValidateSanityData() error {
+	if err := checkMetaType(redeemReq.Type, PortalRedeemFromLiquidationPoolMeta, PortalRedeemFromLiquidationPoolMetaV3); err != nil {
+		return err
+	}
+	if _, err := checkTokenID(redeemReq.TokenID, "portal token ID"); err != nil {
+		return err
+	}
+	if err := checkPositive(redeemReq.RedeemAmount, "redeem amount"); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(redeemReq.RedeemerIncAddressStr, "redeemer incognito address"); err != nil {
+		return err
+	}
+	if redeemReq.Type == PortalRedeemFromLiquidationPoolMetaV3 {
+		return checkEVMAddress(redeemReq.RedeemerExtAddressStr, "redeemer external address")
+	}
+	return nil
+}
+
+func (redeemReq PortalRedeemFromLiquidationPool) BurnedAmounts() map[common.Hash]uint64 {
+	tokenID, err := common.Hash{}.NewHashFromStr(redeemReq.TokenID)
+	if err != nil {
+		return nil
+	}
+	return map[common.Hash]uint64{*tokenID: redeemReq.RedeemAmount}
+}
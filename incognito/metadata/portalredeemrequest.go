@@ -0,0 +1,104 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+)
+
+// PortalRedeemRequest - portal user redeems public tokens to RemoteAddress on the external chain,
+// the tx burns RedeemAmount of the ptoken and pays RedeemFee in PRV.
+// RedeemerExternalAddress is the ETH address receiving the collateral if the custodians are liquidated (v3)
+type PortalRedeemRequest struct {
+	MetadataBase
+	UniqueRedeemID          string
+	TokenID                 string
+	RedeemAmount            uint64
+	RedeemerIncAddressStr   string
+	RemoteAddress           string
+	RedeemFee               uint64
+	RedeemerExternalAddress string
+}
+
+func NewPortalRedeemRequest(
+	uniqueRedeemID string,
+	tokenID string,
+	redeemAmount uint64,
+	redeemerIncAddressStr string,
+	remoteAddr string,
+	redeemFee uint64,
+	redeemerExternalAddress string,
+	metaType int,
+) (*PortalRedeemRequest, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	portalRedeemRequest := &PortalRedeemRequest{
+		UniqueRedeemID:          uniqueRedeemID,
+		TokenID:                 tokenID,
+		RedeemAmount:            redeemAmount,
+		RedeemerIncAddressStr:   redeemerIncAddressStr,
+		RemoteAddress:           remoteAddr,
+		RedeemFee:               redeemFee,
+		RedeemerExternalAddress: redeemerExternalAddress,
+	}
+	portalRedeemRequest.MetadataBase = metadataBase
+	return portalRedeemRequest, nil
+}
+
+func (redeemReq PortalRedeemRequest) Hash() *common.Hash {
+	record := redeemReq.MetadataBase.Hash().String()
+	record += redeemReq.UniqueRedeemID
+	record += redeemReq.TokenID
+	record += strconv.FormatUint(redeemReq.RedeemAmount, 10)
+	record += strconv.FormatUint(redeemReq.RedeemFee, 10)
+	record += redeemReq.RedeemerIncAddressStr
+	record += redeemReq.RemoteAddress
+	if redeemReq.Type == PortalRedeemRequestMetaV3 {
+		record += redeemReq.RedeemerExternalAddress
+	}
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (redeemReq *PortalRedeemRequest) CalculateSize() uint64 {
+	return calculateSize(redeemReq)
+}
+
+func (redeemReq PortalRedeemRequest) ValidateSanityData() error {
+	if err := checkMetaType(redeemReq.Type, PortalRedeemRequestMeta, PortalRedeemRequestMetaV3); err != nil {
+		return err
+	}
+	if err := checkNotEmpty(redeemReq.UniqueRedeemID, "redeem ID"); err != nil {
+		return err
+	}
+	if _, err := checkTokenID(redeemReq.TokenID, "portal token ID"); err != nil {
+		return err
+	}
+	if err := checkPositive(redeemReq.RedeemAmount, "redeem amount"); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(redeemReq.RedeemerIncAddressStr, "redeemer incognito address"); err != nil {
+		return err
+	}
+	if err := checkNotEmpty(redeemReq.RemoteAddress, "remote address"); err != nil {
+		return err
+	}
+	if err := checkPositive(redeemReq.RedeemFee, "redeem fee"); err != nil {
+		return err
+	}
+	if redeemReq.Type == PortalRedeemRequestMetaV3 {
+		return checkEVMAddress(redeemReq.RedeemerExternalAddress, "redeemer external address")
+	}
+	return nil
+}
+
+// BurnedAmounts of a redeem request, the redeem fee is paid in PRV
+func (redeemReq PortalRedeemRequest) BurnedAmounts() map[common.Hash]uint64 {
+	tokenID, err := common.Hash{}.NewHashFromStr(redeemReq.TokenID)
+	if err != nil {
+		return nil
+	}
+	return map[common.Hash]uint64{*tokenID: redeemReq.RedeemAmount, common.PRVCoinID: redeemReq.RedeemFee}
+}
@@ -0,0 +1,75 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+)
+
+// PortalRequestPTokens - portal user requests the ptokens of a porting request,
+// PortingProof is the proof of the transfer of the public tokens to the custodians on the external chain
+type PortalRequestPTokens struct {
+	MetadataBase
+	UniquePortingID string
+	TokenID         string
+	IncogAddressStr string
+	PortingAmount   uint64
+	PortingProof    string
+}
+
+func NewPortalRequestPTokens(
+	uniquePortingID string,
+	tokenID string,
+	incogAddressStr string,
+	portingAmount uint64,
+	portingProof string,
+	metaType int,
+) (*PortalRequestPTokens, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	requestPTokens := &PortalRequestPTokens{
+		UniquePortingID: uniquePortingID,
+		TokenID:         tokenID,
+		IncogAddressStr: incogAddressStr,
+		PortingAmount:   portingAmount,
+		PortingProof:    portingProof,
+	}
+	requestPTokens.MetadataBase = metadataBase
+	return requestPTokens, nil
+}
+
+func (reqPToken PortalRequestPTokens) Hash() *common.Hash {
+	record := reqPToken.MetadataBase.Hash().String()
+	record += reqPToken.UniquePortingID
+	record += reqPToken.TokenID
+	record += reqPToken.IncogAddressStr
+	record += strconv.FormatUint(reqPToken.PortingAmount, 10)
+	record += reqPToken.PortingProof
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (reqPToken *PortalRequestPTokens) CalculateSize() uint64 {
+	return calculateSize(reqPToken)
+}
+
+func (reqPToken PortalRequestPTokens) ValidateSanityData() error {
+	if err := checkMetaType(reqPToken.Type, PortalUserRequestPTokenMeta); err != nil {
+		return err
+	}
+	if err := checkNotEmpty(reqPToken.UniquePortingID, "porting ID"); err != nil {
+		return err
+	}
+	if _, err := checkTokenID(reqPToken.TokenID, "portal token ID"); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(reqPToken.IncogAddressStr, "incognito address"); err != nil {
+		return err
+	}
+	if err := checkPositive(reqPToken.PortingAmount, "porting amount"); err != nil {
+		return err
+	}
+	return checkNotEmpty(reqPToken.PortingProof, "porting proof")
+}
@@ -0,0 +1,44 @@
+package metadata
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+)
+
+// PortalRequestWithdrawReward - portal custodian withdraws its rewards in TokenID
+type PortalRequestWithdrawReward struct {
+	MetadataBase
+	CustodianAddressStr string
+	TokenID             common.Hash
+}
+
+func NewPortalRequestWithdrawReward(custodianAddressStr string, tokenID common.Hash, metaType int) (*PortalRequestWithdrawReward, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	withdrawRewardReq := &PortalRequestWithdrawReward{
+		CustodianAddressStr: custodianAddressStr,
+		TokenID:             tokenID,
+	}
+	withdrawRewardReq.MetadataBase = metadataBase
+	return withdrawRewardReq, nil
+}
+
+func (withdrawRewardReq PortalRequestWithdrawReward) Hash() *common.Hash {
+	record := withdrawRewardReq.MetadataBase.Hash().String()
+	record += withdrawRewardReq.CustodianAddressStr
+	record += withdrawRewardReq.TokenID.String()
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (withdrawRewardReq *PortalRequestWithdrawReward) CalculateSize() uint64 {
+	return calculateSize(withdrawRewardReq)
+}
+
+func (withdrawRewardReq PortalRequestWithdrawReward) ValidateSanityData() error {
+	if err := checkMetaType(withdrawRewardReq.Type, PortalRequestWithdrawRewardMeta); err != nil {
+		return err
+	}
+	return checkPaymentAddress(withdrawRewardReq.CustodianAddressStr, "custodian address")
+}
@@ -0,0 +1,164 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	rCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// PortalTopUpWaitingPortingRequest - portal custodian tops up the PRV collateral it holds for a waiting porting request
+type PortalTopUpWaitingPortingRequest struct {
+	MetadataBase
+	IncogAddressStr      string
+	PortingID            string
+	PTokenID             string
+	DepositedAmount      uint64
+	FreeCollateralAmount uint64
+}
+
+// PortalTopUpWaitingPortingRequestV3 - portal custodian tops up the ETH or ERC20 collateral
+// it holds for a waiting porting request
+type PortalTopUpWaitingPortingRequestV3 struct {
+	MetadataBase
+	IncognitoAddress          string
+	PortingID                 string
+	PortalTokenID             string
+	CollateralTokenID         string
+	DepositAmount             uint64
+	FreeTokenCollateralAmount uint64
+	BlockHash                 rCommon.Hash
+	TxIndex                   uint
+	ProofStrs                 []string
+}
+
+func NewPortalTopUpWaitingPortingRequest(
+	incogAddressStr string,
+	portingID string,
+	pTokenID string,
+	depositedAmount uint64,
+	freeCollateralAmount uint64,
+	metaType int,
+) (*PortalTopUpWaitingPortingRequest, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	topUpReq := &PortalTopUpWaitingPortingRequest{
+		IncogAddressStr:      incogAddressStr,
+		PortingID:            portingID,
+		PTokenID:             pTokenID,
+		DepositedAmount:      depositedAmount,
+		FreeCollateralAmount: freeCollateralAmount,
+	}
+	topUpReq.MetadataBase = metadataBase
+	return topUpReq, nil
+}
+
+func (topUpReq PortalTopUpWaitingPortingRequest) Hash() *common.Hash {
+	record := topUpReq.MetadataBase.Hash().String()
+	record += topUpReq.IncogAddressStr
+	record += topUpReq.PortingID
+	record += topUpReq.PTokenID
+	record += strconv.FormatUint(topUpReq.DepositedAmount, 10)
+	record += strconv.FormatUint(topUpReq.FreeCollateralAmount, 10)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (topUpReq *PortalTopUpWaitingPortingRequest) CalculateSize() uint64 {
+	return calculateSize(topUpReq)
+}
+
+func NewPortalTopUpWaitingPortingRequestV3(
+	incognitoAddress string,
+	portingID string,
+	portalTokenID string,
+	collateralTokenID string,
+	depositAmount uint64,
+	freeTokenCollateralAmount uint64,
+	blockHash rCommon.Hash,
+	txIndex uint,
+	proofStrs []string,
+	metaType int,
+) (*PortalTopUpWaitingPortingRequestV3, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	topUpReq := &PortalTopUpWaitingPortingRequestV3{
+		IncognitoAddress:          incognitoAddress,
+		PortingID:                 portingID,
+		PortalTokenID:             portalTokenID,
+		CollateralTokenID:         collateralTokenID,
+		DepositAmount:             depositAmount,
+		FreeTokenCollateralAmount: freeTokenCollateralAmount,
+		BlockHash:                 blockHash,
+		TxIndex:                   txIndex,
+		ProofStrs:                 proofStrs,
+	}
+	topUpReq.MetadataBase = metadataBase
+	return topUpReq, nil
+}
+
+func (topUpReq PortalTopUpWaitingPortingRequestV3) Hash() *common.Hash {
+	record := topUpReq.MetadataBase.Hash().String()
+	record += topUpReq.IncognitoAddress
+	record += topUpReq.PortingID
+	record += topUpReq.PortalTokenID
+	record += topUpReq.CollateralTokenID
+	record += strconv.FormatUint(topUpReq.DepositAmount, 10)
+	record += strconv.FormatUint(topUpReq.FreeTokenCollateralAmount, 10)
+	record += hashEVMProof(topUpReq.BlockHash, topUpReq.TxIndex, topUpReq.ProofStrs)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (topUpReq *PortalTopUpWaitingPortingRequestV3) CalculateSize() uint64 {
+	return calculateSize(topUpReq)
+}
+
+func (topUpReq PortalTopUpWaitingPortingRequest) ValidateSanityData() error {
+	if err := checkMetaType(topUpReq.Type, PortalTopUpWaitingPortingRequestMeta); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(topUpReq.IncogAddressStr, "custodian address"); err != nil {
+		return err
+	}
+	if err := checkNotEmpty(topUpReq.PortingID, "porting ID"); err != nil {
+		return err
+	}
+	if _, err := checkTokenID(topUpReq.PTokenID, "portal token ID"); err != nil {
+		return err
+	}
+	return checkTopupAmounts(topUpReq.DepositedAmount, topUpReq.FreeCollateralAmount)
+}
+
+func (topUpReq PortalTopUpWaitingPortingRequest) BurnedAmounts() map[common.Hash]uint64 {
+	return map[common.Hash]uint64{common.PRVCoinID: topUpReq.DepositedAmount}
+}
+
+func (topUpReq PortalTopUpWaitingPortingRequestV3) ValidateSanityData() error {
+	if err := checkMetaType(topUpReq.Type, PortalTopUpWaitingPortingRequestMetaV3); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(topUpReq.IncognitoAddress, "custodian address"); err != nil {
+		return err
+	}
+	if err := checkNotEmpty(topUpReq.PortingID, "porting ID"); err != nil {
+		return err
+	}
+	if _, err := checkTokenID(topUpReq.PortalTokenID, "portal token ID"); err != nil {
+		return err
+	}
+	if err := checkEVMAddress(topUpReq.CollateralTokenID, "collateral token ID"); err != nil {
+		return err
+	}
+	if err := checkTopupAmounts(topUpReq.DepositAmount, topUpReq.FreeTokenCollateralAmount); err != nil {
+		return err
+	}
+	if topUpReq.DepositAmount > 0 {
+		return checkEVMProof(topUpReq.BlockHash, topUpReq.ProofStrs)
+	}
+	return nil
+}
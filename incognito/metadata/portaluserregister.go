@@ -0,0 +1,79 @@
+package metadata
+
+import (
+	"strconv"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+)
+
+// PortalUserRegister - portal user requests porting of public tokens,
+// the tx pays PortingFee in PRV to the burning address
+type PortalUserRegister struct {
+	MetadataBase
+	UniqueRegisterId string
+	IncogAddressStr  string
+	PTokenId         string
+	RegisterAmount   uint64
+	PortingFee       uint64
+}
+
+func NewPortalUserRegister(
+	uniqueRegisterId string,
+	incogAddressStr string,
+	pTokenId string,
+	registerAmount uint64,
+	portingFee uint64,
+	metaType int,
+) (*PortalUserRegister, error) {
+	metadataBase := MetadataBase{
+		Type: metaType,
+	}
+	portalUserRegister := &PortalUserRegister{
+		UniqueRegisterId: uniqueRegisterId,
+		IncogAddressStr:  incogAddressStr,
+		PTokenId:         pTokenId,
+		RegisterAmount:   registerAmount,
+		PortingFee:       portingFee,
+	}
+	portalUserRegister.MetadataBase = metadataBase
+	return portalUserRegister, nil
+}
+
+func (portalUserRegister PortalUserRegister) Hash() *common.Hash {
+	record := portalUserRegister.MetadataBase.Hash().String()
+	record += portalUserRegister.UniqueRegisterId
+	record += portalUserRegister.IncogAddressStr
+	record += portalUserRegister.PTokenId
+	record += strconv.FormatUint(portalUserRegister.RegisterAmount, 10)
+	record += strconv.FormatUint(portalUserRegister.PortingFee, 10)
+	// final hash
+	hash := common.HashH([]byte(record))
+	return &hash
+}
+
+func (portalUserRegister *PortalUserRegister) CalculateSize() uint64 {
+	return calculateSize(portalUserRegister)
+}
+
+func (portalUserRegister PortalUserRegister) ValidateSanityData() error {
+	if err := checkMetaType(portalUserRegister.Type, PortalRequestPortingMeta, PortalRequestPortingMetaV3); err != nil {
+		return err
+	}
+	if err := checkNotEmpty(portalUserRegister.UniqueRegisterId, "porting ID"); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(portalUserRegister.IncogAddressStr, "incognito address"); err != nil {
+		return err
+	}
+	if _, err := checkTokenID(portalUserRegister.PTokenId, "portal token ID"); err != nil {
+		return err
+	}
+	if err := checkPositive(portalUserRegister.RegisterAmount, "porting amount"); err != nil {
+		return err
+	}
+	return checkPositive(portalUserRegister.PortingFee, "porting fee")
+}
+
+func (portalUserRegister PortalUserRegister) BurnedAmounts() map[common.Hash]uint64 {
+	return map[common.Hash]uint64{common.PRVCoinID: portalUserRegister.PortingFee}
+}
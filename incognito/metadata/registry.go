@@ -0,0 +1,81 @@
+package metadata
+
+import (
+	"sort"
+	"sync"
+)
+
+// MetadataFactory returns a new empty metadata of a type, ParseMetadata unmarshals into it
+type MetadataFactory func() Metadata
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[int]MetadataFactory{}
+)
+
+// Register makes ParseMetadata parse the metadata type with the factory,
+// registering a type again replaces its factory so apps can add or override types of forked networks
+func Register(metaType int, factory MetadataFactory) {
+	if factory == nil {
+		panic("metadata: Register factory is nil")
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[metaType] = factory
+}
+
+// RegisteredTypes returns the registered metadata types in increasing order
+func RegisteredTypes() []int {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	res := make([]int, 0, len(registry))
+	for metaType := range registry {
+		res = append(res, metaType)
+	}
+	sort.Ints(res)
+	return res
+}
+
+func getFactory(metaType int) (MetadataFactory, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	factory, ok := registry[metaType]
+	return factory, ok
+}
+
+func init() {
+	Register(IssuingETHRequestMeta, func() Metadata { return &IssuingEVMRequest{} })
+	Register(IssuingBSCRequestMeta, func() Metadata { return &IssuingEVMRequest{} })
+	Register(BurningRequestMeta, func() Metadata { return &BurningRequest{} })
+	Register(BurningRequestMetaV2, func() Metadata { return &BurningRequest{} })
+	Register(BurningPBSCRequestMeta, func() Metadata { return &BurningRequest{} })
+	Register(BurningForDepositToSCRequestMeta, func() Metadata { return &BurningRequest{} })
+	Register(BurningForDepositToSCRequestMetaV2, func() Metadata { return &BurningRequest{} })
+	Register(ShardStakingMeta, func() Metadata { return &StakingMetadata{} })
+	Register(BeaconStakingMeta, func() Metadata { return &StakingMetadata{} })
+	Register(WithDrawRewardRequestMeta, func() Metadata { return &WithDrawRewardRequest{} })
+	Register(StopAutoStakingMeta, func() Metadata { return &StopAutoStakingMetadata{} })
+	Register(UnStakingMeta, func() Metadata { return &UnStakingRequest{} })
+	Register(PDEContributionMeta, func() Metadata { return &PDEContribution{} })
+	Register(PDEPRVRequiredContributionRequestMeta, func() Metadata { return &PDEContribution{} })
+	Register(PDETradeRequestMeta, func() Metadata { return &PDETradeRequest{} })
+	Register(PDECrossPoolTradeRequestMeta, func() Metadata { return &PDECrossPoolTradeRequest{} })
+	Register(PDEWithdrawalRequestMeta, func() Metadata { return &PDEWithdrawalRequest{} })
+	Register(PDEFeeWithdrawalRequestMeta, func() Metadata { return &PDEFeeWithdrawalRequest{} })
+	Register(PortalRequestPortingMeta, func() Metadata { return &PortalUserRegister{} })
+	Register(PortalRequestPortingMetaV3, func() Metadata { return &PortalUserRegister{} })
+	Register(PortalUserRequestPTokenMeta, func() Metadata { return &PortalRequestPTokens{} })
+	Register(PortalRedeemRequestMeta, func() Metadata { return &PortalRedeemRequest{} })
+	Register(PortalRedeemRequestMetaV3, func() Metadata { return &PortalRedeemRequest{} })
+	Register(PortalRedeemFromLiquidationPoolMeta, func() Metadata { return &PortalRedeemFromLiquidationPool{} })
+	Register(PortalRedeemFromLiquidationPoolMetaV3, func() Metadata { return &PortalRedeemFromLiquidationPool{} })
+	Register(PortalCustodianDepositMeta, func() Metadata { return &PortalCustodianDeposit{} })
+	Register(PortalCustodianDepositMetaV3, func() Metadata { return &PortalCustodianDepositV3{} })
+	Register(PortalCustodianWithdrawRequestMeta, func() Metadata { return &PortalCustodianWithdrawRequest{} })
+	Register(PortalCustodianWithdrawRequestMetaV3, func() Metadata { return &PortalCustodianWithdrawRequestV3{} })
+	Register(PortalCustodianTopupMetaV2, func() Metadata { return &PortalLiquidationCustodianDepositV2{} })
+	Register(PortalCustodianTopupMetaV3, func() Metadata { return &PortalLiquidationCustodianDepositV3{} })
+	Register(PortalTopUpWaitingPortingRequestMeta, func() Metadata { return &PortalTopUpWaitingPortingRequest{} })
+	Register(PortalTopUpWaitingPortingRequestMetaV3, func() Metadata { return &PortalTopUpWaitingPortingRequestV3{} })
+	Register(PortalRequestWithdrawRewardMeta, func() Metadata { return &PortalRequestWithdrawReward{} })
+}
@@ -0,0 +1,124 @@
+package metadata
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fillTestValues sets every exported field of v to a non-zero value derived from seed
+func fillTestValues(v reflect.Value, seed int) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		fillTestValues(v.Elem(), seed)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				fillTestValues(v.Field(i), seed+i+1)
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fillTestValues(v.Index(i), seed+i)
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			fillTestValues(v.Index(i), seed+i)
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		elem := reflect.New(v.Type().Elem()).Elem()
+		fillTestValues(key, seed)
+		fillTestValues(elem, seed+1)
+		v.SetMapIndex(key, elem)
+	case reflect.String:
+		v.SetString("value" + string(rune('a'+seed%26)))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(seed%100 + 1))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(seed%100 + 1))
+	}
+}
+
+func TestParseMetadataRoundTrip(t *testing.T) {
+	metaTypes := RegisteredTypes()
+	assert.NotEqual(t, 0, len(metaTypes))
+	for _, metaType := range metaTypes {
+		factory, ok := getFactory(metaType)
+		assert.Equal(t, true, ok)
+		meta := factory()
+		fillTestValues(reflect.ValueOf(meta), metaType)
+		reflect.ValueOf(meta).Elem().FieldByName("MetadataBase").Set(reflect.ValueOf(MetadataBase{Type: metaType}))
+
+		parsed, err := ParseMetadata(meta)
+		assert.Equal(t, nil, err, "type %v", metaType)
+		assert.Equal(t, meta, parsed, "type %v", metaType)
+		assert.Equal(t, metaType, parsed.GetType())
+		assert.Equal(t, meta.Hash(), parsed.Hash(), "type %v", metaType)
+		assert.Equal(t, meta.CalculateSize(), parsed.CalculateSize(), "type %v", metaType)
+	}
+}
+
+func TestParseMetadataInvalidType(t *testing.T) {
+	meta, err := ParseMetadata(nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, meta)
+
+	for _, raw := range []interface{}{
+		map[string]interface{}{"TxReqID": "abc"},
+		map[string]interface{}{"Type": "91"},
+		map[string]interface{}{"Type": 91.5},
+		"not an object",
+	} {
+		_, err := ParseMetadata(raw)
+		assert.NotEqual(t, nil, err)
+	}
+
+	_, err = ParseMetadata(map[string]interface{}{"Type": "91"})
+	metaErr, ok := err.(*MetadataError)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, ErrCodeMessage[InvalidMetadataTypeErr].Code, metaErr.Code)
+
+	_, err = ParseMetadata(map[string]interface{}{"Type": -7})
+	metaErr, ok = err.(*MetadataError)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, ErrCodeMessage[UnregisteredMetadataTypeErr].Code, metaErr.Code)
+
+	_, err = ParseMetadata(map[string]interface{}{"Type": PDETradeRequestMeta, "TokenIDToBuyStr": 1})
+	metaErr, ok = err.(*MetadataError)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, ErrCodeMessage[ParseMetadataErr].Code, metaErr.Code)
+}
+
+type testForkedMetadata struct {
+	MetadataBase
+	Memo string
+}
+
+func TestRegisterMetadata(t *testing.T) {
+	const forkedMeta = 9001
+	_, err := ParseMetadata(testForkedMetadata{MetadataBase{forkedMeta}, "memo"})
+	assert.NotEqual(t, nil, err)
+
+	Register(forkedMeta, func() Metadata { return &testForkedMetadata{} })
+	defer func() {
+		registryMu.Lock()
+		delete(registry, forkedMeta)
+		registryMu.Unlock()
+	}()
+	assert.Contains(t, RegisteredTypes(), forkedMeta)
+
+	meta, err := ParseMetadata(testForkedMetadata{MetadataBase{forkedMeta}, "memo"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, &testForkedMetadata{MetadataBase{forkedMeta}, "memo"}, meta)
+
+	assert.Panics(t, func() { Register(forkedMeta, nil) })
+}
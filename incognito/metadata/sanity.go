@@ -0,0 +1,137 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+	"github.com/0xkraken/incognito-wasm/incognito/privacy"
+	"github.com/0xkraken/incognito-wasm/incognito/wallet"
+	rCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// BurningMetadata is a metadata whose tx pays the burning address
+type BurningMetadata interface {
+	Metadata
+	// BurnedAmounts returns the amount of each token the tx must pay the burning address
+	BurnedAmounts() map[common.Hash]uint64
+}
+
+func checkMetaType(metaType int, allowedTypes ...int) error {
+	for _, allowedType := range allowedTypes {
+		if metaType == allowedType {
+			return nil
+		}
+	}
+	return errors.Errorf("metadata type %v is invalid", metaType)
+}
+
+func checkPaymentAddress(addressStr string, field string) error {
+	keyWallet, err := wallet.Base58CheckDeserialize(addressStr)
+	if err != nil {
+		return errors.Wrapf(err, "%v %v is not a payment address", field, addressStr)
+	}
+	if len(keyWallet.KeySet.PaymentAddress.Pk) == 0 {
+		return errors.Errorf("%v %v is not a payment address", field, addressStr)
+	}
+	return nil
+}
+
+func checkTokenID(tokenIDStr string, field string) (*common.Hash, error) {
+	tokenID, err := common.Hash{}.NewHashFromStr(tokenIDStr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%v %v is not a token ID", field, tokenIDStr)
+	}
+	return tokenID, nil
+}
+
+func checkNotEmpty(value string, field string) error {
+	if value == "" {
+		return errors.Errorf("%v is empty", field)
+	}
+	return nil
+}
+
+func checkPositive(amount uint64, field string) error {
+	if amount == 0 {
+		return errors.Errorf("%v is zero", field)
+	}
+	return nil
+}
+
+// checkEVMAddress accepts a hex Ethereum or BSC address, with or without the 0x prefix
+func checkEVMAddress(addressStr string, field string) error {
+	if !rCommon.IsHexAddress(addressStr) {
+		return errors.Errorf("%v %v is not an EVM address", field, addressStr)
+	}
+	return nil
+}
+
+func checkCommitteePublicKey(committeePublicKey string) error {
+	pubKey := new(incognitokey.CommitteePublicKey)
+	if err := pubKey.FromBase58(committeePublicKey); err != nil {
+		return errors.Wrap(err, "committee public key is invalid")
+	}
+	if !pubKey.CheckSanityData() {
+		return errors.New("committee public key is invalid")
+	}
+	return nil
+}
+
+func checkEVMProof(blockHash rCommon.Hash, proofStrs []string) error {
+	if blockHash == (rCommon.Hash{}) {
+		return errors.New("block hash is empty")
+	}
+	if len(proofStrs) == 0 {
+		return errors.New("receipt proof is empty")
+	}
+	return nil
+}
+
+// IsBurningAddress reports whether a payment address is the burning address
+func IsBurningAddress(paymentAddress privacy.PaymentAddress) bool {
+	keyWallet, err := wallet.Base58CheckDeserialize(BurningAddress)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(paymentAddress.Pk, keyWallet.KeySet.PaymentAddress.Pk)
+}
+
+// ValidateBurnedAmounts checks that the payments of a tx to the burning address, by token ID,
+// are the amounts its metadata claims, metadata which burn nothing are not checked
+func ValidateBurnedAmounts(meta Metadata, payments map[common.Hash][]*privacy.PaymentInfo) error {
+	burningMeta, ok := meta.(BurningMetadata)
+	if !ok {
+		return nil
+	}
+	burned := map[common.Hash]uint64{}
+	for tokenID, paymentInfos := range payments {
+		for _, paymentInfo := range paymentInfos {
+			if IsBurningAddress(paymentInfo.PaymentAddress) {
+				burned[tokenID] += paymentInfo.Amount
+			}
+		}
+	}
+	claimed := burningMeta.BurnedAmounts()
+	for tokenID, amount := range claimed {
+		if burned[tokenID] != amount {
+			return errors.Errorf("tx burns %v of token %v, metadata claims %v", burned[tokenID], tokenID.String(), amount)
+		}
+	}
+	for tokenID, amount := range burned {
+		if _, ok := claimed[tokenID]; !ok {
+			return errors.Errorf("tx burns %v of token %v, metadata claims 0", amount, tokenID.String())
+		}
+	}
+	return nil
+}
+
+// checkHexRemoteAddress accepts a remote address in hex without the 0x prefix, as the bridge burning instructions need
+func checkHexRemoteAddress(remoteAddress string) error {
+	if _, err := hex.DecodeString(remoteAddress); err != nil || remoteAddress == "" {
+		return errors.Errorf("remote address %v is not hex", remoteAddress)
+	}
+	return nil
+}
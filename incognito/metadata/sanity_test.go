@@ -0,0 +1,133 @@
+package metadata
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+	"github.com/0xkraken/incognito-wasm/incognito/privacy"
+	"github.com/0xkraken/incognito-wasm/incognito/wallet"
+	"github.com/stretchr/testify/assert"
+)
+
+func testPaymentAddress(t *testing.T, addressStr string) privacy.PaymentAddress {
+	keyWallet, err := wallet.Base58CheckDeserialize(addressStr)
+	assert.Equal(t, nil, err)
+	return keyWallet.KeySet.PaymentAddress
+}
+
+func TestValidateSanityDataEmptyMetadata(t *testing.T) {
+	// every registered type has its own checks, an empty metadata of the type is invalid
+	for _, metaType := range RegisteredTypes() {
+		factory, _ := getFactory(metaType)
+		meta := factory()
+		reflect.ValueOf(meta).Elem().FieldByName("MetadataBase").Set(reflect.ValueOf(MetadataBase{Type: metaType}))
+		assert.NotEqual(t, nil, meta.ValidateSanityData(), "type %v", metaType)
+	}
+}
+
+func TestBurningRequestValidateSanityData(t *testing.T) {
+	tokenID := common.HashH([]byte("pETH"))
+	req, _ := NewBurningRequest(testPaymentAddress(t, testIncAddress), 100, tokenID, "pETH",
+		"e722d8b71dcc0152d47c3521baa9ae3c71e9b4a3", BurningRequestMetaV2)
+	assert.Equal(t, nil, req.ValidateSanityData())
+
+	bad := *req
+	bad.BurningAmount = 0
+	assert.NotEqual(t, nil, bad.ValidateSanityData())
+	bad = *req
+	bad.RemoteAddress = "not hex"
+	assert.NotEqual(t, nil, bad.ValidateSanityData())
+	bad = *req
+	bad.TokenID = common.PRVCoinID
+	assert.NotEqual(t, nil, bad.ValidateSanityData())
+	bad = *req
+	bad.Type = IssuingETHRequestMeta
+	assert.NotEqual(t, nil, bad.ValidateSanityData())
+}
+
+func TestPDETradeRequestValidateSanityData(t *testing.T) {
+	prv := common.PRVCoinID.String()
+	req, _ := NewPDETradeRequest(testPortalBTCTokenID, prv, 1000, 1, 10, testIncAddress, PDETradeRequestMeta)
+	assert.Equal(t, nil, req.ValidateSanityData())
+
+	bad := *req
+	bad.TokenIDToBuyStr = prv
+	assert.NotEqual(t, nil, bad.ValidateSanityData())
+	bad = *req
+	bad.TokenIDToSellStr = "not a token"
+	assert.NotEqual(t, nil, bad.ValidateSanityData())
+	bad = *req
+	bad.SellAmount = 0
+	assert.NotEqual(t, nil, bad.ValidateSanityData())
+	bad = *req
+	bad.TraderAddressStr = "not an address"
+	assert.NotEqual(t, nil, bad.ValidateSanityData())
+
+	crossReq, _ := NewPDECrossPoolTradeRequest(testPortalBTCTokenID, prv, 1000, 1, 10, testIncAddress, "", PDECrossPoolTradeRequestMeta)
+	assert.Equal(t, nil, crossReq.ValidateSanityData())
+	crossReq.SubTraderAddressStr = "not an address"
+	assert.NotEqual(t, nil, crossReq.ValidateSanityData())
+}
+
+func TestStakingMetadataValidateSanityData(t *testing.T) {
+	seed := common.HashB([]byte("staking"))
+	keySet := new(incognitokey.KeySet).GenerateKey(seed)
+	committeeKey, err := incognitokey.NewCommitteeKeyFromSeed(seed, keySet.PaymentAddress.Pk)
+	assert.Equal(t, nil, err)
+	committeeKeyStr, err := committeeKey.ToBase58()
+	assert.Equal(t, nil, err)
+
+	staking, err := NewStakingMetadata(ShardStakingMeta, testIncAddress, testIncAddress, ShardStakingAmount, committeeKeyStr, true)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, staking.ValidateSanityData())
+
+	staking.StakingAmountShard = ShardStakingAmount - 1
+	assert.NotEqual(t, nil, staking.ValidateSanityData())
+	staking.Type = BeaconStakingMeta
+	staking.StakingAmountShard = 3 * ShardStakingAmount
+	assert.NotEqual(t, nil, staking.ValidateSanityData())
+	staking.StakingAmountShard = ShardStakingAmount
+	assert.Equal(t, nil, staking.ValidateSanityData())
+	assert.Equal(t, 3*ShardStakingAmount, staking.BurnedAmounts()[common.PRVCoinID])
+	staking.CommitteePublicKey = "invalid key"
+	assert.NotEqual(t, nil, staking.ValidateSanityData())
+}
+
+func TestValidateBurnedAmounts(t *testing.T) {
+	burningAddress := testPaymentAddress(t, BurningAddress)
+	assert.Equal(t, true, IsBurningAddress(burningAddress))
+	assert.Equal(t, false, IsBurningAddress(testPaymentAddress(t, testIncAddress)))
+
+	prvID := common.PRVCoinID
+	tokenID, _ := common.Hash{}.NewHashFromStr(testPortalBTCTokenID)
+	burn := func(amount uint64) *privacy.PaymentInfo {
+		return &privacy.PaymentInfo{PaymentAddress: burningAddress, Amount: amount}
+	}
+	change := &privacy.PaymentInfo{PaymentAddress: testPaymentAddress(t, testIncAddress), Amount: 7}
+
+	// a cross pool trade selling a token pays the trading fee in PRV
+	crossReq, _ := NewPDECrossPoolTradeRequest(prvID.String(), testPortalBTCTokenID, 1000, 1, 10, testIncAddress, "", PDECrossPoolTradeRequestMeta)
+	assert.Equal(t, nil, ValidateBurnedAmounts(crossReq, map[common.Hash][]*privacy.PaymentInfo{
+		prvID:    {burn(10), change},
+		*tokenID: {burn(600), burn(400), change},
+	}))
+	assert.NotEqual(t, nil, ValidateBurnedAmounts(crossReq, map[common.Hash][]*privacy.PaymentInfo{
+		prvID:    {change},
+		*tokenID: {burn(1000)},
+	}))
+	assert.NotEqual(t, nil, ValidateBurnedAmounts(crossReq, map[common.Hash][]*privacy.PaymentInfo{
+		prvID:    {burn(10)},
+		*tokenID: {burn(999)},
+	}))
+
+	// the trading fee of a trade is paid in the token to sell
+	tradeReq, _ := NewPDETradeRequest(testPortalBTCTokenID, prvID.String(), 1000, 1, 10, testIncAddress, PDETradeRequestMeta)
+	assert.Equal(t, nil, ValidateBurnedAmounts(tradeReq, map[common.Hash][]*privacy.PaymentInfo{prvID: {burn(1010)}}))
+	assert.NotEqual(t, nil, ValidateBurnedAmounts(tradeReq, map[common.Hash][]*privacy.PaymentInfo{prvID: {burn(1010), burn(1)}}))
+
+	// metadata which burn nothing are not checked
+	withdrawal, _ := NewPDEWithdrawalRequest(testIncAddress, prvID.String(), testPortalBTCTokenID, 5, PDEWithdrawalRequestMeta)
+	assert.Equal(t, nil, ValidateBurnedAmounts(withdrawal, map[common.Hash][]*privacy.PaymentInfo{prvID: {burn(1)}}))
+}
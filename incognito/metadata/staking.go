@@ -49,3 +49,31 @@ func (stakingMetadata StakingMetadata) GetType() int {
 func (stakingMetadata *StakingMetadata) CalculateSize() uint64 {
 	return calculateSize(stakingMetadata)
 }
+
+func (stakingMetadata StakingMetadata) ValidateSanityData() error {
+	if err := checkMetaType(stakingMetadata.Type, ShardStakingMeta, BeaconStakingMeta); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(stakingMetadata.FunderPaymentAddress, "funder payment address"); err != nil {
+		return err
+	}
+	if err := checkPaymentAddress(stakingMetadata.RewardReceiverPaymentAddress, "reward receiver payment address"); err != nil {
+		return err
+	}
+	if err := checkCommitteePublicKey(stakingMetadata.CommitteePublicKey); err != nil {
+		return err
+	}
+	// StakingAmountShard is always the shard stake, beacon candidates burn three times it
+	if stakingMetadata.StakingAmountShard != ShardStakingAmount {
+		return errors.New("staking amount is not the required stake")
+	}
+	return nil
+}
+
+func (stakingMetadata StakingMetadata) BurnedAmounts() map[common.Hash]uint64 {
+	amount := stakingMetadata.StakingAmountShard
+	if stakingMetadata.Type == BeaconStakingMeta {
+		amount *= 3
+	}
+	return map[common.Hash]uint64{common.PRVCoinID: amount}
+}
@@ -28,3 +28,10 @@ func (stopAutoStakingMetadata StopAutoStakingMetadata) GetType() int {
 func (stopAutoStakingMetadata *StopAutoStakingMetadata) CalculateSize() uint64 {
 	return calculateSize(stopAutoStakingMetadata)
 }
+
+func (stopAutoStakingMetadata StopAutoStakingMetadata) ValidateSanityData() error {
+	if err := checkMetaType(stopAutoStakingMetadata.Type, StopAutoStakingMeta); err != nil {
+		return err
+	}
+	return checkCommitteePublicKey(stopAutoStakingMetadata.CommitteePublicKey)
+}
@@ -0,0 +1,47 @@
+package metadata
+
+import (
+	"errors"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+)
+
+// UnStakingRequest - a staker withdraws the candidate of CommitteePublicKey while it is still pending,
+// without waiting for an epoch change as StopAutoStakingMetadata does
+type UnStakingRequest struct {
+	MetadataBase
+	CommitteePublicKey string
+}
+
+func NewUnStakingRequest(unStakingType int, committeePublicKey string) (*UnStakingRequest, error) {
+	if unStakingType != common.UnStakingMeta {
+		return nil, errors.New("invalid unstaking type")
+	}
+	committeePubKey := new(incognitokey.CommitteePublicKey)
+	if err := committeePubKey.FromBase58(committeePublicKey); err != nil {
+		return nil, errors.New("invalid committee public key")
+	}
+	if !committeePubKey.CheckSanityData() {
+		return nil, errors.New("invalid committee public key")
+	}
+	metadataBase := NewMetadataBase(unStakingType)
+	return &UnStakingRequest{
+		MetadataBase:       *metadataBase,
+		CommitteePublicKey: committeePublicKey,
+	}, nil
+}
+
+func (unStakingRequest UnStakingRequest) GetType() int {
+	return unStakingRequest.Type
+}
+
+func (unStakingRequest *UnStakingRequest) CalculateSize() uint64 {
+	return calculateSize(unStakingRequest)
+}
+
+func (unStakingRequest UnStakingRequest) ValidateSanityData() error {
+	if err := checkMetaType(unStakingRequest.Type, UnStakingMeta); err != nil {
+		return err
+	}
+	return checkCommitteePublicKey(unStakingRequest.CommitteePublicKey)
+}
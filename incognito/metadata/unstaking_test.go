@@ -0,0 +1,36 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/incognitokey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnStakingRequest(t *testing.T) {
+	seed := common.HashB([]byte("unstaking"))
+	keySet := new(incognitokey.KeySet).GenerateKey(seed)
+	committeeKey, err := incognitokey.NewCommitteeKeyFromSeed(seed, keySet.PaymentAddress.Pk)
+	assert.Equal(t, nil, err)
+	committeeKeyStr, err := committeeKey.ToBase58()
+	assert.Equal(t, nil, err)
+
+	req, err := NewUnStakingRequest(UnStakingMeta, committeeKeyStr)
+	assert.Equal(t, nil, err)
+	md, err := ParseMetadata(req)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, req, md)
+
+	_, err = NewUnStakingRequest(StopAutoStakingMeta, committeeKeyStr)
+	assert.NotEqual(t, nil, err)
+	_, err = NewUnStakingRequest(UnStakingMeta, "invalid key")
+	assert.NotEqual(t, nil, err)
+
+	// a key without mining keys
+	committeeKey.MiningPubKey = map[string][]byte{}
+	committeeKeyStr, err = committeeKey.ToBase58()
+	assert.Equal(t, nil, err)
+	_, err = NewUnStakingRequest(UnStakingMeta, committeeKeyStr)
+	assert.NotEqual(t, nil, err)
+}
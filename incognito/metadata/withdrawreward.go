@@ -4,6 +4,7 @@ import (
 	"github.com/0xkraken/incognito-wasm/incognito/common"
 	"github.com/0xkraken/incognito-wasm/incognito/privacy"
 	"strconv"
+	"github.com/pkg/errors"
 )
 
 type WithDrawRewardRequest struct {
@@ -30,3 +31,13 @@ func (withDrawRewardRequest WithDrawRewardRequest) GetType() int {
 	return withDrawRewardRequest.Type
 }
 
+
+func (withDrawRewardRequest WithDrawRewardRequest) ValidateSanityData() error {
+	if err := checkMetaType(withDrawRewardRequest.Type, WithDrawRewardRequestMeta); err != nil {
+		return err
+	}
+	if len(withDrawRewardRequest.PaymentAddress.Pk) == 0 {
+		return errors.New("payment address is empty")
+	}
+	return nil
+}
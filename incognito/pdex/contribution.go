@@ -0,0 +1,227 @@
+package pdex
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/0xkraken/incognito-wasm/incognito/privacy"
+	"github.com/pkg/errors"
+)
+
+// ContributionPlan is a pair of contributions to a pool with the amounts the chain matches and returns
+type ContributionPlan struct {
+	PDEContributionPairID string
+	ContributorAddressStr string
+	Token1IDStr           string
+	Token1Amount          uint64
+	Token2IDStr           string
+	Token2Amount          uint64
+	// the amounts the pool takes and the amounts the chain returns to the contributor
+	Actual1Amount   uint64
+	Returned1Amount uint64
+	Actual2Amount   uint64
+	Returned2Amount uint64
+	// ExpectedShares is the shares of the contributor increase, for the pool as it is in the snapshot
+	ExpectedShares uint64
+}
+
+// NewContributionPairID returns a pair ID no other contribution uses, the waiting contributions of the
+// chain are matched by it so it must not collide with the pair ID of any other contributor
+func NewContributionPairID(contributorAddressStr string) string {
+	record := contributorAddressStr
+	record += strconv.FormatInt(time.Now().UnixNano(), 10)
+	record += string(privacy.RandBytes(32))
+	return "pdecontribution-" + hex.EncodeToString(common.HashB([]byte(record)))
+}
+
+// MatchedAmount returns the amount of the other token of the pool to contribute with amount of tokenIDStr,
+// at the price of the pool
+func MatchedAmount(pool PoolPair, tokenIDStr string, amount uint64) (uint64, error) {
+	reserve, otherReserve, err := pool.GetReserves(tokenIDStr)
+	if err != nil {
+		return 0, err
+	}
+	if reserve == 0 || otherReserve == 0 {
+		return 0, errors.Errorf("pool %v-%v is empty", pool.Token1IDStr, pool.Token2IDStr)
+	}
+	res := new(big.Int).Mul(new(big.Int).SetUint64(amount), new(big.Int).SetUint64(otherReserve))
+	res.Div(res, new(big.Int).SetUint64(reserve))
+	if !res.IsUint64() {
+		return 0, errors.New("matched amount overflows")
+	}
+	return res.Uint64(), nil
+}
+
+// ComputeActualContributedAmounts returns the amounts of two contributions the pool takes and
+// the amounts the chain returns, the pool takes the contributions at its price
+// and all of them when it is empty
+func ComputeActualContributedAmounts(
+	pool *PoolPair,
+	token1IDStr string,
+	amount1 uint64,
+	amount2 uint64,
+) (actual1 uint64, returned1 uint64, actual2 uint64, returned2 uint64, err error) {
+	if pool == nil || pool.Token1PoolValue == 0 || pool.Token2PoolValue == 0 {
+		return amount1, 0, amount2, 0, nil
+	}
+	reserve1, reserve2, err := pool.GetReserves(token1IDStr)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	expected2 := new(big.Int).Mul(new(big.Int).SetUint64(amount1), new(big.Int).SetUint64(reserve2))
+	expected2.Div(expected2, new(big.Int).SetUint64(reserve1))
+	if expected2.Cmp(new(big.Int).SetUint64(amount2)) <= 0 {
+		return amount1, 0, expected2.Uint64(), amount2 - expected2.Uint64(), nil
+	}
+	expected1 := new(big.Int).Mul(new(big.Int).SetUint64(amount2), new(big.Int).SetUint64(reserve1))
+	expected1.Div(expected1, new(big.Int).SetUint64(reserve2))
+	return expected1.Uint64(), amount1 - expected1.Uint64(), amount2, 0, nil
+}
+
+// ExpectedShares returns the shares a contribution of actual1 of token1IDStr and actual2 of the other token adds,
+// the chain counts the shares on the first token of the pair in key order
+func (state PoolState) ExpectedShares(token1IDStr string, actual1 uint64, token2IDStr string, actual2 uint64) uint64 {
+	amount := actual1
+	sortedToken1IDStr, _ := sortTokenPair(token1IDStr, token2IDStr)
+	if sortedToken1IDStr != token1IDStr {
+		amount = actual2
+	}
+	_, totalShares := state.GetShares(token1IDStr, token2IDStr, "")
+	pool, err := state.GetPoolPair(token1IDStr, token2IDStr)
+	if err != nil || totalShares == 0 {
+		return amount
+	}
+	reserve, _, err := pool.GetReserves(sortedToken1IDStr)
+	if err != nil || reserve == 0 {
+		return amount
+	}
+	res := new(big.Int).Mul(new(big.Int).SetUint64(amount), new(big.Int).SetUint64(totalShares))
+	res.Div(res, new(big.Int).SetUint64(reserve))
+	return res.Uint64()
+}
+
+// PlanContribution plans the contribution of amount1 of token1IDStr with token2IDStr,
+// with amount2 zero it contributes the matched amount of token2IDStr at the price of the pool
+func (state PoolState) PlanContribution(
+	contributorAddressStr string,
+	token1IDStr string,
+	amount1 uint64,
+	token2IDStr string,
+	amount2 uint64,
+) (*ContributionPlan, error) {
+	if token1IDStr == token2IDStr {
+		return nil, errors.New("can not contribute a token with itself")
+	}
+	if amount1 == 0 {
+		return nil, errors.New("contributed amount is zero")
+	}
+	pool, err := state.GetPoolPair(token1IDStr, token2IDStr)
+	if err != nil {
+		pool = nil
+	}
+	if amount2 == 0 {
+		if pool == nil {
+			return nil, errors.Errorf("no pool for pair %v-%v, both amounts are required", token1IDStr, token2IDStr)
+		}
+		amount2, err = MatchedAmount(*pool, token1IDStr, amount1)
+		if err != nil {
+			return nil, err
+		}
+		if amount2 == 0 {
+			return nil, errors.New("contributed amount is too small for the price of the pool")
+		}
+	}
+
+	plan := &ContributionPlan{
+		PDEContributionPairID: NewContributionPairID(contributorAddressStr),
+		ContributorAddressStr: contributorAddressStr,
+		Token1IDStr:           token1IDStr,
+		Token1Amount:          amount1,
+		Token2IDStr:           token2IDStr,
+		Token2Amount:          amount2,
+	}
+	plan.Actual1Amount, plan.Returned1Amount, plan.Actual2Amount, plan.Returned2Amount, err = ComputeActualContributedAmounts(
+		pool, token1IDStr, amount1, amount2,
+	)
+	if err != nil {
+		return nil, err
+	}
+	plan.ExpectedShares = state.ExpectedShares(token1IDStr, plan.Actual1Amount, token2IDStr, plan.Actual2Amount)
+	return plan, nil
+}
+
+// Contributions returns the metadata of both contributions, each of its own type, PDEPRVRequiredContributionRequestMeta
+// or PDEContributionMeta, a contribution goes with a PRV tx when it contributes PRV and a privacy token tx otherwise
+func (plan ContributionPlan) Contributions(metaType1 int, metaType2 int) ([]*metadata.PDEContribution, error) {
+	res := []*metadata.PDEContribution{}
+	for _, contribution := range []struct {
+		tokenIDStr string
+		amount     uint64
+		metaType   int
+	}{{plan.Token1IDStr, plan.Token1Amount, metaType1}, {plan.Token2IDStr, plan.Token2Amount, metaType2}} {
+		md, err := metadata.NewPDEContribution(
+			plan.PDEContributionPairID, plan.ContributorAddressStr, contribution.amount, contribution.tokenIDStr, contribution.metaType,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, md)
+	}
+	return res, nil
+}
+
+// Refunds returns the amounts the chain will return to the contributor, without the tx IDs of the contributions
+func (plan ContributionPlan) Refunds() []metadata.PDERefundContribution {
+	res := []metadata.PDERefundContribution{}
+	if plan.Returned1Amount > 0 {
+		res = append(res, metadata.PDERefundContribution{
+			PDEContributionPairID: plan.PDEContributionPairID,
+			ContributorAddressStr: plan.ContributorAddressStr,
+			ContributedAmount:     plan.Returned1Amount,
+			TokenIDStr:            plan.Token1IDStr,
+		})
+	}
+	if plan.Returned2Amount > 0 {
+		res = append(res, metadata.PDERefundContribution{
+			PDEContributionPairID: plan.PDEContributionPairID,
+			ContributorAddressStr: plan.ContributorAddressStr,
+			ContributedAmount:     plan.Returned2Amount,
+			TokenIDStr:            plan.Token2IDStr,
+		})
+	}
+	return res
+}
+
+// WithdrawalAmounts returns the amounts of both tokens withdrawing withdrawalShareAmt of the shares of
+// contributorAddressStr redeems, the chain withdraws all the shares of the contributor when there are less
+func (state PoolState) WithdrawalAmounts(
+	token1IDStr string,
+	token2IDStr string,
+	contributorAddressStr string,
+	withdrawalShareAmt uint64,
+) (uint64, uint64, error) {
+	pool, err := state.GetPoolPair(token1IDStr, token2IDStr)
+	if err != nil {
+		return 0, 0, err
+	}
+	shares, totalShares := state.GetShares(token1IDStr, token2IDStr, contributorAddressStr)
+	if shares == 0 || totalShares == 0 {
+		return 0, 0, errors.Errorf("%v has no shares in pool %v-%v", contributorAddressStr, token1IDStr, token2IDStr)
+	}
+	if withdrawalShareAmt > shares {
+		withdrawalShareAmt = shares
+	}
+	reserve1, reserve2, err := pool.GetReserves(token1IDStr)
+	if err != nil {
+		return 0, 0, err
+	}
+	amount := func(reserve uint64) uint64 {
+		res := new(big.Int).Mul(new(big.Int).SetUint64(reserve), new(big.Int).SetUint64(withdrawalShareAmt))
+		return res.Div(res, new(big.Int).SetUint64(totalShares)).Uint64()
+	}
+	return amount(reserve1), amount(reserve2), nil
+}
@@ -0,0 +1,95 @@
+package pdex
+
+import (
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestContributionState() *PoolState {
+	token1, token2 := sortTokenPair(prvIDStr, tokenAStr)
+	return &PoolState{
+		PDEPoolPairs: map[string]*PoolPair{
+			"pdepool-100-" + token1 + "-" + token2: {Token1IDStr: prvIDStr, Token1PoolValue: 1000000, Token2IDStr: tokenAStr, Token2PoolValue: 2000000},
+		},
+		PDEShares: map[string]uint64{
+			"pdeshare-100-" + token1 + "-" + token2 + "-alice": 300000,
+			"pdeshare-100-" + token1 + "-" + token2 + "-bob":   700000,
+		},
+	}
+}
+
+func TestPlanContribution(t *testing.T) {
+	state := newTestContributionState()
+
+	// the matched amount of A at 1 PRV = 2 A
+	plan, err := state.PlanContribution("carol", prvIDStr, 1000, tokenAStr, 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(2000), plan.Token2Amount)
+	assert.Equal(t, uint64(1000), plan.Actual1Amount)
+	assert.Equal(t, uint64(2000), plan.Actual2Amount)
+	assert.Equal(t, 0, len(plan.Refunds()))
+	// 1000000 shares for the whole pool, 1/1000 of either token is 1000 shares
+	assert.Equal(t, uint64(1000), plan.ExpectedShares)
+
+	contributions, err := plan.Contributions(metadata.PDEPRVRequiredContributionRequestMeta, metadata.PDEContributionMeta)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(contributions))
+	assert.Equal(t, metadata.PDEPRVRequiredContributionRequestMeta, contributions[0].Type)
+	assert.Equal(t, metadata.PDEContributionMeta, contributions[1].Type)
+	assert.Equal(t, plan.PDEContributionPairID, contributions[0].PDEContributionPairID)
+	assert.Equal(t, plan.PDEContributionPairID, contributions[1].PDEContributionPairID)
+	assert.Equal(t, tokenAStr, contributions[1].TokenIDStr)
+	assert.Equal(t, uint64(2000), contributions[1].ContributedAmount)
+
+	// too much A comes back
+	plan, err = state.PlanContribution("carol", prvIDStr, 1000, tokenAStr, 2500)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(2000), plan.Actual2Amount)
+	assert.Equal(t, uint64(500), plan.Returned2Amount)
+	refunds := plan.Refunds()
+	assert.Equal(t, 1, len(refunds))
+	assert.Equal(t, tokenAStr, refunds[0].TokenIDStr)
+	assert.Equal(t, uint64(500), refunds[0].ContributedAmount)
+
+	// and too much PRV
+	plan, err = state.PlanContribution("carol", prvIDStr, 1000, tokenAStr, 1000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(500), plan.Actual1Amount)
+	assert.Equal(t, uint64(500), plan.Returned1Amount)
+	assert.Equal(t, uint64(0), plan.Returned2Amount)
+
+	// a new pool takes both amounts
+	plan, err = state.PlanContribution("carol", prvIDStr, 1000, tokenBStr, 30)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(30), plan.Actual2Amount)
+	assert.Equal(t, 0, len(plan.Refunds()))
+	_, err = state.PlanContribution("carol", prvIDStr, 1000, tokenBStr, 0)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestNewContributionPairID(t *testing.T) {
+	assert.NotEqual(t, NewContributionPairID("alice"), NewContributionPairID("alice"))
+}
+
+func TestWithdrawalAmounts(t *testing.T) {
+	state := newTestContributionState()
+	shares, totalShares := state.GetShares(tokenAStr, prvIDStr, "alice")
+	assert.Equal(t, uint64(300000), shares)
+	assert.Equal(t, uint64(1000000), totalShares)
+
+	amountPRV, amountA, err := state.WithdrawalAmounts(prvIDStr, tokenAStr, "alice", 100000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(100000), amountPRV)
+	assert.Equal(t, uint64(200000), amountA)
+
+	// more than the shares of alice withdraws all of them
+	amountA, amountPRV, err = state.WithdrawalAmounts(tokenAStr, prvIDStr, "alice", 500000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(300000), amountPRV)
+	assert.Equal(t, uint64(600000), amountA)
+
+	_, _, err = state.WithdrawalAmounts(prvIDStr, tokenAStr, "carol", 1)
+	assert.NotEqual(t, nil, err)
+}
@@ -0,0 +1,96 @@
+package pdex
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/pkg/errors"
+)
+
+// PoolPair is the reserves of a pDEX pool, as in the PDEPoolPairs of the node's pde state
+type PoolPair struct {
+	Token1IDStr     string
+	Token1PoolValue uint64
+	Token2IDStr     string
+	Token2PoolValue uint64
+}
+
+// PoolState is a snapshot of the pDEX pools, the result of the getpdestate RPC
+type PoolState struct {
+	PDEPoolPairs map[string]*PoolPair
+	// PDEShares are the shares of the contributors by key pdeshare-<beacon height>-<token 1>-<token 2>-<contributor>
+	PDEShares       map[string]uint64
+	BeaconTimeStamp int64
+}
+
+// NewPoolStateFromJSON parses the result of the getpdestate RPC
+func NewPoolStateFromJSON(data []byte) (*PoolState, error) {
+	state := new(PoolState)
+	err := json.Unmarshal(data, state)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not parse pde state")
+	}
+	return state, nil
+}
+
+// HasToken returns true if tokenIDStr is one of the tokens of the pool
+func (pool PoolPair) HasToken(tokenIDStr string) bool {
+	return pool.Token1IDStr == tokenIDStr || pool.Token2IDStr == tokenIDStr
+}
+
+// GetReserves returns the reserves of the pool of tokenIDStrToSell and the other token
+func (pool PoolPair) GetReserves(tokenIDStrToSell string) (sellReserve uint64, buyReserve uint64, err error) {
+	switch tokenIDStrToSell {
+	case pool.Token1IDStr:
+		return pool.Token1PoolValue, pool.Token2PoolValue, nil
+	case pool.Token2IDStr:
+		return pool.Token2PoolValue, pool.Token1PoolValue, nil
+	default:
+		return 0, 0, errors.Errorf("token %v is not in pool %v-%v", tokenIDStrToSell, pool.Token1IDStr, pool.Token2IDStr)
+	}
+}
+
+// GetPoolPair returns the pool of two tokens
+func (state PoolState) GetPoolPair(token1IDStr string, token2IDStr string) (*PoolPair, error) {
+	if token1IDStr == token2IDStr {
+		return nil, errors.Errorf("no pool of token %v with itself", token1IDStr)
+	}
+	for _, pool := range state.PDEPoolPairs {
+		if pool != nil && pool.HasToken(token1IDStr) && pool.HasToken(token2IDStr) {
+			return pool, nil
+		}
+	}
+	return nil, errors.Errorf("no pool for pair %v-%v", token1IDStr, token2IDStr)
+}
+
+// GetPRVPoolPair returns the pool of a token with PRV
+func (state PoolState) GetPRVPoolPair(tokenIDStr string) (*PoolPair, error) {
+	return state.GetPoolPair(common.PRVCoinID.String(), tokenIDStr)
+}
+
+// sortTokenPair returns the tokens of a pair in the order of the chain keys
+func sortTokenPair(token1IDStr string, token2IDStr string) (string, string) {
+	if token1IDStr > token2IDStr {
+		return token2IDStr, token1IDStr
+	}
+	return token1IDStr, token2IDStr
+}
+
+// GetShares returns the shares of contributorAddressStr and the total shares in the pool of two tokens
+func (state PoolState) GetShares(token1IDStr string, token2IDStr string, contributorAddressStr string) (uint64, uint64) {
+	token1IDStr, token2IDStr = sortTokenPair(token1IDStr, token2IDStr)
+	pairInfix := "-" + token1IDStr + "-" + token2IDStr + "-"
+	shares, totalShares := uint64(0), uint64(0)
+	for key, value := range state.PDEShares {
+		i := strings.Index(key, pairInfix)
+		if !strings.HasPrefix(key, "pdeshare-") || i < 0 {
+			continue
+		}
+		totalShares += value
+		if key[i+len(pairInfix):] == contributorAddressStr {
+			shares += value
+		}
+	}
+	return shares, totalShares
+}
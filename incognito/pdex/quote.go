@@ -0,0 +1,152 @@
+package pdex
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/pkg/errors"
+)
+
+// MaxSlippage is the slippage tolerance percentage a quote accepts, excluded
+const MaxSlippage = 100
+
+// Quote is the expected result of selling SellAmount of TokenIDToSellStr for TokenIDToBuyStr
+type Quote struct {
+	TokenIDToSellStr string
+	TokenIDToBuyStr  string
+	SellAmount       uint64
+	ReceiveAmount    uint64
+	// Route is the tokens the trade goes through, from the sold token to the bought one
+	Route []string
+	// PriceImpact is the loss of the trade against the spot price of the pools, in percent
+	PriceImpact float64
+}
+
+// TradeValue returns the amount of the other token the pool pays for sellAmount of tokenIDStrToSell,
+// the chain rounds the new reserve of the bought token up so the pool invariant never decreases.
+// The trading fee is paid in PRV on top of the sell amount and goes to the liquidity providers,
+// it does not change the reserves
+func TradeValue(pool PoolPair, tokenIDStrToSell string, sellAmount uint64) (uint64, error) {
+	sellReserve, buyReserve, err := pool.GetReserves(tokenIDStrToSell)
+	if err != nil {
+		return 0, err
+	}
+	if sellReserve == 0 || buyReserve == 0 {
+		return 0, errors.Errorf("pool %v-%v is empty", pool.Token1IDStr, pool.Token2IDStr)
+	}
+	if sellAmount == 0 {
+		return 0, errors.New("sell amount is zero")
+	}
+
+	invariant := new(big.Int).Mul(new(big.Int).SetUint64(sellReserve), new(big.Int).SetUint64(buyReserve))
+	newSellReserve := new(big.Int).Add(new(big.Int).SetUint64(sellReserve), new(big.Int).SetUint64(sellAmount))
+	newBuyReserve, mod := new(big.Int).DivMod(invariant, newSellReserve, new(big.Int))
+	if mod.Sign() != 0 {
+		newBuyReserve.Add(newBuyReserve, big.NewInt(1))
+	}
+	if newBuyReserve.Cmp(new(big.Int).SetUint64(buyReserve)) >= 0 {
+		return 0, errors.New("sell amount is too small to buy anything")
+	}
+	return buyReserve - newBuyReserve.Uint64(), nil
+}
+
+// spotPrice returns the price of tokenIDStrToSell in the other token of the pool
+func spotPrice(pool PoolPair, tokenIDStrToSell string) (float64, error) {
+	sellReserve, buyReserve, err := pool.GetReserves(tokenIDStrToSell)
+	if err != nil {
+		return 0, err
+	}
+	return float64(buyReserve) / float64(sellReserve), nil
+}
+
+// Route returns the pools a trade goes through: the pool of the pair if one of the tokens is PRV,
+// the pools of both tokens with PRV otherwise, as the chain routes cross pool trades
+func (state PoolState) Route(tokenIDStrToSell string, tokenIDStrToBuy string) ([]string, []*PoolPair, error) {
+	prvIDStr := common.PRVCoinID.String()
+	if tokenIDStrToSell == tokenIDStrToBuy {
+		return nil, nil, errors.New("token to sell and token to buy are the same")
+	}
+	if tokenIDStrToSell == prvIDStr || tokenIDStrToBuy == prvIDStr {
+		pool, err := state.GetPoolPair(tokenIDStrToSell, tokenIDStrToBuy)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []string{tokenIDStrToSell, tokenIDStrToBuy}, []*PoolPair{pool}, nil
+	}
+	sellPool, err := state.GetPRVPoolPair(tokenIDStrToSell)
+	if err != nil {
+		return nil, nil, err
+	}
+	buyPool, err := state.GetPRVPoolPair(tokenIDStrToBuy)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []string{tokenIDStrToSell, prvIDStr, tokenIDStrToBuy}, []*PoolPair{sellPool, buyPool}, nil
+}
+
+// Quote computes the expected receive amount and price impact of a cross pool trade
+func (state PoolState) Quote(tokenIDStrToSell string, tokenIDStrToBuy string, sellAmount uint64) (*Quote, error) {
+	route, pools, err := state.Route(tokenIDStrToSell, tokenIDStrToBuy)
+	if err != nil {
+		return nil, err
+	}
+	return quoteRoute(route, pools, sellAmount)
+}
+
+// QuotePool computes the expected receive amount and price impact of a trade in the pool of the pair,
+// the only pool a PDETradeRequest trades in
+func (state PoolState) QuotePool(tokenIDStrToSell string, tokenIDStrToBuy string, sellAmount uint64) (*Quote, error) {
+	pool, err := state.GetPoolPair(tokenIDStrToSell, tokenIDStrToBuy)
+	if err != nil {
+		return nil, err
+	}
+	return quoteRoute([]string{tokenIDStrToSell, tokenIDStrToBuy}, []*PoolPair{pool}, sellAmount)
+}
+
+// quoteRoute trades sellAmount through pools, route[i] is the token sold in pools[i]
+func quoteRoute(route []string, pools []*PoolPair, sellAmount uint64) (*Quote, error) {
+	tokenIDStrToSell := route[0]
+	tokenIDStrToBuy := route[len(route)-1]
+	amount := sellAmount
+	price := 1.0
+	for i, pool := range pools {
+		hopPrice, err := spotPrice(*pool, route[i])
+		if err != nil {
+			return nil, err
+		}
+		price *= hopPrice
+		amount, err = TradeValue(*pool, route[i], amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	priceImpact := (1 - float64(amount)/(float64(sellAmount)*price)) * 100
+	return &Quote{
+		TokenIDToSellStr: tokenIDStrToSell,
+		TokenIDToBuyStr:  tokenIDStrToBuy,
+		SellAmount:       sellAmount,
+		ReceiveAmount:    amount,
+		Route:            route,
+		PriceImpact:      math.Max(priceImpact, 0),
+	}, nil
+}
+
+// MinAcceptableAmount returns the least amount to accept for a slippage tolerance in percent,
+// the MinAcceptableAmount of the trade request
+func (quote Quote) MinAcceptableAmount(slippage float64) (uint64, error) {
+	return MinAcceptableAmount(quote.ReceiveAmount, slippage)
+}
+
+// MinAcceptableAmount returns receiveAmount decreased by the slippage tolerance in percent, rounded down
+func MinAcceptableAmount(receiveAmount uint64, slippage float64) (uint64, error) {
+	if math.IsNaN(slippage) || slippage < 0 || slippage >= MaxSlippage {
+		return 0, errors.Errorf("slippage %v is not in [0, %v)", slippage, MaxSlippage)
+	}
+	// in hundredths of a percent
+	keep := new(big.Int).SetInt64(int64(math.Round((MaxSlippage - slippage) * 100)))
+	res := new(big.Int).Mul(new(big.Int).SetUint64(receiveAmount), keep)
+	res.Div(res, big.NewInt(MaxSlippage*100))
+	return res.Uint64(), nil
+}
@@ -0,0 +1,140 @@
+package pdex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	prvIDStr  = common.PRVCoinID.String()
+	tokenAStr = common.HashH([]byte("token A")).String()
+	tokenBStr = common.HashH([]byte("token B")).String()
+	tokenCStr = common.HashH([]byte("token C")).String()
+)
+
+const testPDEState = `{
+	"PDEPoolPairs": {
+		"pdepool-100-0000000000000000000000000000000000000000000000000000000000000004-A": {
+			"Token1IDStr": "0000000000000000000000000000000000000000000000000000000000000004",
+			"Token1PoolValue": 1000000,
+			"Token2IDStr": "TOKEN_A",
+			"Token2PoolValue": 2000000
+		},
+		"pdepool-100-0000000000000000000000000000000000000000000000000000000000000004-B": {
+			"Token1IDStr": "TOKEN_B",
+			"Token1PoolValue": 500000,
+			"Token2IDStr": "0000000000000000000000000000000000000000000000000000000000000004",
+			"Token2PoolValue": 5000000
+		}
+	},
+	"BeaconTimeStamp": 1600000000
+}`
+
+func newTestPoolState(t *testing.T) *PoolState {
+	data := strings.NewReplacer("TOKEN_A", tokenAStr, "TOKEN_B", tokenBStr).Replace(testPDEState)
+	state, err := NewPoolStateFromJSON([]byte(data))
+	assert.Equal(t, nil, err)
+	return state
+}
+
+func TestTradeValue(t *testing.T) {
+	pool := PoolPair{Token1IDStr: prvIDStr, Token1PoolValue: 1000, Token2IDStr: tokenAStr, Token2PoolValue: 3000}
+	// 1000 * 3000 / 1100 = 2727.27, the new reserve rounds up to 2728
+	amount, err := TradeValue(pool, prvIDStr, 100)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(272), amount)
+	// 1000 * 3000 / 3300 = 909.09, rounded up to 910
+	amount, err = TradeValue(pool, tokenAStr, 300)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(90), amount)
+
+	_, err = TradeValue(pool, prvIDStr, 0)
+	assert.NotEqual(t, nil, err)
+	_, err = TradeValue(pool, tokenBStr, 100)
+	assert.NotEqual(t, nil, err)
+	_, err = TradeValue(PoolPair{Token1IDStr: prvIDStr, Token2IDStr: tokenAStr}, prvIDStr, 100)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestQuote(t *testing.T) {
+	state := newTestPoolState(t)
+
+	// one hop
+	quote, err := state.Quote(prvIDStr, tokenAStr, 1000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{prvIDStr, tokenAStr}, quote.Route)
+	expected, _ := TradeValue(*state.PDEPoolPairs["pdepool-100-0000000000000000000000000000000000000000000000000000000000000004-A"], prvIDStr, 1000)
+	assert.Equal(t, expected, quote.ReceiveAmount)
+	assert.Equal(t, true, quote.PriceImpact > 0 && quote.PriceImpact < 0.2)
+
+	// two hops through PRV
+	quote, err = state.Quote(tokenAStr, tokenBStr, 20000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{tokenAStr, prvIDStr, tokenBStr}, quote.Route)
+	prvAmount, _ := TradeValue(*state.PDEPoolPairs["pdepool-100-0000000000000000000000000000000000000000000000000000000000000004-A"], tokenAStr, 20000)
+	expected, _ = TradeValue(*state.PDEPoolPairs["pdepool-100-0000000000000000000000000000000000000000000000000000000000000004-B"], prvIDStr, prvAmount)
+	assert.Equal(t, expected, quote.ReceiveAmount)
+	// 20000 A is 10000 PRV is 1000 B at spot price
+	assert.Equal(t, true, quote.ReceiveAmount < 1000)
+	assert.Equal(t, true, quote.PriceImpact > 1 && quote.PriceImpact < 2)
+
+	// a larger trade moves the price more
+	bigQuote, err := state.Quote(tokenAStr, tokenBStr, 200000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, bigQuote.PriceImpact > quote.PriceImpact)
+
+	_, err = state.Quote(tokenAStr, tokenCStr, 1000)
+	assert.NotEqual(t, nil, err)
+	_, err = state.Quote(tokenAStr, tokenAStr, 1000)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestQuotePool(t *testing.T) {
+	state := newTestRouterState(200000, 10000)
+
+	// only in the pool of the pair, even when the route through PRV pays more
+	quote, err := state.QuotePool(tokenAStr, tokenBStr, 20000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{tokenAStr, tokenBStr}, quote.Route)
+	expected, _ := TradeValue(*state.PDEPoolPairs["a-b"], tokenAStr, 20000)
+	assert.Equal(t, expected, quote.ReceiveAmount)
+	crossQuote, err := state.Quote(tokenAStr, tokenBStr, 20000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, crossQuote.ReceiveAmount > quote.ReceiveAmount)
+
+	// PRV pairs trade in the same pool either way
+	quote, err = state.QuotePool(prvIDStr, tokenAStr, 1000)
+	assert.Equal(t, nil, err)
+	crossQuote, err = state.Quote(prvIDStr, tokenAStr, 1000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, crossQuote, quote)
+
+	delete(state.PDEPoolPairs, "a-b")
+	_, err = state.QuotePool(tokenAStr, tokenBStr, 20000)
+	assert.NotEqual(t, nil, err)
+	_, err = state.QuotePool(tokenAStr, tokenAStr, 1000)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestMinAcceptableAmount(t *testing.T) {
+	amount, err := MinAcceptableAmount(1000, 1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(990), amount)
+	amount, err = MinAcceptableAmount(1000, 0.5)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(995), amount)
+	amount, err = MinAcceptableAmount(999, 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(999), amount)
+	amount, err = Quote{ReceiveAmount: 1 << 62}.MinAcceptableAmount(50)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, uint64(1<<61), amount)
+
+	_, err = MinAcceptableAmount(1000, -1)
+	assert.NotEqual(t, nil, err)
+	_, err = MinAcceptableAmount(1000, 100)
+	assert.NotEqual(t, nil, err)
+}
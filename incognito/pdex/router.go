@@ -0,0 +1,194 @@
+package pdex
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/pkg/errors"
+)
+
+// Hop is the trade in one pool of a route
+type Hop struct {
+	TokenIDToSellStr string
+	TokenIDToBuyStr  string
+	SellAmount       uint64
+	ReceiveAmount    uint64
+	Pool             *PoolPair
+}
+
+// TradeRoute is a trade through the pools of Path, from the sold token to the bought one
+type TradeRoute struct {
+	Path          []string
+	Hops          []Hop
+	SellAmount    uint64
+	ReceiveAmount uint64
+}
+
+// SplitTrade is a trade split across routes
+type SplitTrade struct {
+	Routes        []*TradeRoute
+	SellAmount    uint64
+	ReceiveAmount uint64
+}
+
+// FindPaths returns the paths of a trade: the pool of the pair if there is one and,
+// when neither token is PRV, the hop through the PRV pools of both tokens
+func (state PoolState) FindPaths(tokenIDStrToSell string, tokenIDStrToBuy string) ([][]string, error) {
+	if tokenIDStrToSell == tokenIDStrToBuy {
+		return nil, errors.New("token to sell and token to buy are the same")
+	}
+	paths := [][]string{}
+	if _, err := state.GetPoolPair(tokenIDStrToSell, tokenIDStrToBuy); err == nil {
+		paths = append(paths, []string{tokenIDStrToSell, tokenIDStrToBuy})
+	}
+	prvIDStr := common.PRVCoinID.String()
+	if tokenIDStrToSell != prvIDStr && tokenIDStrToBuy != prvIDStr {
+		_, sellErr := state.GetPRVPoolPair(tokenIDStrToSell)
+		_, buyErr := state.GetPRVPoolPair(tokenIDStrToBuy)
+		if sellErr == nil && buyErr == nil {
+			paths = append(paths, []string{tokenIDStrToSell, prvIDStr, tokenIDStrToBuy})
+		}
+	}
+	if len(paths) == 0 {
+		return nil, errors.Errorf("no route from %v to %v", tokenIDStrToSell, tokenIDStrToBuy)
+	}
+	return paths, nil
+}
+
+// SimulateRoute trades sellAmount along path with the pDEX formula
+func (state PoolState) SimulateRoute(path []string, sellAmount uint64) (*TradeRoute, error) {
+	if len(path) < 2 {
+		return nil, errors.New("route has less than 2 tokens")
+	}
+	route := &TradeRoute{Path: path, SellAmount: sellAmount}
+	amount := sellAmount
+	for i := 0; i+1 < len(path); i++ {
+		pool, err := state.GetPoolPair(path[i], path[i+1])
+		if err != nil {
+			return nil, err
+		}
+		receiveAmount, err := TradeValue(*pool, path[i], amount)
+		if err != nil {
+			return nil, err
+		}
+		route.Hops = append(route.Hops, Hop{
+			TokenIDToSellStr: path[i],
+			TokenIDToBuyStr:  path[i+1],
+			SellAmount:       amount,
+			ReceiveAmount:    receiveAmount,
+			Pool:             pool,
+		})
+		amount = receiveAmount
+	}
+	route.ReceiveAmount = amount
+	return route, nil
+}
+
+// BestRoute returns the route paying the most for sellAmount
+func (state PoolState) BestRoute(tokenIDStrToSell string, tokenIDStrToBuy string, sellAmount uint64) (*TradeRoute, error) {
+	paths, err := state.FindPaths(tokenIDStrToSell, tokenIDStrToBuy)
+	if err != nil {
+		return nil, err
+	}
+	var best *TradeRoute
+	for _, path := range paths {
+		route, err := state.SimulateRoute(path, sellAmount)
+		if err != nil {
+			continue
+		}
+		if best == nil || route.ReceiveAmount > best.ReceiveAmount {
+			best = route
+		}
+	}
+	if best == nil {
+		return nil, errors.Errorf("no route from %v to %v pays for %v", tokenIDStrToSell, tokenIDStrToBuy, sellAmount)
+	}
+	return best, nil
+}
+
+// SplitRoute splits sellAmount in parts and gives each part to the route it increases the output the most,
+// the paths of a pair share no pool so their outputs add up
+func (state PoolState) SplitRoute(tokenIDStrToSell string, tokenIDStrToBuy string, sellAmount uint64, parts int) (*SplitTrade, error) {
+	if parts <= 0 || uint64(parts) > sellAmount {
+		return nil, errors.Errorf("can not split %v in %v parts", sellAmount, parts)
+	}
+	paths, err := state.FindPaths(tokenIDStrToSell, tokenIDStrToBuy)
+	if err != nil {
+		return nil, err
+	}
+
+	output := func(path []string, amount uint64) uint64 {
+		if amount == 0 {
+			return 0
+		}
+		route, err := state.SimulateRoute(path, amount)
+		if err != nil {
+			return 0
+		}
+		return route.ReceiveAmount
+	}
+	allocs := make([]uint64, len(paths))
+	partAmount := sellAmount / uint64(parts)
+	for i := 0; i < parts; i++ {
+		amount := partAmount
+		if i == parts-1 {
+			amount = sellAmount - partAmount*uint64(parts-1)
+		}
+		bestPath, bestGain := 0, uint64(0)
+		for j, path := range paths {
+			before, after := output(path, allocs[j]), output(path, allocs[j]+amount)
+			if after > before && after-before > bestGain {
+				bestPath, bestGain = j, after-before
+			}
+		}
+		allocs[bestPath] += amount
+	}
+
+	res := &SplitTrade{SellAmount: sellAmount}
+	for j, path := range paths {
+		if allocs[j] == 0 {
+			continue
+		}
+		route, err := state.SimulateRoute(path, allocs[j])
+		if err != nil {
+			return nil, errors.Errorf("no route from %v to %v pays for %v", tokenIDStrToSell, tokenIDStrToBuy, allocs[j])
+		}
+		res.Routes = append(res.Routes, route)
+		res.ReceiveAmount += route.ReceiveAmount
+	}
+	return res, nil
+}
+
+// TradeRequest returns the trade request metadata of the route: a PDECrossPoolTradeRequest for PRV pairs
+// and routes through PRV, a PDETradeRequest in the pool of two tokens otherwise.
+// The min acceptable amount is ReceiveAmount less the slippage tolerance in percent
+func (route TradeRoute) TradeRequest(
+	traderAddressStr string,
+	subTraderAddressStr string,
+	tradingFee uint64,
+	slippage float64,
+) (metadata.Metadata, error) {
+	if len(route.Path) < 2 {
+		return nil, errors.New("route has less than 2 tokens")
+	}
+	minAcceptableAmount, err := MinAcceptableAmount(route.ReceiveAmount, slippage)
+	if err != nil {
+		return nil, err
+	}
+	tokenIDStrToSell := route.Path[0]
+	tokenIDStrToBuy := route.Path[len(route.Path)-1]
+
+	prvIDStr := common.PRVCoinID.String()
+	if len(route.Path) == 2 && tokenIDStrToSell != prvIDStr && tokenIDStrToBuy != prvIDStr {
+		return metadata.NewPDETradeRequest(
+			tokenIDStrToBuy, tokenIDStrToSell, route.SellAmount, minAcceptableAmount, tradingFee,
+			traderAddressStr, metadata.PDETradeRequestMeta,
+		)
+	}
+	if len(route.Path) > 3 || (len(route.Path) == 3 && route.Path[1] != prvIDStr) {
+		return nil, errors.New("cross pool trades only go through PRV")
+	}
+	return metadata.NewPDECrossPoolTradeRequest(
+		tokenIDStrToBuy, tokenIDStrToSell, route.SellAmount, minAcceptableAmount, tradingFee,
+		traderAddressStr, subTraderAddressStr, metadata.PDECrossPoolTradeRequestMeta,
+	)
+}
@@ -0,0 +1,111 @@
+package pdex
+
+import (
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/metadata"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRouterState returns pools of A and B with PRV at 1 A = 0.5 PRV = 0.05 B,
+// and a small direct A-B pool at the same price
+func newTestRouterState(directA uint64, directB uint64) *PoolState {
+	return &PoolState{PDEPoolPairs: map[string]*PoolPair{
+		"prv-a": {Token1IDStr: prvIDStr, Token1PoolValue: 1000000, Token2IDStr: tokenAStr, Token2PoolValue: 2000000},
+		"prv-b": {Token1IDStr: tokenBStr, Token1PoolValue: 500000, Token2IDStr: prvIDStr, Token2PoolValue: 5000000},
+		"a-b":   {Token1IDStr: tokenAStr, Token1PoolValue: directA, Token2IDStr: tokenBStr, Token2PoolValue: directB},
+	}}
+}
+
+func TestFindPaths(t *testing.T) {
+	state := newTestRouterState(200000, 10000)
+	paths, err := state.FindPaths(tokenAStr, tokenBStr)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, [][]string{{tokenAStr, tokenBStr}, {tokenAStr, prvIDStr, tokenBStr}}, paths)
+
+	paths, err = state.FindPaths(prvIDStr, tokenBStr)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, [][]string{{prvIDStr, tokenBStr}}, paths)
+
+	delete(state.PDEPoolPairs, "a-b")
+	paths, err = state.FindPaths(tokenBStr, tokenAStr)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, [][]string{{tokenBStr, prvIDStr, tokenAStr}}, paths)
+
+	_, err = state.FindPaths(tokenAStr, tokenCStr)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestBestRoute(t *testing.T) {
+	// the small direct pool pays more for small trades
+	state := newTestRouterState(200000, 10000)
+	route, err := state.BestRoute(tokenAStr, tokenBStr, 1000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{tokenAStr, tokenBStr}, route.Path)
+	assert.Equal(t, 1, len(route.Hops))
+
+	// and the deep PRV pools for large ones
+	route, err = state.BestRoute(tokenAStr, tokenBStr, 100000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{tokenAStr, prvIDStr, tokenBStr}, route.Path)
+	assert.Equal(t, 2, len(route.Hops))
+	assert.Equal(t, route.Hops[0].ReceiveAmount, route.Hops[1].SellAmount)
+	assert.Equal(t, route.Hops[1].ReceiveAmount, route.ReceiveAmount)
+
+	quote, err := state.Quote(tokenAStr, tokenBStr, 100000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, quote.ReceiveAmount, route.ReceiveAmount)
+}
+
+func TestSplitRoute(t *testing.T) {
+	state := newTestRouterState(200000, 10000)
+	sellAmount := uint64(100000)
+	split, err := state.SplitRoute(tokenAStr, tokenBStr, sellAmount, 20)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(split.Routes))
+	assert.Equal(t, sellAmount, split.Routes[0].SellAmount+split.Routes[1].SellAmount)
+
+	best, err := state.BestRoute(tokenAStr, tokenBStr, sellAmount)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, split.ReceiveAmount > best.ReceiveAmount)
+
+	// one part is the best route
+	split, err = state.SplitRoute(tokenAStr, tokenBStr, sellAmount, 1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(split.Routes))
+	assert.Equal(t, best.ReceiveAmount, split.ReceiveAmount)
+
+	_, err = state.SplitRoute(tokenAStr, tokenBStr, 10, 11)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestTradeRouteTradeRequest(t *testing.T) {
+	state := newTestRouterState(200000, 10000)
+
+	route, err := state.SimulateRoute([]string{tokenAStr, tokenBStr}, 1000)
+	assert.Equal(t, nil, err)
+	md, err := route.TradeRequest("trader", "", 10, 1)
+	assert.Equal(t, nil, err)
+	tradeReq, ok := md.(*metadata.PDETradeRequest)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, tokenBStr, tradeReq.TokenIDToBuyStr)
+	assert.Equal(t, uint64(1000), tradeReq.SellAmount)
+	assert.Equal(t, route.ReceiveAmount*99/100, tradeReq.MinAcceptableAmount)
+
+	route, err = state.SimulateRoute([]string{tokenAStr, prvIDStr, tokenBStr}, 1000)
+	assert.Equal(t, nil, err)
+	md, err = route.TradeRequest("trader", "sub trader", 10, 0)
+	assert.Equal(t, nil, err)
+	crossPoolReq, ok := md.(*metadata.PDECrossPoolTradeRequest)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, metadata.PDECrossPoolTradeRequestMeta, crossPoolReq.Type)
+	assert.Equal(t, route.ReceiveAmount, crossPoolReq.MinAcceptableAmount)
+	assert.Equal(t, "sub trader", crossPoolReq.SubTraderAddressStr)
+
+	route, err = state.SimulateRoute([]string{prvIDStr, tokenAStr}, 1000)
+	assert.Equal(t, nil, err)
+	md, err = route.TradeRequest("trader", "", 10, 0)
+	assert.Equal(t, nil, err)
+	_, ok = md.(*metadata.PDECrossPoolTradeRequest)
+	assert.Equal(t, true, ok)
+}
@@ -595,7 +595,14 @@ func (outputCoin *OutputCoin) SetBytes(bytes []byte) error {
 // Encrypt returns a ciphertext encrypting for a coin using a hybrid cryptosystem,
 // in which AES encryption scheme is used as a data encapsulation scheme,
 // and ElGamal cryptosystem is used as a key encapsulation scheme.
+// It produces a HybridCipherTextVersion1 ciphertext, the format fullnodes and other wallets decrypt
 func (outputCoin *OutputCoin) Encrypt(recipientTK TransmissionKey) *PrivacyError {
+	return outputCoin.EncryptWithVersion(recipientTK, HybridCipherTextVersion1)
+}
+
+// EncryptWithVersion encrypts the coin details as Encrypt does with the given hybrid ciphertext version,
+// coin details of HybridCipherTextVersion2 fail to decrypt when they have been modified
+func (outputCoin *OutputCoin) EncryptWithVersion(recipientTK TransmissionKey, version int) *PrivacyError {
 	// 32-byte first: Randomness, the rest of msg is value of coin
 	msg := append(outputCoin.CoinDetails.randomness.ToBytesS(), new(big.Int).SetUint64(outputCoin.CoinDetails.value).Bytes()...)
 
@@ -604,7 +611,7 @@ func (outputCoin *OutputCoin) Encrypt(recipientTK TransmissionKey) *PrivacyError
 		return NewPrivacyErr(EncryptOutputCoinErr, err)
 	}
 
-	outputCoin.CoinDetailsEncrypted, err = HybridEncrypt(msg, pubKeyPoint)
+	outputCoin.CoinDetailsEncrypted, err = HybridEncryptWithVersion(msg, pubKeyPoint, version)
 	if err != nil {
 		return NewPrivacyErr(EncryptOutputCoinErr, err)
 	}
@@ -612,6 +619,11 @@ func (outputCoin *OutputCoin) Encrypt(recipientTK TransmissionKey) *PrivacyError
 	return nil
 }
 
+// DecryptMemo returns the memo in the coin info, decrypting it with recipient's viewing key if it is encrypted
+func (outputCoin *OutputCoin) DecryptMemo(viewingKey ViewingKey) ([]byte, error) {
+	return DecryptMemo(outputCoin.CoinDetails.GetInfo(), viewingKey)
+}
+
 // Decrypt decrypts a ciphertext encrypting for coin with recipient's receiving key
 func (outputCoin *OutputCoin) Decrypt(viewingKey ViewingKey) *PrivacyError {
 	msg, err := HybridDecrypt(outputCoin.CoinDetailsEncrypted, new(Scalar).FromBytesS(viewingKey.Rk))
@@ -67,7 +67,7 @@ func TestCoinMarshalJSON(t *testing.T) {
 		coin.snDerivator = RandomScalar()
 		coin.randomness = RandomScalar()
 		coin.value = uint64(100)
-		coin.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.snDerivator)
+		coin.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.snDerivator)
 		coin.CommitAll()
 		coin.info = []byte("Incognito chain")
 
@@ -98,7 +98,7 @@ func TestCoinBytesSetBytes(t *testing.T) {
 		coin.snDerivator = RandomScalar()
 		coin.randomness = RandomScalar()
 		coin.value = uint64(100)
-		coin.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.snDerivator)
+		coin.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.snDerivator)
 		coin.CommitAll()
 		coin.info = []byte("Incognito chain")
 
@@ -128,7 +128,7 @@ func TestCoinBytesSetBytesWithMissingFields(t *testing.T) {
 		coin.snDerivator = RandomScalar()
 		coin.randomness = RandomScalar()
 		coin.value = uint64(100)
-		coin.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.snDerivator)
+		coin.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.snDerivator)
 		//coin.CommitAll()
 		coin.info = []byte("Incognito chain")
 
@@ -159,7 +159,7 @@ func TestCoinBytesSetBytesWithInvalidBytes(t *testing.T) {
 	coin.snDerivator = RandomScalar()
 	coin.randomness = RandomScalar()
 	coin.value = uint64(100)
-	coin.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.snDerivator)
+	coin.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.snDerivator)
 	coin.CommitAll()
 	coin.info = []byte("Incognito chain")
 
@@ -203,7 +203,7 @@ func TestInputCoinBytesSetBytes(t *testing.T) {
 		coin.CoinDetails.snDerivator = RandomScalar()
 		coin.CoinDetails.randomness = RandomScalar()
 		coin.CoinDetails.value = uint64(100)
-		coin.CoinDetails.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.CoinDetails.snDerivator)
+		coin.CoinDetails.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.CoinDetails.snDerivator)
 		coin.CoinDetails.CommitAll()
 		coin.CoinDetails.info = []byte("Incognito chain")
 
@@ -232,7 +232,7 @@ func TestInputCoinBytesSetBytesWithMissingFields(t *testing.T) {
 	coin.CoinDetails.snDerivator = RandomScalar()
 	coin.CoinDetails.randomness = RandomScalar()
 	coin.CoinDetails.value = uint64(100)
-	coin.CoinDetails.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.CoinDetails.snDerivator)
+	coin.CoinDetails.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.CoinDetails.snDerivator)
 	//coin.CoinDetails.CommitAll()
 	coin.CoinDetails.info = []byte("Incognito chain")
 
@@ -259,7 +259,7 @@ func TestInputCoinBytesSetBytesWithInvalidBytes(t *testing.T) {
 	coin.CoinDetails.snDerivator = RandomScalar()
 	coin.CoinDetails.randomness = RandomScalar()
 	coin.CoinDetails.value = uint64(100)
-	coin.CoinDetails.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.CoinDetails.snDerivator)
+	coin.CoinDetails.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.CoinDetails.snDerivator)
 	//coin.CoinDetails.CommitAll()
 	coin.CoinDetails.info = []byte("Incognito chain")
 
@@ -302,7 +302,7 @@ func TestOutputCoinBytesSetBytes(t *testing.T) {
 	coin.CoinDetails.snDerivator = RandomScalar()
 	coin.CoinDetails.randomness = RandomScalar()
 	coin.CoinDetails.value = uint64(100)
-	coin.CoinDetails.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.CoinDetails.snDerivator)
+	coin.CoinDetails.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytesS(privateKey), coin.CoinDetails.snDerivator)
 	//coin.CoinDetails.CommitAll()
 	coin.CoinDetails.info = []byte("Incognito chain")
 	coin.Encrypt(paymentAddr.Tk)
@@ -332,7 +332,7 @@ func TestOutputCoinBytesSetBytesWithMissingFields(t *testing.T) {
 	coin.CoinDetails.snDerivator = RandomScalar()
 	coin.CoinDetails.randomness = RandomScalar()
 	coin.CoinDetails.value = uint64(100)
-	//coin.CoinDetails.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytes(SliceToArray(privateKey)), coin.CoinDetails.snDerivator)
+	//coin.CoinDetails.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytes(SliceToArray(privateKey)), coin.CoinDetails.snDerivator)
 	//coin.CoinDetails.CommitAll()
 	coin.CoinDetails.info = []byte("Incognito chain")
 	coin.Encrypt(paymentAddr.Tk)
@@ -361,7 +361,7 @@ func TestOutputCoinBytesSetBytesWithInvalidBytes(t *testing.T) {
 	coin.CoinDetails.snDerivator = RandomScalar()
 	coin.CoinDetails.randomness = RandomScalar()
 	coin.CoinDetails.value = uint64(100)
-	//coin.CoinDetails.serialNumber = PedCom.G[0].Derive(PedCom.G[0], new(Scalar).FromBytes(SliceToArray(privateKey)), coin.CoinDetails.snDerivator)
+	//coin.CoinDetails.serialNumber = new(Point).Derive(PedCom.G[0], new(Scalar).FromBytes(SliceToArray(privateKey)), coin.CoinDetails.snDerivator)
 	//coin.CoinDetails.CommitAll()
 	coin.CoinDetails.info = []byte("Incognito chain")
 	coin.Encrypt(paymentAddr.Tk)
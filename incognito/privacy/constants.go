@@ -9,13 +9,18 @@ const (
 )
 
 const (
-	Ed25519KeySize        = 32
-	AESKeySize            = 32
-	CommitmentRingSize    = 8
-	CommitmentRingSizeExp = 3
-	CStringBulletProof    = "bulletproof"
-	CStringBurnAddress    = "burningaddress"
-	FixedRandomnessString = "fixedrandomness"
+	Ed25519KeySize          = 32
+	AESKeySize              = 32
+	CommitmentRingSize      = 8
+	CommitmentRingSizeExp   = 3
+	CStringBulletProof      = "bulletproof"
+	CStringBurnAddress      = "burningaddress"
+	CStringHybridEncryption = "hybridencryption"
+	CStringMuSigKeyAgg      = "musigkeyagg"
+	CStringMuSigNonce       = "musignonce"
+	CStringSchnorrNonce     = "schnorrnonce"
+	CStringThresholdNonce   = "thresholdnonce"
+	FixedRandomnessString   = "fixedrandomness"
 )
 
 const (
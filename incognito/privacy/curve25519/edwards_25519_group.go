@@ -40,6 +40,28 @@ func (c *CachedGroupElement) Zero() {
 	FeZero(&c.T2d)    //c.T2d.Zero()
 }
 
+// CachedGroupElementSize is the length of the encoding produced by CachedGroupElement.ToBytes
+const CachedGroupElementSize = 4 * KeyLength
+
+// ToBytes encodes the four field elements of c in canonical little-endian form,
+// so the encoding does not depend on the FieldElement representation of the platform
+func (c *CachedGroupElement) ToBytes(s *[CachedGroupElementSize]byte) {
+	var k Key
+	for i, fe := range []*FieldElement{&c.yPlusX, &c.yMinusX, &c.Z, &c.T2d} {
+		FeToBytes(&k, fe)
+		copy(s[i*KeyLength:(i+1)*KeyLength], k[:])
+	}
+}
+
+// FromBytes decodes an encoding produced by CachedGroupElement.ToBytes
+func (c *CachedGroupElement) FromBytes(s *[CachedGroupElementSize]byte) {
+	var k Key
+	for i, fe := range []*FieldElement{&c.yPlusX, &c.yMinusX, &c.Z, &c.T2d} {
+		copy(k[:], s[i*KeyLength:(i+1)*KeyLength])
+		FeFromBytes(fe, &k)
+	}
+}
+
 func (p *ProjectiveGroupElement) Zero() {
 	FeZero(&p.X)
 	FeOne(&p.Y)
@@ -0,0 +1,91 @@
+// +build ignore
+
+// This program generates generators_table.go, the embedded table of the
+// hash-derived generators used by the Pedersen commitment and the bulletproofs.
+// It only depends on curve25519 so it can run while the table is missing.
+// To regenerate the table, run `go generate` in the privacy package.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+
+	C25519 "github.com/0xkraken/incognito-wasm/incognito/privacy/curve25519"
+)
+
+const (
+	// must match CStringBulletProof in constants.go
+	cStringBulletProof = "bulletproof"
+
+	// indices [1, numEmbeddedGenerators] cover the 4 hash-derived Pedersen bases,
+	// the 2*MaxOutputCoin*MaxExp bulletproof generators g, h and the generator u
+	numPedersenBases      = 5
+	numEmbeddedGenerators = numPedersenBases + 2*32*64
+)
+
+// hashToPointFromIndex is a copy of privacy.HashToPointFromIndex
+func hashToPointFromIndex(index int64, padStr string) C25519.Key {
+	array := C25519.GBASE.ToBytes()
+	msg := array[:]
+	msg = append(msg, []byte(padStr)...)
+	msg = append(msg, []byte(string(rune(index)))...)
+
+	keyHash := C25519.Key(C25519.Keccak256(msg))
+	return *keyHash.HashToPoint()
+}
+
+func writeTable(buf *bytes.Buffer, data []byte) {
+	buf.WriteString("\"")
+	for _, b := range data {
+		fmt.Fprintf(buf, "\\x%02x", b)
+	}
+	buf.WriteString("\"\n")
+}
+
+func main() {
+	var generators, precomputed []byte
+
+	bases := make([]C25519.Key, numPedersenBases)
+	bases[0] = C25519.GBASE
+	for i := int64(1); i <= numEmbeddedGenerators; i++ {
+		key := hashToPointFromIndex(i, cStringBulletProof)
+		generators = append(generators, key[:]...)
+		if i < numPedersenBases {
+			bases[i] = key
+		}
+	}
+
+	for i := range bases {
+		var point C25519.ExtendedGroupElement
+		var cached [8]C25519.CachedGroupElement
+		point.FromBytes(&bases[i])
+		C25519.GePrecompute(&cached, &point)
+		for j := range cached {
+			var encoded [C25519.CachedGroupElementSize]byte
+			cached[j].ToBytes(&encoded)
+			precomputed = append(precomputed, encoded[:]...)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("// Code generated by gen_generators.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package privacy\n\n")
+	fmt.Fprintf(buf, "const numEmbeddedGenerators = %d\n\n", numEmbeddedGenerators)
+	buf.WriteString("// generatorsTable holds HashToPointFromIndex(i, CStringBulletProof) for i in [1, numEmbeddedGenerators]\n")
+	buf.WriteString("const generatorsTable = ")
+	writeTable(buf, generators)
+	buf.WriteString("\n// pedersenPrecomputedTable holds the GePrecompute tables of PedCom.G encoded by CachedGroupElement.ToBytes\n")
+	buf.WriteString("const pedersenPrecomputedTable = ")
+	writeTable(buf, precomputed)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("generators_table.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
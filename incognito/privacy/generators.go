@@ -0,0 +1,48 @@
+package privacy
+
+import (
+	"sync"
+
+	C25519 "github.com/0xkraken/incognito-wasm/incognito/privacy/curve25519"
+)
+
+//go:generate go run gen_generators.go
+
+// GeneratorFromIndex returns HashToPointFromIndex(index, CStringBulletProof).
+// Indices covered by the embedded table are read from it instead of being hashed to the curve
+func GeneratorFromIndex(index int64) *Point {
+	if index < 1 || index > numEmbeddedGenerators {
+		return HashToPointFromIndex(index, CStringBulletProof)
+	}
+
+	// the table is checked against HashToPointFromIndex in tests, so it skips the point validation
+	p := new(Point)
+	offset := (index - 1) * Ed25519KeySize
+	copy(p.key[:], generatorsTable[offset:offset+Ed25519KeySize])
+	return p
+}
+
+// pedersenPrecomputed holds the [8]CachedGroupElement tables of the Pedersen generators,
+// decoded from pedersenPrecomputedTable on first use
+type pedersenPrecomputed struct {
+	once sync.Once
+	g    [][8]C25519.CachedGroupElement
+}
+
+func (pre *pedersenPrecomputed) get() [][8]C25519.CachedGroupElement {
+	pre.once.Do(func() {
+		numBases := len(pedersenPrecomputedTable) / (8 * C25519.CachedGroupElementSize)
+		pre.g = make([][8]C25519.CachedGroupElement, numBases)
+
+		var encoded [C25519.CachedGroupElementSize]byte
+		offset := 0
+		for i := range pre.g {
+			for j := range pre.g[i] {
+				copy(encoded[:], pedersenPrecomputedTable[offset:offset+C25519.CachedGroupElementSize])
+				pre.g[i][j].FromBytes(&encoded)
+				offset += C25519.CachedGroupElementSize
+			}
+		}
+	})
+	return pre.g
+}
@@ -0,0 +1,11 @@
+// Code generated by gen_generators.go; DO NOT EDIT.
+
+package privacy
+
+const numEmbeddedGenerators = 4101
+
+// generatorsTable holds HashToPointFromIndex(i, CStringBulletProof) for i in [1, numEmbeddedGenerators]
+const generatorsTable = "\x21\xd7\x6c\x7e\xd1\xe9\x61\x2e\x1f\xbd\xc5\x9d\x57\x5f\x60\x84\xdd\x00\x16\xf4\x1c\x02\xfa\x10\x2c\x48\xe0\x2b\x79\xf9\xa2\x6a\x81\x37\xdc\x59\x23\x48\x72\xd6\xb3\xba\x13\xce\x00\xda\x25\x03\x37\xf6\x19\xd8\xb4\xad\x24\x87\x27\xbf\x9b\xc8\x5d\x44\x6e\x43\xe0\x79\x6c\x3a\x4b\x33\x9a\x32\x09\xde\x51\x4b\xf5\x2f\x4e\xb5\x2c\xb1\xc3\xdf\xdb\x5d\xb2\xd8\xb0\x85\x44\xf8\x36\x24\x7d\x13\x74\x66\xee\x9a\x08\x74\xba\x6c\xaa\xd4\x5e\x19\x07\x04\x9d\xcf\x3f\x05\x4f\xe5\x8d\x8e\xef\x21\x09\xc3\x93\x45\xc4\x19\x33\x5a\xae\x50\xa5\xf8\x5c\x57\x87\xb0\xa0\x74\x39\x54\x4d\xc8\x27\x68\xe6\x25\x77\x86\xc3\x70\x96\xa6\xc8\x70\x97\x51\xe7\xb4\xd9\xc4\x40\x36\xff\xd3\xd4\x45\xb9\x9b\xd5\x2c\x8b\xcf\x7d\x22\xf5\x0d\xe7\xec\x72\x60\x5a\xea\x08\xfe\x10\x9b\x69\xdb\x80\x0c\x32\x0d\x0a\xb1\x3e\x43\x48\x09\xd8\x7c\x8c\x99\x84\x5c\x75\x5d\xb8\x22\x22\x35\x02\x38\xf6\x61\xad\x54\xc0\x50\x84\xa3\x86\x48\x7b\x35\x40\x35\x6f\xec\x17\xee\x3b\xd2\xfd\x5f\xd5\x34\x8e\x67\x97\x2a\x62\xc2\xe4\xdb\xd5\xfb\x81\xb0\x27\xf6\x95\xfc\x4b\x81\x97\x9f\x09\x97\x72\xc9\x94\xe2\x99\xb2\x98\xd1\x44\x14\x4c\x89\xa3\x52\xb6\xa5\x6a\x1f\x05\xd7\xa6\xd9\xc6\x5f\xa0\xd7\x1e\xcf\x5f\x3c\xc1\x8e\x82\x41\x8d\x44\x8d\xa2\x81\x17\xe9\x16\xaa\xa1\x93\xd2\x74\x29\x7d\xa7\x45\xb0\xe5\x86\xdb\x86\xd6\x27\xf8\x6c\xd8\x28\x71\xee\x50\x62\xc3\x69\x5c\xd4\xb4\x78\xdc\x30\x0b\x5e\x81\xf3\x10\x68\xab\xcd\xd4\x69\xde\x9e\x77\xa6\xb5\xe8\x5b\xc7\x91\x17\xd9\x2a\x12\xfe\xb7\xeb\x1d\x88\xdf\x14\xe4\xb4\x58\xbd\xe3\x27\x88\x2e\xbb\xed\xec\xfe\x9e\x0f\xa4\xaa\x90\x4d\x8c\xe0\xd5\x54\xca\xc1\x7a\xaf\x93\x0b\xc8\x0f\xee\x0e\x5b\x98\x4e\x26\xae\x84\x43\x4d\xb6\xc8\x6d\x31\xa8\x9a\x88\xfa\x84\xa2\xe9\xfd\xe6\xf8\xf2\x32\xdf\x42\x7d\x5a\xfc\x4d\xbb\x12\xcd\xcb\xfa\xa6\xb7\x7c\x67\xf7\xd2\x51\xdd\xc5\x97\xac\x00\xd6\xd0\x7a\xf8\x72\x96\xb7\x31\xfa\x47\x08\x58\x02\x9e\x16\xe6\x7a\x25\x90\x76\x63\x47\x59\x44\x24\x2a\x6e\xb3\x6c\x1b\xeb\xf8\x1f\x03\xf8\x1f\x69\xf7\xb7\xfd\xa0\x4f\x07\x32\xbd\xef\x75\xef\x10\xbc\xcd\x2b\x33\x54\xf1\x3f\x57\x08\xf2\x0a\x86\xc8\xe1\xf9\xc4\x05\x5a\x59\xfe\xae\xd6\xbf\x7f\xff\x6b\xdd\x5a\xe8\x7a\xeb\x0c\x85\x81\xb4\xcf\xc6\x22\x33\xf7\x50\x2f\x03\x36\xe4\x2a\x47\xf6\x6d\x63\x00\xf5\x7e\x4f\x24\xd7\xcd\x60\x69\xe1\x50\xa2\x7d\xd0\x49\xf3\x66\x46\x81\x0e\x45\x40\x66\x55\xc3\x74\x7a\xe5\x17\x0e\xe2\x1e\x30\x50\x00\x18\x3a\xfa\x1d\x0a\xee\x72\x45\x25\x92\x37\xbd\x26\x22\xef\x83\x2a\x99\xbc\x41\x3c\x12\x1a\x06\x16\x7b\xd3\x9f\xe7\x11\x7c\xe4\x48\xe6\x9d\x67\x71\xca\x43\x47\x99\x24\x74\x66\x0e\xdb\x87\x4e\xde\xce\x9c\x53\x53\xcf\xae\x28\x06\x7f\x54\xab\xdd\x43\x78\x20\x8c\x12\x5c\x03\xaa\xf5\x9a\x65\x63\x85\x70\xb3\x1c\x75\xe7\xf7\xf4\x41\x96\xbe\xfc\x7a\xa2\xed\x89\x18\xcc\xeb\x68\xb0\x61\x95\x0d\x3f\x54\x10\xe6\xeb\x75\x62\x6a\x40\x8c\x75\x54\xf6\x5a\x66\xfa\xb4\xf0\x21\xba\xed\xba\xc8\x35\xe8\x76\x20\xe1\x62\x16\x13\x7d\x5e\x2f\xae\x4b\x88\x89\x6d\x9e\x07\xbc\x2a\x1b\x82\x9b\x5b\xf9\x8c\x1d\xc8\x77\xf3\x9b\xb5\x54\xd0\x93\x06\x25\x53\xa6\x23\xee\x48\x9d\x84\xe4\xcd\x5e\xfb\xbf\x1b\xe3\xf9\x95\x26\x99\x6b\xae\x21\x42\x3c\xb6\x45\x12\x92\xe2\x93\x39\xb1\xdf\x43\xd3\x78\x7f\xe5\x71\xae\x07\x9f\x4f\x19\xcb\x11\xa9\xe8\x9b\xd0\x06\xbe\x96\x47\x41\xc5\x14\x37\x26\xa8\x9a\x59\x34\x14\xdf\x0f\xa7\x43\x8b\x10\x56\x38\x2c\xb3\xf2\xb1\x65\xd6\x28\x69\xc6\x08\x5d\x90\xca\xbc\xb8\x1b\x03\xf9\xe5\xd9\x12\x97\x4c\x10\x7b\xf2\x86\x74\xca\xfa\x90\x53\xe1\x0e\x76\x6d\x21\x8b\x7e\x1c\xd1\x15\x2e\x7a\x4e\x28\xdd\x3f\x9c\x20\x3d\xd7\x58\x37\xa2\xc8\xa0\xa9\x72\xd3\xce\x99\xef\xb6\x82\xf9\x0b\xeb\x70\x79\x65\x1b\x03\x50\x05\xbf\x07\xe8\x7f\x86\x1d\x37\x5f\x5e\x25\x8e\x63\x9d\x68\xf4\x14\xde\x2a\xcb\xfb\x6f\x4c\xd7\x25\x56\xfb\x6c\x17\x2d\x37\xad\xaf\xe8\x60\x80\x85\x0f\x17\x28\xbf\x79\xb9\x69\x7f\xbd\x8f\x1d\xda\x4b\x8d\x26\xbb\x22\xa8\xe5\x75\xf6\x56\xf5\x41\x9f\xfb\xa5\x70\xaa\xdd\x3d\xce\x2d\x8e\xe6\x54\xfa\xb8\xd7\xb6\xba\xcb\xe0\x08\x3d\xa7\x92\xb2\xa4\x96\xad\xef\x76\x20\x7a\xce\xf4\x8f\x77\x3e\xac\x66\xe8\x3b\xb0\xe7\xa6\x13\x28\x32\x55\x83\x4d\xfb\x90\x81\xf5\xdc\x26\xf0\xc7\xa2\x5e\xf8\x26\x83\x85\x22\x6f\x55\x6d\xc7\x87\x5c\xd1\x6c\x34\x28\x69\x7c\x61\x78\x37\xa0\xbd\xbe\xa3\xe2\xfd\x61\xb0\xea\x86\x57\x31\xc7\xcb\x0c\x04\x4f\x6c\x1e\xdd\x4f\xd2\x01\x9b\x2c\x73\x0d\xfd\x38\x0e\x37\x17\xea\xd7\x2b\x27\x96\xae\x1f\x83\xf7\xa2\x97\x1e\xcd\x4a\x8e\xff\x96\x17\x7c\x06\x6f\xfa\x41\xe2\xc5\xf3\x5b\xda\x0d\x86\x5c\xa9\x38\x44\xba\xf9\xcb\x40\xd8\x05\x9b\x57\x45\x30\x88\x24\xe6\x56\x97\x63\xf2\x60\x6d\x20\x16\xfe\x0c\xfc\xcb\x04\x6a\xf1\x08\x0c\xd1\x67\x3b\x60\xd3\x84\xda\x15\xdf\x98\x05\x10\xd3\xcb\xa3\xfc\x21\x2d\x45\x4b\xd1\xfb\x6e\x41\xe5\x2b\xd0\xe1\x8f\x14\xe3\xa7\xfe\x1b\x18\x6a\xc3\xa4\xc1\x0c\x66\xe3\x40\xb3\x59\x08\xfc\x99\x93\xab\x84\xe3\x82\xa1\xe8\xb9\x36\xcf\x44\x1b\x2b\xd3\xa5\xc5\x79\xe0\xcb\xc2\x12\xf0\x2b\xd3\x9f\xdd\x31\x58\x9e\x14\x22\x5f\xaa\x56\x2e\x2d\xcc\xa3\x35\xfe\xd2\xb5\xdf\x80\xff\xda\x5b\x08\x17\x75\xa5\x51\x8e\x9a\x57\x53\x26\xc5\x48\x10\x46\xb8\x3f\xb3\xa2\x0b\xa9\xb7\x58\xd1\x2d\x03\x71\x54\xd3\xf2\xff\xbd\xa5\xc3\x25\x6f\x0d\x72\xf6\xe1\xfb\x2a\x36\xee\xfe\xc1\x17\x47\xc0\x3b\x1e\x55\x6f\x44\xe5\x92\x3e\x7b\xc0\x2a\x19\x9a\x39\x19\xd3\x3c\xce\x4d\xec\x5a\xd3\x19\x01\xbf\x01\x07\x9c\x14\x29\xa5\xc6\x1b\x42\x59\xa0\x3e\x75\xc7\x50\xf0\x56\xce\x92\x2d\x09\x17\x46\xa7\x01\x20\xea\x0a\xb8\x68\x6b\x60\x9f\x17\x35\x97\x8c\x23\xd8\x22\xbb\xc6\xc4\x73\x69\x2a\x48\x46\xa8\x6c\x49\x57\x26\x76\xca\x41\x40\x42\x12\xa7\x2b\xd5\x14\x26\x2e\x83\xbd\xdc\xd4\x96\x30\xee\xc6\xce\xf6\xf2\x67\x83\xa8\x05\x11\x49\x4f\xf3\xbc\xe1\xbf\x37\xa8\xbf\xdc\x77\x6e\x85\xa5\x08\x7e\xb6\x4f\xd5\x16\x86\x81\x74\xc0\x18\x3c\x92\xfa\x89\x45\xa7\x1f\x50\x26\x77\xbc\x7f\xcd\x96\xeb\x7b\x5b\xd7\xe5\xd3\x86\x3e\xde\x2c\x85\xf2\x72\x74\xe5\x71\x2b\xca\x50\x10\x9b\xe5\x3a\x40\x1c\xfe\x57\x2a\xf6\xfb\xc5\x4c\xde\xe5\x3a\x88\x15\x95\x6a\xcd\x16\xce\xac\xf6\xc9\x6d\xef\x7c\x31\x80\x51\xf5\xb6\x71\x4e\x4f\x20\xa1\x40\x09\xff\x26\x08\xb2\x06\x85\xe7\xaf\x6e\x57\xe2\xe1\x99\x71\xa0\x84\xbd\x3c\xb4\x0b\xe3\x36\x87\x24\x4a\xde\xc3\xef\x79\xd3\x91\x91\x02\x61\x57\x53\xe2\x3f\x50\x6d\x41\x10\xec\x52\xff\xb3\x63\xa1\x3d\x37\x5b\xf6\xed\xea\xad\xef\x66\x7f\x6c\x52\xb1\x07\xce\x59\x5c\x3a\x08\xbb\x93\xbf\x6e\xd5\x82\xcb\x18\xeb\xd3\x67\x98\x14\xdd\x70\xad\x6f\x84\x63\x23\x0d\x97\x7e\xe3\x4a\x8f\x9b\xc1\xfd\x8e\xfc\x9b\x05\xdb\x50\x6c\x8f\xb2\xc3\x77\x04\xf1\xaa\x1d\x43\xb9\x0e\x72\xd4\x42\xb5\xb9\xf6\x12\x63\x17\x56\xfb\xa5\x59\x55\x09\xdc\x2a\x4b\xfe\x11\xba\x0b\x07\x6f\xcb\x8a\x99\xb9\x06\x3e\x5c\x17\x0c\x3e\x68\xa1\x1a\xdf\x6e\xb3\x55\x7f\x7f\x8f\x5c\x2a\x69\x84\x62\x61\xb3\x35\x4b\x04\xa7\xa6\x94\x7b\x38\x5a\x73\x43\xd9\xcb\x59\xf9\x40\x35\x33\xf1\x44\x7c\x70\xad\x4c\x22\xbf\xad\xef\x42\x0e\x03\x88\xf8\x4a\x44\x22\x22\x09\xb5\x60\xad\xfc\x36\x63\x9d\xb0\x39\x5f\x8e\x92\xd2\x12\x4f\x76\x2f\x4d\x1d\x40\xa9\x2a\x1d\xeb\xaa\x19\xc2\xe9\x3d\x02\x87\xe3\x4d\xc7\x6d\xf0\x0b\x74\xa1\xa4\x73\x26\x20\x80\x3a\x1b\x04\xb7\xd8\x61\xe4\x7c\xdf\xf7\x35\x92\xb5\x75\xd3\x03\xff\xc3\x13\xe3\x95\xc8\x10\x32\x1b\x27\x25\x2c\x96\x42\x64\x44\x6d\xa1\x10\xf3\x8e\x39\xf0\x09\xbf\x94\xd8\x8d\x5a\x7a\x6e\xfa\xc3\x81\x90\xc2\xbc\x65\xf9\xba\x55\x26\xaf\x19\x59\x26\x6c\x7f\x00\x82\x13\x3c\x25\x54\xb7\x4d\x11\x48\xcc\xbe\xf0\xf0\xc2\xf5\x26\x8e\xd6\xcf\x83\xb4\x5f\x59\xcb\x19\x4f\x07\x7c\x1a\x2a\x2d\x73\xc0\xe7\x0f\xea\xe1\x6a\x58\x86\x1d\x48\x8f\xc4\x31\x5c\x1f\x78\x28\x7d\xcd\xd8\xa2\x68\xed\xfa\xce\x25\x1a\xc2\x6e\x70\x2b\xd0\x9a\xaf\x70\x9f\xec\x7d\xbd\xe5\xf3\x15\xae\x4b\x3e\x30\xc6\x10\xc0\xe8\x25\x43\x3e\x1b\x1e\x7d\x24\x0a\x8c\xb5\xf6\x10\xe9\x87\x69\xb8\x46\xe6\x97\xf0\x5c\x1c\x81\x93\x01\x43\x39\xaf\xf3\xf0\x90\x1b\x8c\xf0\x67\x0b\x7f\x85\x5c\x87\x52\x1a\xed\x87\x74\xb7\x6a\x13\x04\x18\xa7\x12\xb4\x2d\xc2\x89\x42\xf5\x27\xbe\x07\x2b\xf3\xad\x1c\xb2\xfd\xe2\xe3\xac\x1f\x78\x95\xbc\x09\xd2\xb3\xfc\xf8\x18\x27\xb2\xea\x9c\x99\xb4\xeb\x52\x84\xcc\x2a\xc5\x5d\x09\x16\x05\x38\x36\x87\xee\x96\x74\xf0\x91\x13\x92\x3a\xc1\x46\x36\x9d\x07\x59\x81\x5b\xad\x26\x82\xcb\x0a\xba\x88\x23\x4e\xb2\xe3\x9c\xd3\x99\x38\xe6\xd4\x13\x23\x1c\xaa\x3b\xb3\x57\x55\xdf\xfe\xca\x67\xfd\xcd\x19\xac\x96\x92\x76\x8e\x63\x19\xeb\x8c\x73\x69\xcc\xc6\x00\x69\x4e\x80\x8d\x6e\x83\x63\x2f\x54\xe7\x91\x1d\x15\x53\x78\xc0\x29\xa6\x50\xa4\x20\xe4\x2d\x85\x9b\x93\x77\xf0\xff\xfc\xa9\x26\xa2\x6e\x95\xd8\xae\xf1\xfc\xc5\x66\xa1\x7e\xc2\x21\x02\xa9\x55\x90\x7b\xfb\x0f\x31\xd3\x6d\x3c\xf0\xa6\x14\x59\x8a\x39\xb3\xd4\xb0\x8f\xea\x56\x24\x77\x7e\xeb\x46\x45\x19\xc3\x7c\xf9\x15\xd3\x58\x30\xb2\x06\x8c\x7b\xe5\x02\x9f\x94\xb5\xdd\x8c\xaf\x35\x56\xc9\x74\x5e\x1d\xbb\xbd\x0c\x72\xfa\xca\x1e\xb9\x12\x41\x6d\x2d\x85\x03\x02\xe4\x3d\x70\x0a\xa0\x24\x9b\x0a\x05\x92\xa7\x15\x03\x05\xc4\x6e\x79\x92\x82\xcd\xac\x2f\x5c\xc4\x26\x02\xb4\xda\x3b\xc9\x55\x19\x60\x88\x0a\x79\x9c\x33\x4e\xef\x06\xe1\xdc\x1c\xbe\x8a\x9f\x9b\x76\x86\xe4\xbe\x9e\x41\xaf\xe4\x13\x80\x0b\xaf\x59\x75\x3d\x63\xb9\xd6\xb3\xa1\xdd\x6b\x35\xdd\x99\xf0\xb6\x68\xd5\x7b\x87\xc8\xd9\x08\xd9\x5d\xf2\x1c\xce\x9e\x89\x08\xc2\x33\x8c\x94\x2e\xac\xa1\xd9\xe4\x97\xc3\x04\x86\xcd\x05\x05\xe5\x03\x0a\x75\x19\x58\x6c\x35\x29\xe1\xef\xc7\x5d\xc0\x77\x0f\x15\xe4\xf3\x35\xd8\x62\x51\xb0\xfe\xed\xfe\xd6\xf2\x9b\xce\x59\x2d\x44\xbe\x5e\xbf\xb8\x21\xa7\xd1\x64\xc0\x05\xb3\x89\x35\xa3\x5e\xd9\xa6\x83\x79\x78\xb6\x40\xf0\x5c\xb2\xce\x7f\xec\x8f\x10\x22\xc1\x28\x58\xe3\x01\x48\x66\x75\xfb\x9a\x79\x74\xe9\x96\x8a\xf7\xc0\x63\x11\x24\x73\xd7\x70\x03\xea\x29\x82\x00\xd2\xb1\x42\x38\xc6\xbd\x7e\x67\xaf\xc6\x9c\x6f\xf7\xad\x2c\xf4\x4c\x93\x94\x99\xaa\x07\x93\xb2\x33\xe7\x61\x42\x9e\xc5\xd8\x29\x95\x97\xa6\x6e\x3a\x18\x49\x3f\x7a\x37\x97\xa6\x9d\x5c\x37\xc4\x6b\x73\x6c\x3e\xf5\x1d\x37\xb8\x52\x51\xb0\xd2\xb0\x4b\xcf\xd2\x0f\x59\x6d\xa4\x6a\x69\xcc\xcd\x00\x36\x1e\x05\x7c\x23\xeb\xb4\x44\xa9\x76\xd1\x17\x32\xcb\x9b\x9f\x9c\xab\x81\xa1\x3f\x50\x65\x9c\x65\x39\x92\x13\x49\xe8\xf5\x86\xf1\xb1\x3c\x75\x90\x56\xcf\x3e\x2d\x9e\x88\xcc\xb2\xdb\x29\x81\x0b\x8b\x3a\xb8\xa5\xe7\x4a\xab\xf6\x81\xcd\x53\x1a\x31\x1d\x70\x71\xd0\x86\xee\x1f\x39\x6e\x01\xfd\x94\xb5\xce\xc3\xb3\xe6\x01\x50\x72\x8f\x76\xcf\x42\x4c\x81\xf3\xe6\x40\xa9\x6b\x5e\x20\x62\xd8\x91\x2d\xd2\xbc\xb9\xd7\xe0\x74\x04\x49\x44\xee\xa4\x23\x51\x6d\x67\x4c\xa1\xf6\x5f\xb9\x4b\xa5\x3c\x39\xf2\x82\x40\xbc\xea\x12\x66\x86\xb7\xe9\x8d\xe5\x07\x7c\xe6\x34\x7b\x25\x16\x80\xdd\x50\x54\xcd\x3c\x30\x0b\xc4\xea\x09\x66\x51\xfc\x15\xf9\x93\x8e\xe3\xc4\x21\xf4\xa6\x83\x4a\x5e\x7a\x68\xc0\x36\xdb\x9d\x44\x0b\x4e\x1c\x0a\x38\x64\x56\x88\x0f\x48\x28\xf6\xe5\x61\xb1\xf3\xa3\x7c\x7d\xfb\xe0\xe1\x17\x57\xed\xa2\x61\x69\xea\x9f\x76\x23\xbe\x3d\x42\x7a\xf8\xcb\x86\x2a\x02\xf9\xcb\x4b\x25\xfd\xe3\x6c\xf7\x75\xed\x71\x36\x12\x5f\xcd\xae\x94\xaa\xaf\xde\xa7\xe5\xb4\xd3\x96\x12\xd4\xf8\x24\x2b\x58\x91\x81\xe3\x06\xdc\x8f\x1d\xdf\x59\xe7\x73\x0f\x8e\x30\x91\x8e\xe3\x8a\x2d\x2b\x06\x01\x90\x55\xcf\xdf\xab\xbb\x0d\xaf\x59\x0d\xba\xe9\x9a\xd8\xda\x1e\x68\x19\x4f\x56\xc2\xe9\x11\x10\x3f\x70\x6c\x60\xf6\x2d\x78\x72\xaa\xd1\x96\x65\x7e\x15\x56\xd9\xd6\x8f\xc2\x04\x77\xde\xd7\x76\x44\x50\x37\x24\x10\xb5\x8c\x8c\xe1\x60\xa0\xe8\x7f\x10\x73\x1c\x45\xb7\x3e\xfd\x65\x77\xfb\xf7\x80\xfa\x5d\xd9\xb8\x43\x04\xaf\xbf\xef\x76\x93\x8d\xfc\x17\x8a\x55\x6c\x65\x74\xce\x33\x51\xa5\x5c\xca\x17\xd0\xb0\x65\xfa\x0a\x99\xee\x94\x00\x77\x58\x6b\x29\xc7\x2a\x55\x0d\xb7\xe6\xc4\x57\x43\xb4\xe3\x43\x3b\x1e\x82\x14\x93\x45\x9b\xe3\x82\x3d\xde\xa7\xc3\x6a\x35\x9e\x75\xd1\x2a\x32\xf1\xe0\xc8\x4e\x13\x7e\x77\x2c\x13\x1f\x92\x20\x49\x3c\x38\x36\x71\xa9\xbe\x0a\x41\x05\xe0\x3d\xb9\xc2\xc1\xba\xdb\x09\xcc\xa6\xc6\xdc\xd8\x66\xde\x38\xa9\xb6\xb7\x58\xaa\x16\x32\x06\x1a\xb4\xbf\xcf\x6b\xe8\x25\xe9\xa7\xc6\x3f\xb0\xe2\xe7\xd4\x5f\x6f\x84\x71\x1a\x2d\x78\x1a\x47\x93\x69\xa1\xa3\x73\x74\x84\xfd\xd5\x60\xb7\x18\x31\x58\x83\x4f\x13\x2a\xa7\x55\x5d\x8e\x8d\x81\xbf\x14\x49\xca\xef\x01\x00\xf6\xa0\x3c\x2e\x64\x44\x01\x66\x8e\x96\x51\xcf\x7f\x91\x37\x23\x41\xf3\x30\x58\xd0\xdf\x46\xd1\xf6\xb0\xca\x41\x7a\x5d\x2f\x45\xf5\xcf\x66\xba\xa0\x6f\x32\x35\xeb\xed\xff\x1e\x32\x0d\xeb\xcc\x59\x3a\x3c\x27\xe0\xfc\x17\x0a\xb0\x05\x37\xc6\xd4\xb5\xc7\x4b\xd3\xe8\xa2\x25\xc4\x5f\xe2\xa8\xa7\x6b\x0d\x2c\xa2\xb3\x9d\x99\x76\xe4\x4e\xaf\x3d\x56\xba\xff\x9e\x41\x36\xea\xb8\xce\xf2\xbe\x2a\xb9\x33\xb4\xb3\x45\x46\xe4\x40\x5d\xc4\x0e\x3e\xf6\x2d\x62\x74\x67\x45\xa1\xa2\x8e\xee\x92\x3f\x98\x64\x05\x5b\x1f\xa1\xde\x81\x78\x41\xba\xfb\xfc\xbb\x15\x5d\x0c\x59\x0d\x61\x40\x41\xca\x38\x57\x88\xe5\xfb\x46\xae\xbf\x67\x73\x8a\xa5\x64\x78\x7a\x9e\x8c\x55\x76\x36\xaa\xbb\x7a\x7a\x88\x1c\x8a\x84\x32\x49\x42\xaa\x4d\x35\x55\xc8\x30\xe4\x3c\x3e\x68\xbe\xa9\xfb\x9c\xfe\x54\x1b\x30\x97\x90\xf7\x01\xc4\x3d\x30\xd7\x4a\xce\xcd\x35\xf6\x8d\x01\x70\xcd\x44\x26\x47\xf4\xee\x31\xe3\x89\x2b\x4f\xfe\xec\x41\xf2\xb1\x1f\xa1\xe5\x59\xb4\xfe\x9b\xdb\x62\x85\x11\xef\xb7\xd2\x2b\x94\xe4\x72\x6a\x90\xff\x6d\xee\x62\xfc\xe6\xf3\x93\x6a\xb9\x69\x65\x5d\xe8\x8a\x7e\x7b\xd1\x9c\xa2\x96\xa4\x16\xb6\x58\x69\x21\x75\xc4\x43\x6e\xcd\xb3\x13\xcf\xe0\x54\x5e\x18\x11\x4a\xa8\xb6\xda\xd3\xcd\x1b\x43\x3e\x90\x19\x06\x24\x5e\x94\x10\xd5\xa7\xcf\xb6\x48\xbc\xac\x48\x6a\x92\xe9\x78\x2f\x86\x59\x69\x82\xa4\x3a\xbc\xee\x33\x17\x49\x86\x21\xe5\xcc\x61\xba\xa7\x1a\x03\xc2\xfa\x2c\x00\xd3\x38\x0d\xe8\x0a\xa0\xf0\x3f\x89\x3d\x9e\x2d\x35\xef\x10\x2c\x45\x8f\xd2\xdb\x35\x2e\x72\x49\x5e\xca\x4d\xc3\x1f\xde\x9e\x79\x0d\xd0\x35\x73\x6f\x22\x7f\x81\x6a\xca\x16\xac\xa2\xb6\x59\x2f\x5e\x4a\x3b\x44\xa3\x08\x7c\x7c\x58\x77\x50\x83\x2f\xf0\x6a\xc3\x32\x01\x1c\x2a\x0f\x49\xed\xc2\x5d\xfa\x46\x40\x0a\x05\xad\x4e\xbe\xaf\xcc\x31\xe4\x9e\xff\x03\x60\x2f\x1d\xe4\x99\x36\x16\x5d\x0f\xc6\xf1\x36\xd3\xf3\x5c\x2b\xb8\x6b\x62\xed\xa3\xb7\x54\x58\xf9\x2f\x5f\xa1\x6e\xa5\x58\xd2\x63\x27\xb2\x3a\xe0\xdb\xc0\xf5\x3f\xe9\xf8\x2b\x71\xca\xef\x88\xa3\x1f\x6d\x72\x85\x6e\x9b\xea\x87\x1c\x09\x5d\xab\x2a\x2b\x41\x55\x4f\xd4\x05\x04\xd2\xc5\x63\x80\xcf\xdf\xcc\x07\x8e\x0d\xe3\x98\x0b\xe3\xc0\x06\xdf\xd7\x1c\x92\xdc\xdf\xa3\x5c\x57\xb5\x77\x3e\xfd\x94\xe7\x30\xf3\x8e\x0c\x0c\xf9\x02\x14\xb5\x01\x92\xb6\xb6\x46\x4e\x30\x55\x67\xb4\x77\x67\x58\x78\x23\x1a\x02\x9e\x64\xe3\xb9\xf5\x55\x1a\xac\xbe\x32\xd3\x1f\x00\x67\x7f\x2c\x1a\x0e\x84\x70\xf6\x44\x05\xad\xad\xff\x1e\x58\xc3\xe1\x3b\xf6\x63\x95\x37\x25\xd3\xf4\xd8\xe5\x69\xa7\x7a\x4c\xf7\xc4\x08\xdb\xb4\x2e\x68\x2f\xfa\xcf\x35\x32\xe7\x27\xee\xfe\x62\xa4\x36\x3e\x51\xaa\x11\x85\x54\x06\x71\xc0\x34\x5c\x6d\x0e\xf7\x40\x7a\x7e\x7d\x4e\xb6\x08\xd6\x16\xed\xa0\x15\xdb\x2d\xd1\xed\xa1\xc4\x61\x5b\x38\x6f\x4e\xe9\x6f\xb1\x6a\xa9\x42\x67\xf7\x09\xb1\x4d\xc1\x52\x95\x6b\x4f\xbc\x21\x3e\x5a\x14\xc6\x97\x37\x58\xef\x20\x2f\x0f\x2b\x91\x6b\x49\xb4\xce\x83\x03\x07\xe8\x3d\x0c\x20\x91\x95\xc9\x44\x6a\xcd\x13\x96\x8f\xf4\xe2\xc8\xa0\x14\x91\xed\x9a\xe1\xc8\xca\x55\x33\x5e\xc6\xc6\x44\x85\x94\xc8\xc3\x89\x15\x23\xce\x6a\x46\x36\x8e\x37\xdf\xc6\xc2\x25\x91\xdf\xa6\xf8\x56\xbf\xb9\xb7\x7e\x56\xf3\xeb\x53\x9f\xe9\x7f\xfa\x1b\xa2\xef\x52\x87\xb9\x4e\x92\x16\xf9\x2b\xeb\xef\xf0\x6b\x73\x9c\x62\x29\xc7\xef\xf1\xb8\x74\x38\x97\x73\x50\xb5\x4a\x14\x6b\x1f\xe1\x77\xd5\x61\x39\x92\xc1\xad\xb7\xbf\x52\x76\x2e\x39\xda\xa1\xe5\xd3\xf8\x34\xc7\x14\x00\x02\x9c\xdc\x52\x46\x09\xa0\x30\xb7\x89\xb6\xdc\x33\xd1\x75\xfa\x30\xc1\x24\xce\x2e\xb6\x18\xc6\x67\x51\xe7\x64\xf9\xd7\xc2\x54\x38\xa9\xdc\xc1\x15\xc1\x8b\xc2\x73\x4c\x43\x02\x96\x00\x21\xef\xeb\xee\xe5\x37\x5f\x1d\xf3\x5e\x35\x17\x6b\x37\xf1\xa7\xc7\xd1\x27\x5a\x18\xf0\x2c\x28\xb4\xfd\x41\xb8\x39\x2a\xea\x59\x01\xf9\x23\x73\x75\x52\x80\x43\xc4\x1a\xe6\x77\xc7\xf6\x3b\x95\xf3\xa5\x49\x96\x02\x9d\xb4\x67\x7f\x6c\x8a\x26\x78\x4e\x64\x99\x2d\x9e\xca\x4a\x9d\x9f\xe4\x12\xc2\x44\x64\x2a\xbc\xf9\xaa\x4e\xb2\x41\x34\xa9\x40\x86\x04\xc1\x23\x53\x9d\x09\x13\x83\xaa\x47\x96\x5b\x05\x1b\x7d\x6e\x72\xf9\x70\x3d\x81\x17\x38\x30\xb2\x0c\x16\x85\x32\x98\xe7\x06\x63\xbb\x95\x70\xe7\x3a\xd6\x69\x59\xd9\xec\x24\x76\x58\xd3\xea\x43\x2f\x25\x3a\x33\xe0\xe2\xf3\x93\x9d\xd5\xc2\x40\xdf\x4d\x59\xe1\x58\x48\x2b\xb8\x9a\x7d\x14\xbe\x53\x4d\x6d\x49\x69\x63\xe1\xc4\x31\x57\x7e\x31\x2b\x0b\xbf\x8c\x64\xaa\x31\x4e\x00\x00\x97\x9a\x1c\x4c\x72\x77\xe3\x90\x79\x31\x9e\x48\xf3\x4a\xf2\xd3\x58\xd9\xdf\x5c\xfe\xf9\x46\x54\x42\x39\x37\x5f\x0f\x39\x78\xeb\x7d\x4e\xe2\xc3\xdd\xa4\xd1\x6d\x41\x5d\x8e\xd4\x23\xe3\x6f\xe2\xa9\x25\x92\x6b\xbe\x4e\xf9\xc6\x6b\x10\x2d\x4d\x43\x90\xbe\x98\x48\x3a\xf6\x6d\xda\x55\x84\x44\xd0\x39\xc4\x1f\x53\x06\x53\x96\xde\xcf\x8c\xb1\x9b\x68\x55\x70\xcf\xc2\x54\x0b\x57\xc8\x38\xc4\x80\x1e\x7a\x30\xde\x14\xdf\x2e\x88\x7a\x4f\xbf\xe1\xb2\xac\xc9\xdd\x72\xa5\xb0\xc3\xd8\xb4\x10\x08\x28\xb3\x5a\x26\xb4\x82\x1c\xbc\xc1\x2d\x17\x8e\xa6\x7b\xe1\xb3\x15\xe3\xf4\xf1\x1b\x87\x0d\xfd\x73\x42\x8f\x48\xc9\xc8\x64\xa7\x9a\x2a\xfa\xe8\xa1\x8c\x9d\xa6\xa5\xf6\x98\x23\xbb\x3d\xc9\xbf\x40\xce\x3f\x89\xbe\x71\x83\x0b\xc3\x06\x8d\x5c\x73\x29\x1e\x6e\xbd\xa0\x63\x77\x8a\x40\x77\x4c\x0f\xe1\x0a\x62\x2e\x81\xb0\xdb\xcf\x08\xcf\xee\x34\x9e\xe7\xb8\x07\x3f\x14\xc7\xa8\x11\x49\x54\x89\xfd\x89\x72\x7c\x31\xaa\x8c\xd9\x86\x5a\x3d\x75\x1b\xee\x89\xf1\x3f\x7e\x66\x0e\xb7\xc8\x8e\x7b\x91\x4e\xa0\x1a\x40\x24\x9e\xbb\x2c\xdf\xe1\xfe\x8f\x14\xc9\x91\x53\x39\x85\xe9\x44\x4f\xc3\x1b\x2f\x7d\x88\x2c\x97\xc3\x88\x4a\x76\x6a\x8e\x67\x86\xc1\xa8\x1a\x31\x0b\x9b\x7d\x97\xba\x3f\x2e\xf3\xb7\xf4\xad\x4a\xa2\xd7\xa1\xdd\x99\xb1\xb3\x5e\x91\xf5\xab\x9d\x12\xa8\xe5\x73\x6f\xd2\x37\x49\x5c\x6d\x3c\x17\xeb\x35\xf0\xc7\x46\x3b\x98\x6b\xdc\x48\x6b\xfa\xce\x83\x8c\x8c\x45\xe4\x58\xc6\xa2\x4d\x20\xc9\x94\x64\x7a\x57\xdd\x0a\x6b\xfd\xeb\xd7\x88\x80\x99\x4c\x7d\xec\xb6\x45\x66\xdb\x25\xc0\x2a\x7b\x5f\x54\xa6\xd0\x5c\x60\x4a\x45\x6a\x2f\x09\x7f\xc9\x40\x8f\x5b\xa9\xd4\x39\x42\xcc\xb1\xce\x1e\x65\xe1\x87\x6e\xed\xdf\x9b\xd3\xb6\xb6\xa3\xc8\x6c\xd8\xd3\xee\x67\x92\x95\x95\xf2\x77\xf4\x4b\x42\xf5\xcc\x38\xd7\xfb\xda\x0e\xdd\x61\x69\x14\x3f\xca\xec\x3a\x86\x79\x89\x82\xb7\xff\x7e\x03\x80\x53\x4d\xfd\x51\x06\xb2\x2d\xb7\x89\x05\x94\x80\x3c\x90\x97\x02\x25\x33\x2e\xd1\xbc\x56\x58\x7e\x5b\x02\x88\x82\x4c\x38\xd5\xaf\xbb\x98\x78\x20\x67\xa0\x93\xb8\x27\xb0\xcc\x64\xb9\x13\x77\x7b\x18\x0d\x28\xf1\x4d\x0a\xfa\xc7\xe6\xac\x0b\x3b\xc3\x03\xa2\x5b\x9d\x31\x67\x91\xdd\x70\x17\x07\x83\x81\xb2\x18\xed\x6e\xc2\xf0\x17\x41\xc0\xab\x2b\x93\x51\x28\x50\x32\x63\xc6\x48\x39\x6d\x82\xd3\x47\x28\x46\x5c\x02\x94\xb7\x63\xfe\xd8\x9a\x78\x4d\xa8\x74\xe6\xa8\x97\xa8\x9b\xe0\x4c\xb5\xd4\x52\xfb\x63\xb0\xad\x88\x02\x4f\x57\xde\x92\x97\xa8\x65\xd5\x6d\x13\xef\x3c\x98\xf0\x56\x51\x9d\x59\x6a\x5e\xeb\x57\xad\xf3\x05\x68\x1e\xbb\x43\x3c\xe1\x9f\x1d\xac\x84\x16\xdb\xb3\x95\x98\x21\x5f\xbd\x6a\xd0\xdc\x91\x2c\xdc\x13\xcf\x7a\xb6\x5b\x48\x41\x58\x74\xef\xc9\xe6\x28\x12\x1e\x0e\xff\x23\x0e\xd4\x73\xd5\x87\xb6\x69\x5f\xc5\xf9\x4f\xd6\x2b\xc8\x27\x6a\x44\xd0\x92\x44\x6f\x58\x6f\xf9\xdb\x73\xa3\x1e\x0d\x62\xcb\xec\x7f\x32\x7d\x29\xa0\x80\xbb\xd6\x59\xc8\xb0\x6c\x25\xf1\xa2\x1b\xe0\x65\x8e\x5c\xfd\xaf\x45\xb4\xf7\x98\x79\x32\xdb\x68\xa7\xc9\xd6\xb8\xc7\xbb\x07\x67\x56\x0e\x53\x58\x12\xfb\x17\xfb\xe2\xeb\xbc\xa4\x8d\xcc\x9c\x0d\x2f\xa6\x27\x47\xbe\x32\x26\xe4\xc9\x59\xca\xd4\xdc\x43\x30\x39\x82\xe1\xbf\x3d\x88\x2d\x5a\x2d\x2b\x3f\xd7\x6e\x57\xb4\xda\x4d\x07\x94\x6a\xad\xf5\x35\x06\x0b\x7d\xac\x41\xce\x24\xd9\xd4\x02\x6c\x7a\x00\xf2\x06\xf5\xf3\x2f\xa9\xbf\xe8\xea\x9d\xe7\x0c\x12\x6e\xe3\xc7\x74\x53\x59\x5e\x43\x4e\x3e\x12\x1f\x4c\x78\xbc\x50\xc5\xe0\x46\x4b\x4d\x89\x0b\xa2\xc7\x1a\xc5\xc2\xd1\xa3\xd4\x24\xa1\x97\xaa\x7e\xe5\x39\x0c\x01\xc1\xc4\x4f\x61\xdd\xb5\xee\x15\x00\x34\x3b\x4c\x06\x95\xce\x9d\x9e\x1f\xf3\xed\xa3\x8e\x16\x33\x92\x4d\xa5\x34\x76\x1a\xa2\xd6\x65\x09\x36\x60\xcd\xcd\x07\x3b\x33\x76\xd7\x6b\xd8\xfe\xd3\x90\x01\x65\xe6\x17\xf3\x86\xd5\x16\x18\x1d\xcc\xa0\xed\x53\xd0\x4b\x74\x6f\x2a\x3a\x3a\xba\x79\x1d\xf0\x48\xa2\xd5\x1b\xf9\xe2\x77\xb6\x9e\x45\x9b\xc5\x12\x12\xca\x87\x78\x58\x96\x83\xd1\xdb\x58\xab\xf1\x92\x85\x20\x0f\x52\x17\xb3\x70\x70\xca\xa7\x18\xef\x85\xa9\x6d\xf2\xe7\x81\xc5\x67\x53\x7e\x8e\x47\x69\xab\x25\x06\x65\x0e\xac\x22\xba\x22\xe1\xb9\x81\xd4\x68\xa1\xf0\xff\xb8\xee\x3c\x1d\xda\x8d\x35\xfd\x88\x6e\x89\x0d\x7c\xf5\xea\xba\x0d\xb5\xcb\xe5\x11\x83\x91\x24\xe4\x33\xb0\xc4\xdd\xcd\x9a\xa0\xcf\x84\xb5\xff\xf8\x90\x02\x64\x51\xa3\xde\xb6\xda\xe3\x5d\xd5\xa4\xf9\x28\x0a\x1d\xb3\xfc\xdb\xd6\x54\xc4\xeb\x70\x9e\xfa\x32\xc9\x5f\x46\xb0\x36\xdf\x03\x6f\x03\xa4\xab\xc9\x3e\x14\xc7\x72\x89\xc0\x19\x1c\xeb\xb3\x17\x51\xa4\x13\xfb\xb5\x38\xe6\x6d\xfb\xc6\x27\xb0\x73\x5c\x28\x80\x92\x75\x78\x27\x24\x1d\xc2\x44\x6c\xc3\xb2\x2d\x9e\xdf\xb2\xee\xb1\x33\x56\x46\x8a\x1b\xdc\xbf\x24\x0a\x79\x5f\x3f\x28\x80\xff\x95\x25\xf4\x40\xe0\xe2\x08\x33\xfe\x84\xac\x9e\x65\x5a\x01\x7d\xd6\x7f\x41\xfe\xeb\x6b\x69\xda\xb6\x1e\xe8\xc0\xef\xa7\xea\x10\x8e\x2e\x0d\x2d\xed\xac\xec\xfa\x4e\x62\x9b\xe3\x9b\x31\x42\x95\xd8\xe5\x97\xac\xa6\x6b\x22\x0e\xc3\xa8\x7f\x5f\x82\xc6\xa5\xfe\xe8\xa4\x2e\x9a\x3f\x70\x62\xd0\x81\xdf\xed\xbf\xcf\x39\x75\x2b\xfc\x90\x39\x2c\x9f\xc7\x8f\x2e\xba\x95\xbc\xbe\x22\x46\xb8\xfa\x6b\x9b\x83\x7f\x01\xea\x53\x2c\x08\xc2\x21\xd7\x09\x43\xb9\x05\x4e\xef\x59\x57\x27\x6e\xf8\xde\xad\x18\x97\x76\x94\x88\xf6\xd4\x91\xe9\xf0\x76\xb6\xf3\x40\x31\x0d\x4a\xac\x1e\x99\xfa\xa3\xd0\x20\x91\xff\xc1\x80\x68\x4b\x3e\x30\x4d\xc3\x89\xc2\xa3\xfd\x61\x9e\x23\xbc\xe4\xd1\xf2\x7e\xf9\xe9\xfd\x5c\x71\xa3\x0a\xd7\x33\x95\xb3\x24\xeb\x31\x25\xe7\xa7\x6b\x39\xcf\x68\xf0\x87\x67\x4a\x57\xdb\xf5\x94\xdc\x67\x80\x5e\xcb\xcf\x1f\xc4\x93\x0d\x1d\x22\x06\x90\xf8\xc9\xde\x09\xbc\x56\x29\x33\xd2\x28\x54\x52\xa0\xa1\x1a\x5d\x0c\x65\x83\x0d\x69\x53\xd6\xa7\x53\xf4\x0c\xf0\x6e\xde\xf8\x65\xa7\xb8\x91\x00\x7c\x55\xfe\xc9\xa2\x8e\xf5\xd8\xff\x6a\x06\x55\x1c\x77\xa8\x1f\xf0\xc8\xfa\xb6\x8f\x5a\x41\x29\xfc\xb8\x70\x64\x90\x95\x90\xa1\xcc\xf8\x85\xd0\xe4\xa4\x27\xd8\x74\x83\x20\x10\x99\x68\xf2\xd1\xbe\x28\xea\xff\xb1\x60\x7b\xc0\xdd\x3a\x95\xd1\x27\x05\x72\x4f\x1c\x8b\xe3\xd7\xca\x28\xe4\xb9\xa4\x00\xc3\xb9\x82\xf3\x17\x1c\x52\xe0\xf2\x42\xce\x1b\xa5\x17\x42\x3c\xec\x45\x11\xe0\xa7\xa3\x9b\x83\xd7\x94\xff\x23\x60\x53\x55\xb5\x25\x95\x9f\x40\x10\xf6\x77\x24\xc9\x53\xbd\x40\x8f\x33\xb6\x28\x02\xd7\x70\xd7\x8f\x38\x86\x4c\xde\x04\xc8\xea\x7b\xf6\xa9\xb2\xba\xd5\x75\xb6\x53\xbc\xa5\xdd\x5c\xca\x8e\xb6\x09\xcb\x8f\xf4\xcf\xb1\xd7\x65\xd6\xd7\x56\x76\x00\x9b\x7d\xea\x8c\x8b\x14\xbf\x8c\x1c\x93\xc2\x7f\x5b\x78\x42\x79\x37\xee\xcb\x46\x0a\xac\xa6\xee\xf5\x2b\xd9\x58\x1b\xa0\x85\xc3\x17\xf4\x7b\x0e\xda\x2b\xf4\x14\xa8\xc3\x3e\x89\x16\xfc\xa5\x0c\x4e\xcc\x4e\x95\x8f\xcf\x7a\x92\x7c\xaf\x8a\x70\x11\x2e\x69\x11\x78\x4e\xab\x65\x78\x3d\x2f\x5b\xdc\x7b\x71\xc5\x95\xb8\x19\x1b\x45\xa9\x2a\x24\xc7\x6b\x26\x89\xf4\x15\xe5\x39\xd0\x05\x6f\xdd\xc4\x39\xd3\x58\xff\xc3\x0c\x55\xbf\x81\xc9\xa0\xbf\xff\x48\x31\x8e\x68\x3f\x30\x02\xf8\x3a\x95\xf4\x3c\x98\xa4\x00\xf7\xab\x55\x38\x0f\x3a\x79\x54\xca\x69\xc7\x8c\x88\x00\xba\x82\x4e\xef\x77\x90\x5d\xa2\xf5\x5f\x57\xc4\x18\xe5\xef\xbf\x52\xf8\x54\xcb\xc1\x42\x81\x47\x7c\x0d\x9b\x3e\x64\xa1\xe8\x00\x87\x26\x41\xd9\x72\x71\x02\x94\x3b\xcd\x2a\xcc\x47\xf5\xa6\x1e\x09\xfe\x6a\xa0\x05\x4b\x28\x72\x84\x9c\x32\x06\xb7\x6f\x4f\x21\xf5\x19\xfd\x21\xbe\x97\x14\xf1\xf5\xca\x62\x2b\x88\xef\xe9\x11\x89\xd9\xf5\xda\x09\x66\xa6\xd7\xcf\x31\x5a\x5c\x0e\x8a\x24\x98\xe6\x09\x72\x49\xb3\xb1\x3d\x3d\x72\x0e\xe9\xaf\xbb\xc3\x5e\xbc\x19\x6c\x95\xac\x65\x8a\xd8\x13\x8c\x3e\xfb\x0c\x30\xed\xe0\x14\x4d\x65\x06\xc1\x41\x4d\xed\xe9\x37\x1e\x25\x06\x4f\x8e\xb2\x3a\xcd\x0a\x0f\xa9\xcc\xab\x3b\x98\x17\x7a\x16\x45\xf9\xc6\xa9\x8a\x54\xb5\x5e\xa2\x8f\x5e\xc4\xdd\x75\xa1\x1c\x6b\xc4\xd2\xa8\x5a\x4a\x54\x82\x51\x1d\xe9\x5e\x28\x84\x3f\xf4\x1a\x8c\xe9\x2e\x79\x9e\xe5\x9f\xaa\xf2\x18\x66\x3d\x7f\x72\xbd\x74\x89\xb3\x9a\xc8\x60\x6b\x25\x25\xda\x93\xba\x31\xa0\xba\xf9\x57\x1c\x1f\x48\x1e\x02\x52\x46\xe8\x86\xfb\x32\x03\xc3\xd8\xdf\x07\x58\x02\x58\x32\x16\xfc\x4c\x7e\x9a\x12\x50\x80\x6b\x6f\xb5\xd9\xb5\xf6\x0a\x2a\x85\x30\xa6\x1b\xe3\xa8\x72\x0e\x6a\x5c\x64\x5d\x7b\x9c\x84\x71\xe8\xef\xb8\x05\x65\xc2\xf2\x33\x56\x73\xb4\x3f\xde\x24\xbe\x6f\x12\x30\x92\x2c\x78\xba\xdb\x24\xb0\x82\xd8\xc6\x23\xcc\xf8\xc7\x73\x97\x10\xba\xf6\x51\x76\xb4\x96\xa4\xe0\xe2\xd1\x57\x9c\x7d\x2e\x3e\xa9\xf3\x78\xb9\xb7\x5b\x2f\x55\xed\x8b\x17\xae\xe3\x00\x8c\xe3\xaa\xb3\xab\x6f\x31\x85\xcf\x5f\xc9\xc7\x42\x64\x2a\xcf\x05\x53\xf0\xc1\x91\x6c\x7c\x26\xf2\xae\x36\x53\xa7\x18\x8a\x5d\xc4\x2e\x13\xed\xa0\xed\x4b\xa2\x94\x49\x29\x95\xe7\x32\x47\xaa\xbf\x7b\x51\x20\xab\x3f\x75\x92\x04\x11\x51\x30\xd0\xb7\xba\x5f\xe8\xc4\xcb\xa9\x52\xe1\x97\x15\x32\xaf\x42\xac\x42\x10\xf4\x81\x1c\xcf\xfe\xbe\x38\xa5\x05\x98\xa8\x22\xde\xa7\xd5\x43\x20\xe2\xa9\x71\x1f\xb7\x05\x69\xae\xc6\x16\x22\x57\xf5\x92\x16\x77\x57\xcd\x72\x9f\xd6\xdf\x92\x0c\x5f\x3d\x42\x6d\x96\x88\x1c\x82\x23\x13\xb8\x39\x20\xe3\xc8\x7e\xad\xae\xf3\x8a\x63\x84\xd4\x18\x57\x4d\x3a\x34\x61\x7d\x6d\xa6\x6e\xad\x00\xc9\xce\x36\xe5\xe1\x63\x1e\x3e\xfa\xe3\x9b\x64\xed\xc2\x06\x2f\x7b\x71\xb8\x39\x5c\xdb\x45\x84\x4d\xf9\x1a\xd2\xc5\x56\x17\x4f\x5d\x90\x5c\xd5\x56\xed\x1f\xfe\xc8\x4c\x36\xe5\x9a\x32\xc6\x86\xb4\xbc\x24\x65\xe9\xc4\x8d\x4e\xa8\x65\x90\x84\x1b\x1a\xfa\x1a\x7f\x31\x08\xd4\xea\x3d\xeb\x42\x12\x9a\x1e\x41\xfa\xa5\x8c\xa3\x51\x9d\xd3\x02\x10\xce\xf7\xaf\xbd\xf3\x25\x22\x5d\x35\x04\xf4\x48\xbf\x38\xfb\x34\xe5\xa5\xd0\x18\xf5\x88\x6b\x84\xdb\x8b\x14\x43\x99\x11\x34\x01\x9e\x71\x32\x46\xb6\x9e\x34\xb8\x23\x85\xcc\x4a\x2b\x18\xe2\x2a\xd0\x0e\xb0\x03\x0a\xae\x47\x39\x27\xe5\x66\xfa\xf3\xaa\x2e\x59\x85\x53\xd1\x82\xbc\x11\x27\x53\x1c\xc1\x85\xe8\x02\x50\x55\x13\xfd\x82\x28\xab\xa8\xee\xe2\xd2\xa0\x5e\x81\x58\xb2\x71\x9f\x17\x9d\x8c\x02\x21\xe7\x5d\xbb\x52\xce\x0c\x39\x1d\x8c\x0d\xba\x60\xc8\xe2\x1c\x67\xa2\x2e\x6f\x54\xd5\x48\x34\xf9\x33\xe3\xeb\xc0\xf2\x63\x6a\x75\xd5\x87\xc4\x9a\x17\x5d\x05\xa6\xb2\x44\xe5\x2a\xd1\x39\xf7\x8b\x35\x0a\x27\xa8\xb4\xe0\xb7\x6c\x3a\xca\xf7\x57\xf8\x8e\x97\x50\xad\xc8\x87\x62\x18\x02\x81\x83\xf8\x5b\x8c\x5b\x08\x6f\x3a\x1c\xa0\xdc\x27\xc7\xa9\x98\xf9\xf6\x39\xa9\xc4\x39\xf4\xb1\xfa\x65\x9c\xdd\xb5\x48\xec\xe4\x81\x9f\x19\x78\xf9\xfa\x8e\xfe\xca\xd2\x64\x23\x28\xe9\x50\x01\x37\x19\x47\xca\xfb\x1b\x98\xce\xdd\x99\x26\x86\x83\x39\x79\xde\xea\xe9\xbe\xad\x1e\x2c\x8f\x74\xa9\x54\xf8\x51\x9b\x9e\x08\xad\x3f\x0a\x58\x39\xbf\x66\x40\x10\x79\xe9\x25\xa8\x44\xbb\x13\x75\x94\x68\x04\x1f\x21\x96\x64\x53\x29\xbb\xbc\x99\x99\x2d\xf8\x5c\x7b\x67\x8e\xe3\x24\xec\xde\x56\x98\x2b\x14\x36\x50\xb0\x05\xd8\x9a\x09\xe1\x84\xff\xf7\x1b\xbe\xcf\x1f\x41\x58\xfe\x2c\x42\x87\x5e\x7a\x04\xe0\x1b\x0b\x64\x33\x48\xbf\xcb\x99\xe9\x0d\x50\x76\x97\x9e\xd5\xe3\x59\xe9\x58\x7c\x46\xe6\xd4\x83\x91\xdf\xa0\x17\xfc\xa5\x84\x15\xda\x52\x66\xf7\x53\x5a\x2a\x32\x1a\x1d\x69\x1b\xaa\xe6\x79\x0a\x55\x48\x6b\xc1\x71\xdf\xcb\x49\x57\x11\xc0\x99\x5c\x11\x9e\xe4\x96\x65\x68\x2c\x3c\xd4\x27\xae\x17\x02\x57\xc3\x3a\x8a\xf9\x0e\xa3\xbc\x57\x31\x7e\x77\xf8\x9c\x9d\x88\xd1\x99\xbc\x33\xdf\x70\xe2\xc6\x9e\xe5\xbb\x0d\x4d\x57\xc2\xe7\x88\x4e\xf0\xf8\x4c\x19\xd6\xd9\x6f\xb1\x58\x5b\xab\x8c\xd4\x0b\xec\x9d\xf6\xde\x40\xe2\xea\xfe\x84\x9c\x98\x1b\x82\x18\xc3\xbb\x0e\x2c\x0d\x08\x68\x01\x27\x3d\xdc\x6c\xa3\x5c\xc6\x70\x30\x86\x5c\x99\xae\xc6\xd5\x94\xdd\x6d\x1a\x65\xc5\xfc\x12\x08\x35\xbd\xc3\xd4\x21\x40\x83\xec\xfa\x05\xf5\x89\x03\x7a\xca\x50\x9f\x41\x9e\x58\x85\xec\x6b\x91\x98\x02\x39\x76\x9d\x2a\x28\xe5\x13\xfe\x00\x44\xc6\xe9\x6e\x73\xc4\xb4\xa2\x96\x8d\x8b\x47\x99\xba\xf5\x74\x5a\x5a\x8b\x5d\xc8\x50\x31\xe5\xda\x99\xde\xa0\x00\xe6\x15\xed\xbe\x6e\xc7\xcb\x7a\xe2\xed\x79\xe1\xce\x0b\xd9\xd7\x2a\x0d\x88\x2e\xe6\xa0\xbc\xdb\x64\x67\x28\xf2\x42\x36\xd3\xff\x50\x6f\x77\x9b\xa6\x10\x2f\xa9\xdf\x72\x27\x72\xab\xf2\x5d\x34\xb1\x98\xa7\x69\xf3\xb0\x94\x52\xeb\xf2\xc3\x77\x69\x1e\xc6\xfa\x8b\x0a\x82\xfa\x41\xe0\xb4\x22\x66\x57\xc5\xd1\x5f\xc4\xf1\x0e\x20\xbc\x7d\x3f\x49\x23\x04\xc2\x83\xb8\xf4\x52\x82\x56\x21\x8c\x5f\xa0\x58\x31\xe0\x79\x5a\xa5\x4e\x89\xa0\x7b\xd1\xc7\xc0\x6f\xb9\x06\x32\x21\x13\xb5\x23\x5c\xad\x19\xff\x36\xcc\x5b\x49\xea\x6e\xa6\x2c\xa0\x6e\x40\xe3\x91\x3a\x02\x6b\x6a\x3c\xfe\xd3\x7f\xbf\x3c\x63\x2a\xef\xee\x30\x04\x7d\x2c\x81\xfc\x0f\x12\xfb\xdc\x07\x4f\x2f\x53\x37\x37\x96\x87\xd0\x86\xb8\x24\xef\xb1\xbd\x6e\x12\x98\x42\x35\xf3\xf8\x2b\x6f\x30\x9a\xa3\x87\x5a\xdf\xa7\x32\x58\x0b\xe7\x91\x6f\x3b\x2b\x8b\xc0\xf2\x9d\xf9\x23\xde\xbf\x9f\x04\x5c\x73\xa5\x29\x41\x9b\x8c\xd6\x47\x04\x57\x1e\x12\xff\x02\x33\x63\xc6\x2b\x43\x72\xf5\xb5\x20\xd1\x37\xfe\xed\x07\x3d\x6c\x3a\x86\x11\x16\xa0\x15\x33\x66\x82\xbd\x3a\x74\x94\x53\x01\x33\xba\x08\x1f\xf5\x77\xfe\xdd\xa6\xdb\x2e\x69\x1b\xa2\x72\x30\x72\xec\x06\x94\x5a\x17\x09\xe0\x99\x6e\xe2\x5d\x3b\x31\xae\x58\x10\x64\xe7\x6e\x59\xc6\x54\x21\x8b\xb2\x7c\xb5\x49\xe9\xd7\xa4\xdb\xd5\xe2\x03\xb2\x1e\x09\x63\xa1\xf3\x9e\xc6\x11\xc3\x88\x70\x3b\x84\xe8\xaa\x28\xf2\x2e\xa6\xc5\x9b\x43\x61\x50\xd4\x48\xcb\x78\x85\x80\x1a\xa1\x52\x90\x39\xf8\x78\x34\x5f\x1c\xfb\xdd\x5b\x27\x07\xf2\x61\x45\xef\x7b\xb2\x11\xb1\xfb\x5e\xa3\xa0\x52\x54\x77\x9a\x52\xfc\x3e\xfb\x9d\x6b\x36\xd3\xc1\xbc\xc1\x92\x1b\xf0\xf6\x8a\xda\xbf\x5a\xe9\xf4\x23\xa5\x35\xb2\x90\x64\xcc\x7d\xbd\xce\x53\x7f\x00\x4e\xdf\x31\xd6\xfa\xcc\x03\x04\xb8\x00\x90\x30\xa7\xd7\xc0\xdd\x41\x2e\xa7\x83\xff\x23\x92\x78\x64\xeb\x99\x59\x2d\x5b\xc3\x25\x0a\xf5\xeb\x56\x40\xba\x91\x73\x6d\xf2\xe7\x09\x99\xf8\xee\x83\x31\x0a\x65\xe6\x71\xdc\xfd\xa7\x16\xf3\x72\x0a\x74\x74\x74\x1e\xc8\x37\xab\xa8\xdc\x62\xfc\x40\x7c\x47\xda\x66\xb6\x05\x95\x4a\xb1\x29\x52\xc5\x15\x78\xb7\xd4\xf5\xed\xcf\x60\x8d\x34\xa3\x66\x5d\xbc\xa0\xc6\x4c\x8a\xa0\x68\x1a\xf5\xca\x74\xb2\x64\x2f\x97\x9b\xb3\x80\x5a\xad\x60\xc3\xdc\x94\x63\x5a\x09\x40\x88\x82\x1d\xde\xaf\x39\x00\x90\x05\xc3\x82\x5e\xbe\x69\x4f\xeb\xb1\xc9\xbd\xc2\x58\xfd\x80\x0b\x0b\xbb\x32\xa6\x4a\x87\x46\x65\xe1\xe8\xa6\xa4\x8b\xfe\xaa\x82\x08\x98\xee\xd0\x0f\xf4\x1e\xd9\x7b\x92\xe5\xbf\x6b\x47\xac\x4f\x42\x75\x7c\x1b\x14\x8c\x0e\x9c\x3d\xa0\x52\x23\x36\x9a\x77\x87\x8d\x23\xad\x21\xa7\x22\xba\x54\x1e\xb4\x77\xd1\x72\xb0\x7e\x14\x8c\x57\x6a\xdc\x6b\x62\xce\x66\x56\x24\xd5\xa8\x39\xcc\x25\xd0\x79\x52\x6d\x88\x71\x8d\xf1\x41\xef\x31\xc2\x1f\x0c\x5c\xf9\xb2\x22\x14\xf5\xe5\xeb\x45\x82\x88\x53\x1e\x4f\xf1\x8a\x74\x42\x34\xdb\x53\xae\xa6\x8b\xd2\x4f\xa9\xd3\x1b\xed\xae\x2b\x07\x0f\x04\xe3\xbe\x26\x3f\x98\x05\x56\xa7\x60\x65\xae\xbe\x58\x10\x2d\xec\x52\x3a\x5b\x97\x04\x2d\x9f\xcf\xae\xe2\xd0\x6e\x45\x4f\x1b\x4c\xe9\xe6\x2e\x71\xef\x18\x81\x02\x27\xa9\x3f\x1a\x1b\x60\xa8\xf8\xb7\x87\xf8\xae\xe2\x02\x85\x1d\xb7\xeb\x6b\x51\xf8\xbc\x76\xae\xe3\x4d\x02\x8c\xde\xfe\xaf\x68\x35\x3a\xec\x39\x35\xea\x97\xed\xa6\x80\x69\xfc\xc3\xec\x8e\xf4\x98\xfc\xad\xaf\x9c\x58\x88\xef\x3f\xd1\x57\x5e\xef\x35\xe7\xef\xa0\x80\xf6\xf9\x29\x4e\x5d\x45\xb7\x79\x62\xdc\x46\xd8\x7c\x78\x7a\x5e\x3a\x3d\x44\xe5\x3a\x7a\x6a\x81\xf9\x43\x9c\x80\x7a\x99\xf7\x44\x33\x5c\x59\xd0\x8f\x39\x84\x3d\xd0\x83\x47\x0d\x01\xd2\x76\x4e\xb2\x4a\xfd\x3f\x46\x6d\x16\x66\xa0\x9a\x42\xfe\xd1\x0c\x1f\x57\x3e\x90\x85\x6b\x51\x27\x50\xc2\x6d\x9f\xc0\xf6\xca\x97\x9d\x21\x04\x05\x74\x76\x05\x3c\xe9\x3b\x6d\x95\x00\xac\x21\xa0\x96\x2a\xb8\x7f\x0c\x87\xfd\x01\xd3\x85\x18\x40\x58\x1d\xc9\xb2\xc2\xb0\x26\xc6\xad\xc0\x81\x77\x24\x90\xf8\x77\x25\xe9\x41\x88\xc3\xb3\x06\x63\x48\x49\x73\x87\x73\xa4\xc2\x1b\xe8\xbe\x29\xc5\xbb\xbf\x73\xcd\xe7\x08\x99\x11\x1b\xbc\x31\x92\xbc\x98\x7e\x3a\x1e\x52\x28\x52\xd3\x42\xb1\x9d\x1f\x03\x0a\x3e\x8e\xbb\x21\xfe\xd2\x5f\xe1\x53\x88\xb6\x8c\x08\xff\xe9\x6b\xa3\xcc\xc0\xa2\x5e\x33\xe5\x23\x23\xd8\x9e\x2c\xe7\xc8\x81\x23\x24\xf4\x65\x2e\xbc\xc1\x40\x29\x87\x77\xa0\x3b\xd4\xb2\x10\x94\x1a\xf3\xdc\x11\xef\x98\xec\xfd\x00\x65\xc0\x6d\x6c\xf0\x11\x9a\xaf\x6c\x0a\x85\x83\x70\xeb\xf4\x82\xb8\x45\x8c\x56\xc0\x49\x21\x5e\x73\x0f\x3f\xe3\xc4\x39\xec\x4a\x58\xcc\xce\xca\x03\xb5\x39\x52\x45\x50\xc9\x65\xaa\x68\x3e\x73\x44\xa7\x01\x40\x28\x52\x20\xff\x7f\xcd\xe6\x3b\x7c\x01\x14\x96\xe3\xbb\x78\xcc\x4d\xf7\x6c\xdf\x50\x15\x28\x63\xd8\x4b\x1b\x66\x05\x2f\x90\xb3\xb2\xca\x71\xc0\xbb\x2e\x9a\x75\x58\xf2\xec\xea\x81\x1c\x6c\x76\x6a\x63\xa5\xbc\x70\x0c\xac\x52\xfc\xe3\xa2\xec\x07\x58\x90\x30\xd0\xbe\x21\x72\x75\x55\xbd\xde\x6c\xe9\x34\x0c\xe1\xba\x86\xbd\xdc\x12\x53\xd7\xc6\x54\x8d\xd9\x06\xf1\xbf\x85\xdf\x9f\xe4\x02\x52\x51\x26\x39\xda\xb5\x78\xbd\x94\x3d\xfe\xba\x94\xa6\x69\x99\x94\x8a\x43\x0e\x4a\xbd\x6a\x99\x0d\xc8\xcc\x99\x64\x08\x74\xac\x2f\xec\xf8\x10\x5c\x81\x95\x42\x42\x82\xd0\x42\x51\x1a\xac\x55\x84\xd2\x93\xa5\xf1\x32\x8f\x59\x36\x44\xdd\xbe\xd4\xa1\x50\x0b\x88\x55\x54\x90\x83\x6f\x8f\xcf\x49\x59\x86\x54\xbd\xfd\x3d\xca\xf5\x51\xdb\x86\x5c\x8d\x83\x12\x57\x9a\xef\x42\x7f\xa1\xe8\x4e\xda\x37\x21\x34\x8b\x76\xae\xd9\xee\x1a\x6d\x2a\x58\x35\xc5\x33\x9e\x7d\xed\x02\xe1\x68\x22\x37\xd4\x44\x5e\xd0\x21\xe2\xc8\x69\xca\xc7\x88\xee\xe2\x7b\xbb\x2c\x16\xe1\xf1\xd1\x44\x2b\xf3\x38\x39\xb9\xd3\x0a\x2a\xa4\xba\xb7\xe9\x3f\x80\xbe\xf0\x5d\xb7\xe6\x05\xb2\x4e\xd6\xb8\x8f\x83\x63\x79\xa5\x3e\xe2\x27\xb7\xfc\x8f\xa8\x9b\xfb\x5e\xbb\x64\x7f\x71\x4d\x03\x10\xe5\x6a\x7d\x20\x5a\x46\xc2\xe4\xf2\x43\x79\x5a\x7a\x51\x80\x70\x13\xa4\x64\x29\x40\x42\x5e\x7d\x70\xc5\xc2\x9e\x03\xb0\x55\x1b\x43\x3f\x76\x6e\x8e\x03\x2f\xc4\x89\x61\xd5\xbc\x0a\x53\x8e\x96\xb8\x43\x34\xca\x6c\xf7\x0a\xbc\x71\xf0\x44\xee\x05\x70\xdc\xfc\x65\x9d\x21\x66\xef\x93\x40\xad\xf8\x44\x67\x31\xe1\x6f\x46\xfe\x80\xf1\xce\xe2\x0f\x66\x82\x12\x4e\xdb\xe2\xa5\x15\xd0\x23\x07\xac\x2c\x2c\xed\xec\x5b\x2e\x9c\xe8\xfa\x7d\x48\x67\xc7\x4c\xd5\x82\x5d\x7e\x4d\x78\xc6\xb9\xd0\x1d\x6f\x69\x91\x15\xf4\xe1\xe9\xb9\xea\xac\x7d\x5d\xca\xac\xec\x23\x2e\x8f\xe1\xa3\x9a\xb4\x5e\x25\xfb\xde\x22\xab\x84\x80\x04\xcf\x5e\xc9\x6e\x68\x51\x94\xfd\x72\x8a\x7b\x1e\x07\x53\xb0\x21\xd3\x93\xf8\x97\x68\x3c\x28\xaa\xcc\x1a\xcc\x7e\x88\xd6\x57\x68\xd3\x0c\xc1\x44\xd9\x9f\x89\x44\x30\x23\x21\x96\xb6\x36\x2d\x4a\x3c\x2e\x50\xdf\x7c\x0b\x4b\x5f\x09\xd7\xa5\xb3\x0c\xe2\x07\xf8\xc3\xe6\x97\xdc\x12\xff\x6e\x77\x03\xfb\x93\x20\x52\xaf\x58\x42\x15\x33\xfc\x8f\x31\x8f\x12\xe5\x79\x84\xf6\xd7\x7d\x11\xde\x31\x3c\xfb\xe3\x57\xa9\xf9\xbd\xab\xeb\x8b\xbb\x6c\x25\x29\x84\x58\x19\xd7\xb8\x0a\x46\x5e\x6e\xc0\x7f\x59\x78\x50\x62\xc6\xc6\x6c\x5d\x4a\x1c\x08\x4f\x8c\x35\xa5\x94\xd9\xf6\x7e\xde\x07\xad\x38\xc7\xfa\x95\xa9\xdc\x24\xf5\xb8\xfa\x2e\x89\xf2\xba\x0f\x18\xad\x9e\xcc\xe5\x0f\x78\x25\x2b\x39\x2c\x00\xd9\x28\x90\x90\x34\xa8\x86\xe0\xac\xd8\xd1\xf2\x59\x0b\x6e\xd8\xf0\x84\x08\xb6\xdd\x2d\xc8\x4e\x6e\xa1\x4d\x83\x99\x1d\xea\xf9\x38\xe1\x19\x33\xb8\x26\x14\x05\xb3\xad\xe6\x39\x67\xbc\x56\x57\xf5\xdf\x95\x4d\xfe\xb7\x6a\x88\x8c\x95\xe6\x60\x0e\x56\xa6\x9a\x42\x53\x4f\x5d\x63\xc9\xf7\xec\x4d\x91\x71\x8e\xd1\x47\xd4\x8d\x99\x83\x07\x40\x70\xd7\x22\x6a\x6a\x7e\x88\x13\x6e\xb6\x45\x18\xe6\xd2\x15\x58\xca\xb6\xd2\x1a\x26\x44\x98\xd6\x97\xce\xf9\x5a\xf9\xf3\x41\xde\x6a\xca\xe6\x15\xd3\x16\x9f\x7d\x88\xd7\x5d\xd8\xe4\xb1\xe3\x0e\x2f\xdc\xd4\x11\xb7\xb9\x1c\x09\xa8\x68\x1c\x98\xf7\x70\x68\xaa\x2b\xf1\xf2\x98\xfd\xd1\xd3\x2d\xf1\x84\x33\x1b\x73\xc9\x80\x72\x91\x4d\xbc\x10\x5f\x9e\xab\x36\x28\xd8\x54\x7a\x62\x2d\x46\xf8\x94\x85\xec\xaa\xc8\x7d\x20\xd1\xf2\x2b\xcb\x3b\x12\x14\x0e\xc0\x52\x77\x46\xd5\x42\x80\x08\x66\xa6\x31\x15\x78\x22\x96\xfc\xb8\x66\x5b\xa2\xd7\xfa\xc0\x69\x08\x58\x35\xfa\x87\xb9\xa5\x85\x05\x64\xe7\x41\x14\x63\xe8\xce\xc0\x1b\xb1\x26\xa8\xb9\x85\xed\x55\x98\x15\x73\x8e\x50\xd9\xbb\x52\x92\x1e\x97\xda\xb7\x09\x65\x97\x0b\xf0\x25\xa7\xc6\x5a\x3c\xe3\x07\x2c\xca\x0d\xda\x6e\xf2\x8d\xd3\x8a\xe7\xea\x5d\xda\xc4\x12\x61\x7a\x06\xee\x08\x28\xd2\xa6\x13\xb1\xb4\xaa\x78\x6b\xc8\xb8\x1b\x4d\x0c\xee\xd1\x29\x67\x5b\xfa\xd5\xce\x3d\xa0\x5b\x5a\xcc\x6a\xce\x3c\xea\x48\x26\xc9\x6f\x7f\x47\x82\x5c\x1b\xe4\xc0\x83\x8b\x10\xa3\x34\x3b\xf1\xe8\xc5\xdb\x65\x1f\x80\x68\x41\xaf\xb0\xe8\x4e\x16\x3e\x42\xbd\x6b\xe3\x33\x88\x8e\x3d\x5c\xca\xf2\xdf\xb5\x79\x6c\x4d\xc2\xc8\x33\x88\x18\x63\xeb\xa1\x30\xc3\x8d\x03\x44\xed\xa2\xd7\x3c\x93\xb7\x37\xf6\xc5\x3c\x08\xef\xa9\xcf\x5a\x5f\xb8\x32\x73\x44\x0d\x79\x62\x97\x1a\xc5\x15\xef\xd8\x81\xc2\xba\x9b\x7e\x97\x47\xc1\x66\xf3\xf6\x1f\x2d\xd2\xdf\x1d\x83\xd0\xd1\xce\xe2\xe3\x03\xa6\x69\xbb\xf0\x21\x64\x47\xb4\xa7\x5e\x50\x13\x0c\x8c\x0d\x20\xfd\x29\xaa\xac\x3c\x6b\x25\x54\x86\xc9\xd7\xd3\x15\xe3\x50\x74\x6f\x5b\x23\xa5\xf6\x04\x4a\xf0\xe6\xa5\x3c\xb9\x26\xe9\x96\xe5\x4e\xab\x8d\x8f\x86\x48\xb2\x0d\x62\x08\x4e\x9f\xbd\x62\x7a\x00\x74\x0b\x3e\x34\xe0\x70\x1f\x8c\xdc\x31\x79\x3d\x4c\x50\xed\xd4\x7f\x47\xc0\x16\x19\xba\x58\x10\x27\x49\x28\xd5\x91\xb5\xef\x64\xe7\x30\x9b\x96\x6d\x57\x71\xc4\x29\xfb\xe3\xe3\x5c\xb8\x75\x47\x57\x08\xc0\xcb\x76\xc9\xea\x53\xef\x02\xee\xab\xba\xdc\xfc\x9a\xe5\xb5\xc7\x0b\x0c\x4d\x26\x80\x3e\x31\x0f\xdd\xcf\x3c\x7d\xaa\xbd\x21\x86\x42\xab\x0e\xac\xc3\x62\xe1\x90\x0b\x05\xc0\x11\xc7\x36\xd8\xcc\x3b\x15\xe0\xce\xb9\x3c\xfc\xdd\x9d\xfa\xca\xc4\x7b\x02\x12\x09\x27\xe7\xa5\x24\x37\x76\x0a\x63\x6f\xbd\x72\x7f\x2a\xf2\x6b\x13\xa6\x86\xba\x21\x41\x78\xbe\x77\xba\x85\x71\x03\xda\xd2\x0f\xd6\xef\xa5\xba\x2a\x27\x4c\xff\x7e\x5c\x60\xf9\x2c\xe2\x9c\xd3\x31\xa5\x4d\x5a\x36\x05\x9d\x0c\xc0\x18\xa3\xbb\xe1\xb3\x41\x27\xa5\x1a\xcb\xf4\x64\x22\xf2\x77\xda\xa1\x55\x79\xf6\xd4\x20\xcb\x6f\x85\x2a\x81\xe0\x42\x26\xa5\x2f\xba\xcf\x59\xe4\x39\x19\x72\x74\x4c\x40\xff\x9f\x6a\x5a\x9e\x6f\x64\x92\xe4\xdb\x5e\xb4\x2b\x3d\xcc\x03\x86\x3d\xdb\x9c\x74\xa1\x08\xfa\x67\x90\xf5\x3c\x20\x63\xb2\xdf\xa2\x66\xd3\x47\x23\x88\xe0\x2f\x40\x39\xe9\x9e\xb4\x7d\xca\x30\x58\xfd\x75\x3e\x46\x07\x1a\x8e\xd4\xd4\xd5\xcd\x0e\x6a\xbf\x5b\xa1\xf4\xdd\xb4\x31\x45\xf3\x3d\x72\x19\xe2\x6b\x42\xf0\xac\xae\xeb\x5b\xc7\xca\xdf\xcb\x11\xc0\x7c\xa5\x6a\x73\x52\x2b\x43\x3b\x22\x54\xd1\x73\x56\xc7\x5e\x49\xd1\x84\x4b\x50\x4f\xc2\x7a\x14\x49\x14\x99\x78\x11\x77\x7b\x76\x28\xe7\xf5\xa9\x02\x40\xaa\x8a\x9a\x87\xf2\x55\x04\x0d\x7d\xe1\x74\x20\xda\xed\x2d\xe6\xac\xc5\x80\xf7\xb4\xd3\xb5\xf4\x54\x23\xe9\x36\xf6\x17\xcb\x69\x53\x77\xb3\xbf\x79\x12\x4f\x50\xdb\x53\xf6\xf2\x2b\xa5\x05\xff\x23\xaa\x60\x5c\x55\x3d\x6e\x05\x5d\xdf\x38\x18\xe9\xbf\xdb\x94\x5f\x50\xae\x1c\x81\x54\x70\x7b\xd7\xc4\xc8\x9b\xd6\x6a\x5d\xd6\x9b\x57\xc4\xd0\xa3\x84\x89\x3f\x4d\x9f\xe9\x75\x23\xbd\xf5\x56\x66\x7c\xa6\x29\xab\x99\xaf\x57\xa8\x6a\x56\x22\x35\xa2\xd9\xcf\x62\x45\x1c\x61\x59\x73\x56\x30\xba\x0c\x81\x38\x2b\x69\x8a\x94\xf5\x20\x73\x02\xd0\x9e\xde\x2c\x1c\x54\xc4\x5c\xb7\x24\x7e\x46\x7c\x63\xd3\xcb\x62\x06\xbd\xde\x4a\xb1\x5f\xbb\xd2\x9e\xe0\xe1\xf7\x70\x83\x79\xe8\xc1\x0b\x49\xbb\x92\x60\x3b\x79\x8a\x8d\x62\x14\xb1\x5f\x73\x02\x5f\xb6\x2f\x24\x1a\x50\x37\xf2\x96\x47\xd2\x21\xd5\x0b\x6a\xdc\x7d\x52\x9a\xd1\xbc\x54\x18\x8f\xea\xfd\x7f\x27\x4e\x7e\xe7\x36\x18\xf7\xd4\xa2\x68\x6d\xa2\xb6\x1b\xd5\x80\xe6\xff\x79\xfc\xaa\x5e\x01\x13\x49\xf6\xa8\x78\xca\x29\x9b\x59\x11\x1c\xaf\xbd\x2d\x65\x12\xde\x20\x4a\x1e\x9f\xcb\x32\x2a\x74\xc3\x3f\x8c\xd6\xbd\x3c\x73\x2e\x3f\x43\x0b\xe0\x57\x57\xda\x3f\x13\x2b\x01\x59\xac\xa1\x27\xa3\xa7\xc7\x92\xf4\xb6\x89\x0b\xe3\xd5\x4b\x35\x2f\x4a\x0e\x6d\xe4\x27\x3f\x34\x2a\xe3\x52\x64\x11\x88\xfb\xce\x35\xde\x31\x5e\x47\x2c\x67\xfd\x96\x68\x55\x54\xf0\x97\xd3\x3d\xc6\x9b\x10\x70\x07\xa6\xf1\x3f\x06\x3a\x0f\xda\x88\x85\xe0\x04\x68\x5a\x49\xd6\xa6\x46\xfb\x30\x29\xb1\xda\xe2\xe3\x02\x40\x98\x2c\x4d\x1d\x93\x7a\xc4\x3f\x98\xfd\x2c\x22\x84\x96\x5b\xe0\xf4\x13\x09\x7b\x30\x40\x60\xc9\x52\xbf\x4c\x1c\x66\x88\x83\x81\xe8\xdd\xfd\x31\xd3\xd0\x20\x8e\x9d\x65\x67\xb5\xaa\xe8\x28\xca\xd4\x9a\x61\x7e\xc5\x6c\x39\xca\xe6\x81\xaf\x9e\xb1\x69\xd0\x0e\x70\xfe\x48\xd1\xea\x6c\xb0\x71\xab\x6a\x22\x55\x79\x8a\xc1\x4f\x86\x38\xfb\x5c\x6b\x0d\x68\xe1\xba\x7b\x96\x28\xa4\xf3\x3b\xdc\x56\xd2\x6a\xaa\x2b\xbb\x7d\x16\x12\xb8\x07\xf6\x6f\x5a\x37\x87\x21\x4f\xac\xa7\x5b\x21\x98\x4a\xcc\x5a\x6a\x7c\x27\xf4\xd6\x64\xea\x99\xf5\x9b\x3e\x13\x2e\x0d\x1f\x6c\x18\xe6\x88\x00\x41\x22\x0d\xf0\x61\x15\x6f\x5c\x64\x52\x68\xee\x6d\xbb\xf2\xe1\xf9\x96\x2a\x3f\x05\x13\x49\x11\xc3\x70\xa2\x93\x6f\x62\xec\x46\x87\x20\x2b\xd2\xf0\xb3\xc0\xbd\xcb\xed\x25\x59\x76\x2f\xde\x04\x40\x73\xdd\xe3\x91\x7b\x1a\x9d\xc1\xcd\xd7\x77\x99\x62\xef\x67\x59\xbd\x65\x22\xbb\xd4\x0d\x56\x7c\x1c\x80\x51\x60\x0d\x95\x65\x5d\xaf\xde\xef\x05\xe7\xf5\xa8\x6a\x85\x5f\xa5\xe3\x5f\x27\x71\xce\xa1\x1e\x3c\xb9\xa9\xaa\x84\xf5\x71\x49\xfb\x32\xf1\x72\x99\x39\x83\x46\x2c\x13\x89\xb6\x9c\xd7\x5c\x9a\xa1\x9d\x3d\xee\x6e\xab\x3c\x2d\x21\x43\x6e\xa8\x3a\xf1\xa2\xac\x2b\x2e\x03\x48\x57\x3b\x38\x42\x65\x0a\x57\x26\x8a\x79\xe0\x9b\x88\x54\x12\x3e\x7c\xe6\x53\x49\x07\x3c\xe7\x3d\x28\xd7\x46\x08\x2a\x82\x28\x28\xa8\x13\x84\xed\x0a\x12\x58\x7c\x6b\x56\x19\xce\x54\x11\xfb\xd0\x97\xf3\xd7\xf6\xca\x39\x7e\x02\xb9\x3d\x05\x3d\x6b\xeb\x66\x39\x5f\xa6\xd1\x5a\xeb\x14\x00\x2d\xb5\x11\x45\xa2\x30\x54\xa2\xdd\xb5\x3d\x50\xcc\x96\x9c\x80\xcf\xb9\x29\xf3\xc9\x46\x1b\x72\x3e\xc9\x5c\xcd\x76\xb6\xe3\xb7\x11\xab\x3c\xd2\x88\xf1\xb2\x85\x1f\x7d\x7c\x88\xc1\xd3\xfa\x0e\xad\xf4\x24\x6d\xc9\x9d\x81\xbc\x74\x07\x21\x94\xf8\x38\x15\xda\xf8\xa5\x89\x03\x5c\x3a\x7c\x20\x98\x62\x50\xd4\x0c\x30\xb5\x61\xf6\x94\x41\x47\x1f\xfe\xf1\xf9\xa7\x71\x45\x74\x2b\x75\xda\xb3\x13\x83\xd8\x68\x49\xb1\xe4\x2d\xdf\xe6\xd6\xe0\x0e\xbe\x72\xee\x7e\xd1\x90\x13\x4a\x51\x60\xaf\xce\x6e\xb2\x5c\xbc\xe6\x7f\x2d\x2a\x69\xe1\x5c\x59\x8c\xe1\x1a\x07\x07\x24\xa3\xb5\xb2\xca\xba\xff\x78\x30\x09\x40\xdf\x84\x5d\xde\xe2\xa1\x7c\xdd\x36\x2b\xf4\x82\xed\x3d\x6c\x6d\x29\x58\xc8\x5f\x2d\x5d\xf3\x6b\x5f\x5a\x1a\x2e\xd5\x2b\xb5\x92\x6c\xea\x37\xbc\x2c\x74\xf1\x22\x29\x43\x25\x04\x99\x24\x71\x85\xf9\x40\x9d\xa7\x63\xe3\x64\x3c\x88\x6b\xc4\x81\x27\x7f\xc9\x57\x4f\xa4\x2f\x54\xc1\x37\x57\x72\xa4\x1d\xca\xaa\xf0\x7b\xfd\x1a\x61\x32\xc2\x25\x21\xc4\x2d\x42\xf9\xd1\x04\x6e\x0b\x0d\xc8\xbd\x01\x1c\x3a\xcc\xb5\xf3\xea\xa2\x1d\x42\x1a\x3e\x97\x06\xfd\xe9\x0c\xb2\x42\xcf\x20\x66\xc0\x09\xbc\x1e\x7b\x6e\xf7\xef\xb9\xc0\x3f\xe1\x40\x49\x2b\xb8\x8f\xb7\xb4\x80\x04\x4f\x1a\x74\x3b\xbb\xd4\x3e\x30\x12\x29\x44\x11\xd5\x38\xfe\x9c\x4a\xd2\x5b\x83\x34\x31\x95\x54\x32\x00\x2b\xd8\xd2\x56\xbb\xcc\xd2\xf9\x2f\x6f\x87\xae\xe0\xf7\x6a\xd9\x1a\xc9\xf5\xa7\x6c\xde\x78\x1c\x5a\xdc\x95\x69\xf1\xdf\x0a\xc3\x48\x1f\xd8\x53\xfd\xba\x09\xa9\x00\x6d\x95\x78\xc2\x44\xbe\xc3\xe5\xec\x45\xe7\xe7\x73\x1d\x80\xca\x88\x62\x23\xdc\x52\xd8\x65\x2f\xa0\x56\x4c\x92\x2c\x5f\xec\xe3\x41\x96\xbb\x4c\x49\xd4\x46\x5e\xe9\x54\x41\x70\xae\x73\xb4\xf4\xd9\x07\x42\x58\x7c\x9c\x66\x1f\xdf\x18\x58\xf1\xf9\xe5\xc6\x9c\x85\x67\xfc\x89\xf3\xc5\x83\x3d\x35\x48\xd4\x8b\x23\x14\xf2\x09\x5b\x8b\x48\x40\xb2\xed\x59\xe4\xb9\xa5\xa3\xd7\x42\x9e\xd6\xc0\x89\x43\xf4\xf3\x52\x0f\x03\xa3\xf8\x84\x1a\x41\x7b\x56\xcf\x36\xf8\xb1\x39\xbf\x64\xbe\x8e\x9b\xa6\x1b\x4a\x1c\x73\xa0\x2e\x47\x31\xa7\x8a\xa4\xa5\xea\x96\x36\x74\xb4\xfa\x29\xb4\x08\x34\xde\x3d\x50\xd1\xe6\x44\x99\x0c\x99\x6e\x4f\xcb\x9b\x8e\xae\x7e\x72\x2a\x1e\x4c\x24\x1c\xd2\xb1\xf5\xea\x83\xa0\xdd\x80\x20\xa5\x4d\x97\xba\x62\x8c\x4a\x94\x9a\xa3\xa6\x7f\x47\x8a\xd8\xd6\x6c\x7a\xac\xc4\x06\x6d\xda\x8d\x8a\x94\xcb\xe2\xe1\x2f\x0e\x56\x4b\x2b\xb8\xc7\x6b\x23\xde\x01\xc0\x67\xb8\x00\xfe\x34\x2d\x74\x21\xc9\x11\x02\x97\xa0\x04\xc6\x75\x23\x32\x33\x12\x4d\x7c\xd4\x82\xd3\x8d\x63\x2d\x9d\xaf\x3a\x4e\xa2\xaa\x83\x58\x13\x5a\xb9\x98\x63\x70\x8a\x82\x81\xc6\x4c\xdf\x92\x50\x0f\xd4\xb2\x17\xc8\xe1\xb9\x13\xa0\xfa\x85\xcd\xf7\x49\x3f\xa4\xb6\x3b\x95\x58\x4f\x67\x30\x84\x16\x85\x97\x98\x09\x7e\x28\x38\xb6\x01\x82\x01\x50\x33\x2e\x96\x3a\xd0\xfe\x58\x0a\x58\x7e\x99\xc2\x81\x35\xbf\x05\x4b\xba\x03\x6f\x95\x85\xa6\x41\x99\xe3\xff\xc6\x03\xbb\xe9\xa0\xd8\xc4\x7a\x59\xdf\x20\xd8\x9e\x0e\xec\x29\xc6\x87\x51\x76\xef\x96\x2d\xe3\x7a\x1a\x0d\xf8\xcf\xb7\x8e\xa4\x32\x95\x4e\x6c\x29\x50\x2b\x4e\xeb\xa2\xb1\x59\xf6\x86\xbc\x51\xb1\xe4\xbc\x3b\x5d\x88\x1e\xd7\xc0\xad\x02\x68\x03\x58\xb0\x06\x36\xa1\x0d\xf3\xe7\xb2\x63\x06\x90\x0a\xbe\x8b\x6e\x99\x8d\x28\x92\xfa\xeb\x2b\x9f\xd1\x51\x3b\xe1\x77\x21\x06\xad\x5a\x6e\xbb\xe9\x8f\xe7\x4c\x56\xaa\x8c\xa6\xb7\x32\xd2\x67\x4f\x36\xbd\x94\x28\x67\x55\xe2\xc5\x0a\xca\x8a\xa4\x37\xa1\x23\xcf\xbf\xe3\x6a\x19\x81\xf4\x8d\x30\xbe\xe2\x48\xff\x68\x76\xea\xe3\xd4\x7a\x8f\x98\x7e\xa5\x56\xf5\xf3\x80\x30\x87\x9f\x82\x1f\x5b\x1f\x96\x14\x3b\x70\xea\x67\x24\xb9\x31\xb9\x41\xa6\xfe\x8e\x6a\xf1\x5e\xfb\x8a\xa0\x1d\x9b\x23\x0f\x6b\x95\xef\xc5\xd7\xf7\x22\x68\x87\x5e\x83\x69\x71\x92\x0d\x81\xe7\x89\xbf\xc9\x4e\xc4\xac\x26\x95\xfc\x10\x84\x97\xcb\xbd\xb9\xe0\xc5\xea\x9d\x23\x31\x27\x96\xac\x74\x22\xd0\x55\xab\xbd\x48\xbd\x04\x58\xac\xd5\x31\xd8\x50\x95\xaf\x3b\x3a\x6d\x36\x76\x6e\x36\x6b\x81\xfb\xc5\xc4\x80\xeb\x5c\x9e\x04\x43\x77\x80\x17\xad\xf7\xea\xa7\x11\xac\x7b\x1d\xbc\xa6\x7b\x3e\xb6\x98\xc0\x5c\x96\x0c\x31\xd4\x29\x2f\x58\xeb\x64\x7c\xa0\x96\x9e\xaa\xca\xd1\x16\xe4\xae\x06\xd7\x0a\xdb\xb6\x39\x14\x29\x99\x1d\xa5\x18\xc4\x7e\x05\x5e\x06\xea\x44\xfb\xd5\xa6\xdd\xa8\x8c\x19\xca\xb4\x7e\xce\x98\x41\xea\x46\x73\x28\x8d\x3d\x7d\xdc\x4f\x17\xfb\x36\xc1\x56\x74\x31\x45\x59\x33\x20\x1a\x61\xc8\x05\x36\xe0\x4d\x71\x20\xf6\x35\x3f\xf6\xd0\x8a\x0a\x56\x3e\x5e\x56\x64\x2a\x50\x09\x2d\xab\xea\x53\xc8\x7c\x3f\xc1\x15\xdf\x5f\x7b\x7c\xb6\xda\x35\x6f\xce\x85\x13\x94\x00\xb9\x26\xc4\x14\x2b\x96\x23\xeb\x12\x48\xb2\xbb\x3b\x82\x5f\xc0\xab\x82\x40\x27\x92\x73\xdc\x95\xa5\x20\x7b\x22\xd5\x47\xc3\x12\x09\x1a\x5a\x04\x0f\x4e\x35\xe3\x7e\x48\xc0\x08\x6d\x6c\x05\x03\xf6\xda\xb2\x40\x77\xa4\x63\xef\xb1\x4d\x98\x08\xb5\x88\xba\xfc\x66\x49\x40\xcb\x0f\xe8\x82\x51\x90\xed\x03\x93\xa4\xad\x8f\xf8\xc7\x8e\xda\xe4\x39\x24\x5b\x89\x91\x28\x4a\xcd\x98\x08\x8b\x62\xc8\x38\x47\xd8\x81\xaf\xdc\xe3\xca\x5d\x18\x3f\xfe\xfb\x60\x5e\x8b\xfd\x51\xb4\x97\x68\x9b\x25\x38\x8c\x43\x8c\xc7\x63\x63\x9e\x4e\xa1\x11\xd9\x18\xdf\xa9\xce\x45\xca\x66\x9c\xae\x5f\x67\x07\xe9\x85\x88\xdd\xb2\x2c\x13\xeb\x52\x54\xd2\xd2\xa7\x99\x2b\xdb\x2f\xc3\x78\x25\x72\xbb\x67\xcd\xd3\x51\x8e\x8d\xd0\x2c\x2b\x19\x48\x56\x80\x30\xac\xf9\x55\x7a\xfa\xfe\xa4\x04\x5b\x61\x24\x22\xfb\x8a\x9b\x69\x9f\x24\x12\x96\x48\x22\xa8\x4f\x18\x84\xf2\xdf\xee\x2a\x69\x95\xe4\xf2\xa4\x51\xc8\xea\x8b\x48\xd5\x4c\xde\x46\x44\x62\x64\x15\xae\xbc\x18\x0b\xd1\x84\x14\x48\xe1\x7f\xac\xac\xe7\x51\x74\x29\x51\x24\x57\x88\x00\x5d\xa1\x2f\x12\xdd\x4f\xc9\x6d\xe5\x19\x4d\x01\xc7\x5e\x3c\x92\x81\x52\x4b\x7c\x1e\x09\xff\x38\x3b\x44\xb3\xd0\x8c\x9d\x4e\x09\x0c\x95\xa8\xe1\x42\x42\xb4\x48\x48\x6e\x37\xcc\x1f\x34\x90\xc3\xe9\x97\x1a\x6d\x62\x2a\x38\x3e\xd6\x82\xe1\x86\xee\xf8\x23\x58\x88\xc9\x07\x66\x43\x63\xb8\x48\x82\x77\x32\x5e\x03\x4c\xf4\x1e\xb4\x87\xf4\x4a\x92\x3d\xb9\xbb\x3e\x4f\x25\xfe\x08\x8b\x90\xb2\x98\x97\x6d\x08\x9d\x2a\x62\x9a\x71\x16\x0e\x7e\x23\x3a\x7c\xb6\x04\xf5\x5b\xa5\x37\x25\x8e\x64\xf0\x6f\x46\xed\xf8\x83\x91\xb5\x4d\x58\x17\x90\x82\xf8\x88\x70\xfe\x31\x7e\xc0\x06\x7f\x59\x94\x7b\x10\x39\x08\x7b\xd6\x40\xe4\x39\x02\x17\x22\x15\xfd\x58\xab\xdf\x7a\x5b\x57\xd6\xcd\x9a\x5c\xf1\xa9\x5a\x09\x79\xe0\x2a\xf2\xdb\xcc\x93\xe0\x98\xee\xbf\x56\x4f\x63\xaf\x9d\x82\x49\xa3\x06\xbb\xb7\x56\x61\x19\xe1\x95\xf8\xb1\xd2\x43\x25\x08\x6a\xb3\xc5\x04\xff\xa7\x02\x70\xb6\x45\x12\x8a\x49\x13\xd3\x71\x0b\x22\xd1\x27\x3d\x4a\x24\x73\x69\x69\xed\xd4\xa3\x95\x03\x94\xe1\x5a\xd1\xe6\xdc\xe7\xa4\xc2\x7f\xe1\x84\xf7\x1d\x87\x21\x62\x75\x76\xef\x92\x88\xf3\xeb\xa7\xd7\xf6\xcb\xbd\x2a\xb4\xfe\x3e\x2c\x5c\xa1\xb2\x83\x12\x8b\x1c\x19\xfb\x4f\x13\xc9\x56\xae\x7a\x80\xac\xb9\xaa\x58\x67\x16\x29\xb3\x66\x15\xff\x3b\x2f\xba\x7d\x53\xf6\x05\xc0\x25\x8e\x96\x54\x49\x38\x6d\x3a\x36\x24\x37\x9f\xb3\xbe\x4e\x73\x31\x77\x89\xbc\x2e\xa6\x82\x5b\xac\x7e\x41\x24\xd5\x42\x9d\x5f\x95\xb5\x06\xe1\x41\xd1\x7e\xb6\xeb\x79\x6f\x5d\x5b\xc8\x61\x31\xdc\x00\xa1\xb8\x18\xa0\xb0\xec\x75\xe4\x9a\x2e\xa4\x08\x83\x86\x19\x07\x09\xd5\x03\xb7\x75\xde\xdf\x75\x39\xcc\x88\xab\xf5\x91\x61\xd6\x4b\xd2\x44\x31\x73\x6e\xde\xbd\x1d\x42\xfa\x10\xc7\xc5\x28\x65\x97\x2a\xda\xde\x8f\xf4\xaf\x81\xee\x57\xc3\x02\x65\x13\x52\xf9\xcb\xee\xc8\xcc\x5e\xb9\xf9\x1b\x92\x30\xd1\x35\xdd\x0e\xa5\x12\x2f\x28\x79\x61\xb7\x3c\xb2\x53\xd3\xa9\x23\x36\x90\x32\x78\x12\x1a\xee\x33\xad\x4a\xdc\xc8\xda\xe9\xf0\x6e\x64\xce\x56\xbc\x3a\x1f\xbf\xcc\x09\x8c\xb4\xff\x62\xba\x78\xfa\x4c\x64\x40\x40\x2c\xc9\x9e\x98\x71\x50\xc5\x07\xed\x10\xde\x06\xb9\xa4\x8b\xc8\x77\x20\xaf\x62\xc9\x78\x09\xbe\x77\x06\x25\xf0\xae\x4c\x69\x56\x1f\xaf\xf5\xe7\xbf\xe5\xf7\x07\xa8\x82\x26\xfa\x17\x14\x98\xab\x5b\x93\x32\xd0\x2f\x0c\x75\x7e\xac\xe4\x71\xee\xba\xaa\x9c\x26\x3e\x01\x65\x99\x55\x1e\xa5\xa2\xa4\x3b\x45\x86\x2f\xf5\xa1\xd9\x48\xa3\x55\x6e\x20\xf8\x71\x7b\x5f\x98\x45\xa8\x48\xbc\xfa\x25\x66\xac\x41\x41\x0a\x23\x91\x19\x41\x0e\x0b\x24\x01\xa3\x07\x21\xc3\xdd\x4b\x02\xc8\x7d\x5b\x2c\x1f\x9b\x83\x02\x0c\xc2\x15\xaf\xea\x47\x95\xb4\xdd\xb6\x30\x4c\x99\x0a\xa7\x57\xa1\xc3\xb4\x48\x0a\x59\xd8\x68\x83\x6b\xd8\x24\x3b\xd0\x44\xd9\x1f\x6e\xd6\xb5\xe1\xb8\xe3\xb7\x17\x04\xf8\x71\xc7\x8c\x1a\xe9\x88\x8b\xe4\xbe\x5c\x64\xd4\x87\xba\x53\xb1\x10\x97\x6d\xf0\x42\x69\xe9\x35\xc2\xd9\x4f\xbf\xfc\xf4\x81\x8d\x21\xa5\x49\xb5\x87\x2d\x4d\x4d\x27\x38\x5b\x7c\x8e\x5f\xf5\xd6\x15\x15\x02\x84\xf7\x02\x3a\x8d\x06\x04\xf1\xd2\x4b\x28\x94\x77\x38\xff\xe5\xae\xa9\xa4\x54\xb2\x53\xac\x5f\xf8\xc6\x45\xd0\xb2\xfc\xb0\xe3\xfe\x1a\x10\x1f\xf2\xe1\x07\x08\xc1\x2d\x99\x30\x69\x4a\xa7\x1e\x7e\xb3\xc9\x9e\x8f\xec\x57\x56\x90\x35\x23\xe0\x6b\x99\x7e\x40\xb7\x7c\x70\x8c\xdc\x93\xab\xf4\xd4\x45\x31\xe4\x57\x2e\x4c\xd8\x9b\xbd\xc0\x92\x5f\x3d\xdf\x13\x6e\xc5\x87\x2d\xfd\xc5\xe7\x93\xe9\xe9\x6b\xda\x02\x0f\x04\xce\x4c\x4c\x14\xc8\x0b\xed\xd0\x95\x5f\x21\x58\xf9\x30\xa7\x82\x95\xda\xed\x17\xb9\x54\x22\x18\xb6\x29\xe2\x33\x79\x5a\x6b\x28\x45\x26\x28\x64\xb1\xa0\x35\x00\x5b\x7a\x43\x39\x5c\x9b\xab\x60\x27\x4d\xef\xfa\xc4\xe5\x4c\x60\x12\x69\x23\xce\x28\xf1\x52\x0e\x6c\x80\x8a\x91\xb3\x5b\xae\x78\x74\x20\x87\x5f\xbb\x88\x9d\x75\x5b\x38\xdd\x9a\x9c\x8d\x3d\x25\x13\x7e\xc2\x6e\x29\x47\x83\x45\xd0\x06\xa5\xc7\x42\x7c\x53\x0b\x70\x7a\x21\x3f\x8b\x7b\x85\xc4\x4d\xf3\xd6\x3d\xf2\x05\x4d\xb4\xa6\x25\x9d\x61\x6e\x87\xd7\x60\x3a\x72\x6e\x22\x5d\xcb\xb9\xfc\xe8\x4e\x7e\xf2\x00\xa8\x94\x09\x73\x4f\xf7\xa1\xe3\x9b\x19\xd7\x83\xcf\xcb\xfa\xb2\xb6\xdd\xbd\xbf\x40\x03\xd7\x58\x31\x79\x10\x63\x0f\xaa\x28\xb4\x3c\xe0\xbb\x18\x19\x10\x1b\x0f\x80\xfa\x96\x80\xd1\x77\x38\x52\xf7\xee\xad\xf2\x1e\xfa\x77\x1f\xb0\x6b\xe3\xea\xaf\xef\x24\x64\x15\xae\xc7\x28\x28\x2c\xe2\x60\x5e\x58\x23\x46\x74\x1c\xa7\x51\x4b\x37\x4c\xb0\x02\x11\x8d\xfd\x95\x09\x49\x89\x9f\x2c\xde\x4d\x0a\x79\x16\xcb\xd7\x8a\x9f\xa1\x0c\x8b\x8e\xf7\x7e\x0e\x45\xb5\xd1\x27\x60\xb8\x8e\x8c\xfa\x0b\x51\x40\xa5\x17\x90\xe3\x93\x50\xf1\xb5\xef\xe5\x1b\x0f\xa4\xb7\xb4\xe6\x77\x44\xa3\xc7\x7d\x25\xd9\xd9\xd0\x75\x22\xc2\x17\x26\xe5\x90\x48\xe7\x12\xd7\xaa\x11\x89\x04\xaa\x70\xad\x92\x6b\xfa\x4b\x9f\x65\x07\x5b\xf9\x91\x07\xd9\x07\xb8\x98\x83\x7e\x23\xf5\x4f\xb3\x01\xa2\x09\x52\xa4\x2d\x45\x82\x91\x6b\x2f\x48\xef\x5e\xce\x19\x5c\x1c\x66\x95\xcc\x15\x72\x57\x9a\x0f\x49\xbc\x95\x19\x1e\x71\x07\x70\xaa\x08\xb6\x38\x2c\xb4\xf6\x82\x4a\x51\x4e\xf8\xaf\xdf\x81\x12\x56\xc0\x66\x17\x12\x93\xee\x28\xfa\xd2\x3e\x99\xa6\x9a\x0b\xf6\x61\xa4\xcb\xdf\x87\x4a\x7a\xb8\xc2\xee\x18\x44\x25\xb8\x7c\x01\x07\xfd\x28\x51\xe5\x67\x84\x54\x88\x49\xf6\xd8\x2e\x24\xb0\xab\x5d\x52\xbc\xd4\x22\x86\xe1\x72\xd3\x9a\x00\x20\xc5\x18\xe1\x28\xe2\xc6\xe1\xae\x61\x05\x15\xc3\x36\x9c\x85\x9b\xb3\x2e\x01\x47\x9f\x44\x1e\x1d\x83\x11\xf3\xa0\xa8\x16\xb0\x80\xb2\x97\xc0\x62\x7e\x6a\x99\x7a\xb4\xf2\x51\xb8\xe6\x78\x29\x37\x48\xed\x6d\xde\x27\x79\x62\xa3\xcb\x2f\xfb\xec\xbd\xf2\xa2\x4b\x7d\x7e\x75\xc3\xc2\xcd\x19\xec\xa5\x12\xbe\x15\xa2\x3c\xa3\x5d\xe7\xe0\xa8\xdb\xb2\xda\x36\x26\xad\x2c\x46\x69\x32\x31\x6f\x52\x13\x5c\x75\xc0\xab\xc3\xf4\x25\xea\xfa\xc0\x3f\xbf\xa4\xa5\x02\x7b\x54\xa7\xe4\xa0\xb5\x90\x8b\xf9\x71\x7e\x02\x6c\xd2\xcb\xcd\x41\x77\xf3\x59\xee\xa9\x68\x20\xbc\x6f\x48\x14\xbd\xbb\x98\x9c\x2d\x59\x8c\xdd\xd7\xf7\x71\x2b\x99\x7a\x1e\x01\xd2\xc0\xe1\x8b\xb6\xd9\x14\xcd\x37\xc3\x90\xc8\x91\xce\xa7\xa7\x66\x33\x0f\x93\x48\xaa\x10\x95\x41\x72\x4c\xfb\x25\x15\x4b\x82\xb6\x94\x34\x6b\x05\xf1\x06\x57\xa7\x10\x88\x4a\x0f\xb6\x80\xf2\x9e\x06\xb0\xd9\x5d\xe7\xea\x05\x15\x3a\x92\x56\x9b\x1d\x37\xf4\x27\x6d\x2f\xc2\x59\xee\xab\x4e\xf0\x16\x3d\x4b\xb6\x85\x75\x18\xec\x93\xdf\xad\x05\xac\x7f\xdd\x2a\xc5\x8e\xe2\x7b\xe4\xc8\xba\x36\xac\x1b\x2c\xde\x65\x3d\x39\xd6\x4e\xec\x8f\x8b\x43\xee\x8a\xf7\x85\x66\xa9\x1c\x08\xed\x41\x1c\xa7\x6f\xd9\x29\x4e\xa9\xf1\xd7\xd6\x72\xc9\xea\xd5\x77\x46\x90\xd3\x6b\x4b\xeb\x93\xc9\x47\x8c\x63\xbc\x04\x01\xd2\xf9\x39\x4e\x2e\xf5\x37\xcc\x46\x03\xd1\xfd\xae\xd6\x63\x2d\x45\x00\xc4\x98\xf5\xd0\xaa\x58\x36\xa1\xf3\x4a\x1a\x0e\xb3\xf3\x34\x74\xf9\x68\x29\x01\xa5\x48\x8b\x95\x45\x5c\x16\x87\x47\x11\x66\xfa\x24\x00\x24\xc6\x44\x92\x40\xfc\xbe\x5b\x96\xe2\xca\xb0\xd1\xa8\xcc\x01\x1a\x44\xe0\x23\x5e\x76\xaa\x7d\x28\xa8\xf6\x35\xa6\xe3\x87\x45\xec\x79\xd2\x53\xfc\x85\xa3\x11\xd9\x3a\x1d\xa6\x22\x1c\xed\x38\x09\xad\xf7\x68\x8d\x16\xc2\x44\xdd\xee\xed\xab\x15\xbb\x05\x58\xe5\x24\x46\x03\x01\x95\x3d\x52\xa2\x11\x88\x8f\x08\x59\x2e\xad\x07\x5e\x12\x70\x8b\xdf\x4f\xef\x41\xb2\xb8\xd4\x32\x00\x01\x1a\xda\x22\x9d\x57\x50\x11\xc2\x77\x73\x37\x58\x9e\x8e\xec\xb3\xb8\xc3\x88\x7e\x6d\xaf\xf0\xae\xe0\xc9\x6d\xb8\xc7\xc6\x8b\x18\x29\xe8\x9b\x72\x32\x3c\x41\xfc\x36\x4e\xad\x76\x3f\xc4\x51\x43\x3e\x1f\x05\x86\xd4\x65\x08\x50\xe3\x9a\x38\x05\xee\x24\x28\x4a\xb6\xf8\x0a\xdf\xc4\x63\xf4\x66\x74\x63\x6a\x6b\x53\x25\xdf\x4f\x67\xf3\x7b\xe8\x40\xee\xd2\x9b\x61\x59\x4a\x0b\x58\x7d\x79\x93\x27\x51\xd3\x54\x58\xbb\xe4\x8d\xc2\x10\xb5\x41\xae\xae\x1d\x6c\x8f\x5a\x5b\x2b\x25\x23\x9c\x32\x77\x71\x58\x04\x24\x9e\x79\xc5\xbd\xdd\x01\x86\x66\xf9\x1a\x39\x0e\x1e\xe7\x7d\x69\x71\x45\xb6\x1b\x57\x1c\xbc\xa2\x6f\x99\x83\x65\xda\x46\xa6\xe3\xb2\x67\x9b\x75\xfe\xbc\xea\xff\xc6\x11\x58\x3b\xe6\xa1\xa6\x3e\x7b\xdb\xa4\x77\x46\xa2\x2a\x00\xc2\x08\x3e\x1a\x63\xcc\x9c\xb1\xac\x6a\xbe\x3a\x5d\xa4\x5d\xe8\x44\xfd\x78\x07\x69\x66\x2a\x9e\x9f\xfe\x88\x5d\x53\xb5\x56\xcc\x6e\x83\xbe\xed\x40\x07\x7e\x3e\x3f\x97\x33\xfc\xb4\x38\x8b\x13\x97\x56\x86\xee\x2f\x13\x4c\x08\xb4\x1a\x4a\xfb\x75\x60\x07\x06\xde\x0b\x46\xe4\xdd\xe1\x45\x46\xe6\x48\x2f\xeb\x2e\x3f\xa8\x1e\x66\x43\xa7\x10\x3f\x83\x9a\x11\xcc\x39\xed\x84\x47\x09\x0b\xef\x0c\x77\x99\x15\x94\x50\xfc\x7c\x03\x53\xc9\xa9\xc5\x94\xee\xf6\x70\xd0\x17\x5e\xb7\xb7\xaf\x1c\x24\x30\xa0\x63\xa9\xea\xd5\x3a\xec\x7e\xb0\x1f\x59\x88\x82\x20\x9f\xdd\xd5\xc5\x5c\xe2\xc8\xfa\x2c\xf5\xc7\x7e\xf7\x9f\x8c\x6f\x88\x0d\x98\x62\xd8\xa1\xeb\xe3\x62\xb1\x44\xce\xe9\xd1\x2a\x94\x3f\xa7\x9f\xb1\xe9\x5a\xeb\x60\xd0\x9f\xcc\x43\x18\x4b\x41\xa3\xe8\x53\x8b\xf1\x5c\x33\xc6\xc6\x36\x61\x27\xb3\x2d\xa9\x60\xc9\xfc\x34\x25\xac\xd9\xac\x86\xa1\x5d\x3d\xf8\x9b\x49\x14\xe9\x38\x1f\xed\x08\xdf\xc7\x26\x13\x47\x4a\x1f\xc5\x96\x7a\x14\xf2\xb0\x4c\x00\x26\x20\x42\xe2\xc1\x26\xf3\x38\x27\x87\x33\xb7\xd2\x73\x2d\xbf\xfb\x68\xd1\xf5\x38\x90\x10\x05\xcb\x0d\x0e\x25\x89\x75\x4a\xef\x9a\xfe\x42\x88\x16\x53\x70\x77\x85\xac\xa8\x39\xe0\xee\x0c\x30\x93\x78\x39\xd0\x08\x1c\xa9\xe0\x29\x5b\xff\x3c\xc9\x07\xa0\xfd\x05\x63\x2f\x6f\xb1\x62\x6e\x02\xf4\xff\xa9\xe9\xe4\xca\x30\x25\xca\xda\xce\x97\xe7\x4f\xd0\x46\xc0\xd0\xd3\xea\x4f\x7a\xc6\xa9\x97\xfc\xee\x73\x93\x33\x5a\x5e\xb4\xb2\xfb\x36\xc9\xc3\x66\xd0\x18\x00\xf6\x7e\x2c\x77\x1b\x8a\xc3\xdf\x3f\x34\x56\x7d\xf8\xa7\x87\xda\x09\xc1\xee\x5c\x86\xb0\xcc\x2b\xf0\x86\x91\xfa\x44\x2d\xee\x73\x14\x09\xc7\x52\xd4\xef\x0d\x20\x4b\x5d\xd9\xad\x01\xd9\x2b\xc0\xbc\x2c\xe2\x4c\x9e\x0b\x09\x47\xac\x40\x7a\x65\xae\xcf\x75\x4f\xf8\xf4\x7d\xa9\xbc\xf5\xca\x97\x4f\xe0\x8f\xc8\xf2\xfa\x76\x6e\x42\xab\xc5\xa5\xc8\x2f\x6d\x67\xa3\xe4\x13\xf8\x43\x67\xee\x33\x8b\xb3\xef\xb6\xf7\x58\xd5\xb3\x2e\x66\xca\x6e\x40\xce\x95\x34\x12\x15\xd0\x36\x4e\x9a\xc6\x2b\x6f\x01\x06\x77\x51\x86\x73\xde\xc6\xc4\xbe\xaf\x91\x2f\x70\xf6\x6b\x48\x12\x52\xc1\x39\xb6\x47\x45\xef\x19\xec\x38\x66\xe4\x5a\x6b\xa8\x29\xce\x06\x8b\xed\xbc\x20\xb1\x4d\x6d\xc5\x1f\x97\x85\x99\x5d\x0d\x1d\x73\xa5\x78\x71\x05\x74\x8f\xbf\x40\x0b\x3c\xfe\xf2\x50\x57\xf2\x95\x9c\x4f\x08\x06\x8f\xda\x5b\x6f\xde\x92\x6c\x39\xcc\x60\xbb\xea\x38\x3f\xac\xe2\x3e\x08\x72\x58\x45\x89\x17\x16\x89\x9f\x39\xb0\xcb\x36\x1c\xaa\x63\x35\x82\x11\xaf\x62\xf4\x16\x7f\x57\x28\xb9\x67\x6a\xde\xb4\xe5\xe7\xd1\x07\xf3\xab\x0a\x8e\x4d\x94\x17\x2a\xbd\x18\x35\x1d\x2a\xae\xe7\x50\xf8\xf8\x72\x39\x52\x79\xbb\x5a\x37\xd2\x4b\xba\x5f\x42\x15\x04\xb7\x62\x5f\xb9\xd8\x1a\x58\x89\x7b\x92\x39\xfe\x42\x38\x14\xad\x69\xe7\xd3\x3d\xd9\x01\xda\x2a\x20\xcf\xec\xfd\x02\xa4\xc5\xa3\x3c\x4a\x2c\x65\xa4\x98\x21\xed\xce\xf0\x32\xf9\x57\x34\x71\x4c\x9f\x1d\x6b\x85\x52\xb8\xa0\x56\x4c\x4c\x79\x50\xfc\x0a\xb4\x32\x26\xe8\xe1\xba\xee\xbb\x81\x7a\xd8\x71\xcf\x45\x35\x1c\x35\xd8\x94\x6a\xf0\x6a\xa2\xa5\xca\x6a\x88\xa4\x07\x67\x39\x64\xae\x44\xe8\x37\x04\x41\xca\x2e\xfb\xb9\x9b\xf4\x56\x40\xbd\x35\x2d\x0d\x6e\xb5\x90\x1a\x21\x2a\x04\xb9\xbb\x34\x7c\xab\x0d\x5b\x80\xeb\x29\xf7\x0f\x2d\xfb\x64\x8f\xe9\xf6\xe2\xc6\x42\x01\xc9\xe5\xe4\x40\xc3\x1c\x9a\xc4\x57\x9e\x53\xa5\xcf\xaa\x3f\x82\x27\xe0\x8a\xae\x57\x93\x56\x07\xfa\xce\x8d\x70\xe2\x79\xe3\x5f\x55\x57\x7f\x2e\xa7\x79\xb6\xd0\x16\xd3\xdf\x11\x32\xee\xb3\x17\xbe\xd2\x39\x02\x18\xcf\x71\xee\x5f\x99\x8a\x1a\x6a\x6f\x05\xac\x88\x67\x69\x46\x46\x5d\xb2\xf5\xeb\x73\x7c\xa3\xfe\x49\x37\x2e\x46\x17\x16\x9c\x8f\x5c\x7d\xe1\x6b\x9f\x45\x0f\x8d\x5f\x0c\x43\x87\x61\x5a\x43\x81\x4e\x2d\x54\xe6\x3e\x34\x1e\xc7\xba\xf1\xc6\x52\xcd\xe7\x6e\xcb\xf9\xfb\x05\xfc\x42\xbc\x21\x90\x19\x61\xd2\x12\x95\xb6\x30\xc1\x11\xde\x94\x07\xcb\xec\xa1\x53\x3c\xf2\x14\x4b\x78\x3f\xd4\x10\xe9\x97\xf2\x31\xdf\xc6\x7c\x77\x1b\xdf\x93\x44\xde\x95\xc3\x70\xef\xc7\xf2\x05\xff\x19\x6d\x8d\xfd\x3b\x19\x8a\xc9\xb4\x09\x3e\x1b\xd0\x16\xdb\x2d\x57\xce\x17\xb9\xa0\xe9\xf2\x3b\xa6\x11\x30\xa2\x53\x96\xda\xb2\x13\x7b\x5e\x91\xcf\x36\x83\xd9\x5c\x5a\x8f\x3a\xa1\xbc\x93\x1c\x20\x14\x83\x32\xc8\xd1\xf1\x95\xb6\xd6\x82\x29\x78\x38\x88\x99\xdc\x82\x80\x69\x21\x33\xe9\xcc\x23\xe3\xaa\x17\xc0\x01\x7d\xb5\x51\x73\xdf\x3c\x67\x0d\x2c\x39\xaf\x9e\x47\x17\x3a\x03\xe2\x82\x48\xaf\x82\x64\xc6\x07\xad\x5a\x8f\x60\x7d\xe0\x47\x9f\x35\x48\x88\xcf\xeb\x9a\xe2\x31\x06\xa4\x86\x0b\xb2\x1f\xd2\x2a\xfe\x8e\x87\x68\x69\xb4\x81\x09\x58\x84\x9f\x99\x29\xe5\x85\xe3\x51\x46\x06\x8d\x62\x96\xc3\xe4\xfd\x68\xc2\x85\x2b\x02\x31\x84\x00\x33\x96\x19\x15\xd3\xb1\xbd\x5d\x3f\xb8\x70\xe8\xc5\x9d\x58\xfe\xdf\x75\xa5\xe4\x0a\xee\xf8\x1f\x5c\xf7\x57\x37\x87\x1a\x37\x14\x80\xbd\x2b\x0b\xe8\x20\xd1\xde\x84\xc7\x97\x2e\x77\x28\xf9\xae\x9c\xfe\xdc\x09\x28\xd2\x79\xdd\x36\x55\x94\xcc\x98\xdb\x91\x49\x6e\x73\x6c\xac\x92\x4a\x3b\x56\xba\xc5\x80\x1c\x64\xa2\xf7\x59\x7f\xb1\xbb\xda\xb2\x15\x11\xd1\x6c\xc9\x25\xcd\xe8\x2b\xb3\x74\xb3\x40\xbf\x6b\x79\xe7\x63\x98\xa7\x2b\xe0\xce\xcd\x86\xe8\x36\xbd\x72\xdb\xc0\xe3\x19\xb3\x55\x43\x2e\xeb\xc8\xe5\x49\xe4\x6d\x13\xcd\xf3\x10\x1a\xc7\xcf\x74\x5b\x54\x2d\x38\xdf\x6a\xdc\xf8\xa7\x7c\x7b\xdf\xe5\xba\xd9\x3a\xb7\x62\x3f\x13\xe6\xa8\x81\x53\xb1\x7b\xe5\xfd\x9d\xc5\xcb\xb1\x97\xac\x1a\x70\xcc\xa7\x29\x53\x71\x16\x04\xbd\x41\xd1\x22\x63\x1c\xae\xbc\x25\x48\x99\x17\x66\x90\xa4\x1f\x4d\x20\xea\x02\x53\x92\xa3\xf9\xee\x06\x55\xa9\xc5\xc8\x88\x37\x97\xf2\x9a\x23\xde\xc7\xb9\xf3\x68\xcb\x96\xad\x94\x27\x42\x3c\x7d\xb9\x76\x01\x85\x2f\x6d\xa5\x53\x6c\x9a\xcc\x17\xf8\x9a\xb3\xdb\x42\x52\x23\x18\x32\x67\x06\xff\x1c\x85\x91\x94\x05\xcb\x35\xa3\x07\x1e\xab\xe8\xbd\x89\x4f\xe6\xc7\x1a\x6a\x0f\x62\xb3\xdf\xb7\x06\x24\x2d\x75\xdf\x9b\x8a\xe0\xd3\xbe\x08\xea\x37\x9e\x98\x30\x58\x61\x28\x1a\xd4\xff\xcb\xec\x0b\x2a\x03\x85\xce\x45\x45\x53\x0a\x29\x82\x63\xe4\x64\x4d\xd8\x2e\x27\x74\x03\xfb\x6f\xef\xae\xf1\x49\x7b\xce\xfb\x47\xaa\x97\x7b\x9d\x09\x1a\x14\x5e\xb9\xfd\xc9\x8f\xc4\x1c\x06\xe2\xa5\xc7\xfe\xb9\x18\xd8\xe6\x05\xf6\x43\xa1\xc2\xcc\xb9\x4c\xcd\x2c\xf7\xb8\x6b\x00\x19\x62\x90\x82\x0d\x00\xe2\x06\x75\x8a\xdb\xaa\xf0\x9e\x2d\xf9\xe1\xdb\x33\xf7\x9d\x6e\x60\x73\xf0\x77\x90\x29\x05\x33\x64\x4d\x95\xba\xc9\x29\x5c\x92\x59\xf7\x15\x9f\x9a\x8f\x21\x66\x72\x4d\x9f\x8f\x1f\x76\xa0\xb9\xcb\x4f\xd2\x78\x00\xa2\x76\xa5\x1f\xc4\x70\x7a\x46\x0f\xc5\xf5\x4e\xed\x33\xcc\x0f\x41\xf7\x13\xc8\xa9\x24\xdf\xbf\x55\x07\x45\xa9\x8c\x3b\x90\x63\x92\xf0\xfa\xf3\x47\x6e\xc5\x1c\x35\x1b\x90\xab\xfc\x3a\x07\x5a\xfe\x82\x59\x93\x40\xbb\x35\x91\x4e\xa8\xfe\x9b\xe6\xc9\x6c\x78\xaf\x63\xbf\x32\xd5\x2d\x72\x15\x6f\xf7\xa5\x90\x02\xe0\x48\x91\x20\xca\xf1\x13\x40\x55\x86\x3c\x30\xb6\x7f\x8d\xe5\x12\xb4\x5f\x42\x1b\xfd\xaf\x8a\x8a\x83\x55\x98\xfd\xf0\x18\xed\x65\x5e\x04\xb0\xc2\x46\x92\xa1\x6b\x94\xf9\x93\xcf\x75\x89\x87\xa7\x67\xbf\x15\x8a\xdd\xeb\xfa\xeb\x94\x49\x38\x72\x59\x6a\xe9\x2d\x49\xf1\x2c\x93\xa2\x32\x91\xd7\xdc\xdb\xc1\xc7\x78\x84\x4f\xc2\x42\x1a\x9e\x32\xda\xa2\xf4\xf5\xef\x7e\x6b\x31\xee\xa3\xa3\x30\x84\xe0\x20\x88\x77\x4d\xdc\xc9\x30\xff\x31\x05\x47\x45\x4d\xd7\xaa\xe4\x08\xa0\x14\xbb\x06\x39\x6c\x20\xf2\xaf\xea\xdf\xbe\xb0\xe9\xcc\xb3\x15\xd7\xcd\x9f\xba\x27\x22\x29\xbb\xac\x3c\xbe\x5f\xd8\x56\x1f\x50\xdb\x72\xa2\x25\x3a\x3b\x50\x2f\x9f\x07\x42\xfa\x08\xe7\x0e\x94\xdc\x98\xaf\x51\xfe\x41\x80\xf8\xa4\xf9\x62\x65\x8b\xdc\x31\xba\x3a\xaa\xd6\x0f\xa4\x56\x0d\x14\xdc\xbe\x28\xcc\x23\x82\x33\xfe\x7b\xa2\x0e\x85\xe5\x33\x7b\xd6\x83\xd4\xe1\x62\x0b\x7d\x33\xe0\x35\x2c\xda\xef\xbe\x0b\x3e\x76\xbe\x95\x60\x28\x4c\xd0\x42\x70\x08\x72\xc0\x4f\x88\x05\x9f\xaf\x2e\x1a\xa4\x49\x6d\x2a\x3a\x88\xa9\x6c\xe6\xd7\xcb\x78\x7a\x55\xca\xb1\x34\x18\x83\x65\x00\x42\x63\x21\x1b\x29\xfc\xf2\x81\x36\xde\x6c\x4e\x3a\xb7\x33\x0e\x7d\x1c\xff\x29\x02\x61\x96\xf5\x67\x64\x02\x3a\xcd\x18\x8c\x11\xde\x48\x8c\x66\xc8\xe1\x80\xfa\x94\x88\x32\x7e\x93\x06\xea\x55\x36\x7f\x5f\x2d\x55\x4a\x06\xc3\x37\x45\x2d\xa1\xc3\xf3\x8f\x96\x87\x01\x3d\xae\x6f\x2e\x66\xc7\x2b\x4e\xb0\x2c\xac\x0c\x81\x1f\x1a\x04\xf5\x5a\x5e\x0b\x23\xa5\x3c\xe7\x5b\xce\xf8\x12\xac\x3c\xf0\x94\x10\x6a\xcd\x7d\x90\xfe\x02\x3e\xb3\x6f\xd4\xaa\xf3\x1c\xc2\x0b\x51\x28\x7b\xdd\x0e\x52\x32\x1e\xdd\x6e\xb1\xf9\x16\x71\x41\x0d\x4d\x50\xc6\xa6\xb0\x42\xe3\x05\x4c\x4d\x22\x22\x8d\x4b\x07\x42\x19\x41\x29\x62\xd0\x08\x66\x49\x67\x67\xc4\x6c\xb4\x61\x5e\x62\x18\x71\x79\xda\x9e\x8f\x4c\xcd\x1f\x75\x55\xe6\xf9\xe4\x1c\x7f\x6e\xd2\x75\x2a\xfa\x74\x62\xbc\x62\xc6\xf7\x46\x21\x48\xeb\xa1\xda\x20\xcc\xa1\xfd\xf9\x96\x21\xf5\x55\x35\x61\x4c\xab\x22\xf7\x6c\xc3\xcd\xbe\xe1\x05\xf7\xc3\x92\x62\xde\xae\xc2\x21\xff\xb5\x01\x1a\xfa\x95\x02\x30\x32\x9f\x6c\x49\xdc\x49\x73\x88\x23\x68\x07\x0f\x95\x75\x63\x95\x39\x3b\x23\x5a\x17\xed\x04\x7a\x37\x70\x32\xa0\xea\x1b\x57\x61\xe9\x3b\x10\x1b\x6b\x71\x17\x25\x03\x98\xe3\x53\x72\xc8\x27\xd1\x2d\x7c\x8f\x06\x19\xcb\x64\x93\xb6\xee\x4d\x82\x8b\xf1\x19\x00\x72\xff\xef\x0b\x1d\x2d\xe9\x8b\x49\x2a\x23\xd5\x5f\x4c\x63\x5d\xef\xd2\xc8\x29\x41\x53\x4b\x4a\xb2\x5e\x8d\x9a\xf4\x84\xb0\x79\x85\x7f\xf2\xd6\x36\x51\x39\xc4\xa0\xaf\x51\x45\x02\x50\x9a\x76\xbe\x3a\x1c\x18\x43\xeb\x8c\xde\x46\xe2\xad\x33\x9f\x89\x27\xb0\x5a\x80\xe3\x68\x8b\x65\x4f\x27\x68\x83\x6a\x4f\x17\x1a\xa6\xa3\xf8\x5f\x7d\x25\x95\x89\xec\x83\xcd\x2c\xd9\x0e\xb5\x61\x40\xd7\x25\x27\x9d\x6b\x91\xbe\x28\x69\xf3\x31\x79\x0f\x9b\x39\xbc\xee\x3d\xab\xde\xa5\xb9\x8d\x22\x42\x1d\x16\xd4\xd5\x69\x14\x10\x7c\x0c\xa0\x28\xf2\xe5\x5f\xab\x39\xb6\xe8\x19\x40\x12\x19\xc2\xf0\xd4\x11\x7b\x9f\x78\x5b\x1b\x6d\x28\x27\xf8\xd7\x39\x84\x1d\x39\x03\x23\xc6\x00\x08\x25\xe5\xf4\x0b\xe8\x91\xe9\x0b\xb7\x84\x98\x60\x38\xfe\x10\x83\x79\x8d\x5a\x6d\x8e\xc3\x35\xe1\x69\x29\x3b\x8f\xab\x89\xfb\xa1\x26\xb6\x48\x1f\x7a\x16\xcf\x01\xea\x64\x0e\xbb\xa3\xc5\x21\x52\xf4\x44\x74\x30\x4b\x30\xec\xbd\x03\xaf\xfe\x10\x9c\x82\x57\x84\x5d\x33\x84\x76\xc5\x18\xf8\xd7\x81\xb3\xe9\x8b\x14\x77\x6a\xa0\x03\x67\xf0\x05\x00\x1e\xe2\xc0\xb5\x4e\xde\x32\x9e\xa8\xce\x14\x4b\x01\x2e\x70\x57\x5c\xb9\x0d\xb3\xd5\x03\xeb\x2b\x6d\x6d\xe6\xb2\xdf\xc7\xaa\x30\x69\x1f\xce\x6b\xf3\x3f\xc3\x05\x72\xec\x6a\xee\xd4\x73\x76\xb1\x8a\x07\xee\xb3\x09\x64\xa2\x11\x28\xf1\x7e\x76\xc6\x67\x55\x17\xe0\x21\x13\xf8\x78\xb7\x7f\x25\x45\xa2\xf4\x7f\xed\x00\x58\x46\x57\x90\x28\x1e\x4d\x79\x51\x4c\xbc\x78\xce\x85\xbc\xc3\x65\x81\xab\x4a\xaf\x91\x14\xf6\x42\x66\x33\x41\xd9\xf6\xa2\x5c\xa1\x86\xea\x2c\xad\xfa\x21\x96\x38\xba\xd4\xe1\xe8\xd4\x52\x31\xe0\x52\xeb\xad\xa2\xe6\x40\xb6\x18\xa6\x65\x6a\x6f\x42\x69\x3b\x1b\x11\xef\x1c\xf5\x6c\x8f\xde\x40\xe6\xd9\xc6\x18\xa9\xcc\x94\x42\x27\x33\xfc\xa2\xaa\x6a\xd8\x18\xa2\x00\x2d\xbc\x16\xab\x45\x18\x0d\xeb\x19\xaa\xe6\xee\x1b\x55\x0e\x8e\x57\x0d\x2b\x85\xcd\xe3\x95\x7b\x03\x83\x2d\xde\xd0\xef\xc4\xc7\xd1\x6e\x6a\x25\x7e\x4c\x18\x9a\x8a\x28\x4b\xcc\x9f\xf5\x59\x4a\xa3\x53\xd5\x29\x30\xdf\x17\x40\x0a\xd8\x74\xdf\x35\x63\xfa\xa2\x49\x8a\x4d\xb5\x35\xdc\x60\x2e\xf1\xe0\x90\xf8\xf0\x2e\x25\x80\xf4\x24\xc8\x29\xbf\x8a\xd2\x79\xa6\x33\x81\xe6\x9e\x7b\x6f\x92\x57\x42\x52\x6f\x40\x88\x5e\x8d\x18\x6c\xcd\xe4\x42\x80\x8f\x5c\x70\xd8\xb4\xb2\x64\x5e\xfe\xaf\xe3\x40\x04\xee\x2c\x7e\xe6\x73\x16\x0d\x36\x92\xcb\xe8\xdb\xca\x3d\xd4\x3e\xc9\x9c\xac\x93\xdf\x60\x04\xb7\x72\xde\x92\xf1\xb3\x0c\xe7\x80\xc9\x83\x32\xd6\xca\x20\x1d\x9b\x31\x1a\x07\xf6\x01\xe2\x96\xb1\x19\x36\x92\xb2\x8f\x32\xd8\xe4\x87\x7a\x21\xa3\x98\x52\x76\x3b\xdc\x99\x5e\x43\xe5\xb6\x07\x3b\xf6\xdc\x6c\x07\x9f\xde\x0d\x0f\xb1\xc7\xbe\xca\x09\xaa\x35\x1c\xae\x01\x44\x81\xb0\xa2\x18\x1e\xb9\xbe\x1d\x28\x64\xd2\xf6\xed\xb7\x7e\xab\x6b\x11\x72\x2b\x72\xe1\xe0\xbc\x6f\xeb\xa2\x25\xff\x51\x4b\x13\x7f\x8a\x57\x17\x15\x61\x12\xf2\xde\x2e\x52\x28\xcc\x8a\x71\xde\xd4\xfa\x7a\x79\x1b\x18\x35\x55\xb6\xec\x8c\x9b\xff\x58\x7c\x6e\xf4\xdd\xd6\xc3\x84\xba\xbb\x30\xb2\xc3\xe2\x8d\xdb\x18\xfc\xb5\x9a\xba\x72\x0d\x4c\xdb\xb3\x12\x8a\xe7\xf1\x7e\xc3\x6c\xc7\xe9\xb4\xe4\x87\xd7\x69\x79\xbd\xba\x3c\xc6\xb4\x58\xd0\x43\x8f\xc0\x5a\xc2\x4b\xdd\x2d\x50\x04\x85\x7b\xbb\x8d\x51\x07\xa6\xd5\x7d\x48\x2e\xc3\xe9\xbb\xb9\xea\x20\xa2\xdb\x06\x24\x4b\x4e\xd7\xb0\xe4\xf7\x9b\x0e\x8f\xe5\x06\x37\x59\x19\xb8\x20\x22\x67\xa6\xac\x63\x77\x93\x91\x36\xd9\xc5\x97\x30\x87\xd1\x54\x8c\x11\xdf\x0c\x68\x58\x3f\x64\xd7\x3b\x3b\xe0\xe2\x3d\x58\x9a\xed\x55\xb6\x2a\x10\x76\xcf\x72\x37\x32\x27\x13\x7c\x4e\x1c\xa6\xbf\xe1\xf9\x4c\x45\xa5\xa0\x27\xf3\x85\x04\x6e\x6a\x2b\xd5\xe4\x07\x02\x9a\xe0\x36\xba\x70\xe3\x6c\x04\x66\x9f\xaf\x31\xca\xaa\xc6\xaf\xdc\x20\x13\x26\x37\x61\x67\x0a\x98\x96\xa6\x1e\x22\x17\xdd\x4a\xd9\xd3\xf4\x12\xdf\x03\x67\x8a\x34\x30\x64\x39\x8e\xdb\x84\x44\x7b\xee\x72\x2b\x49\xbc\xec\x1c\x72\xd4\x29\x65\x98\xf0\xc7\x5c\x4e\x76\xbd\xac\x0e\x27\x25\xad\x8d\xce\x5b\xe0\x16\x11\x63\x39\x41\xd8\x14\xea\x73\x92\xad\xb8\x89\x81\xad\x55\x14\x7e\x7a\xe7\xbd\x3a\x6f\xf0\x63\x58\x63\xdd\x45\x30\xfd\x14\x0a\x85\x22\x4b\x62\x55\x01\x1f\x31\xaa\xb6\xc6\xa1\xfb\xf1\x4e\x83\x29\x83\x92\xd5\x56\xd9\xe6\xeb\x7e\x01\xb3\x39\x3a\x00\x8a\x14\x01\x74\x77\x44\x5a\x8d\xfa\x0b\x20\xf4\x62\xde\xaa\xb4\x13\xfd\xe1\xf5\x64\x56\x9a\x90\x42\x55\xaf\xf7\xfc\xc3\xfc\x47\x06\x47\xa3\xd2\x8a\x86\x55\x61\x72\x8e\x68\xea\x59\x28\x35\xb1\x8c\x5d\xb1\xa8\x30\xe7\x7a\xa6\x3b\x7b\x63\xda\xaf\x3b\x5f\xb8\x26\x88\xa0\x0b\x5a\x71\x42\xa6\x57\xa8\xa9\xce\xce\xc2\x28\x43\x7b\xd3\xc4\x14\xd8\x88\xf8\x58\x63\x40\xb8\x93\x77\x15\x25\xa0\x86\x86\x6c\x97\x50\x15\x90\x85\xda\x0b\xbe\x58\xf7\xa7\x82\x28\x36\x36\x59\x58\x70\xb4\x17\x34\xc0\xfc\x76\xdd\x55\xad\x6e\x25\x7f\xcd\x83\xc6\x78\xc0\x38\x72\x7c\x5b\xe8\xf2\xc1\x2a\x77\x49\xbe\x5d\x26\x2a\xe4\x12\xa1\x83\x9c\x32\x74\x2d\x2c\xab\x47\x1d\x4a\x8f\x89\x69\x66\x81\x6f\x83\x8e\xca\xbb\x57\xb0\xcb\x85\x97\x06\x7a\x59\xa1\x89\x5e\x4f\xeb\x0d\x71\x65\x98\xc9\x42\x79\x93\xb2\x40\x56\x6f\x91\x40\x14\xf5\x69\xc4\x09\xd2\x58\x75\x2f\x94\x9f\xd9\x5e\xc4\xee\xbd\xe6\xec\x9f\x6b\x28\xb3\xc4\x0b\x58\x07\xf4\xee\xfe\xad\x4e\xe5\xf1\x6c\xbc\x34\xca\xf7\xf2\xfd\x54\x09\x0a\xfd\x8e\x4c\x9a\x5f\xb8\x94\x1e\x5e\x3e\x1d\x74\x05\xd6\xd7\xdc\x20\x5c\xc9\xf9\xd8\xde\xf5\x74\xe2\x99\xc2\x58\xed\x02\x43\x8b\x3e\x59\x4e\xe4\x19\x09\x4b\x90\xd7\x8d\x81\x94\xf7\x6c\xa1\xca\x60\xb6\xa6\x65\x17\xdf\x35\xbe\x87\xe0\xea\x99\x77\x35\x9c\x14\xb7\xfe\x12\x9d\x60\x18\x74\x69\x33\x9a\x28\x51\x7a\xad\x4f\x17\x90\x23\x57\xbc\x84\x8a\x0c\x6f\xdb\x90\xa1\x7a\x05\x7f\x27\x22\x15\x3d\xed\xa0\x54\xcc\x1b\xe7\x18\xb9\x66\x6e\xa3\x80\x6f\x49\x09\x1a\x3c\x1c\x40\x61\x2e\x44\x57\x34\xce\xbc\xe4\xce\xb2\xc7\x6b\xe8\x00\xdf\xe7\xef\x81\x8b\x74\x92\x4d\x21\xaf\x6c\x9a\x10\x69\xa4\x2b\x6e\x8b\xc9\x79\xe5\xd5\x5f\xb4\xc7\xa4\x4f\x6c\x05\xd0\x2e\x56\x60\x56\x59\xce\x09\xb0\xec\x02\xf1\x23\x11\x9e\x3c\x0f\xc5\xd8\x46\xf7\x0d\x09\xc3\xc7\xb1\x36\x53\x9d\x28\x9e\x53\x91\xfe\xfe\xa2\xef\x4a\xf5\xf0\x59\x05\x57\xb3\xd0\xd4\xe2\x7a\xca\x3d\x7d\xb5\xb4\x30\xc7\x6d\x0e\x20\xbb\xca\x73\xd5\x27\x1e\x08\x55\xa7\xf2\xb1\x9c\x30\xb5\x30\x3b\xbb\x3b\x7a\x47\x19\x68\x7d\x0c\xc1\x56\x62\x33\x21\xcd\xe2\x5b\x89\xe6\xbe\xf4\x2e\x1a\xe0\x8d\xc7\xfd\xe3\x97\xc8\x9d\x8d\xcb\xa2\x98\x4e\x2c\x43\x19\xca\x48\xce\xfa\x57\x44\x9e\x24\xec\xe4\x7b\xcd\x00\xae\x55\x4e\x75\x39\xe8\x6f\xb3\xdc\x1e\x21\x5a\x35\x46\x30\x1b\x46\x0d\x39\xe1\x0d\x0d\xfd\x66\xc5\x1e\x74\xdf\x45\xd0\x56\x81\xf1\x80\xac\x04\x19\x50\xa2\x7e\x9d\x8d\xf2\xdf\x07\xff\xb9\xa6\x5d\x7b\xf3\x47\x56\x93\x78\xcb\xa3\xa0\x2e\x0e\x10\x31\x09\xa3\x6b\x92\xd9\x51\x4c\x63\x04\xcf\xb8\x52\xc9\x01\x3d\x7d\x21\xe9\xcd\x97\xc4\x73\x54\xb7\x15\x79\xa3\xf6\x7e\xbb\x41\xe8\xe0\x94\x5d\xcb\x1a\x57\x94\xc7\xe6\x3c\xf5\xe0\x89\x06\x89\xfb\x03\x8d\xca\xfb\x2d\xa3\xde\x6f\x0f\xa7\xa0\x3b\x72\xc3\x11\x54\xe6\xbd\xc6\xe8\x86\xe1\x52\x1e\x07\x67\x25\x2c\xbd\x62\x65\x3e\xf0\x98\x77\xae\x4a\xc2\x35\x67\x6d\x04\x47\x04\x6f\x84\xae\x50\x0e\x26\x5a\xe5\xdd\x77\x09\x3d\x83\x69\xf4\xf9\x40\xf7\x71\x31\x4c\xeb\x49\xcc\x34\xb7\xc8\x3b\x13\x66\x62\x0a\x6e\xbc\xfe\x28\xbe\x26\x78\x3d\xfb\xf5\xcf\x19\xcd\xc9\x2a\x26\x48\x56\x1b\x44\x99\x9b\xad\x71\xf8\x4f\x12\x8b\x2a\x0d\xe8\x62\x4f\x30\xd3\x40\x2d\x65\xcd\xbc\xc0\xd2\x53\xe5\xb0\x52\xe4\x44\xad\x85\x79\x2e\xba\x0d\x40\xa4\xbb\x30\xba\x28\xd6\xee\x1e\xf4\x6f\xac\xa8\x1b\xf9\x1b\x81\x15\x72\x91\xfb\x20\x03\x34\x48\x0b\x95\x01\xa7\xfe\x72\xef\x69\x3e\x40\x85\xd7\x07\xb1\x21\x2c\x03\x37\xa8\x3c\x75\x2d\x0c\x68\xe9\x0d\xe9\x05\xd2\xc4\x2c\xa8\x8d\x7d\xa2\x01\x8e\x12\x3f\xef\x8a\xff\x5a\xdb\xc7\x6c\x9d\x2f\x60\xc5\x6d\x5f\xb6\x60\x40\xbf\x01\x6a\x05\xe8\x1a\xe2\x27\xc0\xa0\xd2\xf4\x65\xb4\xf1\x44\xc3\x44\x76\x85\xab\xfc\x8c\xce\x35\x38\x1e\x69\x58\x92\x15\xc6\x31\x10\x1d\x1e\xb1\x66\x4a\x21\x93\xf3\x63\x49\x9a\x0a\x99\x94\xe5\xba\xe6\xf0\x16\xcd\xb5\x60\x8d\xbd\xf8\xd2\xd7\x82\x74\xc3\x1d\x25\x07\xd6\xf0\xa6\x7f\x07\xfc\x96\xd2\x76\x9e\x31\x86\x40\x6e\xa8\xff\xa3\x74\x64\x69\x47\xee\x88\x09\xe1\x3f\xef\xbc\x12\xb3\xc8\x4e\x17\xbf\xf7\x14\x4b\x07\x9e\xdf\xb1\x4a\x10\x62\x30\xc9\x20\x0d\x31\x8f\x86\x95\xa1\x2b\x1c\x18\xf6\x72\x8e\x2c\x84\x0f\xe7\x4c\x99\x8d\x4f\xe2\x3e\x65\x4b\xb7\xe0\xa3\x46\x6c\xe8\x74\x08\xf1\xfd\xe5\x8b\x3b\x17\x69\x1c\x95\xb0\x23\xba\xb7\xf8\x77\x4a\x0a\x52\x3b\xda\x35\xa5\xab\x77\x51\x06\xda\x5e\x1a\xc4\xbe\x70\x51\x33\x57\xb4\x07\x37\xd5\x85\x5f\x12\x21\x61\x8a\x70\xc4\x81\xb8\x4d\xee\x04\xd8\x9a\x32\x32\x2b\xaa\x82\x0a\xf3\x26\x6d\x8c\xb6\x5a\x90\xbf\x4c\xa0\x59\x9d\x09\xfb\x3a\xa2\xcd\xc9\xfe\xcf\x60\x1e\x23\x36\x98\x91\xc8\x6f\x5b\x0c\x84\x22\x33\xc7\xa5\x19\xa5\x5c\xca\x81\x36\x8d\xe2\x08\xef\xac\xc4\x3e\xce\x0e\xe1\x8d\x90\x4f\x07\x44\xa4\x23\x4c\x03\xea\x95\xfe\x1d\xbb\x56\x78\xd5\xd4\xa1\xe8\x52\x6e\x83\xec\x54\xb1\xea\xa9\xdd\x47\xdf\xc7\x89\x04\x0c\x0b\x6e\x7c\x0f\xd4\x51\x16\x16\xfa\x5b\x6c\xe3\xac\x9c\x4a\xe0\x56\x3a\x07\x9d\x44\x7a\x92\x82\xae\x66\x10\x6d\xa3\x24\x85\x75\x8b\xf9\xd7\xa8\xfe\x8f\x35\x34\x60\x3c\x4b\xcb\xad\x6b\x67\x62\xda\x0d\x2c\x75\x55\xa6\x3e\x49\x55\x72\x5b\xed\x04\xf2\x41\xb5\x18\x45\x52\x2c\xff\xb2\x23\xe1\x35\x3d\xec\x9f\x38\x14\xbd\x7f\x21\xd3\xb7\x9f\x62\x1a\x8c\x8a\x1e\xe7\x1a\x2e\xbd\x10\x91\xb1\xe9\x2c\xd7\x82\xff\xa7\x90\x84\x73\x22\x4b\x0b\xb1\x72\x71\xb4\xc1\x3a\x17\x8c\xb7\xe9\xb4\xcd\x59\xab\xf0\xae\x7a\x7b\xb5\x59\x68\x47\x12\x6f\x38\x7f\x6c\xbc\xed\x26\xb2\xae\xcf\x97\x96\x02\xef\xf5\x13\xba\x73\x5f\xc9\x08\xc7\xde\x41\x9a\x65\x21\x55\x13\xc7\xf4\x26\xfa\xc9\x0c\x20\x79\xf2\x0b\x46\xb0\x38\xba\x43\x40\x5d\xe4\x96\x84\xf7\x96\x98\x82\x2e\x56\x85\x4b\x36\x93\x67\x25\xde\x81\x06\x3f\x65\x04\x34\x5b\xa6\xcf\x2f\xde\x09\x23\xa1\xac\x5f\x4f\x6d\x5f\xbb\x15\xe4\xef\xa3\xc0\xef\x1e\xfb\xf1\x1c\x12\x22\x5e\x2a\x69\x13\x02\x9c\x73\x6e\xd6\xab\x1e\xaf\x29\x3f\x78\x67\xa4\x4e\x1c\x07\x5c\x00\xa7\x88\x45\xe0\x4f\x60\x60\x08\xe7\x67\x2e\x62\x19\xc8\x4b\x9c\x1d\xed\x7e\x1d\x23\xde\x65\x2e\xdf\xdb\x3f\xff\xd4\x90\x13\xf6\xac\x1b\x11\xf7\x13\x2e\x88\x94\xb5\x66\x2e\xe8\x46\x05\x81\xd5\x24\x57\x43\xb9\x5d\x11\x97\xc0\x6c\x80\xf5\xf3\xc9\x13\x64\x30\x78\xb5\x36\x69\x8a\xb5\x83\x45\x32\x54\xc4\x64\x00\x69\xf3\xb3\xab\x3e\x11\xb7\x2a\x49\x4d\x6f\xed\x08\x70\x67\x46\x2c\xad\x96\xc8\x2b\x67\x26\x42\x53\x4b\x0f\x20\xef\x72\x3d\xf3\x52\xf3\x0e\xa6\x50\x0b\x84\xc4\xe1\x8f\xed\x20\x72\x06\x68\xe4\x69\xcb\xd0\x08\xbc\x3b\xa8\x07\x1f\xfe\x84\xd2\x6d\x9f\x1e\xd0\x6d\x1b\xf4\x7d\xec\x68\x7c\x5b\x12\xa7\x5e\xba\x98\x6b\x75\x60\x00\x07\xd4\x1a\x2e\xa4\x92\x5f\xfa\x4c\xf1\x4d\x36\x9b\xac\xca\x9a\x5f\xda\x9f\x1b\xf5\x0d\xda\x59\x38\x38\xa3\xfd\x83\x98\xe8\x2c\x64\x35\xc3\x20\x2e\x60\x91\xdf\x43\x6e\x41\x83\x29\xe0\x53\x53\xc3\x0f\x44\x4e\xc8\x9d\xc9\x22\xfe\x72\x51\x3b\xd5\x0b\x04\x69\x4d\x75\xa9\xb7\x70\x33\x65\xa1\x14\x82\xe9\xc9\x55\x8f\x39\xb5\xea\xe9\xa3\xe0\x58\x69\x60\xf7\x3c\x25\x7c\xe0\xa0\x23\xb6\x6c\x8e\xff\x8d\xbd\x6f\x4e\x3b\x42\x15\xe1\x24\x77\xfd\x02\x26\x9d\x9b\x62\xcc\x79\xe0\xf1\x01\x7b\x1d\x1d\xee\x61\xb8\xd0\x20\x23\xe6\xcc\x74\x99\xa9\xdb\xa6\x9d\x80\xad\x50\xf8\x31\x52\x52\x7b\x02\x6c\x6a\x90\xa3\xbc\xa7\x82\xc9\x51\xd4\x1f\x12\xd9\xa6\xbc\x38\x6a\x0b\x1f\x31\xad\xa9\x3f\x9d\x2e\xd9\x05\x6c\x95\x3e\xbf\xf0\xd4\xf1\xf5\xdb\x56\xf5\xf5\xd8\xe4\x0b\xae\x12\xb2\x45\x8f\xfd\x1f\x75\x4d\x56\x1b\xe5\x71\x9a\x61\x85\x15\x6a\x9c\x89\xe2\x22\x56\x4a\x88\x68\x8a\x86\x7d\xcc\x46\xd4\xf1\xaf\xa7\xff\x15\x99\x12\xa5\x1e\xf3\x17\x55\x01\x6a\xad\x0a\x0b\xf0\x78\x5b\x51\xec\x1b\xdc\xa5\x04\xce\x7c\x11\x7a\x9f\x16\xcc\x37\x9a\x9c\x21\x2c\x5b\x04\x66\xf0\x10\x13\x68\x3f\x6d\x93\x43\x27\x27\x35\x2d\x6d\xab\x1d\xef\xa9\x5c\x09\xd7\xd8\x04\x57\xd8\xdf\xdd\x22\x27\x36\xbf\x3c\x81\x33\x12\xad\x19\x51\xac\x5c\xb4\x43\x1e\x7c\x4a\x79\x89\x83\x8a\x8b\xc3\xd4\x46\x0b\x31\x18\x9e\xf3\xbe\x02\x04\xeb\x35\xe7\x6c\xde\x0d\x48\x82\x3e\xd6\x27\x39\xcf\x64\x57\x74\x27\xdc\x46\xf9\xac\x4f\x46\xa0\xbd\x03\x72\xd8\x00\x3e\x04\xf6\xb8\xc1\xc9\x22\x80\x90\x47\xaf\x99\xf1\x82\x09\x0a\x72\x86\x97\x79\x6d\xda\xd8\xaa\xf5\xdc\x3a\x10\x50\x8d\x36\xb5\xab\x12\xa2\x28\x1d\x44\x05\x33\x23\x4b\x07\xe7\x68\x45\x75\xa5\xe9\x8f\x99\x32\xe7\x1d\xfc\x95\xc3\x6b\x03\xd2\x18\xa2\x13\x15\x83\x1b\x4e\x27\xdf\x25\x4e\x83\x06\x99\x09\x83\x42\x5a\x68\x50\x07\x6b\x83\x0d\xcd\xf3\xff\x11\x43\xff\x28\xa0\x6f\x74\xe2\xa1\x40\xc4\x5e\xdc\x79\x82\x03\x68\x09\xac\x07\xfc\x0b\xcb\xbc\xfe\x6b\x71\x7e\x59\xa8\xba\x2a\x7a\x7c\x4a\x42\xeb\x04\xf5\x95\x2d\x3c\xde\xb0\xde\xe5\xb1\x0c\x87\x61\xcc\xd3\x11\xbc\xc4\xf3\xc0\xc2\xa0\x55\x79\x4e\x21\xde\xd2\x04\x62\x56\x5e\x5c\x08\xe9\xe5\x1c\x62\xd6\x52\xea\x50\x57\x34\xf2\x66\x6b\xfb\x3c\x4b\x68\x74\x21\x42\xa9\xac\x7a\xf6\x07\xf9\x98\xdb\xba\xa0\xf0\x70\x48\x84\x50\xd5\xee\x7c\xb2\x92\xb5\xf0\xe6\x9f\x38\xd0\x12\x87\x12\x6e\x43\xfc\xbc\xaa\x54\x1a\xd4\xe7\xf1\xdb\xc7\xc9\x7e\xb6\x90\x7c\x1e\x6e\x44\x82\x3a\x81\xaa\x52\x0a\x36\x65\x0f\x75\xd3\xe0\x69\xf0\x0e\x1e\xf4\x30\x3e\x05\x47\x8b\x3e\xa2\xf7\x34\xa7\xe9\x65\x9b\x94\xcc\x95\x50\xf0\x9a\xa2\x49\x0a\xd6\xf3\x18\xfe\x96\x42\x3d\xa1\x08\xcd\x01\x7c\xb9\x17\xcb\x06\x88\x44\x99\x58\x8c\xd9\x2e\x33\x85\xf2\x87\xf5\x8f\x50\xec\x12\xdb\x0e\x4d\x03\xb9\xe7\x3c\x21\x7e\xb6\xe0\x20\xcf\x57\x74\xc6\x26\x51\x5a\xee\x81\xad\xf8\x2b\xb4\xef\x58\x1b\x72\xf4\x80\x59\x3e\x25\x8b\x9e\x1a\xc4\x9f\xbb\x26\x52\xe4\xf0\x28\xb8\x19\x2e\xaf\x8c\x9e\x4a\x63\xcf\x7d\x4a\x26\xbc\x4b\x93\xc5\x27\xbf\xff\x6c\xe7\x6a\xaa\x82\xc3\xcd\xee\x08\x85\x76\xa0\x8a\x43\x69\xfc\x91\x4a\x2b\x53\x69\xcb\x4c\xf3\xa1\x75\x7e\x3c\x5c\x4a\xf1\x4f\x39\xea\x40\x50\x4d\x2d\x07\x82\x86\xba\x28\x35\xba\x3b\xf2\x35\xd8\xf2\x79\x30\x95\xb4\x6a\x7b\xf5\x94\xf9\x11\x09\xce\x1c\x48\x90\xe8\xb6\xeb\x55\x5d\x2c\x5b\x41\x31\xb5\x9d\x9c\xa6\xb3\x80\xd7\x8e\xff\x3b\xb3\x0a\xd4\x25\xaa\xc9\xcf\xda\x31\x54\x66\xc6\xf9\xc4\x32\xc0\xd1\x94\xde\x07\x0d\x1b\xe7\x11\xfc\x5b\x74\x6b\xa3\x01\x8e\x29\xd8\x8e\xeb\x88\x05\x28\x97\x27\x47\xd7\x84\x3e\xcc\x64\xc7\x21\x66\xcf\xca\x9b\x97\x74\xf6\x26\xf8\xac\x14\xb9\xf2\xd0\x4b\x99\x34\x09\x04\xc0\x69\x7a\x2a\x83\x1f\x16\x7c\x8f\xc4\x95\xfe\x95\xd5\xbf\x0d\xcd\xf7\x4f\xe5\x17\xc6\x42\xb5\x25\x06\xc3\x99\xf4\x66\xb4\x44\x81\xcf\x70\xea\x26\x44\xd2\xf2\x1d\xd9\xc0\xfc\x47\xc0\xbe\x9d\x11\x95\xab\x63\x33\xdd\x43\x0f\x54\x09\xd0\x7b\xb7\x42\x78\xe9\x0e\xec\x6a\x25\xd0\x77\xb8\xfb\x45\xd3\x33\x68\x7d\x92\x9c\x21\xdd\xe0\xc0\x9a\x42\xd6\x32\xdd\x74\x80\xa6\xf4\xed\x05\xa1\x84\x0a\x8a\x63\x9f\x9d\x28\x83\x8d\x7f\xb6\xe4\xe3\xd9\x48\xcd\x14\xd0\x19\xd5\xb1\xab\x02\x19\x24\xd8\x70\xb9\x68\xe2\x51\xda\x24\xf3\x41\x85\xa5\x21\xca\xd8\xff\x15\x27\xf6\x19\x5a\xbd\x20\x3a\x36\xf4\x17\x95\x58\xf5\x0a\x20\x0a\xc6\xb9\xea\x83\x7c\x9f\x25\x2a\x1a\x8b\x85\xef\x81\x24\x30\x7c\x42\x97\x2a\xf0\x9f\x63\xac\x73\xe7\xfa\x01\xa8\xf2\x2c\xc4\xa2\xb4\x32\xed\xc0\x75\xc6\x83\xa9\x54\x61\x77\xb7\xeb\x7b\x63\x5d\xf1\x6a\x75\x32\xe0\x8c\x2a\x9e\x37\xa8\x80\x4b\xba\x41\x43\xd5\x52\xf3\xa1\xcf\xbb\x3d\x3f\x87\x6c\x2a\x9b\x12\x3f\x95\x0d\x90\xa7\xae\xe9\x66\x1d\xde\x18\x2e\xd2\x33\xa1\x5c\x69\x89\x1f\xd4\x10\x04\xdf\xa3\xf3\xdf\x63\xbe\xae\xd7\x5d\xaa\x93\x95\x64\x49\xf5\x83\x84\x29\x05\x6d\xcf\xad\x16\x78\xb1\xe4\xa1\x82\x34\xe5\xa3\xd3\x86\x2c\xf2\x4d\xa5\x05\x97\xdc\x5a\x35\xe1\xa5\x2b\x77\xc9\xc7\x92\x89\xce\x63\xec\xa0\xe8\x01\x6a\xc0\xcb\xae\xcd\xb0\xa1\x6b\xa1\xe0\x0c\xb6\x90\x02\x89\x22\x56\xeb\x1a\xc1\xc5\xbf\xae\xa2\x28\xb9\x08\x49\xd0\xea\x3f\x6a\x79\xd0\xbd\xe5\x71\x15\xc5\x36\x7f\xa7\xfd\x06\x6c\x04\x42\x18\xa5\x95\xa5\xcd\x4a\x5d\x48\x52\x5e\xd8\x17\x7a\x1c\xa7\xf7\x9f\x24\x16\x87\x00\xd1\x59\x80\x0b\x33\x70\xb3\xd0\x2b\x4f\xcf\xfd\xfe\x20\x41\x7e\x45\xfa\x57\xeb\xc9\x00\x8e\x1b\x78\xfe\x30\xa8\x15\x67\xc8\xc6\xdd\x1c\xce\x5b\xda\x55\xe2\xeb\xb3\x6c\xc1\xb6\xe2\x1a\x7e\x76\x2c\x52\xde\x92\xb6\x15\xe9\x03\xaa\xcc\xf3\x56\x04\x65\x53\x89\xe3\x0c\x75\x90\xf2\x6b\xda\x5f\xab\x8d\x11\x9d\x8f\x52\x9b\x8b\x60\x89\x73\xa7\xef\x9c\xea\x74\x53\x32\x4b\xc7\x18\x7d\xe2\x8c\x66\x78\x49\x88\xa1\xb1\xc6\x8c\x35\x79\x33\xe9\x58\xdb\x2a\x46\xc8\x73\xed\x3a\xaf\xa0\xd2\xee\xc9\x7b\x74\x22\xc3\x6a\xbd\xf3\x69\x44\xf8\xcf\x16\xc1\x1f\xa3\x56\x3b\x24\xf9\xff\x0b\xc9\x5a\x90\x68\x67\x11\xea\xd8\xf1\x3b\x61\x45\xd0\x2e\xf2\xd2\x97\x1d\x3b\xf0\x93\xce\x67\xea\x63\xea\x74\x5d\xeb\x6b\x5f\xe7\xae\x10\xc6\x9d\x29\x80\xab\x90\x95\xf0\x66\x14\xbe\xfd\x17\xdc\x10\x1f\x7a\xbc\x6a\xef\xc9\xe1\x13\xca\x04\x3c\xba\xe5\x71\x05\x14\x8a\x8e\x6a\x07\x89\xe2\x4b\x3a\xd8\xa3\x79\x01\x7f\x5b\x7a\x65\x50\x12\x31\x4b\x85\xb6\x06\x44\xe5\x46\x1d\xf1\x31\x28\x40\x5a\xc1\xe7\xba\x40\xaa\x16\xa7\xf7\x39\xc1\x56\x04\xce\xb7\x07\x79\x15\x7d\x1d\xc5\x59\x24\x4e\xa4\xbd\xd1\x7d\x30\x4f\x84\x36\xd2\xd9\x93\x17\x56\x99\xc5\x96\x5f\x82\xfb\x35\xf7\x0c\x5e\x19\x59\x07\x7a\xe0\x68\x0b\x45\x82\x9d\xe5\xbc\x5d\xac\xc5\xf8\x81\xb6\x25\x15\x8a\xf6\x7b\x16\xa3\x81\x09\xaf\x8e\xdd\xe0\x16\x0b\x71\xd5\x83\x29\x9c\xf4\x1b\x8c\xc3\x9d\x3e\x51\x4a\xff\xda\x9b\x0d\x50\x78\x81\xd8\xb1\x4d\xd0\xc0\xe7\xa7\x55\xb1\x1d\x72\x56\x32\xe2\xa3\xc9\x3a\x00\xde\x0f\x37\x08\x22\x74\xc0\x82\x2e\xb2\xfb\xde\xe0\x03\x73\x25\x4a\xeb\x5e\x91\xf5\xc3\xe3\xe6\x28\x13\x26\x55\x54\xed\x0a\x2e\x5d\x9d\x37\x76\x08\x50\xba\x42\xd7\x5f\xc4\x3a\x01\x08\xc4\x49\xac\xa9\x8b\x59\x19\x6c\x82\x4c\xf0\x15\x7e\xd2\x64\xd1\xd6\xfa\xd3\xe7\xbc\x5b\xb6\x2c\x59\x66\x56\xff\x7b\x87\xd3\x56\xf0\xed\x4a\xb2\x78\x19\x46\xdf\x43\x9a\x48\x63\xe9\x40\x03\xe4\x8b\xb6\x5c\x8a\x71\x01\x22\x43\xe8\x30\xdf\x68\x41\xb4\xcd\xca\xcd\x87\x87\x75\x0c\x2c\xa9\x26\x45\xed\xe5\x7c\x80\x45\x7f\x6d\x4c\x99\xba\x6f\x3d\xc7\xae\x48\x91\xda\xfd\xec\x4a\xec\xa8\x0a\xdc\xa9\x52\x15\x55\x81\x6b\xd5\x57\xeb\x90\x09\x96\x55\x1b\x92\x12\xaa\x40\x9a\x16\xc2\x55\x7c\x59\x22\xee\x87\x65\x9b\x62\x47\x6a\xb5\xc3\x6a\xd7\x09\x3a\x5e\xd4\x76\xcb\x45\xc0\x51\x5d\x21\xcb\x5e\x93\x7a\x49\x02\xaf\x01\x8d\xe8\x2f\xe5\x4d\xf5\x66\xb5\x69\xe3\x98\xe9\x71\xc2\x23\xc5\xa9\xc2\xa8\x9c\x90\x87\x6d\x46\x2a\x4f\x09\xc0\xba\x42\x8d\x9f\x4b\xdf\xc5\x21\xc6\xc4\x73\xd7\x45\x39\x61\x31\x9e\x3e\x31\xa7\x17\x35\x2f\xe3\x6c\x86\x3b\x00\x52\xda\x02\x7f\x76\x56\x04\xe1\x07\xfc\x78\x64\x34\xa7\x22\xa6\x59\x2c\x69\xc7\xf0\x57\x8d\x8f\x49\x44\x23\x67\xbe\x43\x75\x02\xbb\x25\xe9\xba\x64\xe8\xaf\xfe\xac\x85\xd2\x48\x7a\x82\x35\x02\xfd\xd6\x8b\xae\xa9\xb3\xe4\x87\x22\xe8\x41\xaa\x88\xe2\x82\xb6\x65\x96\x03\xd8\xff\x43\xfc\x2d\xbc\xf1\xff\x01\x70\xe4\xda\xb8\xd1\x81\x86\x2d\x71\xf0\x8a\xbf\xfc\x73\xf3\xc2\xf0\x8b\xf3\x3b\x83\xb5\xbf\xc9\x8a\xd9\xd7\x54\xc0\x33\xcb\xb6\xf3\xa8\x96\xea\xc6\x92\x37\x33\xc9\x1e\x4e\xf4\xc7\x76\x60\xbd\xe7\x03\xef\x0a\x3f\xbc\xfe\x98\xa6\x1b\xaf\xf7\x3a\xf3\x96\x11\xc2\x5a\xba\x26\x1d\x1d\x1a\x56\xe0\xca\x3f\x8c\x0f\x69\x88\x93\xef\xc7\x32\xb0\xee\x82\xfc\xb1\x7d\x12\xb4\xe7\x36\x98\x00\x61\xd8\x43\x0d\xe0\xf1\x52\x87\x37\xf4\x72\x13\x88\xdf\x99\xb1\xd3\x44\x03\x9e\xae\x9c\x37\x5a\xf0\xb1\x39\xfd\xe3\x8c\x50\x63\x35\xa7\x35\x50\xbd\x80\x96\xbf\x5d\x37\x58\x35\x8a\x4a\x2e\x24\x9d\x4f\xea\xfc\xec\x8b\x1b\xf3\x53\xb4\xd5\x50\xac\x88\xbf\x39\x6b\xac\x87\x7e\x57\xcb\x29\x8b\x6f\xb6\x09\xbc\x80\xdc\x5a\x53\x14\xa8\x0c\x6a\xe5\x24\xef\xac\xbc\x61\xa3\x76\xce\xf4\xec\x4c\xce\xe8\x5f\x34\xa5\x8b\x89\xa7\x74\xe8\x15\x8a\x5c\xb8\xbe\xd2\x99\xe3\xe5\x4c\xd1\xae\xa0\xba\x89\xb1\x93\xa3\x9e\xe6\x19\x4b\xd8\x71\xd4\x21\x34\xb5\xee\x6f\x77\xa3\x9b\xd2\x0e\xfa\x20\x5a\xea\x41\x83\x08\x2c\x8c\x50\xba\x58\x2b\xe1\xdf\x60\x55\x59\xc7\x6d\x0e\x6c\x9a\x85\x7a\x8e\x73\x98\xd0\x54\x4d\x84\xe7\x45\xa0\x59\xd8\x60\x85\x0d\x40\xa9\x05\x90\x52\xa3\x5f\x87\x0b\x4c\xd3\x51\xaa\x1d\x65\x25\xb7\xd9\x69\x81\x5d\x51\xf4\xf3\xb0\xa9\x8b\x96\x47\xed\x3a\xdf\xbd\xb1\x7d\x3b\xe6\xc1\xf9\x3c\x82\x44\x70\x07\xb2\x24\x1f\x81\x34\x50\x64\x94\x1b\xf7\xca\x49\x6f\xe1\x24\x83\x8a\x82\x99\xcb\x59\xe1\x82\xce\xc4\xe7\xd2\x58\x8b\x73\xc3\x09\xd2\x1c\xea\x14\xd4\x6a\x5d\x4a\x04\xbd\x8f\xda\x28\xa8\x53\xfd\x05\x58\x5d\xaf\x11\x63\xeb\x6f\xe3\xfe\x3d\x11\x06\xc2\xec\x93\x7a\xb7\x8a\xb9\xa9\x6e\x15\x20\xa5\x8e\x66\xc4\x09\xfe\x2e\xdf\x68\xa7\x9a\x2b\xc5\x81\x52\xcc\x09\x25\x74\xad\x36\x0e\xa9\x38\x23\x10\xd9\x66\xd4\xcc\xb5\xff\x8a\xff\x2c\xb6\x71\xc6\xa3\xe2\xb9\xc7\x0b\x1f\x31\xd9\xd1\x11\x76\x1d\xf8\xa3\x04\x1c\x3a\x82\x81\x38\x01\x0c\x1e\xe5\x83\x26\xf5\xf8\xa4\xf2\xb1\x24\x3a\xee\xf9\x58\x9e\x88\x2d\x33\x02\xf6\x07\x68\xea\x23\x1d\x7b\xc6\xd4\x56\x31\xa3\xc1\x2e\x1b\xe5\xc6\xb9\x0b\xe8\x98\x07\xf7\x77\x94\x65\xff\x76\xfc\x95\x7c\x74\xad\x24\x01\x2c\xee\xd6\x7e\xa6\x91\xda\x7b\xdb\x56\x3a\xd4\x08\x21\x5f\xde\x49\xa3\x74\x03\x4a\x7d\x87\xa4\x66\xa9\xc2\x47\x00\xb8\x84\x83\xdb\xb9\xb4\x9b\x29\xaa\xaa\xc5\x7b\xec\x40\x1c\x78\x21\x6c\x29\xb1\x72\xa4\x9b\xbd\xdd\x85\x7e\x7d\xce\x7e\xb5\xec\xc3\x75\xd3\x2b\xfd\x63\x60\xc8\xd9\xfb\xda\xf7\x99\x15\x4d\xa8\x17\xe6\x82\xc0\x63\xa1\x89\xe1\x79\x34\x26\x69\x1a\xc8\x7c\x40\xe3\x12\x27\x90\x7e\x36\x72\xb1\x52\x80\x50\x02\x70\xf9\xfc\x8e\x75\x60\x3d\x67\x60\x78\x3d\x98\x55\x06\x55\x77\x7f\x6c\x41\x66\xff\x60\x2b\xc3\x4c\x8e\x63\x40\xc9\x9d\x5e\x9a\xb9\x2b\x37\x9d\xd3\x35\x1a\x51\xab\x78\x46\xce\x83\xe7\x70\xce\x2a\x55\xf1\x23\xdf\x9d\x55\x5e\x47\x3d\x58\x19\x51\x24\x0d\x24\xdb\xb8\x2d\x32\x29\x1f\x5c\x51\x4f\xb7\x4f\xbd\xc6\x54\x3c\xff\x7c\xb8\xe6\xc1\x60\xed\x78\x1e\x15\xed\xdd\xce\x0a\xff\xf2\x17\x96\xd7\x47\x38\x9b\x3a\xdf\xb4\x60\xef\x48\xc8\x8f\xd3\x67\xc8\x8c\xd0\xa9\x57\x1f\xdd\x31\xc1\xc2\x0e\x08\x4b\x86\x8b\x5a\xe8\x09\x8e\x83\x68\x6f\xb1\x56\x86\x52\xe6\x10\xa5\x50\xb7\xc0\x77\x48\x87\x98\xca\x26\xb6\x73\xe0\xf5\xb5\xa6\x7e\xae\xc8\x9b\x22\x3e\xb0\x96\x72\x3e\x3c\xee\x48\xe1\x38\x23\x07\xbe\xbd\x0b\x4f\x2d\xf0\x23\x48\x12\x66\xfa\xe9\xfa\x27\xc3\x77\x5d\x08\x79\x9f\x42\xed\x8b\x96\xb8\x01\x6e\x98\x34\xae\x98\x25\x4c\x93\x9f\x4e\x7f\xec\x95\x51\xaa\x43\x64\x45\xae\xb1\xe7\x5e\x1e\x78\xf7\x03\x1b\xd1\xa8\xf1\x7e\x90\xb5\xaa\x6b\x20\x12\xc2\xd2\x36\x1d\xfd\x29\x8f\x97\xf7\x2f\xa0\x32\x7e\xac\xaa\x1a\xd8\xef\xad\x87\x83\xd9\x7f\x2f\xbe\x9b\xf4\x4a\xf3\xe6\x8a\xee\x1f\xfb\x81\x7f\x00\x59\x05\x88\x0e\x3d\xe9\x29\xee\x7b\xff\xa5\xf3\x97\xa7\x14\x5d\xe4\x9b\x28\xd3\x60\x70\xab\x19\x2d\x9a\x4f\x75\xd5\x54\x9e\x92\xbc\xa6\xed\x7e\xda\xdd\xb2\x0a\x58\x24\x01\x2b\xa0\xb8\x65\xf6\x0a\x07\xd3\x2b\x67\xa9\xcc\x26\x8d\x38\x59\xcd\xe5\x09\xe2\x9f\xe7\x43\x98\x3b\x55\xcc\xeb\xb6\x92\x4e\x32\xa3\x2a\x8e\xd0\xee\xfe\x7d\xcf\x02\x02\x67\xc7\x52\xe7\xa0\xfc\x91\x60\x15\xdf\x3a\x8c\x96\x42\xe6\xf3\x06\x08\x9a\x9e\x7e\x92\xe7\x37\x9d\xac\x59\xea\x0e\x30\xb2\xd2\x5b\x03\x35\x07\xcc\xb9\x7c\x68\x46\x11\x3e\xd6\x25\xc9\x54\x4a\x3b\xec\xd2\x03\xfb\x45\x57\x06\x67\x8d\x20\x8e\x3e\xed\x10\x7e\x7b\x23\xf3\xd3\x13\xc6\x2c\xe6\xa3\xbc\x87\x30\x1a\x57\xc6\x81\x07\xec\x0e\x7f\x71\xd6\x76\x1f\xcb\xa2\x55\x6a\xa0\x4c\xb8\xb7\x19\x3c\x2e\x07\x53\x50\xbe\x0a\x65\x19\x47\x80\xf6\x0b\x3b\xb6\xb0\xe8\x28\xac\xf1\x92\xcb\x16\x83\x24\x24\x46\xe8\x79\x0a\xf7\xf7\x92\x4b\x61\x17\xc2\x25\xaf\x4b\x9f\xbb\x37\x45\xea\x89\xda\xc5\x69\xb1\xce\x71\x33\x93\xd6\xb5\xa3\x98\xf6\x98\xbd\xe0\x96\xdd\xe7\x34\x1b\xd5\x62\x82\xd2\x32\xfd\x6d\xd3\xe5\x76\x71\x32\x08\x40\x4f\xf3\xb5\x25\x1b\x2a\x60\x6f\xe0\x18\x38\x67\x66\x1a\xff\x4d\x4b\x4b\x86\xd9\xab\x84\x30\xe2\xe4\xcf\x47\x26\x95\x92\x90\xcf\xdd\xf4\xa7\xc8\x2d\x09\x88\x67\x13\xa9\x5e\xae\x3b\x6e\xdc\xf6\x76\x70\x9c\x84\xfc\x36\x2d\x51\x3f\x62\xcf\x49\xfd\x43\x52\x3e\xa3\x36\xc2\x19\x9f\x8a\xb4\x41\x0e\x7e\xb7\x7b\x19\xb3\xd6\xc1\xe0\x1f\x94\x50\x18\x41\xc0\x5f\xe1\x4d\xbf\xfa\x2d\x36\x3e\x66\x66\x3c\x16\x62\xce\x7d\x73\x3c\xab\x5c\x66\x34\x1c\x4a\xea\x8a\x08\xaf\xdc\x98\x96\xa8\xd0\xe3\x77\x7f\xa7\x5a\x0c\x27\xdd\x62\xc3\x28\xb5\x4f\xd9\x90\x2d\xfd\x29\xb8\x4b\xd6\xeb\x8b\x4b\xd9\xfb\x5f\xc4\x9a\xfd\xd6\xfa\xa2\x49\x18\x28\x9b\x5a\xb2\x1b\xef\xef\x40\xa4\x41\x0a\xad\x7f\xb2\xfd\x60\x39\x51\xb5\xcc\xf1\x94\x68\xb7\x07\x11\x3c\xf0\xe2\xa9\x31\xe6\xa2\x1f\xe8\x98\x1e\x3d\x83\xfe\x7a\xb7\x60\x17\xd3\x61\x5d\x34\x6b\xe2\xba\x04\x25\x13\xfc\x8d\xbc\x50\x17\x55\x4b\xbb\x13\xaf\x04\xf3\xc0\x2d\xde\x0b\x17\x2e\x63\x1d\xa9\x6c\xc5\x23\x4c\xe2\x29\xa5\x26\xf0\x10\xa3\x0c\x7d\x91\x5a\x37\x60\x3a\x13\x6c\xeb\x2d\x7d\xf1\x29\x8f\x1b\xb3\x6d\xfb\x11\x1a\xbe\xb9\xce\xca\xb5\xad\x25\x70\x13\x5b\x16\xca\x08\xd7\x83\x7e\xf1\xa3\xf4\xae\xde\xe4\xa3\xe7\x6a\xd5\x6d\xcf\x08\xcd\x63\xe2\x45\xed\xea\x7f\xcf\x26\x4f\xdd\x89\xc8\x42\x8c\x78\x2e\x99\xf5\xe0\x1e\xb3\xe7\x30\x5b\x34\xa6\xd7\xca\x42\x43\x6e\xe2\x60\xa4\x34\xad\x5f\xf3\x73\x11\xcb\xcf\xb7\xdb\x23\x2e\x88\x88\x82\xe3\xe5\xc7\x9a\x88\xfd\x11\x7e\x7b\x4b\xd1\xd9\x15\xa5\xaf\x7b\x91\x93\x53\x8c\xf9\x01\xe7\x69\xaa\x0a\xf1\xd0\x24\xdd\x78\x4e\xbf\xdf\x41\x23\x55\xc6\xbf\x2c\x25\x49\x24\x36\x0d\x6b\xce\x05\xc1\xb0\x4e\xce\xca\x60\x04\x49\x40\x71\x35\xbc\xc6\xbd\x77\xaf\xc1\x14\x56\x8d\xae\x2a\x35\x6b\xdc\x55\x90\x63\x16\x12\x03\x10\xb8\x62\x40\x73\x0f\xf5\xf3\xba\x93\xe2\xa8\x50\xb9\xb2\x89\x49\x72\xfb\x29\x05\x1c\x8e\x3a\x17\xe6\xe1\xcc\x2f\x4c\x21\x1b\x82\x8d\x29\xbc\xd9\xa6\x6a\x9f\x0b\xec\x81\x01\x83\x50\x28\x2f\x31\x17\xdc\x62\xd3\x1c\xf5\xe0\x60\x74\xab\xf7\x1d\xaf\xa2\x07\xaa\x06\x58\xcf\x90\x77\x98\x93\xda\x6d\x83\x5b\x08\xa8\x53\x38\xfb\xe7\x48\x9a\x50\x97\xbf\x5b\xc2\x45\x1a\xea\x73\xbc\xbe\x5e\x49\x58\x00\x32\x93\x89\x5c\x72\xb2\x47\xc7\x66\x24\x15\x99\x4a\xa8\xf0\xc5\x8c\x38\x9d\xa9\x29\x68\xbb\x90\xe1\xfc\x97\xda\x17\xdd\x08\x78\x48\x00\xc2\x83\x0c\x32\x83\xd0\xe9\xd2\xce\x3b\x5b\x69\x20\x07\x5f\x14\x44\x31\x43\x2f\x99\x6d\x9d\xc3\xcd\x83\x98\x85\xd9\xfd\xbd\xa5\xa5\x6b\x98\x0a\x1c\x8b\x94\x48\xde\x2d\x86\x5e\xc6\xfa\xd2\x60\x7d\xcc\x91\x59\xa0\xb4\x85\x9d\xe9\x8f\x3c\x9a\x0a\xc0\xd0\xbf\xf1\xb6\xeb\xa4\x45\x7c\x8a\x61\xea\xff\x8d\xef\x91\x64\xb1\xd9\x22\x29\x0c\x06\x82\x4e\x32\x54\x21\xa9\xf4\x5c\x1c\x49\x79\x0f\x8d\x3a\x29\x14\xae\x92\x64\xe8\xd6\xbc\x7c\x19\x60\xf2\x5d\x4c\xcc\x81\x9c\x21\x0a\x9f\xb8\xe2\x96\x8e\x97\x2f\x1c\xc4\xfa\x08\x22\x51\xb9\xc6\x64\x94\xd8\x87\x1d\xa7\x4c\x80\xac\xec\x82\x89\x6f\xb5\x06\xe3\xd8\x0d\x2a\x96\x72\x35\x08\x71\xf7\x00\x4a\xd3\x86\xc5\xfc\x4a\x83\x07\x64\x1e\xb1\xe4\x2a\x2a\x17\xa4\xf0\xc3\x35\x58\x6b\xa4\xf1\xe1\xca\x42\xda\x3b\x39\x16\x57\x05\x85\x54\x13\xfd\x93\x3b\xdc\x3f\x6e\xaf\xa6\xdd\xf6\x69\xc3\x0a\x42\x26\xfe\x2b\x46\xdb\x2c\x8d\xe5\x56\xfe\x73\x77\x61\xa8\x96\xa2\x9d\x3c\xf6\x96\x75\x00\x30\xfb\x47\xf1\xac\x8e\x8b\xf2\x92\xfa\xf5\xb6\x3a\x5e\xad\x25\x83\x3e\x4f\x4b\xff\xa8\x83\xac\xf9\x47\xb1\xeb\xcb\x5c\x42\xda\x8b\xbe\x4f\x41\xc5\x38\xa4\x94\x40\x68\x1e\x42\xa4\xfe\xab\x37\x2f\x14\x3d\x64\xdc\x5c\xf7\x18\x6f\xa6\x12\x51\x3d\xce\x3d\x99\xa7\x1f\x96\x89\x98\x62\xdc\x63\xa3\xac\xce\x91\xa4\xc8\x50\x19\x98\x3c\x70\xc6\x55\x62\x3f\x6c\x5d\x13\x84\xc1\x91\x9a\xc1\x93\x68\x16\xd9\xf3\x07\xe6\xc5\xec\x66\xbc\x48\x94\x35\x9e\x6e\x58\xc9\xe1\xa9\x4c\x32\x91\x42\x06\x05\x94\x81\xac\x02\xe7\xb0\xd2\x8c\xc5\x26\xc0\xf2\xba\xe0\x24\x6b\xcf\xe1\x03\x63\xae\x05\xc1\x7f\x76\xb4\x3f\x40\xf3\xa6\x6d\xdd\x34\x95\x12\x0e\x23\x46\x9f\x29\x30\x0c\x02\x34\x47\x11\x56\xae\x4f\xfe\xc3\xd9\x6f\xf0\xe3\x7e\x95\x3c\x33\xaa\xa4\x82\x52\xa8\x3c\x24\xb4\x33\x5b\x0f\x58\x1d\x3f\x3b\xcb\xcf\x7b\x13\xf6\x8d\x0b\x27\xbb\x4c\xef\xed\x1f\x30\x87\x10\xad\x3e\x42\x88\x2d\x68\xdc\xe9\x27\x03\x8a\x27\x00\x83\xe0\x9b\xce\xc6\x41\xc7\x2c\x94\x7b\x63\xa7\x9c\xe8\x60\xe9\xe5\x9b\x52\xfd\xe1\xbe\xd3\x15\xcb\x23\xe8\x5f\x47\xf1\x94\xfd\xdc\xce\x27\x56\x6f\xdf\x6a\xdc\x15\x41\xa1\x27\x0e\xe6\xe6\x36\x6f\xe4\x71\x8e\x75\x37\x3a\xb4\xc0\xc0\x57\xb1\x0f\xc1\x57\xf8\x58\xea\x44\xbf\x6a\x8e\x89\x5e\xa8\xb0\x6b\x61\x98\xc6\xcf\x76\x95\x86\x26\xc3\xdf\x03\x53\x9f\x0d\xa5\x01\x2a\xbc\x49\xcb\x7c\x14\x91\x64\x5e\x8e\xf4\x49\x25\x56\x5c\x6e\x89\x4f\x47\xba\x79\x2a\xc2\x74\x2b\x50\xd1\xe7\x2b\xa1\xeb\x11\xc1\xea\x97\x32\xf3\x57\x53\x9c\x39\x10\x7b\xff\x8d\x63\xb9\x78\x2f\x28\x90\x32\x95\x57\x0b\xb5\xdf\xaa\xb3\xdf\x27\xfb\x6e\x82\xbb\xec\x48\x74\x58\x07\x64\x48\x7d\x12\xf2\xe1\xec\x2c\x46\xa8\x5d\x78\xb9\xe4\x15\xfc\xe1\x5a\x61\x5a\x5d\x52\xbe\x7b\xa3\x07\x27\x03\x78\x55\x6c\x77\xd1\x47\x0f\x80\x34\xaa\xd7\x1e\xe6\xe8\x6e\x9e\xe3\x72\x63\x6c\xf3\x77\xd1\x76\x39\x17\x5b\x94\xd3\x4c\xd2\x2c\x5e\x71\xa2\xdf\xf4\x90\x02\x2f\xf8\xcc\xaa\x16\xac\x74\x53\x6e\x12\xf2\x3c\x48\x9e\x13\xe7\x62\x64\xe5\x11\xfc\xe1\x98\xd4\x75\x83\x57\x35\x9b\x77\xa9\x03\x36\xf4\x54\x4b\xf2\x03\x24\x19\xf4\xd3\x1e\xdf\xfe\x91\x4b\x60\xce\xca\x29\xbc\x3f\x2b\x0c\xdd\xc6\x08\x18\xe4\x7e\x2a\x71\x1c\x90\x5c\xf1\xd3\xdc\x57\x10\x7f\x8c\x53\xbc\xd6\xf2\x9c\x44\x3c\x66\x09\xe1\x43\xf2\x19\x65\x18\x14\x41\xc3\x93\x33\x53\x9e\xe2\x10\x51\xd7\x57\x1c\x8c\x2e\xb6\xcd\x42\xb8\xd4\x44\xf0\x87\x3e\xb0\x65\xa0\x08\x9a\x50\x6f\x22\x20\x1a\xb9\x19\xd7\x9e\x3c\xaa\x39\x7d\x64\xac\x14\xd1\x31\xb9\xe3\xef\x68\x11\x01\x82\x8e\x37\x26\x3e\xd5\x71\x4a\x1e\x3b\x7b\xfd\x87\x8e\xe6\x56\x21\xd2\x3e\xfa\xcb\x74\xe3\x06\x50\x8b\x7c\xde\xa8\xe1\x41\x14\xbd\x63\x50\x85\xd4\x55\x4d\xf4\xcf\x84\x85\x26\x16\x93\xd3\x35\x6d\xf2\x83\x7e\xdc\x18\x7e\x91\x8a\xe0\xa0\xd3\xb6\x18\x1e\x65\x8c\x21\xdf\xd1\xab\x3d\x21\x55\xc5\x59\x56\x6d\x3b\xed\x42\x14\x72\x97\xcb\x91\xee\xb7\xd4\x53\xe2\x9c\xb1\x70\x06\x1f\x14\xcc\x01\x22\x69\x38\x41\x2d\xe7\xa6\x93\x55\x35\x58\xb6\xa6\xad\xeb\xbd\x8e\x29\xf0\x08\x42\x4f\x9a\xf0\x26\x7d\x02\x2e\x6f\xa2\xb2\xb3\xdf\x8e\xad\xf8\x93\x75\xcb\x1f\x11\x1f\x7e\xca\x25\xc0\xd1\x39\x28\x16\x74\x38\xf0\xe0\xe5\x53\x76\x24\xc7\xd2\x92\x60\x2e\x99\xe1\xa7\x89\x20\xe1\x07\x73\xef\xf6\xcf\xf9\x2e\x56\x4f\x94\x11\xe9\xc8\x27\xbb\xe7\x10\x8a\xb3\x59\x45\x4c\x36\x48\xcb\x88\x8b\x92\xaf\xd1\x79\x24\x0d\x00\xc7\x94\xa8\x3b\xa0\x19\xcd\xa3\x66\xe1\x13\x09\xfc\xc2\x16\xd1\xad\x73\xfe\x78\xc7\xdf\xfe\x2b\x2e\x46\xb2\x83\xa8\x49\x79\xa9\x46\xf6\xad\x07\xca\xef\x11\xec\xb5\x8d\x35\x8d\x96\xa4\xb8\xb5\x63\x22\x21\xf9\xa7\xb9\x14\x0b\xed\x96\x1f\x06\x88\xc3\x83\x6b\xd0\xfe\x82\x49\x75\x1c\x62\x63\xb9\xf2\x02\x45\x46\x32\x94\x23\x69\xf6\x74\xb0\xba\x48\xc9\x58\xaa\x3f\x64\x3c\x33\x9b\x61\x2a\x1f\x83\xb7\x57\x8a\xe6\xd3\x7e\xd2\xee\x46\xce\x4c\x01\xdb\x3a\x32\x99\x75\x67\xfa\xc8\xab\x3f\x6a\x7e\x7e\x33\x56\x11\xa4\x1e\x8b\xac\x71\x18\xb2\x47\x92\x17\xd9\xc3\xcf\x76\x80\x0c\xe8\x16\xcd\x8f\x99\xdf\xbd\x64\x01\x99\xbc\x8c\x57\xd1\xe3\x59\x46\x72\x45\x04\x71\x2c\xbd\x15\x7b\x72\x34\x84\xec\x4a\x7a\x3e\x4f\x46\xb5\xe2\x1b\x76\xd2\xd4\x29\x66\x42\xe4\x21\x82\x7c\x1e\x36\x8a\xf8\x10\x7b\xb2\xba\xb6\x03\xe3\xf6\xec\xd4\x12\xe6\x8d\xd3\xeb\x26\x74\x53\x4f\x53\x72\x91\x3d\xaa\x79\x03\x24\x73\xb2\x8f\xb5\x81\xd5\x73\xec\x1c\xa5\x1b\x5e\x99\xf0\x41\x51\x49\xa4\x9c\x19\x96\x52\x18\x0e\x1d\x3d\x4c\x04\x55\xcc\x22\xb3\xbe\xca\xd0\x09\x6f\x86\xf3\x6e\xe7\xe2\xcd\x7d\x5a\x83\x6e\xb3\x73\xc7\x07\x29\x14\x32\xf1\x0a\xcd\xb9\x21\x86\x35\x1e\x2e\xeb\xb8\x00\x87\xc3\x25\x65\xa0\x19\xda\x5d\xb1\xf2\x9e\x0f\xa8\x76\x1f\x2a\xbd\x51\xeb\x81\x30\x9f\xfe\xf3\xb7\x99\x51\xd2\x41\xb1\xb2\x69\x61\x37\xcf\x8e\xe7\x64\xdb\x6f\xa2\x19\x67\x90\x96\xa9\xea\xe9\xf6\xa4\xc1\xda\x08\x17\xe1\xf5\x2d\x14\xc0\x67\xc1\x3c\x3d\xde\x9b\x65\x17\x19\xe8\x9b\x6d\xee\xb2\xbf\xe2\x49\xe8\x30\xc4\x45\x02\x76\x75\x91\x51\xa6\x36\xfc\xcc\x3c\x5b\x3e\x10\xa2\xff\x67\xa5\x61\xc1\xdc\xc7\x70\x61\x62\xfc\x37\x45\xd9\x02\x4b\xe6\x3a\x45\x35\xf3\x0f\xa5\xdb\xce\x75\x1d\xd1\xcd\x5d\x7f\xf6\x66\xbf\x87\xf1\x84\x22\x1c\xf7\x1e\xde\x74\x30\xf6\x3e\x0e\x91\xe6\x65\x35\x54\xf0\x20\x3d\x1a\x43\x4e\xcf\x19\xea\x7e\x64\x14\xcb\x4c\x6f\x86\xb6\xaf\xaa\x0a\xcb\x63\x11\xe8\x0c\xbd\xc3\xae\x00\x08\x76\x5f\x95\x32\x32\x37\x4c\x42\x98\xb0\xcb\x03\x9e\x59\x8e\xc7\xee\x72\xba\x21\x48\x20\x91\x90\x77\x52\xb3\x7e\x29\xc4\xb9\xd8\x5e\xef\xce\x1c\x0f\x9f\xd3\x6e\xb6\xc2\xe0\x5d\x93\x9a\x8d\x73\x36\x66\xea\x0f\x83\x34\x2a\x68\x9d\x76\xb2\xdc\x91\xbe\x9c\x7d\x6d\xe9\xc1\xa9\x0d\x67\x3f\x60\xf2\x88\x98\x1b\xb7\xb8\x45\x48\x13\x7b\x43\x31\xd1\xc8\x9e\xb1\xcf\x37\xee\x9c\xb9\x26\x23\x34\x51\x29\xd5\x54\x20\x16\x67\x4f\x37\x38\x94\xde\x26\xe4\x5a\xaf\x3f\xc3\xdd\x9a\xa6\x84\x0e\xe5\x3c\xb9\xd5\x92\x71\x69\x15\xd0\x1c\x2d\x33\x73\x93\xd7\xc2\x3d\x06\x0d\xc9\x93\x3a\x9b\xc9\x3b\xab\xa5\xc3\xf7\x62\xd9\x86\x6f\x1b\x82\xbb\x49\xd3\x1b\x4e\x2d\x25\x23\xef\x29\xc7\xed\x4c\x53\xe3\x53\x3c\x84\x1a\x09\xd4\x6d\xc1\x44\x51\xd4\x96\xdc\x97\xfa\x43\x22\x59\xac\x69\x05\x62\x67\xc2\x2f\xc3\x94\xf9\x0b\xe5\x71\xfc\x37\x23\x80\xf2\xdf\x8f\xc0\x9d\x00\xde\xef\x45\x7d\x67\x04\x2b\xc7\xac\x5a\xc9\x1f\xb7\x4d\x94\x81\x51\x48\x32\xdf\xd0\x90\x5b\xcc\x52\x97\xb8\x56\x9b\x6d\x25\xb6\x3c\x42\xed\x78\x79\x9e\x88\x54\xb3\xd8\x2f\x06\xf4\x8a\xea\x8d\x5e\xff\x61\x28\x35\x3c\x90\x53\xb9\x26\xfa\x36\x7f\x6d\xf8\x0e\x9f\x62\x64\x4c\xdc\xca\x80\x57\xdc\x26\xfd\x01\xc9\x23\x0e\x40\x99\xc2\x31\x52\x1d\xf9\x3a\x72\xd2\x4c\x4c\xd2\x7e\x42\x18\x2d\x1a\xa7\x74\x66\xe9\x53\xdc\x17\x2e\xe7\x4e\x2a\x5c\x46\xf4\x8f\x5f\x4b\xa5\x71\xaa\xd0\xb1\xb1\x1f\xc5\xa7\x30\x22\x56\x77\x54\x9c\x12\x30\xe5\x9e\x30\xaa\xdc\x43\x92\x27\x11\x8e\xc2\x76\x96\xf0\x1f\xa5\xaa\x54\x46\x6e\x1c\x73\xbc\xd2\x58\x7f\xcd\x54\x51\xc3\x20\x28\xfe\xd9\x90\x87\x43\x41\x0d\xbf\xd3\x31\xb1\x2b\x6e\xda\x52\x84\x48\x3c\xc1\xab\xfb\xbb\x79\xdb\xb1\xf2\x4e\x07\x55\x5e\xbe\x48\xc1\x73\x7a\x89\xdf\xd5\x88\xbd\xcb\x80\x7a\xdd\x44\x69\x0d\x9b\xc1\xda\xf5\x0c\xde\x1e\x7c\x2b\xa5\xab\xba\x87\x4b\x95\xbc\x13\x28\xec\x54\xb3\x6b\xc8\x23\x40\x96\x47\x69\x15\x46\x4c\xe2\x7d\xc3\x17\x1d\x06\x21\x5b\x0d\xa7\xc9\x18\xc5\xa9\x37\xc7\x40\xff\xe4\x13\x76\x37\x38\x7d\xf7\xfa\x06\x5b\xd4\x65\xd4\xcb\x4c\xba\xa4\x47\x34\x88\x05\x95\x15\x70\xe5\x70\xa0\x08\x8d\x16\xc6\xcd\xab\xa6\xda\x3a\x1c\x68\x47\x6f\xee\xd9\xc2\xbb\x0b\x51\xb8\x6b\x63\x3a\xf4\x09\xee\x3f\xab\x7a\x07\x07\x87\x02\xf2\x92\xb7\xd1\x1f\xbd\xa1\x0e\xfd\x7f\x6e\xee\x3b\x17\x4e\x92\x62\x3d\xae\xc6\xd0\xf1\x80\x53\x27\xd6\x91\x66\xd3\x07\xd9\x22\xce\x2f\xb8\xed\x34\x59\x9e\x5b\xd8\xf9\xb6\xdd\x2b\x4f\x34\xaf\x1c\x0c\x43\xc6\x67\x66\x87\x99\x3a\xd4\x64\xb1\x36\xac\x80\xce\x43\x38\xf8\x8e\x6a\x36\x52\xb8\x31\xd5\xef\x3d\x3b\x47\xe4\xfb\x84\x09\x4c\x5f\x95\x23\x92\x16\x4b\x0d\x6f\x8f\xcb\xcc\xa7\xd3\xcb\x68\xfa\xa9\x0e\xe2\x53\x46\xef\xc0\xce\x50\x11\x0d\x4e\x84\x89\xcc\xc8\x0c\x91\x20\x10\x58\x46\x04\xf3\x05\x14\x77\x36\xaa\xaf\xa3\x48\x8c\x62\xd9\xba\x0f\x21\x89\x9f\x45\x94\x04\x4b\xcb\x77\xcf\x95\xf3\x40\xec\xf6\xc1\x02\x9c\xba\x09\x71\x44\x8b\x2f\xe2\xf7\x25\xba\x96\x37\x21\x34\x4f\x4b\x50\x1f\xa8\xe5\xbf\xb5\xb9\x28\x80\xbd\x02\x8d\xb1\x01\xb2\x57\x6c\xd9\x91\xdd\x56\x77\xcd\xcd\x59\x86\x67\x15\x4a\x80\xc9\x00\x78\x67\xa3\x89\x79\x2a\xdd\xab\xa8\xdb\x50\x7b\xee\xe0\x64\x97\xa3\xd7\x2a\x9c\x50\xa5\x66\xe6\xf4\xe4\x38\x34\x9d\x9d\xf0\x1f\x8d\x55\x00\xc9\x24\xb3\x60\x2e\x2f\x76\x33\xe6\x63\x9f\x9f\x5a\x54\x1b\x16\xa7\x62\x01\xc8\x5f\x01\x2f\xe4\x7f\xf7\xc7\x1b\x02\xc3\xe2\x85\x0c\x44\x47\x64\x64\x21\x04\x55\x84\xc0\x03\x2d\xe4\xc3\x53\x7b\xed\x2b\x6b\xc7\x6d\x3a\x94\x3c\x42\x7e\x88\x93\xc2\x5e\x9d\x9e\xb5\x7f\xa7\x6e\x98\x67\x99\x4d\x12\xc5\xae\x3a\x68\x69\x50\xf2\x5e\xf5\x60\x62\x30\x87\x07\x84\x28\x0c\x03\x34\x34\x96\xa2\xb8\xd5\xe4\x8c\xfa\x96\xc1\xf0\xa8\x4e\xd3\x06\x4c\x45\xf4\x43\xb5\x73\x4a\x80\x3e\x31\x14\x6e\xfa\x81\xc0\x94\x07\x2a\x86\x42\x0b\x38\x86\x8f\x63\xf5\x12\x6f\xc9\x5a\xe4\x40\x58\xf2\xf8\xb8\x6d\x2e\x7f\x0c\x8f\x4c\x40\x42\xd1\x05\x6d\x3f\x54\x50\xc9\xb3\x10\xda\xc3\x8c\xa3\x38\xe1\x1c\xe4\x36\x58\xa0\xde\xd5\xc9\xe0\xb9\xd1\x99\xc8\xcb\xca\x9d\x11\xd5\x34\x4c\xe4\x01\x01\x85\x5c\xc5\x32\xed\x79\xb5\x6e\x75\xa2\x3c\x44\xb2\xcb\x56\xfe\xb4\x38\x30\xe3\xc0\x9f\x14\x46\x83\x42\x4c\x32\x3e\x6c\xe7\x86\xc1\x35\xa4\x64\x87\x30\xa5\xfb\xc6\x29\xd5\x94\xa6\xa2\xd4\x97\x77\x5d\xf9\x29\x37\x77\x7c\x8f\xb8\xbf\x19\x4f\x51\x5d\x4c\xcc\xef\x1b\xc3\xac\x96\xce\x08\x5b\x88\xc7\xa0\x2c\x8b\x5a\x84\x7b\xe9\x17\xa3\x05\xa2\x0b\x06\x8a\x73\xfc\x46\x9f\xa0\x52\xcf\xd9\x08\x2f\xc8\xf8\x95\x16\x02\x9a\x19\xb6\x30\x4a\xf0\xd2\xb7\x62\xe9\x3a\x46\x49\xd5\x99\xec\x85\x70\x0a\xda\x80\xf6\xdc\x33\x4c\xbb\x20\x62\xe8\xcb\x0d\xa3\xa1\x29\x41\x86\x80\x14\xf1\xca\xb7\x12\x1b\x1d\x7b\x54\x15\xe7\x85\x82\x4c\xb3\x22\x66\xe3\x5f\xa4\x8f\x88\x73\x36\xb5\x28\x66\x15\x41\x52\x90\x6d\x56\x2c\x1d\x23\x0b\x75\x9b\xe1\x15\xa5\x2e\x75\xb2\x21\x13\xa2\x94\x11\xba\xab\x51\xb3\x26\xbd\x77\x69\xa3\x4e\x23\x21\x0f\xfb\xb2\x8a\x7d\x06\xc3\x42\x55\x77\xa4\xb9\xb6\x80\x2a\x29\x84\xb2\x8f\x47\x47\x42\x59\x83\xcf\xd8\x5d\x3c\x32\x78\xa2\x2f\xb6\xfa\x88\x20\x17\x8d\x3a\x4a\x82\x58\x64\xbb\x8c\xa4\x2f\x55\x75\x6e\x04\x8f\xc5\xfe\x5b\x6c\xb9\x2b\x9f\x1d\x37\x47\x63\x32\xe9\x29\xeb\x00\x9f\x36\x67\x66\xfc\x78\x7c\x37\x10\xca\x52\xee\x7f\x74\xeb\x85\xcf\x8e\xb6\x23\xd2\x5c\x43\x0d\x2a\x2e\xd3\x9d\xd3\x54\x19\xbc\xf3\x96\x7c\xb9\xa1\x9b\x4e\x84\x1b\x12\xc9\xd2\xa7\x7b\xfe\x3c\x22\x4d\xfe\x46\xdb\x06\x90\x5b\x11\x71\xbf\xa2\xa0\x8d\x5f\xdc\xcd\x53\x22\x0b\x78\x1e\xf7\xa9\x5d\x16\x07\x40\xda\x5f\xd3\x78\xe9\xfa\xd6\xed\xd3\xfc\x71\xde\x5e\xea\x4c\xb7\xc7\xd4\x46\x4f\x9e\x00\x3e\xb9\x93\x96\xe0\x05\x7c\x8e\xf1\x7c\xf9\x15\x94\xb7\x9a\x0a\x83\x23\xa3\xe5\x24\xb3\x30\x5d\xa5\x7b\xae\x19\x51\xdd\xb3\xf3\x77\xcd\xda\xed\x77\x9a\xbd\x6c\xef\x10\xfb\x47\xfe\x2b\x52\x30\x51\xea\x98\xe6\xa8\xb4\xa2\xc7\x69\x72\x62\x2e\x98\xd9\x48\xb7\xc2\x29\x2c\x30\xd7\x51\x15\x28\x30\xda\x5c\x7b\x14\x0b\x24\x44\xf9\xf6\x5c\xa9\x46\xbe\xdd\xf4\x67\x80\xf8\x70\x26\x47\xac\x37\xf1\x5e\x28\x29\x11\xef\xc9\x3e\x0c\x1b\x11\xa4\xfa\xfe\x40\x11\x0a\x3e\xaf\xcc\xc7\x38\x44\xfb\x4d\x01\xa3\x8f\x53\x56\x33\xc8\x4a\xcf\x45\x5c\x3c\x25\x12\xe2\x57\xd4\x38\x3b\xa0\x63\x2e\xa6\xac\xa6\x9d\xfd\x65\xff\x07\x7d\x2c\x1f\x0c\x5d\x47\xdc\xf6\xfc\xf4\x49\x2c\x67\x3a\x9f\x67\x8a\x0a\xd8\xe4\x6b\x1a\xae\x47\xdb\xd4\x20\x4c\x80\x30\x30\x92\xc0\x58\x4d\x44\x49\x2c\x4d\x45\xa1\xae\xbb\xd8\xce\xc3\xb2\xc3\x06\xe9\x65\xf7\x09\x1e\x48\x64\x3b\xd2\x3a\xab\x0e\xec\x77\x2e\x6d\x5b\x9f\xed\xfd\x77\x34\x86\xb3\xfc\x58\x1a\xd5\x1e\xc3\x95\xb4\x20\x33\x92\x2d\xf0\xda\x50\x60\x1b\xff\x09\x3a\x70\x4d\x57\xb5\x84\x7a\x69\xaa\x41\xa0\x6c\xc1\x48\x75\x7c\x8b\xe8\xe3\x27\x72\x6c\x63\x5c\xf6\x4b\xbb\xa4\x3f\x10\x34\x31\x9e\x46\xc5\x21\xfb\xc5\xc5\xa4\xd4\x4f\x2c\x6b\x29\xe0\x5d\x5f\x48\xe1\xa5\x69\xd6\xe9\x7d\x5c\x26\x10\xe1\xac\x41\x6c\x11\x2e\x7e\x80\xe4\x88\x84\x5e\x6e\xce\x13\xdd\xe4\x0d\x4e\x7e\xbf\x2c\xaa\x18\xe6\xb2\xd2\x6b\x1a\xa4\x07\x58\x95\x38\xe0\xeb\x84\x1b\x15\x13\x2f\xb0\x3a\xcb\xac\xbd\x6f\x7c\x43\xa2\xd6\x37\x0c\x4b\x4e\x90\xf0\x28\xf4\x2d\x4f\xc4\x12\x1d\xc2\x50\x8a\x4c\x5e\x2f\xc3\x27\xad\x90\xee\x3f\xf8\xb9\x62\x9f\x08\xa9\x1d\xc3\xd5\x28\x5e\xc5\x11\xdc\xca\xc7\x7c\x61\x80\x67\x3c\x36\x6b\xc4\x40\xd8\x75\x4a\x55\xb2\xe5\x60\x3c\x28\xdc\x5b\x8f\x23\xf8\xbc\x22\x79\x46\xb1\x18\x5a\x87\xb1\x9c\x7e\x08\x14\xb2\xb3\x24\xc0\x2f\xe1\x78\xca\x9a\xe0\xcd\x26\xc6\x6d\x4b\x33\xe6\x14\xd3\x61\xf3\x22\xef\xd8\x6d\x4e\x66\xe6\xa2\x04\x71\xaf\x06\xbc\xf2\x2f\xfb\x12\x91\x55\xda\x81\x6e\xd1\xb3\x0b\x07\x73\xf9\x7d\x81\x0d\xec\x92\xdf\xe6\xd8\x06\x3d\xd8\xa0\xe7\x48\xbf\x16\x13\x73\x1a\x4c\xbb\x7c\xf4\x67\x62\x7a\xe5\xec\x5a\x14\xe2\x67\x4b\x3f\x40\x74\x2c\x17\xb3\x09\xad\x04\xe3\x8c\xbf\x6f\x56\xbf\x7c\x71\x3e\x7f\xa5\x22\xfd\x47\xda\xec\xdb\x66\xb9\x20\x95\x8b\xf5\x6f\x22\x35\xd0\x22\x4c\x3d\xc9\xb3\x47\x88\xb5\xf7\x26\x85\xa4\x9a\xfb\xd4\x0b\xe7\xf8\xfc\x2c\x5c\x6c\xaa\x28\xf7\xa4\x38\xd2\x4a\x26\x19\x2b\x99\x72\x98\x9b\xba\x05\x2c\x9c\x2a\xe2\x3b\x1e\xf9\xe7\xa8\x21\x5d\x30\x5f\x69\xdd\xac\x93\x0e\x7a\x5e\x3e\xa4\xda\x20\x92\x66\x20\x13\xcc\xe3\x55\x83\xd9\x01\x91\xb3\x59\xa7\x37\x9e\x70\x98\x06\x9e\xb0\x05\x5c\x19\xb8\x8e\x9a\x0c\x05\xe6\x6b\x77\x16\xe8\xca\x15\xab\xad\xe8\xec\x56\x84\x49\xcd\xa2\x22\x82\x1b\xef\x07\xa4\x9f\xa7\x75\x5f\x53\x54\xfa\xa0\x5d\x6e\xa5\xa4\x49\x51\xcf\xc2\x06\xdf\x37\x3f\xff\xdd\x52\x8a\xfe\xcc\x22\xb1\xe5\x39\x58\x3d\x78\x06\x45\xa6\x23\x07\x4d\xb6\x8b\x25\x4a\xe4\x7b\x7f\x9d\x1e\x0e\x8e\xa9\x74\x39\xfb\xad\xf5\x92\xfd\x64\x0d\x22\x2d\xc0\x41\x73\x97\x67\x7e\x09\x71\xd6\xdf\x0e\x56\xcc\x45\xbd\xe2\x63\x16\x7a\xb8\x14\x56\x21\xa2\x98\x90\xe5\xce\x56\x8e\x4c\xe3\x43\x11\x2a\x34\xa6\x2f\x2f\x96\xd1\x39\xaf\xee\xe8\x63\x3c\xb0\xeb\x4b\xf1\xdd\x36\xf8\xcf\x69\x92\xcf\x2c\xb9\x13\xae\xc6\x3c\x95\x21\xa2\x69\x4c\xd9\x3e\xb7\x5b\xa8\x55\x59\x2f\x64\xb5\x53\x43\x6e\x49\x2d\x3c\x7a\xf4\xc4\x68\xe7\xe5\xb6\x30\xf7\x63\x81\xfc\x9c\x2a\x1e\xf2\xfd\x0e\xb8\xc8\xad\x97\x87\xab\xbb\x4e\xed\x13\x53\x6b\xb2\xee\x8a\xe9\x32\xf3\x45\x49\xfb\x1a\xcd\xc4\x9b\xd9\x1f\xc2\x25\x35\x3f\x57\x38\xce\x8b\x18\xab\x78\x82\xef\xf6\xc7\xa4\xf2\xf2\xa6\xce\xfd\xaa\x11\x07\xa9\x0b\x7d\x74\xf8\x62\xe9\xec\xf3\xfd\x5c\x60\xfa\xaa\x51\x56\xe9\x41\x0c\x48\x4e\xe1\xe2\xf0\x2b\xf1\x96\x9c\x3e\x0b\x74\x71\x9f\x97\x8c\x20\x15\x3d\x8d\x1c\x4f\xbf\x97\x86\xff\x67\x16\x6b\x2d\x70\x2e\xdd\x1f\x0a\x44\x29\x7d\xe2\x47\xd3\x1c\x74\xb4\x4b\xa0\xa3\x4a\x8d\x23\x9f\x76\x00\xf8\xf4\x7e\x3f\xfb\x7c\x22\x73\xa3\x0e\x68\xa6\x53\xfe\x01\x96\x28\xc2\x3a\xcf\xf4\xe6\x35\xb8\x90\x92\xd1\x91\x84\x95\xfa\x19\x30\x81\x23\xf3\xd9\x58\x6b\x51\x02\xf6\x94\xd7\x02\x33\x0a\x50\x42\x64\xd3\x72\xa6\x4b\x46\x93\x1e\x61\xb6\xe5\xb8\xdf\x90\x23\x9a\xf7\xb6\xc0\xb5\xd9\xdd\xa3\xd3\xf9\xd2\xc8\xd8\xcc\xcc\x76\xf2\x7b\x0c\x79\x4a\x52\x25\x8b\x62\x41\xa2\xe4\x73\xa2\x30\xd0\x3d\xf1\x89\x73\x0d\x29\xe6\x16\x29\xd4\x5d\x43\xf0\xbe\x0c\x81\x0d\x0b\xa4\xbc\xe5\xa2\x19\xf7\x4d\x52\xbc\x93\xde\x50\xbd\xbc\xbf\xcb\x46\xf5\xe8\xd9\x0f\xed\x2c\x3b\xd4\x87\x64\xc2\xc1\x5a\x4d\xb1\x9f\x81\x84\xb0\xc6\xd4\x45\xf3\xba\x17\xb8\x9b\xfd\x61\x5b\x08\x2c\xd3\xbe\xaa\xd1\x1e\x38\x66\x49\x50\x47\x6d\x13\xe1\x93\x69\xed\xd3\xf2\x8a\x5c\x4b\x2d\x97\x10\x2c\xdb\x8d\xbc\x41\x12\x74\xab\x78\xdf\xa0\xfd\xb4\x1a\x77\xee\x1e\x03\x41\x52\x42\x8a\x37\x8c\x5c\x65\x0a\x74\xdc\xc9\x06\x40\x3d\xea\x04\x4e\x7e\xcf\x07\x2f\xd6\xa8\x56\xf8\xf0\xda\xed\xb2\xed\xab\xcd\x21\x30\x77\xca\xa0\xb3\xf4\xba\x6e\x56\x23\xb7\x6d\x1e\xcb\x9f\xf8\x04\xdc\x2e\xae\xd7\xd6\xcf\x5f\x34\xc3\x04\xac\xaf\x4e\x3f\xf5\x1d\xa1\xe6\x44\x75\x19\xd9\x0c\x91\x26\xe8\xc5\xe9\x90\x30\x3e\x79\x29\xf7\x96\xc2\x28\x87\xfa\x63\x8f\xc1\x35\xeb\xc2\xb9\x09\x20\x64\xcb\xdf\x61\xce\x5d\x81\xe5\x3e\xdb\xdc\x75\x8c\x6f\xe0\x79\x78\xaa\x81\x91\xec\x5d\x0e\x6d\x6b\xcf\xb8\x83\xb9\xdb\x84\x9b\x26\x04\xd1\x09\x73\xc8\x06\x1a\x40\xc5\x78\xc4\x11\xaf\x08\x91\xfb\xcb\xb2\x90\x8c\xef\x30\x52\x44\x22\x8f\xf7\x5b\x63\x8c\x16\xe8\xad\x8b\xb7\x49\x98\xa4\xe6\x6e\x5d\x38\xa0\x72\x7d\x4f\x11\x33\x16\x0f\x40\x91\x4e\x8a\xd7\x75\xc6\x1a\xa1\xad\x3a\x30\x2d\xa7\x45\x8d\x23\xcf\xe2\x30\x83\xb4\x88\xd5\x5e\xc5\xb8\x5d\x3a\x25\x1c\x68\x99\x2f\x4e\x7d\x50\x01\xe3\x4a\xed\x96\x71\x7a\xaa\x9b\x0d\x83\xda\x72\x5b\xe5\xcb\x91\xad\x99\x2c\x6d\x3e\x87\x8e\x57\x27\x44\xfe\xb5\xb2\xdf\x0e\x06\x7c\x6b\x15\x7a\x4a\x5b\x5b\xbf\xcc\x00\x31\xbe\x5d\x13\x0c\x66\x4b\x4c\x95\x75\xff\x9f\xa5\xf6\xc5\x93\x5f\xde\xc1\xd6\x12\xd9\x26\xe4\xaf\xff\x90\x82\xcc\xd9\x7e\x46\x60\x44\x87\x17\x58\x58\xa5\xe4\x49\xea\x00\x69\xaf\x90\xc7\xfb\xaa\xd3\x4a\xc1\x35\xd7\xab\x80\x09\x1f\xfa\x1f\x41\x1a\xa6\x9c\xff\x4a\xc5\x0c\xa9\x64\x58\x3a\x4f\xec\x8f\xa3\xca\x56\xfa\xa7\xb8\x82\xab\x9e\xd4\xba\xb8\x5d\x5d\xb7\xa8\x2d\x54\xa5\xcf\x88\x44\x7a\x5b\x37\xa1\xd9\x1a\x76\x59\x41\x10\x9e\x1e\x84\x8e\x0b\xfe\xff\xe0\xee\xcb\x0a\xf9\x11\x65\xed\x93\xf4\x17\xd2\x57\xd7\xeb\xd6\x2c\xc3\xbd\xb0\xed\x48\x7a\x34\x23\xef\xfa\xf5\xad\xbf\x54\x23\xdd\x9d\x64\x13\x34\xf2\x4d\x7d\x3c\x4d\xb5\x4e\x39\xba\x16\xf2\xdd\x24\x7d\xc9\xc5\x20\xd1\x0e\x3b\xff\xf0\x0d\x7c\x9a\x1c\x5a\x82\xb0\x94\x76\xae\xb2\x9a\xc1\x26\x52\xb3\x36\xad\xf7\x1c\xb1\x5a\xca\x7d\x0a\xe1\xca\x4c\xd9\xba\x2c\xb1\xe7\x1c\xac\x93\x56\xd2\x2e\x14\x6a\x7a\x01\x3a\xec\x24\x7a\x58\x62\x4d\x70\xfc\xaa\x65\x39\xac\xfa\xcd\xb9\xdd\x87\x05\xd5\xec\x07\x7b\x64\xbb\x33\xd4\x1c\xa3\x08\xc7\x93\xf2\x77\xcc\x05\xcf\xaa\x53\x76\x5d\x24\xa7\x7d\x0c\x09\xd8\x3b\x73\x4a\xfc\x7b\x18\xe8\x9a\xaa\x89\xfc\x25\xc5\x7d\x5d\x18\x3b\xb4\xa8\xd0\xa7\x1e\x73\x4e\x08\x63\x78\x81\xe3\x3a\x46\x3c\x93\xd4\xa9\x91\x83\x5d\x2c\x04\x0e\x26\xaf\x8b\x13\xaf\xf5\xe0\xe6\x82\xc7\x72\xc6\xa2\x8a\xfa\x2f\xbd\x2d\xdf\x9b\x4b\xa1\xf3\xe9\xaf\x6b\x54\x6e\x02\xae\x25\xd8\xee\xd0\x6a\x95\xa2\x3a\x62\x11\xb2\x7a\xb2\x13\xca\x23\x76\x84\xb7\x99\xcf\xe4\x0e\xf5\xc2\x17\xac\xd5\xb1\x4a\x21\x83\xcf\x23\x5b\x4c\x65\xc0\x2e\x0c\xde\x88\x97\x94\x9e\xc4\x33\x83\x2d\x8c\x60\x86\xb2\x2b\x65\x4d\x94\x22\x53\xa8\x00\x40\xa4\x88\xc7\xac\xc1\xf9\xdf\xa1\x58\x84\x41\x12\xe2\x3a\x0f\xad\xb9\x71\x2c\x62\xab\xfa\xb5\x4b\xe7\x43\x20\x79\xd2\x1f\xcc\x07\x5d\x28\xb7\x89\xd0\x7b\x42\x70\xbe\xfe\x58\x2e\xa2\xa3\xea\x3d\xd2\x68\x29\xec\x42\x6c\xc3\x9e\x7c\x59\xba\x58\x7b\xaa\xac\xe4\xe7\x84\x5f\xc1\x9e\xf3\xfb\x68\x9c\x63\xc5\xa1\xad\x27\x68\x63\x81\xae\xb4\xd2\x54\x48\xf5\xf7\x39\x12\xfa\x16\x51\xff\xe7\x7d\xa6\x4f\xe1\xa4\x93\x82\x79\x02\xa6\x7d\xba\x73\xf3\xe1\xa7\x6b\x51\x60\x86\x39\xe7\xe8\xb8\x1b\x84\xdb\x37\xca\x4b\xcb\x5d\x00\x8b\x8d\x51\x72\x32\x00\xe2\xe8\x2a\xbc\x2e\xd5\x40\x05\xa1\x47\xb0\xd8\xc3\x0c\x69\x2d\x52\x47\xbe\xd7\x9d\x36\x6e\x59\xa9\xfd\x0b\xd8\x74\xa4\x9a\x51\x80\xca\x7d\x54\x95\x48\xc9\x24\x35\x13\x55\x4d\x2d\xe6\x43\x02\xe6\x0d\x43\x4a\x16\x30\x9e\xb0\x5f\x76\x26\x5c\x51\xb4\x22\x0d\x4e\x42\x72\x95\xbd\x50\xa1\x42\x54\xa9\x08\x09\xe9\x32\x05\xec\x8b\x3b\xa8\xcf\x13\x69\xce\x3f\x88\xee\x84\xbe\x9f\x21\x4c\x23\xa5\x30\xbe\xa9\x2a\xeb\x42\x01\x4c\x5a\xaa\xe7\xa0\x46\x0f\x87\xf6\x8e\x41\xfd\x25\xd0\x8e\x16\xf7\x09\xef\xd2\xd3\x75\x9a\x00\x87\x52\x2f\x76\xea\x75\x8c\x28\x4e\x0a\x83\xf1\x0b\x36\x27\x42\x4a\x41\x66\x23\x03\x67\x4f\x81\x5e\xc5\x00\xac\xa6\x54\x83\x50\x75\xc1\x10\xa8\x0c\x43\x72\xca\x15\x9c\x50\xa1\xe2\xbd\x09\x8a\xb9\x23\xc9\x24\xb5\x06\xac\xcb\xc3\xe0\xf9\xba\xbf\xe5\x0f\x04\x33\xe4\x0a\xf7\x50\x27\x3c\x9d\xab\x52\x37\x73\x66\x97\xd6\xde\xd8\x35\xa1\xfd\x91\xd6\xa9\x0c\xe4\x95\xda\x23\x7f\x9e\xfe\x1e\xd1\x15\x2d\x77\xcb\xaa\x47\x2c\x63\x83\x8a\xbc\x88\xe8\xd5\x07\x2c\xad\xa1\xa9\xc6\x64\x67\x75\xf5\x8a\x48\x16\x30\xec\x03\xaf\xd3\x74\x61\x24\x80\x55\x20\xdb\x1a\xca\xe1\x61\xa2\x79\xa8\x22\x56\xfa\x10\xd4\x6d\x7f\xa9\x02\xa1\xc7\x5f\x4e\xbc\xf4\x56\xee\xb0\xd3\x51\xf4\xf3\xbb\x39\x81\x53\x8b\xe1\x3e\x59\x16\x82\xaa\x4b\x8e\x02\xc6\xbe\xc8\x9e\xda\x93\xf4\x5b\xf5\x47\xe4\x8b\x7e\xc2\x38\xce\x44\x7c\x4a\xcf\xae\x02\x88\x1b\x5c\x95\x0a\xe0\x23\x94\xf8\x37\xa4\x09\x22\x31\x50\x25\xec\xa9\x8a\xa8\x1f\x22\x0d\xdd\xe3\x8f\xb0\xb1\x1e\x75\x27\xee\x76\xea\xc5\x06\x6c\x1c\x15\x83\x1a\x8e\x9f\xbf\xd1\xa0\xc8\x69\x55\x62\x72\xc9\x7a\xb1\x05\x74\x96\x1e\xe2\xa8\xd8\x62\x61\xdd\xc0\x48\x76\x9f\x41\xc1\xdf\x1a\x49\xbd\x50\xcc\x0a\x90\x9a\x24\x07\xf2\x52\x67\x9d\x08\xec\x48\xc5\xba\xb9\x67\x10\x99\x2a\x26\xb9\x29\x2a\x96\xc7\xd6\xc9\x96\xc0\x27\xc1\x1b\xdc\x9b\xdc\xf2\xc7\xeb\x23\x57\x20\x34\x64\xf4\xb8\xdd\x9a\x5b\x61\x5b\x7e\xec\x7c\x09\x38\x84\xfe\xee\xea\x2e\xfb\x5c\xaa\x9b\x4f\x1a\xc9\x57\x8b\x18\xaf\x5b\xf9\xad\x64\x7a\xc7\x14\xc5\xb2\xe0\x9e\x8d\x48\xe6\xcc\x74\x70\x9e\xca\xf2\xa3\x31\x7c\x78\x33\x54\x66\xe1\x4c\x8e\x7f\xa3\x15\x86\x87\x19\x0d\x1a\xff\xba\x1b\xf4\xa9\x41\x24\x45\xdf\x38\xda\x86\xce\xce\x82\xf1\x21\xb4\xfc\x39\x2a\x66\x84\x21\xfc\xf3\xf4\xd5\x66\xfa\x93\x43\xfd\x9d\x76\x8e\x0e\xb1\x29\x59\x88\x67\xa5\xb3\x30\xd6\x62\x57\xe5\x65\x39\xba\x21\x22\x27\xd2\xc6\x41\x5e\x2a\x15\x15\x97\x1c\xd8\xac\x9b\xa9\x99\x28\x4a\xe4\xd6\x22\xc3\x0e\xe4\x0d\x29\xe5\x67\x17\x79\x15\x2e\x53\x8a\x45\x05\x68\x4d\x92\x21\x2f\xe6\x22\xdd\xb9\xa0\x0d\xba\xbd\x9b\xd9\x75\xd5\x3b\x1f\xcc\x04\x54\x6e\x35\x30\x85\xc1\xbe\x8e\x84\x6f\xbc\xbf\xe5\xca\xde\x41\x4a\xcf\x52\x2e\xf1\xd9\x30\x8e\xa1\xc8\x79\xa6\x04\x75\x72\x6b\x91\x45\xbc\x48\x8f\x9a\x2e\x48\x0c\x0d\x97\x6d\xba\x42\x0a\x28\xf1\x09\xf1\x64\x72\x6a\x10\x14\xbd\xc1\xac\x46\xce\x49\x69\x07\xdb\xac\x76\x37\xca\xac\x08\x0e\x29\xa9\x06\x11\xf2\xb6\xf6\x23\xab\x22\x17\x40\x35\x25\x16\xe8\x40\xd8\x86\x38\x3a\xb7\xd9\x99\x5a\x96\x9d\x19\xf1\x6c\x85\x6d\x8b\xfe\x2e\xd2\x69\x82\x48\x05\xb6\xa3\xe8\x9f\x5d\x65\xb5\xbe\x25\xcb\x74\x5d\x7c\x12\x7a\x72\x13\x81\x83\x04\x76\xcc\xeb\xf4\xe4\xae\x9d\x01\xb7\x11\x85\xa3\xad\x1b\x06\xb0\x85\x07\xbe\xea\x48\x89\x95\xad\x8c\xc2\x39\xcd\xd8\xf2\x4b\xfd\x0a\x40\xab\x38\x4f\xb9\x39\x26\x26\xe7\x71\x72\xf0\x7f\x5e\x3d\x25\x07\x3c\xfa\x10\x11\x42\xed\x07\x60\x5c\xba\xf4\xe6\xf2\xd2\xa7\x76\x2b\x75\x7f\xa8\x5e\x51\x32\xe7\x67\xfd\xaa\x8f\x06\x6c\xe8\x25\xc7\x61\xc8\x87\x17\x9a\x3e\x54\x08\x1d\x40\xf1\xaa\x0b\xc2\xa8\x53\x46\xd6\x29\x7b\x8a\x2f\x0b\x3e\x81\xf2\xad\xdf\x3e\xbd\x9e\x22\xbb\x9c\x87\x7c\xfa\xc7\x5d\xf8\x27\x11\x18\xee\x9d\xd2\xa6\x98\x79\x89\x9f\x4a\xd8\x69\x0a\xa5\x51\x99\x72\x09\x5b\x4f\xf8\x34\x7f\xb7\x1c\x2d\xda\x8d\x6b\x7a\x1d\xc2\xe8\xec\x8a\x90\x2a\xd0\xff\x35\x91\x7d\x71\x85\x85\x82\xbc\xcc\x89\x53\xf1\x90\x48\x15\xbb\x99\x87\xe2\xba\x20\x65\xf2\x4d\xe8\x2d\x5a\x4a\x20\xa7\xea\xfe\x13\xf9\xba\x9a\xe2\x6d\x17\xfd\x58\xec\x05\x78\xba\xe5\x2f\x99\x78\xb0\xd0\x89\xca\xa1\xcb\xf3\xf8\x3c\x88\x87\x50\xc0\xba\x98\x6d\xbe\x52\xe0\x50\x9f\xfd\xb3\xa8\xe6\x6f\x7a\x50\x14\x5d\x33\x78\xa5\x5a\xb8\x3e\xe3\x85\x50\x47\x7a\xdd\x38\xe6\xb9\x82\x7c\xee\x2d\x79\x40\xb7\xbd\x72\xd5\x9e\xa0\x90\x65\x8f\x36\x54\x6c\xe2\xed\x73\x68\xde\x4c\x1d\x7c\x0e\xe6\x74\xaa\xea\xf6\xf9\x48\x98\x55\x01\xf0\xaf\x86\xb4\xa6\x24\x0e\x5f\x67\x1c\xf7\xb4\x28\xee\xdc\xc0\x05\x24\xa6\xaa\x5b\x75\x9c\x70\xce\x71\xfe\x89\x54\x11\x5e\x84\xb2\xf5\x93\x1a\x36\xfa\xfc\x55\x6a\xdb\xe0\xf1\x52\x92\x65\xd9\x86\x9f\x77\x00\xe6\x67\x5f\x01\x35\x9c\xab\xdc\x07\x7d\xc3\x42\xe0\x3e\xdc\xd2\xe7\x6b\x23\x91\x93\x35\x8a\x3d\xf6\xb2\x94\x7c\x3e\x44\x16\x35\x59\xc4\x3a\x1c\xfb\x0d\xa9\xd3\xd9\x99\x1f\x67\x8a\xf0\x0d\x2b\xcc\x11\x57\xb9\xa0\xee\x9d\x51\x34\xf7\x62\xcc\xe3\x73\x09\x3b\x51\x9e\x27\x28\x0d\x09\xa5\xf7\xdc\xa4\x40\xed\x6b\xe1\xa7\x9e\x73\xa5\x83\x49\x21\x94\x8f\x19\x8a\xea\xd7\xd0\x94\x63\x92\x70\xc6\x7f\xdc\xec\xb8\x12\x2e\x50\xdd\x56\x66\x05\xb7\x1c\x4f\x8b\x65\x9c\x5e\x52\xf1\x2d\x27\xdd\xe8\xbd\x5f\x19\x86\x5d\x4d\xbc\x9e\x0c\xfd\x23\x99\x7d\xe4\x7e\xcf\x9f\xec\x00\x87\x08\x7a\x70\xac\x7e\x90\x18\x92\x78\xe4\xb8\xd2\x95\x68\xdf\x2a\x10\x49\x05\x04\x10\xee\x59\x53\x6e\xcc\xea\x2d\x7d\xfa\x85\x2a\x80\x92\xf9\x36\x84\x29\xa1\x21\x28\xfb\x77\xaa\xbd\x30\x93\x45\xc7\x1d\x6a\x05\x0c\x71\x31\x0d\x4e\xc5\x20\xff\x86\x31\xfd\x09\xc6\xf7\x5c\xe0\xdb\x36\xd8\x60\x2b\x11\x18\x09\x1d\x26\x8f\x47\x9f\xe4\x86\x25\x8e\xee\x3b\x5a\x95\xa9\x53\x0c\xaa\x50\xfc\xec\xf3\x37\xac\xa9\xb8\x50\x7d\x8f\xe7\xd0\x71\xc3\xba\xe6\x5e\x01\xf7\x0f\x04\x94\xaf\x99\x60\x6f\xd4\x6a\x30\x51\xd2\x18\x4d\xd4\xe2\x2a\x7a\xd3\x6b\xf3\x4c\xeb\xcb\xb0\xa3\x20\x79\xd4\xcb\x34\x8d\x90\xf3\x4e\xe4\x84\x12\x78\xbd\xdc\x16\xee\xca\x57\x25\xee\x63\x4f\xe8\x6d\x06\xd8\x78\x4a\xed\x47\xff\xb0\x1f\xa3\xeb\xcb\xbd\xbc\x95\x29\xc1\x7b\x7f\x52\x36\x86\x26\xae\xea\x2e\x40\x31\x6d\x8d\x81\xf1\x76\x12\x62\x8d\xdd\x0f\x21\x68\x61\xc2\xa8\x58\x44\x18\x76\xc6\xba\x5e\xaa\xf1\xc5\x5a\x30\x16\x78\x3f\x93\xf7\x8b\x24\xb5\x27\x01\x41\x88\x01\x44\xc8\xd5\x66\xcb\x96\x09\x09\xde\x7c\x23\x35\xcd\xa4\x0f\x42\xea\x5d\x21\xf9\x13\x6e\x2a\xb1\x8f\xe3\x05\x64\x6f\x06\x19\x8c\x65\x83\x25\x77\x27\x22\xc1\x4d\x26\xd4\x55\x95\x13\x83\xde\x7b\x5f\x4f\x13\x9e\xbb\xbc\x1d\x68\x69\x90\x5b\xbb\xfb\x1a\xbf\xa0\x5f\x17\xb5\x0a\x28\x1d\xce\xe1\xf1\x5a\xcb\x9c\x97\xcb\xa5\x48\x60\x81\xee\xf2\x50\x8a\xa6\x1a\xad\xdc\xf1\x09\x53\x2a\xaa\xc3\xdd\x4c\x61\xae\xca\x58\x92\x48\x91\x64\xea\x9c\xbe\xcb\xf5\x4e\xf1\x39\xdb\x18\x1e\x8a\x57\x7e\xbe\xc6\x19\x59\x5e\x64\xcd\x9f\x5e\x32\xc5\xca\xbb\x6a\x03\x57\x9d\xea\x61\xdf\xfc\x20\xe8\xe1\xa6\x3e\x66\xbf\xe5\xe7\xb6\x37\xc8\x28\x9e\x82\x03\xc3\x6a\x81\x42\x81\xb5\xb9\x89\x35\xa7\xb3\x01\x8c\x7a\x02\x33\xa6\xa2\xf3\x34\xfb\x33\x67\x3c\x7b\xf6\xc3\x41\xe1\xc9\xcf\x05\xb7\x84\x85\x15\x8c\x69\x33\xb6\x60\x39\xb2\x65\x42\xf2\x52\x7c\x47\x90\x13\xc5\xc3\xb7\xf0\xe7\x19\xbd\xf2\x37\xae\x24\x74\xb7\x82\x93\x2e\xa3\x98\xb4\x96\xad\x73\xf8\x90\x4c\xbf\x51\x90\xa1\x43\xf8\x67\xda\x8e\x3f\x6f\x2d\xdb\x73\x44\xb6\x0c\x6b\xe6\x6f\xf1\x53\x1f\x7b\x4f\x5b\xe6\x68\x29\x14\xe5\x7b\x49\x4c\xb6\xd1\xcb\x5f\x4a\x06\x65\x8d\xfb\x05\x42\x0c\x55\x57\x91\x4d\x74\x7b\x0f\x0e\xdd\xb9\xdc\xaf\x91\x70\xa8\xd1\x7b\xef\xa9\xf5\x3a\xdd\x6e\x80\x01\xd2\x41\x0c\x9a\xb5\x50\x19\x82\x00\x29\xf8\xff\x21\xc1\xd7\xf8\x66\x5d\x5f\xd1\x26\xd9\x60\x97\xde\x0f\x24\xff\x19\x40\xdb\x65\x35\xa5\x6b\xee\x40\xfd\xf4\xf8\x9c\xde\xef\x4e\x70\x6d\x6e\x60\xfa\xba\x31\x52\x74\x0c\x5b\x00\xb2\xf5\xdd\x67\x67\xdb\x75\xa5\xdb\x5d\x14\x2f\xe2\xea\xbb\x08\x06\x83\x6b\xd8\xef\x87\x9e\xe4\x1c\x4d\xb8\xb4\xb1\x10\xf9\x33\xeb\x5d\x5a\xa8\xc3\x5d\x06\x2f\x10\x12\xef\xe4\xf4\x77\x78\x8a\xd0\x68\xd5\x85\x10\x5c\xf7\xf6\xb4\x81\xcf\x40\x2a\xee\xd6\x8b\x87\xa5\x23\xd0\x4a\x91\xbe\x90\xa7\xca\x06\xf1\x3c\x67\x06\xce\x58\x7f\x35\xd7\x13\x79\x8c\xc7\xc8\x4b\x2a\x2c\x9c\xa8\xd9\x8b\x7d\xae\x76\x09\x54\x82\xc5\x42\x5c\xb6\xb1\xc0\x61\x60\x74\x71\x1c\x16\x81\x2c\xa3\x84\x6e\x4f\xce\x41\xb8\x7a\x19\xe9\x57\xbf\x5d\x90\xd6\x30\xd4\x00\xdd\x24\xd3\xbc\x97\x6a\x98\x78\x24\x67\xb4\xca\x74\x12\x18\x04\xe2\x99\xbf\x85\xb9\x99\x41\xc3\x8a\xdb\x80\x4e\xa3\x8f\x27\xfd\x37\xcb\x89\x73\xde\x62\xeb\x5a\x2e\x26\x2e\x2c\x1f\xd8\xcc\xec\x7e\x6a\xd0\xb1\x7a\x9b\x83\xc3\x7f\xd3\xb3\x8d\x4e\x39\xf1\x1c\x05\x46\x3f\x0e\xdd\x87\x0e\x88\xa2\x8d\x50\xc9\x89\xbb\xc6\xdd\x37\xfc\x94\x8b\x7f\xa3\x20\x5a\x4a\x67\xa6\x8f\x63\xe5\x5f\xab\x82\xf9\x4e\x26\x94\xa5\x41\x07\x5a\x51\xcb\xf4\x63\x3d\x1c\xf1\x3d\x22\x55\xcf\xf1\xcd\x03\x96\x26\x9c\xd3\xd3\x05\xb8\xc3\xe7\x02\xc6\xe5\x45\x38\xc0\x94\x31\xc5\xb7\x1f\x19\xf3\x47\x3b\xd5\xd9\x8a\xcb\x52\xc1\x71\xd6\x37\xff\xe4\x4d\xe4\xa6\x92\x96\x18\x11\x42\xc8\x84\xa5\x25\xb2\x77\x90\xd7\xe9\xcd\x6f\xbd\x77\xae\xa5\xb0\x6a\x43\xdd\x65\x16\x6f\xca\xa0\xd0\x33\x22\x6d\x4b\x3c\x2b\x4e\x98\xe8\x62\x63\xea\xd8\xe5\x59\x42\x84\xc8\xd8\x7e\x40\x26\x71\x14\x6b\x1f\x77\xc5\x1b\x11\xa2\x64\x2e\xeb\x57\xd9\x12\x17\xe7\x87\x2f\x06\xbd\x61\xf9\x77\x0c\x25\x30\xc1\x89\x6a\xc6\x3b\x99\x59\x09\x5d\x60\x97\x3a\x50\xf0\xea\x40\xfe\x02\xf1\x77\xdb\xe6\xa3\x17\xed\x31\x82\x5d\x88\x80\x59\x15\xe3\x5a\x8a\xaf\x92\x82\xad\xb6\x28\xab\x5a\x64\x87\xba\xa6\xfc\x55\xb4\xad\xa6\x7c\xc7\x6f\xcf\x42\x37\xbb\xa8\x92\x11\xb7\x22\xfa\x59\xe9\x9f\x98\x13\xb8\x4e\x22\xdc\x87\xfb\x4e\x04\x0b\xef\x55\x9c\xbb\x3a\x43\xdc\xa0\x23\x56\x79\xa4\xe3\x94\x42\x72\x07\xd7\xb7\x61\xe5\x4f\x05\x10\xf0\xa4\xc2\x49\x12\xfd\x22\x27\x29\x06\x93\x34\x25\xd3\xd3\x20\x45\xda\x10\xb4\xe4\x0c\xba\x10\x22\x0f\x8f\x1d\xd9\xb6\x18\xbe\x45\xc5\x6b\xee\x3f\x9e\x0c\xd6\x94\x4c\x16\xe8\xe5\xf3\x62\x3e\x0c\xb2\x9b\x44\x9d\x2c\xe1\x77\xf2\x17\xb2\x73\x0c\xfe\xa0\x39\xe8\x97\xab\x91\xc2\x29\x5f\x8e\x23\x16\x65\xf0\xce\xae\xe3\x9b\xe7\x0b\x69\x7b\x48\x3b\xa6\x41\xa2\x34\xdd\x9d\x97\x91\xf9\x26\x41\xc9\x98\x8b\x9d\x25\x20\x40\xb3\x2f\x59\xbf\x15\x5a\xf5\x0a\x23\xeb\x0b\xe2\x81\x0a\xf9\x27\xdf\x4a\x5c\x08\xff\xf3\x07\xc9\xc4\x11\x95\xec\xdf\xbe\x02\x23\x80\x22\x42\x7b\x52\x29\xca\xaa\x41\x75\x8a\xa3\x03\x8b\xd6\xac\x43\xdb\x58\xc0\x96\x2e\x33\xb0\x19\x29\x4c\xf5\x6e\x57\xcc\x52\x7c\x53\x4a\x4d\xd1\xd9\x28\x79\xfb\x35\xda\xcd\x29\x05\xed\x2f\x24\x72\x9b\x92\xbf\x02\x0c\x9a\x61\xd6\xe1\xbc\x9b\xb7\x40\x67\xc9\xf3\x3a\xe0\xf8\xbf\x33\xc3\x07\x34\x41\x96\xd4\x0f\x08\x8e\x52\x32\x5f\xcf\x22\xaa\xd3\xa7\xf9\xe0\xce\xe3\x38\x4c\x90\x46\xe9\x29\x36\xf0\x04\xa3\xd6\xb2\xe0\xf5\x29\x82\x79\xde\x8b\x9c\x99\xea\x56\x3a\xc1\xda\xc2\x3b\xf3\x23\x13\xda\xfa\x3e\x37\xb4\x23\xc1\x14\xf2\x7f\x16\x8f\xaa\x12\xdc\xfb\xdc\x59\x58\xbe\x24\x4c\x44\x94\x13\x69\xd1\x8a\xe8\x49\x39\x86\x9e\x7c\xaf\x9c\x99\x9c\x5e\x1f\x94\x50\x57\x41\xcc\x85\x43\x88\x05\xc7\x2b\x1d\xf9\x5f\x23\x4c\x4c\x2c\xc3\x20\x93\x30\x25\x5a\xe0\xc4\xd0\xdc\xfd\x2a\x34\x81\x3a\xb0\xf6\xdd\x75\xab\xd2\x8d\x51\xbd\xad\xe4\x91\x02\x2c\xce\xd2\x9f\x3b\x70\x70\xf0\xaf\x50\x1d\x2d\xa9\x36\x4a\x11\xbf\x2d\xfe\x05\xf8\x81\xf5\xe9\xfe\x1c\xaa\xcd\xfb\x3e\xed\x5d\xa3\xe0\x53\x96\x7f\x2c\x5b\x05\x83\xa8\xa3\x65\x68\x33\xa2\x49\xe8\x0b\xf0\x15\x46\x4a\x50\x05\xc9\x06\xb5\x77\x80\x76\xc8\xd8\xdb\x88\xb7\x57\xd1\xdf\x0c\xf6\x3b\x70\x0f\xfe\xe9\x2e\x70\x68\x24\xe5\x25\xcd\x2a\xd5\x7d\x7f\xd7\x41\xc7\x1d\x86\x11\xc8\x6d\xaf\x9f\xac\x38\x1e\x3a\x8c\x48\xa4\xc4\x88\xc3\x21\xdf\x43\x69\xd8\x36\xe3\xbc\x74\x81\xbd\xd2\x61\x79\xdb\x75\x77\x70\x29\xdd\x01\x0d\x54\x66\x71\xf1\xbe\xdb\x83\xfd\xdd\x2a\xc3\xe5\xee\xc3\x82\xef\x4e\x65\x30\x02\x03\x83\x17\xac\x43\xcb\x1d\x74\xdc\xba\xf7\x55\xf6\xc8\xeb\x23\x83\xbd\xc3\x1f\x0e\x24\xff\x61\x63\x7e\xc5\x1b\xaa\x8e\x3a\x59\xd2\x4f\x94\xe0\xf1\x37\x38\x64\xff\xe9\xec\x1c\x60\xbd\x52\x17\xfa\x0b\x56\x6c\xb0\x42\xe2\xc4\xe7\x47\xe8\xda\x20\xed\xc4\xa5\x41\x85\xef\x41\xf2\x51\x9b\xe6\x4a\x80\x3e\xf8\xa5\xb7\x0b\x0f\xa6\x25\x5b\x7a\x6d\x43\xb9\x12\x1c\xfb\x21\xbb\x46\x42\x1f\xce\xd1\x89\x0b\x1d\x05\x0c\x9c\xfd\xe3\x02\xd5\x69\xaf\xe5\xa0\x34\xe5\x3f\x71\x7b\xf6\x1d\x16\xe6\x34\x64\xb1\x0e\xf0\xee\x4d\xd4\xce\xe0\x39\x6d\xce\x6b\xb8\x1b\x59\xd9\x78\x91\xe4\x8f\xa2\x56\xd2\xb3\x30\x01\xea\x85\xda\xb0\x08\x36\x7a\x61\x65\x1a\x93\x72\xd9\x67\xfd\x66\x20\x77\x1f\xc3\x6d\x4a\x5e\xd2\x74\x29\x2c\x36\xcd\x7f\x97\xd5\x29\x01\x52\xdd\x86\xaa\xc3\x95\x4a\xbc\x94\x94\x7b\xf5\x9c\x8b\x03\xcf\xb4\xbe\x08\xad\x3d\xbf\x5d\x33\x7f\x40\x46\xa5\x7f\x7d\x40\x3a\x50\x12\x08\xfd\xa6\x57\xc3\x46\x26\xcf\x19\xd5\x2f\x94\x6e\x64\x0d\xd3\xe7\x9d\x51\xa7\x8d\xb5\xeb\xaa\x17\x8a\x62\x71\x61\x35\xb6\x9a\x92\x9e\xc5\xb4\x10\x86\x0c\xc7\x64\xc9\x59\x90\x7c\x08\xfb\xcc\xa8\x68\x4b\x0d\xcb\xbf\x9e\x2b\xf0\x17\x31\x71\x06\xbb\x37\x49\xeb\x0c\x2d\x80\x63\x04\x63\xec\xc4\xea\xfc\xb2\x25\xc4\xf7\x98\x9b\x98\x9c\x47\x5c\x94\xe4\x6a\x3e\xe5\x3a\xf7\x14\x75\x70\xb2\xca\x56\x8d\x91\x8c\x7d\x01\xac\x6c\xb5\x46\x99\x31\x27\x68\xba\xc5\x2b\xb8\x18\x94\x9d\xbb\x8c\xd0\x98\xca\x69\xd8\x2c\xb4\x25\xc1\x6c\xe0\xc8\xdb\xbe\x87\x9c\x28\xbe\x71\x24\x7e\x1f\xef\x04\x04\x6a\xf5\xac\x27\x2b\xcf\x19\xbe\xcd\xd9\x92\xfb\xda\x5f\x6e\xab\xf0\xca\x80\xb4\x07\xb0\x79\x50\xae\x0b\xcb\x90\x9e\xe3\x27\x81\x42\x9b\x4e\x53\x5c\x95\x30\x8a\xd9\x52\x9a\x3e\x25\xe9\x8f\xa3\x0c\xdb\xc2\x80\xe4\xd5\x69\xe8\x66\xc1\xd1\x22\x5c\x3b\x65\x5a\xc2\x39\xdc\x86\x52\x09\x15\x3f\x1f\xc8\x92\xfc\xbc\x21\x13\x90\xf9\x98\x19\x45\xde\x67\x47\xba\x0f\xbb\xf4\x87\x67\xe1\xcc\xf6\x47\xe7\xf3\x37\x89\x8c\xd3\xf3\x28\x8c\xe7\xee\x30\x72\xab\x4c\x3a\x71\xe9\xc1\x2c\xcd\x0e\x06\xa7\x01\xa1\xe8\xff\x40\xaf\x4b\x1a\x4a\xc1\x46\xa0\xeb\x0f\xd9\xc0\xf1\xa2\xb8\xb5\x13\x9f\x96\x35\xba\x24\x4f\xf8\xde\x52\x7e\x5b\x7d\x2a\x92\xbf\xba\x79\x26\xa4\xff\xbd\x86\x1a\x56\x86\xcc\xb2\xdf\x09\x86\x85\xd9\x5c\xe8\x50\xf3\x8b\x40\xe6\x14\xcd\xfd\xd5\xc9\xd5\xca\xdd\x03\x7a\xcd\x10\x5c\x9d\x3e\x4a\x3d\xf2\x3b\x99\x4b\xb4\x4a\xad\xb0\x0d\xf7\x44\xd9\x58\x80\x89\x07\x54\xdf\x3e\x6e\xee\xeb\xc4\xca\x93\xd0\x7e\x24\x54\x73\x02\xa5\x9e\xf0\x62\xc0\x24\xfe\x10\xa1\xc9\x31\xd0\x4b\x49\xfb\x0a\x13\xc1\xfa\xa5\xa4\xb9\x87\xb6\xda\x2e\x65\x16\x9b\xcd\x74\x19\xd9\x05\x68\x85\x82\x19\x35\xa6\x9d\x87\x56\xb2\x81\x90\xaf\x59\x60\xa3\x86\x86\xc0\xee\x8c\x9e\x5f\xea\xb8\x88\x9b\x6a\x43\xee\x3f\x08\x15\x00\x83\x04\x13\x44\x10\x76\xc0\x24\xaf\x30\xf7\xb6\x9c\xe5\x3c\x24\x8e\x38\x8c\x4d\xf4\x9f\xf3\x7a\x15\xb1\x11\xf3\xf1\x36\xaf\x87\xf7\x92\xa1\xff\x16\x77\x0d\x9d\x39\xb9\x8e\xca\xc0\xfb\x9a\xf2\x98\x3a\x50\x66\x8c\x8c\x27\x45\x89\x08\xb6\x63\x36\xf8\x0e\x73\xe2\x21\xd8\x69\x10\xbf\x91\xa4\x49\x5d\x2b\x8a\x74\xe9\xaa\x72\x44\xf4\x43\x14\x42\x8d\x20\x60\x87\x5a\x7d\x50\xb7\xb5\xc2\xbc\xf2\x4b\x1f\x28\x99\xd4\xc9\x1d\x0f\xeb\x8a\x42\x94\x5f\x7b\x4b\x10\x35\x03\x9e\x4a\x8e\x45\x7f\x07\xc6\x61\x32\x15\xbe\x60\x99\xf0\xc0\xd6\x71\xea\x80\xcd\x52\xba\xc0\xce\xbe\x9a\x84\x97\xe6\x07\x06\x9c\x8e\x11\xb1\x5a\xee\x51\x80\xb7\x6f\xb0\x9c\x65\x35\xaa\x2c\x21\x81\xe6\xe8\x3f\x22\xde\x0f\xa0\xf0\x44\x53\x18\xd6\x9b\xa6\x37\x16\x9e\x0d\xb1\x4f\x91\x1f\x54\x5a\x81\xde\xc8\x2a\x33\xa9\xeb\xa9\xd8\x2c\xa1\x72\xb4\xfb\x88\x89\x2f\xa5\x2e\xb3\xc7\xb5\xe3\xde\x63\xb4\x4e\x42\xc6\xcb\x45\xeb\x1a\x49\x14\xae\x7d\x3f\xf5\xd9\xeb\xf0\x6d\xc9\x72\xbe\xd6\xa4\x73\xe4\x8e\xe5\x42\x68\x68\x28\x9b\xb4\x38\xd3\xff\x6a\xa9\x48\xbb\xd8\x90\xe2\x77\x5f\xf4\xee\x28\x7b\x83\xe0\xc5\xf9\x9c\xa9\x0b\xec\x7b\x92\x0c\x4a\xf4\x01\x3c\xa0\x0a\xbd\x09\xf1\x3d\xe8\x74\x11\x6d\x53\xbe\xd7\x9a\xa6\xdd\xcb\xb2\xf1\xef\x64\x0b\x64\x65\x2c\x54\xfc\xe9\xa5\x88\xd0\x96\x32\xc6\xc0\x02\x5b\x61\x7e\xf2\xf2\x81\x44\xf9\x24\x76\x63\x93\x42\xd1\x1d\xaf\xe6\x19\xa4\x87\xf3\x29\x6b\x11\x52\x3d\x5f\x21\xfe\xf4\xd1\x81\xfd\x15\x0a\x04\x11\x4e\x01\x42\xe5\xbc\x4f\xcd\x29\x5c\x63\xc7\x55\xc4\x45\x82\xa3\x0f\x38\x37\x35\x56\x2c\x56\xe9\x20\x0a\x3e\xd5\x98\x1b\xc7\x9c\xd1\xd1\x61\x7d\x8f\x08\x16\xcc\x57\x3b\x29\x7a\x30\x87\xbc\x0d\x3b\xcb\x11\x3a\xa9\x19\x2b\x32\x91\x24\x2a\x37\xf7\x12\xb3\xc9\x86\x06\x97\x1c\x52\x79\xb8\xb2\xd7\xa9\x90\x14\x5a\xe0\x6d\xe2\x58\x95\xcb\x92\xa9\x1f\xda\x6d\x88\x26\xec\xab\x58\xde\x47\x9d\x7f\xaa\xe6\xc6\x92\x33\x4c\xb5\x42\xf4\x7c\x62\x96\x14\xc3\xa1\x51\xae\xb0\x07\x36\xc6\x48\x8c\x25\xff\x3f\x0d\xb7\xf4\xe2\xf9\x05\x3a\x81\x21\xd9\x5c\xde\x38\xb3\x84\x37\x14\x2d\x06\x20\xaf\x0c\x26\x4b\xc3\x8b\x37\xb5\x5d\xaf\x5b\xdf\x2f\xec\xc2\x9c\xbc\xbd\x24\x41\x06\xd6\x94\xfd\x47\xdc\x6f\x57\xbf\xb8\xed\x58\x29\x71\x95\x24\xc1\x5d\x04\x82\xad\x9a\x47\x73\x1b\x1e\x20\x30\x56\x94\xa7\xf9\x22\x56\x81\xda\x89\x66\x25\x52\xe5\x9f\x55\xfc\x59\x7a\xa0\xe0\x9c\xf8\xf5\x1b\xbd\xa2\x41\xaf\x1d\x84\x11\x95\xd1\xfe\x9f\xa6\x83\xec\x34\x33\x60\x4a\xc9\xf4\x30\x77\x7f\xdb\x24\xb9\xb7\x51\x50\x29\x59\x2d\x1e\xaf\x5e\x09\x30\x59\x6a\xa9\x9e\x4f\x0f\xfb\x34\x7a\x74\x8d\xab\x7c\xa2\x43\x56\xcf\xb8\xb2\x58\x53\x27\x4f\x82\x3f\xfc\xff\x2d\xa3\xd4\x04\xc5\x2c\xc3\x28\x07\x01\x9a\xef\x1b\x60\x37\x23\xc0\xb9\x42\x52\x14\xd0\x10\x6e\x3b\x30\xd2\x72\xf9\x12\x90\x7b\x94\x12\x3e\x5b\x67\xaa\x2d\x11\x51\xcd\x12\xcd\x05\xc6\x65\xf9\x00\x32\x6a\x15\xb2\xf5\x0a\x46\xe4\x55\xad\xfd\x3b\xa2\xe0\xa8\xba\xfb\x90\x67\x94\x69\x92\xe2\x5b\x3c\x59\x00\xb8\xbb\xb0\x26\x78\x5f\x41\xf7\x4a\xc4\x04\xda\xd4\x16\x84\x1f\x07\x49\x5d\x63\xd3\xf7\xdf\x52\x8c\x55\x06\xf3\xb0\x09\x2b\x98\xa1\xda\xb5\xa4\xdd\xe4\xc4\x16\x68\xc8\x33\x7e\x6d\x68\x40\x51\xde\xc8\x5f\x8e\xe9\xc2\x74\x93\x83\x49\x74\xa0\xd2\x77\x6b\xb9\x06\xc7\x14\xda\xfe\x1e\xd9\x0b\xcb\x61\xf9\xda\x48\xb0\xc6\xab\xa5\x6d\xd4\x88\x9a\x8a\x8b\xbf\xed\xbc\xc5\x33\x72\xf4\xb4\xa1\x9e\x6c\xa4\x0e\x3a\x6a\xec\x4b\xdf\x42\x23\x62\x96\xf7\xd0\x2c\xd4\x40\xa3\xcc\xb2\x0c\xae\x29\x13\x86\x2d\xb5\x56\x2d\x8b\xb1\x05\xc8\x5b\xb1\x53\x89\xd3\x96\x1d\x94\xb8\xa7\xb2\x8e\x3d\x0d\x22\x40\x24\x5f\x75\x0b\x14\x62\x6b\xee\xc9\xc7\xfe\xaf\x57\x4d\x69\x1c\x4c\xda\xc5\x6b\x93\xb3\x7e\xfa\x2b\x0d\x11\x0e\xf0\x77\x43\x8e\x24\x03\x24\x12\xb9\xa7\xb1\x9f\xc9\x52\x71\x6d\x76\x29\xd2\xac\xc2\x51\xe9\xdc\x64\x19\x4b\xa2\x20\xb1\x26\x86\xcc\xaa\xda\x2a\x61\xf0\xd3\x4e\x2e\xc5\xbd\x1f\xfe\x1f\x42\x7b\x78\x6d\x5a\x7c\xb3\x67\xfb\xf2\xd7\xe7\xeb\x01\x82\x65\x60\xc6\xd7\x25\x38\x8d\x0c\xdf\xab\xbc\x40\x26\x9b\xdf\xb2\xb9\x9a\xee\xfa\x4b\x67\xa0\x22\x65\x7e\x95\xcd\xe7\xc2\x55\x47\x68\xf6\x09\xfb\x1c\xf6\x76\xf3\xf4\xf8\xde\x08\x9b\x03\x49\x22\x54\x59\xd8\xbf\x93\x5d\x13\x69\xa1\x28\xc7\x17\xbe\x7f\xe7\xa6\x4a\xde\xf6\xb9\xbe\x0b\x24\xd3\x62\xac\x0f\xc9\x05\x3c\xb7\x88\x62\xde\x45\x2c\x48\x2f\x15\x04\xda\x3b\xe5\x5b\xc5\x43\xdf\xee\xfe\x61\x5b\x65\xcc\xf8\x15\x66\x0e\x3e\x8c\x41\x1e\x62\x5a\x74\x82\xb0\xca\xe5\x34\x00\x4a\x9b\x2a\x5f\x41\x4e\x22\xd0\xdf\xad\x74\x7e\x2c\xca\x2c\x58\x92\xdf\x0d\x0b\x74\xd7\x68\x64\x47\x97\x79\xf3\x8c\x9a\x48\x4a\x1c\x45\x2a\xaf\xfd\x4b\x47\x11\x99\xe8\xb0\x3f\x56\x53\x51\x6f\xfa\x59\x9a\xaf\xa1\xc8\x1d\xf1\xa7\x5a\x6a\xbc\xe2\x85\x63\xe2\x3d\xa6\x95\x06\xf8\x4d\xd8\xf8\x12\xcc\x2d\x4f\x7c\x3c\xf3\x24\x92\x4f\xa4\x49\xdb\x0b\x2e\x3c\xef\x90\x8f\x81\x68\x28\x8b\xd2\xac\x37\x49\xa6\x02\xf7\xa5\x20\x8d\x83\x0e\xe9\x05\xc2\xba\xc2\x78\xc9\xaa\x05\xc0\x64\xf0\xc9\x34\x8a\x9c\x65\x6f\x2d\xda\x59\xf2\x07\x2d\x27\xfd\x8d\xb3\x50\x6f\x93\x83\x50\xe9\x09\xb0\xba\x28\x34\x93\xb6\xdd\x95\x47\x56\xf3\x53\xd3\x99\xb1\xfb\x58\x68\xe0\xc3\x0a\xf7\x71\x65\xf8\x2d\x50\xe0\xe7\x31\x73\xe8\x07\x4f\x61\x86\x6b\x1b\x71\xe5\x1c\x18\xe8\x3b\x45\x45\x6b\xc3\xcd\x37\x4f\xe7\x51\x52\x16\xb9\xbc\x70\xe4\x92\x35\x75\xef\x18\x8d\x1b\x2a\x38\x53\x11\x3e\x5e\x4e\xac\x97\xd5\x98\x52\x2a\x73\x6f\x8f\x38\xef\x4c\xcb\x77\xe7\xe3\x98\xb0\xf5\x01\x86\x60\x94\x9b\x1b\xa7\x78\x81\x54\x17\x33\x14\x78\xe2\x82\x3b\xf4\x2c\x77\x45\x25\x32\x76\x80\x4d\x85\x6c\x7c\xa7\x70\xe1\x82\x6f\x02\x05\xe7\x89\x6f\xba\x99\x39\x4b\xe7\xb6\x57\x17\xa5\x44\x11\x04\x5d\x21\x10\xaf\xfa\x86\x42\x83\x06\x66\x50\xcb\xfb\x33\xb4\x0a\x60\x1d\x60\xf3\x91\xc5\xc3\x23\x82\x4c\x31\xa3\x59\x23\xb6\x4f\xf8\x7a\xb3\x4f\x89\x28\x6c\x07\xee\xe6\x68\x33\x3d\xfb\xca\xa5\xb0\x94\x66\xae\x3d\x69\x9e\xce\x20\x92\x7e\x2d\x0f\x46\xec\xfc\x54\xe8\xbb\x48\x30\xeb\x99\x21\x83\x65\x2c\x2f\x27\x37\x80\xf4\x68\x84\x72\x48\x7c\x37\x4b\x03\x4a\x10\x14\x36\x98\x0e\x5d\x24\xed\x20\x0f\x77\x3d\x93\x8b\x2b\xd4\x5b\x8e\xc6\x5c\x82\x07\x79\xa9\xae\x04\x0b\x7c\x9d\xa9\xe6\xe0\xa1\xdb\x35\xed\x19\xd1\x08\xa9\x1a\x66\xa3\xe8\xf5\x45\xef\x17\x40\xf1\x2d\xe1\xbf\x78\x2a\xab\xb1\xbe\xb7\x8e\xeb\xb4\xe8\xfd\xc7\x4c\x3b\xa7\x6a\x98\xf9\xcc\xb7\x41\x66\x07\xef\xfb\xd9\x70\xeb\xe2\x2f\xae\xec\xe4\x8f\xd4\x6c\x94\x15\xf0\x0e\x6d\xb4\x23\xe4\x90\xe3\x67\x7e\x40\x97\xd7\xa3\x62\x6d\x25\xe5\x12\xcc\x1e\xa6\xdc\x9a\xb4\xf5\x11\x55\x62\x42\x9c\xed\xd3\xb6\x2e\xe8\xa6\xcd\x3b\x4f\xfa\xe3\xf0\x60\x95\x49\x42\x64\xf6\xc4\x9d\xdc\x95\xfd\x67\x5c\xb5\xf5\x3f\x42\xab\x55\xd9\x8f\x53\x12\x47\x70\x67\x10\xa2\x72\x13\x6a\xdc\x15\x9f\xba\x47\xd3\x2e\xe4\xcd\x55\xdf\x1d\x0f\x2f\xf7\x7a\xfd\xdc\xca\xb5\x66\xb8\x0a\x8d\x05\x4e\xd0\x74\x23\x39\xa8\x29\x78\xab\x74\x8e\x16\x32\x31\x2a\x98\x8e\x6d\xf1\x93\xaf\xa7\x63\x83\xfb\x6b\x8e\x1e\xf0\x14\x11\xaa\xdd\x84\x2e\x15\xba\x69\x26\x80\xf0\x8a\x83\x55\x79\x76\xe8\x60\x37\xc2\x8f\x68\xf8\xea\x51\x5d\x43\xe2\xa6\x58\xdf\xfa\xa7\x76\x30\xb4\x3a\x93\xce\x9e\xe4\x1b\x5b\xa1\x99\x57\x0f\xe5\x37\x20\x94\xee\x0c\x4e\xf1\x19\xbc\x86\x06\xfe\x1a\x03\xd1\x20\x74\x9b\xd1\x0d\xb9\x36\x64\xd3\x39\xef\x46\xcb\x61\x09\x1a\xeb\x51\xa5\xa0\x72\x0c\x32\x81\xd2\x22\xf4\xbd\x50\x93\x6f\xa1\x5f\xa1\xda\xf4\x42\x77\x2f\x79\x1d\xc7\xc4\x56\xe6\xeb\xd0\xa5\x3c\x4b\x35\x8c\xc2\x26\x89\xbf\x50\x2a\x0f\xf0\xe5\xda\xf3\x06\x14\x68\xa3\xef\xeb\x1a\xab\x41\x8b\xd4\x04\x4a\x21\x5d\xf3\xbe\x7e\x33\xe6\xbd\x23\x8d\x38\x2a\x19\xda\x2c\x65\xf7\x4f\xfb\x5a\x95\xb5\x48\x3f\x00\x32\x3a\x0a\xe3\xea\x80\x4f\xf2\x05\x8f\xf6\x7b\x89\xdd\xc8\x2a\xa2\x15\x64\xe3\xe1\x05\x80\x10\x38\x68\xb5\x03\xef\xc3\x6c\x7c\x09\x30\xd0\x77\x0a\x5e\xc7\xc6\xcd\x91\x07\x15\x39\x34\xbf\xb6\x5c\xad\xe2\x39\x67\xa5\x3c\x17\x9e\x61\xba\x35\x3f\xfb\x1c\xb1\xec\x6b\xbb\x87\x89\x0d\x09\xe8\xcd\xce\xef\x7b\xbf\x80\x13\x3d\x86\x80\x3b\xf1\x6a\x94\x60\x6f\x82\xcf\x8e\xd1\x4a\xdf\xa0\x87\x2b\xfe\xaf\x49\x50\x90\xb7\x49\xbe\xf0\x6e\x07\xe5\xbb\xd2\x7b\x05\xf5\x55\x8e\x0c\xe5\xe7\xf5\x3a\x48\x3b\x28\x08\xe0\x23\x96\x59\xa5\x17\x00\xcc\x6a\x6b\x8b\xee\xe9\x3c\x73\xbf\x44\xf7\x61\xf1\xb7\x1f\x4b\x5b\x15\x94\x1f\x30\x41\x7f\xfd\x58\xb6\x55\x13\xbc\xe9\x53\xad\xb9\x44\xf8\x58\x41\xcf\xd0\x2d\x4f\x63\x1f\x7f\x51\x22\xc2\x63\x58\x46\xfb\x85\xb7\x73\xbe\xa3\xfd\xd7\x74\xf7\xe6\xe0\x13\xd4\x1d\xdc\x38\xb8\x4c\xc8\x39\xb4\x75\x5d\x07\x67\xf3\x38\xc3\x97\xb3\x83\xb8\xb4\x32\xcf\x7e\x1b\xb2\x2f\xd3\xdb\x9b\xbb\x9d\x3b\xf6\xe6\x40\x52\xac\xc3\x5a\x77\x90\xd8\xf4\x98\x41\x46\x7e\x9c\x6b\xc9\x3f\xf2\x14\xfc\xd6\x54\xc7\xbd\xa8\xbd\xc0\xda\xaf\x50\x74\xf8\x79\x6a\xff\xab\xbc\x15\xa7\xba\x50\xc8\xe7\x0f\x18\x71\x58\x5d\xc5\x8c\xad\xb4\x33\x53\x4c\x42\x11\x36\x38\x36\x52\xa5\x61\x21\xa4\xf8\x29\x12\x21\x47\x2f\xe7\xf9\x42\x80\x3a\x4a\xa1\xdc\xfe\xa8\xaa\x4b\xc9\x6f\x25\xb1\x2c\xa7\x4c\x91\xc9\xe2\x57\xaf\x11\x43\x63\xab\xfc\x97\x09\xeb\xb7\x15\x91\x66\x47\x86\x1e\x43\x02\xf0\x13\x2e\x98\xfe\x40\x14\x05\x60\x0c\x55\x89\xc2\xf7\x0e\x2e\x54\xd3\xe5\xd7\x82\x55\xf4\x28\xd2\x13\x29\xbf\x5b\x8e\x6a\xfe\xe2\x98\x54\xc8\xe6\xa5\xc6\x81\x79\x5f\xc9\xa3\x09\x53\xbc\x18\x48\x19\x24\x28\x1e\x00\x82\x12\x90\xb4\x24\xa6\xf2\xaa\xf5\x39\x92\x49\xba\x5e\x92\x1d\x1b\xc9\x13\xba\xa6\xbd\xd8\x90\x5d\xf6\x05\x78\xed\xc5\xb9\xa0\xb9\x3a\xd1\x77\x80\x2f\x16\xfe\x6d\x37\xe4\x52\xb0\x3a\xa6\x23\xf6\xb6\x77\xb4\x74\xa1\xeb\x7d\x75\x4f\x49\x1c\x9e\xf0\x37\x65\xe8\xe0\xb4\x09\x53\x4c\xa4\x6a\x74\xec\xc2\x4a\x18\x64\xc2\x59\x58\xe5\xa8\x0e\xe7\x01\xa7\xc1\x04\x7d\x8c\x94\x33\x3c\x9d\x3b\xbb\x84\x91\xc0\x94\xb6\x06\x29\xa1\xe3\x41\xbe\xaf\xdf\x03\xa2\x36\x96\x43\xdf\x8b\xa2\x33\xc2\x5d\x98\xc8\xae\x77\xe1\x31\x5f\x6e\x5c\x22\x4e\xb4\xee\x67\xd3\xac\x78\x57\x6d\x22\xaf\x9c\x0c\x0b\x03\x77\xee\x58\xe3\x63\xdf\xed\x96\xe2\x92\x45\x60\x95\xbc\x2f\xc0\x2b\x4e\x26\xb7\x76\xe2\xde\x42\x3e\xff\x63\x11\xca\xb1\x39\x53\x45\x9e\xa3\x7d\x64\xf3\x89\x1e\x83\x3e\x74\xe6\x07\x08\x47\x0f\x72\x14\x9f\x1e\x9a\xd7\x8b\x83\x77\x2c\x10\xf1\x80\x77\xae\x9d\xf8\x8c\x62\xe7\x49\xf8\x1a\xf2\xbd\xcf\x94\x88\xfb\x9d\x87\x66\xab\xde\x56\xd1\x70\xc5\x3d\x32\xc1\xce\x6a\x2e\x42\x10\x86\x3f\x2b\x3d\x50\x23\xd6\x60\x32\xda\xe8\x54\x0a\x2b\xff\xf8\xab\x9d\x28\xc7\x32\xc3\x12\x9b\x71\x20\x95\xfa\x36\x87\xb4\xe5\x6d\x06\x37\xfe\x47\xc4\x71\x1c\x6a\x0e\xa9\x1a\x72\x31\x49\xbe\x05\x51\x88\x2e\xa0\xc2\x5a\x15\xeb\x7f\xf8\x19\x33\xe0\x9b\xb0\x2d\xba\xac\x12\x68\x73\xaf\x8f\x2b\xf0\xf6\x61\xf5\x74\x5b\x24\x7e\x5c\xf7\xc7\xc5\xe6\x4a\xf4\xd6\xfb\xc5\x02\xe0\xd5\xb4\xb8\x23\x35\x12\x05\x96\xb8\xc7\x8b\x12\x9e\x02\x4c\x57\x95\x8e\xc2\xc4\xf6\x10\xc2\xf2\xde\x1f\x31\xe2\x67\x9e\x81\x11\x1e\x03\x12\xd2\x1e\x9e\x25\x19\xf1\x90\xcd\x2b\x83\x07\x47\x4f\x09\xd6\xe5\x5b\x8c\x6c\x94\xaa\xbc\xe5\x95\xb1\xb5\xef\x96\x2f\x84\x62\x27\x4d\x10\x58\x6e\xfd\x29\xd0\x1d\xbb\x4f\xc9\x5e\xd4\x0f\xb2\x96\x27\x02\x49\xa2\x25\xb2\x63\x32\x64\x8c\x9a\x1d\xaa\xc7\xf1\xb2\xfc\xc7\xbc\x53\x5e\x86\x14\xe9\x9a\xc1\x6f\x86\xa7\x8a\xa2\x4e\xf4\x49\xb8\xa0\x71\x61\x23\x29\x00\x98\x15\x24\xc5\x9d\x3d\xc4\x32\xcf\x05\x8a\x48\x6b\x8b\x11\x0b\x14\x59\xde\x5c\x25\x39\x6b\xf5\x65\x35\x90\x55\x98\x5b\xf4\x37\x1e\x4b\xaa\x19\x79\x1f\x7c\x16\x77\xb8\x98\xa3\x8f\x9f\x08\x14\x21\x11\xcc\xc4\xdf\x75\x9a\x93\x99\xdd\x32\xc6\xeb\x60\x11\xdd\x6a\x13\xe7\x0d\x92\xb3\x91\xe1\xb5\x41\xe1\x3f\xb9\x28\x7b\xc3\x19\xc0\x51\x49\x3c\xc1\xbd\x0c\xea\x86\x27\xd7\x10\x5d\x01\xbe\xde\xd2\x3e\x07\xee\xf9\xe6\x52\x81\xe7\xf0\x2c\x66\x47\xa8\xb1\xec\x7a\x2d\x36\x50\xf8\xdb\x8a\xf2\x47\x3b\x39\x9d\x6d\xb1\x2c\xea\x77\x3f\xd8\x36\xec\xe9\xce\x69\x63\xd7\x82\x00\x5a\xe9\x1f\xc6\xb4\x93\xb4\x3c\xf0\x7b\xd9\x60\xdd\x4c\x98\xfa\xc3\xa3\xf1\x76\xc7\x80\x79\xfe\xcb\x79\x70\x21\xf5\x39\xf4\xb0\x73\x39\x8c\x9a\x0a\x6b\x3e\x97\x8c\xb1\x11\x2e\xf5\x3e\x07\x6d\x63\x2d\x70\x89\xd2\x7c\x90\x5b\x4a\x7d\x24\x36\x40\x60\x38\x0d\xea\xc6\x21\x8e\x68\xc8\x9d\x80\x5f\xa3\xca\x9b\x1e\x2e\xda\xf9\xab\x3d\xf8\xb7\xdf\x56\xd7\xcf\x93\xd0\xa8\x2a\x50\x47\x30\x17\x2d\xd3\x0e\x38\x45\x6b\x3a\x2b\x70\x29\x71\x87\x9f\x18\x87\x92\x5b\xe7\x6e\x86\xe8\x94\x75\xd8\x3c\x34\x50\x6d\xda\x70\xb7\x46\x88\x8d\x17\x5d\x7b\x96\x89\x81\xa6\x8c\x1e\x48\x60\x63\x94\xa5\x07\x8f\x3e\xe4\x4d\xb3\xcd\xd1\x38\xde\xfd\x21\x86\x2f\x1b\x89\x3e\xfe\x9d\xff\x43\x1c\xf4\x4d\xc2\x3f\x91\x2d\x39\xf1\xb1\x05\x35\x47\x1c\x75\xd5\x29\x9e\xfe\x9f\xd9\xa6\xaa\x8e\x48\xbf\x27\x0c\x68\x7a\xe0\x37\xb3\xee\x25\x5c\x1c\x3d\xb0\xb6\xbe\x71\xc2\x3d\x62\x00\x5e\xb5\x17\x70\x23\x02\x6c\x79\xd1\x02\x7d\x85\x3a\xf2\xe6\xb0\x98\x9c\xb0\x0c\xfc\xc8\xbe\xf4\x0a\x44\x2b\x19\x52\x40\x25\xa7\xfd\xfc\x9f\xbc\xd8\x7b\x67\x8e\x00\xa1\x0d\x56\x8e\xc2\xa0\x81\xf1\x0b\x9b\x7a\xc9\xd2\x43\xb6\xe3\x2b\x07\xf5\xe7\x35\x43\x6b\xa9\xdd\x5d\x75\xc5\xeb\xe5\xeb\xba\xeb\xfc\xef\x4f\x9b\xda\x08\xfc\x49\xc7\x4a\xf3\x5d\x24\x7c\x44\xc7\xea\xb7\xd6\xf9\x91\x02\xb3\x17\x67\x8e\x43\xf7\xc6\xd6\x40\x2c\x87\x8d\xab\x21\x95\x4d\x75\x6e\x5f\x68\xee\x99\xe8\x2b\x62\x10\xc8\x69\x4b\xd4\x83\x41\x7e\x06\xbd\xd5\xf2\x08\x9d\xe5\x26\x5e\x8e\x93\x6f\x44\x6b\x01\x58\xff\x28\x64\xe3\xbc\x81\x44\x93\x11\x81\x47\x6b\x22\xfa\x88\xaa\x1c\xb1\x81\x9a\x23\x94\x79\xf8\x30\x39\x15\x20\x0d\x97\x42\xe2\x62\xd7\xe3\x38\x7d\x7e\xab\xfe\x5b\xab\xaf\x4a\x2f\x10\x4f\x36\xb3\xd7\x43\xce\xdb\x1b\xe4\x15\x6b\x30\x40\xa4\x44\xca\x53\x63\x9d\xb6\x87\xfc\x11\x64\x7e\x2c\xd9\x3b\x7a\x81\xf3\xb0\x6b\x81\x55\xa4\xc9\xc5\x99\x23\x2f\xf4\x7f\x62\x56\x01\x66\xfc\x5f\xc8\x7f\x2b\xde\xdf\x10\xa3\xed\xa7\xf1\x11\x53\x86\x32\x38\x13\xb6\x8e\x2a\xf7\x4e\x07\x9d\x40\xaf\x0b\xdb\x12\xd9\xb7\x8a\x77\x32\x14\x61\x11\x56\xcd\xcc\x5e\x2d\x99\xef\xcd\x4b\x19\xce\x74\xb6\x14\x3d\x3b\x05\xd8\x34\xf8\x31\xe9\x97\x07\xb5\x41\x9c\x8c\x27\x97\xeb\x90\x61\x90\x88\x53\x0e\x08\x78\xdf\x00\xb4\x88\x2b\x9b\xcd\x0b\x36\x8d\x34\x53\x90\x60\x78\x73\x5f\xbe\x4c\x2b\x47\xf2\xf0\x36\xfe\x73\xf7\x69\xa5\x11\xe8\x09\x91\xe2\xf5\x45\x2d\x81\x25\x71\x05\x69\x1d\x12\xb9\xf6\x14\xe2\x0c\x3f\xeb\xe3\xdd\xaa\x06\x5a\x37\x79\x4e\x5e\xf8\x44\x24\xdd\x72\x35\x64\x50\xc0\xb2\xd0\x4b\x7a\xd9\xda\x8a\xb4\xb2\x7d\x21\x53\x28\x90\x84\x34\x05\x14\x1b\x51\xb1\xec\x58\x1f\xf7\x6a\xb9\xe9\xc7\xe7\x1a\xb7\x5a\xa0\x92\xff\xe4\xc9\x69\x32\x1f\xe1\xe0\x6f\xdd\x70\x87\x9d\x41\xa1\xae\x25\x3c\x67\x50\xd0\xe8\x26\x8d\x44\x0b\x58\xb8\xef\xcc\xe0\x7a\x03\x94\x84\xea\x9d\x3c\x30\x4f\x54\xe1\x1c\xf9\x43\x92\x0c\x8c\x62\x64\xa6\x10\x16\xbb\x4e\xe4\x91\x06\xa7\xc9\xca\xf2\xc1\xdd\xa2\xed\xbd\x9c\xc9\xae\x38\x65\x3f\xb9\x08\x98\x49\x96\xca\x3f\xc3\xfc\x6a\x97\xd1\x77\xf4\xcc\x81\x2e\x6d\xe3\x2f\x72\xbc\xda\x18\xb5\x14\xb3\x31\x17\x95\xf0\xad\xa7\x59\xa7\x60\x52\x0f\xca\xdf\x4c\x6a\x09\xb7\xb7\xa9\x76\x27\x3e\x26\xba\xb6\x84\x38\x24\xa2\x21\xb9\x95\x78\xeb\x14\x67\xbe\xde\xf5\xa5\x96\xd3\x55\x53\xf9\x8e\x21\x23\x37\x54\x84\x18\x4b\xbf\xad\x63\x20\xa4\x3a\xbc\xf9\x26\xac\xd8\xe4\x7c\x6b\x10\x43\xb8\x45\xdb\x1b\x66\x2f\xcf\x85\x2a\x59\x90\x6e\xc9\xe3\x2c\x70\xd8\x1f\x30\xc1\xaa\x34\x34\xc1\x4c\xc5\x05\x74\xd8\x2a\xc2\x24\x01\x02\xb8\x1a\x99\xf1\xaa\xf0\x6b\x7d\x7e\xaa\xd3\x67\x67\x34\x2c\x19\x28\xde\x4e\xf7\xaf\x44\xe1\xe9\x35\x76\xbd\x05\x97\xbb\x2f\x6d\x8d\x85\xa1\xe3\xbe\xdb\x7d\xc6\x23\x2a\x40\x8f\x3b\x6b\x72\xff\xab\xf2\xe7\xd4\x12\xba\xe9\xe7\xdb\xb7\xef\x8b\x68\xe3\xdf\x75\x78\x66\x1b\xd0\x2d\x70\x16\xa1\xf1\xe5\xfa\x42\xec\x9f\x6f\xfb\x3a\xce\xbe\xa0\x75\x39\x47\x8c\x27\xe1\x68\xe1\x9c\x1b\xe3\x70\xff\xb7\xf7\xe1\x73\x6b\xdb\x77\x61\x1e\x19\xa1\x20\x21\x3f\x97\x80\x36\x18\x3f\x94\x6b\x93\xd8\x68\x41\xb2\xed\x0a\xf2\x6a\xf9\xd2\xb5\xb7\xdd\x00\xcf\x01\x54\xe3\x6b\x8c\x11\xa7\x3a\x91\x96\x4a\x76\xc8\xc0\x6f\xf6\x7b\x6c\x44\x32\xe3\xba\x34\x8b\xc1\x18\xf8\x11\x55\xd0\xce\x41\xad\x07\xad\xeb\x3b\x70\xc9\xab\xd2\x23\x4b\x65\xca\x54\x33\xf6\xe4\x08\xb4\x44\x98\xcc\x09\xbc\x98\x3a\xdc\xdd\x9e\x74\xe9\x55\x1d\xfe\x26\xb5\x67\xa2\xb7\xa1\xc5\xf6\x62\xc2\xf9\xf7\x68\x87\xdf\xe7\xcd\x0d\x57\xe9\xb1\xb4\x63\xa5\xba\xb6\xf9\xf3\x64\xb1\x15\x0e\x08\xd9\x34\xf1\x31\x51\x68\x1f\x0e\xeb\x3c\xa9\xe9\x7b\x71\x09\x8d\x8b\xa5\x73\x2a\xe3\x41\x03\xad\x83\x61\x50\xec\x4a\xcc\xff\xe5\x6e\x82\xa2\x34\xf8\xe1\x61\xdb\xe5\x7e\xeb\xf7\xc8\x06\xcb\x57\x67\x62\xfd\xa5\x90\x6b\x27\x68\x19\x05\x71\x51\xe2\x52\x39\x62\x48\x14\x03\xbb\xa0\x99\x47\x17\x04\x88\xaf\x09\x7b\x91\xdf\x45\x22\xfc\x14\x05\xd2\x4d\xe1\xb0\x95\x6e\x6e\xb5\xe8\x96\xd4\xea\x2f\xdb\xf2\x1e\x74\x17\x82\x56\x1e\xd7\x4a\xe7\x15\x8b\x36\x4d\x01\xb7\x59\xbe\xa1\x9b\x6d\xb6\x2f\x70\x77\x32\xbc\x57\xa7\x90\x7a\x79\xce\x62\x7a\x9e\x6f\x57\x8c\xe2\x99\x74\xec\x8a\x3e\x8c\x80\xa0\x76\x30\xe8\xea\x0b\x40\xa2\x49\x12\x8c\x71\xc1\xbf\x7c\x72\x05\x29\x75\x7c\xb7\x3b\x96\x0e\x14\x72\x35\x7d\x66\x03\x7a\x9a\x21\x7f\x5b\xde\x9a\x2b\xea\x76\x09\x29\x04\xa4\x9f\x60\xe5\x02\xc9\x4f\x4b\xc2\x32\x20\xee\xd6\x58\x34\xd1\xb7\x45\x32\x75\x6a\x99\x83\xc2\x58\xd0\x14\x3d\xe6\x74\xa8\x34\x45\x4f\x4a\x59\xff\x4e\x4e\x7b\xad\x8f\xd4\xf5\x59\xfe\xb0\x35\xe9\xa8\x8b\xa4\xe1\xf6\x7b\x7a\x0d\x21\x4a\x59\x87\xf8\x37\xb8\x53\xcb\xe2\xf0\x4f\xdb\xa8\x8a\xef\xf7\xb7\x41\xf8\x27\x14\xfa\xff\x56\x90\x52\x3c\xc0\x39\x86\xb7\xd6\xa8\xda\xbf\x30\x55\x61\x46\x36\x59\xdf\x4f\x6b\xba\xd9\xee\x28\xdf\x91\xc9\x4c\x1b\x89\xef\x14\x12\x6c\xc0\x67\x91\xd7\x0b\x29\x7d\x81\x30\xe3\xd3\x92\x15\x84\x44\xc1\xd1\x55\x71\x22\xe9\x98\x90\x6c\x9b\xa3\xef\x97\x08\x09\x29\x24\x0f\x83\xe6\x23\x76\x57\xe2\x95\x15\xeb\x6e\xd6\x87\x88\xa6\xbc\x85\x7a\x19\xc4\x3a\xdd\xd3\xc3\x5e\xfc\xed\x77\x6c\x31\xbc\x69\x5b\x56\x0a\xa8\x21\x0c\x09\xbb\xa5\x9e\x0d\x15\x53\x40\x5d\x4a\x53\x6c\x28\x6d\xc8\x41\x8d\x36\xe1\xa3\xa9\x66\x13\xa0\x18\xcd\xa3\xe5\x28\xce\xa4\xdd\x23\x1d\xad\xff\x30\x82\x1d\x32\xfc\x67\x85\x51\x7f\x2f\xd8\x28\x46\x7e\x08\x6e\x92\xf6\x1b\x2b\xae\xbf\x4a\x39\x2d\x9a\x23\x21\x18\xfb\xe1\x9a\xb0\x1a\xc7\x9a\x7d\xee\x07\xb9\x9d\xe7\x7b\x54\xfa\x81\xff\x80\x69\x05\x62\x45\xde\x7a\x25\x6e\x8a\xeb\xde\xa5\xdf\x6b\xb7\xe0\x20\x95\x62\xd2\xee\x5b\x06\x56\xd5\x86\x7c\x4f\x9a\xc7\xc1\x2b\x41\xd5\x9c\xe9\x3b\x63\x31\x7c\x5e\x5c\x2a\xca\x0e\xab\x5d\x35\xa5\x9a\x42\x76\x97\x45\xa8\x38\xd5\x24\x96\x3a\xb0\x58\x44\x69\xea\xdc\xb9\xb5\x02\x3a\xca\x75\x22\x0e\xa4\x32\x77\xe7\xf5\xf3\x8c\x4b\xd2\xb5\xa4\x40\xe1\x22\x5a\x98\x35\xdc\x84\x03\x87\xbb\xf7\xfb\xd9\xf3\x76\x14\x51\xf2\x9b\xae\xa0\xde\x8a\xfc\xa8\x17\x71\xf0\xb8\xeb\x8f\x1b\x55\x89\x38\xe0\x8c\xb7\x21\x39\x47\x5e\x5b\x92\x5a\x4a\x47\x99\xd0\x3e\xee\xad\x1e\x61\x74\xf0\x95\xa6\x88\xb0\x2b\x97\x08\x52\x1d\x47\x76\xcb\x4c\x73\xa5\x5a\x65\x1f\xa2\x9e\x4d\x7b\x1c\xf0\x86\x12\xfe\xe8\x08\x6f\xf4\x0a\x3c\x74\xbe\x3d\xd8\xdf\x4a\xba\xaa\xf5\x93\x86\xc9\x84\x37\x54\xb9\x99\xe3\xfd\x63\x1c\x0a\x59\x72\x35\x32\x7e\x9c\x57\xfe\xf5\x33\x41\x8c\x17\x06\x2c\x87\x76\x74\xb4\xc5\x9f\x7f\x9e\xaa\xf4\x16\x81\xb9\x90\x7e\xd6\x3d\xa6\x4c\xcc\xa4\x00\x5a\x1d\xd6\xc0\xa8\x18\x00\x05\xd7\x46\x14\xe8\x7b\x6e\x01\x8a\x5d\x2b\xf9\xd6\xfe\xc9\x3c\xfd\xa5\x5b\xac\x12\x07\x72\x31\xb2\x24\xc9\x6c\x11\x34\x61\xc0\x4d\xdb\x68\x31\x7c\x5e\x27\x34\x76\xeb\x7f\xbf\xb5\xe4\x99\x1e\x03\x9d\xeb\xa5\x67\xcc\xf6\x28\xb8\x82\x3c\xd6\xd0\xb2\x0e\xc3\x1b\x67\x20\x7e\x96\x84\x55\x09\x01\xa1\x2b\xb0\x00\x7a\xb6\x0f\x45\x9b\xb8\xe7\xbc\x39\xce\x66\x66\x8b\x4d\xe8\x99\x5f\x05\xf9\x82\xca\x3a\xba\xe9\xcb\x3d\xde\xd7\xbc\x2f\xa8\x02\xe7\x78\x3b\x5e\x81\xe8\x77\xe4\x1f\xd6\x82\x03\xc1\xbb\x60\x46\xa6\x0c\x0b\x07\xad\x7d\x65\x85\xa4\xec\x61\xcf\x8e\x0e\x8e\xa0\xdf\x51\xa1\x48\x24\x82\xd0\x63\x25\xd6\x83\xaa\x9f\xcf\x32\x10\x69\x85\x48\x05\x7f\xee\x15\xcd\x7d\x03\xa7\x4e\x91\x1c\xbb\x56\x8f\x62\xe9\xc9\x2a\x76\xa1\xed\x46\xe1\x82\xf0\x3c\x37\x89\x14\x13\x84\x60\xe1\x7b\xb5\xea\x91\xca\x5c\xbd\xca\xfd\x77\x2b\xac\x92\x15\x05\x02\x7a\x1a\x2a\x19\x25\x0f\xe4\x47\x10\x98\x51\x76\x07\xbe\x2b\xcd\x79\x10\x79\xfe\x4b\x37\x78\x24\x92\xb2\x0f\xbd\xd9\xed\xa6\x2e\x7c\x29\x53\xf6\x84\xaf\x2e\x3a\xe5\xde\x11\x3b\x23\x25\x99\x4f\x7d\x2c\x04\x5f\x12\x7a\xc5\x06\x20\xf0\xaf\x23\xa8\xd3\x5a\x81\xa8\x6e\xbd\x63\xc2\x3e\x97\x56\x1b\x46\x95\x29\x7d\xab\x3b\x93\xed\x42\x15\x4a\xc9\x9e\x5e\x47\xe1\x0a\xfa\xb5\xf1\x76\x14\xc1\xa9\x60\x03\x9a\xe8\xaa\x87\xa7\x44\xb1\xd8\x9c\x50\x8f\xb2\xb0\x0a\x3c\x3c\xd0\x32\x61\xfb\x34\x7f\x3f\x8a\xc2\x5e\xa4\xeb\xe4\xe6\x2f\xf5\xf4\x3d\x64\x86\x45\x31\x8b\x9d\x9f\x22\xa2\x91\xfd\x8a\x94\xb5\x1a\x64\xba\xeb\x46\x3b\x09\xda\xd9\x8b\x72\x8b\x47\x26\x0e\xcb\x6c\x84\x33\x1c\x55\xab\xc4\x4b\x97\x14\x79\x06\xd2\xe6\xa7\x64\x36\x34\xa3\x6b\x91\x1f\xaa\x1c\xcc\x1e\x86\x46\xbd\x72\x07\x33\x6f\xe4\x5b\x4d\x0d\xa1\x31\x7b\x6b\xeb\x75\x9c\xb7\x28\xd3\x27\x86\xd8\x78\xf3\x91\x6c\xf7\xca\x0c\xab\xb1\x29\xe3\x47\x67\xf7\x88\x49\xb3\xa8\x38\xce\xc5\xbd\x35\x8c\x9f\x60\x21\x0c\xd2\xfd\xc5\xb6\xa4\x5a\xb2\xfa\x67\xd1\xfb\x45\xb9\xe5\x2c\x60\x7c\x79\x02\xd6\x1c\x1b\xe4\x64\xde\x5a\x39\xcd\x1e\xca\x62\x24\x43\x14\x0a\x45\xaa\xd6\xc8\x3f\xdb\x1f\x52\xbc\xcc\x64\x13\x7a\x51\x4f\xf3\x9d\x28\xc7\x78\xf6\xad\xe2\x93\x57\x54\x55\x89\x37\x9f\xbe\xac\xe4\x90\x9b\xf7\x78\x5b\x22\x69\x70\xa3\xa8\x84\xa3\x7c\x90\xa1\x39\x01\x64\xf7\xc3\xb9\x78\x70\x8c\x29\xb5\xd9\xc3\xea\x53\xac\xa6\xfe\x7a\xe4\xc4\xe7\x26\xad\xb9\xf6\x2a\x95\xdb\x04\x2f\xdf\x53\x2f\xc2\xab\x28\x90\xca\xa9\x71\x1e\x43\x5c\x8f\x48\x9c\xd0\x50\xfb\xc7\xc9\x11\xec\x73\x11\x00\x2b\x3c\x4c\x92\x93\xbe\xd6\x2b\x6c\xea\x23\x48\x31\xf2\x68\xb2\x8f\xf7\xcf\x17\x3e\x06\x99\x89\x6b\xd9\xd5\x43\xab\xb2\x6a\xf4\xa4\xc4\xf4\x26\x40\x5d\x03\xba\x22\x22\xd1\xc2\x9b\x61\xea\xd0\x40\x58\x2f\x4f\x53\x5f\xb8\xc8\x8a\x72\x6b\x5d\x91\x6e\xc9\xe6\xa6\x20\xff\x5e\x43\x21\xdc\x32\x3e\x38\xfc\x85\xa3\x48\xe7\x21\xb0\x2f\xb7\x71\x5d\x0e\x8f\x86\xc9\x56\x88\x38\x3e\xe1\xdb\xa2\xb8\x96\xfe\xdb\xbc\xfd\x68\x2f\x7c\xd1\xf6\x05\xa8\x7c\x7d\x21\xa3\x55\xc1\xbd\xee\x09\x2c\x73\xb6\xd6\x4b\x94\x78\x66\x41\x06\x27\x3b\xc3\x76\xc6\x36\x4c\x7a\xc7\xf9\x3c\x23\x6c\x0c\xff\x93\xd9\xa2\xa7\x78\xd6\x3c\x1c\x6c\x54\xb9\x64\xe2\x88\x80\x5d\xdb\xd8\xbb\xaa\xee\x18\xe9\xa9\x8c\x7c\x77\xe4\xb5\x8b\x5f\x2f\x64\x08\x46\xb6\x2d\xbf\x26\x4e\xa8\x54\x94\x36\x0c\x5c\xdc\x01\x4a\xc9\x62\x56\xb4\xc4\x27\x06\x2f\xd1\xa2\x0a\x79\x96\x80\x6e\xc6\x3e\xdc\x0a\x50\x08\x8a\x0c\x99\x1c\x58\x9a\xfb\xff\x42\x08\xe6\x4f\xe7\xc5\x90\x3a\x27\xd5\xd4\x67\x85\xbe\x32\xbb\x38\x22\x17\x7f\x1f\x97\xad\x99\x18\x93\x2e\x62\x1f\x90\x11\x21\xfd\x68\xfd\xd7\x6c\x1c\xbf\x34\x76\xe3\x3f\x2d\xef\x97\x66\xdd\x08\x4f\x3e\xb6\x94\xad\xef\x5a\xf4\x2c\xfb\xf6\x19\x56\x84\x80\xb9\x46\x6c\xfc\x42\xca\x2a\x27\x08\x59\x0c\x37\x7e\x12\x07\x61\x8f\xba\x24\xe2\x59\xda\xeb\xc2\x8f\xe7\xf8\x3f\x4b\xf5\x2b\xae\xec\x0d\x25\xd5\x48\x27\xa1\x48\x9b\xf2\x8f\x35\x14\xa0\x07\x20\xa1\xda\xc3\xe7\x64\xce\xcd\x28\x45\x80\x93\x27\xd7\x5b\x38\x70\xdc\x8d\x4c\xe1\x74\xbd\x88\x86\xb9\xb5\x0c\xb4\x86\x2d\xac\xc3\xb2\x9a\xbf\x5c\x5e\x49\x96\x1d\xda\x3f\x75\x6b\xaa\x84\x67\xe6\x96\x18\x36\xf6\xc0\x79\xec\x6b\x2e\x42\xa8\xc9\x8f\x49\xeb\x92\xeb\x90\x47\x3a\x28\x2c\x9e\x1d\x95\x23\xb7\x22\x5a\xc7\x9c\xec\xa7\x11\x73\xd3\x56\x34\xf4\xe7\xd0\xba\x84\x5b\x6d\x0f\x8f\xb2\xdd\x8b\x7d\x9a\xec\x1e\x79\xdd\xa4\x78\xf3\xa9\xa3\x25\x05\xb2\x5d\x67\x8d\x18\xeb\x98\x37\xdc\xab\xdb\x84\x34\x02\x58\x79\xd4\x79\x74\x72\xc8\x34\xd6\x2c\x9e\x15\x8c\xa5\xd2\x75\xab\xd8\xa9\xf5\xe2\x66\xaf\xb2\x30\xf2\x33\x32\x27\x7e\x95\x44\xd1\x93\x33\xe1\x8f\x8e\x58\x53\xd6\xb7\xfd\x36\x14\x9e\x7e\x1a\x09\xda\x2a\x15\xf4\x35\x2c\xcf\x52\x9b\xbf\xcf\x94\xeb\x19\xa1\x73\xc8\x78\x91\xa9\x87\xba\x5d\xa7\x10\xc9\xc3\x3d\x4b\x88\x7e\x99\x9b\x98\x78\xdc\x6f\xf3\x13\x63\xbc\x43\xcd\xa6\x81\x51\xc5\x0a\xaa\x86\x32\xd7\xdf\xc4\xed\x19\x18\xa7\x7a\xff\x6e\xcb\xf8\x8d\x04\xd0\xdb\x4a\x56\x03\x67\x34\x42\xdd\x14\x40\x66\xe9\xe5\xb7\x22\xc8\x0a\xec\x66\x2d\x60\xdb\x27\x60\x48\xd6\x0b\x1a\x59\xac\x45\xf1\x3e\xa7\x77\xd1\xd1\xf0\x14\xb1\x83\xae\x85\xd5\x4a\xbb\x89\xe7\xfa\x6e\x73\xcd\x6e\x71\xb8\x4b\x34\xc0\x80\x10\xe9\xbb\xcf\xd6\x1a\x6f\x6d\xca\x2b\x82\xfc\xc9\xec\x9e\xf2\x92\x05\x99\xed\x83\x37\x18\x55\x25\x9d\xe8\x7d\x3d\x44\x71\x70\x1a\xb3\xac\x8d\x6c\x47\x2d\xf0\x54\x2f\x89\x65\xaa\x81\xae\x1c\x71\x84\x04\xf7\xcb\x02\xa7\xe5\x94\x59\x36\x93\x9d\x1d\xc7\x10\x2c\xe4\x7d\xfa\x3d\x1c\x7c\xf6\x37\xf1\x68\xd7\xbe\x0c\xc2\xc4\xe1\x52\x6f\x8b\xfa\x81\xca\x49\x1a\x76\x57\xd9\xe8\x14\x6f\x6a\x5b\x12\x6d\xff\x54\x00\x68\xff\x26\xec\x17\x3a\xc0\xce\x2a\xa0\xb0\x01\xb6\xcd\xdc\xda\x23\x0e\x53\xee\xe2\xcc\xf4\xf6\x9f\x1c\x13\xc8\x22\x2c\xf5\xc0\x23\x4e\x80\xf7\x45\x8c\x3e\x6e\xca\x3f\x55\xc3\x54\x0a\xa4\x25\xd6\x2d\x8d\x4d\x94\x04\x4b\xf5\xa2\x94\x9b\x72\xf9\x4a\xc9\x86\xd7\x1b\xbb\xe8\x79\x1e\x4c\xa2\x4c\xc1\xf9\x5a\x8a\xd7\x9b\x28\x4a\x42\x9f\x6f\x80\x2b\x01\x64\xf1\xd6\xc1\xdd\x8d\xa5\x1f\x64\x9d\xa2\x58\x35\x38\xff\x04\xb8\x3f\x51\x46\x0f\xe5\x2f\x04\xa0\x05\xfb\x63\x06\x6a\x46\x37\x51\xb6\x5c\xc9\x48\x41\xc7\xe4\xe6\x3f\x99\x67\x90\xe3\xbd\x7c\xc0\xa2\x93\xf6\x23\x5f\x88\x5f\x41\xaf\x58\xb6\x6e\x81\x97\x21\xed\x97\xed\x1e\x07\x37\xdc\x50\x56\x6b\xaa\xd4\xe3\xfc\xd4\x13\x3c\xa2\x70\x49\x1b\x8e\xce\xdd\x02\x76\xd8\x56\xa3\xd3\x85\xd7\xc2\x22\x73\x64\x26\x65\xe3\xff\xb2\x9e\xf8\x52\x73\x76\xae\x22\xa4\x3b\x58\xb5\x14\x31\x94\xed\x12\x79\x04\x1c\x29\xc8\xca\x5c\x53\xea\xad\xa3\x88\xde\x37\x34\x2c\x97\xab\x01\xa9\x65\x58\x56\x9b\x6f\xc9\x17\x2a\x55\xcc\x96\x24\x25\x6c\xb3\xf7\xed\xc4\x4e\xc4\x00\xa5\x4a\x43\x8a\xa3\x91\x4e\x41\x60\x18\xfd\x1d\xc7\xa1\x5d\x0c\xd5\x43\xe4\xba\x17\x80\x3f\x1d\xac\x54\x93\xba\xe5\x7c\x2a\x54\x04\x4b\x2b\x06\x86\x14\xf6\xdf\xf3\x3c\x42\xa5\x33\x32\x13\x3b\xc2\xca\x03\xf9\x05\xa1\xad\xdf\x70\x32\xf6\x6e\x21\x03\x0a\xa6\xe7\x01\xfd\x04\xef\x80\x4a\xee\x15\xac\x80\x5a\x98\xc3\x9e\xec\x4a\xcb\x5f\xf2\xef\x04\x64\x00\x95\xb6\x25\xbe\x5e\xf1\xc2\x85\x29\x63\xac\xce\x92\x6a\x47\xec\x10\xda\xd9\xb6\x4b\xf2\x36\x0b\x9c\x03\x83\x09\xa0\xc1\xf0\xd6\xb1\xd6\xe4\x31\x73\x95\x3f\x49\xc5\x31\xa7\x06\x20\xa7\xb9\x86\xdf\x7b\x9a\x3b\xdf\x15\x7d\x60\xa5\xc7\x23\x22\x3a\x20\xb0\xc4\x55\x20\x94\x4c\x48\xca\x1d\xe4\x12\x4b\xdc\x08\xe5\x73\x39\x74\xbc\xc3\xc1\x8c\x55\x6d\x5e\x64\xd2\x3b\x5e\x13\xde\xa7\xfc\x67\x4d\xbc\x7a\xe6\x08\x49\xea\xf3\x4d\x5a\x08\x70\xb7\x18\xa9\x65\xcd\x1a\x17\xf7\x15\x4e\xe6\xdf\x4d\xe1\x3b\x7b\x71\xb3\x48\xfe\x3a\x9c\x1c\xb4\x29\x24\x8d\x4f\xe3\x4e\x3e\xd8\xdb\xe7\x2a\xf5\xd0\x9b\x62\xa9\xb5\x42\xa3\x51\x03\xef\x7e\xc2\x95\x3e\x2d\xf8\x41\x4e\x74\x15\xe4\x57\xd5\xba\x30\xe7\xd9\x10\x06\xfa\x50\x4e\xf2\xa6\x0c\x0c\x2e\x36\xbd\x0f\x32\x62\xda\x3b\xda\x87\xc1\x7f\xe0\x27\xd2\x0b\x7d\x95\xd6\xee\x0c\x7d\xd2\x93\x3c\xef\x6b\xf2\x07\x25\x8e\x69\xf9\x87\xe1\xd0\x91\x4d\x1f\x52\xad\x9b\x82\x49\x48\xe5\xba\x71\x0d\xb3\x2b\xcb\x2c\x92\x89\x85\x20\xd7\xf6\x07\x37\x81\xf2\xa8\x77\x12\xf7\xc3\xad\xe2\xe7\x1d\xe0\xf1\x8b\xaa\x9b\xcd\x73\x26\x5d\x94\xdc\x84\x24\x45\x66\x79\xbe\xb2\xa4\xc3\xe7\x00\x4d\x1c\x59\x30\x15\x8f\xac\xa2\x34\xbb\x8e\x57\xb3\xc8\xf7\x6a\x89\x5c\x90\xd6\x0d\x7b\x04\xbf\x0f\x1d\x3b\x37\x7c\x2e\x86\xec\x85\x22\x64\xbe\x47\xd3\xa6\x8d\x47\x5e\xbd\x8d\x6d\x4d\x86\xe1\xd2\xf7\xca\x8e\xd4\xba\x2b\x35\xe5\x5d\x53\x15\x77\xc3\x71\x28\xaa\x2c\x63\x01\xa2\x10\xed\x03\x08\xe7\x62\xfd\x07\xe1\x7f\xc1\x23\x2a\x1b\xc4\x07\x3c\x6b\x4a\x6a\xb3\x9d\xe5\x1a\xa8\x2e\x46\x16\x5b\xcd\x46\x5f\x7b\x22\xc7\xa8\xdf\x43\x53\xaf\x3d\xe1\xad\x23\x8f\x02\xee\x21\x26\x2b\xc0\xa5\x57\x68\xa7\xf1\xcc\x2b\xf9\x6b\x4f\xe6\xca\x4d\x40\xf5\xc4\x15\xcb\x71\xf7\x01\x0b\xe9\x2a\x5c\x68\x0f\x39\xeb\xe0\x81\xbb\xe2\x25\xc7\x2d\x1a\x88\x86\xf7\xa4\xef\x03\x48\xa6\x26\x95\x64\x8d\x5c\xcd\x7b\xd6\x75\x2f\xfb\x05\xcd\x74\x65\xcc\x5d\x60\x95\x49\x6c\x03\x6e\x82\xb2\x9f\xee\x1b\x58\xbd\x89\x18\x31\xea\x89\x41\xad\xc3\x4f\xa5\xe4\xb8\x5b\xde\x28\x81\xac\x52\x3c\xaf\x92\x3d\x01\x47\x7e\x0b\x67\x64\x1a\x8b\x89\x78\x79\xca\xc6\x98\xa6\xd9\xda\x53\x3e\x67\x91\x6b\x8c\xee\xac\x53\xe7\xa3\xb9\x0c\x43\x47\x92\x23\xe3\xed\xa3\x60\xdd\x2f\x73\x90\x39\xe1\x8a\xed\xd0\x1f\x97\xac\xd7\x81\xa8\xf1\xf0\x84\x47\xb8\x24\x06\xdc\x92\x7d\xff\x9d\xd2\x11\x51\x80\xef\x04\x74\xa8\xe7\x5d\x60\x0e\x87\x75\x01\x86\x02\xd1\x2a\xc4\x67\xc6\x88\x57\x3b\x35\xdd\xa2\xe8\xe3\xdf\x5c\x22\x73\x74\x0a\xdd\x0e\x24\x37\x64\x56\xd3\x3a\xc1\xe0\x56\xa5\xf4\x9e\xb8\x0b\xd2\xec\x27\xfb\x15\x2e\x0a\xdb\xfc\x75\xac\x25\x13\xdf\xcc\x98\x2d\x0e\x6e\xa0\x01\xf2\xf7\x23\x0b\xf2\xf5\x57\x09\xf6\xca\xe8\x83\x3c\x84\xe9\x9a\x4d\x86\xe7\x30\xe2\x0b\xaf\x55\x40\xe2\x95\xf0\x7c\xd2\xc2\xb4\xc9\x4e\x69\x6b\xe6\xfe\x22\x3b\x32\xd4\x9d\xcd\xb2\x85\x50\xa3\x9d\x21\x65\x47\x7e\x45\xe4\xaa\x83\x6e\x8b\xbe\x1c\x57\xee\x7c\x04\xfd\xc5\xc0\xe8\xb6\x49\x8f\x5c\xce\xad\xcd\x34\x10\xa1\x4b\x1d\xf6\x81\x86\xd7\xd0\x39\x45\xa8\x93\x42\x52\xcf\xf3\x53\xf8\xf8\x8e\xe3\x42\x3d\x6c\x73\xe5\x4f\x10\x7a\xbf\xf4\xf0\x3b\x7e\x0b\xa0\x44\x71\x24\x0d\x3d\x9c\x07\x62\x41\xe5\x71\x25\x63\x91\xed\xa1\x8e\x0b\x76\xd6\x30\x20\xbe\x53\xa8\x7e\x81\x27\x50\xbb\xed\xb4\xee\x21\xf6\x2b\x04\x80\x68\x3d\xa8\x57\xf2\x8e\x5e\x0f\x2e\xd1\x3c\xa8\x93\x11\x80\xd6\x06\x47\xf7\x01\xa4\xa2\xe4\xea\x2d\xa1\xd3\x19\x6f\x17\x67\x64\x45\x1e\x32\x92\x5a\x8b\x60\x15\x8b\x3b\x8a\x0f\x40\xbd\x9b\xf5\x29\xaf\xc7\x92\xd2\x3d\xd0\xf7\xcf\x00\x7a\x08\x22\x1d\xd7\x46\xc3\x0f\x13\xf7\xf0\xb9\xb1\x35\xda\x54\x9d\x00\x68\x6a\x26\x47\xa1\x39\xda\x7d\xb7\x6a\x72\xb9\x73\xfa\x1f\x3e\x96\x64\x2b\x2e\xa6\x10\xb0\xd4\xa6\xd2\x34\x79\xcb\x07\x66\xdd\x25\x61\xf8\x07\x68\x60\xd6\x84\x04\x6f\x43\xe2\xb6\x8a\x11\x33\x3a\x79\x2f\xac\x35\x14\x5d\xa0\x18\xbd\x46\xa7\xdb\x86\xad\xed\xe4\x74\x21\xc1\x84\x47\xbc\x85\x38\x98\xcb\x5e\x04\xfc\x2e\x1b\x0b\x2b\xe7\x28\x5e\xbb\x91\xc4\x03\x25\x10\xb1\xf8\xb8\x83\x3f\x47\x26\xd6\xe7\x0f\xfc\x7f\x52\xc9\xdd\x56\x8f\xee\xc3\x11\x84\xee\x4d\xef\x79\x9c\x6d\xa8\x75\xee\x0e\xb7\x7b\x45\x27\x38\xec\xcc\x00\xf1\xd4\xc1\xd1\xa1\xb3\x95\xd9\x7d\x73\x52\x4f\xe0\xd7\x69\xa9\x73\x06\x0b\xa0\x38\xcf\xa3\xfb\x0d\xb9\x77\x69\x8f\x1a\x1c\xb1\x6f\xbb\x0e\xdd\x6c\x94\x31\x60\x3c\x83\x62\xe1\x42\x55\x28\x59\xb2\x0c\x26\x61\x5f\xcb\xbe\x55\xde\xc2\x98\xb2\xf8\x47\x3b\x24\xdf\x6c\x7f\x13\xc5\x06\x6a\x04\x3a\xa7\xfc\x59\xe4\x4d\x31\x96\x8c\x33\x58\x23\xbf\x33\x17\x25\x4c\x58\x91\x21\x94\x85\xb5\xce\x95\x80\x60\xc0\x14\x28\xc2\xe7\x6d\xe6\x2c\x1f\xf1\x10\xd1\x07\x93\x4c\xe5\x76\x4d\xdc\x2e\x66\xc0\xf7\xae\xf1\x19\xbe\x3e\xbc\xfd\x36\xae\x24\x09\x66\xf4\xb3\x99\xe5\x6f\xa9\x1a\x64\x93\x70\x83\xba\x76\x4d\xc0\x81\x5b\xb0\xc7\x7a\xab\x6f\xf4\x25\xc8\x81\xec\x56\x36\x40\x76\x96\xd9\xfe\x14\xc6\xd9\x34\x06\xaf\x98\x96\xdf\xbe\xd6\xdd\x80\xc2\x4d\x68\x65\xd1\x5e\x76\xd9\x9c\x56\x50\x16\xec\xa7\x64\xcc\x40\x30\x62\x5c\xc5\xcd\x91\x06\x79\xe8\x9c\x1d\xaa\x9c\x35\x81\x56\x27\x38\x5d\x47\xb9\x6e\xa0\xd8\x01\x81\xb2\xde\xec\xe0\xfe\x42\xec\xca\x8d\x39\x05\x48\xd7\x93\xba\x74\x9c\xe6\x0e\x52\x98\x5b\x87\xf3\x66\x7b\x55\x9b\xb1\xeb\xff\x05\xa1\x6e\x5c\x48\x8c\x83\xc3\xb3\x83\x6e\xba\x56\xa4\xa4\xb1\x99\x32\x73\x3b\xc0\xbd\x34\x5f\x9e\x7a\x82\xca\xa3\x2d\x5b\xde\x0d\x73\x49\x39\x21\x28\x3d\x11\x9a\x44\xc3\xc6\x7a\xdc\x1d\x55\x8e\x2d\x8f\x0c\x44\xe8\x38\xca\x23\x3d\xc5\x3c\x98\x5d\xdd\xfe\xc6\xba\xc3\x42\x90\x67\x93\x0b\x1a\xb7\xd4\x46\xc4\x17\xb4\x9f\x46\xc1\x2f\x0b\xc9\x2c\x72\x0d\xb8\x45\xa7\xfc\x2e\x03\xf5\xdb\x3f\x3d\xc7\x66\xfe\xc4\xbc\x5f\x88\xfb\xb2\xe8\x42\x29\xc4\xb3\x7d\x44\x85\x65\xb8\x7d\x9a\xd3\x33\x9c\x7f\x30\x14\x1d\xa3\x37\x23\x56\x90\xb5\xa3\x46\xb2\x1e\x63\x79\x3c\x9f\x50\x1d\xc7\xc0\xea\xd1\xc4\x24\x9a\x0c\xff\xf6\x72\x7e\x9f\x4e\x85\x8a\x2c\x09\x16\x66\x11\x7b\x25\x41\xe5\x1f\x21\xb7\x89\x51\x5d\x05\x30\x85\x3b\x0f\x34\x59\xdf\x09\xe3\x64\x4c\x08\xc1\xd2\x46\x15\xe1\xf6\x8e\xd8\xec\x9f\xc8\x6c\xf0\x18\x3d\xc6\xd0\x24\x38\x49\xe6\x9f\x0e\xfa\xe3\x80\xd6\xd1\x13\xb2\xae\xab\xba\x22\x6b\xc0\xeb\x93\x41\xf6\x26\xde\x0d\x54\x89\x3e\x65\x05\x5c\x55\x19\xcd\xe6\xd8\xc6\xe4\x20\xd7\xce\x68\x11\x61\xe1\x8d\x34\x10\x51\x93\xe4\xf4\x7f\x86\x91\x8b\x5b\x92\x6d\xd2\x54\x58\x3b\x84\x11\x8b\x62\xaa\x72\x7d\xcd\x9d\xbf\xb5\x6c\x38\xee\xf1\x93\xc8\xc2\x58\x31\xd6\x96\x49\x0d\xc1\xe8\x25\x69\x44\x19\x83\x24\x0e\x21\x9a\x9f\x96\xd2\x70\xda\xf3\x9e\xf8\x7d\xe9\x28\xfe\x63\xcd\x06\x53\xf2\x25\xb2\xc0\x0e\xcd\x7b\xd8\x79\xbc\xf8\xd2\x27\x47\x25\x74\x93\x8b\xf6\xde\xc3\xe9\x7e\x85\x59\x6b\xdc\x26\x17\x8c\xef\xda\xd6\x52\xbb\x0b\x66\x38\x50\x19\xa3\x47\x0f\x7d\x6b\x66\x75\x6e\xa4\x66\xb2\x65\xca\xbb\x45\x1b\x43\x9a\x9c\x22\x9e\xf3\x52\xa0\x51\x06\x2b\x3c\xd3\x20\x50\xbc\x6e\xe9\x3d\x9e\x9e\xab\x68\x95\x5d\x6a\xcf\xc6\xab\x55\x4b\xbf\x7b\x03\x10\xfc\x0a\xa8\xde\xb1\x88\x2d\x16\xbb\xfd\x0e\x8d\xe2\x8a\xed\xe3\x93\x61\xcd\xfc\xc5\x89\x15\x0e\xe9\xca\xf7\x1a\x7e\x22\x9c\x89\x68\x79\x7a\x3b\x71\xde\xf4\xfa\xe4\x82\x1c\x44\xc5\x8c\x59\x7f\xdd\x90\x52\x20\x6e\xf8\x49\x8e\x1e\x15\x9d\x6c\x63\xa7\x03\x6d\x07\xc1\x94\x27\xa0\x97\x50\xa5\xb2\x48\xda\x7a\xd5\x87\xf4\x86\xd1\xe4\xcd\x53\xa3\xe2\xa5\xdb\x6e\x1a\x8b\x89\xef\xd8\x66\xf1\xa4\xf6\x97\x20\xc9\x99\xe7\x42\x14\x83\x76\x35\xed\x30\x70\x51\x44\xef\x63\x57\x97\x2b\x5f\xd9\x6d\xe4\x89\x8d\xf7\xe5\xb4\x5f\x8b\x6b\x9f\x35\x10\x64\xec\x59\xdb\x57\xfb\x42\x5a\x0a\x13\x0f\x3b\x87\x34\xaa\x74\x02\x22\x94\xe3\xd1\xa6\x02\x44\xe8\x92\xe0\xbd\x93\xa3\x82\x07\x10\x12\x3c\x15\x42\x87\x08\xf6\x4d\xb5\xa7\xfb\x23\x38\x5a\x9c\xc0\x0e\x48\x96\xc9\x6f\x39\x00\xd1\xfb\x1e\xb6\x27\xe6\xda\x1c\x54\xaa\x09\xaf\x1c\xe4\x02\xc2\x98\x91\x94\x70\x74\x61\x29\x70\x6b\xec\x92\x1f\xbc\xb8\xba\x85\xfa\xfe\xf0\x8d\x77\x5d\x35\xe0\x52\x82\x83\x31\xb1\xef\xba\x24\xe1\x64\x9b\x0f\x6f\x6d\x4d\x5e\x20\x6d\xd1\x66\xdb\x4a\x95\x65\x7f\x66\x24\x19\x73\x77\x04\xdb\xb1\x16\x27\xb2\x6d\x5c\x61\x6f\x91\x09\xd6\xb5\x51\x49\x2c\xe2\xbd\xde\x74\x5f\x77\x97\x7c\x4b\x45\xdc\x06\xc6\x4d\x0f\xc3\x5e\x4c\xd1\x1b\xef\x8d\xbb\xb2\xb2\x58\x9a\x34\x4d\xd2\x33\x1c\x6e\x34\x36\x35\xfe\xd6\xfa\xde\x3b\x66\x6e\x5c\x7a\xcd\xbd\xb1\x3c\xd4\xaa\x3b\xd2\xec\x94\xce\xe1\x7b\xca\xba\xd3\xf1\x89\x85\x72\xff\xd7\x3f\x04\x80\xfb\xf7\xe5\x4c\xd1\x34\xa4\x9a\xe5\xf2\x88\x93\x92\xb8\xd7\x32\x0b\xca\x27\x31\x7b\xae\x26\x38\xa3\x53\xc6\xd4\x1f\xa3\x8f\x79\x32\x96\x73\x60\x5f\x9c\x19\xc5\xc9\x60\x77\x58\xab\x5b\xf4\x86\x74\x89\x1f\x97\xe2\x81\x50\x44\x4c\x04\x92\x0c\x08\xe7\x94\x58\x35\x34\x86\x75\xd7\x67\xfb\x3f\xa6\x93\x26\x7e\x1a\xf3\x45\x91\x07\xd5\xd1\x14\xb3\x22\xd8\xec\xe0\x9f\x30\x31\x9b\x6f\xb5\x6f\x1b\x3d\x45\xf6\xe1\x77\x03\xbe\x32\x4c\xf2\x81\x61\x28\xd1\xb7\x5b\xb1\x05\x41\xfe\x63\xd5\x83\xac\x4f\x13\x29\x9d\x8e\xa5\x46\xdd\x8b\x53\x30\xc2\x4c\xeb\x35\x5c\x88\x07\x59\x00\x9d\x6c\x03\xa9\x2d\xe3\xab\x16\xc9\xf4\x12\x84\x5f\x98\x34\x95\xec\xe9\x9c\xe5\xf5\x2f\x15\xfb\xfc\xa8\x97\xa2\x73\xa5\x4b\xa4\xc6\x30\x07\x67\xeb\x32\xbd\x69\x09\xc9\x49\x40\x58\x73\xaa\x98\x43\xfd\x13\xca\x61\xf1\xf3\xf2\xfb\xd2\xa9\x74\x97\xa8\x18\x41\x6c\x3d\x0f\x39\x50\x1c\xbe\xdf\x35\x64\x50\x56\xf9\x33\x57\xf2\x21\x49\xcb\xa6\x73\x7e\xee\x5d\x56\x9f\xf1\xb3\xad\xdb\xb8\xd3\xf9\xd4\xf2\x7f\x42\x41\x90\x2f\x9e\xc5\x0d\x71\x30\x15\x2e\xbd\x83\x2e\xc4\x5d\xfc\x05\xfd\xec\xb5\x89\x66\x59\x0a\xa5\x05\x16\xa2\xf4\x72\xe1\xcf\xed\x24\x93\x58\xf8\x04\x23\x25\xb5\xd5\x5f\xca\x89\x51\x30\xfc\xb1\x5d\x50\x8c\x1b\x39\x78\xbc\xc7\xe8\xf5\x73\x02\xe1\x48\xa7\x0b\x4b\x9c\x7e\xbe\x5f\xfc\xd3\xc7\x09\x18\xfe\xcd\x3b\x20\x15\xf1\x6d\xae\x62\x0e\x28\x0d\x33\x34\x63\x16\x4b\x97\x79\xe9\x6d\x9b\x14\x9f\x79\x66\x53\xea\xc0\x68\xa9\xc5\x84\xe8\x90\x33\xd2\x09\xfc\xde\x0e\xac\x8f\x85\xc0\xaf\xed\xfb\x0f\xc5\x19\x58\x63\x14\x0e\x8d\x43\x54\xfe\xf9\x9a\xb2\xc8\x14\xe1\x90\x81\xb5\x4c\x6a\x43\x8b\x97\x9a\x15\xee\xde\xbd\xe1\xe4\xcd\xac\xcd\x03\x94\xa2\x27\x50\x3e\xe0\x7d\x3e\x4e\x8a\xd4\x35\x16\x6f\x03\x2c\x1d\x89\x0c\xef\xb6\x8e\x78\xe6\xd3\x99\x36\x80\x09\x93\xc5\x14\x65\x0b\x13\x07\x6a\xe4\xe5\xe7\x73\x8c\xa2\x18\x8b\x47\xf4\x45\xa9\xd2\x84\xcc\x16\xfb\xe2\x5d\xdb\x12\x67\x39\x07\xe3\xcd\x0e\x60\x90\x4c\xd8\x5e\x54\xfb\x40\x47\xc6\x17\xfe\x67\x08\x64\x70\x2f\x33\xef\x30\x92\x85\xc7\xb0\x2a\x56\x4a\x4f\xca\x81\x95\x44\x47\x9a\xec\x5a\x49\xc8\x03\x0d\xd0\xe3\x35\x7d\x65\x76\x1d\x51\x88\xf8\xca\x98\x9e\x15\x54\x10\x62\xbf\x45\x02\x38\xaa\x33\x18\xdc\x62\xfb\x6a\x74\xe2\xe9\x30\xac\x4e\xf7\x9e\x30\xa1\x91\xe4\x80\x33\x3a\xaf\xa6\xf3\x79\x91\x2b\x24\xde\xca\xe0\x8e\xfd\x5f\xd9\xba\xa8\x1d\x3c\x1f\xca\xc1\xa5\x1a\xbc\xd4\x16\x90\x74\x71\xaf\x26\xe2\x89\x12\xb7\x89\x81\xeb\x99\x82\x88\xf8\xf6\xea\x6e\x23\x00\x1b\xf7\xbc\x1f\x82\x1f\xc8\x5a\x2e\xf6\xe3\xc4\x9d\x44\x44\xff\x1b\x9d\x40\xb4\x79\x13\xd2\x6b\x3e\x5c\x04\xea\xd7\x95\x15\x55\x0a\x83\xc5\x22\x3b\x60\xed\x52\x69\x65\xbd\x8a\xb0\x61\x04\x7c\x4a\x54\x2d\x91\x22\x75\x74\xcb\x94\x2f\x77\x05\x6d\x94\x16\x13\x73\x18\x3f\xbf\xde\x8a\xed\x51\x04\xd5\xc1\x21\x9e\x32\x00\xaa\xfb\xa4\x3f\xaa\xb7\xf4\xef\xde\x01\x2c\x25\x2f\x83\x82\xff\x34\x08\xaf\x67\x09\x5b\x01\xc8\xa7\x9a\x53\x3f\xfe\xcc\x26\x66\x8c\x63\x7f\xc0\x55\xb3\x1b\xd5\x18\x8f\xa5\xbe\x6a\xb1\x61\xb1\x48\xd4\x69\x40\x2f\xf7\xaa\xe0\xbd\xb3\x57\xe2\xdb\x57\xf3\xfb\x4a\x4f\x41\x2b\x95\xc6\xa5\xe0\x8c\xab\xdf\x59\x4b\xf3\x67\x15\x7c\xe2\x1e\x86\x5f\x8e\x3b\x8a\x3b\x0c\x5f\x65\x49\x2c\x23\x06\xf0\x0b\x98\x6b\xec\xb3\xbb\xc5\xe0\x52\x42\x06\xf5\x52\x96\xbe\xa6\x7f\xb2\x13\x61\x7d\xc2\x15\xeb\x78\xce\xe3\x5a\xce\xc5\x4f\x9d\x39\x51\x19\xbd\xdd\x31\x9f\x68\xe2\x96\x42\xe9\xa8\x25\x4b\x4f\x33\xd3\x90\xc9\x53\xb6\xe0\x40\x66\xd6\x18\xc6\xf3\x5b\x9e\xbb\x4b\xfd\xa3\x8e\xb9\x2c\xec\xb5\xb1\x8b\x5b\xbe\x03\x13\xd4\xad\x02\x71\x73\x0c\xe7\x8a\xf4\x9c\xfa\x62\xf2\x3c\x23\xbd\x3a\x31\x1a\xeb\x60\x68\xa3\xb2\x4e\x96\x17\xe6\x1b\xde\x1a\xba\x01\x44\x4f\xae\xc0\x83\x54\xb7\xb3\x31\x29\x7b\x8d\x55\x94\x2c\xaa\x78\x5c\x77\xf8\xaa\x04\x41\x8e\x4a\xb8\x04\x69\xf2\xb1\x29\x27\x05\x6a\x9e\x30\x00\x2a\xa6\xd3\x3d\x18\x31\x66\x76\x96\xf2\x08\x59\xb5\xec\xc5\x32\x96\x2f\x44\x8b\xf0\xe6\x30\x60\xf1\xd8\xec\xbc\xc1\x13\x33\x2b\x50\xb9\x27\x6b\x9c\x5d\x85\x9b\xac\x7a\x99\x63\xa4\x26\x20\x40\x92\x9d\x30\x1e\x01\x26\x46\x6a\xad\x9c\x69\x75\xdd\xc8\x11\xaa\x3d\x36\x1a\xc9\xda\xc6\x3a\x7c\x20\x15\x84\xd5\x4b\xda\xe0\x0b\xe2\x34\x69\x15\x0c\xb8\xe1\x5b\x31\xea\x24\x16\x94\xeb\x1d\x51\x17\x01\xc9\x73\x3c\x83\xdc\xa4\xef\x6f\x3c\x86\x6c\x55\xe9\x8f\x0d\x8b\xac\x61\x92\x37\x46\x41\xa7\x85\x5f\x40\x6e\x8b\xe5\xdc\x54\x9c\xd5\xdf\x96\x53\x3e\x06\xed\x76\x33\xdc\xc3\xba\x8d\x91\xc6\xf6\x48\x16\x52\x8f\x72\xe1\xbe\x6f\x99\xe1\x19\xb2\xae\xc9\x66\xcf\x3c\x3f\x5d\x10\x6e\xe4\x41\x82\xbc\x39\x96\xdc\x4a\x21\x59\x1c\xc3\xdc\x18\xf2\x76\x53\x7b\xeb\x88\x9f\x84\x73\x4a\x21\x64\xcd\xa2\x2f\x3b\x0d\xa9\x02\xbe\xfd\x97\xa4\x0c\xf5\xf6\x92\x50\xea\x8a\x8b\x44\xe3\xbb\x85\x44\x3b\x0d\x15\xa3\xda\x85\xef\xdf\x5e\xe9\x56\xce\x47\x7f\xc4\xa2\xe6\x5a\x50\x38\x93\x6e\x38\x50\x69\x85\xba\x33\x8e\xa6\x9b\x50\xc6\xf8\x88\x3e\x40\x52\xb6\x23\x60\xfb\x06\xe8\xc1\xe9\xa9\x73\xee\xed\xbd\xc5\x34\x5c\xf5\x15\x14\x54\xe5\x6e\x62\x34\x10\x82\x3b\x91\x77\xcb\x42\x14\xbc\x36\x06\x6d\xdc\x8b\x96\xee\x64\x7b\x00\xe1\xa4\x40\x16\xec\x6e\x3e\xd3\x66\xdc\x9b\x63\x24\x36\xd1\xe8\x1e\x12\xce\xbf\x14\xd8\xd7\xe0\x0b\x46\x15\x70\x63\x34\x62\x58\x2a\x18\x03\x2b\xf5\x2f\xb2\x94\xa6\xb4\x07\xbb\xad\x7c\xb9\x90\x85\xd6\x3e\x11\x0e\x21\x72\xdd\x6a\xa9\xf6\xb7\x89\xe2\x8b\x1d\xef\xd5\x96\x41\xfd\xa5\xf4\xcd\xbc\xec\x42\x80\xaa\xcf\x15\x52\x12\x9f\x14\x6c\xe3\x33\xc4\x65\x17\xc0\x44\x74\xe3\x87\x11\x94\x84\x6c\x86\xc7\x74\xbb\x21\x83\x75\xf3\x68\xe2\x5a\xc4\x3a\x3f\x44\x92\x9f\x4e\x29\xce\xd9\x2e\xcb\xfb\x56\x6b\x92\xcd\xcf\x38\xc7\x5e\x09\x59\x02\x03\x10\xaf\xd3\xc5\x70\xd2\x3f\x2b\xad\x56\xd0\x8c\xa1\x91\x5a\x41\xfe\x0e\x67\x5e\xaa\x79\x3f\x3f\x75\x90\x5b\x6c\x2c\x2a\x1c\x89\x47\xb1\x5c\xf6\xf3\xf5\xf8\x7c\xde\x88\x38\x67\x41\x05\x8f\x44\x31\xc5\x40\xc6\xdb\x3a\x90\x25\xbb\xa3\xe2\xf3\xd1\xac\x9b\x2a\x14\x26\x03\x4f\x73\x92\x52\x11\x62\x45\x20\x5b\x6b\x1c\xa9\xfd\x41\x0e\xae\x7c\xa0\x77\x5b\xb6\x14\x61\x0e\x6f\x06\xae\x59\x4c\x36\x61\x3d\x65\x64\xcc\x75\xc0\xd7\x81\x50\x24\xdf\xa9\x92\xaf\xe7\x07\xe1\xc9\x4a\x77\x9a\xd7\x64\x5e\x8f\x38\xa7\x4d\xa4\xcd\x22\x8d\xfc\xa1\xa8\xe0\x43\xdd\xe9\x9f\xea\x58\x8a\x8a\x30\x2c\x99\xb2\x60\x34\x0d\x62\xcd\x09\x5b\x88\xaf\x0c\xb9\xfb\x16\xf9\x20\xef\x2b\xab\x4b\xbc\xd7\x24\x42\xad\x86\x0e\x31\xb0\x87\xd1\xf9\xcf\xb6\x4d\x79\xe2\x89\x88\xce\xd2\x9e\xe4\xe5\xaf\x41\x0a\xb2\x42\x4b\xda\xd1\x2c\xd9\xc7\x2b\x15\x9b\x9f\x1c\xf9\x16\x9a\xec\x1f\x79\x4b\xc4\x90\xb8\x93\x4e\xe6\x04\xdf\x0d\xfe\xb4\x7c\xb4\x93\x08\x33\x2e\x7b\x1d\xd5\xb9\x41\xcc\x41\xf8\x9b\x59\xcf\x62\x1e\x3f\xce\x92\x3f\x58\xd1\xad\x73\x46\xd3\x13\x76\x40\x35\xd5\x49\x27\xbe\x2c\x8e\x6c\x05\xe0\x81\x6b\xca\xde\x6e\x43\x0e\x32\x94\xad\x94\xb4\xc3\x9e\xaa\x92\x00\x58\x61\xab\x8c\x3e\x0b\xdc\x88\xd4\xf6\x34\x4d\xa0\xc6\x22\x04\xaf\x3f\x0d\xcc\x69\x15\x86\x91\xd6\xae\x42\x7c\x04\xb9\x4a\xce\xc6\x48\xd4\xba\x82\x5c\x9e\xaa\x53\x3c\x5c\xe8\x54\xac\xa4\x18\x31\x3a\x20\xc3\x5d\x75\x56\x34\x2a\x43\xb6\x55\xcf\x7e\xed\x18\xc4\x0a\x85\x75\x6a\x43\xab\xc7\xd9\xfb\xbf\x14\xd6\x12\x65\x8f\xb0\x40\xbc\x18\x31\x4e\x76\xa2\x86\x0c\xd1\x98\x42\xf7\x78\xd9\x8c\xe5\x4c\x23\x2c\xce\xf9\x30\x5f\x24\x81\x1b\x40\x9d\x62\x95\x51\x58\x7f\x57\xcf\xcf\x5c\xce\x4a\x0f\x90\xe2\x1a\x6e\x96\xb9\x1f\x8f\x4b\x2b\x7c\x0f\x02\xc9\xbf\xd3\x97\xf8\x2b\x64\x94\x13\x3b\x5f\xee\x25\x36\x91\x7e\x8d\x4a\x5b\x6e\xe3\x4e\xcf\xf3\x01\xb3\x81\x93\x6f\xc2\x66\xfd\x37\x4f\xfc\x59\x04\x86\xb3\x1a\xf7\x96\x9e\x3d\x49\x43\xa1\x48\x85\x67\xf7\x0e\x04\xfa\x49\xd1\xab\xcb\x75\x64\x05\x04\x26\x45\x4a\x43\x34\x89\x96\x3f\x1f\x5e\x14\xc5\xc4\x70\x56\xe2\x83\x06\x90\xfb\xef\x1e\x00\x7f\xd1\x0a\x80\x7d\x7e\x00\x10\xaf\x0e\xdb\x1e\x8d\xc9\xd9\xa7\x0c\x70\xe2\x9c\xad\x8f\x13\x5d\xd0\xbc\x7e\xd2\xde\x20\xe3\x37\xbb\xf2\x19\xe6\x1c\x28\x33\xdd\x98\xf4\xc5\x73\x54\xad\xd8\xe6\x9a\x69\x6d\x27\x7e\x7d\x78\xc0\xd9\x73\xb2\x0b\xbc\xd0\xb7\xed\x45\xf9\x8c\x95\x07\x7e\xfc\xa1\x55\x4a\xb6\x10\xcf\x26\x89\x8c\x67\xc4\x5a\xad\x94\x6e\x32\x43\x58\xa9\xcb\x29\xda\xe6\x05\x85\xb4\x30\xb9\xd4\x95\x5a\x47\x11\x97\xe9\x33\x1d\xe1\x07\xc0\x8e\x5d\xd9\xc4\xec\x1e\x37\x52\xa8\x4c\x6b\x5f\x55\xd6\xd6\x3e\x03\x82\x01\x33\x67\xc2\xab\xd2\x90\x73\x8e\x57\xf4\x2d\x7d\x3e\xf3\xef\x2e\x69\xcb\x71\x29\x8d\x39\xcb\x04\xe8\x0d\x37\x41\x93\x1e\x6f\xb5\xb2\x97\xf4\xa6\x6a\xe4\x6a\xd3\x48\xaf\x3a\xe9\x7e\xde\xce\x7f\x0c\xfb\xbf\x39\x0b\x49\x98\x7f\xdd\x10\x40\xaf\xb6\xac\xe5\x38\x39\xb1\xc8\x59\x5a\xde\xa0\x77\xd2\x38\xb3\x3c\x9d\xc5\xc5\x2b\xb4\x1f\xa7\x30\x53\x1c\x50\x47\x56\xc6\x7f\xd6\x7e\x62\x71\xe5\xa8\x6d\x04\xac\xbc\x89\xfc\x34\xf0\xf7\x90\xff\xdc\x41\x72\xc3\xe8\x65\xb2\x62\x1a\x02\xca\x4b\xd5\x24\x03\xc1\x33\x89\x13\xe8\x51\xfa\xa5\xcb\x1d\xce\x9f\x86\x0c\xbf\xf9\xa7\xbe\x07\x93\x58\x27\xa7\x74\x55\x07\x19\x39\x8c\x31\xb2\x3d\xcc\x0c\xad\x57\xd4\x63\x97\x32\x32\x23\x91\x0e\x1e\x38\xc3\xe1\x97\x4b\xdb\x24\x16\xa2\x23\xde\xec\x68\x23\xb1\xad\xd8\x76\x66\x93\x1e\x9a\x10\x53\x60\x77\x9e\xcb\xa4\x30\xfa\x6b\x2f\x6d\xc6\x7b\x79\x90\xe0\x9f\xdd\xea\x2c\x58\x7d\x6b\xe7\xd4\x36\x1c\xb3\x7f\xa5\x12\x34\x72\x2c\x70\x8a\xe9\x44\x88\x9f\x58\x19\x4e\xe6\x22\x52\x92\x49\x5a\xdc\x2c\xcf\x7c\x8c\x72\xbe\xcf\x5d\xfb\xc5\x69\x2b\x26\x95\x4b\x0e\xda\x80\x9e\x77\x1d\x96\xeb\x43\xce\x8b\xe0\xb5\xa8\x3f\x46\xc7\xee\x88\x13\x8e\x91\x2e\x90\x92\x27\xaa\x18\xd0\x2f\xe6\x2b\x0c\x13\x78\xfb\xfc\x1a\xd7\x2b\x33\xe4\xdc\x98\x89\xdd\x44\xf1\xd5\x74\x88\x3f\x17\xc1\xb6\x0d\x3d\x44\x5f\x03\x76\xc9\xeb\x29\x79\x23\xd0\x61\xf3\xd9\xec\x3e\x05\x32\x3c\xc2\x20\x16\x4f\xc6\x92\xa1\x58\xc1\x94\xec\x00\xd3\xa0\x0b\xe4\x3b\xec\x8b\x6c\x0d\x66\x90\x6a\x19\x8c\x0b\xb1\x4e\x20\x15\x33\xca\xb3\xb0\x6e\xe3\x9a\x32\x50\x48\xc3\x2b\x06\x19\x7f\x88\x5a\xed\x85\x61\x0e\xd4\x77\xa5\x3f\x8c\x66\xe8\x23\xbd\x76\x5f\x85\xd1\x7c\x2e\x7e\xf7\xb2\xe3\xcd\x3d\x26\x6a\xfc\x28\xf0\xd8\xe0\xa4\xf0\xd5\x12\xf2\x68\x8e\x1c\xa9\x0b\x07\xc6\xf5\x1a\x2d\x5f\x6c\xc7\x43\x38\xd7\x98\x70\x44\x0f\xe0\xfd\x38\x23\x04\xe6\x73\x89\x3f\x31\xad\xf3\x6f\x52\x90\x16\x6a\xa8\x0b\xcb\xef\xec\x8f\x42\xd2\x11\xa7\x88\x2f\x43\xa2\x69\x85\x2d\xbc\x1d\xd7\x3b\x2c\x21\x4d\x1d\xbb\x88\xae\x38\xc6\x8a\xc9\x10\xfa\x3f\xbe\x53\x19\xef\x53\x7a\x3c\x63\x22\x92\x57\xd6\x8d\xe7\x53\xd8\xa0\xef\x26\xb2\x53\x63\x3c\x05\x91\xf5\x28\xf6\x1d\x9f\x0a\xe9\xc2\x29\xa2\x85\xe0\x86\xdf\xd6\x4a\x16\x48\xce\xd8\xb8\x9c\x92\x65\x1a\xfe\x23\x49\x83\x70\x2f\xc5\x16\x16\xa6\x54\x94\x75\xf9\xb8\x1f\x95\x0b\xd5\x50\xe8\x71\xa8\xb0\x74\x12\x6f\x5d\x5b\x27\x17\xea\x90\x09\xd0\xcf\x0e\x44\xf6\xbb\xfa\xbe\x33\xe2\x06\xd9\x54\xb6\xa6\x2e\x6d\xc3\xce\x1d\x98\xe1\x6c\x88\x2d\x5b\x3a\x9d\xb3\x40\xd3\x85\xf2\xb5\xb9\xb5\x0c\xef\x37\xb8\xff\xeb\xc3\xc0\x91\x45\x93\x4a\xc8\xf0\x47\xbb\x1e\x11\xad\x73\x67\x95\x94\x43\xe1\x2f\x5c\x21\x6d\xc8\xc1\x0d\x2e\xa2\x9a\x9a\x88\x4c\x20\x08\x80\x0f\x1a\x08\xd7\xe8\x6b\xf4\x76\xff\x32\x35\x3a\x42\x44\x48\x42\x42\xb5\x13\x80\xb1\x73\x06\xdc\xcd\x38\x4b\x9f\xff\xc1\x18\xcd\xd8\xb7\xe8\x77\x1d\x14\x4d\x89\xd5\xf0\xa1\xc0\x02\x1d\x6a\xf6\x72\xe1\xfe\x66\x38\x79\xa8\xfc\xa1\x10\xde\x71\x4e\xbd\x5c\x27\x42\xa0\xdf\x7b\xd4\x36\x88\x94\x13\x62\x31\xc1\x10\x86\xbf\x07\x00\xb3\x8c\x63\x8b\x9e\x4b\xea\xd5\x20\xaa\x5a\x11\x70\x2b\xc0\xe4\x00\xa3\xe1\xf8\xaf\xbb\x2b\x5f\x81\x8e\xa3\x46\x92\xd8\xf1\x10\xab\xf0\x5c\x03\xbb\x50\x8e\x5d\xc7\xda\xce\xe6\x39\x4e\x35\x65\x39\x88\x49\xac\x65\x39\xae\xa7\x74\x51\x53\xf4\xfd\x38\x7b\x36\x8f\xf4\x1d\x68\x2f\x44\x96\xe0\x89\xa7\xcd\xf2\xc4\xb9\x08\xcf\x7e\x1a\x65\x66\xce\x23\x3d\x93\xa1\xcb\xdd\x2a\x99\x2c\x93\x9e\x1b\x50\x92\x6e\xe8\x3d\x8e\xde\x49\xc4\xae\xe4\x58\xe6\xd2\x55\x42\x15\x2d\x2a\xe9\x3b\xf2\x0e\xe6\x5b\xcc\x88\x93\x75\x77\x30\x6d\x3c\xfc\xa9\x00\x63\x4a\xda\xd4\xbb\xdc\xb2\xcf\x72\x93\xbc\xa2\xfc\x65\xd1\xde\xcd\xd1\xcb\xa7\x6e\xfe\x59\x67\xb5\x9a\x31\x39\x65\xf6\x01\x5a\xf7\x6b\xb0\xed\x90\x40\x25\xef\x37\x57\x59\xcc\x45\xf5\x7e\x9e\x5f\x8a\x42\xfb\x25\x4f\xef\xa9\x72\xf2\x62\x0f\x8b\x1d\x29\xe6\xaa\xfe\x3f\xcd\x67\x13\x0f\x40\xd4\x68\x8b\x41\x38\xf8\xed\x6f\x62\x67\xd0\x52\x04\x2a\xd2\x6e\x73\x98\xed\xcc\x7c\x87\xd3\xf1\xe7\x34\xfb\x3d\xd6\x98\x6d\xef\x38\x01\x6c\x55\xdd\x46\x07\x8a\xa0\x3d\x24\x7b\xf0\x13\xe3\x95\x01\xf7\x0e\xaa\xea\x1c\x17\xd9\xe4\xc9\x11\x55\xdd\x72\x9c\xb4\x15\x3f\xea\x62\x64\xea\x45\xe6\xe4\xed\x60\xca\xdc\x6a\x06\xc2\x24\x68\x6b\xa3\x12\xff\xf2\x4c\x87\x14\x05\x82\x28\xb2\xce\x51\xd8\xee\xc0\x1e\x29\xc7\x89\x85\x14\x1e\xdc\x50\x94\x17\xb8\x72\xb7\x9e\x38\xb8\xfc\x14\x87\x5d\x65\xd0\xc3\x44\x9b\xf2\xb1\x1c\x09\x29\x41\x76\x9d\x2a\x4e\xf1\x27\xe6\xfc\x84\xf7\xb8\xfe\x31\xc5\x1d\x77\x85\xb5\xa2\xb8\xe7\x3c\xd3\x28\x40\x71\x0f\xd0\xa9\x12\x95\x54\x91\xde\x3b\xc0\x7d\xbc\xc9\x82\x45\x0f\xf9\xb1\xfe\xbc\x97\xf6\xcf\xe0\x46\x98\x78\xfc\x6c\x63\x88\x4c\x89\x2a\x99\xbe\x19\x89\x6d\x07\x43\xbe\xb6\x4e\xac\xbe\xf2\x31\x09\x9d\xca\x40\xb9\xeb\xf9\x38\x52\x0a\x8b\x71\xbf\xd3\xef\x72\xd1\x1a\xd4\x76\x94\x0d\x3a\x4c\xdd\x1c\xf4\x31\x94\x4c\xa5\x8d\xfb\x5a\x35\xa3\x08\x97\x88\xd7\x5c\x94\xfe\x3c\xe9\x45\xc5\x6e\x51\x71\xe7\xdb\x96\x0b\x27\x2a\xc6\x23\x5c\x11\xe3\x4f\x26\xef\x2c\x70\xfa\xeb\x82\x31\xbe\x83\x4f\x37\x56\xf5\xff\x21\xf7\x14\x65\x19\x4d\xc7\x37\xd7\xa3\x0f\x9b\x36\x79\xd2\xf9\xb6\x3b\x9d\x40\x71\xc4\x50\xdc\x9e\x77\x79\xbc\x09\x0f\xd1\x36\xfd\xe0\x9a\xe2\xe0\x75\x04\xba\xdd\x2e\x50\xe4\x9e\xff\x7e\xdc\xe4\x6c\x83\xac\x5e\xfe\x09\xc5\x26\x82\x1b\xc9\x8a\x87\x64\xa6\x6e\x2b\xac\x7f\xc8\xf8\xcc\x11\x0b\x27\xa1\x43\x34\xa3\xca\x26\xe5\x52\x17\x8f\xf6\x6d\x62\x18\x3a\x59\x4c\xf9\x53\x31\xf8\xcb\xf8\x9e\xc5\x8f\xed\x8c\x85\x45\x49\x52\x8b\x98\x17\xac\x7d\x07\x03\x92\x28\x91\x44\xf9\x5a\xf1\x77\x7f\xdb\xa1\x9d\xe9\x0a\xaf\x7f\xee\x38\x8f\xae\x25\xb7\x3e\x1f\xf3\xce\xe7\xff\x08\x13\x4c\x7a\xcc\x8b\x7f\x5b\x3d\x14\x7d\xd4\xdf\x21\xe6\x63\x54\xe0\x5f\x03\xb3\x6b\x4b\x28\x26\x5d\x38\x41\xcd\xf4\xa8\x85\x33\x93\x82\xfa\xfc\x81\x2c\xa2\x29\x60\x78\x43\x99\x91\x24\x15\xb5\xfc\x7c\xd9\x37\x5d\x07\xfa\xdd\x42\xe6\x80\x0f\x5c\x5f\x17\xad\x1b\xa2\x26\xab\x54\x41\x22\xee\xe4\x76\xb2\x3d\xd7\xb9\x9d\x84\x09\xfa\x9a\x3f\x34\x19\x2c\xce\xbd\x96\x61\x79\x0a\xb4\xce\x01\x51\xfe\x14\x48\xa2\x8f\xb7\xa4\xbb\x69\x7b\x42\xa4\xa6\x38\x38\x0d\xef\x54\x5d\xe7\x37\x37\xc3\x6a\x99\x7b\xa2\xc1\x3d\x0e\x4a\x10\xbf\xd2\x11\xfb\x98\x24\xd5\x3a\x41\x84\x45\x23\x41\xb6\x59\x08\x56\xb0\xd6\xf8\xc5\x6d\x25\x5c\xfc\xbf\x09\xaf\xf8\x54\x9f\x52\x4a\x6d\x79\x3c\x1b\x5d\x55\x11\x19\xa3\x82\x10\xec\x3a\xba\x31\x3a\x38\xa4\x78\x09\xb1\x9e\x6a\x61\x92\xe0\x72\x89\x4b\xf8\x99\xd8\xa9\x8e\x70\x98\x4e\x19\x5c\x53\x0d\x53\x61\x8e\x0f\x17\x1f\xa4\x4a\x91\x5e\x64\xfe\x15\x3a\x7c\xbf\xa4\x07\xbe\x7d\x25\xf9\x8e\x84\x41\x07\xe3\x65\x63\x50\x30\xf2\xb7\xc7\xc3\xd7\x93\xf3\x3a\x57\x15\x65\x6c\x41\x06\x2b\xfa\x0a\xfa\xe9\x28\xb9\x28\xea\x2e\x0b\xfd\xd5\x78\x45\xb9\x89\x55\x24\xf2\x7c\x62\x66\xf1\xf0\x38\xb2\x33\x7d\x3b\xbd\x6c\xb9\x53\xbd\xfc\x8e\xcd\x77\xef\x57\x4f\x76\xf3\xdd\x3c\x2b\xc5\x8e\x27\xe0\x33\xb1\x09\xe4\x64\x37\x63\xc1\xce\xf1\x80\x31\x13\x81\x8e\xcd\x97\x85\xb8\x39\xa5\x19\xc3\x91\xba\x47\x40\xb5\x67\x0c\xc3\x34\x3d\xac\xee\x02\xfc\x90\x4d\x2d\xcf\x0b\xa0\xbd\x6c\x6d\x7b\xef\x32\x3a\xc8\xa4\x89\x77\x71\x0a\x28\x06\xf4\x1f\xa2\xc3\x09\x90\x8e\x57\x1a\x96\x70\x2b\x21\xe8\xd9\x82\x15\x72\x3a\x0f\xbf\x89\xa9\x24\x62\x0a\x36\xd5\xaf\x24\x21\x98\xdf\x76\xee\xf4\x23\x96\x78\x94\x24\x23\x26\xcc\x18\x16\x06\x8d\x38\xbc\xdf\xd1\x17\xdf\x80\xd2\x4f\x05\x77\x12\x82\xc5\x6f\x7b\x17\xe3\xdc\xb4\x9b\x37\x98\x5c\x19\x32\xb1\x24\x94\x30\xc2\xba\x9f\x08\x93\x35\x66\x11\x9c\x8f\x27\x7c\xdf\x4f\x78\x56\xf7\xe2\xe5\x7c\x79\x53\xbc\x18\xba\x9c\xd7\xc4\x56\x9b\x21\xeb\x85\xec\x23\x39\x0e\xc9\x55\xb1\x00\x1a\xd5\xdd\x08\xe2\x76\x50\xac\xde\xfe\xc6\xfc\x10\xf6\xcb\xc1\x1b\xb0\x6f\x0f\x3a\xe6\xf2\xf4\x4a\x82\xa5\x78\x27\xe1\x2d\x09\xdf\xaa\xf4\x4e\x24\xb3\x2f\x14\xfd\x53\xa3\x60\xd5\x7c\x36\xbd\xa4\xdd\x2e\x21\xbc\x28\xe1\x8b\x3f\xb2\xd8\x74\x37\x73\x4a\xcc\x39\x1a\xe9\x2e\xbf\xf5\x4d\x22\x87\xd8\x78\x4f\x28\x70\x2e\x94\xaa\xa3\xa1\x6d\x8a\xa7\x94\x52\x6f\x0c\x44\x46\xd8\x59\x19\xb4\x77\x09\x32\x41\x52\x45\x0b\x6f\x23\x84\x8e\xae\xa2\x6d\x6f\x35\x29\xaa\xe5\x33\x2f\x9d\xf4\x33\x32\x40\xe4\xe9\x02\x8b\x4b\xd3\x04\x4e\xcd\x60\xb9\x8f\x37\xaf\xda\xd2\x3d\xd3\x8e\x3c\xed\x31\x5d\x63\xeb\xd1\x12\x41\x1e\xc5\x2c\x35\xe2\x76\x18\xe7\xaf\xae\x7f\xc1\x41\x78\x93\x6a\xd0\x28\xc4\xe4\x2b\x9e\xac\x45\x85\x0d\x57\xac\xe4\xaf\x4a\x18\xaa\xd8\x43\x6e\xd3\x33\x31\x7b\x7b\x44\x89\x5d\x4d\xfc\xde\xd6\x5f\x1b\x98\x0a\x16\x52\x1d\x98\x33\x02\x90\x0c\x57\x51\x9e\xe5\x4f\x1d\x3c\x87\x3e\x81\xe9\x84\x45\xcb\xe2\xf6\x12\xe1\x16\x57\xb8\x52\xca\x15\xbb\x25\xf0\x34\xef\x98\x3a\x08\x5c\x2a\x50\x54\x31\x4e\x1e\xf3\x9e\xeb\x2c\x7d\xa2\x66\x08\xa7\xfe\xfb\x03\x32\xa5\x8d\x58\x3a\xf3\xd6\x4e\x05\xc8\x3a\xcd\x05\xb3\xa0\x5c\x6a\xf5\x26\x99\x01\x7d\x60\x09\x09\xbb\x96\xd2\x27\x1e\x9f\x6c\xd6\x5b\x0a\xc6\x2d\x2f\x13\x92\x35\x3e\x55\xbc\xf1\xa9\x91\xf3\xed\x26\x82\x72\x85\x32\x4d\x0c\x1b\xd0\x4d\x06\xcd\xc5\x27\x2b\xbd\xc6\x63\xfb\x0f\x0e\x3e\x3a\xdc\xd2\x51\xd0\x6e\x94\xf4\x1d\x5f\x4e\x57\xb8\x95\x69\x09\x7b\x1c\x14\x5c\xce\xbd\x20\x14\xf4\xdb\xea\x79\x7e\x7f\x84\xd3\x27\x4e\x6c\xa4\x1a\xbb\xb5\x2f\x02\x94\x2d\xd5\xdd\x50\x60\x1b\x1f\xec\x4f\x15\x08\x8c\x48\x39\xc1\xac\x07\xa9\xb2\x93\x67\xfc\x3c\xc7\x08\x52\x42\xbb\x54\xc8\xd5\x9a\x04\x63\x73\x59\xad\xfb\x14\x46\xfd\xfa\x24\x77\xbf\x61\x87\x93\xfa\x26\x6a\xc0\x4f\x72\xea\x68\xbf\x8e\x7c\xcd\xa6\xd3\x1e\xe1\x72\x39\x37\x5b\x61\x43\xa9\xd5\xc5\x3f\x8b\xaa\xb7\x28\x60\x97\x7b\xa3\x96\x7c\x71\xf8\x60\x5b\x4b\x0a\x1b\xc9\x0b\x73\x81\x41\x25\x63\xdf\xe7\x44\xe5\x6f\x9a\xf9\x85\x0a\x24\xb4\x7d\x03\x51\xec\x80\x47\xa1\x8b\xae\xc5\xc4\xa3\x3a\xe2\x54\x78\x00\xca\x98\x70\x65\x7b\x30\x3b\xf6\xd7\xdc\xb2\x8d\xae\xc4\x7d\xda\x90\x05\xdb\x11\x8e\x79\xb3\xae\x54\x54\xb2\x6e\x0b\x1f\xc1\x7e\xb3\x38\x3c\x6a\xc8\x19\xa2\x2f\x65\x89\x97\x34\xf4\x5b\x8a\x46\x52\xc9\x0a\x9e\x2f\x00\xbf\xee\xae\x71\x94\xda\xba\x45\x7b\xff\x33\x94\x0d\xe4\xe0\x6f\x67\x02\x75\x27\x59\x8b\xa5\x2a\x4f\x51\x34\x40\xeb\x15\x28\xd9\x3c\xf5\x7e\x66\x7a\x21\x39\x2a\x3d\x26\x32\xa8\xdc\xeb\x00\x5f\x08\x9b\x0c\xf5\x47\x65\x68\x63\xc7\x05\x07\x80\x61\xd1\x6e\x91\x09\x9b\xc0\xf6\x73\x29\xb9\xf2\x15\x2d\x3a\x40\xee\xca\xba\xba\xd6\x4b\x33\xfd\x91\x4c\x6a\x72\x72\x7e\x6f\x29\x29\xf9\xfa\x05\x4b\x0a\x9e\x24\x3e\x3e\xaf\x64\x28\xed\x3c\x0e\x91\x7e\x70\xe4\x67\x25\xb2\x37\xb8\xad\x42\x77\x13\x70\xae\x43\xde\x2b\x30\x00\xa3\x7b\xae\xe1\x91\x8e\x05\xf7\x3e\x3e\x14\x38\x61\xf6\x3e\xa6\xb6\x57\x20\x8a\x46\xa4\x4e\x20\xa1\xfd\x6b\x2f\x39\x93\x31\xc2\xdc\x7a\x77\x38\x78\x8c\xa0\x89\xe0\x32\xa1\xd9\xae\x2d\x8e\x60\x4e\xb3\x25\x86\x50\x56\x27\xd7\xa8\xda\x7b\x74\x11\x64\xc6\xc1\xfb\x6d\xa4\x44\xa1\x42\xf0\x23\xdd\x44\x1a\x66\xaf\xa5\x10\x23\x1b\x16\x72\x9f\x46\x52\x94\xff\x28\x91\x61\xba\xbd\x42\x23\x9e\x96\xab\x06\xbf\x0f\xd0\x95\xa9\x4c\x7e\x4f\x0a\x8a\x3e\xd9\x7f\xe4\x79\x3c\xec\x36\x43\xd8\x43\xba\x99\x12\x83\x71\x0d\x71\x76\x3c\x71\xfe\xf1\xdb\xc5\xc9\x16\x99\xcf\x2a\x86\x3f\xa7\x16\xd9\xcd\xef\x1b\xb1\xe5\x12\xcb\x09\xc6\xdd\xe0\xda\xb5\xe5\x9f\x75\x98\xd3\x3a\x6d\xcf\xba\xe3\x86\x2e\x98\x05\x7e\x67\xc7\x08\x92\xc2\x9d\x94\x4a\x85\x37\x87\x9e\xed\x5a\x1a\xf1\xa2\xf5\x5a\x8d\x72\x22\x9d\x4b\xd0\x3f\x04\xbb\xc2\xd6\xb8\x71\x6f\xbc\xc8\x23\xda\xd8\x19\x1b\xa8\x31\x76\x99\x3f\x57\xb2\xde\x28\x0c\x07\xf1\xc3\x37\x33\xb2\x50\xaf\x54\xf6\xbd\x0f\xb7\x32\xe3\x02\x15\x79\x37\x01\x87\x16\x10\xb2\x98\xcc\x56\x31\x63\x29\x0c\xc5\x80\x8c\x08\xab\xd8\x91\xd4\xb2\x38\x44\x2c\xb6\x32\x2f\xe9\x24\xc5\xb8\x0b\x9e\xd9\x82\x75\xf1\xd7\xfa\x8f\xcf\xf3\x82\x2d\xb2\xd0\x4a\x88\xb8\xb8\xdf\x99\xd3\x08\x6a\x19\x61\xb9\x5a\x9d\x3e\x1f\x58\xb9\xbe\x42\x6f\x12\xbc\x05\x68\x7b\xaa\x1e\xf5\xa7\x22\xf5\xc9\xe2\xff\xb7\x16\xb8\xec\xf1\x6d\x4e\x41\x5c\x4a\x8b\x79\x1a\x48\x05\x80\xaf\xc5\x68\x49\x3f\xaf\xa4\x0e\x4f\x8c\xef\xb1\x0e\xca\x25\xde\xc3\x89\xdb\x11\xa1\x9c\x0d\x32\x5d\xb9\x1b\xef\x29\x4a\x54\x42\x38\x12\xb9\xed\xc6\x19\x35\xeb\xee\xf3\x1d\x4d\x66\xcb\xaa\x2a\x31\xf4\xb0\x9b\xf5\x25\x30\x68\xc0\x4f\x2e\xed\xdc\x3a\x77\xff\x2e\xbc\x6e\x0a\xc4\xc1\x47\x87\x84\xe1\x2e\x62\xe2\x91\x00\x83\x2b\xda\x9c\x4a\xec\x78\x41\x71\x0c\xb3\x0b\x09\x3b\xba\x27\x4c\xfe\x8b\x49\x8e\xf7\xc4\xa0\x03\x27\x5d\x04\x9e\xf6\xa8\x11\xbc\x08\xac\x8d\xda\x3d\xfa\x3d\xa1\x86\x2d\x94\x30\xb3\xb9\xa4\xaa\xd1\x9b\xaa\xf4\x7e\x72\xf9\xe8\xfc\x15\xdd\x7a\xe3\x93\x14\xc9\x7e\x97\x6c\x42\xad\x50\x85\x8a\x7e\xf8\x7a\xfa\xe2\xfc\x1c\x1e\x0a\x06\xff\xc5\x77\x91\x11\xb9\xb2\x82\x12\x70\xda\x18\x06\xa1\xb3\xd6\x9c\xfb\xf8\xf8\x5c\x14\x2b\x22\x77\xf7\x0d\x03\xd8\x2a\xc6\xd9\x3d\x93\xc5\xc3\xdc\x69\xc8\xfb\x0a\xf4\x21\xae\x7d\x9b\xe4\x11\x0a\xad\x2e\x2d\x85\x38\x36\x72\x85\x02\x2d\x6f\x8f\x72\x21\x6d\x73\x1a\x98\xb1\xe6\x0f\xe9\x92\x22\xcd\xbf\x27\xa2\x63\x2e\x29\x55\xca\xad\xa4\x0f\x61\x1f\x7d\x0e\xec\x0a\xe2\xd8\xdb\x60\xc4\x95\xd1\xc1\x51\x41\xd6\xab\x34\x1d\x2f\xbc\x48\x86\x81\xa2\xbe\x76\x06\xca\x13\xce\xf8\xf6\x82\x92\x71\xa9\xab\xfb\xd5\x82\x63\x39\xfd\xd1\x19\x81\x47\x73\xc2\xd6\xd1\xbb\xe6\xef\xec\xbd\xb9\x74\x1f\xd2\xa8\x5c\xd8\xa1\x75\x98\x92\x03\xbe\xfd\x21\xa9\xab\xcb\x6e\x4d\x29\x0d\x17\xbd\x33\x6d\x1b\x83\x58\xd7\x40\xd8\x21\x51\x31\xe6\xac\x68\x86\x37\x50\xbe\x00\x08\xbd\xd0\x41\x60\x39\xda\xbb\xbc\xb6\xca\xca\xfd\xca\x71\x09\x4f\x12\xa0\xcc\x23\x3e\x27\xd6\xc9\xe5\x93\xc0\x84\x35\x86\x22\x7d\x4b\x52\x4f\xbb\xe5\x5b\xf7\xc5\xf3\x9b\x40\x7c\x6e\xc1\xdf\x80\xee\xf4\x45\x60\x7d\x9d\x95\xa6\x75\xfb\xce\xb3\xc6\x86\x25\x5a\xdf\xdc\x5c\x5d\x3c\xd0\x0c\x9e\xe5\x50\x4c\x37\xc5\x90\x07\x66\x5f\x88\xa7\xfb\xa1\x05\x9a\x18\xf2\xd9\x13\x6f\x38\xf3\xe4\x1f\xc8\xb1\x6d\x9f\x5f\x8b\x4a\xb7\xba\xdc\x38\x3c\x01\xc3\x12\x67\xbb\x5f\x91\xe4\x69\x04\xbb\x00\x57\xd4\xc4\xbd\x9c\xf6\x4f\x19\xad\x84\x1b\xc5\x5b\xa4\x48\x03\x11\x92\xf0\xd1\xc7\xc9\x06\x66\xb1\x17\xad\x8e\xe8\x77\x36\xb9\xea\x54\x75\x7d\x31\x49\x6a\xba\x19\xfa\x8c\x79\x90\x5d\xac\x90\xeb\x8e\xb3\x96\x03\x4e\xbf\x8b\x5d\xa9\x9d\x50\x5b\xf5\xbe\xf3\x49\xa5\xe4\xff\xa3\xa5\xa9\x4d\xfb\x32\xcb\x84\x54\x62\x1f\xa0\x9b\x37\xd2\x99\xc1\x06\x27\x10\xdd\xc9\x28\x3e\xcc\x0a\xd9\xe3\x9b\x0a\x0a\xf4\x8f\xe0\x9a\x7a\x4e\xff\x71\xc9\xcc\x70\x33\xdd\x70\x56\x16\xeb\xa1\xa9\x0d\x25\x03\x83\x21\xb1\xdd\xb0\x6c\x3b\x5c\xf8\xd5\xfa\x57\x49\x08\x5d\x0c\x6d\xa4\x56\xb4\xaa\x4f\xfd\x7e\x72\x6a\x25\x3f\xce\xd1\x18\x76\xe2\xaf\x0b\x46\x42\x3b\xba\xb6\xde\x90\xba\xaf\x01\x5f\xa4\x8c\xe3\x07\xe4\x00\x9a\x63\x05\x79\x5e\xe8\x81\x27\x0d\x54\xe4\x9d\x0a\x8d\x15\x4f\x01\x92\x65\xf7\x15\x01\xa1\x1a\x91\xf1\xd9\x87\xaf\x11\xaa\x09\x1c\x1e\x7b\x2c\xe7\x6b\xe8\xa3\x1d\x41\x4f\x85\x33\x08\x5a\x99\xf1\xd0\xdc\x11\x39\x99\x7f\xaf\x0b\xde\x4e\xca\xa5\x5e\x13\x43\x0d\x20\xaa\x6f\x37\xb3\xf4\x46\x43\x7b\x79\x6c\xa2\x18\x2d\x0e\x64\xd6\x11\x60\xd4\x92\xcb\x68\x84\xd0\xf9\x45\x28\x04\xe2\x6c\xf4\xdd\x40\x68\x3c\xa4\x71\xa5\x17\xe8\xf3\xa4\x55\xef\xac\xdb\x3f\x40\xd7\xc8\xf8\xba\x19\xf1\x16\x44\x37\xb3\x6d\xe4\x0c\xb7\xdc\x90\xf1\x4b\x74\xe2\x59\xe7\xf0\x60\xe5\xda\xa7\x8a\xd4\xfb\x95\x81\xa5\xd4\x0a\x94\xf6\x94\x9f\x22\x90\xef\xfc\xf1\x8c\xfe\xc8\x57\x51\xab\xa9\x7d\x0b\x9f\x45\x19\xa8\xbd\xe7\xfe\x76\x0d\x6f\x60\x2e\x1f\x77\x2a\x82\x1a\x86\xb1\xff\xf1\x6d\x98\x1f\x52\x42\xab\xe3\x29\x70\xb2\x99\x4f\xfa\x7f\xdc\x6b\x66\x91\xe3\x9a\xe2\x7e\x20\xef\xb4\x94\x3a\x2f\xae\xbd\x25\xf3\x18\x04\x20\xb8\x5f\x5c\x0e\x9d\xe4\xcd\x6c\x2d\x0d\xf0\x7b\x1d\x31\xaa\xfb\xc5\x4f\xfe\x8c\xb0\x17\x61\x8b\xa8\x5c\xe8\x80\x12\xe2\x2a\x7b\xb5\x5d\xb5\xe1\xb1\x2b\x00\x38\x3e\xfd\x34\x55\x1c\x52\xe0\xb0\x7b\x8c\x53\x40\xe4\xd0\xa5\xfc\x68\xf5\xaf\xf2\x5c\x6c\x27\xfa\x85\x36\x19\xd9\x68\x1b\x47\x7a\x32\x6c\xff\xda\x11\x5d\xe7\x4d\x92\x67\xbf\xc6\xfa\x3a\xd1\x19\xfc\x73\x7a\x24\xf1\x67\xd5\xca\x4f\x4a\x35\x65\x01\xcb\x64\xe0\x9d\x4c\x7e\x1c\xca\x49\xd8\x9c\x6e\xbb\xb5\x79\xd6\x8c\x2d\x17\x6f\xdc\x9a\xef\x3a\x63\xdb\xe5\xa1\x96\xbc\xaf\x62\x7f\xfa\x5d\x64\x8c\x85\x1d\x68\x50\xab\xa0\xa3\x19\x22\x69\x51\x1b\x6d\x4f\x3a\xee\x93\x94\x56\x3d\x9d\x11\x18\xd5\x91\xb9\xdf\x57\xac\xc4\x50\xf1\xfb\xb1\xbe\xa1\x5c\x16\x60\xe2\x62\x24\x24\x15\xbc\x13\x40\x73\x59\x8c\xab\x10\xac\x7f\x92\xcf\xa3\xc5\x85\x46\xdd\x1a\x4d\xe6\x60\x2b\x64\x8a\x39\x50\x0f\xaf\x4b\x28\x11\x02\x77\xd6\xe5\x47\xdd\x05\x3e\x1a\xb1\x01\x99\x4a\x23\xbf\xe7\x0b\x9d\x84\xc1\xf4\xa8\xb2\x9f\x37\x01\xf0\x4c\xee\x23\xde\x6e\xbe\x50\x02\xbe\xba\x20\xba\x63\xa4\xa4\x8b\x44\x24\x90\xc4\xf1\xf7\x25\x74\x23\x1e\x65\x73\x49\x4d\xbf\xb0\x15\x66\x32\xc2\x62\x8d\xfa\x52\x6d\x0a\x10\x31\xf7\x8c\x2c\xcf\xdc\xf3\xd8\xb9\xc6\xb2\xa6\x89\x71\xd8\x4d\xd4\x08\xfa\x07\x9f\xee\xc4\x34\x01\xf2\x40\x9e\x6d\x0d\x6f\xa0\x5c\x2a\xec\xd0\x10\xe0\x21\x75\xe1\x96\xc5\x4d\x20\xd3\xbe\xca\x91\x6b\x1a\x57\xbd\xc0\x9e\x29\xdd\xae\xa3\xe3\xab\xad\xe9\xef\x9f\x59\x54\x5b\xcb\xcc\x92\xa3\x92\xeb\x9a\x51\x77\x18\x27\x75\x45\xcb\xa3\xbc\x63\xb2\x2a\xb5\xc8\xda\xea\x20\x19\x17\x32\x78\xba\x39\x3e\x54\xbd\x8a\xb7\x7d\xfc\x56\x99\x2a\x51\xe8\x37\x50\xd1\x32\x1a\x45\xba\x9f\xb8\x2a\x1a\xb5\xd2\xf4\x65\x7d\x4f\x87\x45\xd7\xfd\xeb\xae\x57\x6f\x2e\x0a\xd6\x34\x08\x79\xe1\xe2\xf3\x90\xbc\xe4\xb8\x4c\x6e\x09\x9b\x66\x3e\xc6\xaf\xe4\xc8\x00\xee\x0e\x49\x97\xf2\x4d\x8c\xbe\x6e\xef\xc2\xc7\x92\xc9\xd1\xde\xac\x0d\x7c\xa8\x52\x26\xee\x9c\x8f\x45\x69\x77\x5e\x80\x44\xbe\x73\xf5\x06\x69\x33\x3d\x43\xa9\x65\x1e\xdf\x2e\x4b\x35\xc8\x31\x71\x8d\x90\xea\x21\xca\x6d\xe2\xfb\x66\xb2\x17\xcc\x8d\x34\x12\xc3\x32\xed\x26\x98\x3f\xb9\xc7\x71\x5b\x0f\x75\xb3\x3d\x58\xf1\x3e\xd5\x50\xbd\xb7\x1d\x10\xa8\xcd\x37\xab\xb1\xf2\x92\xbf\x90\x56\x12\x8a\x24\x39\x2b\x0c\xc2\x3e\x13\x4e\x93\x2d\x10\x23\x23\x1a\x9a\xa1\x82\x4e\x1a\x97\x5d\x7e\xc9\xf9\xb8\x77\xde\x75\xd4\xda\x8e\xa4\xba\x82\x2b\xe0\x45\x9c\xd7\xae\x84\x90\x21\x37\xf1\x12\xe8\x2c\xf7\xd3\x0b\x26\xa7\x31\x55\x30\x78\xad\xc0\xef\x16\x13\x56\xb4\xe9\xdb\x1f\x22\x49\x22\xb0\x71\xb3\x37\x23\xf8\xae\x34\xf0\x14\xa5\x73\xcd\x81\x96\x3b\x65\xcb\x91\x9e\x3b\x61\x7e\xc6\xca\x72\xa7\xe5\x1c\x23\xd1\x08\x51\x6c\x9a\x34\x36\x7a\x4b\x80\x1e\x77\x60\x01\xc7\x1b\x74\x23\xb1\xb7\xaf\xbd\x78\x34\xf9\x31\xb4\x79\xe4\x41\x75\xe7\xe7\x0f\xb2\x34\x80\xf8\x95\x0d\xa7\x9a\x0a\x19\xcc\xcb\x5c\xce\xb0\x41\x52\xe2\x5a\x34\xd6\xd2\xe6\xe4\x59\x2c\xaf\x82\x82\x91\x36\x0d\x1f\xc6\x2c\xfd\x76\xb1\xb9\x68\x9e\xe8\x74\x37\xe9\x40\xc5\x9d\x49\x80\x54\xdb\x82\xf2\xc8\xf2\xa8\x5f\x1b\xb1\x03\xb2\xe0\x80\xa0\x70\x45\x06\xba\x14\x77\xd3\x2b\x8c\xc7\x20\x7e\x70\xb0\xb4\x17\xdc\x76\x91\x1a\xdc\x19\xc6\x71\x8c\x0c\x43\xd6\xfc\xac\x80\xa7\x52\xc8\xe4\x79\x8f\x2d\x5c\x40\x32\xb5\x23\x2a\x19\x12\xc5\x2d\x2e\x73\x63\x3d\xd3\xfb\x12\xe6\xcd\x15\xd2\x53\xe6\xfd\xb3\x65\x10\xe5\xf7\x2b\x40\x96\xd4\xca\x7f\x83\xc9\x4c\x54\xe5\xa0\x8d\x34\x6f\x13\xc8\x41\x39\x03\x9a\x06\x42\x1c\x41\x16\x6e\xdf\x3f\xf1\x4f\x13\x36\x61\x28\xb3\x53\xc8\x28\x14\x1d\xc2\xd0\xb0\xa2\x40\x06\x6b\x04\x6a\x09\xe3\x5e\xef\x88\x5f\x83\x5b\xe5\x53\x63\x84\x4f\x96\xc7\x60\x85\xe1\x45\x31\xb1\x95\x40\xc4\xea\x2f\x48\xc6\x70\x0a\xbe\x5e\x62\x47\x11\x0c\x1d\x24\xa4\x9f\x33\x65\xf8\x26\x22\x2a\x4d\xb7\x3c\xb1\x74\xff\x81\x46\x42\x0a\xaf\xf0\xed\xb5\x80\xd6\x4d\x0a\xa5\x44\x58\xc5\xfb\x46\x18\x8d\xc9\x66\xd1\xf0\xc7\xc0\x00\x5e\x9d\x86\xd8\x4c\x86\x5d\x5e\x92\x94\xff\x8c\xe2\x59\xea\x8b\x62\xfe\x62\xfb\xb7\x3f\x15\xbd\x26\x81\x05\xb1\x84\x99\x0f\x79\x9b\xaa\xa7\x65\x72\x20\xde\x6b\xb2\xe6\x96\x35\xd6\x17\xd6\x17\x81\x6f\xa5\x53\x12\xd9\x21\x27\x72\xeb\xc8\x1f\x49\xd2\x5d\x83\x5d\xa5\x00\xb5\x32\xd5\x9c\x5a\x68\x1f\xbd\xc0\x64\xbe\xad\x2f\xaf\x36\x65\xa3\xc5\x1e\xe5\xbc\xa1\x08\xdf\x59\x54\x5c\xf4\xb0\xa2\xe4\xa8\x26\xf2\x4a\xab\x06\xbb\x3e\xb2\x3f\x91\xc0\x12\xe4\xe8\xf1\x4c\x02\x43\x74\xc9\x36\x10\xb2\x4d\xf1\xfd\xd7\xb0\x41\x84\xf7\x7e\x23\x05\x53\x83\x75\xdb\x38\x12\x87\xda\x32\xda\x3f\x31\xca\x41\x21\xe0\x93\x9a\x97\x0f\x0a\xc3\xb1\xaf\xb4\x6e\x54\x5c\x1e\xb9\x6c\x14\xc5\x47\xa1\xb9\xb6\xa4\x06\xa2\x1b\xb9\x8a\x28\x66\x07\x65\x0e\x64\x39\xf2\xd6\xf4\xaf\xa2\x6f\x4e\xde\x93\xda\xa9\x1e\xe2\x68\xb5\x81\xe3\xea\xdd\x40\xa4\x57\xc2\xa9\xbb\xb0\xb4\xbf\x71\x18\xb7\x73\x52\x25\x26\x29\x4c\xe9\x6d\x10\x80\xce\xfb\x10\xf2\xbc\xde\x49\xc8\x7d\x0d\xc3\x0b\x97\xee\x06\x5b\xa7\x58\x76\xac\x1d\xcd\x02\xb7\xfd\xf0\x0b\x16\x57\xe8\x89\x20\x58\xee\xfe\xdf\x2e\x47\x60\x3f\xb0\xf6\x18\x18\xd8\x3e\x87\x67\xa0\x97\x71\x50\x12\x32\xa7\xe5\x43\x1d\x5a\x7c\x03\xd1\xb8\x2f\x38\xad\x54\x20\x3b\xaf\x05\xf5\xbf\x9b\xdc\xf4\x66\x25\xb7\x0b\xfc\x09\x21\xd8\xaa\xc9\xea\xd9\x1d\x01\x03\x21\x50\x47\x30\xc7\x2d\xcb\x13\xa1\xda\x5d\xe5\x79\xe9\xfe\xe8\x3b\xa4\x4a\xef\x99\x34\x41\x6c\x95\x10\x8f\x4e\x8b\x68\x28\x61\x00\x1c\xc2\xb5\xb0\x5c\x15\x62\x90\xb7\x26\x00\x07\x7f\x16\x8b\x1d\xab\x22\x1a\x02\x55\x59\x3f\x5d\xfb\x46\x71\x45\x53\x49\x46\x0c\xe8\x18\x8d\x0c\xce\x52\x70\x56\x63\x44\xaa\x67\xc8\x61\x33\xbe\x51\xf6\x56\xb3\xdd\x46\xfa\x18\x59\x46\x33\x74\x23\x3a\x0f\xcb\x1f\x34\x47\x25\xba\x7a\xd4\x0b\x6e\x3c\x32\x1f\x63\xf5\xc6\x86\xe3\x67\xe0\xc3\x30\x13\x16\x70\xe2\x59\x85\x0a\x16\x88\x78\x7a\x16\x81\xf3\xf6\x76\x2b\xec\x90\xcf\xef\x4a\xc3\xc7\x20\xeb\x91\xc9\xb8\x96\xcc\xfb\xdb\x42\x5e\xf1\x65\x86\xab\x6b\x4b\x71\x09\xaf\xcb\x18\x36\x3f\xc2\xa6\xc5\x67\x26\x65\x0b\xb2\xbc\x6e\xca\xaa\xae\xc4\x3c\x15\xa1\x59\xf3\x18\xdf\x43\xaa\x4e\x8c\x9e\x9c\x6d\xf5\x81\xb3\x59\xa4\x13\x63\x7f\x08\x11\xc6\x30\xd7\x3d\x6d\xd0\x5e\x34\xa8\xab\x62\x8b\xe7\x43\x32\xe5\x73\x03\x45\x5c\x94\x2c\x10\x51\x46\x81\x81\x8a\x2b\x4a\x1e\x6b\x92\x0d\xe2\xd8\xfa\xed\x1d\xa8\xa1\xdc\xd2\x70\x96\x40\x91\x17\xfe\x5b\x4b\xd3\xb6\xe4\x48\xd3\x90\xe6\x53\x6f\x11\x3f\xe4\x3c\xeb\xc8\xc3\x9f\x54\x57\x80\xbf\x59\x0a\xe4\x49\x74\xaf\x92\x9a\xb0\x8f\x50\xa3\x60\xa4\x6d\x8e\x5c\x5a\xfb\x51\xde\xfd\xd2\xfb\xba\x28\x05\xa4\x1b\x32\xa6\x2d\xe8\x5f\xa7\x1e\x34\xc6\x67\xf1\x4d\x80\xb8\xd1\xc0\x18\xc6\x85\x6f\xec\x56\x74\xef\x3f\x09\x03\xbb\x09\x11\x10\x6e\x9d\x83\xe3\x99\xed\xe3\xde\xda\x65\x0b\x2c\xc2\x1c\x76\xb4\x5a\xd3\x22\xbb\xcb\x93\x31\x10\x5c\xca\x8f\x64\xa2\x4d\xee\xa3\x52\xc2\xa1\x74\x7d\xe9\x52\x29\x77\xac\xc6\xf7\x52\x5a\x98\x8e\x69\x09\xa6\xd8\xd1\x7f\x72\x8a\x1c\xb2\x9b\xbd\xa7\xbe\x8f\xdb\x66\xb9\x54\x3e\xd2\x8f\xb9\x72\x7e\x67\xc9\x75\x4e\xa1\xe5\x1d\x60\x86\x43\x7b\x18\x48\x8d\xac\x0f\x1d\x51\xfd\x26\x13\x36\x34\x4b\xd7\x93\x01\x48\x67\x96\x68\xe5\x2f\x4b\x8f\x20\xb7\x87\x55\x81\xb3\xd6\x8b\xed\x6a\x1e\xb9\xea\x57\xba\x3f\x50\x1f\x5d\xce\x0e\xee\xef\xcd\xbc\xc3\x00\x52\x26\x36\xef\xbc\xdf\x8e\xb1\xa8\xcb\x39\xea\xc0\x5b\x0a\xd0\xa9\xdf\xe8\x44\x25\xd8\x46\x81\xbb\x36\x90\xb8\x15\xdf\x4d\x37\x97\xd3\x1b\x08\x98\x41\x8d\x5f\x68\x7d\x33\x73\xea\xfd\x93\x9d\x65\x5e\x08\x09\x3a\x3b\x42\x4c\xbd\xb6\xd7\xaf\x04\x20\x68\x40\x5d\xca\xdc\x21\x01\xbc\x84\x6f\x10\x1f\xb5\x23\x87\x0c\x20\xff\x7f\x35\x29\x16\x05\x9c\xd4\x3c\x96\x2d\x30\x61\x8e\x84\xc0\xab\x62\x85\xed\xd0\x5a\xa2\x15\x0f\x46\x96\xe9\x13\x7e\x6f\xe4\xfc\x7d\x76\x67\x6e\xe3\x38\x05\xd1\x86\x27\xfc\x29\x25\xc8\x44\x79\x40\x09\xc2\x37\xe7\xdb\xa3\x94\xdb\xea\x95\x41\xcd\x71\x4b\x59\x3f\xf3\x81\xb9\x0a\x99\xc1\xfc\xad\x57\xe1\x2b\xed\xf0\xa4\xdf\x2c\x42\xcc\xfc\x6f\xa7\x70\x5a\xb6\x61\x80\x46\x21\xe3\x24\x12\xe4\x5f\x3e\xfb\x7d\x69\x93\x72\xd1\x23\x00\x87\xce\xb5\x17\xbb\x10\x84\x2e\x61\x23\xa4\xdc\x6e\x7f\x27\xa1\xdd\x73\xde\xe2\x6b\xa7\x1a\xc4\xde\x3b\xd0\x69\x7f\x4c\x9c\x99\xd3\x7a\x0e\xf3\xa5\x9f\xd4\xec\xef\x91\xb0\xde\xd3\xb2\x7d\xa7\x1d\x11\xaf\x83\x90\x9e\x69\x88\x66\x2c\xc8\x58\x2f\x70\xe9\x95\x4a\x31\x28\x37\x0d\xc9\x51\xab\x6b\xbc\x24\xd8\x24\x23\x33\xf4\xac\x0a\x83\x2f\x3c\x51\xa9\x9a\x49\x78\x75\x1f\x8f\x7c\x3a\x64\xde\x37\xf7\xbc\x1e\x45\xeb\x4c\xdc\x32\x86\xfd\x7e\x5f\x7a\x05\x06\x99\x62\x1e\x6c\xa3\x75\xb2\x98\x39\xe9\xc3\x25\xeb\xaf\xf6\xd7\x09\x2a\x5d\x49\x27\x54\x26\xf0\xdb\x90\x74\x8b\x15\xd6\xc8\xe3\x99\x38\x88\xd1\x2b\xc4\x8c\x92\x93\x72\xc1\xf1\x7a\xcb\x00\x9f\x88\xbf\x5b\x30\x77\xda\xce\xb9\x1b\x30\x5f\xf5\x82\x03\x11\xaa\x5c\x86\xbc\xf9\x3e\x2a\x33\x3d\x19\xc7\x5c\x07\x1f\x85\xb5\xe0\x5b\xe2\x9c\xc2\x67\x35\x61\x16\x2a\x76\x34\x62\xcf\x8a\xe4\x6e\x2c\x70\x96\xa8\xe9\xcd\x89\x0f\x87\x17\x55\x84\x9b\xc0\xbf\x8c\xa7\x21\x0d\x5d\xc0\x16\xba\xcd\x8d\xdd\x73\x00\x54\x4b\xa8\xb2\x2e\xc5\x1f\xea\x1a\x69\x7f\xf8\x31\xce\xe3\x47\x53\x37\x0e\x38\xf8\x6a\x34\xb9\x16\xb5\xdd\x74\xf1\xed\x5d\xda\x48\x20\x30\x9a\xfb\xf4\x16\xd5\x8f\x25\x71\xe9\xf8\xbc\x2b\x68\x66\x32\x1d\x90\xd0\x0b\x6f\x41\xb9\x0b\x70\x35\x69\xfd\x31\xfb\xf5\x18\xb0\x9b\x61\xbf\x7c\xb7\x3b\xe3\x56\x19\x15\x40\x00\x10\x33\xc8\xac\xe8\xb9\x78\x83\xab\xfe\xf8\xfe\x26\xb8\xde\x54\x18\x8d\xbf\x75\xff\x83\x08\xc6\xd1\xd4\x7e\x68\xe2\x59\x28\xd5\xf4\x70\x84\x7a\xb6\x32\x70\xc6\xdc\x03\x17\x9b\x9c\xcb\xd6\xd5\x5e\x9d\xa5\x5f\x32\xc8\xa3\x57\xa9\xd1\xbd\xc1\x6b\xc0\x89\x1c\x7b\x5b\xb4\x90\x1e\x58\x29\x60\x59\x61\xbc\x43\xfa\xc9\x42\x3c\xe4\x3c\xfd\x26\xe2\x1b\xca\x0d\xb9\x89\xb7\x44\x16\x76\x2e\x94\x07\x16\x6c\xea\xf7\x34\xaf\x21\x93\x9b\x6f\xd9\xe6\xb4\x96\xc8\x11\x72\x10\x37\xc7\xcd\x07\x23\x96\x95\x2d\xb3\x14\x10\x60\xcf\x0f\x08\x3a\xa1\x4f\x80\xb6\x11\x42\xdf\xf3\xbc\x0a\x00\x3e\x33\x2f\x15\xe2\x34\x76\xdf\x63\xf4\x50\xb6\xd4\x34\x3f\xe6\x8f\x5c\xa2\x7b\x10\xd9\x53\x7a\x91\x61\x8f\x88\x76\x33\x5c\x87\xfe\x01\xa0\x56\xac\xa9\x61\x79\xee\x31\x90\x5b\xc9\x44\x2c\x4b\x8a\xd5\xaf\x57\x04\x5f\x20\x07\x5a\xa2\xdd\x9f\x5c\xb4\xd4\x3f\x68\xf3\x30\x3c\x76\xc2\x71\x3f\x93\x36\x54\x48\x8e\x12\x8c\x12\x88\xe6\x11\x5c\xc7\x74\x82\xc0\xcd\xad\x3a\xd4\x6c\xda\x30\xe8\x7b\x2d\x88\xa2\x5b\xb2\x7f\x61\x6c\x28\xee\x77\xf3\xaa\x48\xad\xc7\x6d\x7a\xbb\x26\x74\x82\x07\x25\x47\xb3\x71\xd9\x8d\x3d\x2a\xf8\xb8\xa4\xaf\xd7\xaf\x0d\x43\x6e\xf1\x73\x79\x06\x2a\xbb\x1a\x0e\xa8\x8b\xb0\x10\xae\x28\x9f\x2e\x59\x1d\x32\x08\x8b\xf3\xd0\x82\x20\xa4\xec\xd4\x19\x1e\xbc\xf6\x97\x3e\x1a\x90\xcd\x21\x3c\xaa\xc1\xb9\x18\x2f\xde\xfe\xa0\x30\x44\x8e\x62\x4a\x23\x8b\x42\xd4\xcc\x3d\x73\x40\x3c\xc6\x1e\x2a\x9a\xca\xa5\xa6\x14\x48\x8a\x0b\x46\x9d\x4c\x50\xa5\x9e\x05\x23\xa7\xcb\x25\xd4\xbd\x6b\x69\xf8\x70\x41\xd8\xa4\x7d\x59\x6f\xc1\x17\x1d\x1c\x6e\xe1\x86\x5f\x9b\xdb\x28\xda\xd2\x61\x93\x86\x97\x04\x6f\x8c\x18\xa1\xe5\xb6\x57\x3c\x1c\xd8\x5e\x69\xcc\x4e\x74\x05\x21\x53\x77\x54\xbf\xa6\x7e\x35\x60\x65\xde\x47\x63\x2c\x67\x3d\x19\x90\x2e\x63\x36\x08\x72\x3c\x6f\x47\x4a\xc1\x51\xfa\xfb\x6a\x5f\xb6\x1d\xe5\x38\x44\x31\x3b\x02\x4c\x5b\xc8\x7e\x00\x46\x05\x7d\x81\x71\xc3\xb2\x7b\x3e\x16\xac\xa6\xd3\xe6\xea\x4e\x17\x6a\xc1\xd3\x9f\x9c\xf2\xea\xae\xd4\x13\x35\xef\xa3\x9f\x92\x22\x21\x0b\x5c\x6a\x2d\x9c\xce\x28\x3c\x39\xb6\xb5\xc1\xd4\xc4\xce\x7c\xd6\x10\x89\x8a\x16\x6d\x3d\xdc\x29\xc5\x12\xad\x34\xdd\x2a\x52\xe1\xb7\xa7\xbf\x22\x84\x04\xf9\xea\x81\x8d\x01\xd7\x32\x8c\xe6\xb9\x46\x35\xd4\x47\xfc\x8d\x18\xa0\x02\xd3\x23\xf1\x97\x58\xde\x6f\x5e\x25\xfc\x1c\x2c\x4a\x82\x15\xc2\xd3\x81\xca\x96\x24\x4b\xc1\x1e\x92\x98\x45\x7e\x83\x62\x27\x67\x47\x91\xf2\x8b\xf8\x71\x6e\xc5\xf7\x04\x89\xaa\x39\x9f\x64\x63\x33\x77\x78\x2b\xfa\xa6\x1c\x4c\x50\x79\xb3\xab\x79\x88\xca\xec\x8b\x52\xfe\x93\x87\xaa\xdf\xdd\xf8\x55\xd6\x0c\xe5\x22\x78\x2c\x54\xe4\x67\x12\x6a\x86\xfe\x0d\xab\x9d\x82\x20\x81\x0f\x21\x92\x14\xec\x40\x30\x57\xa2\x42\x69\x7c\xc3\xa8\xc5\x50\x89\xd1\x2a\x9c\x71\x55\xb6\xea\x4c\xf4\x88\xde\x94\x5c\xff\xe5\x3e\x1b\xdb\xfe\x82\xdb\x1d\xf9\xd0\xff\x03\x57\x64\x60\x53\x4f\x7b\x52\x4c\xc6\x1e\xdd\xae\x34\x50\xd1\x18\x79\x4e\x46\x1f\xd7\x1a\x29\x08\xe1\xcd\xad\x00\x16\x12\xec\x0c\xa0\x0f\x0e\x12\xe5\x56\x64\xfb\xbf\xa7\x29\x63\xec\x40\x3b\xd9\xa2\x3c\x19\x16\xf5\x8f\x44\x00\x8d\x00\xf2\x4b\xf3\xc2\xa9\xf5\xa9\xd2\x1e\x6c\xcf\x1f\xbb\xd5\x96\xe4\x93\x29\xd6\x42\x09\x64\x30\xc5\x0b\x6b\xa5\x83\x54\xfa\x83\x8d\x84\x0f\x9c\x4d\x3f\x58\xb6\xa3\x9d\x90\xa5\x04\x1e\x4c\xe9\x24\x4e\x88\x49\xc1\xf0\xa7\x03\xab\x1b\xa0\x5a\x5a\xfd\x04\x36\x24\x5d\x8b\xef\x5f\xe1\x91\xb4\x0f\x9d\x36\xa3\x25\x16\x35\xd2\x65\x2a\x34\x11\x30\x9a\xc4\xe8\xbc\x0f\xcf\x74\x09\xdb\x4e\x52\xdb\xa1\xac\xe5\x20\x7c\x00\x4f\x5a\xd3\x8e\x38\x1e\x10\x16\x1c\x7c\xbf\xcd\x12\xb6\xb8\xdb\x86\xc2\x26\x68\x5f\xba\x0b\xb3\xd6\x2f\x0c\x60\xf4\xce\x79\x09\x59\x1a\xb9\x84\x08\x8e\xce\x00\xd0\x97\xc2\xc2\x38\x64\x86\x50\xa4\x8d\x76\xf2\x12\xb8\x85\x47\x86\xa8\x33\x37\x34\xaf\xdc\xc4\xa8\x2e\x36\xc8\xbc\xc7\x61\xbb\x0f\x38\x60\xa0\x66\x04\x4b\x48\xd3\x10\x6a\x76\x28\x88\x29\xa0\x6c\x4b\xc4\x47\xf0\x83\x97\xe3\x4e\x83\x14\x16\xae\x32\xc2\xac\x88\x31\xbd\x35\x9a\x6e\x3f\x03\x2a\x20\xbd\x16\x41\xf9\x04\x67\xcc\xe9\x7c\x97\x38\xbf\x2a\x31\x1c\xab\x3e\x8b\x7e\xe2\x60\x70\x63\x29\x3d\x3d\xff\xc9\x8a\x57\xd2\x13\xa5\xf5\x1f\xd0\xc3\x44\xc9\x6b\x0f\x4a\x0e\x84\x58\xa8\x37\xfe\x9f\x22\xba\x1e\x7e\xdb\x4f\x51\x37\xd8\x08\x6a\x5f\x13\xbe\xfb\x21\xf3\x79\x22\x21\xed\xd4\x1f\x14\x7a\x25\xce\x0e\xfd\xd8\xc6\x13\x1f\xfa\xd9\x18\xaf\xfd\x60\x58\x71\x59\x70\x7c\x3a\xc2\x32\xa3\x19\x02\x2a\xf9\xe9\xc7\xe9\xeb\x78\xfe\xc5\xd6\xd0\xfe\xae\xba\x9b\x7e\xb3\x38\x49\x14\x28\xbf\xa0\xe3\xde\x94\x21\x57\x11\x27\x49\xf4\x25\xec\x67\xda\x9f\x9b\x2a\xfe\xb4\x38\x4a\x0a\xbc\x20\x86\xb1\x20\xa5\x7e\x0d\x6a\x98\x95\x78\x35\x2a\xc5\x6d\xab\xb8\x05\xb0\xec\x52\xa3\xf0\xe4\xc3\x9f\xf1\x4f\x96\x92\xa4\xb2\xe9\x4e\x3b\x9f\x17\xd8\xd0\x14\xa0\xab\x8b\xf9\x8c\x87\xa5\xf9\xeb\xb7\x27\x1b\xf0\x31\xf6\x3e\x3e\x85\x1f\x92\xbb\xfe\x03\x0f\xd7\x54\x3e\x2b\xcc\x75\x15\x48\x61\xd6\x45\x98\xe5\x14\xd5\x9d\x02\xe2\xd2\xa1\x24\x00\xcc\xe2\xec\xb0\xe1\x7a\x9e\xf1\x55\xe7\xda\x64\x9d\x5d\xb6\xbe\x5d\x91\xa9\x0e\x43\xbd\x8e\xf3\x98\x74\xe3\x59\x30\x83\x73\xb4\x5d\x8c\xb8\x64\xa4\x89\x5e\xc7\xe1\x41\x58\x44\xd1\x3b\x2a\x94\x30\x8c\x25\x4c\x10\x71\x67\x2a\x46\x18\x1c\x85\x8c\x4c\xcc\xa2\xeb\xf6\x04\x28\xb3\xd7\x1b\x82\xc2\x2d\xfb\xe4\xea\x91\x00\x17\x37\x28\x9e\x9a\xb5\xd3\xef\xe9\xad\x26\x63\xf1\x02\x8c\x51\x98\xfe\x97\x1d\xaa\x05\xc7\x44\xfa\x73\xa2\x27\x6e\x72\x3f\xc9\xfb\xbb\xee\xee\x00\x2b\xfd\xec\x64\x9e\x99\xce\xa5\xc7\xa1\xd0\x82\xb8\x30\x1b\x25\x5a\x9f\xfe\x30\xb2\x81\x40\x1e\x60\x39\x50\xb6\xe7\x67\x47\x3c\xab\x73\xad\xc9\x45\x60\x3f\x5a\x17\x7f\x4d\xf1\x8a\x0a\xde\x23\x93\x6e\x5f\x06\x9f\xa1\x7d\x87\x5d\x1f\xdb\x02\x6e\x78\xd0\x54\x2e\xfb\xb8\xf1\xb0\x97\x1c\x7c\xa6\x71\x3e\x60\x48\xb0\xe3\xfd\x79\x87\x63\xda\x1b\xaf\xae\xca\x2d\xd0\x6d\x64\x13\x3d\xa1\x32\xb9\x66\xe3\xa3\xde\xcb\x84\x67\x26\x83\x49\x95\x67\xd4\xdc\xb2\x73\xce\xb2\x11\x11\x44\xda\x5d\xcb\x9c\xb7\x90\x8a\xf5\x17\xb7\x21\x97\x07\x31\x71\x87\x93\x45\xc1\x73\xb7\x13\x35\x71\xb8\x06\x81\x37\x54\xfe\x1d\x5b\x2d\x31\x1a\xe6\x3d\xd3\x51\xc3\xa4\xaf\x80\x2e\xd1\xdd\x5e\x0e\x23\xaf\x9f\xb6\xe9\x85\x26\xf9\x2f\x08\x45\x03\xe1\xa5\xf6\xd4\xf4\x40\x7f\x35\x15\xbf\x14\x5d\x92\x9e\x4a\x8f\xff\x87\xd4\x68\x38\xc0\xf3\xbb\x70\xbb\x0f\x85\x2f\xef\xbe\x35\x2e\x3e\x01\x9b\x4b\x0e\xf9\x33\x88\x84\x01\x80\xc6\xae\xed\x1a\xe8\x6d\x4e\x15\x79\x84\xd9\x14\xaa\xda\x83\x75\x08\x99\x98\xbc\xe4\x18\x23\x42\xb0\x65\xa3\xd0\x4b\x2f\x8c\xc0\x9f\x65\x02\x5f\xc7\x6b\xe8\xb1\x7c\x5e\xe3\xee\x60\x70\xfa\x39\xb4\xe8\x44\xb6\x0a\xb6\xbb\xd1\x4b\xc1\xf2\x80\xe4\x96\x5c\x7d\x9c\xc6\x65\xe5\x76\x12\x29\x27\xeb\x2b\x14\xf0\x1f\x93\x3d\xca\x12\x36\xdd\x62\x34\xa2\x55\x19\x80\xad\x76\x22\xa6\x6f\x93\x82\x17\xd4\x66\x3f\x78\xbb\x41\x3c\x15\x9d\x1a\x8e\x22\xef\x27\x5e\x48\xde\xa7\xe7\x5e\xdf\xda\xde\x36\xdf\xd0\x5e\xb7\x7c\xd2\x37\xa6\x77\x83\xec\xb9\x15\xf9\x26\xaa\x58\x0c\x85\x1e\x46\x25\x7b\xcb\x00\xd6\x70\x36\xf3\x30\x31\x88\x50\x40\x34\x7b\xde\x31\x75\x69\x29\x9d\xff\x0c\x71\xc7\xa2\x5d\xcc\x14\x54\x64\xef\xce\x8e\x96\x9d\x05\x80\xa0\xbc\x0d\x88\x4e\x6e\x57\xb4\xec\xa0\x5b\x5a\x15\xc2\xa3\xd9\xca\xa1\x82\x89\x22\xf6\x71\x52\x2a\xc8\x47\xd2\x47\x97\x18\x99\x0c\xd1\xee\xf9\xd1\xcd\x3a\x8e\x03\x7d\xee\x2e\xb0\x64\xfb\x39\xf1\xde\xac\xa3\x5b\xdc\x4d\x1c\x66\x11\xca\x8e\xc9\xad\x44\x5e\xe0\xd4\xc4\xec\xad\x21\x3e\x27\xa2\xec\xc6\x3d\xef\x79\xef\xfc\xbf\xa9\xf4\xbd\x87\x45\xcd\x05\x66\x10\xd5\x26\x8d\x9d\x64\x13\x5b\xd7\x31\xd7\xdb\x74\xc7\x67\x3f\xb4\x74\x32\xcf\x93\xaf\x6e\xed\xc9\xc9\x5b\xf6\xee\x35\x2f\x8e\x1c\x07\xd7\x0a\x28\xd5\xdc\x68\xc1\x26\x24\x0b\x4e\xdd\x00\x58\x2b\x5d\xad\xa8\x15\xc3\x77\x21\x07\x2d\xb3\xcf\x60\x06\xca\xa4\xa4\x15\x9f\xd2\xb6\x77\x92\x8f\x51\xf7\x72\x53\x62\xd9\xde\xb5\xdb\x3d\x14\x7a\x5a\x54\x9f\xd7\x2e\x9b\xee\x42\x84\x36\x77\xa2\xdc\x1a\x97\x89\x95\x9d\xbf\xb9\x44\x7c\x8c\x9f\x54\x7f\x0c\x90\xc2\x9f\x27\x34\x3e\x2d\xf0\xfe\x4d\xc0\x6c\x83\xf2\x39\xa5\xff\x65\xee\xfa\xc2\xcb\xb2\xce\xcc\xef\xf8\xa1\x54\x72\x37\xe8\x53\x89\x21\x57\x44\xee\x79\xc0\x19\xd5\x80\x1f\x64\x7c\xc1\x7d\x62\x3c\x28\x01\xb8\xe5\x72\x02\xf8\x4e\x78\xe3\x7b\x46\x5f\x2f\x16\x5f\x4d\x1a\xd0\x90\xdb\xa3\x1d\x20\x69\x5e\xe3\x1b\x59\xe2\x68\xb9\xa2\x77\x8f\x86\x3e\x57\xdc\x2a\x9f\xbc\xbe\x41\x3a\x4f\x90\x73\x27\xf3\x0a\x60\x0f\x36\x46\x37\x87\xcf\x8d\xce\x93\xce\x11\x70\x84\xca\xdd\xbf\xbf\x5a\x73\x26\x50\x31\x9b\x5f\x27\x18\x33\x47\xe6\x2d\x20\x80\xf1\xb5\x84\xf9\x83\x16\x79\xc4\x8d\x53\x87\x80\x98\x3b\x8f\xcc\x77\x85\x75\x19\x45\xa8\x4a\x6d\xa6\x9c\xa6\xb4\xe9\x4d\x1f\xb4\xef\xda\x43\x07\x95\x00\x11\xb4\xdb\x46\x05\xc5\xa8\x68\x80\x63\x8f\x39\x33\x3b\x6d\xc0\xcf\xa3\x48\x96\x92\x9b\x3c\x7b\x17\xd5\x63\xe1\x75\xda\x68\xf8\x19\xb7\xd4\x0d\x41\x05\x36\xd4\xb1\x2f\x92\x53\xbb\xfe\xbb\xda\x94\xd1\x08\xfc\x19\x72\xbd\xa7\xeb\xd6\xbe\x91\xd6\x01\xfe\x5d\xcf\xeb\xe8\xa3\x6a\x35\x1e\x3e\xf4\x29\x47\xa3\x1e\xdf\xce\x5d\x6e\x28\x46\x38\x40\xd3\xb2\x4b\xc1\xdd\x48\x9e\x6c\x01\x09\xf9\x5a\xf2\x03\x43\xc7\x43\x40\x6d\xf9\x40\x37\x1a\x2a\xbb\xab\xc2\xab\xd2\xa2\x05\xc6\x4a\xde\x5d\x16\x03\x67\x55\xe8\xe2\x6a\x13\xa2\xa5\x2b\x6c\xe9\x0e\x5a\xaf\x80\x32\x5d\x90\x3e\xe6\x58\x53\x22\x90\x1c\x25\xc2\x28\xc2\x07\x8e\xc4\x36\x31\x63\x65\x17\x02\x85\x08\x65\x5b\xa4\xc5\x63\x70\xb7\x96\xb4\x13\x6c\xdf\x90\x72\x55\x73\x38\x12\x3f\x20\x94\xba\xbc\xee\x89\x65\xfa\xf1\x80\x35\xc1\xe8\x3b\x91\x54\x03\x8b\x6b\x19\x52\xeb\x28\xc8\xe3\x32\x17\x8d\x64\x4d\xcb\xf5\x6d\xe8\x49\x9f\xd9\x43\x7c\xc1\xa8\xb3\xa8\x52\x9c\xdd\xeb\xbf\x53\xc9\xa2\x2f\x95\x69\x32\x56\x4b\xe2\x7c\x54\x96\x3b\x28\x93\x90\x3d\x84\x03\x29\x41\xf0\x12\x2c\x3e\x9c\x5d\x15\x76\x92\x4e\xe9\x3f\x10\x78\xf5\x47\xe5\x27\xc9\x64\x52\xf8\x10\x61\x30\xcf\xde\x12\x88\xde\xd1\x80\x69\x76\xd9\x67\xf3\xf8\xe5\x7e\xb6\xc1\xce\x71\xf6\xf3\xfe\xc4\x2a\xff\x7c\xb4\xd2\xc5\x00\x73\x14\x89\xac\x04\x30\xac\x2f\xb0\xda\xc6\x87\xcb\xf9\xd6\x3d\xfb\x31\x09\xd0\x2f\xc0\x53\x30\xef\xb0\x92\x27\x4d\x4d\xf7\xd8\x00\xc0\xd4\x41\xb8\x46\x5b\x94\x56\x89\x04\x45\x69\xe4\x0f\x80\xc5\x39\xaa\xc8\x0f\xc0\xad\xa0\x91\xf2\x30\xeb\x08\xac\x3d\xe9\xfa\x71\x76\xd9\xfa\x09\xce\x5f\x9b\xc8\x71\xd6\x13\xce\xe2\xf3\x49\xe6\x28\xe4\x4d\x29\xaa\xe3\xfc\xfa\x1e\x75\xe2\x50\x7a\x3b\x6d\x67\x83\x2e\x33\x32\x99\x98\xcb\x13\x95\xe9\x42\x5e\xbf\xb7\xdb\xc4\xdc\xc2\x90\x45\x68\xca\x87\x49\xd4\xd7\x2b\x8f\x87\x8e\x29\x8d\x15\xb8\x53\xd4\xd9\xc2\xc8\xf9\x66\x69\x70\xc8\xac\x19\x21\x58\xa2\xca\xe5\x52\xf1\x91\x55\x08\xac\xb6\x83\x40\x18\x57\x43\xc8\xbb\x99\x26\x61\x00\xe9\xc9\xb9\xc6\x7c\x49\x05\x39\xd5\x96\x2e\xb7\x47\x8d\x37\xca\x20\xe5\xcd\x7f\xde\x11\x7c\x4e\x92\x2e\x54\x2d\xb1\xac\x14\x9e\x4d\x84\x99\x7a\xa5\xb9\x09\x9a\x06\x9d\x73\x3a\xd3\x83\x4b\xb6\x9c\xb9\x17\x23\xe2\x84\x1c\x3f\x70\xc5\x85\xdf\x9a\x17\x2d\xf4\xa2\x6c\xb6\xd4\x37\x16\x47\xd8\x26\x55\xf1\x7c\x86\xea\xa7\xab\xff\x67\x36\x1e\xb3\xdd\xd3\xd7\xe9\x13\x8e\xb6\x30\x05\xe3\xd6\x06\x49\x6a\xfa\x88\xff\x6a\x43\x42\x51\xc2\x74\x1d\x8f\x28\xd9\xbb\x6a\x91\xc2\x7f\x7f\xcf\x60\x0d\x45\xa5\x8e\x95\x9b\x37\xf2\xf6\x60\x53\xe9\x29\xfc\x4e\x75\xe1\xd0\xed\xa4\x9d\x3e\x77\xc3\x2b\xdf\xf0\xba\x62\xa5\x34\x20\xa3\x13\x0f\x99\x02\x63\x1d\x50\x84\xfe\x38\x80\x15\x18\x89\xc1\x0e\x0f\x96\x46\x57\xb6\x83\xe5\x07\x84\x53\xc3\xd8\x1e\x2d\x23\x2a\x35\xbd\xff\x3f\x99\x68\x04\x46\xad\x22\x47\x90\x7b\x23\xe7\xbb\x15\x51\x92\xa7\x32\x46\xb2\x2d\xf9\x76\xb6\xe5\xd3\x02\xb6\x0e\x14\x3a\x56\xa4\xbd\x05\x84\x8a\xf1\x28\xd0\x43\xf0\x5c\xe9\xed\x69\xe1\x29\xb9\x60\xde\x98\xc2\x75\xcc\x6a\x39\x1f\x44\xe5\x52\xcc\xbe\x89\x47\x81\xa5\x07\x4f\x8d\x8e\x22\x3b\x8b\x52\xb7\x62\x15\x7f\x33\xca\x4d\xc4\x67\x42\xd5\x42\x44\xbe\x8b\x3d\x61\xa5\xff\x16\x40\xc9\xd5\x59\x89\xe8\xf1\x2e\x7d\x91\x0b\xde\x83\x0c\x32\x23\xb8\x0c\x92\x16\x94\x6f\x20\x5e\x9d\xda\x05\x3f\x36\xad\x3a\xaf\x41\xe5\x5b\x4a\x02\xf0\x21\x90\x76\xa3\x66\xf6\x43\x51\x3a\xa3\x0b\x7e\x0c\x79\xfe\x5f\xf2\x4d\x90\xc0\xe9\x65\xd9\xe9\x29\x62\xf5\x75\x05\x0a\xc3\xd6\xd8\x51\x81\xda\xa8\xb1\xa1\x76\x31\xde\x57\x6f\xc8\x2e\x8b\x30\xf1\xd9\xc6\x47\x78\x1d\xc7\xd9\x3e\x8c\x0b\xe3\xbf\x94\x01\x31\x17\xf8\x7c\xd3\xe5\x47\x1a\xd6\xaa\xfc\x11\x9a\x9b\x6e\x7e\xf9\xeb\xff\xf3\x88\x5e\xc5\xcc\x6e\x11\x92\xde\xdd\xe9\x3b\x7c\x5e\xc3\x8d\x76\x24\x98\xe8\x4b\xec\x53\x45\xcf\xd1\xad\x37\xce\xda\x04\xfb\xeb\x2a\x66\xa1\x8f\x5c\xd5\xa8\x9e\x29\x2b\x72\x22\x3d\xd5\xf7\x72\x4c\xdf\xa1\xbd\x8c\x3b\x3b\x8d\x1d\xee\xe2\x61\xd7\x6d\xb7\xa2\xaf\x3a\xd3\x9f\xba\xf9\x6a\x20\xb4\x70\x1a\x0e\xbc\xd9\x67\x6f\xd5\xfd\xe3\x13\xfe\x70\x3b\x97\x32\x08\xf2\x0d\x7c\x47\xdb\xfb\xab\xaa\x11\x1e\xbd\x8e\xbc\x61\xe3\xd8\x4a\xb5\x8d\xde\x2a\xd8\x1e\xb7\x24\x44\xec\x2f\xe7\x1e\xc7\xd4\x89\x2f\xe1\x12\xc8\xf7\xb0\x51\xd5\xfb\x94\xea\xad\x3e\x35\x23\x44\x37\xa7\xaf\x30\xa6\x20\xfd\x07\x1c\xe7\x12\x56\x58\x74\x4a\x93\x0a\x66\xae\xae\x10\xc5\x0b\x36\xd2\x17\x7f\xb0\x86\x67\x35\xd9\xfd\x0a\x9a\x43\xe7\xfe\xf7\x33\xfa\xc9\xe4\xf5\xe0\x68\x48\x88\x47\x7b\x5b\x00\x8e\x0a\xde\x35\x36\x4a\x18\xfe\x07\xd9\xa5\xbb\x96\xf3\xf4\x8e\xa1\x38\xb5\xd9\x64\x1b\x3c\x67\x32\xd0\x81\x67\x66\x79\x2f\x9f\xa5\x2b\xeb\x35\x7c\x26\x6c\x31\xda\x07\x8c\xbf\x0f\x02\x93\xc2\x27\x56\x8d\x9b\xa0\x09\x68\xec\xac\xf2\xc2\x53\x29\xf9\x61\x65\x2c\xc2\xe7\x2f\x71\xc7\xea\x23\xf3\xa6\x64\x6d\xcf\x3d\xa2\x1e\x38\xf9\xd0\x43\x98\xf3\xdb\xa7\x82\x12\x16\x50\x8e\xbf\x6f\x64\x93\x57\x63\x9f\x90\x86\xd9\x58\x7c\x74\x3e\x1e\x8f\xa7\x51\x82\x4b\xf1\x99\xe2\xb3\x08\x24\x51\xe3\x27\x91\x6f\x10\x3a\x35\x8b\x82\xd3\x6d\x36\x6e\xcb\x37\xe8\x4c\x57\xbd\xc3\x8d\xf2\xc1\xec\xeb\x00\x51\x59\xdb\xe6\xb6\x78\x6d\xe4\x21\x7d\xa7\x69\xa1\x6a\x3a\x07\xc2\x90\x29\x7b\x0c\x5f\xa3\xce\x9f\x45\x90\x11\x26\xa0\x89\xcc\x13\x74\x22\xaf\xf8\x1f\x0b\x03\x1c\xde\x10\x62\x6b\xe4\x6e\x69\x74\x55\x55\x2f\x73\x80\xeb\x7d\xfa\xd5\x31\x4f\x37\xf6\xbc\x3f\xc8\xbc\xf3\xf6\x9f\x9d\x08\x84\x93\x09\xd6\x73\xd1\x97\x88\xb2\xcc\x9a\x93\x4f\x45\x8f\xbe\x5a\xef\xcc\x1a\xb5\x45\x31\x18\x5e\xc3\xb3\x05\xf0\x68\x15\x10\x6f\x34\xb1\x0e\xe0\x13\xf8\x51\xa5\x63\x76\xd3\xfb\x09\x65\x5e\x92\xbe\x3a\x1a\x68\xc9\x57\xd7\x8a\x8e\xd6\xc2\x99\x8c\xa9\xd1\xe6\xff\xaf\xf3\x0a\xdd\x7d\xeb\xb8\xc1\x33\xc8\x3c\x57\x79\x5b\xee\xce\x1e\xcf\x4e\xd1\x43\xf8\xa6\xf0\x76\xc9\x29\xd2\xd3\xfc\xeb\xe0\xda\xd1\x42\xa1\xa1\x8a\x9c\x13\x70\x40\x72\x0b\x9c\x6c\x01\x18\xf3\x98\x98\xeb\x66\x49\x27\xa4\xbf\xf8\x61\x40\xc5\x7b\x36\xfb\x54\xc0\x0a\xad\x7e\x7f\x24\xf2\x5b\x53\xa4\x5f\xb0\x59\x1e\x1b\x89\xde\xd1\x00\x29\xd2\xc3\x77\xfd\xc9\xfa\x90\x8a\xe4\xf7\xf7\x4a\x1a\x8c\x84\x6e\x0f\xd6\xeb\x4e\x26\x83\x08\x8a\x74\xfe\xad\xc8\xb7\x55\x59\xc0\xb7\x6f\xcd\x40\xf3\xb7\x0a\x5a\xbd\x39\x42\x43\xb9\x96\x26\x04\xcd\x88\x0a\x58\x68\x1e\xf3\x9f\x5d\xfc\xf4\xda\xc2\x06\x0b\xc6\x8f\x12\xf1\xc9\xc9\xeb\xcc\x8b\x05\x04\x3b\x0a\x7d\xc9\x5b\x84\xbd\x69\x13\xad\x74\x6a\xa3\x43\x4e\xec\xea\xb2\x6a\xf1\x00\x17\x1a\xaf\xf5\x43\x1d\x97\xd2\xfe\xab\xe6\xf8\x43\xe0\xba\x56\xcd\xaf\x0a\x2c\xbb\x0a\x7d\xbf\x77\xd6\x55\x41\x1e\x1d\x52\xc0\x60\x94\x61\x28\xe3\x15\x9e\x3a\x49\x39\x7f\x14\x86\xcc\x0b\x50\x67\x3f\xe9\x0e\xc7\xdf\xec\x79\x0d\xf2\xbe\x4d\xb7\x04\x19\x4d\xb9\x6d\x9d\xf6\xdc\x53\xc2\x58\x4f\x8c\x2f\xac\x1f\x93\x98\xa1\x3a\x9b\x7b\xf3\xf9\x20\x35\xf7\x6a\x67\x27\xdd\x47\xe6\xdc\xbf\x74\xcc\xc2\xea\x86\xb2\x1e\xd1\x3e\x46\xea\x09\xbd\x10\xf4\x7b\x6f\xff\x56\x3f\x17\xb7\x36\xff\x38\x02\xae\x92\x60\x2c\x24\xa2\x08\xce\xcc\xcc\x22\xe8\x54\x50\x98\x10\x19\xc6\xf4\x39\xca\x66\x97\xbf\x2e\x32\x7e\xc4\xfa\xf4\xe6\x0a\x14\x66\x12\x4e\x7a\x36\x46\x60\x84\x16\x91\x6f\x34\x62\x64\x49\x39\x81\x74\x99\x4b\x3b\xee\xe8\x4d\x79\x46\x3e\x66\x62\xfe\x7b\x0c\xad\xce\xd9\xfa\x33\x17\x9e\x59\x18\xa8\x91\x8c\xa2\xb8\x5d\x32\x03\x15\xb1\x9b\x01\xc6\x5b\xc8\x47\xb5\x97\x08\xdc\xf6\xb8\xfe\xd9\x68\x2b\xde\xa1\x35\x0f\x7b\x57\x38\x7f\xcc\xcf\xa5\x50\x24\xc1\x36\x0e\x09\x65\x67\x99\xd4\xbf\x25\x4a\x9e\x21\xfb\xd3\x4d\xbe\xc4\xb2\x42\x7c\x8f\xb3\xf1\x30\x1c\xb1\x18\x27\x63\xea\x83\x6f\xc8\x6b\x97\x89\x21\xd2\x8a\xb1\x7e\x02\xe6\x8b\x51\x52\xc7\xf4\x9e\x0a\x0e\xf6\xa4\x5e\x02\x78\xbf\xb3\xd3\xbf\x11\xe3\x40\x0c\x5e\xf4\x3a\x52\x8a\xd7\x7e\x2a\x57\xf0\x13\x76\x2f\xa9\x8c\xee\xac\xb3\xb7\xcf\x82\xaa\x84\x5d\xbd\xcc\x49\x64\x35\xb2\x7a\x29\x02\x52\x92\xad\x9b\xd0\xce\xf7\x0a\x81\x8f\x72\x02\x5a\x17\xbd\x8f\xd0\x6d\xcb\x98\xe9\x58\xcc\xa2\xff\x4b\xe8\xb8\x4f\x35\xde\xf7\x9c\x6e\xa4\xd6\x62\xd8\xeb\x93\x55\xf7\xb3\xba\xc8\xcf\xeb\x5f\x61\x89\x6d\x98\x1d\xb0\xd9\x6c\xa6\xaa\xcc\x06\xec\x08\x38\xb0\x67\x3a\x3c\x64\xf3\x1a\x6c\xf5\xe6\x68\x32\x1d\xf4\x0a\x24\xb9\x4f\xfc\x29\x22\x23\x85\x59\xc1\x5c\x4c\xcc\x63\xcb\x0c\x43\x50\x49\xa8\xa9\x22\x50\x9d\xe3\x3d\x0e\xef\x1d\x74\x11\xc7\xe2\xe0\x85\xda\x3d\xaf\x39\x33\xf5\xcb\x3d\x2b\x05\x09\x21\xb9\x60\x1e\x71\x8c\x26\xeb\xc6\x5b\x43\xd8\xd4\x20\x95\x4e\xe1\xb6\xb3\x51\xfb\xf0\xfd\x4b\xa6\x73\x73\x84\x69\x0a\x42\x5b\xcf\x9a\xea\xcc\x46\xa2\x9b\x5f\x31\xd3\x05\x5e\xc3\x48\xe0\x9d\x3e\xbd\xd4\xb5\xe8\xbe\xd6\x19\x1c\xb4\x84\xa1\x47\xa5\x1b\x2a\xe4\xb1\x53\xec\xd7\x57\x30\x12\xd0\xd0\x4f\x4f\x27\x8d\x7b\xa7\x78\x06\xc7\xc6\x7c\xd6\x6f\x25\x60\xe4\x35\xda\xf1\x8c\x37\xa8\x22\xdd\xea\xe9\xb7\xa9\x04\xdd\x56\x89\x96\x20\x8b\xe1\x41\x1b\xff\x76\x8a\x5a\xca\x1a\x30\xef\x03\xc8\x49\xbc\x3d\xea\x6b\xe9\xb5\xce\xe8\x00\x6b\xc0\x0e\x3f\x36\xa6\x73\x52\x8e\x26\xdc\xad\x3a\x27\x93\x62\x19\x17\x86\xf1\x09\xc6\x31\x16\x38\x71\xc3\xe5\x4d\xda\xab\x96\x4b\xc3\xf8\x38\xce\xd1\x8f\xf7\x65\xc2\x87\xdb\xa2\x63\x3e\x5b\xa1\xc0\x9b\x6e\x7f\x53\x13\xfc\x91\x09\xdb\x27\x8d\xd0\x53\xfb\xf8\x12\xac\x77\x40\x12\xc3\x21\x6c\x4e\xc7\xfd\x81\x13\x11\x8a\x69\xdb\x54\x16\x4b\x9d\xd6\x5e\xbf\xb9\xbb\x58\x46\xac\x74\xc7\xbb\x1e\xa3\xd1\x78\xdd\x1b\x33\x24\x0e\x5e\x47\xd8\x53\xa5\x07\x24\x95\x46\x91\x7a\x5a\x38\x06\xa7\x4b\xa3\x3f\xbf\xee\x64\x57\x38\xbc\x4f\xb3\xc9\x67\x6a\xe5\xc1\xbd\xe8\xdd\x7f\xf4\xe7\x6e\x3d\x1a\xb5\xd4\xfd\xc0\x83\xeb\x22\x4a\xae\x5b\x7c\x4f\x01\xbe\xe4\x50\xb7\x26\x61\x8f\xb6\xc1\x7b\x06\x81\xf2\x02\x01\xdb\x10\x09\x67\xe1\x86\x07\x64\x84\x3f\x04\x48\x39\x1b\x5f\xc2\x04\xef\x7c\xde\x58\xa9\xbe\xdf\x4c\xe1\x52\xb0\x43\x49\xfb\x83\x4d\x57\x0b\xc1\x6d\x21\x0a\x88\xd3\x9b\x4c\x77\x6b\x43\xcc\x17\x68\xc6\x93\x54\xc4\x25\xc8\x6e\x67\xf9\xdc\xd3\xee\x60\x56\xef\xee\xea\x5f\xb5\x4f\x23\xa7\xa7\x3b\x7a\xd5\xa8\x05\x25\xab\x5a\x5a\xa4\x91\x2e\x28\x67\x44\x21\xe9\xb9\x44\xf1\x6d\xe8\x11\xf0\xc5\xdf\xeb\x1c\x76\x7b\x2d\x99\x4f\x49\xea\x95\x61\x7c\x10\xbc\x2f\x74\x5d\x43\x13\xad\x5a\xed\x64\xc8\x94\x40\xe0\x81\x6a\x1a\xaf\x6f\xca\x6e\x7d\x29\x9b\x2e\xb8\x58\xf5\xa9\xc2\x13\x6f\x42\xad\x7d\xe0\x77\x7d\x7b\x34\xa5\x31\x19\x9e\x09\x8c\x18\x1d\xb2\xdf\xc7\x51\xf0\xeb\x84\x6f\xf2\x05\xe6\x9a\x06\xbb\xa1\xdc\x14\xde\x14\x27\xda\x3c\x4e\x1e\x4e\x98\xe9\xcc\xe8\x9d\x53\xd0\x37\x48\xaf\xd3\x8a\xa2\x58\x2c\x06\x5e\xae\x66\x36\x35\x43\x64\xa7\xb5\x25\xb2\x51\xd4\xd1\x6c\x31\x04\x6e\xfd\x6d\xd3\xe9\x82\x55\xa3\x7a\x4e\xbe\xe3\x0b\x10\x83\xee\xcc\x9d\xce\xdd\xac\xd9\xd1\xd7\x96\x9b\x23\x8a\x2e\x83\x51\x95\xc3\x0a\xfa\x29\x76\xb7\x75\xff\xe0\x89\x18\x51\x94\xa3\xfc\x29\x26\x29\xfb\x5b\xcf\xcd\x27\x9a\x9e\xf4\x60\x2c\x1d\xb5\xc9\x1a\x81\xd0\xfb\x58\xa4\x35\xe0\x29\xe2\x66\x1a\x49\x2a\x86\xcf\x0e\x9f\x09\x1c\x07\x71\x43\xe2\x52\xfc\xba\xc2\xf3\x4e\x06\xfe\x65\x18\x26\x07\xe7\xb8\xc8\x47\x41\x2c\x0a\x8c\x28\x93\x98\x8a\xda\x35\x7a\x3e\x6b\xc2\xd1\x9c\x74\x04\xa7\xd4\x87\x06\x52\xc7\x85\xe6\x7b\x01\x1f\xb7\xa8\x18\xee\x1e\xf1\x19\xb0\x92\x80\xbf\x5a\x50\x8d\xe4\x64\xe8\x10\x65\xc0\x57\xdc\xa4\xcf\x80\x50\x38\xcf\x18\xee\xac\x67\x93\x5d\xb0\x88\xa0\x3f\x18\x92\x6c\x74\xbd\xa8\xe3\x86\x12\x36\xfb\x07\xaa\xa2\xd4\x2f\xad\xbd\x29\x34\x03\x88\x55\x27\xe1\x9b\x1e\x71\x56\xed\xd9\x27\xba\xea\x9c\xe0\x4c\x20\x6c\xc8\x94\x97\x1f\xd1\xa1\x7a\x25\x85\x7c\x85\x44\x30\x1d\x72\xd4\xf2\x0c\xd0\xbc\x82\x3a\xee\x08\x0e\x82\xb5\x5f\xa3\x2d\x66\xdc\x49\xd3\x6d\x96\xfe\xc9\xba\xd2\x38\x21\x0b\xbe\x97\xa5\xeb\x64\xcd\xf7\x13\x62\xae\xeb\x17\xf7\xd5\x00\xd9\xd8\xd2\x1d\x0b\xc2\xf7\xa8\xea\x78\xa2\xdb\x83\x57\xe2\xd6\x23\x66\xfa\x8e\xdd\xe3\x9d\x96\x87\xb7\x06\x24\x50\x2f\x58\x3c\x6a\x62\x7b\x8f\x08\xbd\xcd\xdd\xe4\x23\xc0\xd2\x9b\xe4\xfc\x8d\x68\xd6\x76\x16\x55\xf7\x72\x46\x45\x56\x02\x0d\xee\x01\xdc\x3b\xe3\x14\xf1\xe3\xcc\xe8\xeb\x95\x96\xd3\x73\x1a\xd2\x8b\xb1\x12\xa9\xa8\xdf\x08\xe8\xaa\x92\x7d\x64\x56\xfa\x77\xfe\x38\x4a\x89\x46\x3a\xf5\xee\x42\x8b\x38\x22\x74\x31\x2a\x71\x90\xb4\xce\x6d\xcd\x3c\x26\xe7\x22\x54\x23\xe9\xee\xde\x89\x85\x38\x26\xe0\x2a\x4d\x26\x63\x6c\x9c\x1d\x79\x77\xb7\xe6\x6e\xf0\x68\x77\xae\x33\xae\xd2\x3a\x88\xba\x3b\xe7\x1c\xb5\xe8\x33\x18\xc3\x6a\xf3\xc9\x4e\xf0\x51\x57\x09\x13\x0e\x50\x7e\x13\xfc\xa3\x37\x4c\xa3\x60\x39\x0c\x68\x49\x5d\xf2\xda\xc1\x92\xf9\x12\x21\xaa\x0c\x5e\x4a\x1c\xc2\x8d\x72\x9c\x50\x0c\xa9\xca\x35\x77\x32\xc5\xad\x6c\x93\xff\xe6\x9f\xd8\x34\xcd\x59\x69\x84\x17\x69\x94\x96\x7b\xa4\xac\xc0\xf6\x5f\xe3\xa8\xba\xd5\x92\x3d\x1a\x07\xe9\x10\x4a\x79\xff\xbe\x7f\x7e\x51\x40\x66\x9c\x62\xac\xde\xb7\xda\xa6\x05\x61\x8c\x61\x97\xd5\x05\xfd\x6a\x79\x90\xd7\xe0\x9c\x3e\x80\x0f\x09\x86\x98\xca\xf3\x1b\x92\x25\xe0\x85\xe9\xc1\x34\xc3\x83\xae\xa3\xe2\x7d\xf7\x67\x32\x5d\x05\x88\xed\x6d\x48\xa7\x3d\x6a\x7f\xb5\x84\x95\xaf\x2c\x15\x1d\xb2\x67\x4f\x19\xb9\xa9\x92\x3a\xbc\x43\x96\x46\x84\xcb\x28\x64\x7b\xc9\x5f\x1f\x61\xec\x29\x94\xa0\x3a\x79\x7e\x71\x4a\x1c\xbe\x64\xaf\x2c\x28\x9e\x73\x37\xee\x50\xd0\x95\x9b\x4e\xc1\xde\xf3\x11\x66\x90\x76\x64\x13\xb9\x73\x0b\xd9\x36\x8c\x4f\x9b\x35\x6b\xee\x30\x1e\x89\xdf\x17\x0a\x50\xb5\x51\xee\xa6\x47\xaa\x7d\x28\x18\xe2\x7e\x1b\xd8\x60\xbc\xa8\xf0\x35\xd7\x47\x2f\x1b\xa4\x0e\xb1\xf8\xa1\x64\xb9\xc9\xc4\x1e\x90\xe0\x80\x88\x5f\xae\x65\x5f\xb0\xdd\x41\xe8\x61\xc1\x80\xc4\xaa\x05\x6c\xaf\x04\x56\x99\xd7\xc5\x6f\x57\xc8\xaa\x06\x00\xc2\xc7\x86\x50\xa6\x8c\x1d\xbf\x11\x41\xd0\x27\xa4\xbf\x77\x68\x13\x60\x6d\x25\x55\x6f\x46\xdc\xf5\x05\x3b\x72\x63\xca\xa3\xd7\xaf\x2c\x62\x22\xf8\x5a\x74\xd4\xf1\xb1\xeb\x19\x38\x75\xb4\x88\x24\x82\xfa\xa8\x24\x8b\xa4\xf8\x20\xb8\xbb\x50\x25\xcb\x60\x66\x89\xc2\x60\xae\x93\x3c\x03\xf4\xf7\x06\x2e\x2e\x8f\x3d\x47\x3b\x86\xb8\x2b\xe6\x61\xba\x78\x27\x2f\x49\x3a\xbb\x71\x98\xc0\x34\x78\xaa\x7b\x1e\xef\x68\x62\xbe\x83\xe7\x23\x69\x43\x71\x36\xbb\xd4\x03\x9f\x1c\x3b\xf7\x65\xaa\x42\xe3\xe7\x6a\x08\x66\xe7\xdf\x9d\x8a\x7a\x07\x2b\x5c\x08\x51\x50\x3a\xc5\x10\x0d\xc9\xf4\xdf\xe5\x28\x43\x6b\x35\x86\x67\x4c\xf6\xe7\xdb\xcb\xb6\xa6\x98\xf5\x32\x62\x11\xdb\xd0\x13\x43\x87\xfc\x31\x28\x28\x4a\x3b\xe4\xb4\xfd\x17\x73\xa9\x93\xa4\x7c\x3c\xf1\x5e\xa4\x3e\x43\x79\x38\x3e\x13\x70\xee\x32\x19\x34\xc0\x54\x1e\x0f\x43\x3e\x7f\x3d\x4a\xab\x8b\x45\x96\x37\xfe\x57\x06\x4e\xde\xd1\x71\x87\x62\xb0\x4f\x20\xe6\xa6\x81\xc0\x54\xd7\xa5\x35\x5b\x87\x09\x9e\xeb\x8d\xfb\xdd\x4c\x1d\x96\xf7\x91\x68\x43\xd0\xe7\x07\x32\xad\x10\x68\x6d\xc1\xb1\x44\x72\xc0\x81\x03\x9b\xc6\xaf\x03\x1c\x60\x5d\xb5\xd8\x1b\x70\xcb\x71\x22\xab\xed\x24\x40\x2d\xf8\x9b\x4c\x1d\x4f\x3a\x8e\x41\x27\x4f\x9e\x20\x30\xfc\x8e\x26\x28\x3c\xda\x61\xda\xed\xec\x3d\xbd\xf5\x50\xf3\xab\xc2\xcb\x3d\x37\xc6\xcf\x7c\x82\xf9\x6c\x30\x7a\x83\xf0\x23\x69\x6e\x0f\x75\x2c\x3b\x7c\x8c\xb0\x20\xb4\xd1\x5d\x41\x18\x5d\xeb\xbd\xf1\x7d\x78\x4b\x82\x82\xe9\x06\x45\x44\x31\xc6\x6d\xf1\x9d\x02\xa9\x11\xe5\x41\xfa\x27\x0a\x63\x87\xe4\x29\x93\x8b\x3a\x0f\x15\xe9\xc2\x58\x06\x9f\x2a\x22\xeb\xac\x3e\xb7\x01\x01\xfa\x4f\xe2\xd9\x7f\x6b\x23\xe5\xcd\x71\x23\xc8\xa5\xc4\x71\xda\x16\xdb\x9f\x5b\xbd\x63\x33\xd1\x00\x97\xba\xe4\x64\xaa\xfe\xdf\xa2\x14\x3d\xc8\x6a\xe2\xd7\x97\xfc\xf2\x81\x51\xd8\x18\x8e\x34\x07\xf5\xaf\x7f\x7f\xc4\x1d\x06\x15\xd2\x1f\xfe\x62\x65\x30\x05\x49\x29\xcd\x8c\x50\xca\x53\x20\x77\x46\xea\xa4\xde\xab\xc8\x0c\x0e\x83\xa4\xa0\x69\xff\xff\x77\x94\x15\x41\x27\x24\x39\xe5\x63\x7c\x39\xcc\xc5\xae\xaf\x42\xff\x2b\x85\xf6\x84\xfc\x11\x3c\x76\x15\x16\x0b\x9c\x38\x83\x6c\x77\xdd\xd1\xf3\xd4\x89\x75\x9c\x2c\xb0\x1a\x63\x0b\x10\xb4\x01\x8b\xc0\xc9\xc8\x89\x18\x67\x8f\x41\x9e\x5e\x45\x4d\x13\x8a\x44\x59\x46\xc0\xec\xde\x82\x3b\x0b\x70\x4f\x96\xfb\x96\x6d\x4a\x84\x19\x98\xde\xc2\x28\xb4\x9a\x28\x56\x03\x7f\xf8\x23\xeb\xda\xb5\x57\xfb\x72\x7d\xce\x22\xee\xc5\xde\xd2\x51\xb0\x97\x0b\xbc\x0d\x16\x65\x1d\xe8\x71\x77\x46\x34\x2e\xa7\x79\xfb\xe8\x57\xd2\x08\x33\xea\x0c\x10\x52\xdb\x2e\xbc\x5d\x1a\x8b\x56\x71\x99\x75\x2b\xd5\x75\xf9\xf6\x45\xec\x0e\xd1\x25\x7d\x41\xc6\x2a\xec\x49\x82\xc1\x41\x75\x29\xac\x83\x50\xea\xf6\x2b\x6b\xd2\xeb\x8a\x91\x3a\xfb\x8c\x29\xf5\x9d\xed\x56\x1a\x8f\x60\x0b\x6f\x3d\x95\x78\x49\x76\x96\xf4\x0d\xa7\x39\x41\x1d\x6b\xb0\xe1\x07\x31\x2f\xe0\x27\xe7\x89\x49\x92\xbf\xc8\x61\xb8\x61\x8a\x1b\x1e\xc1\x94\x8b\xa9\xef\xe4\xef\x56\x55\x75\x7e\x8f\x42\x18\xcd\xb7\x22\x13\x21\xdd\x9e\x9c\x02\xa8\xda\x21\x54\x64\xac\x03\xfc\x72\x21\x69\x1f\xe0\x27\x1c\x96\x9c\xf7\x62\x66\x5a\x70\x6e\xea\x03\xcc\x10\x49\x85\x41\x8a\x69\x17\xaa\x56\x28\xa1\x33\x58\x96\x2e\xbf\x96\xf4\xbe\xc6\x08\x89\x23\x00\x14\xfa\x0e\x99\xa8\xfb\x28\xff\xdd\xad\x57\xfd\x8a\xf4\x17\x70\x06\x09\x51\xdd\x50\x9c\x5d\x98\x45\xbf\xa3\xfd\x48\xad\x45\x8e\xb0\x22\xe1\xf5\x60\xd0\x5c\x0d\x6f\x0f\x4f\x19\x10\x76\x8a\x90\x8f\x15\x00\x6a\x9a\x4c\x92\x71\x8c\xe3\x99\x38\x10\x9c\x6d\x67\x01\x45\x53\x52\x67\x4a\x46\x17\x74\xa6\xac\x59\xd2\x49\xf0\xa2\x9b\xee\xe5\x49\xe3\x01\xd7\xb5\x38\x3d\x61\x68\x83\x26\x85\xcb\x1e\x14\x22\xbf\x39\x42\xce\xa2\x65\xb2\x10\xbb\xaf\x38\xb3\xbb\x29\x4c\xb0\x30\xcb\x86\xd5\x22\xab\xf3\x32\x69\x75\x13\x95\x41\x59\x14\x87\xde\x8f\xae\x3b\x64\x89\x26\x9d\x3f\xdb\x42\xb5\xa2\x0c\xfb\xbd\x9c\x9d\x11\x98\x6a\x98\xee\xfc\x8d\x54\xc7\x0b\x51\xce\x3c\x46\xc2\x19\x7a\x01\x96\x26\xca\xb8\x0d\xdd\xbb\xcc\xc0\xd1\x1d\x50\xaf\x38\x8a\xed\xa7\xc6\x8e\x66\xe1\x06\x32\x34\xf3\xf9\x2e\x36\x71\x91\xbd\x4c\x13\x3b\xaa\x01\x51\x13\x9c\x97\x51\x61\xa5\x02\x1a\xa6\x81\x6d\x3f\xaa\x81\xf5\x06\x58\xcc\xb4\x95\x76\x9c\xf9\x2b\x81\x12\x13\x1e\x59\x2b\x71\x6c\x03\x6b\x9b\x37\xae\xc7\xe2\x6f\xd7\x63\x01\x69\x02\x44\x82\xb8\x85\xa2\x61\x50\xe6\x2d\x02\x4e\xaa\x53\xf2\xa7\x02\x13\x54\x7f\x19\xae\xf2\x55\x46\x01\x7d\x18\x9e\xa0\x44\xa4\x17\x42\x97\x6f\xec\x2f\x57\xdd\x9d\xb1\xbf\x24\x3b\xb9\x19\x40\x99\x51\xdb\xc5\xc3\x60\xc3\xe3\xfa\xf0\x36\xcc\x8a\x70\x65\xc2\x46\x2b\xc3\x35\x98\x4b\xe1\x22\xad\x30\x30\xe6\x31\xd7\x72\x2c\xcf\x05\xd7\x83\xd7\x5b\x8b\xf3\xf2\x94\x10\xff\x54\x94\x93\x97\x13\xfc\xac\x1d\xfa\x01\xb9\x20\x95\xd5\x2a\x8e\x9a\x1c\x05\x4e\xe8\xe8\xb5\xdb\x2a\x7c\xc6\x96\x6b\xa5\xa9\x49\xd6\xe0\xe1\xa5\x1d\x66\x10\xd4\x9e\xab\x9f\x3a\x15\x3a\xee\x86\x48\x31\xc5\x9e\x32\xdc\x30\x34\xd9\xd6\x7e\xc0\x61\x21\xc0\x9e\x90\xcb\x57\xe3\xe8\x93\xf0\x99\x56\xe0\xa4\x3b\x76\xf3\x64\x06\x4a\x7f\x2e\x90\x74\x57\xe3\xf6\x1b\xe0\xd7\x09\x13\xae\xee\xe3\x38\xa5\xc9\x93\x74\x73\x88\x66\xd1\x04\xba\xf6\xf0\x83\x2d\xb9\xbd\x56\xa1\x62\xaf\xd8\x49\xa0\xba\x19\xbb\x54\xd5\xc8\xb4\x2a\x38\xc0\x89\xc4\x79\x51\x62\xe7\xe7\x65\xb8\x31\xf0\x73\xdb\x6e\x1d\xbf\x73\x9e\x7b\xa3\x97\xd7\x0e\x47\xef\xc0\x72\x02\x3b\x28\x0a\xe8\x7b\x86\x4f\x63\x23\x56\x21\x23\x0f\x81\xaf\x2c\x71\x0c\x8e\xb1\x52\x22\xf6\x6d\x45\x62\xc7\x98\xa3\x65\x7e\x02\x48\x02\x75\x19\x24\x57\x53\x72\xed\x2c\xbb\x9d\xd2\xd2\x6b\xb4\xb7\xf5\xff\x29\xe5\x4e\xbb\x35\x0b\xb7\x92\x72\x12\xdc\x49\x03\x07\xeb\xef\x8a\xcb\xd6\x70\x8a\x15\x79\xea\x03\xb9\x21\x6b\x98\x95\x86\x12\x22\xbb\x98\xb9\x5f\xed\x8e\x04\xc7\xb0\x05\x0e\x2c\x39\xf4\x90\x58\x97\x05\x20\x78\xc9\xd9\x04\xc6\x4b\xaf\x85\x77\xcd\x56\x2b\x15\x4f\x2d\x4a\xf2\xf2\xc9\x22\x42\x26\x89\xe2\x48\x16\xb6\x68\xbc\x59\xa9\x89\x06\xb7\x58\x04\xab\x57\x20\xb7\xd1\xd0\xff\x5e\x34\xa3\x3a\xe2\xd8\x5d\x7e\x77\x75\xc5\x12\x1a\x48\x11\xb9\xb8\x9c\xbf\x20\x27\x5f\x20\x96\x65\x10\x74\xa0\xb2\x05\xc7\xe4\x39\xce\x4f\xe0\xbc\xf9\x97\xc2\x3d\x8e\x93\x4d\xf8\x61\x1b\x79\x87\x27\x51\x0a\x6f\xb2\x10\x93\x3a\xca\xb8\x84\x2f\xcc\x4f\xbd\xe3\xd8\xc5\x47\x64\xd0\xa8\xec\x2d\x5c\x28\xee\xaa\xff\x7e\xf5\x56\x96\x1b\xee\x17\xd1\xd6\xb0\xf0\x11\xf2\x18\x73\x89\xd5\x18\x1e\x21\xa5\x39\xfa\x14\x73\xf0\xbb\x9c\xe3\x53\xfe\x70\xa1\x33\x7c\x6d\xac\xc5\x83\x57\xf4\xa9\xbe\xa7\x94\xfb\xa0\x5c\xf5\x70\x12\xec\xff\x29\x73\xf1\xf7\xaf\x89\xbd\xc2\x8e\xc5\x04\x89\xef\x19\xec\x06\x84\x03\xc7\xad\x40\xdf\x61\x68\xb0\xf6\x8f\x04\xa7\xac\xbc\x24\xed\xad\x6f\xbe\xcd\x0d\xac\x4e\x9f\x42\x38\x2a\x48\xab\xa5\x81\xb3\x21\x83\x31\x58\x67\x4f\x3a\x8b\xb0\x71\xd2\x92\x05\xf3\x30\x5b\x2d\x2f\xa2\x3f\x12\x47\xfc\x88\x19\x6c\xe5\x69\xe9\x6d\x42\x31\xa4\x3d\xca\x68\x93\xd5\xf1\xc8\x70\xb0\xd7\x0a\x26\x9d\x16\x0c\xcf\x98\xdd\x12\x08\x23\x87\xfb\xe5\x34\x27\xf3\x4b\xab\x7a\x95\x4f\x4e\x8f\x2e\x17\x89\xc6\x5f\x01\x0d\x0d\x8d\x33\xce\x1d\x7b\xfa\x93\x74\xf8\x91\x2c\x34\x0d\x09\x79\x8a\x79\x1f\xc3\xb0\x25\xba\x53\x75\x11\xb7\x67\x2b\x78\x1a\xe2\x80\xb8\x8a\x22\x15\xfa\x1b\x6c\xbb\x1b\xaa\x28\x6a\x1c\x06\x4a\xb4\xc8\x17\x88\xa9\x74\x34\x4c\x90\xd5\xa6\xde\x62\x0a\x63\x69\x21\x57\x94\x65\x60\x03\xc7\x4f\xac\xfe\xc7\x92\xc5\xe8\x29\xf1\x0c\x64\x51\x5e\x41\x51\x29\xbe\xbe\xcc\xbe\x26\xa4\xb8\x4b\x22\x53\x29\x0f\xd4\xe7\xb0\x00\xf2\xfe\xc2\xd1\x75\x8c\x52\x97\xa5\xac\xb8\xee\x64\x96\x98\xfd\x5d\x1a\xf1\xe2\xbe\x41\xc7\xfa\xc4\xa6\x82\x95\xc4\xb8\xb7\xa1\x14\x1a\x5b\x37\x0d\x47\x28\xdc\x48\x89\xe3\xfc\xcf\x4d\xd4\xda\xf5\xf8\xbb\x3c\x17\x52\x3c\x36\xfa\xc3\x71\xfc\xd5\x0d\xea\x00\x67\x18\x56\x21\x12\x6f\x6c\x86\x9c\x79\xaa\xb7\x4a\x5d\xa7\x37\xb4\xea\x87\x6f\x25\xee\x9f\x9b\x49\x56\x1d\x18\x68\x09\x5a\xb3\x8d\xba\x20\x7c\x6e\x07\x56\xf3\x07\xb9\x26\xb1\x04\x39\x02\xdf\x67\xec\x40\xab\x7f\x06\xd9\xf0\x4e\xbb\x25\x75\x02\xac\x6f\x68\x55\x13\x92\x12\x0a\x75\xb3\x8b\xa8\x58\x3d\x65\x8e\x7a\x7b\xe2\x91\x8e\xbf\x1b\x23\x2f\x75\x3d\xe7\xb0\x32\x0a\x8c\xfa\x31\x0d\xcf\xdc\x47\xc6\x99\xc2\xfd\xb2\xbf\x76\x3f\xa8\x3b\x64\xae\xe1\xe3\x21\xcd\x36\xf6\xf6\x3d\x5c\xf1\x5f\xfe\x5a\xf9\xa9\x66\x6e\xb6\x87\x7d\x5c\xda\x1f\xdc\x40\x83\x4d\xd2\x2f\x3e\x99\xf8\x68\x32\xdf\x47\x7a\xd0\xe4\x46\x7a\xe9\x58\x87\xb2\x0c\xdf\x42\x11\xba\x4d\x3a\x76\x14\x86\x64\xf6\x51\x6a\x7d\x95\xad\xab\x7c\xc5\x94\xaf\xe3\xb8\xb0\xa1\x6e\xdb\x7f\x4d\xc4\xd4\xcb\x24\x1e\x8f\xba\x0b\x0f\x7c\xf8\x88\x48\xe7\xe2\x58\x26\x12\x95\x53\x5f\xa5\x0d\x1b\x76\x40\xba\xce\xad\xe3\x02\x1f\xc5\x4a\xcc\x5f\x4f\x09\xc1\xb8\xab\x44\xe0\x75\xa3\xee\x19\xb8\x30\x5c\xf8\x75\xa8\x1a\xfd\x0f\xa5\x30\x93\x81\x98\x36\x06\x2f\x1d\xc1\x7d\xfa\x10\xdc\xdf\x44\xff\xca\x58\xb8\x5c\x8c\x26\x0c\xb8\xcc\xa1\x20\xa4\x64\x30\xbf\xcf\x02\x74\x6b\x3f\x23\x47\xba\xab\xd4\xc8\x63\xc3\xc2\x8a\xfe\xfb\x9b\x04\xf7\x79\x70\xa8\xf8\xb4\xa9\xc7\x5b\x6f\x0b\x82\x8b\x59\xbd\xcd\xc8\x6b\xb1\xad\x1b\x49\xaf\x55\x55\xef\x54\xb4\xda\x4d\x86\x6c\x57\x8f\x63\x1d\x8f\x1d\x30\x8c\xc5\x64\x14\x28\xb4\x31\xb1\xdb\xeb\xf1\x03\x97\x78\x50\x52\xbd\x36\xf8\x89\x4b\xb1\x03\x1b\x14\x6e\xf2\xb3\xcb\x55\x08\x09\x6e\x50\x92\x4f\x42\xca\xda\x51\x9c\x50\x1d\x93\xd6\x89\xf3\x48\xa4\x0b\x86\x97\xa5\xd2\xe2\x94\x46\x2a\x81\x2b\x8c\x32\xde\x96\xa7\x4f\x4e\x3d\x8d\x2f\xce\x4b\x97\x6b\xf4\x6d\x1f\xe9\xdd\xef\x17\xc2\xf9\x63\x64\xbd\xf8\x26\xcf\x13\xcd\x48\x19\x9d\xe4\xd8\x47\x59\x6c\x82\x45\xed\xc3\xa9\xc3\x11\x2a\xd0\x59\x19\xeb\xe4\xcd\xa1\x2d\x98\xdf\x9b\xac\x31\xc0\x10\x1e\xa0\x78\xa5\xf2\xa8\x0c\xf1\x3f\xbd\x50\x25\x64\x7f\x22\x2a\x39\x6f\x96\xed\x74\x1e\xfa\xc7\x52\x4c\x73\xf3\x7a\x03\x49\xe8\x1d\xb6\x85\xc0\x2a\x78\x1b\xd3\xb7\xa4\x4b\x74\x5f\xe1\x5f\x92\x43\x3f\xde\x42\x8e\xb8\xa1\x66\x3f\x9e\xb4\x64\x3b\x88\xab\xb3\x7f\x9e\x4b\xe7\x7b\x42\x6b\x72\x75\xaf\x18\xa3\x6f\x2b\xab\x51\x41\x80\xce\x0c\x60\xcc\xab\x92\x89\x92\x58\x4a\x50\x7b\xe2\xce\x5a\x9d\xfc\xf3\x0e\x58\xcd\x87\x0d\x97\x40\xf0\xf5\x8e\x11\x0c\x91\xac\x59\x64\xcd\x31\xab\x65\xd5\x8b\x8e\x54\x21\x8b\x61\x73\xc6\x8c\x7c\xcc\xbd\xc6\x75\x99\x51\x12\xf4\x86\x74\x46\xfe\x51\x9a\x8f\xfa\xd7\x0a\x0a\x97\xab\x83\x66\x68\xda\x77\xf8\x07\x65\x43\x0b\x3c\x33\x17\x34\xe1\x94\x9b\xc6\x35\xd8\xa5\xb0\x80\x3c\x7c\x3f\x88\x3e\x49\xfb\xae\x3c\xbe\xf2\x9a\x4e\xb6\x20\x4f\x33\x05\x85\x25\x6d\x5c\x1b\x4e\x29\x2b\xef\xd8\xee\xe2\x67\x24\x17\x3c\xf1\xe4\x23\x79\x26\x87\x98\xae\x37\xc8\xa0\x99\x45\x0d\x69\x0a\x64\x68\x87\xc8\x54\x9a\xc6\x15\xc0\x20\x82\xf2\x42\xd8\xcd\xc5\xd5\x71\x50\x4d\xa0\xca\x32\x0f\x6d\xb8\x25\xb6\x40\xc0\xaf\xed\x36\xb9\xc2\xdf\xcc\xeb\x4d\x16\xbd\x29\xa6\xf4\x24\xb4\xe8\x3c\x08\xa7\xa1\x2d\x39\x9c\x88\x8e\x00\x2a\x46\x6b\x27\x89\x49\xf3\x13\x29\x98\x56\x39\x79\xc7\x50\xd9\x18\xa6\xca\xd0\xae\x03\x30\xf5\xee\xef\x44\x06\x92\x0e\x67\xad\x95\x2c\xdd\x3a\xa1\x99\x08\xd0\xe9\x17\xa1\x5f\x7c\x01\x52\xb8\x4d\xf1\x28\x02\x86\x47\x3f\x11\xf9\xf5\x78\x11\xb1\x0c\x6c\x4a\x3d\x06\xcf\x1a\x92\xbb\xdf\x1c\x10\x66\x71\x31\xd7\x04\xdf\x58\xb6\x74\x0c\x4f\x54\xc5\xf6\xd0\xaa\xf1\xd5\xf1\x25\x44\xda\xc5\x7e\x9a\xef\x12\x29\x2a\x08\xbf\x3e\x15\x81\xc4\xb2\x4b\x11\x8c\x13\x8f\x90\x38\x44\x64\xe0\xc1\xf7\x45\x45\x54\x83\xb1\x4f\xde\x77\x99\x11\x65\xca\x82\x34\xc0\x80\x90\xb4\x37\x43\xc9\x39\x7a\xac\x23\xac\x92\xea\x20\x06\x4c\x57\x8e\xc4\x73\x5f\x80\x30\xf1\xbb\x60\x76\xa3\x18\xcb\x0a\x72\x25\xf0\xef\x16\x8e\xc1\xb2\xf5\x50\x38\x05\xc7\x85\xd3\x99\x28\xa1\x7d\xb2\x70\xbb\x0c\x0b\xb7\xf2\x33\x96\xad\xd2\x69\xa3\x5f\xb6\xc9\x92\xe3\x43\x37\x60\xe9\xd8\xd0\x58\x2d\x9e\x84\x52\x99\xe3\xf9\x97\xe2\x59\x4f\x45\x57\x10\x6a\x47\x87\x50\x66\x8c\x58\xe0\x61\x98\xd6\x6b\xaa\x27\xc2\x08\x4c\x4c\xea\x36\x3d\xfb\x91\xb6\xb2\xf8\x67\x84\x93\x26\x88\x5c\x44\x6a\x6c\x92\x00\xec\x46\x90\x5b\x78\xb1\x75\x71\xfb\x79\xab\x51\x77\xf3\xba\x54\xf3\xc4\xe0\xa8\xc3\xdd\x54\x9c\x78\x24\xca\x7d\x85\xde\xdd\x50\xb0\x53\x7b\x1b\xcf\x0d\x73\x7f\x82\x41\x58\xdb\xd1\xb3\x32\x03\xcf\x31\x65\x4e\xa8\xae\xb8\x88\x2a\x45\x68\xef\xbc\x97\x38\xf6\xc4\x95\x35\xae\x3c\xc3\xa1\xcb\x27\x90\x99\xac\xca\x01\x7f\xc3\xfe\x1e\xe5\x28\x46\x37\x59\x48\xeb\x0c\x51\xe4\xc1\x52\x2c\x2e\x90\xa6\x2b\x71\xee\x86\x99\xd2\x8b\xa5\x44\x3b\xf6\x65\xb6\xff\xc5\xa2\x5a\x21\x8e\xcd\x20\x01\xff\x41\x42\xd3\x75\xef\x04\x95\x00\x9f\xc8\x96\xb0\xce\x11\x10\xf4\x55\x40\xd2\x4a\x4e\xa2\x19\x2c\xe8\x6d\xd7\xc7\xe5\x0e\xc1\x1c\x86\x9e\x4b\xd2\xaf\xe5\xd2\x3f\x2a\xb4\xa9\xf6\x7f\xc4\x26\x05\x52\x97\x70\x62\xc9\xec\xb9\x95\xf2\x84\xc8\x72\x2a\xdf\x44\x18\xf7\xe8\x37\xcf\x9d\x84\xa9\xd6\xce\xb9\x2a\xc1\x90\xa5\x80\xde\x48\xe3\x82\xed\xbd\xc4\x08\x5d\x21\xa1\x00\x9f\x98\x86\xee\x3e\xec\x11\x6e\xb9\x98\xf4\xd2\x01\x90\xf4\xec\x5c\xe0\xa2\xc1\xd0\x7b\x83\x2a\x50\x76\x13\x25\x86\x39\xb9\xa3\x3e\x01\x1f\xc9\x3e\xe0\x4a\x36\xe6\x01\x2b\x7e\xc6\xe5\xf2\xa9\x14\xb1\xe8\x65\x90\xdc\x9c\xb7\x59\x2c\x47\xa8\x23\x73\x4e\x25\xc0\xcb\x7a\xef\x2a\x4b\x85\xdd\x9f\x1a\xaa\x6b\xaa\xdc\x0c\x61\x37\xb7\x35\x99\x32\xba\x37\x41\x45\xb8\x7d\xab\x61\xda\x50\x7d\x88\xfa\xba\x4e\x92\x72\xbd\xb0\x5b\xf1\x78\x86\x74\xb1\x52\x4a\x47\x8e\x37\x0e\x89\x2c\x1f\x55\x2e\x7e\xd3\x88\xbd\x42\x0e\xee\x08\x9d\xb4\x3a\xe9\x24\xbe\xb2\xd8\xe9\x26\x5e\x92\x12\x1c\x27\x3d\xeb\xa9\x87\x7a\xc0\xd8\xe1\xe9\x9b\xe6\xc2\xcd\x79\x9d\xef\x3e\xe8\x86\x68\x96\xa3\xb3\x94\x84\xdb\xbd\x48\xa4\xdf\xbf\xed\x21\xc6\x09\x9c\xc2\x7a\xbb\x17\x72\xb4\x44\x94\x2a\x1c\x48\xcd\xc7\x44\xf6\xf7\xb0\xb8\xc0\x61\x29\x0e\xec\xc3\x7f\xfd\x18\xa7\xf9\x68\xa5\x4f\x56\xe4\x2f\x39\x5a\xa6\x4a\xf7\x0a\x5d\x8b\x31\xa1\x58\xfc\x85\x45\x84\x0c\xad\x1f\x0a\xd4\xfe\x0e\xf7\xb1\x22\x9d\x57\xaf\xf0\x22\x46\x56\x6b\xc3\xc6\x40\xc8\x7f\xe4\x14\xcd\x63\x29\x76\xee\xff\x70\x18\x77\x41\xf2\x25\xd9\x20\x24\x09\xbe\x70\x74\x0b\xed\xbc\xa7\x03\x81\x4f\x06\xcc\xa4\xdd\xeb\x9f\x86\x7b\xb6\x9d\xc9\x04\xc8\x37\x17\x54\x9b\x2b\x9d\x2d\xda\x34\x74\x04\x07\x7b\xa3\x18\xba\xe1\xfa\x72\x5c\xb0\x2b\x13\xa8\x3d\x25\x92\x80\x89\xfc\x83\x43\x6b\xeb\x29\x74\x24\x44\xfa\x4d\x26\x78\xb5\x2d\xc3\x2b\xeb\x1f\xd5\x18\xb3\x6a\xdd\x50\x3b\xa4\xe6\x01\xcf\xef\xa2\xc2\x50\x80\x34\x5a\x4b\xd4\xd3\xe6\xb1\xe8\x21\xc5\xbb\x8e\xa1\x49\x31\x15\x03\xe3\xdf\x5a\xfc\x44\xf6\x54\x0b\xc0\x9c\x2b\xeb\xf3\xad\x96\x69\x33\x76\x78\xb0\x47\xcc\x98\x53\x70\xaa\xdc\xb0\x91\xa0\x2c\x0b\x9d\x6a\x7f\xc1\x1b\xed\x84\x15\x55\xb2\x4b\xa4\x38\x8c\x41\xb6\xca\x99\x9c\xde\xf4\xbf\x2b\x47\x1a\x6d\x38\xc5\xa3\x81\x8f\x2b\x2c\xd6\x12\xeb\xeb\xcf\x75\x00\x97\x40\xe4\xf9\xaf\xc7\x48\xab\x16\xd0\x46\xab\x3f\x31\x77\x1a\xf9\x5c\x13\x62\x4c\x7f\xeb\xae\xa0\xd0\xa6\x16\x92\x66\xbe\x78\x6b\x87\x27\xb4\xb3\x4f\x23\xf0\x38\x4f\xcc\xf6\x44\x62\x08\xbe\x1c\xf2\x22\x8c\x8b\x16\x9d\xcd\x86\x55\x71\xba\x46\x7a\xa3\x9d\x24\x5a\x75\x8c\xdf\x30\xd5\x2d\xe5\xfe\xb5\xa3\xb6\xb3\x34\x76\xf9\x0d\x0c\x99\x75\xaf\x91\x19\xeb\x40\xc7\x6f\x0f\xdc\x1e\x23\x20\x38\xe6\x50\x25\x8f\xe2\x3d\x87\xb1\x11\xa4\x89\xc7\x14\xbf\x12\x0e\x9c\x34\xa3\xae\xbf\x68\x1c\x90\x77\x06\x9e\xfb\x95\x8f\x4f\x72\xc3\x88\x55\x7a\xbc\x19\xd1\xc4\x37\xcf\x47\x91\x42\xba\xb6\xcf\x7c\xae\x12\x8e\xf0\x5d\x73\xf9\xf7\x1e\x80\xa7\xeb\x85\xed\x54\xb6\xde\x82\x87\x5d\x6e\x04\x7b\x8a\x97\x8f\x98\x4d\x13\x2e\x9a\x0a\xfe\xcc\xd8\x54\xf2\x6d\x4e\x9c\xa2\xe8\xbf\x03\x6e\xcd\x83\x14\x43\xe6\xa7\x38\x47\x62\xdd\xeb\x58\xfb\x42\xab\xfc\xe0\xae\xe6\xd8\xec\xd2\x5d\xb9\x6f\x89\x10\xbb\xcc\x35\x0f\x34\x92\x69\x33\x55\x38\x3d\x76\x8f\xe9\xef\x12\x21\xed\x70\xdd\xc5\x42\xd9\xc4\xb4\xe1\x79\xe9\x96\xf0\xa9\x5d\x47\xf4\x05\xe2\x04\xf0\x9b\xb6\x15\x90\x09\x90\x6c\x6d\x21\xb0\x4a\xd9\x79\x70\x67\x08\x36\x54\x3c\xdb\x03\xb4\x4d\x28\xc5\x1e\x08\x93\xac\x63\x61\x2e\x48\xc7\x19\x7e\xe4\xdc\x8c\xc8\x18\xf9\x69\xab\xba\x9d\xa4\x82\x8f\x4c\x80\xdc\xe8\xdb\x2c\x9d\x3d\x86\xab\xb0\x9d\x56\x79\x6c\x91\x16\x03\x1c\x01\xc2\x37\xa7\x4c\x27\x86\xb3\xc0\x79\xce\x5c\xe3\x91\xcf\xbc\x17\xc0\xdd\x52\xdf\x8e\x8e\x14\xdc\x89\x2c\xad\x34\xc7\x1d\x18\x45\xb8\x84\xfa\x76\xa5\x5e\x97\xf3\x0c\xa2\xc2\x4e\xfc\x48\x11\x05\x70\xf5\x0b\x90\xe3\xc7\x40\x53\xee\x27\xc8\x21\x1e\xce\x2d\x18\xa4\xe8\xe4\xfb\x76\xa5\xba\x8d\xb7\x38\xb8\x70\xa0\x76\xf1\x67\xa9\xaf\x72\x9a\xd2\x7d\xe2\x10\x8a\xec\xe2\x4f\x52\x27\xb5\x71\xfa\xd8\xff\x57\x48\x25\xdc\x21\xf5\xf3\x93\x4e\x09\xcb\xc5\x8b\x0a\xd8\x3d\x75\x5e\xe3\x41\xed\xdf\x2a\x2c\xb1\x6b\x53\x2f\x7d\xce\x45\x04\x06\x75\xfa\xb9\x34\x4d\xee\x6b\x1c\x93\x19\xfd\xf1\xe6\xa7\x15\x0d\xcc\xf0\x18\x09\xd6\x2f\x25\xb8\x9a\x10\x37\x1e\x41\x91\x68\x46\x4a\x02\x7e\x89\xcf\x2d\xb7\x9f\x85\xc1\x9e\x6b\x25\xdd\x43\x73\xb3\xbc\x9d\xc0\xcc\xbe\x41\xa6\xe2\xcd\x8a\x3f\x50\x5f\xc8\x15\x7b\x06\x2a\xc4\x7b\xdd\x50\x53\x00\xbc\xf8\x36\xe5\x42\x3f\xcc\x81\x08\xc8\xd4\xd3\xc3\x77\xaa\x5f\xae\x07\x4c\x91\xc4\x88\xb0\x6e\x71\xef\xb2\x6e\xcf\xda\xfe\x65\x5a\xd3\x07\xa0\x00\x92\x3e\xec\xbe\xc5\x96\x25\x42\x77\x68\x39\x43\x33\x31\xd0\x21\x57\x41\xe7\xff\xb3\xbd\xf6\xef\x55\x29\xee\x84\x33\xa6\xbc\x96\x6b\x78\xbf\xb7\x46\x79\x30\xe2\x66\xd0\xcd\xf7\x57\x02\x7f\x0d\xcb\x2b\xc5\x36\x33\x98\x72\x27\xf8\x38\x62\x12\x24\xb5\x60\x07\x21\xb7\x80\xa7\x6d\x1c\x24\xba\x8f\xbe\x15\x26\x43\x73\x9a\xc0\x02\xe2\x70\xea\x47\x7d\xbb\xff\xb4\x13\xcd\x7e\x23\xa6\x07\xce\xf6\xb2\xdc\x6f\x53\x53\x1d\x58\x3f\x30\xfd\x2c\x42\xa0\xe3\xba\xfb\xf3\x09\x38\xbe\xfb\x2a\x57\x51\x3a\x4f\x9d\xa3\x8d\x23\x17\x34\x5d\x48\xde\xa6\xfa\x44\xc6\x8d\xc0\x6b\x14\xb4\xb1\x2c\x2b\x33\x88\x72\xeb\x47\xdb\xb2\xef\x5d\x2a\x98\x16\xd9\x5c\x4a\x8e\xee\x94\xd7\xea\x63\x71\x70\xf9\x9c\xfd\x69\xbf\x81\x5d\x90\x58\x6c\x36\xd3\x60\xda\x28\xaa\x3d\xd9\xcb\x50\xf9\xcf\x0c\xae\xd2\xca\x04\x4b\x24\xeb\x33\x3b\xc4\xe5\x7b\x3c\x93\x97\x64\x15\xc4\xd5\x66\x2a\x9a\xf2\x4f\x7e\x7e\x03\x75\x81\xc6\x1f\xba\xdd\x0f\xd1\x63\x8b\xbe\xd9\xd1\xe2\x6c\xb8\xe8\x73\xf8\x0b\x3a\xe7\xf0\xe8\x04\x5a\x27\xa4\x66\xa4\xa1\x76\x8b\x28\x83\x78\x70\x33\x24\xa6\xec\x62\x79\x46\x78\x6a\x59\xc7\xf1\xd8\xce\x9e\x17\x3a\xc9\x88\xa7\x5c\x9e\x3f\x6d\x32\x3f\xf4\x5a\x38\x62\x54\x93\x8e\x96\xd8\xb6\x01\xa3\xd4\x7f\x6d\xfa\x7d\x2d\xe0\x77\x03\x43\xd3\xb4\x24\xdf\xb3\xc5\xae\xca\xc2\x13\x28\xd7\xd8\xb4\xce\xef\xa8\x30\x9b\xf0\x98\x16\x8c\x9a\xf6\x83\xe7\x90\x65\xcc\xd6\x13\x20\x86\x60\xbe\x5d\xda\xd3\x9e\xd0\x66\x38\xe6\x1b\x0e\x59\x7c\x53\xc8\xb1\x8f\x10\xae\x18\x4e\x83\xa1\x04\x33\xcc\x9b\x38\xcb\x88\x22\x23\x80\x47\x11\xf9\x38\x6d\xcb\x6e\x0a\xa8\xb1\x6f\x88\x9a\x6a\xf6\x76\x24\xd8\xb2\x6f\x9f\x2e\x77\x10\xa1\xf8\x3d\x9d\x8a\x7f\x2d\xe8\x97\x47\xac\xf8\x8e\x10\x1b\x81\x5a\x8b\x2c\xff\xfc\x5d\x37\x8c\xf7\x26\xc1\x3f\x71\xff\x60\x38\xb9\x3f\xb3\xca\x8c\x34\x45\xb5\x89\xaa\x7d\xe2\x53\xfe\x82\x94\x1c\x1b\xeb\xb1\x9b\x34\x76\x59\x78\x47\x34\x1b\xe7\xfe\x5f\xac\xe1\x33\xee\xe9\x87\xc2\xf7\x61\x94\xc1\xf2\x61\xb7\xc9\x66\x3a\xcf\xb3\x29\x24\x94\x5c\xf3\x1d\x96\xeb\x6d\xab\xc5\x23\x62\x0a\xd8\x06\x5d\x6e\x13\x65\x5c\xb6\xfc\x06\xf6\x48\x22\xfd\x0f\xb8\x6a\x5d\xf7\xf6\x80\x0e\x89\x66\xc0\xab\x88\x42\x4c\x63\xaf\x7f\x92\xb7\x52\xcb\x62\xfc\xbb\xd4\x92\xfb\x4f\xc0\x20\x61\x69\x3f\xf1\x63\xff\xca\x4c\x43\x1d\x08\x53\x8e\x79\xd2\x9e\x94\x92\xe6\x1a\x4f\x16\xd2\xe1\xb9\xfd\x2f\x50\xe7\xa5\x81\x52\x93\x5b\xbc\x75\x99\x0f\xb9\xe6\xd7\xf0\x44\xcd\xa1\x12\xa0\x6c\xdb\x93\x0e\x4d\xc6\x93\xb9\x4d\x1a\xdd\x61\x0f\xd7\xc4\xfd\xdb\x61\xf9\xe7\xef\xc6\x9b\xe5\xef\xe6\xfc\x78\x13\xd8\x5c\x87\x81\xf6\x64\xc5\xce\x14\x17\xbd\xfb\xaf\x71\x71\x66\xb6\x90\x2e\x27\x7e\x88\xb5\x16\xf7\x48\x76\xa4\x4d\x30\x22\x16\x93\x62\x24\x63\x5a\xc5\xc0\x23\x9f\xab\x0d\x25\xf8\x18\x20\xf3\xd2\xa0\x26\xd6\x8b\x23\xd5\x28\x9e\xb9\x77\xf5\x1a\xce\x1c\xe7\x36\xa7\x13\x72\x22\x49\x53\x24\x11\xec\x5b\x85\x3a\x7b\x55\xfc\x26\x61\x62\x27\xb7\xeb\xb1\xd8\x22\x58\x3c\x78\xd6\x3f\xda\xee\x21\x2c\x9f\x0f\x25\x50\x45\x88\xea\x46\xa6\x5c\x48\x74\xe4\xc9\x39\x6e\x0f\xab\xd3\xb5\xc9\xdf\xbe\x6a\xed\x88\xfb\xd0\x3e\xe5\xea\x5b\x35\xe4\x7b\x65\xa2\xcc\x96\xdf\x5d\x79\xdc\xd7\xa0\xf9\x63\xfe\x84\x21\x2b\x24\xaa\x2e\x8f\x84\x99\x0f\x91\x3f\xd1\x32\x89\x7c\xfe\x98\xc3\xca\xfb\x08\x8c\x9a\xf9\x73\xfa\x39\x87\x17\xd8\xd8\xc4\x83\x78\xc7\x45\x2d\x28\x52\x56\x1b\xf9\xa3\x33\x0b\xb1\x63\xc8\x34\x97\xa1\xe7\x31\xf7\xe8\x0a\x43\xec\x2d\x1f\x02\x54\x78\x49\x6c\xcb\xc2\xcd\x91\x6b\x90\x11\xba\xee\x14\x94\x21\x56\xee\x0b\xd0\x5f\xf3\xc3\x67\xea\xb7\xaa\xe2\xa2\x32\x81\x41\x35\x30\x35\x64\xb6\xe7\x57\x5a\x8b\x21\x0e\xbe\x53\x03\x62\x35\x4b\xcc\x08\x5d\xfe\x16\xe8\xc7\x5b\xdb\x72\x36\xa0\x76\x81\xb7\xdc\xdb\x37\xd0\x76\xed\xac\x61\x68\xec\xe7\x5a\xc9\x0e\xa3\x47\x7c\xe5\x97\xfe\x88\xf3\x9a\xe7\x9d\x79\xf0\x3f\x33\xa6\xd1\x30\xe8\xe0\x4a\x12\x1e\x4d\x3c\x98\xb5\x33\xbc\x21\xba\x2a\xa4\x02\xc1\x91\xab\x49\x50\xef\x86\x1f\xae\x4a\x2b\xe3\xc9\x54\xb4\x03\xf0\x7c\x09\x58\xf6\xab\x30\x8c\x70\x40\x2d\xc4\xc5\xc4\xeb\xae\x5f\x39\xa6\x6c\xc6\x78\xa0\x55\xca\x23\xd4\xd8\xe7\x4c\xf2\xa7\xb9\x48\xce\x7b\x8c\x3b\x61\x00\xcd\xcd\x15\x42\x40\x51\xee\xb4\xab\xae\x53\x54\x59\x40\x0c\x80\x5f\x69\xd6\x32\x50\x55\xd5\xd2\x89\x3c\xc1\x90\x0b\xfa\xae\xcf\xa2\x5a\xe0\xcf\x58\xf9\xbe\xc6\x8e\x01\x1b\xa2\x6c\x58\x48\x69\xac\x01\x50\x59\xd0\xec\xab\x87\x4a\x18\xd3\x36\x74\xc8\x4e\x6e\x44\x64\x9d\x70\xb2\x6d\xa0\xea\x04\xf5\x40\x7d\xd6\x19\x93\x0c\x5e\xd8\x2f\x01\x72\x03\x08\x74\x3c\xa3\x42\xb7\x43\x49\x83\x24\xdc\xee\xb9\xf5\x91\x62\xd7\x09\x15\x98\x4d\x3a\x14\xa9\x90\xef\x6f\x1e\x4c\x43\xb6\xe1\xb2\xb8\x81\x47\x7d\x7b\xe4\x2a\xdb\xb4\x74\xbc\x06\x73\xd1\x9b\x9b\x24\xa9\x44\xfb\x91\x88\xa7\x7b\x42\x47\x5e\x1f\x3a\xbb\x91\x4f\xfe\x5f\xef\x90\x9e\x35\xb7\x75\x67\xd9\xd8\xf1\x76\x81\xb7\x49\x88\x3e\x1c\x1d\x55\x5d\x76\x43\x5f\x33\x40\x3c\x7e\xe7\x89\x80\x54\x4a\x95\xe7\x3f\xfc\x5f\x69\xe5\x0b\x8d\x01\x22\xc8\x22\xc8\x13\xc1\xdf\x63\xf7\x19\xfd\xee\x62\x20\xed\x85\x5e\xad\xb9\xc0\x4b\x97\x3c\xa6\x3a\x43\xe5\xca\x6b\xf3\x4a\x9c\xa5\x8f\x7c\x2d\xe7\xc5\x1e\x83\x25\x75\x52\x8e\x65\xea\xb1\x47\x1b\x61\x97\x4d\x03\x83\xa6\x87\x06\xb8\xad\xa1\x10\x0e\x96\x81\x0a\xff\x63\x06\xf3\x85\xfb\x8d\xd3\xa2\x8e\xcc\x57\x19\xc7\x04\xda\xb7\xaf\x71\x4d\x85\x99\xdb\x84\x36\x58\x2b\x87\x40\x8e\x9c\x2d\x37\x9d\x24\xcd\xea\x4a\x3d\x8c\xd0\xd8\x19\xb1\x89\xdf\x4e\xcd\x57\x49\x09\xf0\xd7\x70\xaf\x73\xd2\x5c\xef\x9a\x4f\x27\x8e\xeb\xad\xae\xd2\x63\xe3\x7b\xf4\x1f\x59\xf4\xec\x60\xc3\x61\x12\x0b\x0e\x12\x13\x7a\x23\xae\x5b\x88\xec\xcf\xf5\xba\x8f\x8f\x4f\x5b\x5d\x5a\x0b\xaa\xbd\x61\xbc\x08\xe4\xd5\xa8\x95\x22\xc8\xf5\x31\x17\xee\x41\x52\x69\x94\x74\x77\x90\xcd\x9e\x28\x72\x0c\x38\xc5\x1a\xfd\x8b\x93\x43\x51\xdd\xd9\xb4\x2a\xec\xbd\x14\x9d\x60\xfc\x49\xb4\x7b\xd8\xce\x45\xc4\x4c\x75\xb9\xfd\x53\x58\x56\xff\xea\x2b\x32\x49\xbb\x38\x5f\x34\xda\xb0\xa7\xb8\x91\x7e\x21\x83\xbb\x79\xfb\x0d\x0d\x32\xc4\x6b\x34\xe7\x5b\x36\x02\x4f\xf2\x29\x6b\x67\x06\x9c\x8e\x1f\x89\x96\xc5\xd8\x0b\xc0\x4f\xff\xd3\xf1\x3d\x5d\x65\x05\x2e\x4d\x6b\x55\xd6\xfa\x4c\xfc\x8e\xc7\x49\xb5\xcb\x70\x56\x86\x1d\x62\x2f\xcc\x29\xe7\xa5\x8f\x0c\x27\x58\x7a\xf7\xba\xe1\x70\x20\x27\xbb\x40\xd8\xbb\xe1\xd7\x26\xf9\x59\xd7\x8e\x81\x06\xe6\xb4\x68\xa3\x55\xab\x34\xf9\xde\x06\x9c\x23\xde\x15\x4b\x0c\x84\x74\x34\xe3\xde\xe8\x4c\x0c\x34\x34\xbd\x2c\xe6\xc5\xf5\xd1\x3f\x40\x32\x59\x89\x0e\xb3\x68\x54\xd0\x64\x5b\x8e\x70\xc3\x7c\x49\xf3\xeb\x5e\x52\x51\xcb\x7f\xb1\x0e\x6b\xe5\x6f\xeb\xcb\x1d\x23\x48\x0e\xac\xb5\x30\x4f\x04\x1f\x5e\x47\xa9\x7a\xae\x7e\x50\x68\x68\x6c\xfc\x95\xe6\xfd\xab\x1c\x74\x38\xaa\x0e\xf2\xbe\x8f\x05\x92\x54\x13\x4b\x7a\x1a\x97\x04\x17\xee\xf8\x01\x03\x1a\xad\xce\x6b\x5d\x58\xd7\x69\xdb\x67\x3b\x46\xef\xaa\x4b\xf3\xd8\x6a\xcd\x63\x43\x5d\xca\x52\x1f\x77\xe3\x70\xc5\xa7\xd8\x2d\xc9\x4d\x89\x49\xdc\x25\x62\xb4\x92\xff\x2d\x08\xf5\x15\x29\x60\xc5\x9d\x7f\x59\xe4\x81\x47\x29\xed\x90\x5a\xa4\x16\x52\xd8\xbc\x75\xf3\xb4\xb3\x02\x8b\x6b\x47\x0a\x52\x0f\x70\x27\xbd\x49\x05\xa4\x65\x43\xaa\x9d\x75\x46\x81\x49\x78\x49\x02\x14\xf0\x7b\xeb\x35\x95\xe6\xf7\x20\x8d\x70\x1a\x73\xdd\xe5\xee\x1e\x7d\x48\xa1\xce\x54\x2c\x48\x7d\x53\x76\x81\x00\x70\x42\x34\xe6\x70\x16\xa4\x34\x42\x0b\x53\xc8\xf5\x69\x43\x60\x19\x48\xa3\x60\x0f\xec\x48\x15\xbc\x9f\x19\x0e\x7c\xbb\xe7\x6d\x25\x71\x4c\x46\xe1\x99\x45\x72\xc4\x54\xa7\x8c\x94\xf7\x67\xcf\x88\x16\x56\x7a\x5b\x8b\xda\xb2\xd0\xff\xd6\x7e\xf6\x8a\xa2\x58\x0b\x10\x6f\xd5\x16\x91\x94\x4a\xe5\x2a\x1f\x8c\x36\xe8\x5b\x7e\x23\xb0\x59\x0f\x6f\xad\xf9\x3a\x5c\x1d\x19\x65\x08\xd8\xbd\xd1\x42\x4c\xd6\x79\x56\xa7\x6f\x94\x51\xea\x53\xb1\x93\xe6\xfa\xd6\x06\xc7\x6b\xe9\x45\xba\xeb\x00\x58\x9c\x00\x75\x5d\x0b\x9f\x40\x0b\x1b\x21\x93\xde\xb6\xdd\x76\xf2\x12\xcb\xc2\x9c\xdc\x3e\xd3\x81\x17\xf1\x4a\x89\x92\x38\x8a\xcf\x35\x7f\xe8\x89\x6a\xc9\xa0\x74\x14\x3b\xac\x53\x4f\x83\xf0\x14\x39\xa4\x99\x34\x42\x71\x30\x35\x9c\x9f\x41\x68\x89\x47\xea\x75\x30\x99\x06\xb2\xf8\xd8\x97\x0c\xc7\xbd\x2e\x20\x49\x3d\x15\x6f\x96\xb1\x89\x74\xe0\x56\x70\x9b\xe8\x40\x2e\xa8\x55\x01\x19\x49\xba\x27\xfd\xdf\x55\x1c\xe8\x6e\x23\xb1\xf2\xde\xaf\x29\x27\x31\x6c\xa7\xe4\xb6\x3f\x78\x05\xa3\x23\x58\x2b\x60\x84\x75\x12\x34\x29\xe3\x54\x3a\xf9\x08\x34\x00\xf7\xc4\xfc\x29\x92\xb8\x10\x73\x53\xcb\xb9\x19\xab\xba\x72\xe5\x64\xbc\x79\x28\x8e\x0d\x31\x54\x02\xd7\x2d\xf6\xd6\xe8\x22\x28\x6d\x3d\x71\xd2\x86\xcd\xb3\x45\x18\xb4\x12\x80\x65\x12\x67\x32\xf0\xfa\xf9\xe1\x74\x55\xce\x00\xc1\x27\x23\xc1\x51\xd7\x02\x7f\x0e\x01\x22\xec\x62\xf4\x20\x78\x58\x56\xc2\x32\x65\x98\x38\xe7\xf1\x4c\x28\xc3\x34\xa5\xbd\x78\x31\x8f\xe1\xfd\xda\x6f\x2d\xdf\x24\x7b\xe9\xfa\x5b\x5a\x22\xf8\xa2\xe4\x19\xe7\x0f\x29\x17\x37\xea\x0b\x3b\x38\x26\x1b\x01\x95\xe3\x64\x98\xee\x1f\x53\xc3\x6d\x95\xaf\x04\x52\x6f\x00\xfb\x07\x55\x65\x37\x0e\xb2\xef\xd7\xbc\xb9\x5b\xb2\xb1\x98\x36\xc1\x24\x9b\xf4\x04\x5c\x32\x3a\x32\x7c\xb3\x84\x55\x35\x56\x25\x0c\xc6\x2e\x6d\xc7\xce\x27\xc1\x7f\xf6\x53\xf7\xc0\x6a\x0a\x04\x53\x74\x97\x08\xe1\x18\xb2\x6e\x2c\xb9\x9c\xed\xf5\x3b\x71\xa5\x84\x61\x3b\x29\xc2\x18\x1c\xf7\x27\x08\x40\x30\x11\xec\x33\x78\x1f\x20\x8b\x75\xae\x59\x09\x6d\x5b\x04\x6d\x41\xdc\x3e\x78\x55\x64\xc7\x01\x7b\xb4\xcd\x37\x17\xf3\xb0\xa5\x24\x41\xf6\xa8\x13\xa9\x77\x18\xe7\xa9\x79\x39\x61\x59\x6c\xde\x9e\x14\x4b\xbc\xa2\x8b\xc5\xfb\x4a\x97\xf3\x29\xe5\xe7\x21\xc6\xd7\x25\xc5\xb8\x07\x9f\x16\x67\x20\xe8\x3e\x3c\xaf\x74\xee\x87\x98\xde\x8b\x93\xfc\x0f\x3d\xf7\xa2\x3d\x98\xe0\x33\x12\x1e\xed\x21\x29\x3b\x9b\xfd\x4d\xc0\xd5\xed\x95\x0c\x42\x3e\x3b\x01\x92\xde\x2f\xa8\x46\xc6\xf2\xb2\x00\x41\x0a\xad\xae\xd3\x9a\x82\x2e\xcc\x5b\x48\x1b\x9e\xa9\xc5\xb8\x21\x55\x1f\x49\x57\x65\xd0\xc2\xd2\xef\xd0\xfb\x01\xbd\xce\x5e\x93\x1e\x62\x4d\x72\x8f\x12\xb6\x24\xc0\x88\x14\x79\x75\xf1\x77\x1c\xe8\xcd\x64\x48\x54\x13\x84\xed\x36\x80\xb4\x65\xc5\x41\xe0\x71\x96\xa4\x6c\xa5\xc9\xeb\x4b\xc2\x13\x41\xbf\xda\x3f\xbd\x17\x5f\xed\xf0\xbe\x9f\xd7\x04\x9d\xc3\x24\x7a\x2a\xb3\x70\x1d\xb8\x35\x83\xb0\x76\xbb\x7f\xeb\x80\xe2\x09\x13\x56\xaf\x5f\x37\xd0\x56\x33\x9c\x62\x6a\xb6\xf2\xad\xbe\xf0\xc3\x3e\xf4\x51\xcf\x31\x95\x81\x5d\xff\xc5\xff\x76\x2d\xe3\x8b\x6f\xc9\x32\x34\x79\xce\x4b\x0a\x60\xd9\x86\x86\x9e\xeb\x6b\x20\x2d\x3e\xee\x23\xd4\x6f\x60\xff\x1c\xe2\xd7\x56\x76\x8b\xca\x48\x50\xc3\x14\x00\x14\xd2\x3c\x7f\xd4\x7f\xdf\x15\x03\x52\xf3\xfa\x36\x20\x3b\xe5\xc8\x6c\x1a\x2d\x3d\x43\xdd\xa0\xa3\xfd\xb9\x47\xf4\xcc\x57\x43\xd1\x04\x68\xcd\x42\x5b\xed\xea\x50\x28\xea\x5f\x32\x78\x63\x37\x1a\x1b\xd3\x57\xf6\x0b\x20\x70\x40\x3d\x31\xde\x0c\x6b\x19\xca\x20\xae\xfe\xe1\xb7\x17\x81\xc5\xc2\xce\xfa\x49\x56\xae\xfb\x86\xfd\x21\x6a\x47\xba\x98\x95\x0d\x09\x2e\xe0\x38\x0a\x71\x34\x01\x3b\x9e\x3a\xc7\xfc\xe4\x3a\x71\x8a\xbd\xf7\x04\x4c\x44\xca\xd9\x55\xcf\x78\xb8\x7a\xc0\xa1\x40\xf5\x0c\xd1\x8e\x24\x3a\xb0\x56\x00\x8e\xc5\x08\xef\x15\xc6\x64\xc0\x7d\xb4\xfe\x35\x35\x50\xb5\xda\x57\x83\xb3\x25\xd4\x8d\xb8\x85\xac\xfc\x1f\x25\xc0\x61\x10\xff\x1c\xbe\xc4\x90\xe6\xd3\x72\x4f\x19\x9f\x14\x62\x45\x78\x41\x8e\x94\x64\x17\xd8\xf2\x92\x19\x5c\x7f\x98\xeb\x06\x93\xae\x0d\x45\x3a\xd6\x97\x1e\x7d\xbf\x3c\xfd\x66\x2e\xae\xa3\x17\xb8\xb6\x42\xec\x55\x53\x75\xcf\x90\x70\x1d\xde\x72\xbd\x9b\xf8\xb4\x39\x68\x4e\x50\x08\xf9\x37\x19\x35\xd8\x74\xb9\x0b\x65\xe8\x05\xf3\x16\xd2\x14\x0e\x70\xd9\x5a\xa1\x0c\xca\x89\x53\x06\xc0\x25\xbe\x05\xc8\xdf\x02\xe1\x4e\x4b\xa4\x3b\x94\x03\xcb\x46\x08\xa6\x9c\x62\x98\xc9\xe3\xfc\x13\xb8\xde\xea\x58\x06\x61\x26\xf2\x7e\x7e\xc4\x91\x30\xa5\x09\x08\xdb\x09\xb6\xa9\x9d\xa9\x08\xa1\xe9\x5e\x79\xf2\xb7\x23\x64\xb8\xec\x15\x2a\x43\x55\xe6\x25\xed\x3e\xe9\x5d\x4c\xa3\x8c\xac\x6c\x5f\x4d\x60\x64\x19\x98\xf7\x84\x5f\x52\xd8\xc7\x2f\xbc\xc5\x92\xde\x96\x9b\x03\xa7\x22\x8e\x0b\x31\x82\x87\x4f\x11\xda\x0b\x6d\x9b\xd7\x6a\x34\x96\x0f\x4c\x9f\xc0\xf9\x7c\x7b\x6e\x11\xce\x48\x71\xa4\x79\x46\x59\x1c\x75\xe5\x37\x66\xee\xaf\x3a\x6e\x0d\x9a\xad\xc2\x40\x72\x2e\x00\xbf\xea\x3c\x37\x44\x55\x5f\xe9\x9d\x9a\x1f\x74\x15\x82\xfd\xc8\xd2\x2f\xb1\x4e\xbc\xea\xb8\x3f\xfa\xeb\x1a\xa9\x87\xb2\x67\xe7\x07\xea\x63\x77\x0b\x97\x6b\x85\x15\xb9\xd1\xb7\x42\x8e\x5d\xca\x05\x93\x49\x2c\x35\x42\xe4\x4e\x5f\x1f\x85\x60\x6e\x9c\x02\x6a\xba\x51\x33\x44\x05\xc6\x10\x7c\xf2\x98\x8f\x7e\xb8\x28\x3a\xbd\x2c\xb6\x7b\xaa\x66\xf0\x74\x2f\x39\x25\x8a\x8e\x51\x90\x73\xa8\xf3\x9a\x65\xce\x5e\x98\x58\x6a\x23\xbe\x75\xf2\xb8\x59\x36\xe7\x95\x6d\x0d\xf9\xdb\xbf\x69\x2d\x78\x57\xd7\x4f\x8c\xf5\x1e\x95\xce\x8f\x0a\x47\xa7\x59\x95\x38\xe4\x2c\x2c\xc0\xba\xc0\xdf\xe6\xc7\xf2\x32\xa4\xb4\x50\x56\xa4\x0f\x6b\x72\x79\x87\x00\xbd\xd5\x75\x96\xb1\xcf\xc1\x70\xad\x9f\x3b\x8c\x9c\x36\x86\x15\xb8\x85\x59\xd4\x64\x30\xaa\xc7\xae\xd9\x52\xae\x88\xcf\x6f\xb8\x21\x64\xb7\x9b\xca\xca\xb4\x1e\xba\x24\xec\xc1\xa3\x41\x89\xaf\x90\xb4\xb2\xf1\xc2\xac\x75\xf1\x4a\x86\x99\x2e\xa6\xca\x66\x98\x99\xc6\xe7\x25\xa2\xc5\x01\x87\x4f\x5e\xe1\x47\x9f\x7b\x3b\xff\x84\xf3\x54\x0f\x54\xa3\x56\x2c\xdd\xb6\x0d\x74\xfc\x79\x59\x8a\xf5\xc9\x80\xa5\xc4\xba\x1e\xd8\x3b\x17\x03\x3e\x31\xde\x39\x5b\xba\xe9\x7c\xd8\x05\xae\x14\x54\xba\x81\xf5\x16\x55\x48\x76\x8f\x26\x78\x41\x8f\x60\xe4\xcf\x3b\xf0\x48\x00\x29\x9e\x41\x08\x6b\xc7\x64\x96\x88\xfa\x44\x12\x39\x59\x75\x42\xd8\x37\xcd\x1b\x52\x99\x22\x63\xf6\xca\xc1\xec\x34\xcf\x0a\xb7\x64\x36\x43\x55\xf5\xd8\xee\x93\x87\xf0\x50\x73\x01\xa0\x42\x46\x4f\x03\xc4\x5e\xc1\x67\x47\x20\x1c\x9f\xd2\xc0\x78\x9e\xd1\x05\xe9\x72\xd6\x83\x94\x0e\x85\xc7\x8d\x45\x31\xff\x66\x3f\x08\x56\x9b\xce\xf1\x0a\xa8\xad\xda\x55\xff\xfe\xae\xeb\x06\x05\x28\x56\x0f\x66\x60\x9a\x66\x60\x75\x42\xe9\x27\x2d\x43\xac\x3a\xaf\xf8\x63\x95\x35\x5e\x76\x7b\xe0\x18\x7d\xc1\x28\x6a\x8a\xf4\x2b\xab\xa3\xd5\x87\x49\x7b\xb0\x30\xe3\x1a\x0b\xa6\x11\xc8\x5d\x7e\x72\x11\x01\xf5\x7f\x85\x90\x16\xe6\x37\xd9\xf5\x32\x13\x88\xff\xd2\xf1\xa4\x51\xeb\xab\x7f\x6d\x5d\xe6\x98\xee\x10\xe5\x54\xa2\xd9\x42\x26\x33\xcb\x38\x1d\xae\x2c\x91\x07\x82\xa4\x32\x65\xeb\x40\x94\x89\x2d\xe1\x15\x08\xc1\x2e\x32\xa8\xd5\x2d\xa3\x94\xe9\xac\xc1\x6e\xfb\x8a\x09\x45\xe0\x0f\x52\x28\xfa\x99\xaf\x21\x7b\x79\x17\xcb\x6a\x7c\x49\x03\x30\x84\xd8\xd7\x1f\xe0\x45\xd0\x23\xba\x29\x10\x38\x2d\xb1\xab\xf7\x2f\xac\x3e\x4c\x88\xbb\xf7\x8e\x72\x38\xbf\xbd\x04\x64\x27\x02\x83\x74\xa8\x8a\x09\xd4\x26\xff\x2b\x88\x4a\xf3\x4b\x8a\x05\x77\x76\x9d\x97\xd7\x19\x3e\x26\x50\x7e\xf0\x99\x35\x9f\xab\xd1\xac\xe4\xdb\xad\xb5\xbe\x1b\xd9\xee\x79\x03\xae\x56\x44\x5e\x6c\x15\x15\x83\x52\x01\xee\xf3\xb0\x7a\x9b\xc9\x8f\x34\x0e\xa1\x6a\x65\xfe\x32\xc6\x0f\x42\xaa\x53\x75\xd9\x0a\x07\xdf\x4e\x6e\xae\xf9\x2e\x5e\x7a\x82\xe9\xe8\xda\x2d\xec\x4f\x03\x98\x88\x66\xf4\xfc\x16\x29\x6a\x81\xcf\x99\x3e\xbc\x66\x1c\x7d\xb0\x30\x58\x48\xbf\x96\x6c\x65\x8f\xeb\x3a\xe2\x3d\xf5\xbf\x41\x27\x4a\x2e\x97\x58\xd4\xe8\xdd\xa1\x50\x5a\x6b\xe1\xc3\x8f\x1a\x2a\xa8\x7f\x72\x13\x0c\xea\xff\xd1\x28\x40\xa4\x4f\x2b\xd8\x81\x6f\xe4\xe3\xff\xaa\x6b\xed\xad\xf4\xf2\x36\x53\xa1\xab\x46\x7b\x63\xa1\x2e\x86\x82\xb2\x15\x09\x89\x71\xaa\xb4\xc8\x1a\x7d\x77\x07\xd0\xba\x8a\xf5\xaf\x67\xf9\xe1\x04\x05\x91\x9b\x7e\x17\x78\xc5\x98\x4a\x2d\xc5\x82\x2f\xd5\x82\x2b\xd7\x82\xa8\xdd\x2d\x35\x5a\x88\xd3\x26\x4e\xfa\xfd\xaf\x02\x87\x1e\x3f\x53\x5a\xc3\x2f\x46\x95\xe2\x0e\x46\x0c\x05\xd4\x73\x15\x38\x84\xfe\xde\xf8\x33\xc9\xc8\x7c\x8c\xe9\x13\xf4\x7f\x72\x64\xa7\x65\x61\xa9\xf6\xc0\x28\xa5\x77\x9e\xc6\x24\xea\x57\xaa\xf8\x1f\x27\x87\xa3\x99\x76\xe9\x5a\x9f\x87\xb2\xb7\x44\xcb\x7c\xd7\x53\xeb\x37\x02\x94\x7e\xca\x6a\xd9\xdc\x9d\xf2\x62\x55\xa1\x5b\xd3\x65\x7e\x54\xbe\x2d\x7e\x94\x28\x85\x46\x86\x7b\x9f\xac\x3f\xd0\xf2\xcf\x54\xd7\xe5\x83\x73\x3b\x73\x67\x3d\x01\xbf\xde\xda\x06\x8d\xab\xd7\xc2\x0e\x27\x7b\xa3\xee\x67\x47\xb9\x98\x39\xac\x62\x43\x3f\xaa\xdc\x83\x20\x58\xe0\xc8\x50\xec\xdd\xe6\x68\xa0\xa1\x92\x8a\xbc\x7f\x19\x93\x35\xba\x7f\x8f\x5e\xa0\x4f\x10\xd6\xb5\x9e\x7b\xc5\x1f\xfb\xc7\xa5\x42\x4c\x0b\x6b\x68\x69\x90\x31\x5c\x1b\x1b\x72\x92\x00\x66\x99\x16\xc6\x58\x2d\x00\xae\x53\x44\xd8\x27\xb4\x45\x97\x57\x33\x54\xd6\xa2\x0d\xaf\x73\x3a\xa9\x86\x4a\x0b\xf7\x18\x2f\xed\xea\xa1\x93\xa5\x56\xb2\x13\x27\xbf\x95\x08\xf7\x0f\x7d\xc2\x71\x44\x62\xed\xfb\x3a\x77\xce\x1a\x17\x6d\x80\xc1\x32\xe1\xb4\xc7\x2a\xea\x7b\x82\x5b\xd0\x5a\x81\x33\x7a\x55\x98\xda\xfd\x3a\x42\x40\xb7\xf5\x7e\x74\x9a\xa0\xa7\x73\x87\xd1\x91\xdb\xba\x82\xe7\xd7\x22\x63\x70\xda\x47\x29\x7a\x9e\x01\xed\xdf\x0e\x92\xe1\x11\xb9\x36\x22\xe2\x93\x6d\xe6\x5f\xbe\x58\x14\xbe\xcd\x6b\x24\xe6\xdd\x50\xa9\xa5\x2f\xa8\x6b\x72\xcb\x49\x9a\xce\xb5\xc2\x34\xed\xfb\x14\xd4\xc3\x38\xa4\x47\xd8\xea\x48\xfa\xde\x24\x48\xee\x7e\x39\x12\xd4\x69\x16\x70\x9d\xa1\xc9\x3f\x93\xde\x35\x07\xe5\x00\xb7\xfb\xfa\xcb\xaa\x27\xf6\xb1\x98\x92\x18\xb0\xdc\x9a\x02\xe3\xcf\xc5\x87\xe9\xa4\x38\xe8\xd3\xcf\x30\x8f\xb5\x3e\xfa\x34\x7d\xa4\x9e\x49\xde\x8b\x66\x88\x4a\x0e\x15\x13\x3f\x7c\xc4\xba\xa5\x38\xda\xb4\xd6\xbf\x42\xf9\xf4\x6a\xb9\x17\xa2\x07\x37\xb5\xe8\xfd\x38\x60\xe6\x23\x3e\xf0\xf3\xf4\x24\x5a\xab\xfc\xb8\x2b\x6d\x0b\xd8\xdb\xe7\xfb\xde\x97\x21\xc7\x58\x8f\x3d\x48\x37\xc4\xaa\xab\xcb\x33\x71\x8c\xa9\x76\xa0\x3e\x9c\x96\x71\x0c\xe9\x10\x21\x99\x0f\xf6\x85\x34\xfe\xa5\x30\xf0\x09\xc7\x66\x77\x5c\x48\xc2\xaf\xbf\xfe\x27\x5e\x81\x08\x08\x76\xb9\xb1\x8a\xfd\xa4\xbd\x87\x5c\xfb\x64\x5b\x19\x97\x4e\x2f\xc5\x94\xda\xf9\xc4\xda\xc1\xa9\xe5\x79\x5f\xd7\xe9\xa7\x6e\x22\x68\xcb\xe8\x00\xad\x70\x3c\x0e\x31\x28\x01\x84\x6f\x4b\x2f\x46\xe3\x78\xd5\xb4\xf2\x80\xe0\x5d\x79\x54\x97\x64\xf3\x87\xb3\x41\xda\x4b\xe0\x27\x78\x99\x6f\xcc\xa4\x6d\x88\x30\x1d\x57\x79\xd5\x6f\xa1\x1f\x35\xd9\xfb\x0a\x8d\x72\xbf\xac\x7d\xe1\x8f\xb5\x59\x7d\x26\xe2\x14\xc9\x19\x69\x41\x13\x5b\x87\x3b\x64\xbd\x54\x2b\x2f\xe6\x49\x2f\xbd\x09\xb0\x82\xaa\x94\x5c\xfa\xce\x9f\x95\x13\xb3\x14\x6b\x45\xb9\xa5\xfe\x0c\xfc\xd1\x26\x28\xeb\xf4\x67\xf7\x1f\x32\xeb\xe0\x54\x34\xba\x09\x3a\xbc\xd2\xff\xfb\x71\x34\x64\xbe\x32\x49\x18\x4a\x5a\xe8\x68\xab\x9e\xab\x24\xd6\xaf\xfc\xd3\xef\x4c\x04\xea\x7e\x75\x97\x83\x8a\x1f\x0e\xe9\x63\xfc\x28\xce\xb6\xbf\x65\xdf\xe7\x8c\x3c\xc7\x00\xc9\x3a\xf2\x0f\xdb\xdd\x31\xf7\xe8\x8b\x93\x0c\x18\xdb\x57\x12\x95\xe2\xdb\xda\x0b\x8b\xae\x7e\x0b\xaa\x13\xa1\x4c\x5a\x5c\x29\x16\xcf\x46\xec\x6b\x4f\xa1\x13\x7e\x42\x9c\xea\x7e\x93\xe8\xed\x38\xd0\x16\x6e\xbc\xe2\x4b\xbb\x77\xf9\x43\xc5\x0e\xa1\x91\xf3\x67\xd7\x03\xe7\xde\x59\x3d\x1f\x4c\xc8\xa3\x9c\x98\xbf\xdb\xd7\x80\xc2\x5c\x05\x0f\x8e\xce\xe9\x12\x9f\xe4\xe8\x41\x64\x1f\x14\xa3\xba\xa7\xc4\x38\x01\x2c\x0f\x22\x43\xc8\xad\xb9\xcf\x00\xf4\xa5\x13\xeb\xca\x32\xd9\x6c\xaa\x3c\xac\x18\x3e\xc4\x58\x62\xf1\x86\x7c\x14\xc8\x60\x2e\xe2\x48\x90\xaa\xa6\x30\x0e\xc5\x90\xb5\x19\xea\xef\x52\x9b\x2e\x45\xe5\xae\x7c\x05\x7b\x86\x15\x58\x27\xfe\x43\x2c\x12\x6c\x91\x62\xf5\xf3\xfe\x6a\xaf\x8d\x60\x45\xc2\x13\xef\xff\xef\x2c\xf1\x3b\x93\x42\x89\x38\x54\x71\x75\xf1\x5c\xfb\x66\x05\xc2\xca\x23\xcd\xe0\x90\x93\x72\xe1\x84\xda\x9e\xd4\x41\xff\x82\xe9\xf6\xe8\xd8\x09\x65\xdb\x15\x1c\xe0\x5c\x7e\x01\xbf\x6d\x9f\xd3\xf5\xdd\x01\xba\xbb\xdd\x5b\xcb\x03\x28\x83\xde\x0b\xc8\x9c\x55\x2f\x61\x5d\xd0\x03\x4d\xe0\xcf\x83\xfe\x15\x57\x10\xbd\x10\xdc\x40\xc8\x36\x9c\xdd\x84\x9a\x17\x2d\xd0\xf3\x12\x03\xce\xb8\xdd\x56\x56\x58\xea\xb8\x41\x94\xfc\x5f\x91\x7f\xe3\x7a\x95\x24\xda\x26\x6d\x12\x75\x49\x49\x3a\x0d\x57\x16\x30\xce\xd7\xa6\x64\xc8\x0f\x00\x25\x58\xb5\x8a\x1e\x1f\x1d\xa7\x9a\x68\x2f\x8b\x9b\xc7\xee\xe8\xb4\x3e\x58\x93\x2d\x83\x72\x3c\x1c\xe7\xba\x81\x4d\x1d\xb6\xe2\x3a\x5b\xff\xcc\xea\x10\xff\x8b\x86\x69\xda\x6c\x56\x20\x6e\x1f\x81\xb8\xeb\x94\x6e\x95\xae\x9c\x6d\x29\xf8\x17\x1c\x89\xb7\x1d\xef\x60\x79\xdb\xba\x07\xc3\x0a\x3c\xbb\x83\xa2\x3c\x58\x81\xdf\x79\x78\x8b\xee\x14\x42\x4d\x3d\x5a\x67\x47\xf0\x85\x4a\x50\xcd\xa8\x77\xd1\xe3\xa7\x72\x3a\x22\xce\x2a\x42\x87\x20\x40\xef\x1b\xe6\x51\xed\x70\x2e\xf6\xf0\xd8\x42\x73\xbd\x3f\x4d\x00\xfd\xa3\x96\xd0\x9d\xaf\x92\xd2\xfe\x38\x51\xd4\xff\xb0\xee\x05\xee\xb5\x7f\x19\x38\x2f\x2f\xcd\xe2\xec\xc9\x27\xf5\xbe\xe0\xb8\xe2\xfb\xc4\x92\xd7\x82\x95\xe7\x4c\x86\xd2\x49\x4c\x54\x4c\x93\x14\x4d\xba\x75\x05\x1c\xea\xbb\x59\x70\x85\x52\x40\xb1\x14\x8c\x9f\x47\x24\x6a\x2b\x27\x6e\x91\x8f\x80\xa7\x55\x93\x1f\x19\x4c\x0e\x7d\x9b\x18\xd5\xde\x14\x2e\xc0\x6e\x75\x08\x19\xc6\x4c\x7a\xcc\xd6\x2c\x3c\x7b\x81\x2a\xe2\x2f\x46\xd7\x74\x10\xaa\xb3\xd7\xb2\x0e\x32\x0f\xb4\xe5\xd9\x9e\x91\xb0\xd5\xbb\xae\x70\x86\xf7\x0f\xde\x1d\x28\xa2\x1a\xf7\x48\x5a\x54\xca\x9f\x5c\xe1\x2f\x8a\xa4\x35\x2b\x58\x1c\x7b\xa2\x31\x20\x6a\x86\x06\x58\xbb\x15\x1a\xb7\x5f\x9d\x06\x8b\xee\xf1\x34\xe4\xc8\x79\x3e\x35\xf0\x9e\x0b\x6a\xc8\x96\x97\x3f\xd5\xed\x31\x99\xd2\x43\x9d\x4c\xe9\x43\x47\xad\x97\xc1\x4e\x92\xe9\x7a\xb4\xa6\x29\x74\x70\xa3\x4c\x74\x5c\x04\x18\xc9\xb5\xe8\x4c\xdf\xa2\xae\x7a\x86\xd9\xbf\xd6\xe8\xa3\x04\x5b\x1c\x05\x4a\x45\x08\x6b\xee\xa3\x1c\x16\xb5\x6f\x01\x94\x0c\xba\x60\x0e\xb2\x07\xcc\x3d\xc7\xca\xec\xda\x1e\x50\x5b\x42\x84\x98\x84\x8f\x4a\xaa\x69\x5e\xd4\xae\xa1\x07\x46\xb3\xd6\xd6\x02\x06\x49\x09\x09\x8e\xb4\xf6\x9f\x9f\x5a\x35\xb0\x95\x68\xd0\xcd\xa0\x64\xfb\xd4\xf2\x79\x08\xd4\x18\xa6\x58\xcf\xd2\x4a\x7e\x18\x9c\x2a\x01\x87\xce\x68\x27\xe8\x92\xa9\x0c\xb9\x46\xc4\x77\x8c\xcd\xcd\x09\xe6\xab\x51\x07\xa3\x29\xcf\xb4\x6e\xcd\x81\x02\xd5\x4d\xb5\xe0\x4d\x0f\x58\x6a\x52\x92\x80\x18\xdc\xcb\x67\x81\x56\x09\xff\xe7\x5b\xad\xa2\xaa\x9c\xe9\x3d\xc7\xae\xfd\x2c\x65\xf9\x01\x34\x4d\x41\x4f\x59\xd9\x9b\xef\x13\xf7\xb1\xeb\x05\x21\xaa\x88\x6b\xa8\x9b\x9f\x28\xd4\xa3\xe4\xec\xf2\x5b\xe6\xcf\x31\xa3\xa9\x15\xfd\x50\xc0\x69\x05\x48\x1d\xfc\xd0\xee\xec\x02\x4d\xcf\xee\x1b\xf6\xab\xcf\xfc\x1f\x13\x4b\x52\x0c\xba\xb7\x0a\xf1\x74\x28\xd9\xdc\x65\xc5\x6d\x85\x13\xab\x60\xc8\xc0\x70\x11\x07\x56\x7a\xe8\xcf\xe5\x7c\xe9\x11\x7f\xf6\xdb\xb5\x1e\x16\x8e\xbf\xd8\xa9\x06\xbe\x4f\x34\x81\x7c\x9f\x51\xe7\x5f\xac\xea\x7f\xc7\x8c\xc3\xf8\xec\x98\x13\xe1\x00\x36\x59\x50\xa4\xa5\xb0\x88\x05\x8d\x4a\xc9\xc6\xf9\xa1\xc2\x58\xc7\x73\xc1\x12\x48\x86\xc1\x41\x78\x0a\xd7\xf2\x46\x26\x07\x09\x19\x46\x4a\x3e\x56\xb7\x3b\x42\xf8\x96\xf8\x8b\x1e\x63\x9a\x1a\x2b\x96\x34\x55\x83\xbc\xbb\x65\xc5\xa7\x65\x78\xd3\x2b\x8a\x09\x3e\xbc\xd9\x59\xa2\xdb\xa2\x4a\x2b\xd2\xad\x56\x01\xb5\xd9\xc5\xe0\x12\x48\x7a\x3d\x06\x79\x6d\x91\x04\xd0\x2b\xdf\x1a\xe9\xa3\x65\x2d\xce\x40\xb4\x91\x82\xd2\x06\x63\xed\x13\x48\x91\xdc\xb1\xff\x6b\xee\x17\x5d\xc4\x9a\x33\x18\x45\xc6\x3d\x5b\x18\x7f\x35\xa9\xce\xfc\x45\x9f\xa1\x8e\xcf\xea\x02\x27\xc4\x3c\xff\x67\x5a\xd3\xe5\x8e\x3a\x56\xa8\xa1\x33\x6c\xff\xcb\x53\x72\x3b\x00\x8d\x79\xd3\x0b\x1f\xf5\x3a\xbc\x02\x9b\x6a\x68\x53\xe2\x1e\x52\x6f\x8b\xd1\xc4\x82\xbc\xb8\x70\xc8\xbe\xf0\x65\xd8\x1e\xc9\xaf\x67\x6e\xe0\x0e\xe1\xde\x73\x74\x50\x49\xae\xfb\x5d\x1c\x32\x81\x4b\xf8\xb4\x11\x52\xa8\x09\x7b\x71\x21\x28\x49\x89\x90\xd1\x4e\xa0\x20\x45\x4e\x1b\x03\x3d\xb4\xcf\x97\x65\x6a\x1e\x76\xff\xf0\x55\x5a\x58\x14\xb9\x08\xee\xf7\xc3\x0a\x67\x84\x39\xc2\x1a\x33\x51\xd7\x63\x84\xc1\x71\x76\xb1\x9b\x8c\x99\x7b\xb3\x8d\x9f\x03\xc6\x5a\xd7\x68\xf8\xa6\xdd\xfe\xfd\x14\xa8\x5e\xe7\xc2\x6e\xca\xab\x36\x66\x8e\x81\xa3\xa4\x61\xf3\x13\x82\xa5\x15\x2a\xbf\x88\x5e\x21\xa9\x8d\x07\xd5\xa5\x3e\x7f\xaf\x74\xdb\xee\x75\x3f\x12\x99\x72\xca\x80\x2f\xbd\xda\xc4\x00\x6c\xab\x27\x50\xf6\xea\x10\xd5\x56\x7a\x6d\x2d\xc0\xbc\x7f\x6c\x38\xa5\xdb\x95\x7d\x6d\xd9\x5a\x36\x7e\x87\xa9\x1e\xbc\x03\xb8\xcf\x69\x43\x42\x0f\x81\xf1\x1e\x1b\x6b\x21\xba\xfe\x39\x49\x23\xc0\x69\x95\x45\xd8\x24\x78\xa2\xca\xbf\x3b\xec\xe8\x6b\x1b\x10\x9b\x2a\xb4\x34\x35\xd1\xb1\xdf\x00\x1e\xb3\x93\x32\x0e\x1e\xc2\x16\xe1\x26\x8d\xcd\xc7\x7b\x8a\x5f\xf6\x7e\x1c\x7a\x77\x01\x5b\x27\xfc\x1c\xe4\x20\xce\xaf\xdb\xcf\x81\xb1\x98\x3e\xa7\x12\xa0\x4a\xa3\xb3\xad\x7f\xa9\x1a\x4e\x39\xaf\xbd\xf9\x73\xe4\x8d\xdf\x29\x35\x5e\x1a\x7f\xfd\xfa\xfd\x69\xa8\x53\x49\x8e\x7e\xfd\xe3\x35\xc7\xbe\x55\x47\xd3\xd9\xec\x6d\xd0\x4b\x82\x4d\x1d\x7f\xe3\x11\xf1\x27\x53\x0d\x0a\xcc\xd3\x2d\xfd\xbc\x02\x69\x2d\x87\xe5\xe7\x14\x1c\xb3\x47\x10\xcf\xa3\xd5\x41\x53\x85\xc8\x84\x9d\x2d\xed\x47\x64\x03\xbe\xe3\xb8\xa0\xe3\xe7\xdb\xfa\xbb\xe5\xf5\x31\x42\x3b\x10\xce\x6a\xd1\xb3\xf2\xa4\x5c\xf4\xea\x64\xb5\x27\x1f\x94\xd7\xf6\x9d\x50\xf3\x75\x4c\xeb\xf6\xcc\x35\x42\x72\xdc\x78\x26\xdf\x56\x41\xd5\x67\x8b\x37\x43\x4a\x27\xb3\xa1\xa3\x4e\x86\x18\x89\x37\xf5\xfd\x9e\x13\xcd\x77\xf4\x5a\xd7\x3c\x68\xb1\x7a\xf1\x25\xcc\xd2\xd9\xb2\x43\xa2\xf9\x5d\xb4\x73\x6e\x11\x70\xbf\x47\xdd\x7b\x24\x5c\x7a\xff\x4a\xe4\xe3\x5b\x45\xcc\xaa\x19\x74\x1d\x93\xe8\x28\x48\x14\xef\xf1\xfa\x74\x45\xa7\xf5\x83\xab\x0a\x43\x7b\x2f\x89\x68\xa8\x34\xf9\x2c\xc8\x7c\x6d\x46\xf9\x67\x90\xef\x26\x0d\xda\xe2\x47\x4f\x98\x49\xdc\x63\x68\xb9\x68\x71\x0c\x6f\x87\xf2\x3c\x28\x2a\x2d\xf5\x90\x30\x04\x65\x71\xd2\x81\xdd\x6e\xba\x5e\x49\x87\x15\xcf\xd4\x68\x14\x7c\x4d\x10\x2e\x43\x8f\xbc\x8f\x4d\xf9\x12\x20\x9f\x1d\x5a\xe5\xb2\x91\x41\x2b\x39\x64\x8b\xa6\x10\x78\x70\xe3\x8e\x9c\x7d\x89\xb7\x61\xcb\x68\x60\x95\x82\x44\xb8\xdc\x3f\x9d\x73\x44\xe5\xe7\x35\xbe\x21\x27\xe0\x37\xee\x59\x0d\x89\x55\xfc\xd6\xf9\x48\x48\xcd\x88\x9a\x5a\xa3\x49\x5e\xae\x2f\x36\x6c\x64\xf6\x7d\x90\xbb\xf1\xc6\x17\xbc\x31\x91\xf8\x8d\x9a\x24\x82\x13\x08\xf6\x58\x15\xfa\x8b\xaf\x85\xdb\xb0\x6d\x36\x0d\x96\xbb\xe5\xc9\x02\x65\x90\x23\x49\xdb\x34\x3b\x7c\xc2\xd7\x17\x2d\xae\x7d\x88\x22\xa3\x35\x48\x55\x72\x32\xdd\x3b\x5a\x1f\x1a\xc3\x1c\xce\x4b\x81\xd5\x2f\xb9\xd0\x68\x04\x8f\x15\x1d\x96\x99\xf5\xb5\x58\x6c\x5c\x2a\x36\x7d\x44\xda\x25\x74\x52\xb4\xe3\x35\x92\x31\x21\xe3\x5a\x61\x7d\x60\x7e\x26\x94\xa8\x28\xf2\x57\x94\x65\x94\x64\x32\x7e\x6d\xe5\x5a\xd2\x1b\xad\xda\x75\xd4\x39\x6d\x81\xbf\x32\x7f\xc0\x44\xd8\x70\xdf\xc3\xe7\xd8\x3d\xfc\xc2\x4d\xff\x45\xe7\x36\xde\x2c\xc3\xd3\x88\x8a\xc4\x13\x25\x0f\xf2\x53\xf8\xd1\x76\x74\xa1\x8f\x9c\x97\x02\xfe\x7f\xb6\x40\xf6\x79\xe6\xe5\x94\x6f\xee\x23\xfe\xd3\x63\x84\x47\x6b\x69\x03\x9a\x30\xb4\x92\xd0\x3e\xcf\x8e\xf6\x8e\x50\x7d\x8c\x83\x97\xf7\x0d\xab\x36\x95\x4c\x73\x3e\xdb\xf2\x14\x1c\xd5\x1c\x26\x10\x92\x8d\xd2\xa2\xd0\xd7\x79\x14\x52\xce\x1d\xef\x31\xf4\xe2\x23\x33\x50\xc4\x9b\xad\x87\x05\x08\xc2\x8d\x3b\x1e\x30\xfd\x9f\x20\x35\x39\x6b\x55\x32\x4e\xcb\x28\x90\x8c\x27\xc6\x5b\xb3\x92\x96\xd4\x4c\xa5\xef\x11\x22\xa6\x52\x3f\x0e\xc3\xbf\x1a\x92\xef\xb3\xb4\xfd\x34\x5d\x19\xd3\x09\x26\x1b\x19\x12\x85\xc7\x05\x5f\x1d\x65\x1e\x91\x67\xbe\x98\xfd\x91\x91\x13\x60\x3a\x7a\xae\x80\xc2\xd4\xfe\x01\x58\x6f\xf6\x00\x62\x4d\x77\xb5\x80\x5e\xd3\x98\xad\x59\xb7\xcf\x9d\xa5\x00\x2d\xde\x76\x5f\x78\xab\x35\x81\xbb\x7e\xff\xc9\xdb\xb9\xd7\x8a\x30\xb5\x44\xe5\x4f\x68\x56\x37\x57\x35\x99\x66\x7b\xdb\xd5\x34\xeb\x4a\x55\x0b\x0f\x6c\xb4\xf4\xf8\xa4\x81\xfe\xc9\xfc\x19\xbb\x54\x24\x1b\xa8\x48\xe6\x20\x01\xb9\x40\x3d\x90\x50\x00\xe5\x82\x31\x5b\x52\xd4\xee\xab\xd1\x72\x2a\x64\xfa\x08\xac\x7c\x47\xfe\x3e\xab\xfa\x18\xe0\xfb\x1a\x1d\x4a\x0d\xbf\xdc\x1d\xba\xff\x6d\xe0\xbd\x87\x86\x97\xf2\x00\x4e\xb3\xbc\xa0\x86\x82\xa7\x58\x48\x78\x9c\xb8\x84\xc3\x22\xe0\x82\xf4\x54\xef\x1a\x24\xbf\xf2\xfd\xa5\xa4\x2c\x13\xea\xc6\x38\x6d\x02\x54\x51\xfa\x2b\x53\x18\x8b\x17\xdd\xad\x6a\x06\xd7\xde\xf4\x9f\x67\x00\x5c\xb4\x88\x69\xbc\x2f\x8e\x73\x4e\xe2\x04\xbe\x8a\x47\xb0\x60\x8e\xdc\xe9\xdf\x2c\xfd\xbd\xb4\x4c\xc2\x6b\x86\x73\xd9\x09\xfe\xd0\x9b\xe3\x38\xc2\x9c\xde\x58\x83\x04\xcb\xc0\x66\x55\x75\x94\x44\x1e\x8c\x75\x43\xc9\xcb\x73\x32\x34\x43\x63\x8b\x85\x75\x37\xae\x7a\x45\x46\x69\xc8\x3a\x8f\x30\x45\x81\xed\x14\xd6\x52\x50\xba\x4a\x81\xd5\x57\xc1\x16\x04\xf3\x40\xc6\xfa\xb1\x60\xa1\x2a\x1a\xb6\x0d\xec\x90\xca\xa3\xc4\x58\xb8\x57\xa8\xb2\xc5\xa5\x0f\x35\x45\x20\xdb\xb9\x6b\x6c\x9e\x4f\xe6\xef\x7a\x06\xd8\x29\xed\x72\xc5\x33\xd4\x1e\x7d\x15\x55\x96\x17\x70\x14\x17\x0c\xa1\xdd\xbe\x04\x4c\x36\x76\x9c\xba\x9e\xe1\xa9\x93\xf3\xe8\x4e\x8e\xb6\x6a\xc7\xbb\xf2\xa6\x7b\x80\x00\xa8\xe2\xdb\xf0\xb0\xfc\xfa\xef\xee\x56\xdc\xac\x55\xa8\x9f\x26\x14\xde\xd7\xcf\x01\x0b\xb0\x69\xc3\xd3\x50\xf6\xb3\x88\xe4\xb1\xf9\x10\xf5\x96\x42\x74\x82\x93\x07\x07\x4c\x20\xf0\x19\x50\x37\x39\xd9\xaf\x99\x8b\x56\xad\xd0\x9a\x8a\x50\x8b\x7b\x58\xc8\x3a\xdb\xfd\x25\x3f\x2b\x99\x1e\x26\x20\x4b\xaa\xae\xeb\x1b\x86\xf1\x64\x00\x63\x07\xd0\x08\xc1\x89\x18\x5b\x20\xe4\x27\x6f\xce\x36\x03\x90\xc2\xee\x2e\x51\x37\xba\xf8\x5c\x90\xfa\x74\xbd\xb9\xd6\xc4\x06\x6e\x05\x83\x49\xfe\x31\xec\x92\x29\xe8\x52\xcc\x58\x38\x5f\x59\xe3\xad\xbd\xe3\x99\xdb\x0c\x5d\x9f\xbe\xa6\x9b\x5e\xf5\xc2\xba\x94\xfe\xb4\x43\x35\x16\x11\x61\xd0\x06\x95\x7a\x2f\x01\x82\x9f\x71\x63\x3f\x2f\x84\xff\xc8\xe8\x66\xe1\x0a\x7c\xb0\x7e\x57\x07\x86\xfb\x0f\xd1\x70\xa9\x4c\x77\x8f\x58\xfe\x30\xa6\x8b\x72\xc9\x99\x79\x2b\xb4\x1a\xcd\x83\x17\xbf\x41\x1f\xe8\x2c\x48\xbe\xa6\xe8\x30\x9e\xd8\x61\x1e\xe1\xca\x35\xde\xb9\xd0\x38\xae\xfa\xf4\xd1\x01\xe8\xdb\xba\x67\xe7\xc3\xe2\x97\x8d\x56\x2a\x1e\x32\x61\x13\x54\xbd\x0b\xfe\xbe\x92\xf1\x66\x0b\x08\x8e\x2e\xc7\x4e\x95\x6b\x97\x1a\xe2\xa3\x25\xc0\x4b\x70\x5e\xe0\x43\x92\xee\x18\x57\xd6\x18\xd6\x97\xe2\x17\xe9\x4c\xed\x46\x3b\xdb\x27\xa9\x72\x13\x80\xfe\x58\xe6\x56\xeb\x28\xad\x44\xf1\xd4\x38\x04\x53\x76\xbe\x83\xbd\xd3\x92\x78\x25\xde\xe0\xa3\xcf\xfb\x18\x40\xc2\x11\x82\x78\x94\x7b\xdd\x3a\x96\x7c\x49\x20\x95\x92\x9d\x3d\xe8\x6e\x08\x0e\xb1\x92\x73\x61\x37\x23\x8a\x2c\x0f\xf4\x27\x03\x7c\x3d\x7d\xc3\x5b\xcb\x57\x94\x4e\x49\x50\xa8\x7f\x83\xac\x89\x83\xf2\x32\x42\x20\x3d\xa0\xb6\x83\x74\x83\xd5\x78\xaa\xfe\x0d\x76\x77\x72\x28\xa0\xec\x03\x88\x54\x7b\x19\x05\x0c\xff\x41\xf7\xbe\x6c\xe9\x60\x00\x08\x66\x88\x8d\xfa\x79\xfc\x35\x46\xd7\x70\x09\x2d\xd4\x73\x70\x1e\xcd\x70\x79\xe2\x56\x99\x85\x1f\xa4\x72\xcc\xd9\x36\xbc\x0c\xdb\x49\x0e\x52\xd0\x6e\xb2\x0c\x99\x8b\x0f\xad\xce\x9f\x9f\x73\xac\xbb\x14\x60\x2b\x76\xf2\x37\x4b\xd2\x99\x8f\xe9\x4d\x65\x92\x35\x8f\x53\x83\x7d\x7f\x72\x93\xbe\xdc\x05\x90\x2c\xe3\xb3\xc4\xb5\xb5\x62\x56\x61\xdc\x2c\x74\x6d\x68\x53\x96\x00\xe8\x56\x4c\x75\x39\x30\x4b\xd0\x34\xa5\xb1\x82\xd3\xe9\xcd\x66\xec\x80\xb4\xa3\x47\xaa\xa2\x21\xff\x3e\x42\x35\x5f\x06\xa2\x87\x21\x3d\x34\xf9\x06\x0f\x63\x3e\x8f\x96\x33\xb5\x5d\x0e\xaf\x6e\x1a\x78\xca\x76\xd1\x5c\xd6\x62\xe5\xd1\x2e\x2e\xba\x9c\x95\xd3\xdf\xe7\xd3\xac\x91\xd2\x68\x36\x41\x02\x28\x2d\xeb\xf9\x8f\x62\x69\x80\xfe\xa7\x19\x1b\xe5\xcf\xbe\xdd\x14\x3a\xad\xb7\x4e\x0d\x1b\x86\x55\xab\x92\xfb\xd3\x11\xaf\xa2\x76\x3a\x14\xf8\x59\xb8\xec\x83\xf6\x17\x70\xbc\x86\x51\x67\x34\x70\xc4\xee\x5f\xe0\x16\x0c\xd8\xc2\x91\x92\x5b\x11\xc3\x4e\x36\x0d\xba\xd0\x1c\x4e\x4e\x2b\x81\x0b\x08\x8e\x6f\x6f\xdb\x73\x0a\x01\x74\xe0\x65\xcf\x5c\x6f\x9e\xca\xe7\xf1\xe5\x0c\xa4\xbd\xa9\x21\xa1\x11\x84\x1f\xac\x52\xe7\xde\x45\xef\x8e\x32\x52\x6b\x43\x08\xd8\xad\xf2\xac\x56\x95\xf0\x5c\x7f\x2a\x8e\x62\xdf\x74\x96\xb3\x9d\xd1\xe5\x22\xd5\x62\x23\xf2\x1a\x83\xc0\xdd\xe0\xf2\xf9\xc3\xcd\xcb\xe7\xe3\x1b\xec\x5d\xa3\xef\x8b\x87\x5e\x9f\xb5\x61\x17\xcd\x55\x7b\x10\xf3\xfe\xa1\x04\xd5\xe7\xcf\xe5\xb7\x18\xa0\x86\x0b\x88\x28\x7d\x60\x54\x5a\xd7\xad\x96\x7f\xe2\x5c\xb8\x55\x66\x2b\x5b\x9d\xda\x07\xea\x96\xe3\x03\xe6\x19\x21\xb2\xf6\x0e\x63\xbe\xf5\x2e\x58\x48\x17\x03\x64\xbc\x88\x2a\x34\x68\x31\x86\xa0\x3b\x78\x1b\x8a\xef\x58\x3d\xcf\xe7\x0c\xda\xab\x66\x1c\x62\x24\x26\x15\xde\x79\x78\xf7\xf5\x10\x05\x68\xd2\x15\xf2\xbd\x64\x52\x1e\x06\x09\x3f\x3e\x53\xe6\x46\xfc\xdf\x9b\x8a\xfe\xbb\x59\xa2\xaa\x58\x00\x72\x4b\x89\x6b\x31\xf1\x76\x07\x27\x7e\xb9\xba\x3c\x8a\xf2\x55\x24\x2b\x2c\x66\x9e\x82\x6c\x8e\x8e\xe6\xb3\xfe\x3e\x69\xa4\x8b\xb9\x5f\x5f\xc8\xfe\x34\xd2\xbd\xf4\x16\xc7\x49\xdf\xbd\x11\x7e\x4e\xb6\x43\x26\x11\x4f\x37\x7f\x91\xc1\xb0\x7a\x47\x79\x87\x47\xaa\x2e\xba\x3e\x37\xad\x71\x99\xd7\x34\x62\x49\x04\xde\xa4\x09\x9e\x4f\x21\xbf\xd5\xd9\x7e\xe3\xea\xa8\x78\xf2\x1a\xee\x71\x1e\xd2\x45\x8c\x6d\x21\x6b\xa5\xab\xa9\x65\xf1\x77\x95\x1e\x55\x44\x85\x59\x72\xda\xf1\x17\x15\x02\xc6\x02\x83\xdf\xf6\xd9\x10\xbe\xeb\x33\x92\x10\xff\x8e\xe5\x68\x99\x34\xcc\x88\xc0\xf6\xba\x49\xf4\xf7\xcc\x32\xd6\x66\xec\x10\xdc\x76\x34\xd7\x77\x49\x93\x4a\xc5\xc1\xde\xc5\x20\x5b\x30\xef\x0b\x3f\xd5\x1b\x4b\x17\xd4\x83\x84\x2b\x4e\xe7\xd7\xfc\xf1\x30\x6f\xde\x30\x60\x52\x9b\xfc\x33\x97\x52\xfd\xf7\xfe\xa6\x00\x13\xcc\xef\x00\xa8\xb3\x70\xe1\xa0\xa6\xdf\x44\x7b\x9b\x70\xac\xd5\x88\xad\xc1\x32\x9f\x76\x21\x12\x38\xf8\xac\xa5\xc0\x82\x02\x24\xea\xcc\x25\xcb\x6b\x2f\x49\x3d\x34\x51\xbb\x8d\xcd\x07\xa5\x88\xe5\x15\xa6\x43\xfe\xf0\x24\x5b\x82\x6b\x13\x04\x56\x7c\x61\x2a\x77\x6e\x7f\xc0\x0e\x97\x38\x03\x28\x82\xd8\xe1\x12\x55\x24\x6a\x5c\x45\x3c\x33\x49\xc4\xd6\x94\x07\xb6\x43\x7f\x51\xcc\x5a\x99\xd3\x26\xd3\x3a\xc5\x2f\x5c\xed\x02\x5c\x01\x86\xea\x8f\xa2\x41\x2b\xd2\x16\x21\xbe\xb3\xaa\x3e\x2a\xfb\x3e\x83\x72\xf8\x62\xc3\x8a\x28\x4e\x84\x9e\x37\xb6\x14\x67\x29\x2b\x9e\x0b\x41\xb5\x65\x2c\x40\x6d\xcf\x7b\x8f\x6c\x76\xc1\x29\x79\x18\x07\x8a\xaa\x5e\x12\x02\xa8\x34\x9e\xe7\x39\x54\x9d\x55\x4b\x5d\xc9\xe2\x5f\xf1\xcd\x50\xc5\xae\xdb\x1c\x12\x1e\x2f\x29\x04\xff\x2f\x65\x9b\xb3\xd2\xbd\x0a\x9f\xc8\xd2\x42\x4c\x48\xe0\xd7\x2e\xea\x4d\x96\xab\xef\x5a\xfd\x2d\xcd\x12\x18\x58\xec\x9d\x1f\x73\x70\xf9\x56\xb9\xf4\x11\x63\x75\x51\xb6\x2d\xf2\x9e\x03\x1e\x37\x08\x49\x3b\x29\x15\x83\x4d\xcb\xb5\x30\x01\xf8\xf6\xe4\xd2\x86\xb4\xd7\xd1\x12\x8e\x1f\x98\x3c\x95\x33\xef\x46\xf4\x6f\x8a\xf2\x92\xe4\x58\x13\xaa\x6f\xef\x62\x1b\x1e\xe9\xc7\xef\x84\x20\x7d\xe8\x51\x5f\xfa\x92\x1c\x2a\x37\xc8\x95\x5a\x56\xef\xeb\xcb\xfb\x67\x8a\xf0\xca\xb3\xca\x5f\x23\xca\xea\x5f\xd8\x97\x85\xef\xf0\x11\xe6\xc3\xcb\x48\x10\x3d\x69\x73\x5c\x0e\x07\x72\x43\x7c\x74\xe2\x23\x17\x10\xe9\x04\x6a\x5f\x3a\xda\x3d\xfb\xdb\xf4\x4d\x30\x49\xd0\x0f\x6d\xef\x41\x33\xda\xef\x3c\x98\x68\x25\x75\xfa\xc6\x63\x1b\x5a\x88\x24\x0c\x43\x7a\xeb\x93\x6e\xd0\x72\x17\x62\x7e\xb9\xe3\x4d\x18\x70\x2e\x4f\xd3\x99\x87\x0f\x4c\x83\xac\x1c\x77\xae\x0d\xc0\x7d\xc1\xce\x76\xa0\xa7\xce\xcb\xc1\xe5\xff\x75\x05\xa2\xa8\x2c\x41\xe2\x67\x5a\xad\x20\x01\x13\x03\xe8\x49\xc6\x48\xd7\x95\xb2\x3a\x6c\x5b\x83\x98\x5f\xda\xed\x2d\x71\x9b\x47\x67\x67\x23\xf4\x42\xd0\xbd\x43\x00\x5f\x7f\x9f\x41\xa3\x33\xec\xb0\x11\x82\x9f\x27\x07\x07\x4a\x70\xc2\x83\xb4\xaf\x06\x4c\x71\x64\x70\xa5\xfc\x52\x49\x52\x82\x11\x8c\xbe\x89\x4d\x61\xab\xce\x77\xf5\x6c\xee\xd9\x7f\x6c\x63\xfc\x3f\x2f\xe0\x0a\x8d\xee\x17\xec\x0d\xac\xe6\xac\x47\xc1\x0c\x9c\x15\x1f\x0b\xce\xf8\x08\x80\xbe\xa8\xbb\xbe\x46\xa9\x8b\xad\xad\x15\x1b\x43\xfb\xb7\x4e\xb5\x29\x53\xc3\xdd\xc5\xaa\x9f\x2a\x2f\xf3\x47\xdf\x5f\x5e\x1d\x7a\xaa\x74\x86\x31\xa9\x8b\xca\xc0\x3d\x10\x51\x56\x28\xa4\x97\x3c\x52\x4a\x74\x80\x2c\x4e\x23\xc8\x6d\x25\xc9\xb4\xcd\x24\x6e\xab\xc0\xeb\x2b\xbe\x2c\x00\x59\x7a\xe9\xa8\xb6\xdd\xae\xd7\x1d\xca\xcf\xc9\xee\x25\x05\xd1\x17\x90\x34\x33\x78\xdf\x53\x7d\x41\xf8\xef\xcc\xf0\x94\xe0\xd1\x03\xfa\x2d\x68\x60\xb5\xe0\xf6\xcb\x19\x00\xca\x35\xc1\x1c\x6d\x46\x88\x67\xe2\xf4\x46\x24\xf5\xa3\x16\xb9\xc7\x3f\x74\x64\xc9\x42\x73\x1f\xc6\x81\x15\x2c\xe6\x89\xac\x83\x2d\xf8\xde\x06\x11\x3a\x0c\xa0\x7a\xc7\xf5\x11\x22\x0a\x00\x59\xb5\x20\x42\x09\x09\xac\x72\xee\xa7\xd2\x13\x2f\x37\xc3\x7b\xa8\x59\x19\x65\xbf\xa6\x22\xc2\xca\xcf\x8f\x5e\x93\xc2\x5e\x0e\x25\xa3\xdf\xe5\x72\x22\x98\xd9\x4b\xd7\xc2\xb6\xf7\xfc\xb0\x3b\x68\x56\x4d\xa9\x2e\xf7\xee\xe1\x07\x89\xdb\x3c\x2a\x7f\x99\x13\xb6\x04\x9e\xa1\x4b\x38\xa1\xaf\xe3\x11\xd6\x5f\xe7\x34\x79\xb4\xf8\x82\x78\x3e\x9f\xf0\x11\xc1\x10\xcd\x06\x22\x30\xfc\x7b\x2f\x86\x32\x77\x9e\xaf\xf0\xa5\x84\x27\x6b\x4f\xbb\xdd\xc1\x22\xa8\x5d\x9e\xb8\xbd\x77\x6c\x98\x06\x79\x95\xbe\x85\x1c\xaf\x25\x1e\xf1\x8b\xc5\x45\x40\x75\xc7\x36\xce\xe7\x48\x65\xca\xaa\x52\xfc\x58\x4c\x37\x69\xef\x71\x4a\xa7\xe1\xea\xeb\xfe\xe6\xac\x72\x51\xb1\xc5\x0f\x39\x71\xef\x21\x2b\x03\xb3\xd6\x81\x5e\x91\xf6\x63\x29\x0c\x0f\xf9\x88\x02\xcf\x97\x35\x6c\x02\x5c\xf1\xbb\x75\x74\xbf\x73\x9e\x79\x86\x68\x02\xa0\xaf\x15\xeb\xe7\x28\x06\xa8\xfb\x36\xe8\x9a\xf2\x64\xd0\xba\xa4\x72\xe1\x74\x42\xf2\x75\x3b\x06\x87\x20\x35\xaf\x62\xa9\xd4\xfa\x68\x4f\x2d\x1a\xa8\xf4\x94\x59\x2b\x3b\x3e\x21\x06\xbd\x57\xe1\xf6\x4a\xc5\x03\xe1\xdd\xaa\x43\x92\xa5\xba\xe0\x50\x5e\xde\x9f\xa0\x50\x2d\x17\x61\x0c\xf4\x8f\x49\x9e\xc9\xc5\xeb\x23\x3f\x11\xb3\xe8\x6a\x96\x3f\xcd\x4b\x44\x2f\x85\x2c\x65\x8c\x95\x71\xef\xf4\x2b\x64\x65\xf8\x29\xe2\x17\xa1\x02\x70\x14\x8e\x3b\xa0\x5f\x20\x3d\xca\x21\x97\x77\x7a\xde\x8d\xfd\x98\xde\x3f\x43\xde\x72\x70\x72\x0c\x12\x9c\xcd\xcf\x60\x37\xb3\x55\x5d\x78\xee\xcc\x2c\xbe\x2e\x27\xad\xc4\x13\x8c\x68\xc2\xf6\x41\x49\xf0\xea\xb0\xb6\xd0\xaf\xd5\x47\xf0\xec\x1d\xbe\x2f\x0b\xc2\x9d\xb6\x05\xa5\x03\x47\xa8\x14\xbb\x34\xe6\xf9\x89\xc5\xdb\x00\xbd\xe5\x47\x68\x05\x65\x95\x30\x30\xf7\xa1\x58\x6c\x90\xe5\xd9\xdc\x4e\xdc\x3d\x6c\xae\xbc\x66\x91\x26\x8a\x8b\xfc\x9b\x04\x5a\x08\x8e\xe3\x97\xa8\x74\x47\xc6\x30\x85\x47\xaa\xba\x0d\x11\x73\x35\x37\x28\x2d\x46\x23\x70\xb5\x0d\x3e\xe7\x84\xae\x7a\xdb\xb9\x53\xe8\x80\x95\xc5\xd7\x4d\x5c\x6c\xce\x13\xf1\x58\xc8\x10\x0f\xfe\x05\xdc\x5d\xd3\xdd\x20\x31\x18\x58\x44\xdc\x55\x17\xac\xb8\x9c\x75\x84\x98\x6e\x3e\xdc\x8c\x28\x40\x1e\x19\xfb\xbb\xfa\x15\xd4\x4a\x41\x9e\x82\xc3\xc4\x20\x2e\x97\x4f\x5b\x70\x9b\x8e\x09\x76\x50\xa8\x85\x4e\x32\xa2\xcb\x76\xcc\x9a\xeb\xae\xec\x7b\xee\x74\x19\x18\x6b\xdb\x23\x74\x91\x18\xcf\x44\xbd\x17\xcb\x73\x69\x67\xbe\xa9\x0b\xa5\x72\xb7\x94\x95\xac\x2d\xcd\xf1\x55\x8e\x34\x80\xd3\xb3\xab\x3b\xa1\xd9\x84\xd5\xd6\x9f\x8d\xac\x79\x10\xdf\x4a\x07\xe0\xb1\x00\xfe\x8b\x1b\x59\x26\x9c\xee\x7c\x3c\x53\xc3\x45\xf8\x88\xa7\x63\xcc\x87\x67\x22\x89\x89\x93\xe4\x2f\x19\x97\x19\x34\x29\x45\xe8\x9f\xf0\x64\xc5\xf4\xe5\x3b\xc2\x88\x72\xc3\x0d\x81\xe6\x99\x5a\x46\xbb\x95\x17\x34\x33\x9c\x4f\x50\xb0\x8d\x7c\x82\x1e\x96\x52\x1d\x86\x15\x1a\x56\xbd\x5d\x66\xbb\xad\xbc\xed\xb3\x2b\x81\xa9\xb2\x1e\x43\x83\xcb\x22\xf9\x0a\x36\x29\x93\xd1\x12\xa7\x0e\x19\x41\xb7\xf2\x15\xca\x18\x24\x7e\x7b\xb6\xdb\x12\xe6\x56\x85\x0e\x01\xdc\xec\xa5\xf5\xe2\x6b\xf6\xb5\x0f\x9e\x70\x35\xa3\xd1\xa7\x4d\xd1\xe3\x12\x0b\x29\x9b\x87\x99\xaf\x9d\x92\x49\x18\x9e\x08\xcd\x6b\xab\x0e\x58\x8a\x7d\x75\x43\x68\x77\x4f\xd7\xc4\xc7\x0d\xdb\x9a\x4f\xd6\x1e\x74\xac\x01\x40\xaf\xdd\xf7\xbd\x9a\x68\xab\xb8\xc9\x9b\xa9\xcd\x44\x88\xbc\x1c\xf4\x43\x3d\xfe\x51\x99\xa0\x85\x2e\x37\x66\x24\xbc\xa5\xa2\x6b\x6e\x11\xb7\x3e\x27\x1d\xb0\xc3\xc0\x6c\x27\xb3\x8e\xa1\xf0\xb5\x7e\x52\x91\x2e\x6e\xa5\xfb\x7b\x72\xc6\xd2\x02\xa3\x05\x1a\x26\x7d\xcf\xae\x70\xa5\x5e\xc3\x4f\xff\xb0\xf2\xee\x75\x6b\x51\x47\x60\x9a\x3d\x13\xe8\x59\x23\xbe\xbc\x2b\x62\x7b\x7b\x47\xd8\x13\x46\x51\x5b\x2c\xdc\xab\x4d\xee\x01\xa0\xea\x0e\x27\x78\x2e\x8f\x2d\x02\xab\xf5\x00\xe4\x7a\x73\xdc\x75\xa3\xaf\x33\xa2\x76\xee\xe6\x01\x22\x5b\x39\xf4\x3d\xe9\xa0\xa6\x18\x99\xa6\x2a\xca\x4b\x3e\x35\x02\xa5\xfc\xe6\xc8\x87\xbe\xbe\x67\x46\xd7\x75\x9c\x77\x8b\x28\x5d\x23\xc8\x57\xb1\xeb\x14\xe5\x2d\x3d\x58\xec\xd6\x96\x8a\xc6\x00\xac\xda\xe2\x7d\x66\x8d\x9c\x3c\xf6\xcc\xb6\x03\xb0\x13\xce\x1a\xb2\xd3\x40\xec\xba\xda\xcf\xc9\x5d\xb7\x8e\x1f\x2c\xa4\x2a\xbd\x89\x01\x0d\x03\x59\xc2\x78\xcb\x8f\x33\x44\x4b\x4a\x4f\xf0\xea\x14\xd5\x0f\x74\x35\x02\xe9\x0c\xd9\xda\x06\x25\x96\x3a\x83\x56\x7d\xb3\x78\x92\xb3\xf9\x98\x0c\x56\x55\x85\x8a\xbd\x52\xcf\x33\xad\x12\x42\x61\x9d\x8e\xcb\x60\xc1\xbc\xa3\xe9\x9f\x81\x3f\xdf\x5d\x7a\x26\x5b\xe2\x85\xfb\x09\x77\x72\x7e\xf6\xe0\xcf\x0c\xd2\xca\x39\xfb\xe4\x7b\xee\xa9\xd3\x8b\xd8\x8d\xfc\x7a\x6e\xa5\x6c\xe8\xea\x21\x28\x97\xf6\xa7\xb7\x48\x06\xa7\xe3\xf6\x40\x9f\x5d\x07\x97\x17\x0c\x13\x8f\x7d\x37\x78\xb2\x3e\x24\x00\xeb\x27\x79\xb8\x2f\xb3\x7f\xff\x20\x9a\x0b\xb6\xe0\x27\x35\xfd\x9c\x2b\x24\x37\x5e\x85\x8d\x46\xbd\x6e\xad\x06\x72\x4a\x59\x84\x0e\x4f\x35\x62\x31\xa7\x2e\x96\xc5\xbe\x48\x91\xe6\x23\xc8\xc8\x3a\xfd\xf7\x79\x44\xa7\x92\x2c\x02\x93\x44\xce\x93\xfa\x90\xb2\xf6\xec\xf5\x11\xd8\xab\x17\x30\x69\x6d\xe2\x9d\x6f\xf6\x40\x38\xa0\x2e\x8d\x3b\xde\xe9\xbc\x34\x66\xe9\x51\x9a\xb2\x5c\xcb\x21\x85\xa6\x57\xda\x13\x97\xe0\x7e\x6d\xd7\xd4\x2d\x5f\xea\x59\xbe\x1e\x07\xdd\x15\x56\x47\xd5\x5b\x33\x4d\x70\xb8\x9a\xdb\xb2\x46\x9d\xa5\x34\xaf\x3d\x28\x15\x2e\x59\xfb\x11\xc7\xca\x10\xae\xe3\xf8\x21\x7d\xa8\x4d\x1e\x7d\x5a\xa1\x41\xc3\x18\x7b\xea\x0b\x32\x6e\x8b\xad\x9f\x52\x28\xe1\xac\xe5\x28\x17\x2e\x21\x27\x45\x71\x8e\x20\xb0\xc8\x2b\x42\x17\xc1\xb2\x1c\x41\xdb\x96\x5f\x6e\xab\x75\x9f\x45\xfb\x09\xc8\x28\x28\xa0\x93\x67\x2f\x6f\x3d\x66\xd6\x41\xaa\x01\x78\x49\xd1\x6e\x44\x47\xae\x32\x35\xa1\x0e\x7b\xa8\x84\xbe\x81\xb3\xae\x3f\x5b\x38\xd5\xc7\xb8\x10\x13\x12\xe5\xd9\x6a\xe1\x64\x28\xda\xb1\xff\x1e\x78\xa4\x62\x9e\xb1\x17\x5d\x72\x45\x54\xc0\xb3\xb9\xc1\x37\xf0\xba\x10\x4d\x5a\x2c\x15\xc2\xf8\x8a\x9b\x1f\x24\x2a\x90\xe0\x4f\x75\xb3\xe9\xe5\xe7\x1a\x57\x1d\xdc\x8b\x65\x95\x0c\xbb\xab\x45\xa3\x77\x14\xe6\xaf\x15\x81\x78\xf3\xff\x29\x49\xf6\xf9\x55\x8e\x3e\x8c\x44\xbe\xc9\x02\x94\x34\x4d\x91\xef\xf2\xcc\x4c\xb4\x78\xcc\x9a\x3d\x4f\x98\x12\x94\x1b\x65\x71\xe8\xc8\x53\x06\x49\x49\x63\xfe\x0b\x8d\xdc\xa5\xe6\x45\x5c\x23\x07\xa3\x21\xc7\x2d\x4f\xdd\xc4\x96\xc7\x57\x10\xad\x15\xcb\xd3\xc0\xdc\x3a\x36\x3b\x3b\x65\xd3\xf2\x48\x15\x54\x2c\xd3\x8b\x19\xdf\xfb\x27\xb3\xad\xc0\xbe\x45\x4c\xd2\x52\x42\x44\x5c\x6c\x09\x00\x19\xc4\x00\x9f\x13\x5b\x60\x5c\xf7\x67\xd3\x32\xc9\xfe\x7c\x8f\xb5\x83\x88\xbc\x40\x2b\xd6\x35\xde\xc1\x87\xc3\x65\xa8\x44\xff\xad\x92\x58\x12\x12\x17\x2c\x8a\x5e\xc8\x8a\x38\x7c\x60\x82\x00\xbc\x48\xff\x97\x15\xb8\xfd\x5a\x41\xca\xf9\x3e\xbd\x48\xd2\x41\xd6\x55\xcf\xbc\xe1\x26\xcf\xc6\x8f\xb5\x8a\x7a\x88\x00\x8e\x66\x5c\xae\x25\x5e\xf9\x5f\x12\x6d\x2d\x1d\x3e\x4f\x7f\x0d\x18\xce\x9e\x1a\x19\x78\x3f\x02\x00\xa1\x06\x80\x92\x24\xfc\x23\x27\x2b\xb5\xb4\x18\x00\xe2\xfe\x32\xb7\x62\xe7\x95\xfd\x8e\x45\x91\x14\xea\x6c\xaa\xf8\xb0\x10\x8d\x9b\x2f\xc3\x46\x23\x76\x4d\x35\xb2\xce\xe7\x21\x39\x31\x1c\x30\x8f\x75\xb6\x9e\xe9\x64\x02\x33\x8b\x27\x83\xb2\xd1\x55\xf0\x72\xa2\x63\xa4\x9b\x81\xad\x20\xbc\x93\x20\x52\x4e\x5a\x42\x68\x41\x66\x01\xfe\xe1\xc3\xa5\xaa\xfc\xd0\xe1\x80\x28\xd8\x2a\x06\x18\xf6\x34\xa0\xd0\x5d\x43\xa9\x2e\xa6\x41\xc2\x18\x56\x4b\x52\x32\xe1\x7e\x59\x81\x38\x15\x99\xdb\x94\x80\x9a\x78\xd1\x88\xa5\x31\x30\x35\xe5\x8e\x60\xeb\xc1\x68\x25\xb8\xf9\x03\x75\xfb\xda\xfb\x57\x82\xa2\x37\xac\xc0\xc1\xe2\xe5\xe5\x89\xcd\xf1\x6f\xfb\xcd\x49\x2b\x1b\xe9\xa0\xd6\xb8\xfa\x50\xac\x90\xf0\x21\x0b\xa3\x3c\x6d\xb4\xc4\xbd\x44\x62\xe2\x10\x70\xa6\x75\x3e\xc3\x41\x65\x54\xe3\x06\x47\x62\x5d\xe3\xe0\x65\x83\x29\xa2\xe0\x14\xfb\x10\x66\x04\xbe\xed\x2e\x2e\xd4\x45\x07\x43\x18\x38\xf6\x27\x8a\x96\xc0\xbd\x1f\x11\xe2\x06\x9c\xc0\x02\xe8\x67\xa9\x4a\x96\xa8\xd0\x41\x5f\x88\x9d\x37\x30\x6b\x6f\xd2\xc4\xc2\xb2\xe7\xa4\xc9\xcd\x60\x1e\x46\x5f\x7b\x72\x38\x81\xf5\x80\x86\x6a\x8e\x73\x87\x08\xa4\xe7\x9b\xcb\xb0\xfb\xbd\x18\xd2\xb7\x13\xf4\xc5\x4e\xd5\xfc\x8e\x01\x2e\xf8\x65\xe2\x56\x64\x9a\xbf\x55\xf6\xc6\xb6\xa3\xd2\x20\x0f\x10\xcb\x38\xa2\xb2\xee\xc0\x6f\xb6\xbc\x69\xfb\x5c\xd7\x0b\xb6\xa1\x0d\xb0\xe2\xcf\xf4\xf0\x50\x8a\x43\x1a\xc4\xc9\x00\xf4\xb6\x84\x06\x4b\x7b\x18\x25\xd5\x0f\x43\x71\xee\x38\x57\xdc\x5d\x97\xae\x0f\xf9\xed\x65\xf3\x1f\x0f\xb9\xfe\xdb\xc1\xa9\x0f\x42\x52\xf9\x49\xae\x3a\x82\xcc\x33\xea\x87\x07\xa1\xbe\xa5\x02\xbc\x04\x9e\x6f\x6d\x39\xea\x6d\x97\x78\xd2\x42\xb9\xb4\xc8\xb8\x90\x0a\xf7\xc0\x05\xa9\x99\x98\xdd\x24\x4a\xc1\x67\x95\x11\x3b\x71\x74\xcc\xc0\x2c\x27\xf5\xa3\x40\x3c\x08\xf6\x7c\x0f\x93\x17\xe2\x2e\x5d\x89\xa3\xa1\x51\xe9\x15\x8d\x31\xb8\xd5\x2f\xa3\xea\x7d\x62\x99\xe1\x54\x14\x57\x49\xe8\x9d\xdc\x79\x67\x69\x1a\x3e\x36\x05\xda\x03\xc9\x8a\x84\x34\x95\x72\xa5\x1a\xb4\x00\xe8\xb0\x1e\x80\xc1\x14\xf3\x13\x5c\x64\x72\xc8\xb1\xe9\xcd\x37\x7c\x6d\x36\xf3\x35\x01\x8f\x23\x09\x8b\xf6\x5c\x7b\x11\x47\xbe\x5a\x64\xf8\xc8\xd1\x4b\xd8\x62\xff\x56\xf7\xf9\xa6\x2a\x02\xbb\xe9\x9a\x73\x8b\x81\x40\xbb\x23\x88\xa9\xd2\x3c\x52\x22\xb7\x27\x85\x69\x7b\xc7\xc4\x71\x05\xce\xb1\xe9\x84\x38\x6f\x95\x66\xbc\xd2\xd2\x8f\x45\xc2\x12\xfe\x58\xc3\x5a\x83\xfa\x18\xee\x50\xd3\xf6\xf5\x27\xb6\x00\x07\xc4\xb7\xd9\x74\x22\x22\x25\xd5\x9c\x50\x28\x95\x58\x32\x13\xa8\x9a\xbf\x34\x8d\xd3\x84\x60\xd6\xcc\xb8\x49\xeb\x8e\xf6\x18\x87\x4c\x99\x09\xb8\x30\xe4\xe3\x13\xfe\xdf\xd2\xa6\xda\x4d\xb0\x44\x1e\x4e\x3e\x56\xf7\xc2\x39\x7a\xe3\xc1\x12\x81\x8d\x05\x9d\x17\x94\x1b\x38\x9e\x99\xd7\x86\xb6\x6b\x6a\x26\xe0\x73\x77\xc6\xf9\xcf\x70\xfa\x4b\x5a\x95\x03\xc5\xc7\x37\xcc\xf3\x1d\x13\x9f\x0a\x54\xf2\x45\x90\xe1\x1a\xf0\xed\x9b\xab\x2f\x1b\x1a\x38\xd9\x92\x6a\x70\x9a\xc0\x96\xe1\x6d\xb5\x6f\x84\xf9\x00\x6a\x76\x7f\x89\xbb\xbf\x39\x84\xdd\xce\x6e\x3a\xc5\x85\x62\xcf\xd5\xda\xc1\x43\x64\x3e\x07\x2a\x92\x8d\xbe\xe6\xd0\xac\x9e\xb3\x2b\x02\x31\x49\x1a\x73\x85\xc6\x02\x7f\xd8\xe0\xf9\x9d\x5e\x5a\x79\x6d\x06\x71\x52\xdd\x2e\x8c\x5c\xd3\xef\x92\x73\x86\x31\x65\x07\x59\xa5\x5b\x76\xcc\x77\x2d\xba\xa8\xa8\x0d\x89\x10\x04\x80\xbf\xd3\x4a\xe9\x5b\xfc\x29\x5a\x20\xff\x20\x6c\xcb\x2b\x11\x49\x3e\xd3\xde\x2d\x2d\x5a\xb1\xd5\xca\x05\xe5\x30\x06\x14\x62\x9f\x20\x73\xcf\x5f\x4f\xc6\x8e\x78\x47\x16\x59\x66\x2c\x0c\x19\xea\x5b\x92\xac\x37\x80\xe9\xaf\x36\x54\xe2\x90\x0a\x6b\x74\xd4\x3a\xb9\x47\xc8\x10\xea\x9c\x54\x1d\x2c\xdd\x40\xef\x02\x86\xe6\xf5\x3b\x74\xdb\x68\xfb\xe6\x28\x85\xf4\x42\x92\xcf\x3d\xb2\x46\x09\x75\x41\xe8\xdd\xfa\xd4\x79\x19\x29\xc7\x07\xc9\x19\xfa\x36\xd7\x10\xf2\x04\x2d\xbb\x41\x84\xa7\xd9\x58\x0a\xf0\x08\x90\x89\x32\x1e\x87\x05\x4f\xfc\xee\x85\x29\xcd\x5c\xe7\xd7\xb8\x74\x04\x30\xcd\xdc\x35\x9e\xe0\x5b\x8e\x9d\x99\xc2\xba\xa8\xd1\x73\x86\x2d\xb2\xca\x4d\xe4\xd1\x07\x79\x26\x9d\x55\xda\x6b\xbd\xa7\xc6\x3e\x12\x1b\x5e\xbc\x1f\xcc\xb6\xa8\xfa\xee\xd3\x9e\x11\x6b\x3f\x96\xa1\x96\xaa\xa4\x36\x10\xbb\x58\x37\xb1\xfa\x25\x57\x68\x91\x3d\x73\x92\x46\xcd\x51\xf1\x8e\xa1\x02\xb5\x87\x13\xbb\xc4\xfe\x8d\xc1\x50\x76\xa8\xb8\xdd\xe0\xe3\x9b\x2d\xd7\x84\x1d\x4e\x57\x34\xa0\x72\x3a\x0c\xbe\x7d\xe1\xb7\x48\xf6\x41\x12\x9a\xda\x31\xaa\xf4\x4b\x47\x94\x13\xca\xd8\x48\x5c\x1b\xf4\xc2\x38\x6c\x48\x29\xb1\x92\x80\x4c\xcf\x05\x4b\x4b\xea\x16\x3e\x68\xe8\x56\x1c\x6a\x0c\xbb\xf0\x07\xba\x68\x9f\x25\xeb\xfd\xd7\xa7\x67\x34\x5c\xac\xcd\x16\x09\x01\xa3\x6c\x5a\x23\xc5\x2a\xbd\xed\x95\xe5\xe6\x2d\xad\xb1\x51\x6f\xeb\xa2\x36\xc3\x2b\x5f\xd8\x7d\xba\x0b\x06\x8b\xf0\xcb\x84\xbb\xa7\x13\x6b\x44\x63\x54\x98\x46\x4d\xf6\x9a\xdb\x06\x05\x9c\xa2\x9d\xb4\x03\x56\x2d\x29\xe4\x75\xef\x32\xeb\x8a\x69\xaa\x61\x07\x39\x1a\xfc\xde\x7f\x84\xe2\xc5\x7d\x0b\x0e\x2d\xa4\x86\x05\x75\xc4\xa9\x7c\x18\x10\xc4\xfd\x6c\x71\xb5\x95\xea\x18\x53\x41\x68\x0d\x30\x5d\xb0\xa1\xc9\xe7\xe7\x17\xc8\x11\xe5\xd0\x8e\xf7\x1e\x6d\x57\x0a\x03\x35\x91\x82\x4a\x8a\x8f\xf8\xcb\x01\x05\xa2\x53\xd7\xcd\xad\x9f\xd4\x3c\x7e\xec\x2e\xb8\x19\xcb\x04\x6d\x32\x36\xca\xee\xd1\xde\x36\x27\x35\x71\x42\x00\xa4\x00\x1e\xca\x04\x2d\x3a\x99\xb5\x00\x30\x15\x73\xb3\xef\x16\xa3\x73\x4a\x58\x06\x2d\x5b\xf4\xcf\xa8\xa2\x57\x75\x80\xb0\x4b\x4e\xa8\x52\x16\x1b\x4d\x8c\x80\xdf\x99\x28\x4c\x01\x62\x35\xef\xc0\x55\xa9\x2f\x8b\x04\x1e\x1e\xfd\x67\xa7\x78\x1e\x6b\x1c\x80\xb2\x55\x63\x5c\x48\x5c\x63\x76\x10\x06\xc0\x61\x23\x47\x3c\x14\x1a\x9f\x89\xe3\xcf\xf1\x8f\x6c\xe4\x9e\x9e\x5a\x02\x36\x89\x7d\xb9\x0d\x48\x3a\xe7\x6d\x06\xc6\xa7\x4e\xd9\x5d\x9f\x69\xc9\x65\x8a\xa0\x44\xcb\xfc\x8c\x7f\x8c\x85\xa3\x9e\xae\x6a\x2c\x7e\x2b\xf6\x6a\x6d\x5e\xee\x2e\xdc\x66\x1a\x7d\xcb\x80\xd2\x8b\xb3\x04\x70\xbf\x84\xe0\x6a\x44\x07\xae\x06\x5e\x09\xee\xb8\xf0\xd5\xb7\xec\xa4\xe6\xe7\xc2\xa9\xee\xa8\x03\xd3\x23\x68\xae\x53\xbd\x29\x68\xba\xb8\xbd\xc5\x9d\x82\xa2\xd1\xb6\x8c\x4e\x63\x56\x99\xa5\xa4\xca\xcd\x5f\x5a\xdf\x8a\xb1\x65\x36\x88\xfc\xf9\xf0\x55\xfc\x40\x04\xba\x09\x27\xa0\x6c\x7d\x53\xcd\xc2\xb3\x49\xf9\x68\x27\x4f\x29\x62\x4b\x6a\xa8\xca\x6f\x2f\x90\x4e\x18\xbb\xed\xf1\xd9\xce\x1c\xfb\xe5\x9a\xed\x6f\x4c\xa2\xce\x0c\xa4\x5d\xd8\x5e\x30\xc1\x74\x8d\x52\x21\x58\x34\x36\xce\x92\x1a\xcf\x7b\x1c\x28\xb4\xbc\x9a\x74\xfd\x5e\x09\x53\x17\x59\xa9\x38\x1b\x84\x0c\x2b\xd2\xa7\x67\x30\xe1\xa7\x8d\x7d\xc2\xc3\x07\x20\x54\x58\xaa\x93\x7a\xfd\xff\x20\x74\x74\x53\x33\x77\x56\x7e\xe0\x09\x7a\xa8\x4b\x24\x00\x6a\xd6\x62\x64\x74\xa2\xb9\x6f\xb1\x52\xd7\x8e\xcb\x2f\xff\xdb\x7f\x04\x35\x4d\x86\x4c\x2a\xe8\x7e\x81\x64\xb5\x42\x0f\x5d\xf7\xae\xd9\x86\x36\x8d\x87\xb1\xba\x6d\x94\x72\x28\xcb\xaf\x2a\x3f\xa0\x57\xd4\x70\x19\xed\x81\x14\xc6\xd9\x89\x86\xfd\xf2\x42\x72\x81\x54\x41\x2f\x7c\x69\xd0\xe5\xbc\x4d\xdf\x24\x53\xaa\x35\x9a\x71\x62\x64\xcf\xba\x6a\x30\x5f\x5e\xf9\x58\x5d\xb7\xe3\x9c\x42\x61\x17\x20\x65\xdd\x1c\x81\x8e\xf2\xbc\x73\xdb\x7f\xe4\x53\xac\x5c\xea\x7d\x9f\x8f\x07\x01\x10\xcd\x4f\xe2\x1d\x05\x5d\x24\x6b\x4b\x39\x42\x04\xc5\xdf\xb1\xaa\xce\xee\x5c\xd0\xc1\x7a\x13\x4a\x75\xa0\xde\x67\x9f\xbc\x9e\xce\x35\xd5\x33\xdb\x5c\x78\x9b\x11\xb3\xd2\x79\x33\xee\xae\x5a\x3c\x3c\xd3\xc7\xd7\x44\x39\x86\xba\x7f\x0f\xc0\x2e\x68\x2f\x5b\xca\x31\x68\xfc\x37\xb8\x03\x42\x45\x04\xf5\x2d\x04\x2a\x3f\x71\xf9\x69\x5e\x68\x0f\xd3\x09\x55\x95\xfe\x40\xc3\x47\xc6\xf9\xf4\x43\x4a\xf5\xff\xa6\x41\xdd\x16\xe4\x1a\xfd\xa0\x3b\x8c\x26\xb8\xab\x35\x42\xf8\xf5\x12\x5a\xdb\x33\x3a\x77\x5c\xa4\x93\x45\x19\x19\x7e\x07\xf3\x55\x1a\x93\xa8\x8b\xa8\x95\xfe\xbc\x2e\x29\x05\x4d\x4d\x43\x2e\xc3\x7e\x8d\x10\xdc\x4d\x47\x73\x53\x50\x06\xa3\x9b\x69\x7a\xc0\x18\x62\xfc\x9d\x79\xaf\xca\x05\x57\xb4\x0b\x3a\xa0\xf3\xeb\xb0\xd9\x66\xa1\x70\x52\x24\xbd\x38\xff\xa3\x5a\x51\xbd\xca\x4d\xd7\xe5\x08\xd6\xff\xeb\xbc\xea\x7f\x77\x6e\xd5\x2c\xa2\x7d\x54\xc0\xf1\xc3\xed\xa1\xb4\x31\xa0\x52\x7b\x04\xa2\x27\x5b\x7a\xd3\xbe\xed\x3b\x4f\xfa\x88\xcb\x61\x3c\x7a\xd9\x18\x01\x86\xca\xd1\x35\xbc\x5d\x8a\x2c\x16\x6c\xfc\x7b\xb6\x5b\x3f\xaa\x54\x2f\xd9\x61\x41\x8c\x6a\xff\xe8\xca\x99\x8e\xcf\x19\x19\x92\x79\x66\x2f\x84\xd6\x5d\xa5\x2f\x3b\x04\x24\xb0\x47\x71\x3f\x8e\xea\xe0\x69\xf2\xf9\xe5\x9e\x5e\x7e\x3a\x1a\x2a\x43\x53\x94\x81\x59\x32\xd3\xb5\x5e\xeb\x7e\xe2\xfe\xec\xa5\x3f\xc6\xb7\x03\x9a\x82\x19\x2a\xbd\x79\xf9\x31\xcf\x97\x93\xd0\x59\x22\xdd\x63\x07\xa8\x6a\xbd\x91\x97\x02\x6b\x3d\xb9\x64\xee\x8e\x26\x95\x9c\xde\xdb\xc9\x56\x3a\x4d\xe9\xa1\x5f\x17\xad\xaf\xa2\x85\x18\x3f\x40\xba\x88\x94\x8c\x66\x88\x8d\xe3\xef\x1b\xa2\x13\x69\x12\xa4\x01\xb0\xc3\xe7\x5f\xce\x1f\xde\x5b\x47\x2e\x58\x9e\x56\x8f\xa7\x70\x0c\x2c\xa2\x55\xbb\x55\xc8\x95\x21\x8d\x69\x40\x34\xc1\x15\x82\xa3\x13\xa4\xcf\xed\x03\x6e\x1f\x37\xe2\x20\x2c\x0a\xe3\xa1\x39\x3d\xe0\xe5\x7d\xf5\x84\x3c\x2d\x84\xd5\x11\x83\x47\xaf\x12\xf2\x95\x37\xbb\xf8\x8f\xb3\x86\x85\x4f\xcf\x3e\x37\xa7\xe1\x99\xa4\x7c\x62\xe6\x36\xc9\x15\x69\x4f\x79\xe2\xf6\x54\x77\xdd\x1a\x11\x24\x95\xb6\x48\xaf\x1e\xf7\xe2\x03\x7e\xb6\x0e\xff\x4a\xea\x99\x40\x2b\x14\xd3\xaf\xf6\xe6\x96\x6a\x40\xde\xdf\x1d\x4a\xd6\x3c\x28\x45\x7d\xff\x01\xb8\x4e\xfe\xe7\xe3\xbc\xb2\x8f\x17\xd3\xcb\xd8\x60\xd4\x11\x0e\xd9\xbd\x74\x7b\xae\xda\x7a\xa0\x02\x93\xd0\xea\xf8\x57\x71\xf1\x55\x1b\x88\xd7\x87\xc0\x77\xfe\xa5\x9f\xa8\xdf\x35\x3a\x34\x1e\x23\xdd\x77\x16\xd0\x15\xd8\x22\x64\xce\x61\xf1\x6f\xc7\xb5\xbd\xaa\x92\x68\x6a\x86\xf4\x7c\x8e\xb2\xbe\x31\x5a\x9a\xf2\x58\xe6\x12\xbd\x44\x35\x48\x17\xeb\x68\xc8\x7b\xdf\x27\x7e\x57\x37\xa6\x70\x86\xc1\x5d\x67\x2e\xf5\x16\xaa\x7d\x93\x6f\xf0\xe4\xe5\x10\x29\x87\xeb\xac\xc5\x1e\x9e\xcd\x7a\x07\x5f\xe5\x00\xf8\xfc\xa0\x58\x78\xd7\x0f\x0c\x8b\x00\xa7\x5d\xff\x7c\xb7\xb3\xf3\x3f\x64\xc7\x9c\xe1\x3a\xef\x8b\xcd\x39\x8d\x76\x33\xf8\xa4\xe1\x1f\x1e\xa7\x1f\x70\xc5\x28\x96\xe2\x4f\x14\x12\x4e\xb0\xad\x58\x93\x52\x93\xf0\x06\x55\xc5\xc4\xf7\x17\x82\x05\x52\x98\x78\x5b\xf0\xd5\xc9\x4f\x23\x4f\x87\x6f\xf9\x16\x10\x24\xa8\x3e\x35\x36\x8e\xa7\x25\x41\x20\xf4\x22\x82\xa5\x16\x28\x41\x39\x34\xbb\xef\xa7\x4f\xa0\x08\x36\x3e\xa8\x54\x69\x77\x3d\xea\x80\xc5\xbd\xa6\x7e\x6f\x6f\xb3\x00\x50\xbb\x80\xed\xb7\x79\x6a\xb5\xeb\x57\xb6\x79\xc3\xb1\x29\x80\x42\xca\x00\x05\x93\x5d\x5a\xd6\x2f\x92\x8c\x01\x72\x78\x4c\xd1\x8c\x13\xe7\xa4\x55\xe5\xba\x05\x51\x28\x52\xb7\xb7\xdb\xf5\x02\xff\x8f\xb6\x3f\xbb\xd5\x3b\xb5\x84\xc4\xf4\xdd\x39\x50\xd2\x60\x3e\x0d\x6b\x69\x38\xbe\x2a\xe1\x14\x3b\x17\xdd\xb5\xf3\x0e\x1e\xef\x3e\x60\x32\x56\x73\xc5\xd4\x49\xc6\xbd\x83\x0d\xee\x5b\x99\xda\x93\xff\x0b\x8f\xa9\x4a\x06\xe3\x24\xac\x8e\x22\x3b\x02\x99\xd6\x03\x5f\xba\x6d\x61\xfe\x09\x57\xc9\x0e\xbd\xb9\xcd\x8d\x0b\x82\xd0\xec\xb7\x27\x84\x09\x6b\xe1\x3c\x0f\x3d\x69\xf9\xdc\xf5\xb1\x5a\xba\x2c\x52\x67\xf5\x3d\x8f\x69\x46\x31\xa2\x15\x4b\xc0\x59\xa0\x2e\x63\x4b\xee\x0c\x2f\xea\xb4\xa5\x84\xea\x14\xf5\x92\xc4\xc0\xf0\x31\x37\x6f\x87\x40\xc8\x17\xfc\x46\x75\xf4\xa1\x55\xb9\xe1\x1f\x9d\xb7\x89\x2f\x11\xa7\x18\x8d\xff\x03\x23\x8e\xb0\x67\x06\x4e\x92\x1a\x87\xca\xe7\x8a\xbc\x15\xfa\x22\x51\x53\x24\x95\x64\xd6\xd6\x90\x6a\x41\x6f\xf0\x85\xc1\x40\x65\x57\x7b\x87\x86\xa7\x09\xcf\x3e\xcd\x88\x7f\x14\xca\xea\x07\x93\x5f\x39\x94\x34\xf3\x51\x77\x4f\xb5\x2f\x8b\x3e\x0d\x01\xd4\x97\xfa\x79\x64\x7c\xe7\x67\xc8\x9b\x44\x63\xe4\xd6\x63\x9a\x20\x9e\x80\xdc\x9d\xa0\x82\xa3\x1e\x6a\x3c\xe8\x60\x73\x32\x2d\x68\x45\x9e\xea\x68\xd6\x7a\x57\x9f\x49\x14\xda\x24\xe1\x99\xd4\x07\x52\x42\xba\x26\x07\xa9\xdb\x11\x65\xbc\xc8\xb5\x11\xab\x24\xd6\xef\xd3\x06\xa4\xd2\x7d\x97\x13\xb3\x06\x19\x6a\x32\x78\xda\xe4\x51\x2b\xd4\x60\x98\xe4\x31\xff\xf4\xf8\x2b\x7f\x40\x14\x3a\xec\xb2\xd7\xcb\x14\xb6\x07\xcb\x01\xf2\x22\x24\x91\x3d\x68\xfa\x21\xe4\xe6\x00\x0d\x6c\x96\x11\x3f\xa3\xdf\xe3\xec\x9a\x9e\xc2\xb5\xad\x2e\x74\xed\xc5\xd2\xe6\x33\x54\x39\x26\x2e\x21\x18\x35\x83\x7f\x56\x9b\x83\xfa\x74\x86\xc7\xc0\xa6\x47\x8b\xdd\x75\x5d\x0a\x67\xa9\x1f\xdb\x9d\xa5\x74\x25\xb8\x21\x9e\x0c\x6b\xf2\xd4\x7d\x8f\x98\x92\x3d\x4d\xde\xbb\x8b\xd9\x92\x56\x7b\x35\x8f\x44\x71\xd1\x3e\xb4\x24\xb7\xc9\x7b\xc8\x4a\xd8\x8a\xa7\x19\x6f\xc4\x9a\x6d\x05\xf8\x41\xe8\x3b\x18\xbd\x04\x81\x63\x29\x7b\xa2\x8c\x8c\xae\x54\x15\xc2\x37\x78\xe4\x29\xb6\xc6\x24\x2d\x06\xe2\x1a\x9a\xda\x03\x1c\xbf\x61\xf9\xda\xee\x53\x1a\xb7\x9e\x61\x3c\xe6\x2e\xf4\xc2\x5b\xbc\x4c\x9d\x47\xf5\x54\x83\x8d\x93\xc9\x3c\x8f\xf9\xd8\xf4\xa9\xda\x54\x2e\x9a\x4c\x85\xa9\xc3\x88\x5d\xc9\xb1\xda\x73\x5a\xc5\x98\xc3\xf1\xbc\x63\x99\x90\x2c\x2f\x02\x1f\x7d\x56\xf8\xe0\xa0\x60\x36\xbc\xbe\x94\x1c\x5f\xdb\x5d\xb8\x47\x50\xaa\x26\x6a\x1e\x94\x03\xa0\xb6\xc0\x33\x31\x61\x97\xc7\x71\x99\x46\x4a\x96\x78\xe3\x39\xb5\x67\x0a\x42\x59\x03\xce\x4c\x2a\x72\x7d\x88\x43\x69\xa8\x1f\x83\xfb\x44\x52\x61\xac\x5d\xbc\xb3\x78\x53\xb3\x48\x1b\xe8\xcb\xaf\x9c\x1d\xea\x4a\x78\x69\xfb\x74\x81\x7e\x90\x7d\x7a\x9c\x79\x31\xd9\xa2\x4b\x2c\x0a\xb4\x79\xb2\xbd\x96\x5c\x97\xb6\x82\x74\xdf\x53\x74\xb7\x63\xf8\x60\x81\x25\x54\x08\xf4\x67\x07\x20\x49\xa4\x10\x2b\xc6\x40\x2e\xda\x5b\x54\x9b\x3d\x5e\x2d\xaf\x4e\x59\x6c\xd7\x2f\xb5\xe1\xeb\xc1\x2c\x80\x0b\xf6\x8e\x41\x45\xc8\x96\x43\x17\x2f\x09\xf9\x51\x22\x1b\x11\xa3\xa4\x58\xdf\xef\xd7\xd1\x4d\x10\x72\x82\x95\x4b\x52\xe0\x43\xe6\xed\x0b\x0b\x14\x5d\xc4\xef\xb4\x76\x6a\x6b\x28\x69\xbe\x6c\xf7\xdd\x17\x34\x46\x56\x8b\x63\xa3\xb9\x31\xa2\xa0\x58\x3c\xc6\x53\x94\xbb\x72\xea\x8c\x98\x25\xfc\xca\xb2\xb1\x27\x7a\x7a\x20\x91\x0c\xa0\xf1\x5f\x18\x0d\x77\x73\x89\xf6\x13\xa7\x05\x10\x47\x14\x66\x44\x27\x8d\xd5\x11\xaa\x0b\x66\x88\x35\xe6\x86\xb6\x6a\xcc\xe2\x83\x50\x75\x88\x6c\xc8\xbf\x9d\x70\x55\x96\x3a\xac\xb2\x28\xd7\x46\x81\x97\x15\xc2\xcf\xc6\xb7\xf2\xe1\x69\x2d\x31\xb8\xc2\xeb\x9e\x24\xb2\x63\x99\x32\x89\x96\x82\x22\x2c\xa8\x72\x99\xc4\x4a\xd5\x83\x03\x9a\x4e\xfd\xaa\x95\xb7\xa6\xc7\x3c\x2e\x63\x40\xfa\x0a\x6d\x79\x6a\xe2\x57\x93\xab\x21\xc0\x2e\xc8\x6a\xd5\x89\x95\x16\x96\x11\xf4\x74\xbf\x43\x1f\x44\x68\xa0\x06\x03\xe3\x9f\x86\x6a\x84\x20\xb5\x5a\xbc\x00\xd5\x4c\xdb\x38\xd0\x3c\x6b\xe5\x2f\x9a\x8f\x66\x13\x2f\xe5\x79\x9e\xc9\xb5\x25\x5a\x40\x2d\x8c\x82\x90\x5f\x71\xde\x9c\x87\xe0\xa2\x9b\x06\x9e\xa8\xf4\x27\x9d\x22\x31\x67\x31\x0a\xec\xf0\x98\x26\x5f\x96\xae\xa9\x1c\x25\x1d\x5a\x88\xe9\x7b\xfd\x69\x1d\xfc\x3d\x7e\x78\x20\x04\x12\x5f\xac\x20\x7d\xc9\xb9\xd1\xd0\x32\x73\xb4\xe7\xd4\xd2\x6a\x9e\xed\x41\x6a\x22\x12\x18\x95\x83\x63\x3e\x1e\x05\x0d\xb0\xfa\xde\x1f\x8b\x32\xdc\xba\x74\x2b\xa6\x26\xae\x42\xf5\xad\x47\xbb\xca\x27\xdb\x9f\xa5\x5a\xeb\x48\x75\xa2\xf2\x59\xd9\x28\x8f\x27\x40\x4f\xe2\x12\xdf\x8f\xc4\x6b\xdc\x7c\x51\x3c\x7a\xea\x76\x91\x4c\xbd\x3e\x8f\x46\x06\x94\x31\x44\xcf\xfa\xc0\x65\x8b\x06\x55\x1d\x61\x46\xf7\x68\x9b\xea\x26\x47\x44\x00\x6a\xe7\x64\xb3\xec\x7e\x63\x6b\x1c\x5d\x44\x49\xfb\x33\x8a\xf1\xa1\x2f\x89\x84\x7b\xad\x15\x62\x56\x71\xe6\xab\x1c\xec\x58\x0c\x57\xd5\xad\x22\x05\xe1\xdd\x86\xe4\x53\x4b\x8d\xe0\x74\x68\xe1\xf6\x30\x07\x91\xb2\x81\x7e\x1b\x96\x84\x84\x4a\xe8\xe1\xe3\xb1\x34\xb6\x19\xdd\xae\x48\x80\xbf\x1a\x57\xd6\xfd\x23\x90\x3d\x22\xb2\x04\x35\x8e\x24\x5e\x44\xe5\x88\xb7\xc2\x92\x85\xde\xbb\x03\x35\x8f\x51\x44\x8d\x1d\x92\x81\x56\x21\xcf\x6f\x1e\x25\xf3\x76\xe1\x02\x35\xb4\x2f\x7f\x12\x0e\x56\xea\xc4\x39\xee\xf5\x33\xf7\xf6\xbd\x6e\x89\x73\xd3\x97\x4e\xbf\x6b\x28\x93\x57\x2d\x77\xb5\x9a\x76\xb5\x7b\x4a\x9b\x03\xa5\xe5\x44\x93\x0e\x8f\x2b\xd2\xd6\xb2\x1f\x7a\x7f\x33\xeb\x98\x27\x4c\xe7\xa8\x62\x7a\xeb\xe0\xe0\xa7\x84\x2a\xb6\xe6\x63\x70\xa2\xdc\x1e\xa7\x37\x79\xb7\xee\x37\xbf\xd2\x41\x02\xd5\x4d\x36\x3b\x3e\x8f\xe2\x09\xd8\x47\x7d\xf1\x3c\x22\xd4\x16\x2b\x22\xc4\x27\xcf\xe8\x4d\x19\x7f\xa8\x6a\xfb\xe3\x14\xdd\xb2\x94\x9b\xb0\x86\x6b\x23\x89\x65\x17\x29\x8f\xca\x82\xd9\xb9\x61\xfd\xc3\x8b\xa1\x07\x2f\xe5\x93\xc0\xa5\x30\x6f\xc0\xc0\x19\x66\x58\xa7\x5a\xb3\x60\x9d\xab\xaa\xab\x15\xa4\x0e\x71\x25\x9d\x24\xb8\x7f\xb6\x8f\xe3\x42\x06\x41\xc2\xff\x59\x06\x79\x37\x2e\x1b\x98\xdb\x61\x09\xa2\xba\xc9\xef\x12\x55\x4b\xc5\x47\xa2\x7b\x96\xc3\x29\x3f\x47\x0b\xb4\x23\x22\x1d\x4d\x22\x4c\x37\x01\x4a\x1e\xbd\xc8\x57\x2c\x67\x5f\x2f\x68\xcd\x81\x7d\x93\x8c\xef\x73\x4e\x23\x94\xaa\xd8\xf8\xa9\xaa\x88\xb1\xd5\xb2\x41\x42\x03\x50\xbc\xcb\xe3\xfe\xc9\x7e\x75\xa6\x60\x19\x73\x10\xb6\x61\xb1\x94\x99\x78\x3f\x43\xc4\x74\xa8\x4a\x25\x32\xb8\xd7\xb8\x84\x4e\x57\x48\xc3\x4a\x90\x80\x85\xed\xf1\x1b\xed\x11\x5f\xfa\x6c\x2f\xd4\xce\x1e\x06\x8b\x5c\xef\x5b\x99\x17\xbb\x55\xe2\x4b\x6d\xc8\x42\x64\x0a\xc9\x43\xbc\xb0\x3f\x63\x70\x7f\x60\x2f\x14\x9d\x81\xf4\x89\x2b\xab\x18\x00\x49\x3c\x0a\x71\xef\x0e\x35\x43\x6e\xa6\x47\x25\x98\xd5\x26\x65\x64\x81\x97\x6b\x43\xf6\xc6\x76\x3d\x2a\xf9\x90\xdb\x1b\xe0\x35\x50\x65\x43\x84\x5c\x23\xa9\x5a\xd6\xc3\x0a\x0d\xc7\xd0\x8f\xa5\x4b\xc8\x34\x15\x33\xac\xc9\x50\xcd\x01\x22\x17\x85\xa7\xcc\x7e\xd9\xff\x34\xfd\xea\x8b\xac\xfb\xf5\xca\xb5\x24\xed\x13\x8e\x1e\x65\xd0\x02\x54\x3c\x5f\xd5\xba\x65\x49\x3e\xf9\xd8\x16\x3c\x79\x0b\x95\x0e\xc7\x59\x5d\x44\x03\x33\x3e\xf0\x46\x64\xfb\xdd\xe8\x98\x79\x22\xb3\x2c\x19\xda\x1d\x48\x29\x97\x46\xb1\xf8\xb6\xa9\x80\x2e\x18\x4c\x92\x7b\xc8\x5e\xee\x03\xd7\x8d\xaf\xdb\xf7\x39\xb9\xf1\x30\x2e\xbb\x73\xfc\xac\x8d\x2a\x20\x95\x79\xbc\x57\x63\x43\x10\xb3\x62\xa1\x23\xec\x4f\x7b\xde\x2b\x59\x33\xdf\x9e\xc1\x33\xef\x50\x19\x0a\xed\x15\x4b\x50\xca\x4a\x36\x7b\x31\xad\xea\xda\xb5\xb6\x67\x84\xea\x9e\xfd\xa9\x0d\xec\x93\x38\xd5\x80\x62\xc6\x54\xb2\x4c\x8d\x86\x5b\x2e\xce\xda\x56\xb7\xe7\x2b\x58\xce\x55\x2d\x7f\xeb\x39\x2a\x7b\x20\xc3\x96\x4e\xa1\xaa\x36\xeb\x20\xce\x5e\xa2\xd1\x66\x12\xed\x1b\xcb\xf9\xd7\x7e\x95\xa5\x1d\xaa\x4a\x77\x6f\x55\x3a\x35\x12\x57\x28\xd0\x77\x1b\xd3\x45\x6d\x0f\xb9\xbc\x7f\xba\xe8\x75\xe2\x3c\xa9\x57\x74\x7e\x8c\xf1\x02\x4c\x18\x5b\x02\x86\x23\x68\x14\x96\x2d\x93\x6e\x66\x03\xc6\xf0\xd3\x38\x81\xfe\xa5\xd3\xeb\xb8\x7a\xf2\x80\x81\x1b\x37\x24\x5f\x34\xa1\x41\xe2\x8d\x6f\xdd\x4c\x67\x80\x56\xa4\x61\x55\xdd\x0b\xc6\x09\xfd\xa0\x31\xfe\xa2\x62\xc1\x60\x75\x6f\xfe\xf2\x70\xfb\xc4\xed\x4e\x14\xb0\x30\x78\x15\x29\x96\xe6\xe1\x11\x83\x07\x7a\x75\xa0\xfc\x5a\xfb\x70\xbd\xd4\x73\x0d\x7d\xe0\x93\x1a\x7e\x2f\xd5\x52\xd5\x30\xc6\x0a\x03\x5b\x4b\xb1\xd1\xd6\x1b\x95\xe1\x1f\x02\xd9\x95\x28\x15\x36\x1d\xfb\x8b\x57\xf6\x40\xd2\xa9\x12\xf6\x80\xbb\x29\xe5\xf5\xa0\xc3\xee\xd8\xc9\xc2\x14\xbb\x2b\x2a\x63\xc0\x37\xa7\xfc\x66\x47\x20\x65\xad\xec\x22\x62\x98\x84\x99\x4f\x3a\x08\xae\xcb\xff\x5e\xef\x8b\xe9\x9b\xf4\x25\x65\x25\xe3\xe6\x0a\x71\x58\x9b\x4c\xe6\xeb\x0d\x4e\x11\x55\x06\x2b\x22\x28\x42\x5e\x1a\x29\xe1\x50\xe6\x9b\xeb\x9b\x46\x98\x40\x7d\x3a\x76\xda\x62\x1d\x4f\xd6\xef\x7d\xa0\x76\x71\xf6\x98\xcc\x72\x73\x57\x40\x52\x53\x14\x02\xe4\x15\x7c\xc5\x38\xe6\x37\xc7\xdd\xd1\x41\xe2\x0d\xe9\xf3\xc4\xe4\xff\x6d\x92\x32\xb0\xc1\x13\x46\x24\x81\x92\xd1\x16\x98\x89\x3d\xa2\x70\x36\x6a\x28\x5c\x58\x8b\x59\x53\x16\xfd\x8e\x90\x89\xb2\x2a\x76\x09\x1e\x9a\xfb\x26\x7f\xe5\x29\x4a\x6e\xc7\x7b\x17\xe2\x73\x2e\x7c\x96\x7e\x45\x46\x2e\xfe\x32\x4d\x87\xe7\xde\x23\xc9\x6d\xaa\xca\x55\x90\xfa\x3d\x94\x21\x3b\x58\x24\x82\x27\xcb\x31\xb7\xdf\xea\x3f\xab\xd0\x5e\xcd\xd9\x9b\x3b\x52\xa6\x87\x3b\x35\x1f\x98\xd7\x8a\x77\x7d\xfa\xb4\xda\xf0\xbd\xc2\x49\x1f\x70\xd3\xa7\x29\x57\xc2\x71\x65\x81\x1a\x79\x4c\x09\xfd\xf7\xc5\x01\x71\x9f\xa0\xa2\xe8\xa1\xba\xe5\xb5\x3a\x11\x34\x8c\xb5\x0f\xe4\xa7\x85\x99\x28\x80\x2d\xfc\xdb\xc3\xba\xf8\xd9\x9b\x91\x54\x42\x98\x0d\x01\xdd\x1d\x5d\x96\xca\x6c\xb4\xd3\x85\x5f\x73\x54\x2e\x16\xec\x13\x9a\xca\x30\x95\x4a\xc2\x7d\x31\x46\xd0\x2d\x85\xb2\x2c\xc8\x30\x12\x0b\x43\x47\x08\xbc\xf0\x94\xb1\x99\xe3\x11\x2c\x7e\x52\x3b\x21\x21\x62\x0c\x5d\xfd\x21\x96\xf9\xdb\x0d\xe6\xdd\x7d\x79\x27\x51\x2b\xaa\x30\x0a\x03\x94\x8d\xb2\xc2\x6f\xdd\x44\x51\xd3\xd4\x9b\x37\x61\xb3\xc7\x46\x4c\x19\x94\xfa\xe2\x39\xd3\xd6\x28\x97\xf9\x0e\x52\xa2\x72\x4e\xce\x93\x42\xda\x94\xa4\xcc\x01\x65\xe8\x4a\x8e\x42\x95\xda\xf3\xbe\x6f\xf5\x25\xd7\x0b\xe4\x77\x56\x0d\x4d\x36\x56\x38\x6d\xf7\xf4\x4c\xa2\x2d\x8a\x1e\xb0\x6f\xf3\xa6\x2d\x27\x1f\x88\xa6\xc8\x43\x56\x2c\xbf\x36\x94\x9e\x21\x15\xe0\xe7\x92\xa9\x09\xd0\x9c\x26\x57\xb3\xad\xed\x75\xac\x7f\xac\xeb\xfe\x12\xa4\x48\x23\x38\x48\x5a\x13\xc8\x0b\xbc\x76\x08\x4b\xcd\xdc\x65\x18\x92\x51\x05\xdf\xf2\x17\x7e\x26\x26\x0a\x29\xe3\x74\xc3\x2a\xbd\x83\x3f\xa3\xc2\x61\x14\x86\x53\xa3\x39\x8e\x39\xba\x26\x49\x06\x8a\xcc\xf6\xe3\x66\x38\x27\xe1\xf0\xbc\x6a\x83\xf6\x8d\x77\x81\xa6\x87\xe7\x33\xd3\xd3\xba\xeb\x96\xe8\x62\xe3\xab\x35\x31\xec\x0c\xaa\xe7\x88\x40\xe1\xd6\xa2\x81\x2c\xca\xa0\xe2\x08\x67\xed\x4a\x40\xb3\x10\x23\x62\x3d\x62\xa6\xd9\x44\xe8\xd5\xe7\x57\x55\x15\xe5\x89\x73\xe7\x0a\xc2\x66\xbc\x50\x38\x31\x3b\x06\xfe\x0c\xfb\xa4\xf7\x1e\x25\x3a\x5e\xf9\x1b\x7c\xac\xcf\xdc\xb3\x57\xa5\xc4\xa7\x6e\xfd\x7d\x1c\x92\xec\x6c\xa2\xe9\x2a\x80\x5b\x16\x13\x60\x5e\x0d\xaf\x71\xe0\xc8\x97\xd4\x51\x2b\x45\xd7\x36\x60\x26\xfc\xda\xc4\xf7\x14\x9f\x26\xaf\x39\x1e\xdf\x97\xae\x70\xac\xfb\xe0\xdd\x13\x12\xa7\x84\x95\x67\x2c\xa3\x7e\x9e\x14\xda\x14\x1a\x2a\xc3\xf7\xbd\xaa\x86\xd4\x8a\xd5\xe5\x5b\xc9\x30\xd2\x98\x76\xa0\xf9\x5b\x11\x32\x47\x97\x43\x9f\xf1\x29\xaf\x5f\xd9\xf5\x0a\xc2\x10\xb9\xfb\xf5\xda\xcf\x5c\x93\x68\x85\x63\x92\xbe\x40\x30\x24\xd8\x2d\x21\xcb\xa9\xfd\x0a\x6a\x2b\x20\x4e\xe8\xb2\xee\xde\x9a\xc0\xfd\x91\xdc\x79\x18\x9e\x76\x1b\x3f\x14\xd8\x82\x15\x70\xeb\xba\xa1\xeb\x94\x41\xb2\x2b\x30\xe6\x29\x3f\x97\xa8\x1f\xde\x13\x87\x2d\xf9\x53\x06\x36\x92\x2e\xfe\x55\xf6\x62\x1f\x59\xb0\xfb\x59\xd0\xa5\xcb\x72\xbb\x48\xf7\xf0\x9b\x8d\x66\xee\x97\x8c\x2d\x19\xe9\x61\xce\x98\xdc\xa8\xd8\xe0\xd8\xc8\xcc\x1e\x9a\x76\x7d\x02\x2d\x94\x90\x5a\x3f\xab\xb3\xb7\x20\x01\x6d\xb4\x75\x81\x62\xe8\xbb\xd5\x8b\x6d\x70\x60\xad\x43\x58\x5d\x1b\x06\x65\xd6\xea\x84\xe1\xd2\x53\x14\xf9\x32\x20\x54\xc1\x74\x78\xe8\x30\x13\x49\x66\x92\x1c\xe3\x3d\xac\x3a\x44\x2e\x88\xe0\x9a\x69\x42\x63\xfc\xe8\x16\x7f\x1a\x13\x04\x0a\x46\x3e\x0e\xef\x1e\x06\xd2\x2c\xd4\x4c\xa7\x77\xa5\x93\x15\x3e\x61\x95\xb0\xcd\x64\x4d\x90\x1b\xf3\x37\x8d\x0b\xa7\xe1\x3a\x89\x01\x5e\x92\x85\x4e\x81\x58\x40\xb6\x92\x87\x8f\x80\xe0\x2f\xd4\xdb\x29\xb6\x12\xb9\x8d\x23\xd3\xf2\x1f\x3c\x71\x46\x88\x44\xc0\x12\xec\x14\x80\x8c\xc9\x70\x84\x1b\x77\x40\xbb\x62\xd7\xe2\x31\xd1\x47\x8a\xaf\xab\x40\xdb\xbb\x80\x4d\x34\x94\x8a\x95\x97\x7a\x8d\x25\x78\xab\xdb\x86\x13\x2c\x9b\x49\xfd\xde\x08\x57\x1b\x1f\x72\xe3\xdc\x08\xc7\xc9\x7c\xdc\xb0\x30\x5f\xa5\x32\xdb\x81\x46\x06\x82\x79\xb6\x6d\xee\x3a\x82\xb8\x1a\x82\x95\x81\x90\xdb\xf3\x27\xbf\x7a\x1a\x8c\x5a\x6e\x9b\xba\xe0\x14\xff\xb0\x2f\x18\xd5\xe3\xf2\x51\x9f\xdc\x67\x1c\x8d\x0b\xdd\x21\x57\xe8\x09\x3d\x5c\x69\x83\x8e\x57\xc2\xa9\x80\xe7\x79\xee\x1e\x42\x84\x07\x01\x63\xec\xa3\x0d\x27\xba\x10\x72\x7f\xaa\x6f\x35\xb6\x23\xac\x20\x0c\x01\x6f\x24\xc2\x89\x40\x2b\xea\x78\x84\xda\xbc\xce\x03\xce\x98\x4b\xc2\xed\x8f\x07\x5b\xc8\xa6\x79\x2a\xb8\x54\x32\x1e\x5f\xd8\x1a\x9f\xe0\x72\x29\xda\xed\xb8\x29\xe6\x01\x36\xd8\x4c\x54\x43\x0a\x95\x57\x45\x75\x01\x8b\x4b\x8f\xad\xb8\x17\xd6\xc3\xa6\x0c\xa1\xc1\x08\x95\x4d\xf8\x25\x18\x4c\x68\xc9\xa2\x05\x22\x1d\xa6\x0a\xd3\x45\x44\x07\xff\x2d\xb9\xca\x66\x54\x5d\xf3\xf1\x77\x99\xed\x94\x21\x52\xc5\xac\x68\xb3\xd1\x9b\x77\x16\x70\x66\x8e\xa2\xcb\x90\x37\xb1\x87\xeb\xfc\x91\x22\xa0\x7e\x6a\xaf\x01\xdc\x42\x6c\x95\xf8\x30\xce\x90\xbb\x05\x9a\xe6\x91\x83\x81\xa4\x24\x62\xc8\x1e\xb8\xb1\x24\x62\xeb\xaa\x13\x0f\x62\x10\x06\x10\x2a\x2e\xd1\x62\x5f\xbf\xd8\xd6\xf6\x46\x37\xe0\xa2\x6f\x7a\x70\x63\x62\x77\xd7\x9c\x50\x53\xfe\xd0\x4d\xa7\x93\xd5\x35\x48\x4c\x7f\x6f\xe0\xbe\x8b\x36\xea\x77\x50\xf7\x49\x41\xd5\xae\xe1\x0d\x47\x7e\x9a\x15\x8e\xd5\xad\x07\x85\x87\x3e\x93\xe8\x82\x4d\x17\xb6\xb0\x4a\x3f\xd3\x5c\x8c\xc2\x3a\xde\x72\x2c\xc4\x48\xfa\xff\x59\x33\x25\x3d\x32\xe1\xfb\x10\x02\xed\x12\x8b\x56\xc7\xf4\xc1\xf6\x62\x0a\x70\xc6\xb7\x76\x9b\x1b\x45\xe2\xf7\x9c\xcd\xba\x3c\x9b\x98\xf0\xe1\xa9\x1d\x68\x41\x94\x0f\x5f\x45\xa0\x05\x9a\x60\xcd\xda\x86\x32\x2f\xa7\xc1\xee\xde\x81\xa7\x92\xfc\xb6\x58\x31\xe9\x39\xa4\xba\x94\x5c\x6d\x73\xfa\xf3\x98\xce\x2b\xcf\x66\x88\x68\x78\x40\x25\xa2\xc9\x1f\x41\x98\x62\x64\x7b\x8f\xc4\xec\x88\x79\xea\x63\x0d\x8b\xfc\x86\x24\x63\xd4\xe1\x2b\x1d\x72\x2d\x76\x03\x18\x65\xec\x6a\xf0\xf6\xe8\xea\x0d\x42\x89\x7a\x93\x6f\x09\xf7\x7b\xa1\x95\xab\x66\x32\x97\x2b\x44\xaa\xac\xa3\x0d\x19\x86\x4a\xd7\x2c\x62\xcc\x08\xde\xf3\x33\xd2\x4b\xd2\x5a\xf5\x05\x51\xc9\x90\x22\xc4\x8b\xe0\x57\xf1\x93\x04\xde\x33\x47\xa9\x67\xef\x92\x6c\x78\x91\x98\xeb\x6e\x42\xd1\x7c\x06\x30\x94\x7b\x54\x6e\x31\x33\xa1\xfd\x5e\x8a\x79\x8e\xec\x3c\x2b\x69\x0a\x75\xdf\xdb\xb0\xd7\x44\xd1\x3c\xa0\xd6\xed\x67\xbd\x2c\xf5\xca\x98\xe9\x79\x61\x01\xa3\x47\x82\xd8\xb6\xa1\xdc\xfb\x13\x07\x12\x8e\x77\x41\xbf\xb3\x89\x68\x5e\xab\xc6\x2d\x40\x40\x6f\x85\x64\x3c\x24\x2a\xe5\xff\x4b\xd4\x00\x74\xa7\x5d\x89\xc4\xc0\xe1\xd9\x4e\xa1\x71\x96\x1c\x43\xc4\x85\x8d\x37\xbd\x86\xe3\xa5\xc7\x9c\x25\xae\x90\x5d\x6c\x7d\x7d\xe3\x1c\x22\xd2\x52\x16\x01\x6b\xc9\x96\x67\xfe\xea\x1d\x3b\x93\x85\x20\x51\x4a\xfd\x47\xae\x99\x7d\x54\xcf\x13\x2a\x8f\x2b\x61\x1b\x76\xcb\x9d\x1b\x28\x3b\x37\x6a\x97\xaf\xa1\x55\x7a\xcd\x08\x1f\x40\x4d\x92\xb7\x77\x47\xca\x80\xa4\x99\x93\x8c\xb8\x11\x9d\x8a\x23\x40\xcf\xef\x80\x76\x7a\x3b\x42\x68\x1e\xb2\x57\x1c\xec\x19\xb4\x81\x96\xff\x9d\xeb\x88\x28\xd9\x8b\x8d\x35\x5a\xd9\x40\x34\x30\x6e\x9b\x1f\x2d\x5d\x2f\xe3\xe0\x3b\xb7\x98\xe1\x07\x33\xfe\xe2\x17\xe9\xef\x51\x7e\x1c\x41\x8b\x05\x7e\x04\x55\xd4\xac\x95\x1d\xab\x8b\xbd\x1a\x19\xce\x04\x77\xca\xe1\xad\x53\x3e\x25\xa0\xcb\x44\x25\x00\x7f\x17\x66\x18\x80\xe0\xd9\xc9\x72\x77\x70\x9f\xf9\x5a\x77\x55\x00\xa6\xef\x50\xd2\xc9\x2c\xf2\x77\xa5\xe6\x7b\x8a\x19\x70\x4e\x16\x8f\xe2\x6f\x0c\x35\x47\xd5\x22\x91\xbd\xcf\x89\x6e\xae\x2c\xc3\x8f\xf3\x9f\xd1\xf0\xc5\x90\x4c\xe2\x1e\xc8\x99\x94\xd0\x8e\xa8\xfb\x9e\x51\x3f\xac\x1b\xf6\xed\xb4\xf0\xf0\x46\x80\x16\x40\x18\xe4\xc0\xca\xb4\x91\x75\x12\x8a\x2f\xe1\xeb\x8e\x4b\x4e\x3c\x3b\x40\x16\x5e\x1e\xef\x81\xb7\x66\x5e\x03\x5c\x46\xed\x8c\xc2\xe0\x74\xb9\x1b\xb8\xf9\xc1\x84\xfa\xf7\xdb\xa6\x82\x07\x37\xa5\x55\x08\x8c\xae\x60\x31\x23\xc3\xec\xbc\x96\x6a\xb7\xfd\xa8\xcf\xde\x02\xf6\x23\x9b\xfc\x1a\x85\xd2\xfe\x68\x82\x07\x3c\xa0\x83\xcf\x87\x1d\x11\xb2\xea\xf6\xbd\x80\xdc\x29\x3a\x2f\x38\x8a\x64\xd6\xe4\xd2\xca\xdc\x37\xfc\x99\x79\x1f\xfb\x66\x0f\xea\xc5\x08\xdb\x80\xd5\xb5\xea\x4f\x29\x7b\x7a\x8c\xef\x91\x30\x17\xa2\x9a\x66\x66\x9e\x19\x23\xf9\x57\x73\x24\xf3\x73\xa9\x55\x51\xa9\x6f\xe7\x0c\x96\xcb\xbb\x83\xf3\x72\x4c\x6a\x2e\xee\x42\x14\xac\x15\x56\x5e\x0d\xb1\x75\x00\x90\x66\x05\x82\x4e\x76\x99\x87\xcc\xc7\x47\x2e\xc0\x03\x94\x18\x65\xb5\x69\xc1\x02\x92\x49\x7e\x4c\xc4\xc4\xcc\x52\xaa\x0d\x58\x9d\x36\xca\x60\xa9\xe6\x01\xa7\x15\xb5\x85\xe2\x2a\x01\xd0\xd9\xa2\xe6\x86\x1b\xa7\xc1\xb9\x49\x02\x28\x48\x09\xc9\x23\xdc\x0f\x2a\xd6\x63\x00\x3a\xe1\x29\x84\x9f\xa6\xad\x43\x7c\xc0\xaf\xf8\x31\x8d\x57\x75\xa4\x2b\xc8\x66\xbf\xff\xaa\xa5\xbe\xd2\x56\xde\x6f\x0c\x28\x11\x6e\x62\x11\x7b\x13\xe0\xb7\xac\xd3\x49\xe9\x5f\xbd\x3b\xbf\x72\x71\xba\x87\x65\x85\x96\x8a\xe3\x98\x33\x38\xbf\x6a\x85\x7f\x86\x3d\x6f\x0c\x6d\x6d\x4e\x36\x98\x53\x90\x67\xad\xb8\x88\x21\x2d\xea\x17\x88\x66\x72\x5d\xee\xf5\x49\xdb\x8f\x6e\xb5\x87\x3a\xce\x73\x0a\x2f\x49\x12\x49\xa6\xe6\x6d\xbd\x21\xf4\x42\x88\xe9\xe1\xc3\xda\xb1\xd0\xfc\x90\x27\x8f\xee\xba\xa5\x9c\x5f\xe3\x8c\xa7\xdd\x70\x9b\x9e\xa2\x3e\xf1\xb4\x1f\xfc\x84\x0a\x85\x66\xc4\x94\x67\x27\xe0\xe8\x92\x97\xc8\x09\x52\x03\x15\xab\x07\x7f\x13\xab\xe1\xfb\x73\x89\xb9\x8d\x2e\x9b\x27\xd1\x99\x6b\x70\xd0\x0f\x76\x83\xa6\x61\xb1\x90\x35\x6e\x11\xb0\xb2\xeb\x81\x93\xc1\x19\xa1\xc1\x4e\x63\x83\x0f\xeb\x01\xe8\x5d\xae\x28\xbb\x38\x46\xa6\x04\x64\x48\x2d\xba\x95\x0d\xb9\x3f\x0d\xc9\x41\xbd\xc2\x64\x5b\x0c\x57\x5f\x95\x97\x34\xc5\xd6\x64\x0f\x89\x1d\x49\x93\xa6\xca\x50\x10\x06\xde\xc0\x31\x7d\x95\x07\xb3\x16\x8b\xc7\x99\x59\x4c\x4f\x66\x39\xc2\x44\x64\x9b\x2c\x36\xd4\xe1\xc9\x64\xa2\x1a\x02\xb7\x37\x3d\x95\x72\x13\x43\x1d\xf8\xa8\x80\xed\x4f\xad\x4c\x78\x2e\xe8\xdd\x6f\x0f\x78\x1a\x15\x5b\x4c\x19\x1f\xdd\x67\xe7\x15\x0c\xa1\x9b\x8f\x7f\x59\x6f\x34\xaf\x48\x6e\x57\xd1\x8e\x52\xf4\xf7\x3e\xb1\x23\x7a\x76\x98\x4a\xe2\x42\x07\xf8\x6e\x5a\xfd\x82\x28\xdd\x73\xdd\xec\x47\x4d\xcc\x64\xcc\xac\xae\x29\x98\x18\x43\x39\x41\x38\x62\xca\xc6\xd8\x28\x62\x38\x76\x71\x06\xbf\x08\x56\xc7\x80\xbf\x24\xc6\xd1\x82\x9e\x46\xc0\xf0\xe0\xcc\xdc\x55\x0a\xed\x1b\x4d\xc2\x6b\xe7\x16\xe2\xf9\x45\x09\xe1\x5e\x94\xdb\x64\x36\x38\xc4\x56\x74\x79\x30\xf4\xab\x2b\x5b\x1e\xaa\xc7\xe6\x27\x97\x48\x4b\xb5\x66\xc5\xd3\xf0\xaf\xb3\x74\x82\x1d\x9a\x9e\xeb\xae\x78\x57\x5c\xb5\xa4\x8c\x10\x2c\xac\x79\xdc\xfa\x4a\x10\x02\x72\x46\xf2\xf1\xb6\x24\x74\xf9\xce\x5c\xde\xed\x16\x94\x27\xa9\x36\xfc\xe0\xcd\x40\xef\xeb\xc5\xf0\x60\x56\x46\xda\x9b\x51\xcc\x77\xcc\x2d\x07\xee\x85\xea\x3a\x84\xc9\xf8\x06\xba\x47\x5c\x36\x86\xb3\xae\x47\x35\x22\x4a\x6c\x5a\x00\x44\xde\xf2\x2f\x4d\x43\xd3\xf2\x0f\x24\x43\x06\xb3\x81\x07\x61\x0b\xae\xb0\xd4\x17\x7b\xfe\x4c\xc6\x83\xed\xeb\xc5\x42\xbd\x58\xfb\xa0\x02\xfd\x8d\xa2\x82\xec\x7b\x9b\x86\x54\x14\x4d\x6a\xdf\x2a\x89\x96\xa0\xe3\xa2\x58\xb2\x79\x8a\x7e\xeb\x77\x97\x17\x3e\x2b\x7f\x55\x74\xdf\x45\xf0\x13\x88\x21\x85\xa7\xdb\xd4\x75\xc1\x11\xa6\x15\xa9\x6f\x02\xf4\x31\x9a\xad\xf2\x36\x76\x4b\x31\x66\x77\x54\xab\x9d\xa4\x01\x2a\x34\x0a\x98\xf6\xdc\x69\x08\xc0\x8e\xa6\x13\x9a\xf8\xff\xc3\xc5\xa1\xca\x31\x99\xe2\x44\x3a\xc4\x95\x08\x35\xef\x7a\x53\x4e\x7a\x5b\xf4\x1a\x41\xe7\x28\xc9\x8b\x6f\x2f\xad\xbb\x74\xe7\xbd\x54\xb2\xa8\x55\x19\xbb\xd8\x45\xaf\x3c\x61\xf6\x4b\x55\xc6\xfc\x80\xea\x79\x6e\x49\x5b\xa4\x32\x87\xb5\x5e\x78\xe5\xe2\x05\x47\xad\xfe\xa0\x2d\x20\xc1\xd2\x70\x9e\x6d\x89\x86\x93\x2c\x9b\xd6\xaa\x81\xcc\x93\x33\x2f\xee\xa9\x75\xc1\xd7\xe3\x35\x7c\x48\xf5\x81\xa1\x66\xd6\x89\xca\xe3\xb5\x4c\x93\x9a\x32\xa6\x49\xbb\xa1\x63\xf5\x93\x0d\xfb\x0f\xef\xc9\xea\x61\xe5\xfe\xa1\x44\x13\xcc\xd1\x90\xa6\xd8\x0e\x85\xf1\x5d\xc8\xf7\x3c\xa1\x6e\x5f\xaf\x3a\xf6\x4e\xb9\x51\x15\xf4\x8b\x54\xbe\x75\x2d\x60\x7e\x4c\x32\x79\x82\xce\xa4\xa9\xa9\xe6\x9a\xd6\x2e\x7e\x95\x98\x45\x54\xb6\xc1\x30\x0e\x9f\x00\x43\x5e\xd0\xc2\xcc\x43\xaf\x8d\xcd\x2c\x89\x3e\x26\xa1\x62\x42\xb0\xd6\x6b\x1f\x69\xf7\xad\x0f\x7c\xb7\xfb\xe5\x08\x8e\x6a\xed\x48\x6b\xdd\x17\xf7\xaa\x90\xb2\xef\x89\x18\x98\x03\x94\x36\x8a\x54\x3d\x34\xb4\xa1\x84\x0e\x3e\x14\xc7\x79\xd5\x0a\x71\x21\xfe\xc6\x9f\x76\xb8\x7e\xd5\x24\xee\x5d\x1a\x5b\x88\xae\xc3\x61\xc8\xde\x38\x60\x50\x2f\xdf\x7b\xc0\xd3\x55\xaa\xbd\x2d\x26\x0c\x05\xad\xef\x34\x98\x79\x3f\xb4\x40\x4f\xb4\x3d\x01\x66\x53\x2a\x83\xdd\x37\xde\xac\xfe\x52\x9e\xe2\x43\x48\xb5\x48\xeb\x6e\x3f\x1b\x9c\x19\x9a\x7d\x97\x9e\x8f\x8c\xa8\xf9\x65\xd2\x65\x10\xd5\xef\x00\x87\xba\x7e\xe9\x76\xf7\xc3\xc4\x33\x40\xb0\x05\xb2\x06\xb2\x33\x41\x60\x69\x72\x40\x25\x6c\x40\xe5\xe0\x6b\xb8\x44\x4f\xf0\x2f\x56\xad\x46\xe1\x7f\x33\x6a\xa9\xd3\x19\x58\xa2\x3b\x76\x42\x25\x8c\xa3\xbd\x42\x1c\xed\x42\xd4\xe3\xbc\x67\xc7\xb9\x79\xa5\x51\x42\x16\x80\x04\x41\x0a\x25\xd0\xaa\x09\xca\x2c\xf0\xe2\x52\xed\x57\x3a\xa8\xbc\x96\x09\x04\x87\x88\xe7\x56\xfc\xeb\xcc\x9d\xdb\x56\x94\xf8\x9b\x0d\xfd\x96\x6a\xf0\xcb\x3a\x47\xf1\x2e\xb9\xbf\x02\xea\x0d\xbf\xef\x96\xe8\x69\x8d\x36\x5d\x3c\xab\x4d\xd8\xb8\x1c\x6f\x92\x02\xaa\x3c\x9d\xd3\x48\x8c\xa5\x28\xd0\x48\x4e\xc2\xcb\x5c\xbf\x40\x9c\x04\x8b\xc4\x02\x50\x7e\x1a\xaa\x45\x90\x96\xa7\x71\x53\xae\xf5\x96\x7d\x6a\x44\xbf\x16\xa5\x35\x1a\x89\xa7\x9c\x21\x8c\xe5\xf4\x46\xa0\xc9\xb4\x2c\x97\x0f\x88\x1e\x94\x3b\x57\x63\x43\xd5\x20\xa9\x0f\x1b\x7e\x6a\x5b\x42\x73\xbd\x62\x24\x53\x99\x8d\x1f\x73\xa6\x93\x20\x58\xe3\xf6\x55\xc8\x79\x17\x1a\x3c\xf5\xf1\x93\x44\x62\x20\x4a\x53\xfe\x6d\x3a\x0a\x1b\x16\xfe\xef\xfb\x2c\x22\xe1\x41\x98\x5c\xa7\xe2\x19\x7b\x29\x7d\x6c\x39\xbb\xa3\x23\xd3\xca\xdb\xcd\x76\x78\xa6\x69\x1b\x6d\x5a\x81\xac\x04\x75\x70\x0e\xa5\x92\xe1\xc2\xba\x5f\x2e\xef\x49\x88\xa9\x7e\xda\x9a\xa6\xfa\x81\xfd\xc4\xb9\x35\x6d\x39\x6d\x89\x29\xce\x9a\x0c\xda\x2f\x8e\xb5\x39\x00\x90\x63\x09\xa0\xc6\x8a\x1c\xf3\xf4\x9f\x86\xa5\xd5\x64\xfb\xc6\xe8\x23\xc5\x24\x5a\x79\x05\xe1\x63\x35\x69\xa1\x3e\x99\x2c\x95\x24\xb1\x90\x37\x3b\x68\xbf\x91\xfe\x56\xd2\x23\x0c\x5a\xcd\x77\xfc\xf5\x28\x3f\x45\x9a\x90\xa0\x91\x5a\x0a\x65\x8c\xca\x8b\x7a\x60\x9f\xd5\x63\xad\x6e\xec\x5f\x11\x47\x2b\x07\xe5\x77\x51\xd0\x24\xbb\xa1\x14\xaa\xda\xad\x33\xd9\xfc\xc2\xc2\xd4\xb6\xef\xa4\x74\xa3\xda\xd9\x86\x2e\x06\x96\x24\x08\xc4\x15\x64\x39\x7d\xdf\xcb\x85\x63\xb6\x9b\xad\x78\x9f\x53\xdd\x24\x2c\x65\xb7\x7e\xcd\x6a\x69\x0d\xb1\x59\x72\x04\x85\x68\xc4\x98\xa2\xf0\xe9\x22\x5a\x11\x01\x36\x48\x37\x7a\x50\xa4\x17\xc7\x12\xba\x7d\xd8\x62\x10\x0f\x1b\x9b\xd1\x1f\x4a\x25\x52\x9d\x4c\x8b\x95\xf5\x1d\x8a\x07\x7c\x12\x77\xd7\x50\x33\x8c\x5d\x1b\xb9\xe8\x19\xc8\xd6\xca\x35\xa7\xac\xaf\x8d\xd8\x53\x9b\xc9\x69\xcd\xc3\x1b\x1f\x72\x7b\x1d\x96\xaf\x58\x3a\xd7\x5e\xd9\xb0\x50\x85\x9e\x49\x68\x78\x3a\xb9\x2a\xc9\x96\xc4\xd6\x8a\xc4\xaa\xb0\xae\xd6\x2f\xd8\xaa\x6e\x58\x0e\xe8\xe7\x57\x97\xcc\x99\xc0\xf5\x5f\xe9\xbf\x4d\xb9\xd2\x6c\xc9\xc8\x2c\xa3\x5b\x87\x43\xfb\xbe\xf2\x4f\xfb\x9f\x22\x66\x6f\x37\xbc\x21\xf3\x93\xc6\xc4\x72\x46\xe0\x90\xfd\x29\xd8\x11\x01\x95\xd1\x6e\x2c\x21\xf1\x67\xa4\xcd\x4e\xcc\xb8\xa9\x35\xe4\xd7\xc5\x94\xdc\xae\x80\x75\xc3\xad\xa4\xed\xf7\xac\xd0\xf0\x97\x46\xdd\x92\x96\xfa\x56\x20\x82\xe2\xa4\x81\x44\x79\x04\x3f\x70\x5c\xf3\x3b\xae\x0b\xcd\x29\xe5\xbe\x7a\x4d\x2f\xf8\x73\x7b\x27\x81\xf3\x78\x4d\x05\x6e\xb1\xca\x4c\x31\x99\x68\x72\xbc\x8a\xe9\x35\x3f\x5d\x01\xbb\xa9\x5b\xe9\xaa\x31\x5f\x83\xe7\x11\xb6\x5b\xa1\x18\xc6\x08\x7e\x1f\x93\x80\xc2\x6a\x37\x21\x5b\xfd\x46\x41\x1c\xf5\xa3\x77\x18\xd9\x16\xf3\x4d\x34\xb2\xad\xfd\x21\x55\x74\x24\xd6\x60\xb6\x19\x34\x4a\xf2\x4d\xbb\xcf\xbc\x21\x9c\xc9\x69\x57\x9e\x76\x55\xfb\x8e\xad\x04\x09\x34\x90\x96\xd7\xe4\x6d\xf5\xcf\x66\x45\x5c\xb5\xaa\xf3\x36\xdc\x36\x78\x20\xad\xc6\x46\x6a\xa3\xb2\xfe\xf7\x23\xd3\x40\x2a\x56\x85\xc2\x9f\x44\xcf\x80\x8f\x39\x29\x55\x72\xe4\x66\x12\xb8\x04\x89\x7c\x73\x05\x2b\xb8\x2f\x1b\xd6\x22\x2a\x1d\x8c\xeb\x57\x7b\xec\x0a\x9c\x1b\x3c\x35\x23\x92\xc1\xba\xe1\x81\x32\x1f\x0b\x3e\x73\x34\x74\x7c\xa3\xfd\x48\x36\xc0\x41\x66\x93\x12\x0c\xd7\x2a\x33\x13\x08\x21\x5a\x88\xa7\xf1\xfe\xd6\x82\xd6\xa3\xaa\x22\x91\x2e\xb5\xf9\x52\xeb\x70\x13\xde\x4c\x8c\xe5\x6c\x63\x13\x10\x3c\xd0\x9e\xd7\x96\x82\xad\xb9\xcd\x6e\x6e\x99\x6d\x08\x39\x9a\x70\xb7\x89\x80\x42\xa1\x2b\x6c\x0c\x38\x41\xf8\x92\x63\x9a\x68\x68\x8d\x3f\x3f\x18\xf9\xcd\x48\xed\xd1\xd4\xae\x7a\xae\x41\xfd\xec\x00\xd0\x51\x1d\x14\xab\x0b\x1f\x3f\x32\xbb\xc7\x2a\xfd\x2a\x22\xf5\x90\xc6\x0c\x05\xcf\xf1\x82\x3d\x31\x21\xa4\x93\xb0\x63\xc4\xf4\x69\x59\x05\x0f\x4a\xd7\xc9\x9d\x0a\x31\x4b\x3a\x9a\xc6\xbe\x1f\xaf\xaf\x99\x1d\xb1\x5d\x89\x72\x5d\xbe\xd1\xef\xfe\x40\x51\x34\x93\x9c\x21\x3b\x00\x3a\x5e\xd1\x38\xdf\x2c\xa4\xa1\xad\xa6\x8f\x7d\x1a\x20\x9d\x8e\x1a\x86\xfd\xa6\xdf\xab\xe4\xcc\x85\x0e\x37\x79\xd1\x0b\xe8\xba\x54\x23\xe9\xea\x99\x13\xb1\xd2\xb3\x79\xc9\xf9\xfd\x50\x33\xa5\x20\x9c\xdd\x77\xda\xa8\x15\x83\xbc\xb2\x2f\x48\x82\x73\x81\x08\xaf\x5f\x53\x04\x08\x1c\xf8\xcc\x42\x73\xae\x5b\xce\x81\xda\x44\xc3\xd1\x89\x80\xc3\x56\xe2\x92\x50\xd5\x11\x45\xb6\xd6\xa3\x94\x88\xb6\xfa\x8d\x61\x8a\x7d\x2a\xa8\x74\x2d\x2f\x9e\x98\xe1\x9b\xe0\xa3\xa7\x31\x1b\x35\x36\x62\x66\xb5\xab\x49\x2e\x3f\xdc\xba\xf3\x2f\x6b\x57\x27\x3d\xb1\xfd\x1a\x48\xef\xf3\x2e\xe0\x3a\xc1\x91\x03\x14\xca\xf6\x2d\xf3\xb9\x48\x40\xde\x7c\x7e\xa6\x58\x9d\x4d\x46\xb7\x81\x64\x78\xb9\x19\xb3\x3c\x24\xc4\x0d\x55\x27\xad\x4b\x30\xea\x0d\x42\xc7\xe4\x48\xba\x88\xd3\xf5\x06\xc2\x54\x7e\x1b\xdb\xa8\xa3\xb1\x18\x38\xc4\x3f\x52\xca\x9b\x92\x98\x69\x29\x35\x9f\x2b\x89\x17\x5d\x73\x27\xd6\x46\xc5\xb0\xb5\xc1\x56\xfb\x61\x48\xa3\x9a\xce\x07\xc3\x1e\xcb\x61\x92\x14\xd7\xe7\x15\x90\x7b\xac\x8a\xb1\x86\x47\xa4\xb4\xf0\x32\x40\x97\xb6\xd8\xbd\x9f\x17\xdc\xa6\xcb\xb3\xfa\x3b\x28\xa3\x80\x16\x7c\x54\x14\xac\xc3\xd0\x34\x32\x2c\xb3\x00\x9f\x04\xe6\xd7\x2f\xf3\xe2\x55\x47\xc1\x69\xae\x46\xb2\x63\x83\x5a\x74\xd9\x63\x28\x33\x01\x54\x96\x1c\x28\x81\x28\x9e\x45\x01\x1e\x7a\x88\x37\x4f\x04\x49\x5c\xec\xea\x23\x82\x20\xa7\xca\x1c\x31\xe6\xb1\x3f\x85\xee\x3a\x8b\xbd\xc8\x53\xe3\x72\xec\x21\x7c\x0d\x47\xc5\x21\xed\x7c\xe7\x5c\xbd\xb2\x7c\x8b\xbd\x38\x57\x07\xb0\xa8\xc8\xdf\x96\xf7\x37\x94\x3b\x1f\x9f\xa2\xbc\x05\xfc\x00\xb3\xef\xb0\xeb\x01\x63\xd8\x71\xe1\x08\x3c\xf4\xfb\x27\xe0\xa3\xf8\xa5\xcd\xe2\x27\xba\x7f\x5f\xec\x36\xee\x27\x95\xd6\x4c\xa4\x4e\xed\xf0\xe5\x57\x3d\xbb\x42\x84\xc9\xe7\x4f\x02\x8f\x97\x77\x2c\x8d\x1e\x7b\x8b\x6e\x8b\x5e\x9f\x01\x96\xee\xd8\x1a\xef\x94\xd7\x85\xdb\x06\x43\xcf\x8d\x9c\x3a\xba\x23\x3f\x29\xf8\x2e\x3c\xa3\x75\xcd\x33\x78\xfc\x89\x37\x90\xcd\x36\xbc\x25\x4c\xef\x69\x62\xf3\x34\x7e\xb8\x09\xcf\x8d\x2e\x26\x15\x46\x34\x39\x73\x52\x98\x81\x1c\x4d\x3e\x75\x64\x1d\x85\x25\x40\x80\xb4\xe8\x53\x49\x40\x3b\x8f\x1f\xff\x48\x31\xb2\x81\x3f\xe7\x49\xe1\x98\xa4\xa2\xd2\xdd\x68\xee\x32\xc2\x0c\x02\xc3\xca\xa2\xdd\x87\x3a\xf6\x85\xed\x92\x26\xc0\xae\xd3\x84\xcb\x77\xb4\xd5\xe2\x61\x9a\xa4\x42\xd4\x34\xca\x55\x56\x6c\x2e\xa7\x1d\x95\x76\xd7\xdc\xf1\x20\xf9\xb0\xa0\xa9\x0c\xff\x18\x25\x28\x05\x58\x08\x87\xe6\x2f\x89\x0b\xf5\x50\x8b\x5b\x70\x9e\x77\x6c\xef\xd8\xaf\x6b\x74\x10\x9f\x36\xf5\xcb\xf0\x49\xe9\x4f\xdd\x09\xaa\x62\xe4\x08\x6e\x82\xb2\xc9\x9b\x70\x39\xa9\x11\xa1\x4d\xc7\xa1\x2a\x80\x95\x3e\xda\x1f\xe5\xc8\x9a\xbe\xd5\x2c\x30\xd8\x8b\xb9\x45\xdd\x8b\xf3\x21\xea\xff\x22\xc9\x49\x16\x92\x21\x28\x62\x5f\x8b\xb5\xee\x37\x28\x4a\xa5\x5e\xcf\x8d\x7a\xb2\x32\xc6\xfa\x09\x60\x13\x85\x41\x8c\x88\xbb\x47\x01\x94\xf1\x98\x39\x5f\x8a\x8d\xc4\xe1\x25\x61\xa0\x18\x6e\x4e\x5b\x7d\x96\x34\x24\xbd\x45\xad\x8e\xf6\x57\xb3\xfd\xf9\xc7\xf2\x43\x54\xad\x90\x3d\x19\x95\x9f\x9d\xe6\xec\x0a\x6c\x02\xa4\x04\x99\x06\xb8\xbf\xc6\x63\x60\x37\xae\x06\xea\x41\x7e\x72\xaa\x4f\x7e\xfa\x6c\x17\xab\x10\x5a\x57\x6a\x29\xa5\x62\xce\x83\x2d\xc6\xc6\x48\x39\x08\x01\x02\x0d\x23\xdd\xb8\x1e\x91\xb9\xbc\xed\x15\x16\x66\x76\x3b\xf2\x4a\x21\x19\x42\x8b\x6b\xfd\x10\x8e\xec\x6d\x6a\xcb\x84\x09\xa3\x5c\x4b\xe7\x5b\xa0\x4d\x82\x4e\xc9\x9e\xbb\x2d\x2f\x8e\x27\x15\xbc\x96\xd8\x2e\x67\x17\xb4\x22\x94\x4f\xe9\x9f\x73\x2e\x08\x33\x57\x18\xf6\x13\x74\xc6\xbe\x6c\x59\x33\x8e\x67\xb5\x37\xf9\x0a\xd5\xd8\x5b\x50\x66\x76\x53\xfe\xeb\xa8\xb0\x75\x78\x31\x5d\xcc\x8e\x63\xda\x54\x11\x70\x58\xc5\xcf\x46\x09\xf7\xdf\xb0\xf6\x9b\x23\x95\xb0\x7e\x10\x3b\x05\x88\xf6\x9c\x4a\x17\x21\x7b\x05\x8a\xa5\x6f\x8c\x2d\x85\x55\x10\xb8\x0a\xae\x4d\xe5\x0c\x4c\x6e\x71\xbd\xaf\xa8\xc8\x2e\x2e\x24\xfe\x97\x55\x03\x7c\xb1\x91\x0a\x59\xcf\xc9\x0f\x63\xa8\xa3\xda\x6a\x6b\xe6\x3a\xd7\x93\x10\x4d\xee\x94\x13\x54\xb3\xc0\xb9\xde\xc1\x36\xfa\x57\x87\x7f\x6e\x16\xa0\x5f\xba\xbf\x37\x5e\x13\x3c\x04\x24\x6a\xd8\x3c\x7f\xb1\x6a\x9a\x1b\x25\xd6\x4f\x2b\x14\x20\x7b\xf5\x55\x2f\x65\x6f\x66\x5c\xa9\x14\x77\x28\x3d\x14\x2e\x56\x69\xad\xb1\xd1\x86\x11\x3f\xbf\xc9\x57\x7d\xab\xd6\xde\x4e\x1e\x12\x3e\x97\xb3\xb6\x57\xfe\x05\xdb\x2d\x6e\xae\x81\xb3\x63\x29\xfb\x0a\xac\xd0\x03\x16\x8d\x8a\x67\x48\x13\x3d\x5c\xaf\xd9\xa2\x7d\x32\xf3\xb4\xd2\x20\xdc\x83\x11\x49\xa5\x64\x35\x1e\x05\x80\x23\x3e\x20\x28\x5a\xb1\xd9\xee\x6b\x16\xfd\xd3\x29\xb7\xc2\x6b\xaa\x12\x5c\x02\xf4\x00\x71\xba\x6f\x45\x07\x05\x72\x05\xd0\x74\x54\x35\xb1\xe1\x0f\x3c\x95\x49\x9d\xc0\x9d\x36\x3f\x4a\x5e\x28\x93\x9b\x84\x60\x5c\xf6\x4d\xe8\xc6\x2b\x4e\xcc\x35\x1f\x4a\x2e\xb6\x95\x7c\xdd\x28\x8f\x91\x67\xfa\xec\x30\x8c\x3c\x80\x6e\xc8\x01\x8d\x85\x85\x6b\x7e\x42\xe8\xe6\x4e\x2f\x52\xd8\x12\x37\x34\x37\xa3\x94\x83\xc1\x5a\xb1\x17\x97\xe0\x33\x7b\x0e\x7f\x86\xd8\xec\x25\xf8\xa3\x8a\xc4\x32\xf2\xc5\xd5\x63\x54\xc2\xa8\xb5\xa4\x32\xdd\x5b\x16\x5d\x4e\x7a\x11\xe1\x26\x66\x5e\x2a\x23\xf4\xac\x3d\xda\x5e\x66\x7c\x7c\x05\xac\x4e\x18\x25\x52\xab\x6f\x14\xf8\xa0\x1b\x9d\x6b\xd4\x0e\xa8\xf6\x8c\x11\x35\x2c\x14\x26\x8b\x00\x72\x8d\x87\x30\x1f\xd9\xc3\x2a\xb4\x91\x4d\x87\xec\x91\x56\x86\x62\x28\x41\xca\x12\xe4\x8d\x36\xcc\x1d\x2b\xdf\x55\x3d\xd7\x04\xe4\x04\xbd\x4d\xed\x5c\x77\xca\x58\xd7\xba\x55\x13\x5f\x1a\x38\x5b\xb1\xe9\xb6\xc4\xea\xfd\x02\x34\xdd\x91\xd9\x32\xa6\xcd\x8e\x9e\xf5\xa0\xdf\xd9\x60\x16\xd9\xb4\x29\xb1\x67\xc0\x7e\x1a\xc0\xcf\x0d\xd1\xbc\x7c\xf8\xaa\xed\xd9\x8c\xc1\x05\x77\x5e\xfd\xb4\x8d\xca\x67\x83\x24\x89\x0e\x3c\xb7\x71\xdb\xec\x91\x7d\xed\x2e\x35\xa8\x42\xf8\x57\x41\xbf\x97\x40\x94\xad\x35\xd4\xc6\x10\x7a\x0b\xc8\x60\x38\xe0\xb1\xaa\x77\xe1\x2e\x76\xe2\x9b\x2c\xd7\xcf\xf1\x83\x38\xee\x88\xae\x31\xab\x8e\x23\xc3\xe2\x36\x28\xeb\x15\x4a\x03\x6e\x03\x20\x46\x0a\xa9\xe5\xd9\x58\x03\x75\x78\x05\x5e\xb2\xb5\x15\x26\x86\x01\x54\x68\xb0\xe2\x44\x85\x40\x04\xbc\xbc\x17\xf6\x66\x55\x98\xe4\xd1\x08\x13\xea\x62\xa0\x6a\xe9\x0a\xeb\xf9\xad\xe5\xdf\x3a\xae\xb2\x17\xc5\x0a\xe6\xc2\x11\x3e\x57\x98\x3b\x9e\xf8\x7e\xfe\xde\x87\x6e\x5c\xa0\x6f\x74\x38\xcd\xe2\x50\xf7\x1d\x9b\x6c\xb0\x99\xb6\x4b\x68\xf5\xad\x15\xa9\xd3\xce\x04\xa1\xa6\x12\x8f\x50\x4a\x3b\x66\x38\x26\x2b\xb4\xc8\x04\xcc\xeb\x9e\x81\x66\xc5\xd5\x78\x44\x87\x5d\xfe\x1e\x80\x1f\x01\x73\xf9\xa9\x54\xe3\x6a\xc9\x56\x87\xed\x69\xba\xcf\x17\xff\x77\x83\xda\x4b\xe8\x86\x82\x8a\xb8\x0e\x37\x18\xde\xab\x7d\xe1\x0b\x3b\xf0\x7d\xbe\xd6\xc5\x82\x4f\xde\xaf\xa1\x86\x00\x7d\x10\xc2\xd3\xbb\x46\x2e\x61\x2c\x19\x83\x3d\xc0\xf8\xd7\xbc\xb4\xda\x24\x23\xe8\xc3\x04\x89\xa1\x24\xc6\xc4\x9a\x6d\x08\x03\xef\x8b\xa6\x5e\x58\xce\x83\x7b\xa3\x20\x6d\x69\x6a\x05\xa4\x62\x42\xd5\xae\x4c\xa1\xb7\x3d\xa5\x70\x19\xf8\xcc\x56\xb6\xdd\x8b\x39\xad\x18\xdb\xcb\xb2\xed\xeb\x2e\xf7\xed\x5d\xd9\x40\x8d\x5d\x01\x9b\xf9\xd5\xd7\xc8\x85\xa2\x1f\xe2\x05\xc5\xc9\xb8\x9a\x22\xc2\x7e\x4a\xe1\x9d\x9b\xdc\x65\x6e\xaf\x1e\x80\xcf\x33\xbf\xc6\x96\xa6\x2c\x0f\x0e\x7f\x18\x99\x81\x4e\xe6\x51\x99\x83\x53\x49\x03\x7d\x70\x7e\x2e\x7a\xe4\x5d\x1c\xe0\x90\xb5\xc3\x0b\xc6\x54\x19\x37\x23\x79\xa7\x74\x68\x16\xc5\xf5\xa2\x8e\x1a\x5c\xeb\x30\x88\x66\x82\x1e\x37\x9d\xd5\xba\x65\xf7\xce\x83\x9c\xf3\xcd\xa8\x2f\xb1\x6c\x45\x05\x6c\x4b\x67\x21\x80\xee\xa3\x2b\x5c\x5a\x3c\x98\x54\x8f\x52\x2b\xb2\xb9\x47\x74\xe7\x4b\x1b\x52\x46\x86\x2e\x80\x31\xef\x43\x0c\x7c\x23\x34\x17\x22\x63\xc9\x46\x28\xcc\x69\x9d\xa0\x68\x47\xba\x99\x55\xfb\xad\xc3\x6c\x33\xca\xa6\x98\x23\x8e\x2a\x4d\xf6\x5c\x47\xf3\xb3\x48\xb0\x46\xa1\x7e\x99\x3a\x34\x3b\x35\x9f\x65\x73\x9d\xcd\xcf\xdb\x45\xa2\x3c\x5e\x4c\xc1\x7b\xe2\x60\x0f\x1f\x9c\xff\xdb\x42\xcf\x83\xa3\x70\x73\x27\x31\xe9\xfd\x9b\x2a\x4c\x57\x49\x94\xac\x22\x9e\xab\xcd\xce\xbc\x9d\x3e\x96\x82\xbe\xc6\x65\x79\x90\x55\xb2\x86\xe0\x53\xbd\x71\xef\x1e\xc5\x7d\x17\xca\x45\xf0\xf3\x58\xef\x49\x41\x36\x61\xd8\xd7\xfa\xff\x6a\xb4\xdf\x7e\xaa\xfd\xc0\xf8\xa2\x86\xde\x3f\x2a\x5b\xc5\x5e\x56\x15\x30\x98\xdc\xe9\x6a\x8e\xd5\x2b\x51\xe5\x60\xaa\xb1\xe6\x3b\xf3\x5c\x4b\x2b\x84\xf7\x94\xd2\x57\x35\x41\xd8\xcc\x1c\xeb\x0a\x39\x2f\xc1\x29\xbc\x93\x86\x1b\xee\x09\xd1\x7b\xd2\x89\xce\x8d\x5c\x6b\xef\x3b\x8d\x4f\xb7\x07\x83\x3f\x48\x4d\x10\xdf\x40\x32\x89\x43\xb6\x30\x4f\x48\x33\x39\x8e\x5a\x73\x6e\x93\x88\x4a\x57\x09\xaf\xb3\x5e\x44\x20\xfa\x56\xe4\xda\x1b\x65\x5c\x49\xf1\x35\xbd\x09\x8c\x49\xae\x5e\x1e\x0d\x22\x0e\x43\x89\x46\x35\x8a\x21\xf1\x08\x3b\xdc\x3c\x06\xb4\xaf\xe9\x99\xfe\xea\x71\xdb\x8e\xfd\x78\xa1\x2c\xf1\x59\x8c\x9f\xdb\x2d\x6b\x02\x0f\xf0\xdb\xf0\x62\x34\xa0\x6e\x97\xac\x10\xe7\x6e\x30\x5a\x44\x78\x76\x4e\x28\x84\xf5\xcf\x77\x6e\x25\x03\x46\x10\x7b\x77\x4f\xeb\xb3\x1f\x0b\x77\x6b\xc9\xda\x03\x3f\x56\xed\x4c\x01\x66\xb9\x56\xde\x1b\x35\xbc\xd6\x62\x0f\x3a\xcf\x5e\xd2\x6a\x0f\xcd\xe8\x19\xee\xa2\x97\x99\xd9\x91\x00\xc1\x35\x2d\x0f\x9c\xda\x87\x81\x2c\x6d\xb2\x47\x72\x37\x18\x44\x25\xa7\x79\x8a\xc1\x53\x11\xab\xb9\xc8\x7e\x33\xb0\x81\x2f\x1c\x2e\x04\x7c\x89\x28\xa8\x42\xf8\x39\x66\x81\xac\x4f\x10\x59\x77\x80\xce\x27\xcb\xb0\xb2\x39\xe0\xa4\x7d\x36\x02\xbf\xc2\xc3\xed\x8b\x33\x97\x29\x42\x9e\x3a\x99\x0b\x24\x2d\xd2\x31\x17\x62\xd5\x95\xdd\x51\xdd\x1a\x56\x29\x05\xa8\x94\xad\xc5\x33\x63\x27\x5e\xfc\x95\xdf\x04\x7d\xf5\xad\x8a\x0d\x9e\xf2\x91\x16\x8f\xd7\xc9\x1a\xfc\x1e\x89\x8a\xad\xc2\x24\x44\x77\x1d\x2d\xd1\xbf\x34\x8c\x01\xe0\xa6\xb2\xb3\x1b\xbe\x5f\xd7\x42\x52\x58\xc6\xa5\x1e\x46\x59\xa7\x29\xb0\x0d\x66\xc3\x71\x0b\xc3\x19\x14\x58\x2d\xc8\x7f\xab\x54\xc2\x6e\x5f\x62\xb9\x08\x19\x8a\x78\x18\xd0\x3d\xec\xf1\xe8\x6e\xb3\xb8\x00\x2e\xda\x59\x1c\x6a\x13\xf4\xce\xaf\xb0\x9a\x8e\x52\xc3\xfa\xab\x7b\xf7\x4d\x07\xa6\x88\x41\x62\x6c\x12\xb0\x8e\xe1\xfd\x22\x6b\xe7\x6b\xf8\xb7\xb8\x88\x6b\x1d\xda\x10\x65\x3a\xa3\x1e\x2f\x42\x36\x85\x86\xef\x00\xe0\x93\x27\xe2\x4f\x56\xfa\x3b\xa3\x51\x75\xb5\x0f\x04\x5a\x20\x66\xfc\x32\xe9\xc7\x78\xd8\xda\x04\x2b\xda\xbe\x61\x5a\xc4\xd5\x88\xf3\x63\xb9\x16\xc1\xcc\x19\x88\xc3\x44\xd3\xf9\xce\x80\x15\xdf\xf7\x70\xef\x42\x19\xae\xe6\xc6\x6b\x0e\x59\xb9\x4b\x39\xe2\x44\x1f\x24\xc4\xe2\x67\xec\x50\xff\x73\xb8\xe4\x70\xcf\xd9\xad\x1a\xd3\xb6\x61\x67\xcb\x7f\x1f\x11\x47\x3e\x9b\xa0\x00\xdd\x3a\xba\x0c\x75\xbc\xfb\x1b\xeb\x3d\x1f\xaa\x19\xbf\x1c\xe0\xf2\x7c\x37\x09\x2f\x88\x5b\x47\xff\xc2\x3a\xbb\x4a\xc6\x37\xb4\xb5\xf9\x2a\xd8\xed\x2b\xcb\xad\x8a\xe2\xf5\xbc\xb2\xfb\x58\xa0\x74\x8e\xdc\xb3\xab\xdc\xe3\x58\x72\xa9\x6b\x27\xe3\xcd\xab\x7d\xfd\x0e\x34\x38\x7f\xdf\x48\x25\xd2\xaa\xcf\x5a\xd2\x04\xd2\xe1\xea\xf7\xbc\x66\x18\xf0\x55\x8a\x67\x66\x6d\x76\xc1\x39\xbf\x1b\x2c\xd0\xd9\x42\x16\x03\xaa\x94\x55\x01\xab\xb4\x92\x52\xf7\x28\xf3\xe7\x24\x3e\x0d\xa7\x9e\xb3\xe9\x9b\x68\x81\xea\x90\x6f\x9f\x09\x95\x2c\x69\x04\x7c\x53\xd0\xbf\x4b\x0b\xd8\x2c\x07\xbb\xbd\xbf\x4c\xda\xeb\x96\xb9\x28\x5e\x36\xaf\x3f\x0d\x97\x67\x1b\x3d\x9f\xb9\xc9\x4a\x84\x1f\x26\xe1\xdf\x10\x1e\xdc\x6f\xec\xd9\x85\x80\x63\x02\xa6\xc7\x15\xca\xf5\x91\xb9\xf4\x18\xca\xa9\x3a\x5f\x51\xb2\x96\xcf\x85\xf4\x70\x4c\x2a\x88\xb6\xe7\x0a\x4c\xd8\xdf\xe5\x87\x1f\x3b\xa0\x23\x8b\x83\x92\xf8\x91\xee\x94\xfa\x1a\xdb\x1e\x5a\x47\xa0\x5a\x30\xa6\xe6\xfd\x8f\x5c\xa6\xa8\x2f\x7e\x32\x7a\x77\x73\xce\x66\x9f\x55\xb7\x9b\xa2\x8a\x7a\x5e\xf4\xf0\xf8\x58\x0c\x9d\x04\xf2\x5e\x43\xb9\xb4\xa8\x8b\x6c\x3b\xb3\x54\xea\xb9\x86\x48\xf3\xe8\xfa\x96\xf1\x52\x65\x25\xd3\x8c\x93\x06\x6a\x68\x1f\xb5\xda\xa8\x9d\xa4\xdd\x37\xf7\xdb\x57\xcb\x83\xc5\x0e\x58\x35\xe2\xcb\xff\xb8\x64\x18\x54\xc3\x8b\x8e\xfc\xe4\xd7\x8a\x87\x8a\xe7\x4b\x6a\x0b\x33\x23\x36\xad\xd5\x5b\x9e\x7d\x57\x00\x2f\x63\x09\xe6\xe7\xe3\x2c\x84\x53\x81\xcd\x04\xe6\x6f\xa8\x8d\x41\xfc\xd7\x7e\x6c\xfc\x62\x29\xb6\x5d\x92\x19\x73\x0d\x57\xd0\x57\xc9\x89\x26\x7f\x23\xf0\x2c\x6e\x44\xf1\xae\xfb\x4f\x3b\x8f\x17\xad\x7a\x97\x97\x80\x41\xcb\x26\xc5\xcf\xe1\x7d\xda\x22\x9e\x72\x6a\x7e\xfc\x0e\xe5\xc4\x8c\x57\x79\x96\xd4\xdd\x89\xf4\x70\x7c\xd4\xed\x27\xcc\x21\x90\x8c\xe5\x65\x76\xa8\xa8\x7a\x17\x78\xd9\x09\xe4\x2f\xb6\xeb\xa9\x6d\x21\x74\xad\xce\x63\x6d\x31\x4c\xb4\xea\x59\xcb\x86\xba\x62\x4b\xbc\x89\x7b\x1d\xd7\x32\x3c\x5c\x52\xdf\xed\x86\xfa\x0f\x7a\x81\xec\x81\x6c\x12\x1b\xc6\xfb\xcf\x61\xee\xd0\x1c\xd1\xf8\x5e\x15\x1a\x0f\x0e\x29\xf8\x8b\x2f\x58\x35\x05\x81\x09\xaf\x76\x54\x44\xf5\x9f\x74\x83\x23\x70\x93\xc4\x17\x4f\xe1\xc3\xca\x18\x44\xdd\xcd\x49\xd3\x6e\x6d\x10\x96\xac\xb9\xbc\x5f\x74\x3f\x82\x05\xfe\x02\x6b\x88\xe2\xf8\xb3\xbc\x41\xdd\x4a\x5e\xfb\x43\xf4\x95\xda\x2e\x83\x07\x92\x71\xc6\x00\x5f\xac\xe8\xfc\xb2\xa4\x59\x13\x82\x7e\xdf\x81\xb3\x14\x79\xa4\xe6\xe4\xdd\xc3\x9f\x21\x64\x8e\x2b\xf3\xa3\x5a\x87\x8f\xee\x5a\x6c\x19\xd0\x4e\x64\x6e\x4e\x11\xeb\xc3\xe7\x8f\x27\x5e\xb3\xdd\x01\x60\xcc\xaa\xd0\x84\x51\xf9\x0a\xf2\xb4\xa4\xc4\xe4\x35\xd8\x88\xf2\x1a\x33\x91\xad\x9b\x8f\xf7\xb1\x7e\x9c\xa1\x6c\xef\xc3\xab\x04\x0c\x58\x8f\x8a\x72\x25\x8a\xd3\x89\x66\xad\xbe\x38\x88\x14\x68\xbd\x4a\xe9\xb3\x29\xc3\x43\x23\xed\x0a\x76\xde\x80\x3e\xac\xb7\xc4\x13\x39\x5e\xd4\x80\xe0\x0e\x21\xdf\xbe\xf2\xa3\x6a\xcf\xb7\xe6\x7e\x0a\x51\x1b\xd6\xd9\x17\x3f\xf7\x9f\xb0\x19\x04\x4f\x53\x71\xa2\xed\xc3\x45\x3e\xcb\xec\x53\xe0\xee\xb3\xd1\x4f\xdd\xf7\xbd\xb0\x4e\x2b\xb7\x6d\x32\x09\xa1\x82\xec\x10\x90\x44\xdf\x6a\x5e\xa8\x4b\x7b\x81\x58\xa0\xa4\x7b\x91\x01\xf1\x31\xd7\xd2\x2d\x49\x66\x74\xbb\xc9\x43\xc4\x62\xbc\xda\xee\x8a\x7e\x7a\x6d\xc7\x53\xe7\x4c\xba\x64\xfc\x03\xea\x52\xa0\x9e\x8f\xb1\x78\xea\xa4\x7e\x78\xfa\xbb\xb4\xd0\x21\x6f\x32\xa0\xc2\xd3\xd9\xfc\x90\x05\xbc\x53\x77\x91\x43\xce\x7e\xae\x37\xfa\xdd\xcb\x88\x77\x71\x04\xd5\x27\x6c\x8b\x1b\x2c\x51\xfd\x0c\x34\x20\x22\x77\x7c\x5c\x1b\xf1\xc3\x42\xbb\x0a\xe5\xd5\x35\xb6\x89\x13\x40\xa9\x8e\x6d\xaf\xb0\x10\x9a\x11\xef\x5f\x3e\x2b\xed\x9e\xe3\xd5\xaa\x72\x8d\x63\xa7\xf7\xa9\x20\x1d\x31\x23\xd4\x15\x78\x03\x3b\xac\xbd\xcf\x27\x9c\x77\x13\x70\x30\x98\x25\xc8\x6c\x8f\x1a\xa9\xc3\xa7\x14\xe6\xcf\x0e\xd7\xa4\xec\xb3\xdc\x20\x90\x37\xcd\x96\x8f\x72\xa6\xc3\x6c\x6e\x45\x15\x66\x4a\x5f\x8f\xb4\xdf\x8d\x09\x78\x27\xb7\x16\xe0\xcd\x11\xb0\x2c\xc0\x7b\xdf\xec\xd6\x2b\xe3\xfa\xd4\x11\x01\x55\xdd\xba\x0b\xd9\x53\xde\x18\xac\x71\x1f\xaf\xf4\x1e\x56\x38\x0f\x00\x59\xb5\xed\x1a\x77\x1c\x13\x62\x1a\xc3\x76\x2b\xc9\xf0\x23\xec\x8d\xfb\x6a\x27\x02\xb7\xce\x0a\xfa\x96\xaa\x70\x55\xf2\xc0\xf6\x38\xfb\x94\x32\xbc\x34\x11\xdd\x9c\xe9\xf5\x17\x5b\xb4\xc7\x62\x78\xba\x4a\x8c\xb3\xab\x65\xc8\x41\xf9\x06\xfa\xc0\x1b\x67\x5e\xe3\xd2\xea\xe0\x3a\x5d\xb5\xeb\x7f\x11\x11\x1d\xb3\x3b\xb6\x3a\x30\x18\x5f\x75\x37\x05\x1f\x50\xd1\xa8\x96\xfd\x79\xaa\xc9\xaf\xe0\x6f\x3f\xc4\x43\x16\x0b\x2d\x7c\x96\xef\x41\xac\x1c\x48\x59\xe9\x6b\xe1\x50\x27\x3e\xf2\x5e\x1b\x22\x19\x04\xbd\x82\xa3\x26\x08\xd7\xed\x07\x70\x7c\xe4\x6d\xc2\x0f\xb3\x55\x65\x3e\xd2\x24\x27\x03\x6c\xee\x04\xe1\xd8\x3a\x69\x62\x82\x84\x3b\x1d\x00\x6f\x9f\x69\x47\x1c\x4d\x05\xf2\x74\x8d\xf6\x49\xe8\x6a\x21\x56\x7b\xea\x62\x56\x8b\x9b\x74\x3e\xb8\xf4\x48\x6d\x02\x11\x5c\x41\x48\x8b\x65\x3a\x6f\x84\xe4\xf7\x26\x35\xad\x68\x33\xf1\x59\x07\x0d\x0d\x2a\xf7\x7b\x40\x22\x6b\xde\xf0\xe8\x23\xfd\x86\xc8\xb3\x4d\xb4\xef\x08\x8f\x5f\x00\xeb\xab\x92\xad\x6f\x88\xb3\x97\x90\xc3\x41\xdd\xc8\x1f\x4c\xab\x19\x1a\x6a\xec\x29\x4e\x75\x86\x26\x21\x0c\x56\x3d\x37\x71\xcf\x79\x8d\x6c\xb4\x7e\x4a\x79\x69\xef\x17\xc3\xfe\x9d\xbd\x1f\x4e\xbc\x53\xa1\xd9\xa6\x76\x24\xb0\xaf\xd8\xba\x8b\xb7\x70\x6a\x5d\xe8\x35\xc1\xd2\x89\x3c\xa7\x5a\x09\x7d\x8b\x26\x16\x8e\x03\x9b\x68\x0d\xd6\x20\xf5\xc9\x74\x54\x98\x16\x3b\xc6\x63\x45\xbb\x95\x3a\x52\x77\x36\x1c\x6c\x8a\x0b\x18\xd3\x4d\x82\xf4\xb2\x09\x6d\xd9\x33\x42\x8a\xf5\xc7\xb9\xfc\x6f\xf4\xe6\x96\x63\x6a\x15\x23\x4c\xa8\xea\x1e\xf0\x73\x08\x6d\x51\x9e\x3f\xee\x20\x10\x4c\x3c\x6d\xf4\xe1\xc2\xec\xe5\x95\x7d\xd0\x58\xb1\xe2\xea\xe2\x7e\x1b\x10\x9e\xda\xdf\x4f\x04\x5d\x56\x27\x69\xd2\xb7\xd8\x8b\x83\x59\x8e\xc7\x84\x3d\x46\xc5\x35\x31\x8b\xd5\xe0\xc9\x21\x5c\x39\x6a\xde\x40\x95\xdc\x40\xc1\x39\xf6\x95\x7e\x4e\xaa\xdf\xb9\x66\x7e\x7a\x4a\x5c\xb1\xc2\x45\x58\xd4\x02\xc9\x17\xfc\xe0\x83\xdd\x66\xd7\xdd\x0b\xc1\xe2\x4d\xeb\x0c\x82\x85\x7c\x70\xc5\x95\x90\x55\x28\xc2\x29\x8d\xc5\xaa\xf3\xb9\x41\xae\x1b\xef\xc5\xd9\xbd\x03\x4f\xf8\x44\xbb\x46\x1d\x9c\x81\x0d\xab\x26\x6d\x9d\x58\xe3\xef\xb1\xc5\x69\x06\x6a\xd6\xc0\x77\xef\xf5\x05\x3e\xef\x0f\x3b\xc7\xd3\x89\x0d\x38\xa0\xf0\x42\x9b\xa8\xdf\x3b\xb8\x62\xab\x13\xe5\x70\xf5\x93\x3c\x65\x50\xcb\x04\x7a\x6b\x7e\x01\xa7\xe3\x45\x2b\x65\x63\xb2\x03\xeb\xb2\x93\x63\x96\xe9\xdb\xc1\x4f\xb5\x86\xbf\x40\x4b\x74\x1b\xc9\x8d\x96\x01\x2e\xf4\xe1\x88\xf9\xa0\x8c\x13\x17\xd5\xdc\x9b\xd2\x6d\x42\x2b\xb9\x63\xbe\x83\x27\x1d\xed\x82\xb7\xcb\x9b\x9f\x17\x0b\xe4\xde\xba\x19\x45\x19\x58\x7d\xc0\x56\xb9\xe4\x8a\xe7\xd1\xcc\xf1\x27\x34\x50\xee\xec\xd4\x10\x5d\x52\x3e\x49\x79\x4d\x2a\xb7\x08\xfe\xa6\xdc\xda\xc9\x0a\x2e\xd7\xea\x65\x3a\x3a\xfa\x55\xd3\x5a\x56\x12\xbc\x03\x08\x58\x3c\xda\xb4\xf9\xc1\xe4\x4c\x2f\xb2\x8d\x6e\xc7\xf5\x7e\x87\x0d\x7d\x7c\xb8\x4d\xb5\x2c\x5b\x7f\x3a\xac\x24\x18\xdd\xa9\xcb\x8a\x0d\xa3\xa6\x50\x00\x5d\x79\xba\xd1\x0a\x43\x83\x93\x55\x4b\x73\xd0\x95\xf5\x62\x1b\x79\xcc\x0d\xb7\x68\xba\xb3\x9d\x58\x77\xba\xa8\xd6\xfe\xa7\x1d\x09\xed\x32\xa0\xb0\xb5\xed\x60\x28\x5c\x64\x0a\x7f\x10\x6e\x8a\x75\x5d\xdc\x68\x0d\x0d\xac\x64\xfd\x1f\x1e\x84\x24\x74\x64\xd8\x30\x88\x1f\x7d\x38\x95\x31\x05\x83\x40\x77\x3b\x48\xeb\x9f\x15\x03\x35\x20\x34\x67\x10\xd6\xba\xcf\x73\xcb\x5d\xa4\xef\x52\x67\xaa\xe8\x61\x44\xdb\xb8\xad\xd9\xb4\xbb\x13\x8e\xe6\x62\x1a\x0c\xc6\x77\x00\xe9\x3f\xb6\x10\x6b\xd9\xaa\xa8\xac\x26\xba\xb1\x88\x06\x38\xeb\xe9\xb1\xc3\x99\x87\x68\x4f\xf5\x5b\x8d\x58\x52\xf3\xb2\xd9\xe2\xc8\x5f\x6b\x59\x75\xce\xb8\xbf\xdd\x9d\x75\xba\x63\x2b\x32\xa9\x29\xa8\x0f\xe6\xe2\xd3\x66\xce\x53\xe3\xed\xc4\xfe\xe9\x23\x43\x14\x59\xe7\xc5\x5b\x50\xa4\x88\x22\x50\xf2\xd3\x40\x67\x5f\x48\x70\x05\xfe\xf8\x69\xc2\x28\x1e\xcf\xaa\xba\x9c\xe6\xc9\x65\x31\xf4\x31\xd6\x72\x68\x7d\x04\xc6\x0c\x37\x05\xd5\x32\x6b\x65\x69\x9c\xda\x9e\x18\xf8\xe8\xc4\xb2\x90\x75\x78\xa6\x80\x54\x31\xe8\xf7\x68\xf7\x43\x47\xbb\x4d\x97\xe6\x7b\x88\x01\x67\xba\xa0\xa7\xff\x37\x7f\x73\xfe\x25\x82\xeb\x81\x2d\x58\x78\xd1\x29\x47\xcd\xdc\xa9\x5a\x7e\x05\xff\xe3\x2a\x5b\x0f\x6e\x3d\x67\x57\x2d\x59\xd2\x94\xff\xf9\x19\x97\x26\x8e\x27\xc7\xe4\x0a\x14\x52\xb6\x2b\xb8\xba\x04\xc4\x2b\x22\xf7\x5d\x92\x5f\x27\xc9\x36\xb5\x2d\x3f\x71\x5b\xc3\x09\xa6\xe8\x16\xfb\x8e\x7b\x0e\x9f\x03\x96\x37\xfa\x47\xc1\x0f\xc9\xca\x92\x64\x69\x45\x1a\xab\x39\xfa\x35\x45\x2b\xb6\x71\x46\xf0\xb5\x91\xfa\x30\x22\xe1\xd7\xe1\x2b\x0b\xe2\x94\x96\x3e\xcb\x32\x89\xb1\xd3\x97\x26\xc9\xd9\x85\xf9\x0e\x53\x58\xce\x6a\x28\x7d\x18\x6f\xe6\xd8\x82\x9a\x8b\x1e\x2c\x58\xec\x8a\x23\xf9\x6d\x39\xfe\x63\xc9\xec\x1d\xb8\x31\x59\x78\x1e\xa6\xb5\x6c\xe7\xad\x3b\x6f\x59\xda\x40\x64\xe4\x47\x99\x2f\xd9\x25\xeb\x42\x7f\x66\xa2\x97\xd1\x3b\x62\x28\x7f\xff\x18\xfb\xb7\x87\xd6\xf9\x01\xc4\xcf\x15\x03\x06\xe8\xf0\x75\xfc\xc4\x7c\x8e\xc8\x6d\x08\x79\xd9\x14\x57\x21\x1f\x93\xed\x98\xe0\xd8\x50\x20\x6c\x70\x32\xf2\x7a\xc2\xef\xad\x83\x2e\x4a\x07\xef\xaa\xb1\xe4\xff\xe6\x4e\x19\xf0\xe8\x54\xbe\xef\x53\x20\x8c\xe8\x39\xfb\xd6\x4a\x38\x91\x68\x40\x53\x0b\x1f\x7f\x09\xda\x15\x1c\xe6\x8f\x83\x52\xe0\x52\xc4\xf2\xdf\x4a\x61\xba\xd8\x0b\xcd\xd2\xf8\x9c\x17\x8d\x05\x31\x1b\xc6\x70\x5a\x44\xd9\x46\xe4\x61\x85\x59\xcf\x4e\x8c\xe4\x36\xa4\xdc\xfb\x4e\x49\x22\xa9\xc4\xff\xbe\xec\x80\x58\x50\x23\x7c\x4f\x82\x9c\x2a\x8f\xae\x4b\xd8\xb7\xf0\x02\x09\x72\x7d\xcc\x2c\x62\xe6\xf4\xbb\xe5\x1b\x26\xc5\xa1\x63\x1d\xcf\x8f\xa2\x4c\xf4\xb0\x8f\x29\xd6\x01\x31\x1d\x35\x63\x18\x41\xb0\x89\x40\xd7\x45\xc1\x9e\xa6\xfa\x86\x23\x20\xc5\x86\x74\x77\x38\x6e\x19\x89\x9c\x13\x07\x39\x21\x67\x04\xe8\x72\x34\x83\x60\x44\xc7\xed\x17\xa3\xc4\x31\xb2\x92\xd2\x51\xe0\x59\x11\xc6\x9a\xb7\xae\x15\x86\x5b\xc4\x31\x45\x88\xfa\x9d\x20\x95\x1f\x1d\xc5\x44\x78\x77\xc7\xdd\xcb\x82\x6d\xff\xdf\xfd\x87\xe8\x70\x0f\xc6\x42\xf7\x25\x4e\xe4\xc0\x62\xf1\x1a\x33\xeb\xd3\x49\xdb\x7f\x1a\x56\xd2\x45\x33\x01\xd4\xa9\xd6\xf4\x19\xf0\xd7\x2a\x9a\x5d\xc6\xa2\x72\xcb\x10\x39\x38\x49\x9c\x35\xc9\xa7\xa8\xf2\x3a\x38\x11\x43\xaa\x4b\x2e\x45\x31\x25\x00\x9a\x9c\x05\x82\xbe\xdb\xbf\x44\x45\x75\x3d\x15\x11\xaf\x9f\xe5\xd5\xc4\xc5\x43\x42\xd2\xf7\x7d\xf6\xbe\x43\xd8\xc6\x8f\x45\xdb\xe5\x95\x3c\x0c\x89\x6b\xbf\x87\x77\x9a\xf4\x4c\x5e\x5b\x07\x26\x8a\x20\x4f\x81\xfd\x91\xe1\xf3\xf2\x38\x50\x26\xa4\x22\x1a\x4d\x1e\xaa\x5c\xda\x11\x32\x8f\x7c\x75\x29\x20\xc6\xf1\x30\xb4\x7b\xdd\xab\x64\x6c\xd6\x43\xb6\xdc\x3f\xc6\x67\xbb\x6a\x55\x84\x93\x88\xba\x5f\xed\x35\x86\x04\xe5\x18\x9c\x3d\x8e\x34\xfc\x28\x16\xbb\x34\x6b\x14\x10\x72\x24\xd0\x19\x65\xcb\xd4\xc4\xa1\x87\xda\xa1\x3e\x09\x3e\xdc\x45\x1e\x73\x73\x50\xf9\xe9\x20\x10\x31\x5f\x01\xfb\x4e\x67\x6f\x71\x3f\xfe\xb1\x6b\x0b\x65\x07\x65\x9d\xb4\xd4\x50\x2d\x37\x56\xd5\xb3\xd6\xf4\x71\x9b\xb2\xb2\x48\xda\x60\x23\xd6\x8c\xf5\x69\x26\x0b\x54\x3b\x5e\x1d\xff\x82\x13\x45\xc8\x6b\xad\x86\x39\xfa\x0e\xa9\x46\x4c\xa6\x51\xeb\xdd\x7f\x73\x76\xc4\x64\x92\xc3\x24\xe4\x70\x0c\xb9\xbf\x29\xa2\xee\x61\xb1\xe3\x47\x41\x2a\x4c\x66\xe8\x94\x74\xd0\x1f\x08\x97\x99\x45\xcf\x30\x39\x1c\x88\x4b\x0a\xaa\x57\xa4\x0b\x5a\xea\xcd\xcf\xd0\x20\xa3\x26\x6a\x3f\xf4\xdc\x9e\x8e\x19\xfb\x4a\xfe\xf5\x38\x50\xa8\xc9\xb3\x4a\xeb\x81\x1f\x3e\xf6\x72\xc4\x1b\x8a\x31\xed\x44\x2a\x44\x96\x48\x92\xc4\xb9\xa3\x1b\x1a\xfa\x07\x92\xfa\x3e\xa6\xb1\x9f\x43\x74\x3f\xee\xad\x67\xcf\x9a\x7c\xc6\xd0\x12\x70\xee\x88\x7c\xca\xd9\x8f\x95\x5f\x2d\x02\x54\x94\xb0\x79\x2a\x9d\x1d\x80\xef\x3d\x93\xad\x6f\x78\x06\x65\x25\x6b\xce\x69\xb8\x50\xb1\x83\x7f\x16\x0d\x79\x61\xeb\x3a\x1e\x5c\xe7\x45\x93\x8f\x02\x4e\x44\x19\x6b\x1d\x00\x29\xde\xba\x61\x25\xba\x84\x5b\x7d\x69\x64\x7f\x46\xc2\x90\xc2\xcf\xd9\xe5\xc4\xf2\x22\x55\x01\x78\xf1\x47\xe8\x94\x87\x6a\x6f\x69\xb2\xd5\xdc\x8f\x35\xae\x41\xbf\x0b\x40\xf3\x0f\x95\x78\x34\x14\x4c\x25\xa0\xc8\x0c\xc3\xe8\x76\xf3\xb7\xf7\x42\x9a\x43\x01\x35\xbc\x51\xd9\x76\xe2\x8c\x26\x01\xf1\xd8\x1d\xc7\xcb\xec\x62\x01\x05\x07\x5d\x4e\xe0\xf3\x09\xb6\x44\x8f\xce\xf0\xb1\x7d\x10\xc9\x17\x12\x19\x38\xd1\x6b\x6c\x49\xe9\x10\x07\x0f\xbf\x70\xe6\xc1\x41\xc5\x85\x6c\x21\xe7\x80\xf3\x42\xd2\x96\xc9\x61\x21\x2b\xb9\x76\x17\x15\xb8\x15\xe4\xb5\x86\x0b\x24\x66\xa9\x0b\xb2\x34\x0c\xf0\x4f\xd1\x37\x86\x41\xd6\x5c\xb7\x72\x98\x62\x55\x8b\xeb\xd7\x31\x71\xeb\x0c\xf3\x43\xb6\xc8\x5a\x0f\x9e\x26\xa4\x59\x53\x23\xf1\x1e\x15\xb7\x88\xa5\xaa\x78\x3d\xa3\x42\xca\xa3\x10\x04\x69\x0f\xbf\x2e\x52\xc5\xd7\x12\xae\x6c\x6e\x54\x3c\x79\x9e\x0a\xa0\x07\x85\xf7\xd3\x86\x33\xb7\xcb\x2e\x84\x99\xcd\x4a\xb4\xe4\x54\xd4\xc6\x61\x61\xff\x36\x18\x75\x88\xc8\x99\x2e\x37\xff\xfe\x27\xbe\xbf\xbb\x74\xe8\x28\x77\xcc\xff\x53\xa2\x9b\x69\xb6\x10\x70\x29\x0e\xe9\xc2\x9e\xf9\x8c\x0d\xa2\xae\x03\x04\xc4\xae\x53\x9e\x48\x45\xb6\xe5\xa8\xe0\xad\x86\xed\x3b\xd0\x06\x0e\x86\x6c\xb6\x68\x9c\xe4\xb7\xc1\xee\xc8\x88\x0b\x8d\xa8\x10\x20\x04\x51\x93\x90\x13\xc5\x0f\xcd\xfe\x7c\xce\x87\x72\x08\x8f\xc8\x2e\x31\xd9\x87\xdd\x8f\xf5\x18\x98\xd9\xfe\x52\x41\x51\x90\x1e\xf9\xbc\xd6\x91\xa8\xf2\xf5\x17\xf4\x20\x75\xd5\x16\xed\xef\x76\xcb\x8e\x55\x00\xfa\xa4\x3c\x1d\x7a\xeb\x73\x88\x48\xeb\x10\x20\xcf\xd6\xd3\x49\xd2\x06\x42\xbb\x5e\x3b\xac\xa8\x76\x80\xea\xdb\x0f\x01\xd7\x99\x58\xe0\x45\x07\x69\xec\x1c\xa8\x79\x89\x06\x16\x6f\x28\x5a\x7c\x1a\xee\xc6\xdd\x61\x4d\x98\x29\x3d\xef\xe0\x6a\x89\x44\x9e\x0d\x37\xa4\x71\xcc\x16\x38\xac\xc7\x39\xc0\xcb\x08\xed\xb2\x80\x74\x12\xb2\x56\x37\xfe\xd0\xd9\x98\xa2\xb0\x4a\x5c\xa5\x91\x0d\x48\x68\xea\xdc\xde\xde\x06\x3e\xd3\x51\x3f\xb1\x4c\x24\x47\xdc\xe1\x73\x02\x2c\xd4\xca\x1a\x15\x99\x62\xff\xca\x24\x2c\x8b\x29\x6e\x25\xc1\x21\xa5\x10\x20\xd1\xc1\xfe\xb5\x67\xe2\x4b\x48\x23\x88\xba\x40\x4a\xac\x64\x3c\xfe\xff\xd9\xbe\x82\xc3\xc5\xcd\x1a\x29\x34\x7f\xa8\x34\x34\x15\xb3\x37\x9d\x7a\x0a\x7e\x83\xa9\xd2\x65\x55\x96\x13\x36\x25\x5e\xf8\x3a\xe9\x52\x7c\xd0\xf2\x7b\x0d\x05\xff\x2b\xb8\x4b\x1e\x88\x26\x8d\x93\x72\x9c\xb8\x31\x45\xab\xee\x86\x1d\xff\x79\x1e\x56\x11\xfb\x7e\x79\xe1\xbd\x94\x42\xd5\x55\xb7\x80\xc0\x6b\xaa\x78\xda\xe8\xb4\x47\x12\x3e\x43\xbc\xfa\x89\x41\x7d\xea\x8f\x3f\xbd\xdb\x67\x8a\x86\x78\x4d\x1c\xcf\x9a\xcb\x7c\x50\x1e\x77\x3b\x40\xcd\xe3\xa6\xcb\x2f\xcd\xc2\xc3\xcd\x62\xba\x0b\xf0\x4e\x7f\xb1\x4f\x68\xa5\xb6\x23\xd6\x28\xa6\x37\xef\x2a\x36\x30\x31\x9e\x30\x24\x35\x78\x65\xab\x23\x3c\xc9\x72\x9a\x40\x3d\x0f\x63\x6a\xf8\xdf\xde\xe0\xd4\xb2\x76\x3a\x24\x26\x26\x54\x26\x42\xfc\xa3\xc8\x5b\x06\x51\xed\x88\x21\x4f\x58\xef\xe4\xe0\xcd\x8b\x27\x7b\xda\xfb\x75\xf7\x44\xef\xb5\x19\x3b\xc2\xb7\xaa\x00\x63\x3f\xe0\xde\xf2\x04\x13\x8f\x56\x7e\x4b\xa2\x41\x8b\x73\x37\x7c\x5a\xc1\xbe\x0c\x19\xe4\xbf\x11\x7a\xca\x5e\x04\xff\xc8\x74\xaa\x70\xe2\x85\x28\x74\xc7\x05\xd1\x68\xc2\x79\xbf\x4e\x17\x13\xd8\x25\x91\x65\x8c\x0e\xc3\x81\xdf\xda\x95\xc8\x92\x71\xa2\x76\x9a\x82\x05\xa0\xb9\x76\xc6\x85\x1d\xb6\xe6\x92\xda\x9c\x97\x74\x64\x16\x70\x5b\x2e\xb1\x3b\x00\x50\xe2\xf5\x3e\x17\xff\x2b\x3c\xd6\x5f\xc6\x4b\x99\x04\x2b\xb8\x9e\xb3\x83\x3c\x4d\x78\xe2\xd9\xea\xc0\x51\x7b\x0b\xc1\x52\xa5\xb2\xc7\xf7\x42\x84\x85\xcf\x42\xc4\xc8\xf4\x9f\x58\x30\xdb\x39\x50\x44\x37\xca\x14\x3a\x24\x10\x6d\xbf\xae\x4a\x8e\xff\x30\xb6\x39\x1f\x1d\xb2\x36\xf6\x5e\x1e\x3d\x49\x3e\x88\x74\x7f\x2f\x1c\xa2\x6c\x9c\xe0\x50\xba\x60\x7b\xca\x36\x34\x27\xdc\x6b\x7d\x91\x70\xa8\x64\x6d\x0a\x00\xfc\x2a\xcf\x0f\x25\xa9\x4d\x69\x04\xcf\x0e\xc1\x23\x65\x7e\xc2\x87\x9c\x6c\x2a\x60\x35\x07\xe7\xcb\xa5\xcf\x26\x75\xd6\x55\xf6\x29\xf3\xda\xf5\x02\xa6\x99\x90\x8b\x4b\xcd\x83\xcb\x4c\xed\xdb\x38\xc5\x26\xc6\x69\x83\x7f\x4b\x2d\x24\x33\xd6\xf3\xa0\xea\x71\x4c\x55\xe5\xcf\xc9\xfa\x2f\xd7\xf3\x40\x04\x36\xb4\x2e\x0b\x1a\x11\x22\x4d\x99\x87\x1c\x40\xf8\xec\x0f\x2c\xf2\xca\x01\xb4\x52\xf2\xfd\xd6\x18\xb7\xd7\xc7\xa1\xc6\x04\xb9\xa0\xad\x08\xb7\xc2\x99\x4a\x7e\xda\x07\xb2\xce\xc5\x27\x21\x97\xb0\xfd\x40\x89\x4a\xed\x37\x8f\x05\x8b\xf5\x43\xc7\xe2\x45\x60\xce\x70\x52\x2e\x96\xc7\xbf\xfe\x1e\xdc\x9a\x52\x57\x94\x47\xe4\x9e\x5f\x7b\x8d\xe8\x87\xc5\x8d\xa8\x2a\xc1\x7f\x9b\x86\x2b\xf0\xab\x9d\x7d\x77\xfe\xb0\xae\x8b\x5c\xb0\x7d\x65\xe8\x75\xd0\xb4\x47\x79\xf6\xad\x6c\x5a\x8b\xba\x3f\xe6\x97\xb6\xaa\xdb\xdd\x92\x54\xd5\x04\x35\x03\xa1\x92\x20\x50\x96\x1e\x11\x3e\x4b\x61\x83\xd3\x2a\x90\x02\x6d\xdb\x10\x17\xfd\xa6\xa3\x4c\x4a\x73\x89\xf9\x0d\xd7\x57\x9e\xa8\x0a\x07\x23\xd9\xdb\xfe\x17\x8f\x6a\x98\x00\x86\xed\xd2\x1c\xf2\x41\x92\xa7\xd6\xe4\x96\xd4\x84\x54\x59\x13\xae\x44\x91\xf1\x5c\xcb\xef\xee\xbe\xa1\x25\x8a\x03\x04\x67\xbc\x70\x12\xf1\x6c\xef\xa9\x28\xed\x08\xc6\x3d\x53\x07\x86\xb2\xbc\x5f\xd8\x38\x26\x41\xa5\x76\x7e\x7f\x4d\x5e\x6c\x87\xd6\x2a\x60\xbf\x7d\xbd\xe2\x04\x83\x0d\xf1\x86\xde\xaa\x9f\x07\x4e\x18\xc4\xb8\x9e\x88\x1a\xed\x7a\x06\x0c\xff\x32\xd9\xb7\x01\xbb\xbe\x80\x00\xb5\x0c\x6b\x9d\xd9\x74\xb5\xca\x84\xc0\x8a\x65\x39\x86\x10\x7d\x4f\x8e\x79\xd3\x1b\x5d\x04\xa0\xba\xfb\x49\x9d\x73\x13\x78\xbf\x92\xdb\xb3\x2f\xbb\x02\x71\x99\x9e\xe2\x30\x0f\x34\xc6\x11\xc4\xfe\x1a\xa2\x43\xb4\x8d\x14\xc3\x0e\xdd\xbb\x50\xca\xd0\x06\x5b\x85\x5a\xcf\x94\x38\x59\x95\x38\xa1\xf2\x2d\x14\xb6\x3e\xc3\xcc\x25\xb8\xcb\xe1\x5e\x33\xe5\xc8\x51\x2a\x8c\x10\xdc\x09\xec\xee\xf1\x48\x8f\xf7\xa0\x30\xca\x22\xb9\xcd\xa3\x81\xee\xde\x98\x6a\xf3\x7a\x28\x1a\xda\x10\x96\x1a\x3c\xe9\x4a\x7e\xb3\x61\x15\xfd\x4e\x62\x70\x62\xfe\x1b\x08\xc8\x4f\x27\xc0\x63\x4a\x59\x9e\x63\xda\x4b\xbf\x36\x4f\x77\x27\xb3\x38\xed\x1f\xe2\x77\xf0\xee\xf0\xf5\xfa\x3f\x45\xe3\x67\x6b\x89\x8e\xb6\xce\x8e\x41\x54\x8a\x43\x0e\x90\x52\x51\x91\x06\x2f\x9a\xfb\x48\x09\x54\x3b\x51\xb2\xf6\x90\x75\xc5\xf6\xdd\xec\x1e\xc0\x2b\xcf\x12\x7c\x90\x22\xfe\xd3\x8d\x2d\xe1\x3e\x3c\x2b\x8f\x9e\xd8\xf9\xa0\xd9\x1d\xe0\xb0\x66\x35\xae\x82\xc1\xf9\x55\x0b\x80\x95\xdf\x7d\x92\x0d\x7e\xe1\xae\x38\x99\xce\x49\x82\xdd\xb6\x72\x89\x93\xe3\xe7\x76\x62\x0e\xe3\x88\xad\xea\xed\x19\x73\xa5\xa6\xa7\xb4\x52\x1c\x17\xda\xde\x2f\x49\xf1\x8b\x79\x29\x3e\x93\x07\xfc\x08\x81\x7b\x5d\x63\x1a\xb5\x7e\x28\xe2\x5a\xbe\x2a\xbd\x44\xe4\x8b\xa9\x60\xc7\x6f\x7e\x6f\x6c\x91\x60\x3a\xac\x0e\xb8\x8e\x39\x1b\x30\x2d\x08\xac\x30\x18\x6b\xf1\x5f\x09\xcd\x49\xf6\xe3\x82\xf9\x45\xe4\x64\x71\x6b\xb3\xf5\xd9\xfb\xaf\x0b\x0b\x84\xf0\x73\xea\x18\xfd\x3b\xf5\x30\x08\x78\x60\x38\x7c\xaf\xc0\x1e\xbc\x3c\x47\xfa\x2b\xa6\xde\x37\x80\x9e\xbd\x42\x46\x0a\xeb\x26\x32\x47\x7f\x52\x7c\x5a\x10\xe4\x42\x21\x14\x44\xe7\xa0\x93\x37\xdd\xf3\xa5\xe7\x5d\x39\xc0\x56\xb2\x21\x0c\xad\x11\x1b\x62\x1d\x07\xff\x7b\xc9\x3e\x04\x21\x9f\xdd\x8c\x28\xe4\xa8\x09\x58\x47\xa2\x57\x5d\x3f\x03\xce\x6a\xf1\xaf\x63\x9f\x67\x3e\x37\x12\xbf\xa6\x3d\x82\x1a\xa6\x5d\x51\x84\xd3\x3c\xbc\x08\x32\xe2\x5f\x26\x76\xc0\x2b\x20\x1c\x12\x08\x9c\x84\x31\x94\x25\x93\x0b\x85\xf9\xde\x23\x25\x58\x42\x2f\x9f\x08\xcb\xe1\x8a\xd6\x2a\xf0\xc6\x1f\x27\x2b\x8f\xa2\x11\xa6\x0b\xde\x60\xa7\x9c\x5d\xf3\x6e\xd9\x60\x48\xe3\x2f\x73\xd7\x7b\x5f\xcd\x43\x34\x05\x73\xde\xf1\x9b\x9c\xd7\xe8\x32\x36\x44\x0c\x30\x55\xd0\x4b\x0a\x44\xa7\x73\xd1\xbd\xe4\x36\xa1\x29\x3d\xb1\x0e\x3f\x35\x25\x04\x10\x84\xa3\x4d\xf5\x58\xc1\xb6\x4e\x56\x8a\xfe\xd4\x77\xf7\x86\x35\xa6\xfd\x20\x02\x6d\xee\xdb\x4f\x07\xbf\x1a\xe3\x72\x32\x5f\x2e\xbf\x19\x5f\x67\x4b\xf7\x26\xdd\x2f\x05\x7e\xe9\x1f\xd4\x43\xcb\x74\x85\x0d\x77\x02\xb4\x4e\x8d\xaa\xc4\xf9\x6c\x04\xae\x51\xb6\xf1\xe6\xba\xb6\x50\xbc\x71\x91\xea\x10\x74\xb1\xe8\x75\xea\xed\x49\x56\xf1\xbc\x49\xb0\x4e\x08\x75\x6b\xf0\xbe\x12\xad\x4a\xa0\x30\x8c\xa9\x4e\x3d\x4f\xaa\x44\x95\xa7\x6a\xbd\xf1\xad\xc0\x95\xa4\xfc\xb2\x16\xd8\xa6\xea\x62\xbd\xf3\x74\x0a\xac\x8b\xa7\x9a\x20\x75\x26\x29\x90\x86\x81\x0d\xfb\xd6\x82\xa3\x21\xb9\xa7\x8b\x1e\x0d\x42\xc4\x99\x26\xc0\x46\x5a\x45\x70\xed\x5c\x2a\x30\xea\x38\x8c\x89\x40\x8d\x78\x12\x24\xa1\xca\x64\xd9\x43\x11\x8a\xe0\x54\xa3\xd2\xfc\xa7\xbb\x2c\x72\xe6\x59\xcf\x69\x69\x7c\xcd\x70\x02\xab\xc2\x9a\x04\x3b\x21\x56\xa9\x4a\xdd\xbf\xeb\x90\x28\x97\x6c\xc3\x93\x92\x88\x31\x77\xa8\xfa\x74\xf9\xdf\xd9\x66\x83\x5b\xb2\xd7\x72\x34\x09\x7c\xe4\x6a\x05\x6d\xcf\xd2\x99\x0c\x87\x34\x4a\xbe\xd0\xbc\x03\x48\x98\x37\x5c\x21\x67\x40\x04\x9f\xe8\x8a\x9c\x4f\xad\x04\x13\xe6\x95\x93\xa9\x53\x02\xc8\x71\x68\x5d\xb0\x3f\xb9\x6d\x77\x2b\xb9\xdc\xfe\xdc\xb0\x52\x20\x2b\x3b\x88\xf3\x28\x29\x38\x97\xb7\x57\xf2\x6b\x38\x78\x7b\x67\xe4\x76\x37\x39\x8f\x9d\x6d\x30\xc6\x9e\x5d\x1a\x56\xcd\x3c\x7d\x3b\x12\x36\xb3\xc3\x92\xa3\x47\x95\x4b\x6e\xbb\x2c\x7a\x2f\xfb\x1d\x2d\xde\xea\xf5\xd0\x1d\xb8\x28\xc8\xfa\x44\x9f\x78\xf2\x7a\xc0\xf9\x8d\xeb\x2c\xef\xe7\xfa\x49\xbd\x05\x87\xd4\xd6\xa2\x6a\xc7\xbc\x1d\x9f\x1c\x17\x66\xd7\xe6\xb5\xd6\x4b\x85\x47\x16\x3b\xe3\x9f\x45\x2d\xee\x97\x33\x0d\xd9\x43\xc2\xfc\x71\xde\x32\x14\x0a\xb5\x5b\xb3\x32\x49\xf7\xc3\xba\xa8\xe8\xb7\x21\x21\xbc\xd1\x89\x40\xa8\xcc\xd7\x7e\xdc\x55\xad\x17\x25\xa0\x86\x02\x41\xfd\xa3\xca\xa0\x1a\xf7\xe9\x42\x9d\x8a\x29\xdf\x27\x2c\xc9\xaf\xa5\xf2\x67\xbb\x62\x57\x23\x5e\xbd\xfe\x4f\x58\xd2\x4f\x43\xb0\xcf\xe2\xcb\x0f\x1d\x6b\x4f\x1b\xe6\xdf\xb2\x5a\x0e\x57\x07\x30\x05\xd4\xa4\x0c\xcb\x9a\xbc\xf6\xb7\x51\xc3\x49\x09\x1d\x2a\x7e\xc4\x48\x49\x23\x40\x6a\x42\x73\x2f\xcc\x91\xd1\x86\xf6\xd2\x6b\xdd\x6a\x41\x47\x5e\xf6\xc7\x29\x3d\xd9\x06\x0e\x07\x92\x21\xc5\x91\x8a\xca\x00\x54\xd6\x16\xe5\xd1\x9a\xec\x70\x81\xdf\xda\xfa\xee\xc6\x01\xd4\x97\x3d\x04\x7b\x28\xda\x77\xdb\xf4\x3e\xd9\x8d\xa4\x54\x2f\x2d\x96\xbb\xe0\xfd\xf4\x2e\x4f\x34\x58\x8d\x3a\x3b\xb9\xaa\x86\xd5\x96\x69\x01\x83\x4b\x13\x64\xb3\xfa\xbe\x08\xf1\x26\x4f\xe0\x1e\xe9\x98\xda\xd0\x94\x3d\xc3\x96\x13\xfc\x91\x89\xdc\x58\xf6\xd2\xa9\x22\x2e\x89\xde\xd6\x9f\xcd\x8e\x37\xbe\x62\x87\x75\xb9\xf8\xe9\x61\xdd\x4a\xa2\x18\xda\x24\x19\xec\x3b\x0d\x49\xa3\xc2\x9d\xbe\x8f\xc0\x61\x8a\xdb\x95\xe2\x05\x6a\xde\x13\xc8\x7f\xba\x99\xf1\x75\xaa\x0d\xf3\x7e\xd2\x45\x14\x10\xb3\x89\xce\x88\xd4\x71\xfd\x54\x46\xdb\xfd\x30\x3c\x0f\x54\x76\x32\x91\x89\xcb\xe9\x08\x29\x21\xfa\xeb\xfa\x8b\xf2\xa1\xa3\x1d\x43\x7b\xda\xa5\xcf\xec\x5d\x49\xdd\x9d\x1d\x39\xa4\xf8\xe9\x5e\xdb\x2a\xa1\x47\x1f\x82\x93\x04\x34\xcd\x02\x81\xfb\x29\x46\x3c\x7f\x41\xcc\x38\xcc\xa3\xa7\x0c\x0f\x6c\x27\xc3\x08\xd5\x58\x0e\x4f\xa2\x8e\x66\x16\x25\x7b\xc6\x4d\x24\x01\xb1\xc6\x77\xe7\x78\x39\x8c\x07\x01\xc1\x74\x9f\x41\xac\x92\x8f\xf3\xa6\x64\xde\x7b\x32\x5a\xf9\x9b\x15\xab\xa9\xf0\x6a\x10\x4a\xdc\x6d\xdd\xae\x46\x2a\x73\x36\xd4\x55\x92\x0b\xe5\x9a\x79\x28\x76\xae\x8b\xd3\x4e\xd3\xeb\xae\xca\x61\x5c\xb8\x9e\xc2\x8e\x4e\xe2\xca\x59\x6f\xcd\x2d\xd6\xa5\x63\xae\x47\x83\xb0\x77\x62\x23\xdc\xa2\xd1\x49\xde\xd0\x6a\xb0\x98\x79\xbb\xfc\xd6\xa1\x27\x7d\x4f\x0a\x27\x6a\x26\xda\x38\xd7\x8b\x27\x79\x4c\x39\x58\x9f\x61\xe4\x3b\x55\x4a\xb4\xa6\xa5\x97\x4c\xcf\x25\xc3\xb1\x87\x1e\xe6\x34\x81\x66\x84\xd9\x6f\xc8\x61\x65\xce\x01\x2c\x5c\x55\x0a\x9c\x85\x76\x55\x2e\x3b\xd1\x14\xf6\x8a\xd6\xf4\x0f\xd3\x46\x7f\x78\x70\x13\xcd\xc3\x4e\xfa\xa7\x22\x7f\x6d\x19\xcd\x75\xf8\xc1\xdb\x92\x5f\xbc\xf9\x91\x43\xbd\x4b\x4c\xec\x9b\x3b\x6e\x7f\x39\x48\x7e\x3c\xba\x72\x7c\xee\xef\x97\xf3\xc2\x75\xaf\x69\x40\x71\x21\xee\xb0\x02\xf0\x71\xf1\xae\x83\xcf\x26\xd0\x87\x5c\xaa\xcc\xf7\x23\x08\x67\xba\x0e\x37\x3c\x08\x36\x5d\xd8\x99\xa8\x02\xc4\xb8\x0c\x4d\xc2\x94\xf7\x6b\x9d\x63\x97\x57\xf0\x71\x6c\x06\x54\x5d\x26\xcc\xc0\x98\x6b\x4c\xbc\x98\x8f\x5b\xa4\x35\xc9\x3e\xdd\x85\x23\xe2\xe0\x46\x0e\xbb\xab\x2d\x09\xf5\xf1\xed\xb6\x34\x4b\x67\xa6\xd4\x0e\x4e\xcb\xf1\xb3\xef\x94\xe6\xeb\x52\x75\xe4\x51\x96\x14\xb1\xe1\xb0\xf9\xc5\x3c\xee\xcd\x4e\x9c\x1a\x4a\xc3\xe5\x74\x04\x46\x75\x18\x29\x8c\x6d\xb7\xd1\xd9\xde\x9e\xa4\xe4\xe4\x4e\x06\xb1\x93\x7f\xa4\x4d\x6a\x3f\x6f\xb3\x23\x54\x81\x8d\xfa\xf1\x55\xa1\x4f\x21\x0c\x30\x43\xde\xf1\x6c\x83\x0c\x9a\x94\x47\xde\xd3\x08\x97\x9b\xa4\x61\x15\xbf\x1d\x0e\x03\x43\xc7\x97\xf8\x77\xd5\x0b\xc7\xc6\xab\x15\xa5\xd4\x1a\xa5\x43\x14\xd3\x35\x0a\xe6\x9c\x96\xcd\x0e\xa8\xe5\x97\xad\x28\xcd\x53\xe8\xc7\xaf\x3e\x8e\x02\xfd\x0c\xfa\xc3\x39\x71\x9b\xf8\x4f\x4d\xaf\x27\x36\xe2\x52\x60\x80\x95\xc0\xd1\xb7\xa9\xe7\x16\x1b\xc2\x08\x65\xcd\x2c\xb5\xa4\x33\x54\xf7\x1d\xfa\x5b\x38\x18\xa0\xf6\xd2\xd1\x98\x5a\xc5\x63\xa5\x18\xae\xca\x16\x57\xcd\x91\x66\xec\xbd\x32\x0f\x55\xb3\xcd\xe2\x44\xe2\xbd\xc6\xc6\x34\x87\x52\xf0\x00\x6a\x51\x30\x70\x52\xa2\xa7\x73\x06\x1c\xec\xed\x82\x87\x4c\xd3\xea\x79\xe8\x1b\x29\xd1\x33\x38\xe7\x94\xc5\x3e\xfe\xff\x6a\x02\x84\x0e\x72\x73\xf3\xae\x0b\xa8\xa8\xc1\xc8\x31\x67\x37\xf6\x17\x07\xd5\x1c\xcb\x16\xe0\x57\x3c\xbb\x68\x2a\x78\x91\x57\x2d\xb0\xcd\xed\xfd\xed\xad\x22\x8d\x3d\x1c\x1d\x97\x86\x1e\xe6\xf4\xde\xc3\x65\xfb\xa0\xac\xd5\xed\xab\xf6\x42\x3d\x6f\xee\x5c\xe6\x72\x26\xac\x05\x6a\x05\x2a\x1e\xde\x84\x8e\x05\xda\xf8\x1e\x7d\xe0\x27\xe3\xfc\x13\x42\xc7\x50\x3f\x1d\xa3\x01\x5c\x21\xef\x02\x1e\xf3\x66\x21\xcf\x97\xbc\x5e\x8f\x9f\xa5\xee\xdd\x74\x55\x31\xd2\x68\xbf\xfb\xad\x76\xff\x6d\x5d\xb8\x0a\xd2\x86\xf9\xb6\x29\x78\x99\x53\x8c\xf2\xda\x64\x1d\x12\xa5\xe6\x12\x2f\xc4\x23\x6b\xe5\xf5\x4c\xfc\x98\x6b\x20\xe9\xad\xff\x25\xc6\x14\xe1\x35\xa0\x0b\xbe\x6e\x9b\x3b\xe5\x21\x60\xdd\x83\xc3\x08\xc8\x96\xfa\x32\x9e\xc1\x7f\xa1\xc5\x48\x52\x81\xe5\xd6\x94\x93\xf2\xd0\x23\x92\xf3\x63\x3f\x7c\xfb\x63\x42\x02\x7a\xfd\xad\xe0\x50\x4e\x41\xed\xd3\x13\x32\xc6\x2e\x5c\x20\x2b\x2a\x20\xd7\x76\x24\x0a\x1d\xe3\xff\x16\xa3\xe5\x02\xbd\xee\x03\x08\xa1\xc4\x31\x37\xa2\x57\xe1\x0e\x30\x49\x4d\x7c\x8a\x9a\xaa\x4b\xdb\x66\x0d\x86\x9a\xc6\x6c\x79\x9e\x84\xd9\x7b\x79\xbc\x26\x9d\x98\x56\x42\x9e\x1c\x45\x88\x40\xbe\x59\x4d\xfb\xae\x37\x36\x25\x5e\xce\xa3\xeb\xbf\x72\x2e\xcc\x9e\x5f\xf7\xfb\x5b\x38\x16\x4c\xeb\xb4\xfd\xa5\x8a\x20\x8d\x56\x2d\x88\x16\xa6\x37\xde\x6a\xd9\xb9\xbd\x8f\x21\x00\x65\x8b\x6b\xbe\xf2\x9f\x70\x9a\xad\xe9\xd2\x35\x20\x33\x8e\x46\x2d\xe8\xe8\x37\xf6\x6a\x3f\xab\x91\x06\x83\xc3\x4e\x7c\x7a\x82\x2a\x9a\xd8\xac\x8c\x54\x2c\x32\xfb\x6c\x7b\x17\x45\x3f\x2c\x15\x85\x80\xc6\x17\x63\x70\xa6\x96\x92\x8f\xe1\x12\x96\xd5\xbe\x9b\x08\x3b\x74\x53\x07\x6b\x59\x00\x8c\xa0\x01\x70\x8f\xa8\xa4\x67\x0c\x52\x90\xd3\x06\xe2\xe5\x4b\xbd\xb6\x67\x66\x81\xcb\xf3\x77\x24\xae\x59\x96\xa8\x27\x05\xc8\xc3\x18\x0b\xa0\x17\xb2\x4d\x49\x9e\x68\x92\x60\x3a\x2e\x3a\x12\xdc\x2b\x9b\xce\x3a\xe4\x11\xf8\x87\xf0\xfb\x7b\x81\x8e\x60\x9c\xd3\x3f\xef\xaa\x9d\x27\x3e\xf8\x42\x25\x62\x38\x84\xf7\x29\x68\x64\x09\x2f\xaa\x10\xf4\x39\x8f\x35\x91\xa2\xfb\x5f\x6e\x11\x89\x84\x9e\xdb\xb9\x2e\xeb\xd2\xe5\xc0\x7f\x00\x4d\x28\x5d\x46\x35\x31\xb4\x9f\xf7\x83\x62\x8b\x56\x3c\xe4\x08\xd9\x72\xd1\xf3\x03\xa1\xec\x70\xb3\x7f\x4b\x66\xb4\x79\x63\xbe\xd5\x3a\xaa\x71\x35\xc2\x33\x26\xf9\x67\xae\xbd\xb2\xdf\xce\x57\xa5\xd3\xb4\x31\x46\x01\xf4\x2e\x14\x4c\xb9\x6d\x4c\xeb\x08\xbd\xf5\x5f\xb9\xed\x5a\x33\x7b\x50\x31\x22\xd7\x4d\x74\xcf\x47\xaa\x6a\xc8\xe8\x20\xb3\x08\xce\xeb\xdf\x35\x29\x89\x91\xfc\xed\xb1\x86\xde\xf0\xe9\xe5\x64\xeb\x82\xe0\x6a\x8b\x8c\xaa\xbe\x7a\x17\x59\x7d\x16\xfd\xb7\x99\x42\x7f\x09\x24\x09\xb7\x58\x80\x61\x45\xc4\x41\x63\xa9\x92\x7b\x40\x31\xe5\x71\xe0\x9b\x5c\x1d\x91\x1a\x6e\xb1\xad\x6c\x3c\x1c\x41\x08\xfb\x67\x25\x0f\xb4\x9b\x04\x5d\xe9\xff\xa0\xe4\xf7\x5c\x70\x6c\x7b\x98\xf2\xdb\x19\xab\x95\xd8\xe9\x90\x13\x1f\xf8\x1b\x08\x19\x61\xda\x5a\xea\x6e\xe3\x8e\x67\x49\x1f\xd1\x1f\xec\x82\x3c\x57\x58\x80\x53\x4f\x0d\xab\x82\xbf\xb1\xb8\x92\x40\x4c\x9a\x9b\x02\xeb\xa1\xbf\xf1\x45\x95\x42\xbd\x09\xb7\x4c\x07\x79\xa4\x19\x16\x10\xdd\x2f\xf2\x0f\x72\x40\x54\x91\x74\x01\x4f\xac\x40\xd6\xb6\x8c\xad\x8e\x41\x8a\x25\x53\x73\xb7\xdd\x74\xcb\x0d\x69\xc0\x79\xb1\x2b\xc7\x94\xbb\x22\x0a\xc5\x24\x8d\x8f\x1b\xb1\x8e\xe5\x8f\x07\xb1\xe7\xd0\x9b\xf8\x56\x81\x4e\x11\x05\xb5\xde\xed\xef\xfa\xc5\xc9\x97\xf8\x14\xdf\x76\xf4\xc8\x0b\xe4\xa0\x83\x4e\x50\xd6\xa1\xc1\xc6\xc5\x78\xb3\xe9\x28\x63\x1f\x8a\x6a\x55\x26\x16\xc5\x57\xf8\x91\x7c\x9d\x99\xf2\x3e\xef\xa4\x5b\x3d\x9a\x62\x8f\x78\x55\x00\x85\xb7\x3c\xd6\xb1\xff\x8c\x53\x99\x03\x11\x94\xb8\xe7\xaa\x2e\xd1\x6e\x6d\x93\x82\x49\x84\xf7\x47\x69\xe9\x70\xa2\xb7\x8e\x82\x7f\x76\x30\xc7\xc1\xca\x1c\x3b\x7b\x40\x9c\x97\x9a\xc9\xce\x12\xf4\x43\x91\x54\x3f\x5f\x38\x46\xf0\xbb\x8f\xeb\x6e\xed\xe9\x9a\x51\x72\x06\x74\x82\x3f\x55\xd8\x53\x96\xf1\x54\x89\x30\x67\xe6\x38\x57\xbb\xa4\x63\x3a\x7d\x2f\x4d\x64\x6d\x4d\x9b\x18\xf2\x63\xc3\x00\x5a\xdd\xa7\xc3\xa4\xbd\x47\x6b\x4b\x93\x9d\x17\x86\xa6\xee\xf2\x69\x82\xe5\xb5\xde\x0d\xb3\x17\xb7\x0b\x50\xa6\x39\xe1\xa6\x0c\xfd\x6f\x8a\x81\xd7\x77\x31\xe9\xd4\x48\x7e\x1a\x31\x23\x71\xf7\x3d\x6a\x7a\x07\xa7\x74\xf3\x5b\xf5\x60\xf2\x27\xf7\xb7\xda\xa2\x4f\xa9\xb6\x6b\x17\xba\x6c\xbd\xa3\xb3\xe3\xee\x76\x1e\x54\x45\xab\x95\xc5\xff\x91\xf9\x65\xc0\x61\xf8\x77\xdd\xe5\x36\x50\x93\xcd\xe5\xff\xe8\x9c\xd5\x7f\x30\x89\x04\xb0\x16\x22\x85\x8b\xd4\xd5\x31\x4e\xae\x2d\xc3\x11\xd1\xf7\x50\x2d\x5a\x69\xf2\x26\xc5\xbf\xa8\x90\x70\xfa\x03\xcf\x77\x1e\xe2\xa9\xe8\xde\xf5\xed\xf5\x06\xeb\xea\xa6\xd8\x93\x35\x2a\x39\x91\xaa\x4f\x55\xdc\x88\xad\xbb\xff\x8e\x1e\xa4\x45\x87\x67\x20\x02\x5e\x5d\x33\x98\xb0\x39\x2d\x64\x81\xc0\xdc\xb3\x07\x5f\x36\x85\x24\xa4\x53\x48\xe8\x75\x62\xfc\x20\xd9\x90\xa9\x19\x4e\xc7\x78\xa9\x62\x36\xc5\x33\xe0\x14\xa2\xf8\x86\xb8\xaa\x8c\xa5\xf0\xa4\x9e\xa0\xf4\xa9\x90\xe6\x8e\x88\x3b\x54\x51\xe5\x74\xf0\xc5\x52\x8d\x43\x8b\x9a\xfd\x12\x53\x40\x9b\xe3\x0b\x7c\x1b\xbe\x74\x30\x42\x8a\x77\x6f\x0d\xe0\x14\x1a\x64\x57\x7b\x5d\x09\x6f\xd2\xeb\x92\x4d\x5d\x4a\x01\x4e\x43\xe2\x5a\x42\x7e\x9e\xda\xa7\x52\xdb\x61\xf6\x0a\x61\xd4\x5d\xf3\x74\x08\x15\x01\x2e\x79\x12\xaa\xa9\xf2\x90\xf9\xad\xb4\x52\x01\x2e\x6b\xd5\x53\xc3\xd0\xaf\x48\xe5\x42\xac\x0f\x16\x74\x6d\x84\x5c\x4c\x66\x53\xb8\x0d\x3f\x5b\xbe\x3e\xb3\x83\x85\x7b\x28\xb8\xaa\xb9\x20\xc1\x39\xbd\xa3\x1e\x1b\x5e\x9c\x38\x95\x94\xc9\x72\x93\x58\xac\x49\x7f\x72\x76\xdb\x23\x00\x54\x5b\xdd\x70\x9a\x01\xe6\x2e\x01\xcb\x19\x42\xa4\xe1\x6f\xce\x80\xfc\x3b\x3c\x48\x40\x81\x22\x25\x63\x56\xaa\x3a\xd0\xd9\xf6\x06\x3f\x63\x18\x29\xa3\xeb\x11\x5f\x34\xd4\x9e\x9b\xbc\x3e\x10\x4c\x38\x1f\xff\x75\x5b\x90\xe0\x55\xe1\x8e\x40\x16\xf0\x14\x5e\x9b\xb1\xb1\x7d\x79\x84\x15\xe4\x88\x68\x94\x99\xf8\x63\xb4\xb6\x7f\x53\xb1\x06\x44\xb1\x56\xa0\x62\x24\x41\x93\x6b\x96\x96\x4a\xbe\xc4\x87\x49\xde\x5d\xd3\x0a\xbd\x7f\xb4\x3e\xf2\x32\x2b\x3b\xd2\x49\x68\xe9\x29\xa1\x90\x3b\x59\x55\xc7\x00\x88\x6c\x2c\x53\xd2\x78\x55\x90\x0d\x02\x9f\xf7\x9a\x74\xbe\x8d\x15\x8b\xa4\xee\x26\x0d\xfd\xf5\x6c\xaa\x06\x78\xa1\x54\xb1\xa7\x28\xfb\x1a\x83\xad\x61\xd7\x54\x57\x1f\x9f\x98\x68\x29\xea\xb3\x01\x51\x59\xe3\x12\x74\xed\xf9\x63\x4d\xe9\x79\xce\x68\x3f\x0b\x5a\x3c\x78\x81\x4c\xa2\xef\xb1\x7a\xd7\x39\x65\xe6\xaf\x86\xc1\x25\x2e\xbe\x0c\x86\xf5\x15\x41\x26\x62\x8c\xd9\xa8\xa8\x63\x2c\xd9\x0d\x20\x9a\xde\x00\x79\x04\x9d\x23\xa7\x2c\xc2\xb0\x4e\x3d\x5f\xba\x59\x64\x8e\x79\xa7\x57\xcc\xa4\x93\xd7\xb6\xc7\x97\xdc\xc4\x78\xff\xe6\x65\x20\xe7\x23\x4f\x88\xf5\x56\xe5\x8f\x26\x19\x1b\xb5\xcf\x3b\xa1\xf2\x47\x21\x41\x87\x0f\xf4\x41\x18\x71\x45\x8b\x6b\xb3\x79\xa7\xef\xc7\xc0\xb8\x66\x15\xbe\xef\x07\x9b\x84\xac\x12\xb0\xfb\xcb\x60\x54\xb6\x92\x58\xa7\x5f\xa9\x31\x27\x95\xa7\x1d\x3f\x24\xfc\xab\xfb\x80\x2a\xd7\x9b\x4c\x1f\x24\x76\x96\x5b\x41\xcb\x52\x81\x02\x5f\xfc\xb3\xa8\x1a\xeb\x01\x06\xce\xdf\xdf\x2c\x54\x5c\xbf\x9a\xc5\xa0\x15\xee\x06\x8e\xa9\xa4\x2b\x87\x01\x53\x20\xeb\xad\x20\x7c\x0b\xd8\x7f\x8d\xe3\x9a\x3d\xdf\x2a\x06\x4a\x1f\x6e\x08\xf7\xe0\x8a\x99\x10\x4e\x70\xbd\x7c\xf8\x50\x9d\xa2\x64\x0f\x9c\xbf\x26\x17\x72\x6d\x10\xf9\xbd\x4c\xcb\x93\x12\x5b\x04\xdf\xda\x68\x73\x24\xa8\x6c\x62\xf0\xac\xcb\xc2\x9d\x59\xbd\xa0\xdf\xfc\x6f\xf9\x24\x97\x53\xc6\x6f\x40\x92\x28\xf8\x83\xe9\xef\xd8\xc8\x03\x3e\x50\xa3\xa8\xaa\xdf\x79\xc6\x65\x0d\xac\xe9\x40\x35\xe3\x70\x0a\xef\xa8\x37\xa6\x94\xb6\xef\x8b\x8e\xf7\x10\xbc\x95\xd3\x14\x1d\x02\xf2\x70\x7c\xdc\xa7\xdf\x66\x69\x60\x24\x62\x20\x32\xf7\xef\x3a\x3a\x76\x2d\xc3\xec\x53\x1e\x05\x50\xa3\x53\x9a\x8f\x83\xa0\x40\x6b\xa3\x52\x9d\x36\xfd\xeb\xb6\x4e\xba\x17\xcc\x6f\xca\x5b\xbf\xc0\x02\x5d\xbc\xf1\x77\x7a\x39\x95\x56\x4e\x58\xa3\xc8\xac\x4d\x6d\xcf\x44\xfc\x3c\xe8\x70\x71\x45\x27\xaf\xb9\xbc\xa7\x2e\xbf\x45\x2a\xd3\x22\x6b\xe3\xad\xdc\xde\x3f\x97\xa3\xfb\xeb\xde\x27\xf9\x0e\xcd\x64\x7d\xb6\xeb\x7c\x7a\x0f\xd7\xe3\x41\x5b\xcd\x97\xb9\x8f\xda\x2e\xf2\xd9\xcc\xdc\x08\xfc\x98\xfd\x93\x9c\x59\x38\x7e\x7e\x82\x35\xa8\x9f\xc0\x28\xb6\xfc\xe1\xc2\x88\x8a\x39\x3d\x9d\xbe\x58\xac\x80\x4e\x09\xa3\x99\x30\x92\x9d\x57\x09\xdb\x6e\xb1\xf0\x59\x01\xf5\x89\x34\x8f\x1d\xcb\xe1\xe2\xdf\x8a\x38\xdf\xc3\x4e\xbb\xb5\x85\xd5\x6f\xdb\xd7\x66\xb6\xf3\x62\xa7\xd9\xa3\x97\xff\xda\x96\x12\x7c\x96\x3b\x10\x4e\x11\xeb\xec\xf3\xdf\xa8\x5f\x4b\x6a\x9c\x9c\x47\x9f\x70\xca\xfa\x7b\xdc\x42\x5f\x21\x5e\x06\x8b\x99\xec\x0e\xbf\xe4\x73\x19\xeb\x41\x80\x4d\xf0\x12\x9e\xa1\xde\x66\x02\x39\x45\xe7\x79\x9a\x26\xf4\x2e\x8d\xd6\xef\xdc\xc3\xd6\x76\x31\x04\x29\x26\xa7\x17\xea\xe2\x04\xd1\xee\x6c\xe6\x07\x73\xfc\xe0\x00\x12\x3e\x75\x59\x64\xe7\xc7\x69\x48\xff\x5a\xc0\x90\x4d\xb9\xfc\x83\x06\x82\x52\xb7\x73\x39\xfb\xe9\x70\x8f\xe1\x3b\x72\x52\x05\x41\xe3\x30\x4c\x7b\x7e\x0c\xbd\x6d\xdd\xcc\xcb\x75\x94\x00\x35\xf0\x28\xac\x55\xc8\x3d\xbb\x45\x25\x91\xdf\x97\x69\x17\x0d\xd8\x40\x64\x6a\x36\x16\x68\x37\x48\x82\xfe\x63\xf2\xce\x35\x21\x5d\x48\x84\xb1\xaa\xc1\x95\x15\x66\x29\x64\x12\x89\x6f\x5a\xee\x27\x48\xb3\xf4\xb9\x24\xd6\x4c\x95\x9d\x1a\xc5\x56\x30\x6a\x19\xf2\x25\xbf\xdf\x36\x27\x77\x44\x48\x32\x00\xb8\xc5\x38\x44\x0c\x4a\x44\x16\xfb\xb3\xd5\x1b\x59\x77\x60\x39\x5e\x43\xec\x81\xc7\xba\x37\xc9\xd1\x1f\xad\x45\xe7\xb4\x20\xd7\x20\x75\x1f\x05\x51\x7c\xb8\xa7\x27\xc0\x48\x60\x5b\xc1\x92\x00\xc1\x81\x76\xa6\x5e\x43\x51\xd5\xf0\x9f\xac\xb6\x96\x32\xc2\x51\x00\xde\x5b\xde\x0e\x6c\xf8\x36\xe3\x47\x90\x10\xb6\xd4\xc6\xe1\xec\x7c\xf6\xa3\x8c\xe3\x85\x1b\x13\x72\x51\x75\xbf\x54\xc6\x08\xb6\xb8\xe7\xc0\xc5\x6f\x0a\x7f\x6e\x1f\x90\xd7\x13\x8f\x80\x88\xdd\x8a\xb1\x62\x6c\xb3\xb0\xdf\x84\x9f\x1a\x04\x94\x85\x74\x25\xa3\x69\x4c\x0f\xcd\x61\xd0\xa5\xbc\x63\x52\xd9\x5c\xdf\x62\x22\x05\xcf\x3c\xba\x67\x0f\xfe\x85\x99\xc6\x2d\x9e\x59\x70\x25\x3c\x85\x5d\x1d\x7b\x88\x39\xa7\xd1\xa4\x15\xd7\x9d\x05\xe4\x39\x96\x21\xdf\xd2\xac\x56\xa1\x28\x51\x0c\xdd\xa7\x7c\xc4\xad\xae\x68\x5d\xbf\xcd\x8d\x32\x97\xb6\xf0\xaf\x61\x62\x29\x33\x38\x97\x50\xbf\xe9\x72\x16\x40\xe7\x5e\xa2\x11\x92\x1b\x86\xea\xc5\xf8\x4e\x5d\xdc\x2f\x09\xc3\x1f\xbb\x36\x04\xf4\xe9\xd6\x65\x48\xb1\x1b\x02\x1c\x5e\x82\x9f\x28\xc9\xe0\xa2\x7d\xac\xe5\xaa\x33\x7f\x5a\x68\x05\x62\x95\xd3\x1d\xd5\x8b\x9a\x95\xc9\x0c\x62\xb2\x9b\x62\x54\x18\x76\x7c\x6f\xc3\x11\x51\xf0\x78\xa3\x5c\xec\x6c\xc4\xc4\x4e\x27\x4a\x2f\x6d\xe2\xeb\xe0\x3b\x05\xb6\x28\xfe\xc8\x9d\x57\xdb\x35\x18\xab\xbb\xe0\xcd\x9b\x55\xeb\xbc\xad\xb2\x33\x45\x4a\x38\xb2\xb6\xaa\xa3\x13\xf6\x98\x56\xb1\x3b\x09\xf5\xfd\x0b\x4f\x27\x2a\xa1\x7b\x90\xe4\x23\xfd\xb3\x39\xf8\xa5\xa4\x50\x9d\xe4\x77\x4b\x15\x27\x95\xe0\x48\xec\xa3\x74\xd0\x83\x1d\xca\xa3\xce\xae\x0c\x1b\x6c\xf2\x91\xa1\xe3\xfd\x90\xdb\x7a\xb4\x50\xf9\x10\x8f\x66\x17\xa9\x97\x70\x36\x25\x8e\xac\x74\xdd\xdf\xbc\x14\xec\xc4\x07\x2a\xa9\xf0\xe6\xec\x98\xf6\xed\xd4\x56\x90\xeb\x9d\xdf\x7c\x10\xb9\x76\xc7\xe7\xc6\x7a\x5c\x83\x16\x48\xdd\xfa\x91\xce\xc6\x2e\xc7\x28\x5c\x39\x06\xcf\x93\x6b\xf3\xaf\xc7\x5a\x8d\xaa\x74\xda\xe1\x0d\xd9\xbf\xe9\xab\xc8\x0e\x75\x6c\x5e\xe2\xb6\xa4\xa9\x8c\x12\x10\xb5\xfd\xe0\xb4\x0c\xd0\x15\xdb\xd5\xce\x43\x68\x52\xfb\xd5\xb0\x97\x29\x1d\x2d\xe2\xe7\x03\x98\x2a\x0d\x0c\x51\xce\xfa\xb9\x0c\x8f\x58\xde\xb3\x28\xcd\xd6\x55\x63\x17\x4e\xe7\x5f\x92\x2b\x27\xdf\xca\x92\x41\x73\xe4\x82\x19\xfb\x71\x4f\x20\x87\x47\x55\x5d\xd7\x7a\x4b\x27\xa6\x55\x05\x10\xd7\x20\x89\xa4\xab\xf6\x71\xa6\x98\xe1\x7c\xa8\x87\x2f\x48\x1e\xd6\x26\x05\x25\x75\x38\x8f\x22\x28\xb4\x5b\x19\x51\x64\x31\x82\xe4\x6b\xe1\x19\x63\x9f\xd6\x75\xe0\x6c\x57\x04\x4c\x7f\xbe\x44\x5f\xe6\x8a\x8e\xd7\x79\x2c\x65\x89\x10\x13\xe8\xb5\x7c\x9e\x1f\xbf\xcf\x41\x5b\x5b\x48\x5d\xfe\x2c\xc3\x2d\xa2\x57\x1d\xed\x96\x83\x10\x7d\xfb\x47\x39\x6f\x95\x60\x90\x8d\x8c\x10\x96\x2a\xdd\xe6\xbb\x0f\xfa\xc3\x68\xb2\xab\xa0\xf9\xc0\xaf\x71\x79\xa7\xac\xf9\x63\x03\x90\xd6\x9a\xeb\xbe\x37\x7b\xa6\xd3\x71\x82\x10\xe5\x9d\xc6\x68\xd4\xb0\x43\xb7\xf7\xdb\x11\x45\x23\xac\x4f\xa4\x51\x16\xc1\x89\x78\x55\xb8\x65\xe8\xb6\xa2\x47\x98\xeb\x28\xdd\x3c\xee\x86\xf6\x82\xb1\x55\xf1\x9c\xd9\x52\x63\x30\xc3\x5f\x74\x08\xd1\x4d\x0b\x6d\x77\x2e\x39\x0a\x42\xa6\x33\xd7\x8d\xdc\x9b\xc8\x80\x6d\xd2\xa4\x6d\x7f\xea\x33\xee\x69\xd8\xea\x49\xfb\xee\xb5\x73\xe6\xcc\x23\x44\x9d\xeb\xf6\x07\x97\xe5\x6c\x27\xb4\x53\xff\x1a\xd1\x29\x49\xec\x10\x94\x2e\x8d\x0b\x9c\x54\xe3\xc3\x21\x0d\xdf\x30\xe8\x13\xaf\x47\x51\x17\x93\x53\x89\x82\xf2\x2a\x29\x88\x14\xb0\x1e\xd5\xfd\x49\x48\xb9\x56\x0e\x02\x64\x51\xd5\xff\x9c\xd1\x65\x96\x0c\x64\xa6\x30\x55\x42\x05\xe4\x83\x8b\x6c\xc5\x1c\x08\x32\x11\xf4\xae\xdf\x1a\x6d\xe9\x60\xd7\xc6\x0e\xaf\x50\xa5\x9c\xab\x11\xef\xaa\x28\x26\xec\x6f\xe3\x39\xde\x1e\x51\x90\x0d\x9c\xc2\x35\xd2\x38\x19\x62\x29\x6f\x32\xae\x76\x89\xe6\xcd\x6f\x1b\x86\x6a\x56\x5a\x5d\x00\xbf\xa1\x68\x79\x3a\x1a\x68\x1c\xb6\x75\x7f\x6b\x6c\x26\xa4\x37\xde\x71\x10\xfb\x0e\xd0\x3b\x53\xa5\xd0\xbe\x44\xdf\x94\xef\x62\xe1\x71\x43\xd1\x7c\xd0\xf4\xc6\x52\x3a\x8f\x4e\xd6\x64\xb0\x22\xcb\x42\x0f\xd6\x36\x36\xfe\xe0\x65\x47\x4a\x70\xfd\x88\x51\x97\x48\xca\x43\xfd\x96\xdd\x33\x74\x42\xf3\x1d\x01\xa7\x37\x38\x87\x87\x60\x12\xa7\xeb\x70\x50\xa1\xcd\x8a\x4d\x11\x3d\x23\x28\x38\x5e\x6d\xc2\x70\xaf\xb4\x29\x8d\x3f\x5c\xce\xf9\x9c\x47\x80\xaa\xdb\x70\x05\x77\x84\xed\x41\x5b\x39\xe5\xb5\x3a\xa9\x07\xa4\xc4\xd1\xe0\x2b\xe9\x59\xaf\x87\x65\x1c\x35\x26\x20\x67\x45\x5e\xc9\xbf\xfb\x9f\x50\xb0\x0a\x55\xda\x95\x6f\xc3\x45\x38\xf0\x60\x64\x40\x4b\xb4\xe3\x8e\xa0\x9a\x5d\xa2\x76\x6d\x7a\xcc\x1e\x65\x7d\x3d\xf8\x76\x8e\xf0\x54\x3e\x09\x7b\x73\xa8\x98\x5a\xc0\x47\x73\x26\x86\xd0\x27\x92\xb4\x86\x9c\x27\x59\x41\xd7\xe7\x2c\xe6\xff\x62\x37\xf7\x04\x58\xa7\x80\x96\x92\xa7\x48\xa2\x33\xf6\xc9\xce\x00\xce\x3d\x6f\xc3\xc5\xdc\xa3\x35\x18\xd6\x7c\x01\xd9\x70\x86\x16\x70\x9a\x78\x08\x87\xe1\x2c\xcf\x49\x1c\xba\xbd\x07\x7e\x25\xb3\xa6\x76\xa3\xc5\x7a\xa4\x14\x8e\x5c\x25\xe4\xc5\x44\x4c\x86\x85\xd3\x2e\x3f\x43\xef\x78\xec\x1a\xf5\x5b\x70\x68\x3f\x29\x09\xe3\x9a\x72\x9d\x6f\x98\x4f\xdc\x5a\xff\x90\xe4\x87\xa7\x5b\x0a\x60\xc3\x97\xc7\xcd\xe3\xf7\xa6\x81\x3e\xf1\x42\xf9\x09\x24\x72\x22\x6f\xec\x90\xbf\x80\x3e\xdb\xd6\xd5\x97\x43\x19\x52\x44\xf5\xd1\x9d\xe6\xd3\x63\x9b\x26\xd6\x9a\x5b\x55\xe0\xb8\x4c\xd2\xed\x5c\x0f\x30\x2d\x35\x04\x72\xc1\x25\xa6\xcd\xf2\x82\x11\x0b\xe5\x5f\x3d\xd0\xb1\xa8\x3d\x4b\x04\x22\xb1\x62\x87\x90\xae\xd6\x1a\xb4\x0b\xc6\xbc\xfb\x3b\x73\x0b\x6d\x0a\x46\xc0\x01\x70\x2f\xa2\x15\x70\xc1\xd6\xf3\xe7\x68\xcb\xef\xe3\x29\x6f\x66\x3c\x83\x49\xe3\xdd\x2e\xa4\x41\x49\xa3\x68\x81\xb6\x50\xdc\x8f\xc7\xcd\xc3\x8e\x94\x68\xb5\xeb\xb7\x73\x70\xac\x0f\x46\xaf\x45\x67\xd0\x22\xe3\x62\x51\x8b\x24\x42\x7c\xf1\x3d\xac\x68\x05\x09\xe1\xdf\xe1\xd6\x8f\xd7\x81\x54\xb5\x45\x43\x7c\x73\xb4\xe0\x95\xd9\x5e\x20\x87\x9a\x08\x09\xec\xc3\x91\x8f\xe1\x66\x55\x09\x10\x8a\x01\x74\xc4\x3f\x82\xa2\x57\x01\x70\x34\x00\x62\xe0\xc3\xbd\xb3\x5b\x8c\xcc\xcb\x2d\x5e\x3b\xf0\xa1\x6c\xc3\xc5\x15\xae\xed\x04\xf6\x43\xcf\x06\x7b\x26\x8a\xac\x3a\x99\x6a\xa1\xd7\x1c\x16\xd2\x4a\xbd\x6c\x4a\x22\x70\x83\x53\x06\xd4\xb5\x52\xe7\x4a\x81\x71\xf7\x12\xa9\x1f\x9d\x24\x17\x64\x96\x32\xa5\x33\xfd\xa0\x0d\x91\xd5\x83\x40\xda\xf4\x38\x93\xec\xee\xbf\x8c\xb6\xe3\xcb\xd3\x89\x66\xf5\xd7\x7b\xc1\xcf\xa9\x21\x9f\xd8\x0f\xbb\xee\xf9\xba\x09\x7b\x30\xbf\x09\x3a\x1f\x54\x3c\x89\x60\xb2\x7c\x70\xf1\x6c\x0b\x6a\x27\x7d\xa4\x3c\xc1\x19\x1a\xa9\xc5\x0a\x66\xe4\x61\x5f\xf7\xbe\xc3\xca\xd9\xdc\x5b\x52\x01\x02\x8a\xe6\xa6\xa0\xa5\x8e\x95\x19\x0d\x4e\x0e\xc4\xc1\x71\x75\xb1\x76\xdf\x5c\x07\x7f\x16\xea\x0c\xe0\xe6\x1a\xd3\x45\x23\x2c\xc2\xdf\x7c\x4f\x33\xc2\xfb\x48\x67\xeb\x9d\xa1\x64\x41\x6d\x77\xd2\xa0\x03\x8b\xdc\x12\xc0\x47\x6f\x08\x66\xe8\xef\x99\xbf\x33\x16\xac\x68\x42\xac\x0b\xaf\x28\x1e\xf4\x90\xe5\x8a\x25\x53\x5b\x5a\x63\x29\x43\xfd\xd2\x09\xd4\xd8\xf0\xd2\xd8\xa3\x25\x49\xaf\x66\x8e\xef\xbe\xfa\x5b\x85\x74\xe7\xb4\x05\x74\xd8\x14\x9b\x8b\x42\x1b\xad\x69\x09\xdc\x9a\x3d\xbd\x49\xfc\xa7\x5e\x4e\x40\xa5\x73\xe3\xbe\xc9\x5a\xa2\xac\x36\x1d\xf6\x47\x1a\x97\xef\xf9\xa9\x6a\x52\x8b\x5e\xad\xc7\x5c\x1b\x97\x97\xe9\x7c\x76\xde\xa8\xeb\xff\xc3\xb3\x08\xd1\xa0\x02\x8d\x4b\x14\x12\xb8\xaf\xf4\x91\x5a\x3f\x00\xeb\x11\xab\xdd\x78\x83\x0b\x94\xbf\x64\x3a\x2d\xb7\xe0\xa0\x1e\xf3\x3e\xa9\xeb\x63\xa1\xa7\x32\xda\xb8\xa5\x7f\x3a\x97\xb3\x7a\xc9\x75\x6b\x46\x6f\x38\xe6\x1b\x10\x2f\x72\xc2\x11\xe9\x07\x6a\x0f\xf9\xf2\x4f\x55\x12\x60\xa7\x7a\x70\x50\x0f\x48\x07\xc8\x3c\xb9\x1b\x1a\x90\x3d\x97\x4a\x24\x82\x86\xb3\xfa\x83\xf1\x32\xa4\x9c\x34\xac\x01\x56\x3e\x23\xf9\x43\x10\xba\xb1\x8c\x9b\x39\x80\x3c\xbb\x2f\x32\xb6\xac\xf0\x95\x5a\xbf\x71\xc2\x40\x37\x1f\xf7\x4e\x21\x8f\x11\x43\xf1\xac\xf4\xab\xed\xb6\xf8\x55\xa6\x09\xf0\x75\x8f\x89\x67\x30\x7b\xfa\xb3\x95\x1e\xc3\x48\xf2\xc5\x8b\x75\xa1\xf4\x00\xfe\xce\x93\x59\x49\x78\x33\x24\xdd\xd6\x44\xc3\xb2\x52\x69\xf0\x27\xb1\x15\x38\x42\x4c\x71\xc1\x7c\x77\x66\xbe\xa8\xa6\x0c\xbf\x6a\xa9\x16\x8f\xb5\x58\xba\xee\x92\x60\x4f\x62\xac\xdb\xa7\xe6\xfd\x06\x8b\x92\xff\xb2\xda\x8e\x3d\xec\x48\x0f\x76\x43\x2b\xd9\xb7\x1d\x98\xeb\x43\xb7\x13\xf4\x77\xba\x01\x31\xc3\x0a\x03\xf3\x12\x23\x0e\xf0\x22\xcc\x16\x78\xa4\x46\x91\x7e\x4b\x11\x10\x1c\x07\x61\x9a\xbc\x4c\x04\x3e\x6a\x19\xc0\x76\xaa\x22\x27\xdf\xa6\xee\x2b\x2f\x5b\xf3\x3a\x87\xb5\xcb\x1a\x98\xdd\x8b\xf1\x1e\xe1\xa8\xa3\x50\xf5\x8b\x66\x3d\xf8\xbf\x79\xda\xed\x44\x24\xbc\xc0\xc0\xe4\xa2\x16\xfc\x8c\xf7\x72\x73\xc1\x57\x6c\x46\xb2\x7c\x8e\x0c\x49\x3a\x66\x21\x60\xa3\x04\xb1\xae\x8c\xc7\x41\x97\x11\x41\x5c\x90\x37\x55\x90\x38\xeb\x57\xb7\x11\x7a\x47\x01\xb6\xb2\x15\x32\x92\xd9\xa7\x98\x63\xbc\x99\x5a\x06\x4f\x6a\x5f\xf8\x4b\x8b\x14\xbc\xe4\x61\x1f\xe5\xc3\xe0\xc4\x32\xbc\xf4\xc9\xc8\x73\xd9\x00\x68\xd9\x07\xe0\x93\xaf\xef\xe5\x93\x0d\xc8\xb5\x8c\xaf\xcc\x7b\x6a\xa5\xb9\x08\x9c\xa7\x7c\xf1\x2f\x36\x62\x5e\x1b\xb0\x26\x9f\xc4\x1d\xd4\xcd\xa4\x9b\x81\xbc\x9b\xa1\x2a\x1e\xae\xea\x64\xce\x69\xc1\x8b\x53\x42\x22\x24\x23\x8f\x3f\xa3\xb9\xe4\x39\x7a\x72\x4d\x46\x23\x45\xfe\xb6\x55\x62\x52\xeb\xc6\xc7\x83\x64\x23\x3f\xe8\xdd\xfe\xe5\x70\xd2\x9d\xab\xa8\x1e\xcc\x9b\x3f\x9a\x64\x6d\x1b\xcb\x73\x47\x3d\x5d\x6c\xd0\x6d\x79\x13\xa5\xa2\x70\x4c\xe8\xf2\x7f\xde\x1e\x43\xe3\x29\x27\x5f\x87\x08\x53\xf0\x9d\x80\x0f\xb4\x43\xdb\xa4\xd9\x74\x4c\x27\x73\x4b\x68\xc7\x9f\x2d\x19\x85\x4d\xe3\x45\xbf\xb4\xc7\x3c\x33\x47\xd5\xe2\x21\xae\xf2\x9b\xe3\x29\xb3\x0d\x73\x52\x54\x1d\x43\x91\xe7\xe7\xfe\x56\x16\xb3\xbb\xee\x33\xe0\x54\x83\xba\x3e\x22\x8e\x1e\xca\x39\xee\xa0\xe8\x71\x95\x2f\xf1\x8d\x1d\x8b\x68\xa0\xf7\x1b\x5d\xd3\xf0\xc5\x16\x09\x78\x66\x3a\xdf\x83\x04\x0b\xc3\x80\x9a\x8f\x4e\xb6\x60\xf3\xfe\x63\x6d\xc6\x61\xde\xfd\xc0\xf2\x7d\xf3\x32\x85\xa8\x16\x4c\x83\x9a\x43\x7c\x35\x92\xe9\x5d\x16\x45\x9a\x47\x23\x21\x37\xa1\x9b\xd4\xe9\xf8\x25\x98\xe3\x45\x97\x78\x5a\x10\xb2\x96\x6c\xd1\x39\xa5\x58\x2a\x14\x5e\x3c\xe4\x77\x25\xcf\x8d\xa1\x84\x5e\xf7\x3f\x00\x17\xb2\x9f\x28\x58\xf3\x36\x13\xa5\xe8\xd2\x6f\xe6\x09\x83\x06\x92\x53\x4c\x0c\xc8\xbc\xed\xb4\xbc\xc5\x96\x15\x10\x37\x07\xbe\x81\x1f\x06\xad\x42\x79\x8e\x02\x38\xa9\xa3\xac\x39\x31\x90\x7c\xb2\x2b\xc4\x46\xe6\x27\xed\xab\xc3\xec\x69\xa2\x10\x5c\x3d\x8d\x10\x7f\x41\x25\x55\x0f\xa0\x2e\xa3\x49\x4f\xf8\xec\x72\x3b\x71\x16\x30\xca\xcf\x58\x37\x16\x8e\x50\xda\xcd\x76\x73\x65\xfb\xb5\x57\x78\xc5\xf3\x5b\x8b\xb4\xd5\xfe\xa9\x41\xa8\xf0\xd4\x3b\x07\xa4\x82\xd9\x71\xd8\xb6\xa3\xef\xb1\xea\x05\x8c\x7e\x36\xc0\x08\xfd\xdd\x66\x0d\xcc\xce\x42\x03\x9e\x53\x4f\xf0\x38\x68\x00\x39\xa4\xe0\x56\x4b\xaf\x82\xa9\xfb\xbb\x9b\x30\x32\x94\x63\xaf\x5a\x8e\xf3\xc4\x97\x63\x1b\x9f\x7a\xa7\x6d\xbd\x99\x17\x0a\x9d\xef\x37\x6f\x35\xd4\xc0\x4e\x1e\xc7\x8f\xe6\x00\xd3\x2a\x90\x41\x78\x12\x8c\x26\x9c\xb9\xa5\xce\x37\x3c\xfd\x2c\x55\x1a\xbd\x66\xb8\xd8\x57\x64\x4b\x35\x01\x1c\x04\x68\x59\xbf\x4e\x15\xc6\x46\x90\xc3\xfe\x7f\x23\x34\xad\x7b\x85\x08\x66\xf6\x22\xb6\x16\x6f\x16\x31\xe9\xe9\x5e\xc7\x32\xad\x1e\x71\x05\xa0\xb8\xea\xd8\x20\x06\xff\x70\x85\x03\x14\xf4\xde\x0b\xff\xa4\xb2\xbe\x6c\xbd\x19\x14\x89\x27\xef\x03\xb2\x0c\xed\x5d\xff\x3c\xb2\xd4\xa5\xf0\xaa\x2d\x48\x23\x6e\xe0\xef\x8a\xeb\xf8\x62\xec\x9d\xd9\x01\x26\x4a\xdf\x5a\x4e\x89\xb8\x64\x19\x8d\x3c\x76\xd6\xd7\xc5\x0d\xc3\xb6\xdc\xc5\xb4\xe7\xa7\x8c\x3c\x17\xa4\x9e\xeb\x3c\x2f\xa8\xf5\x48\x39\x55\x68\xd2\x9e\xb3\xa9\x05\x22\xf5\xb4\x73\xf5\xae\xd3\x57\x1c\xe2\x50\x93\x1e\x6a\x27\xb3\x05\xf4\xb7\x8b\x92\xad\x4c\xd7\x3f\x29\x4a\x86\x41\x94\x2e\xc5\x10\x10\x31\xbc\xfb\x84\x93\xb3\x74\x24\x00\x2e\x62\x3f\x85\x1c\xcc\xad\x26\x2d\xd5\x8c\xba\xd4\xa1\xd0\xaa\xce\x6b\x44\x47\x30\x3e\x50\x40\x6f\x1d\xa0\xbb\xc4\x5b\x41\xe2\xe5\x7b\x1d\x20\xb9\x6c\x93\xff\x3f\xea\xcf\xe2\x95\xaf\x43\xd2\xd8\xcb\x82\x45\x30\xb2\x08\x85\x53\x67\x78\xd9\xac\x5a\xc5\xd4\xb5\x3d\x59\xa6\x17\xe4\xb0\xac\xb5\x8f\x3a\x2c\xad\x21\x1b\x61\x30\x1d\x1f\xbc\x5f\x5e\x94\x5d\x1d\xd6\x70\x26\xe4\x4a\x65\xe0\xf8\xd3\x1a\xeb\x1c\x2c\xfb\x08\x8f\x11\xd0\xd4\x42\x3b\x22\x16\xfa\x66\x86\x89\x83\x52\xfc\xb5\x60\x77\x55\x6f\x77\x9d\x18\x72\xee\xd8\xb0\x6e\x94\x54\x7b\xc9\xe9\x65\xdb\xb5\xde\x4a\x61\x50\xc4\x57\xc3\x4f\x54\x0a\x73\xc2\xe7\xe1\x33\xed\x60\x15\xd1\x57\x25\x5e\x69\xdd\x31\x29\x4d\x4b\xd2\x15\xbe\x8d\x70\xc6\xe8\xc4\x04\xf3\xc9\xf0\x0a\x7f\xae\xbe\x7c\x30\xdb\x5b\xfe\xfa\x91\x97\x20\x97\x67\x2c\xb1\x2e\x05\x8c\x45\x42\xe1\x3b\x43\xee\x19\x61\x3f\x2e\x2c\x83\x29\x26\x62\xcd\x62\x2e\x64\xfd\x03\xd3\x06\x03\x41\x72\x29\x9e\x0a\x21\x72\x1d\xfe\xb5\x7f\xc3\xff\xdd\x12\x82\x53\x83\x9a\x94\xc7\x90\x77\xca\x25\x1e\x33\xfc\xa6\x37\xbf\xf5\x61\xd2\x0f\x67\x4c\x8f\x11\x7c\xa3\x86\xb4\x78\x2b\x57\xce\xd0\x27\xd8\x10\x75\x83\xb8\xbd\x36\x62\x96\xc0\xf6\x8e\x35\x10\x09\x75\x4b\x78\x37\xaf\xea\x55\x33\xc4\x6b\x9b\xaa\x36\xd8\x65\x42\x80\xa3\xfb\x70\x2d\xa6\x25\x9d\xaf\x81\xbd\x39\x94\xf6\x0c\x51\xc3\xf8\x58\xb5\xf5\x10\xf2\xe9\x2d\x5e\xff\x66\x24\x76\xea\x9d\x11\x33\x1d\x12\xae\xab\x39\xb7\x8b\x5b\x04\xfb\xe1\x15\xc6\x19\x6f\x01\x55\x09\x78\xa0\xe8\x4c\x22\xaa\xac\x5d\xf0\x5b\xf8\x5e\xaf\x0f\x65\x80\x70\xf3\x7e\xb5\x40\xe7\x31\xda\xe9\xc9\x98\xa3\xff\x79\x40\x6a\x58\x0d\xbf\x5c\x6a\x09\x4c\x5e\x45\x7b\x3a\xc2\xf2\x48\x3e\x9c\x3e\xeb\xf4\x7a\x88\x37\xb8\x28\x48\xb6\x38\xb6\x68\x7a\x29\x1f\x8d\x46\xb1\x94\x95\xb1\x18\xbb\x60\xe9\x8e\x00\x4f\x3d\x70\xfe\x8f\xbb\x09\x3f\x0e\x16\xa0\xa1\xf2\xad\x78\x4a\xd9\x2a\xa2\x75\x84\xcd\xb5\xae\x06\x47\xfe\xe9\x6e\xa6\xec\x83\x05\x35\xe1\x28\x0d\xfa\x57\x77\x80\xf7\x65\x45\x0b\x98\x1b\x6a\x85\xe7\x76\x86\x65\xf8\xf9\xba\x0d\x22\x28\x82\x7e\xac\xc2\xc4\x7f\xe9\x5e\xde\xa0\xc8\x2f\x6a\x82\xfc\xbe\x22\xcd\x47\x43\x87\x1b\xbc\x95\x18\x1a\xe3\xde\x04\xea\xa6\x67\x0a\xf4\xd4\x44\x70\x79\x5b\x74\x41\x68\x44\xda\xcb\xca\xe8\xa0\x40\x52\xef\x81\x27\xf3\x9c\x7c\xa8\x6e\xcc\xcb\xa4\x95\x48\x44\x4b\x8b\x6a\xa3\x76\x87\xd1\x2f\xaf\x71\x85\x03\x91\x91\x18\x97\x0c\xe4\x25\x06\x75\xc4\xcf\x03\x29\x64\xeb\xa5\xb1\xd3\x4a\x54\xab\xca\x9b\x36\x42\xa9\x84\xdb\x6d\xa2\xa1\x9b\x1a\xb5\x54\x62\xb8\xba\xf5\xfb\x0e\x5d\x46\xb1\x72\xab\x86\x32\x7e\xd1\x6d\x23\xaa\xad\x7b\x8d\x16\xeb\xf0\xbf\x56\xf5\x53\x7b\xdf\x60\x57\x03\xfa\xd7\xe0\x5d\x24\x1a\x08\x98\x37\xe9\xaf\x75\xd9\x40\xb4\x9a\xc9\x28\x53\x7f\x5a\x16\xa9\x0b\xf5\x15\xf7\x10\x31\xd0\xe0\x59\xff\x32\x49\xd0\xc2\x1c\xca\xa8\x47\xaa\xfa\x08\xc4\x0d\x48\x6d\x6b\x3b\xc1\x68\x9f\x63\x65\xfb\x41\xf2\x36\x9d\x46\x37\x59\x9f\xdb\xd2\xe0\x67\x70\xef\x10\xc7\xe9\x5d\x52\xc4\xd3\x08\xc1\xa0\x44\x9e\xef\xe6\x1f\x9c\x41\x80\xf5\x3c\xb6\x12\x52\x9c\x51\x6c\xf6\x71\xac\x95\x4f\x96\xd6\xfa\xb0\x40\x5d\xe3\x44\x5e\x48\x1d\x87\x72\x2d\xd1\x76\xae\x87\x3b\x2f\xe2\x8b\xfb\x10\x62\x61\xe2\x5a\x84\xa6\x17\xd2\xdf\xca\x9c\x6f\x45\xbb\xcd\x1d\xf0\xa2\x19\xe1\x32\xf6\x04\xcf\xee\x25\x7f\xa6\x2e\x98\xa2\xc6\x08\xa3\x4a\x67\x7c\xfc\x9d\xff\xf9\x07\x43\xdf\x8f\x87\x99\xe5\x99\x7a\x02\x9c\x5d\x76\xa9\x54\xff\xf4\x83\xf8\xb0\xa3\x03\xb0\xa4\xe6\x7b\x9b\x90\x5b\x59\xe2\x2a\x0c\xe6\xf6\x34\x82\xf1\xe6\x07\x75\x2e\xbe\x7f\x89\x9e\xcc\x36\xb7\x1d\x90\x32\xf2\xde\x02\x67\x55\xf2\x5f\x3d\x6a\xfb\x73\x4d\x6c\x6c\x04\xec\x56\xf8\x9f\xf7\xa9\xee\x31\x72\x3d\xf2\xae\xc0\x95\x41\x92\x88\x84\xb8\x8d\x3f\xb4\xe3\x92\x62\x5a\xd6\xa9\x60\x0e\x37\xac\x7c\x66\xc8\x0f\x4c\xef\xaa\x45\x34\x98\x75\xcf\xc5\x1f\x02\x51\x7f\x34\x77\x00\x0d\xb8\x34\xaa\x80\xe7\xcc\x2c\x30\x2a\xf7\x6f\x62\xcc\xd4\x8d\x38\x7e\xdb\x62\x09\xb9\x45\xd4\x81\xa5\x68\xb6\x9f\xd3\x12\xc9\xb1\x15\x4d\xa5\xa4\x45\xa5\xa2\x35\x27\x75\x1a\xe7\xc5\xfb\xb0\xd3\xcc\xa7\xfc\x45\x6a\x93\xd2\xe7\x44\xba\x7e\xfb\x59\xdb\x14\xa3\xfa\x23\xf7\x83\x6c\xd9\xb5\x6a\xa6\x12\x52\x7d\x3d\x1d\xe9\xdb\xbc\x14\xb8\x59\x3a\x75\xed\xb0\xd4\x2e\x65\x5b\x66\x5d\xfb\x48\x9a\x7e\x4b\xfa\xcc\x58\xae\xff\x20\xfb\x9f\xce\xe3\x09\xdd\xa2\x20\x08\x8f\x2c\x76\x18\x50\x3b\x1f\x5b\xf8\xe6\xa0\x35\xc7\x32\x41\x54\x9e\x72\x65\x69\x0d\x97\xe8\x40\x98\x4f\x38\x05\xfc\x16\x10\x4a\xbd\xeb\x8a\x12\x85\xd0\xa5\x9a\xc7\x9a\xf3\xfa\x02\x46\x1f\xee\xd6\x9d\xe6\xfc\xb6\x17\x71\x89\xa2\x70\x7c\xad\x28\xf3\x30\xf3\x53\xde\x35\x7a\x12\x9c\xf6\xb5\x8e\x51\x5c\x6b\xaa\x20\xa9\xd2\xbe\x6a\xe7\x2d\x3c\x82\x3a\xd0\x9e\x4e\xbc\xd8\xce\xf3\x9f\x70\x7a\x5f\x38\xad\x0b\xd9\xb0\xa8\xbe\x4a\x34\x10\xec\xd6\xa3\x0b\xf9\x9a\x71\x25\x25\xe5\xc7\x73\x3c\xa0\x45\x01\x5a\x12\x74\x7b\x9a\x71\xbe\x54\x64\xc5\x1d\xbc\xa3\x60\x11\xbc\x09\x82\x48\x2a\xdb\x57\xc1\xd8\x58\x30\x2c\x66\xb1\x87\xb4\x16\x33\x63\x71\x7f\x33\xae\x57\xef\xf3\xad\x5d\x86\xf3\xf6\x79\x5b\x6a\xeb\xcd\xa2\xbe\x38\xae\x4c\x61\x5d\x7f\xc6\x03\xf9\xce\x07\x46\x2d\x93\x7c\x5f\xed\xe9\x64\x6d\xc9\x34\xe1\x61\xdf\xf7\x72\xdd\x38\x15\x56\x14\x00\x4b\xca\xe5\xfe\x9c\x54\x78\x0d\x31\x73\x32\xb5\x5c\x53\x53\x42\xd7\xfd\x8e\x52\x6d\xed\x04\x81\x52\xe4\x60\xf7\xa5\xe6\x97\xfc\xd5\xbd\xc2\xc6\xeb\x00\x76\x2d\x4f\xbc\xeb\x2a\xe5\x4d\xf7\x5c\x2b\x4b\x48\xc1\x82\xdc\x56\x6a\xd7\xf7\xee\xfb\xda\x7a\xd6\x7d\x16\x89\x67\xe5\xb0\xd2\x9c\x7c\xba\x3f\x40\x82\xfa\x77\x6e\xa7\x21\x85\xfd\x49\xad\xb3\xc8\xc1\xe3\x6b\x46\x53\x68\x97\x5b\xa9\xf5\xfd\x50\x6a\x49\x82\x8e\x59\x18\xfa\x0b\xa9\xdf\x37\x80\xc3\x30\x07\x1c\x90\x60\x6a\xbc\xe1\x43\x59\x10\x3f\x1a\xf6\x1b\xe2\xe6\x28\x6a\xcc\x9c\x60\x15\xdd\x05\xe1\x7a\x1d\x9b\x87\x80\x25\x8a\xa8\x35\xf8\x94\xdb\xb1\xc6\x6f\x99\x8a\xd0\x3b\x14\x37\x21\x1c\x68\x22\x10\x11\x74\xd5\xe7\xc8\x70\x39\x0e\x53\xdd\x22\xf8\x9d\xa3\x1c\xbc\x38\xe7\x87\x51\x4a\x31\x47\xd0\x4d\x13\x6e\x3c\x74\x1f\x95\xd8\x2e\x2d\x09\x6b\x89\x74\x5f\x69\xb8\xcd\x19\x1a\xa3\x70\xf1\x3c\x78\x23\x0f\x0f\x15\x6f\x27\x0f\x31\x45\x3d\xac\x4e\xa7\x6d\xfa\xbb\xa5\x74\x1b\x30\x0f\x93\xf1\xf4\xef\xbd\x6d\xd8\xf7\x2c\xa3\x6a\x99\xf3\xf1\xa9\xc3\xe8\x5a\x91\xa9\xad\xd9\x91\xbc\x88\xc4\xf2\x0d\xdf\xe1\x9c\xea\xe7\x6e\x72\xdd\x92\x85\xd6\x3d\xcb\xdf\x31\x0c\xc3\xf9\x78\x10\x1a\x8d\x57\x88\xf5\x37\x39\xd6\x9a\xa9\x13\xd6\x92\x27\xb1\xa1\x3d\x37\xee\xba\x3c\xc3\xf1\x8d\x8e\x41\x2a\xda\x71\x3c\xb5\x3c\xec\xb2\xce\xa2\xe8\xa1\x0d\xe8\x38\x55\xa7\x03\xc8\x6f\x85\x11\x9c\xd9\xa1\xb0\x48\xbb\xd7\x12\xb4\xe4\x4c\xde\x84\xab\x27\xf1\x8f\x53\x69\x00\x48\x28\x5d\xb1\x76\xc9\x49\x15\x94\x41\xe4\x70\x54\xbb\x5c\x4f\x40\x97\x04\xfe\x13\x92\xc4\x24\x6a\xe1\x1c\xc8\x9b\xbd\xc3\x97\x32\x33\x00\x10\x03\x8f\x74\x74\x6d\xde\x05\x13\x39\x2d\xd1\x87\x80\xde\xc7\x3b\x9d\xdc\x51\xe7\x78\x8f\x96\x4b\x60\x9c\x21\x17\xb5\xbb\x81\x71\xde\xf2\x96\xd3\x65\x9e\x33\x98\x3b\xf0\x09\x80\xa0\x09\x03\x17\xdc\x5a\x50\x8c\xdc\xbb\x0b\x28\xee\x5f\x0a\x3e\x3f\x74\x82\x83\x8f\x77\x22\xda\x63\x54\x3a\xba\x6d\xac\x5b\xa9\x48\x4e\x70\x62\x3b\x39\x63\x06\x7d\x3c\xb0\xea\x83\xd9\xdd\xc4\x6c\x03\xf8\x4a\x5a\x24\x8d\x59\xe5\xe7\x8c\x9e\x32\x17\x64\x98\x2d\xcd\xf4\x6c\x21\x47\xfb\x85\x27\x9c\x21\x0f\x0c\x9e\xb1\x73\x7f\x35\x83\x7d\xdf\x76\x4f\xb5\x29\x2e\xee\x55\x58\xb2\x6e\x2a\x62\x5c\x76\xe4\xad\x3b\xa3\x4a\x99\x62\x08\xfb\x1f\xf0\x98\x19\x02\x20\xbc\xa6\xc0\x54\xf0\x17\x3d\x78\x35\x65\x40\xd6\x36\xf0\xb0\x6c\x96\x14\xfc\x5c\x74\xf8\xe3\x9e\xcd\x2c\x7c\xa0\xae\xaf\xc7\xd4\x41\xfe\x1f\x1c\x49\x61\x15\x07\xfb\x0b\xbd\x52\x25\xfe\x93\xd5\x90\x30\xa2\xe3\xc3\x47\xe9\x06\x78\x8a\x00\xed\xea\x56\x24\xa6\xf4\xc3\x4d\xbd\xcf\x90\x0a\xd0\x7a\x05\x09\x08\x4c\x81\xa3\x1d\x16\xdd\x8b\xb8\xbb\x4d\x39\xa5\x7f\x68\x58\x66\x69\xb0\xba\x9e\xd0\xbe\xdd\x55\xc6\x87\xff\x0d\xb8\xa6\x65\x23\x3d\xa0\x66\xa3\xaa\x59\x8b\x57\x75\xda\xbb\x5c\x57\x53\xc1\xb5\x7b\xec\x25\xef\x72\xec\x36\xa5\x77\x29\x7c\xfd\xa4\x67\xd8\x46\xf5\x84\xf9\xa6\xbd\x7e\xb6\x4c\x13\x2a\xbc\xad\x08\x3b\x7c\x80\x1e\x90\xda\x0f\x72\x93\xfc\xe0\x13\x88\x24\x74\xca\x4e\x4c\x63\x71\x85\x54\x84\x4b\x65\x8a\x51\x8b\x2b\xfd\x33\xcf\x02\x43\x9a\xd4\x95\xe5\x54\x59\x31\x72\x42\x4e\xab\x43\xbd\xd8\xaa\x7b\x97\x1e\xe9\xa5\xef\x4f\x03\x39\x96\x57\xd6\xec\x2a\x9e\xcc\x13\xd0\x7b\xb4\x6e\x96\xb2\x28\xbb\xd7\xab\xa1\x69\xb5\x0a\x03\xa5\x83\xe9\x9b\xbc\x52\x6c\x2c\xdb\x64\x76\xb9\xef\x71\xca\xad\x4c\x4d\x3a\x02\xe7\x44\x0d\xe2\x15\xdb\x53\x6e\xe0\x4e\x4e\xb1\xe6\x5b\x6c\xf7\xb0\x7c\x72\x0c\xe6\x56\x92\x4f\x18\x43\xd5\x85\x29\xd7\xbb\x7d\xce\x7a\xd2\xd1\x47\x9f\x9f\x03\xed\x83\x30\x24\x07\x1c\x40\xd9\x68\xdb\x34\xee\x71\xa4\x27\x13\xfc\xac\x10\x62\x25\xd6\xd5\xf1\xaf\xd9\x09\xcc\x21\x86\xe7\x36\xda\x66\x8b\x86\x08\x0d\x46\x52\x1e\x4f\x95\xc8\x7a\x7e\x84\x0c\x0a\x97\x99\xbd\xec\x3e\x7c\x8a\x78\xaa\x96\xf5\x47\x7f\x36\x63\x82\x7b\xb9\x26\x34\xe9\x10\x0b\x6a\x1e\xfd\xc2\xe9\xae\xdd\xcb\x5f\x6e\x24\xc3\x53\x6c\xb6\x80\x15\x3f\x4b\x4f\xc3\xf8\xf2\x7f\xcd\xfc\x84\xc3\x45\x66\xba\xeb\xfc\x4f\x98\x7d\x87\x10\x96\x7f\x65\x6f\x80\x86\x9f\x57\xdd\x13\xb4\x36\x3c\x4d\xd6\x02\x25\x9c\x6f\x48\xfa\x9b\x6c\x62\x7f\xde\xe3\xd3\xe8\xdd\xa6\x1c\x1a\x9d\xf8\xf6\x82\x3e\x44\x90\xc6\x17\xa1\xc7\x57\xed\x54\xff\x35\x20\x19\x8f\xb3\xb3\xa4\x89\x67\x1d\xe7\x44\x23\x3e\x32\xf5\x7c\xc9\x66\x2a\x1a\x01\x1e\xa6\x10\x9b\x2f\x77\x35\x45\x62\x8b\xeb\xbb\xa3\x87\xe4\xba\xa7\xe1\x9b\x90\x20\xb0\xf7\xe1\xbc\xaf\xc8\x2f\x65\xf7\x2b\xb5\x1b\x9f\x70\xd0\x4b\x80\x7e\xeb\xba\xdc\x9b\xba\x96\x65\x7b\x70\x1c\xec\x39\xcc\xb3\x7e\x6b\x4c\x86\x15\xf3\x5f\x01\x40\x01\x26\x58\x22\xab\x99\x2a\x25\xa6\x63\x68\xef\x42\x21\x16\x69\xdb\x99\x30\xb5\x73\xe1\x1a\x9e\x10\x09\x13\xaa\x8c\x5b\x28\x56\x9a\x16\x67\x4c\xe7\x65\x8c\x95\x0d\xca\x58\xcb\x2d\x63\xd7\x59\x97\xf9\x49\x03\xa8\x8a\x8b\x65\x4e\xe1\x06\x96\x3b\x9d\xd8\xb0\x62\x5f\xd3\x72\xa6\x1e\x05\x3f\x74\x8a\x0f\xce\x02\xaa\x7c\x5c\x46\x8d\x5c\xef\x69\x1f\x6f\x99\x42\x21\x3b\x33\x87\x91\x1f\xd0\xf5\x7d\x22\xf1\x9a\x60\xa4\xf2\xcd\x94\x94\x33\xbe\x3c\x94\xb9\x3e\x2d\x9b\xa0\x07\xb2\xd8\x66\xbd\x62\x68\x96\x63\x38\x70\xfd\x09\x57\xa5\x52\xff\x5e\xb4\x0f\x81\x67\x88\x46\x86\x86\x35\x1e\xc5\x46\x19\xea\x3e\x23\x79\xa7\xf6\xc9\x35\x0f\xd7\xd2\xb9\x63\x73\xe4\x30\xa1\x26\x6e\x41\x6d\xcf\x88\x36\xb3\xa3\x74\x92\xb3\x02\xdf\xe5\x02\x94\xfa\x3d\xfb\xd8\x9d\xf5\x9d\x76\x0d\xe7\x06\xc4\x01\x15\xc4\x23\x63\x4e\xc4\xfb\xcf\xab\xbd\x31\x6c\x94\x3e\xea\x71\xe5\x05\x42\xcb\x8b\xb0\xc2\xb2\xda\x60\xda\x5d\xdc\x68\x82\xab\xfe\x04\x6f\x53\x55\x88\x20\x7f\x7b\x16\xb7\xfe\x23\x37\x3b\x3d\xf9\x5c\x00\xbd\x0c\x31\x1d\xaa\x7d\xc3\xbf\x3a\xde\x02\x70\x8b\x2d\xd6\xcc\x7c\xc0\x4d\xc9\xcb\x01\xc8\x52\xda\x58\x92\x96\x29\xba\x86\xb6\x2e\x77\x92\xb8\x4d\x10\x56\xfe\x18\x28\x53\x3e\x92\x55\xf2\xe0\xf6\x1d\x5c\x54\x50\xe0\x68\x48\xd3\x78\x2c\x24\x3a\xc6\x5b\xed\xa6\xe7\x04\x0b\xc4\x6f\x78\xb4\x2d\x27\x2b\x20\x02\x3e\xbd\xfb\x68\xef\xd0\x3a\x0e\x49\x6f\x31\xa7\x26\x50\xf0\xe4\x4e\x72\x9d\xf6\xab\x8b\x2e\xc3\xfd\xae\xec\x74\x5e\xc6\x4a\xa7\x06\x21\xe3\x01\x13\xc7\xd1\xfa\xfc\x79\xee\x96\xac\x3c\xd4\x22\x6d\x38\xd5\xb9\x06\x86\x6c\xb7\x76\x74\x14\x68\xaa\x85\xf3\x51\x5f\x79\xe4\x73\xec\xaa\x6d\x3b\x5b\xa6\x50\xe6\x7e\xd8\x76\xd1\x35\xa7\xee\x91\xef\x12\x42\x30\x47\x19\x08\x47\x86\x29\x5e\x45\x55\x4f\x75\x6f\x9f\x3f\x18\xe8\x98\xe1\x6c\xbd\x36\x56\xb8\x79\x4b\x47\xf2\xdc\x47\x29\xe1\x74\x43\x31\xc7\x04\x95\xff\xab\xae\x1d\xef\x34\xc0\x3b\x14\xe6\xb2\x2c\xcc\xeb\xac\x58\x9c\xf5\x93\xa5\xd5\xde\xef\xec\x95\xea\x0c\x1c\xa7\xfd\x42\x71\x9a\x97\x30\x5c\xd0\x83\x6e\x9b\x8d\x64\x67\x2c\x25\xab\x99\xc8\x5b\x53\xe9\x65\x31\x07\x32\xf0\xba\xb7\xe1\xcb\x98\xd6\xb6\xc8\xf2\x5e\x25\x1c\x01\x58\x32\xba\xbe\x89\x3c\xf0\x5a\x21\x62\x2e\x1a\x40\x7a\x33\xc5\xa5\xd8\x90\xa1\xd2\x7b\xcc\x81\x00\xa0\x72\x65\xb5\xc7\x51\xdd\x4a\x23\x48\x97\x07\x2d\xfb\xb4\xd7\x32\x1f\xcd\xf3\x07\xdb\xd1\x71\x01\xfc\x15\xfb\x34\x58\xad\xca\x16\x07\xbc\xcb\xd4\x2f\x29\x38\x49\x34\x14\x6e\x3a\x63\xf4\x6e\xc8\x09\x44\xbf\xba\xdd\x30\x82\xdc\x19\xc3\xfc\x62\xbd\x88\x85\xe5\x39\xb0\xe7\x65\xae\x15\x7a\x2b\x38\x63\x7b\x65\x9c\x0c\x90\xe0\x51\x59\x96\xbf\x37\x16\x84\x45\x68\xcc\xcb\x8e\xb8\x33\x7e\xaf\xdf\xd8\xba\x43\x11\xef\x11\xac\x1c\x3c\x2d\xb4\x5e\x03\xa9\x47\x82\x88\x5c\x08\xe2\xd0\xb7\xa7\xf7\x52\xfd\xb8\x1d\xb8\xc0\x7c\x03\x5a\x5d\x7c\xdf\xf7\xaa\x20\x8b\xd3\xd9\x5a\xeb\x34\xa2\xa2\x43\xe8\x6d\x89\x13\x2e\x5d\xcc\x4a\xd9\xd5\xeb\xc3\x44\x90\xe9\xbf\x15\xf9\x28\xd4\x4d\x06\x57\x55\xe5\xea\x78\x81\xa1\x6d\xaf\xb9\xa2\xbd\x76\x29\xf0\xc0\xd9\xa4\x9e\x53\xe2\x01\x67\xf4\xb2\x52\x2a\xea\x98\xfa\x9f\x21\xa0\x97\xf5\x5e\xb9\xff\x76\x39\x14\x75\x83\xe5\x86\x78\x4d\x10\x73\x64\x1a\x10\x69\x99\x44\x1d\x10\xe2\xeb\xa3\xe7\x9f\x6c\x3f\x18\x5d\xca\x61\xde\x37\xd3\x6f\xcb\x24\x52\x42\x2c\xc2\x3f\x78\x17\xb5\xed\xb1\xec\xc5\x72\xca\x96\x42\x26\xc4\x6e\x96\x51\xaa\x30\x18\x43\x6f\xcc\x65\x01\x70\x6d\x0d\x37\xc4\x49\x78\x3d\x1b\x62\x16\xd3\x9c\x7a\x46\xb3\xed\x39\x2b\x54\x27\x31\x03\xb4\x7e\x99\x6d\xab\x45\x3f\x49\xc6\x93\x19\x74\x70\x4a\xd1\x64\xc6\x71\x90\x9f\x22\xeb\x51\x50\x9c\x08\x0a\xb6\x73\xed\x76\x7f\x1f\xc0\x7d\x9f\x44\xb0\xdb\xf5\x9e\xba\x6f\xad\x3c\xa4\x8e\xa1\x3d\x9e\x0f\x60\x45\x13\x6c\x6c\x15\xa8\x3d\xa3\x98\xe2\xa9\x52\x8d\x9c\xbc\x53\x79\xbe\xaa\x44\xfc\x1f\xdf\x7b\x3c\xf9\xdd\x2f\x76\x8a\x96\x53\x76\x14\xa8\x7a\xb2\x9c\xe2\x6b\xc3\xe2\xf8\x36\x14\xc9\xb9\xfa\x64\x5f\x11\xed\xab\xd4\x7a\xf8\x86\x4d\x57\x77\x28\x27\x65\x5f\xec\xef\x3b\xb1\xde\x01\x97\x5c\x25\x61\x6b\x43\x17\x45\x04\x3a\x35\x1a\xe8\x0d\x31\xd9\x31\xc8\x3e\x00\x31\x66\x36\xc8\x09\xcc\x0f\x0b\x27\x48\xc1\xb8\x27\xc9\xfa\x62\xa5\x6f\x5f\xf2\x97\x48\x42\xde\x57\xc6\xd0\x2a\x92\xc1\x42\xb7\x5a\xf4\x16\xa1\x33\x51\x55\x77\xb2\x5b\xca\xb9\x69\x5a\x05\xe1\x44\x07\x18\x9d\xa1\x35\x90\x89\xd3\x39\x0a\x5c\x18\x3a\xf4\x0c\x1a\x26\xfe\xa6\x8f\xd1\x99\xaf\x3f\xd2\x72\x17\xb1\xf0\x4e\x09\x5b\xf8\x25\x49\xe9\x29\x6b\x62\x36\xc4\xe5\x91\xc9\x2a\xb1\x86\x2f\xb0\x14\x68\x3f\x7c\xb9\x74\x86\xab\xf5\x57\xad\x4c\x4e\x7f\xed\xe2\x70\xe4\x49\xfa\xac\x13\x7a\xdf\xb0\x13\xa5\xda\x06\xe1\xe1\xf5\xb3\x14\x07\xee\x0e\xe0\xdc\x2e\xc6\x2d\x6e\xcf\xa6\x61\x33\x9d\x7a\x2d\x70\x0a\x43\xc8\x33\x92\xd2\x60\x99\xb0\xcc\x99\x9f\x96\x9b\xc3\x9d\xc3\x7b\xd7\xe6\xa9\x62\x7d\x2c\xba\x96\x88\x42\xff\x8f\x4d\x6e\xc8\xbe\x23\x25\xec\x09\x0d\x31\xb4\xbc\xe7\x92\xa9\x5f\x08\xa9\x64\xb6\xb8\xa3\x8a\x6c\x10\x45\x82\x0b\x00\x02\x8e\xc6\xfc\x27\xb9\xbe\xd0\xef\x89\x53\x9d\x86\xc4\x4b\x2e\x49\xd5\x0a\xbe\xe9\xb1\x69\xdc\x6c\x60\x11\x9e\xff\xa5\x43\x39\x00\x01\x5b\xf3\x4e\xd8\x96\x32\xd6\x73\xe5\x69\xa4\xee\x4e\xfd\xab\x78\xf4\xf5\x16\x67\x33\x85\xe0\xe1\x2d\x3c\xd5\x4c\x4d\xe2\xa2\x26\x07\x6e\xae\x81\x96\x7a\x35\x05\x1b\xdb\xde\x61\x1f\xc8\x4d\xee\x80\x69\x1f\x92\x93\x94\x17\x6f\xcc\xdb\x0e\xb3\xd6\x89\x93\x81\x13\xb5\x93\x33\x45\x02\xfb\xcf\x2b\x4e\x8e\xa0\x3a\x81\x6b\x92\x1f\x32\xfd\x59\x02\x2e\xf1\xe9\x52\x57\x5a\xe7\x0a\x87\x4e\x43\x1e\xb9\xad\x94\xc5\x4a\x99\x3b\x86\x9e\xbc\x49\x2b\x4d\x2d\xe6\x27\xa8\x1b\xcb\xeb\xdc\xc7\x12\x60\x2f\xea\x48\xb1\x0b\x66\x6b\xf9\xc7\x4a\x61\x2e\x29\x3a\xbe\x36\x74\x57\x0e\xcb\xb1\x4e\xc1\x8d\xde\xc0\xa2\x4d\x58\x13\xaa\x7d\x1b\x64\xc6\xbb\x5f\x3a\x4f\x27\x84\x6d\x47\x6b\xfb\x78\x3e\x9e\x63\x9c\x51\x39\x29\xb4\x3e\x1c\x57\x5e\x84\x2c\xe8\x72\xaa\xb9\x3e\xe7\x4c\xee\xc6\x32\x05\x15\x0d\x3e\xe4\x99\x4b\x44\xa2\x71\xa0\x63\x47\xc7\xc8\xc2\x84\xb7\xb8\x4f\xf9\xc2\xeb\x0d\xbf\x78\x30\xd4\x91\xa5\x13\x1b\x8e\x4e\x8b\xa0\x5b\x2c\x83\xc6\x66\x30\xbd\x5a\x27\xb1\xc0\x44\x64\xee\x86\xc7\x2b\x7a\xe8\x84\x9a\xdc\x70\xda\x4f\x33\xe5\x61\xb0\x63\xaa\x2c\xd5\x29\x35\xd3\x94\x86\x63\x84\x8d\x80\x91\x95\x5a\x2e\x5a\x68\xd4\x9b\x69\x38\x29\x32\x45\xea\x66\x90\x68\xca\x78\xa1\xa0\xb3\x55\xd8\xef\xd7\xb6\xa0\xe4\x24\x0c\x2d\xab\x36\x70\x4d\xc6\x42\xfe\xff\xa2\xb2\x0d\x4e\x27\x00\xba\x4d\x8b\xe6\xae\xfa\xc5\xf5\xfa\x4c\x18\xf8\x33\xcf\xff\x99\xe5\x4e\x0e\xec\x7e\x84\x6a\xb8\x62\x47\xf9\x15\xde\xf1\xdd\x3b\xe0\x11\x62\xa2\xb2\xb2\x21\x34\x2f\x81\xe0\x08\x41\x1d\x06\x76\xc5\xa6\xcd\xfd\x19\x37\x7e\xe6\xfd\x66\xe1\xbc\x77\x43\x95\x83\x23\xb3\x3a\xcd\x31\xc4\x4c\x66\xa6\xcb\x43\xb6\x09\xcd\x17\x40\xbc\x59\xfb\x61\xfe\x1d\x89\x72\xe7\xd4\x57\x80\xbb\x4b\xb2\x68\xbb\x5e\x56\x69\x73\xd0\xd4\xe3\x9a\xb7\x56\x19\x66\xe4\x9b\xf1\x77\x85\xfa\x45\xc6\x7a\x17\xa0\x05\xd1\x05\xee\x4e\x94\xfe\x90\x1f\x98\xee\x09\xfb\x59\xc0\x27\xaf\xd9\xf5\x34\xb7\x44\xa0\x08\x4d\xd6\xdb\x67\x9a\x23\x34\x89\x02\x73\x8d\x71\x8d\x70\x59\xf5\x6a\xae\xc3\xe4\x9c\xdf\xf8\xc9\x82\xbd\x5d\xd7\x7c\x7b\x95\x9e\x12\xaa\x30\xa5\x2f\x05\xca\x78\xe2\xd3\x54\xaf\xd0\x45\xd6\x9d\x94\x97\x97\xa3\xa3\x75\x7b\x59\x92\x7a\x34\x97\x84\x7a\xef\xf2\xdd\x5a\x7f\x96\x69\x3a\xed\xb6\x27\x10\xb7\x1c\x0c\x41\xde\xfa\xbb\xcc\x0b\xd2\xb5\xb0\xee\xa3\x66\x10\x85\x49\xbb\x19\xd6\x44\x0f\x77\xd3\xf3\x48\xa0\x29\xde\x58\xc1\xcc\x19\x63\xee\x10\x19\x0e\xfc\xaa\x2a\x97\x5d\x3f\x51\x74\x63\xc1\x2c\x7f\x5a\x98\x5f\x77\x42\xc1\x77\x33\xe2\x0f\x94\x81\x25\xd9\x84\xe4\x8e\x64\x5b\xc2\xa0\x56\xdb\x04\x6d\xbd\x16\x28\xad\x03\xef\x5c\xb4\x0d\xcc\x99\xd7\xc2\x04\x46\x0b\xaf\x1b\x02\x21\x84\x60\x35\x11\x99\xb6\x94\xb6\x45\x51\x02\x33\x49\x20\xdf\x9d\xa8\x1b\x0b\xb3\x07\x01\x0b\x89\x4d\x62\x76\xed\xa6\xde\xf2\x30\x1b\x15\xb1\x7f\x37\x41\xe7\xc3\x50\xb6\x5a\xc8\x10\xe6\xe8\xff\x17\xe8\x64\x4f\x42\x8f\x4a\x96\x2d\xaa\xb4\x72\x38\x82\x22\x40\xd6\xc3\xc4\x8e\x74\x7a\xbe\x82\xdb\x88\xf5\x75\x75\x6d\xe8\xe6\xba\xa3\xe8\x3b\x9d\xac\x55\xea\x1e\xff\x46\x77\x03\x92\x9b\x9a\x3c\xee\x40\xb0\xd2\xca\x46\x8d\xf1\xc6\x81\x9a\xdb\x69\x67\x49\x77\x8f\x90\x71\x2a\xdb\xc6\xf5\xde\xfc\x47\x01\x44\xc1\x77\xb9\x16\x2d\x03\x37\xbe\xcc\x42\x42\x02\x04\x06\x48\x12\x1a\x70\xf6\xb7\x3b\xe2\xd2\x5e\x8a\x64\x6b\xb7\x65\x56\x68\x4a\x24\x9f\xc8\xe1\xa1\x99\x71\x68\x3b\x4e\x56\xd2\x18\xf0\xa2\x91\x09\x39\x72\x52\x8c\x58\xab\x14\xd6\x4a\x31\xb0\x6c\x10\x87\xbe\xec\xe8\xbf\xf1\x36\xf1\x6e\x9f\x50\x66\x86\x76\xd6\xcf\xe3\xe4\x72\xb0\xb1\x21\x98\x3d\x9a\x4e\xe9\x77\x47\x64\xc8\x43\x2f\x57\x20\x72\x03\x8b\x68\x80\x80\xb1\x75\xa2\xd9\x2c\xd5\xcc\x37\x9d\x56\x0e\x41\x9c\xa8\x82\x89\xc9\x00\xa6\xf3\xaf\x34\xf6\xe8\x38\xf0\xb5\xde\x9f\xd6\xa5\x57\xa6\xce\x6b\xb9\xa4\x92\x55\x4f\x2f\x4f\x32\x1a\x37\x18\xdf\xf7\x0e\xe7\xd4\x15\x3a\xda\x05\x4d\xa3\x7a\x4c\xe3\x9a\x64\x31\x5b\x60\xad\x46\x73\x07\xc5\xaf\x57\x5c\x36\xbc\x75\xa9\xad\x06\x72\x39\x7f\xc6\xdf\x21\x7a\xc1\xd5\xac\x82\x35\x16\xaa\xc2\x2b\xaf\x78\xc1\x61\x27\xce\x05\xfd\x78\x02\x1b\xca\xff\x9e\x7a\xf1\xe4\x1b\xba\xc4\xe2\x6f\x69\xcd\x09\xf8\xd8\x95\x17\x7d\x29\xcc\x5f\x0b\x3f\x46\x0c\x63\x68\x82\x1c\xc3\x89\x8a\x65\x47\x1f\x33\x3d\x1e\x0c\x3e\xe4\x4a\xce\x92\x36\x18\x93\xa2\x95\x1c\xdb\x49\x1c\x4c\xcd\x53\x02\xfb\x4c\x56\xa2\x68\xc9\x58\xf6\x78\xae\x13\xf4\x4c\xe9\x9a\x52\x16\x92\x13\xd5\xf9\x7c\xc0\xdd\x78\x29\x57\x1d\xbf\x8c\xbe\xad\x85\x1c\x7f\xf8\x1b\x84\xe1\x15\x5c\x1a\x96\x4e\xbe\xde\xba\x2a\x7f\x54\x06\xc5\xb3\x6b\xed\x15\x0b\x34\x3c\xd4\x2c\xd7\xa6\x8d\x9f\x3b\xa8\x56\x5c\x53\x9b\xc7\x99\x64\xe2\x1a\x75\xee\x74\x3c\x4f\x52\xf4\xad\x1b\x45\x57\x7d\x1d\xfd\x62\xf5\x9b\xc7\x1d\xd5\x0c\x98\x36\x9d\xf8\x7a\x85\x73\x99\x52\xb6\x58\x00\x99\x96\x81\xb8\xe4\x02\x5a\xec\x88\xea\x0e\xcc\x19\x7e\x6c\xdd\xdb\x3f\x79\x7e\x3d\x60\xf5\x98\x17\x7a\x13\xa3\x81\xfc\xb9\xca\xd1\xb0\x3e\x0e\x23\xf9\x96\x0b\xb4\x90\xeb\x8c\x13\xad\x44\x40\x60\xd1\x81\x0b\x5e\xb1\x1a\x50\x77\x25\x29\xfb\x03\xcc\x61\x2d\xaa\x63\xf1\xf4\x0e\x7e\x8a\x61\x23\x7d\x54\x1b\x7e\xad\xee\xb4\xc7\xaf\xff\x02\x1a\x4f\x28\xa5\x90\xcd\xc9\x7e\xe7\x8e\xe2\x12\x45\xed\x5b\x5f\x1a\xe5\x53\x62\x91\x21\x1b\x64\xfb\xf1\xff\x85\x9b\x3e\xc7\x7a\x55\xae\x8f\x86\xa4\x76\x42\x00\x72\xbf\x47\x4f\xa9\xc5\x8b\x31\xc3\x9a\x69\x80\x7f\xe4\xc0\x27\xe5\x7f\xfe\xbe\xa0\x64\x82\xf0\xd4\x9d\x49\x79\xcd\x91\x36\x70\x42\x87\xec\x36\xbd\x15\x8d\xd0\x01\x2f\x29\x3a\x47\x10\xc2\xfd\xde\xab\x94\xea\xa5\x08\x01\x4f\x09\x40\x2a\xcc\xbd\x31\x23\xa3\xcf\x74\x8c\x51\xaa\x61\x7e\xe5\xf2\x21\x06\xdd\x46\xec\xab\x41\xe7\x86\xf9\x71\x19\x5c\xa7\xd4\xac\xd3\x3e\x8b\xdb\xf0\xc7\x9c\xd3\xdb\x8a\x02\xa0\x0f\x33\x47\x17\xfd\x4f\x7f\x69\xaf\x99\x25\x91\x20\x74\x57\x61\xac\xde\x6e\x8b\x81\xa0\x76\xdf\x22\x46\x55\x71\xc0\x4d\x28\xd9\xe4\x68\x50\x11\xbb\x56\x23\x5a\xc9\xe3\xa4\xa8\xb1\xd9\x07\xc2\x8e\xe9\x87\xbe\xd5\x22\xcc\x95\xb4\x88\x80\xaa\xcf\x0e\xf9\x3e\x04\x82\xa6\xf7\x94\x2c\xaf\x4f\x7a\x41\x5b\xea\xf4\x84\xf8\x20\x24\x7b\xec\x63\xc4\x5c\x1b\x89\x80\xab\x52\x54\x40\x47\x4c\x72\x27\x9f\x9f\x07\xbb\x62\x1b\x77\xff\xa5\x02\xc0\xe9\x05\x86\x59\x30\x56\x5a\x71\xc5\x3a\x68\xd8\x3b\xbc\x6e\x20\xfb\xd3\xed\x95\xc3\xee\x3a\x10\xcd\xa9\x01\x4a\x1c\x53\xd7\x37\xee\xd2\xc6\x3d\x42\xf9\x41\x2d\xa0\x7f\x41\x97\xd6\xb4\xbe\x66\x10\xca\x62\x6f\xc7\xfd\xcc\x37\x3a\x5f\xaa\x59\xeb\x95\x0a\xa1\x39\x9c\x2f\x75\x3a\x67\x3a\xb1\x3e\xfb\x3f\x45\xe6\xc1\x05\x1c\x16\xe4\x71\x91\xfc\x1b\x07\x5a\x19\x31\x5a\x27\x1b\xe8\xcb\x51\x3d\xbd\xc2\x75\xda\x5e\x35\xf2\xa7\x56\x17\xf8\x86\xa4\x3b\x4e\x2f\x47\xeb\x36\x35\xa4\xd3\xe6\x64\x8f\xbe\xf5\xce\x6b\x41\x4f\xc9\xc0\xc3\x6a\x44\xa7\x73\x44\xc4\xca\x3f\x1e\x65\x35\x08\x1d\x10\xad\x62\xdb\x99\x47\x41\xb1\xe8\xf0\x62\x1f\x0c\xef\x1d\xb2\x78\x95\xb3\xe6\xbd\x14\xb1\xaf\x05\x51\xbe\x8e\xd1\xb4\x17\xc3\x3a\xbc\xd9\x6b\x78\x99\x50\x48\xcd\x63\x59\x8b\x84\x59\xfc\x97\xf3\xbd\xb6\x39\xda\x9c\x6a\x79\x8b\xbe\x37\x35\x11\x3b\xc5\x17\x20\x3f\xe3\x00\xb8\xbd\xae\x6a\x96\x1d\x18\x4c\xd7\xb2\xf1\x44\xe1\x76\x90\xd8\x2c\x5b\xcd\x2a\x3e\x57\x92\x0b\x4e\x6a\x3f\x51\xb3\x1a\x99\xb7\xaf\x01\xe6\x56\x11\x86\x32\x95\x2f\xc8\x6f\xc5\x70\x1d\x37\x89\xa6\xfa\x5e\x86\x99\xb7\x71\x1a\x14\x71\x40\xa0\x7d\x6f\xf8\xbd\x0d\x8f\x2c\xf6\xb6\xbb\x0f\x1c\x72\xa9\x00\xd5\x62\xc3\x1f\x47\xbd\x6a\xb1\xa5\x4c\xb8\x0f\xf6\x84\x6b\x06\xeb\x92\xb6\xd8\x27\x26\xc7\x35\x6a\x7a\x17\x33\x44\x5b\x8f\x74\x2d\x16\xa2\x58\xc0\x7d\x86\xc3\x9d\xf4\xf7\x2c\xdc\x9a\xeb\x75\xaf\xbb\x81\x3d\x93\x47\x71\x05\x0e\x71\x4f\x0c\x72\x7c\x73\x4a\x5b\xc7\x0d\x2e\xab\x1d\xf6\x18\x0c\xad\x0f\x1d\x94\xc5\x90\x80\x57\x3c\x78\x3c\xe4\xf6\x75\x36\x5b\xb4\xcf\x59\x00\x22\x46\x2f\xbe\x00\xfb\xf0\x06\x29\x1d\x1d\x0c\x69\xd8\x97\xe2\xa1\xe7\x0b\x06\x5a\xaf\x6d\xec\xac\x32\xbd\x72\x44\xff\xa1\xb5\xeb\x9d\xb7\xbf\x46\x49\xb2\xa3\x8e\xa3\x7f\xd3\xe6\x7c\xbc\x82\xa7\x57\x5a\x7b\xcf\x3a\x84\xe7\x23\x52\x9c\x01\x05\x0a\x43\x49\x36\x8f\x01\xc5\xff\x1b\x59\xc6\xc6\x05\xe0\x77\x60\x7a\x0a\x1d\x42\x1f\x24\xcc\x9b\x7d\x5f\xfb\xc2\x62\xe6\x08\x6f\x12\x64\x4d\x5a\x7e\xe3\x8f\x36\xab\x9b\x50\x30\x7a\xa1\x55\x46\xc4\x96\x7d\xd0\xc3\x52\x01\x41\xd9\xff\xdf\x4f\xdf\xe5\x58\x1c\xb3\xfe\x8a\xe6\x52\x32\x91\x7b\xbf\xde\x5c\x49\x15\xd3\xe4\x9d\x8d\x43\x00\xc8\x1f\xb2\xf6\xf0\x86\x1d\x2b\xa4\x46\xfd\xc4\x84\x73\xd3\xf8\x42\xd3\x1b\xf5\x28\xa8\x14\x98\x4d\x3a\xf2\x1d\xf1\xa9\xd7\xcd\x98\x80\x51\x74\x8e\x8f\xfa\x88\xd0\x97\x09\x41\x74\xcd\x27\x35\xa4\xab\xa5\x92\x53\x24\xeb\x6d\x8f\x1e\x36\xab\x80\x88\x69\xe7\x0b\xc5\x23\xe3\x4f\xf1\xe8\x6b\xc6\x25\x4f\x73\x6d\x39\xe9\xe3\x01\xa1\x0a\xa5\xce\x30\x39\x42\x89\xf5\x48\x72\xe0\x88\x2a\xeb\xad\x25\xe6\x15\x23\xa7\x0e\x7c\xbe\x48\x7b\xa1\x7a\x69\x04\xba\x4d\xd6\xa8\x0d\x23\xae\x82\xc7\x1f\x98\xdd\x85\xe4\x08\x77\x2e\x2e\xdf\x3c\xd1\x5b\x9e\x83\x6d\xb0\x4c\x40\x30\xc2\xb9\xc8\x54\xf0\x52\x8d\xee\x90\x0a\xe0\x3e\xa1\xaf\x2e\x24\x14\xd7\x65\xff\x4f\xbc\x6a\x80\xf7\xfd\x76\x42\xf2\x4c\x5f\x81\x1d\x5a\xf0\xdc\x28\xcd\x01\xa3\xa9\xb9\xac\x20\xbe\x49\x54\x50\x02\x1d\xfc\xab\xeb\xb5\xde\xcd\x85\x4c\xd5\xf9\xcd\xfa\xdc\xf9\x41\x04\xfd\x39\x9f\xf9\x27\x56\xde\x47\x62\xcb\x04\x0e\x9b\xa9\xe3\x71\x54\x14\xbc\xca\xc7\xab\x96\x79\xd1\x75\x5f\x70\x3a\x16\xae\xe9\x8d\xc8\x21\x3f\x12\xa1\x75\x04\xd0\x56\x1d\x3b\x3a\x59\x11\xdb\x42\xad\xda\xcc\x9e\x76\x21\xdf\x8c\x8a\x05\xa8\xff\xca\xf6\x7c\x65\x1d\xca\xb5\x63\x11\x36\x61\xcc\xcd\xe0\xce\xcd\x58\xb3\x8c\x19\xcd\x51\x33\x02\xe5\xdf\x3b\xdd\xdf\xa7\x0f\xe4\x0e\x0a\x2b\x79\x70\xab\xe5\xbf\x6e\x52\x75\x5c\x66\x58\x82\x9e\xb8\x2a\xc6\x36\xc7\x8e\x8d\x39\x9e\x95\xd3\xff\x82\x2f\x6d\xfb\xc4\xe3\x75\x3f\xd6\x78\x18\x58\x20\x08\x3e\xd4\x19\x43\x43\xc7\x58\x1a\x4f\xf0\x1e\xfd\x43\xb9\x9f\x5c\xed\xc6\x57\x09\x4d\x09\xd6\x20\x7a\x3b\x0d\x1e\xd5\x88\xdc\x7e\xd7\xd1\x34\x53\x0b\xe8\x05\x38\xdb\x3c\x09\x7b\xdc\x43\x3e\x1a\xcf\xe4\x67\x56\x87\xdc\x4a\x7b\x93\x02\x17\x46\x03\x66\xc7\xb5\xd7\x7d\x49\x9a\x11\x9a\x1c\x68\xd8\x74\xb4\x11\x86\xab\x2b\xd9\x6b\x13\xad\x65\x7b\x71\x01\x3d\x6c\x96\x62\x99\xac\x0e\x4c\xc2\xa1\xd5\x95\xaf\x5b\x80\x77\xef\xe9\xaa\xc6\xb7\x78\xfe\x40\x31\x26\x8e\x8b\x7c\x54\xe1\xf6\xd5\xe4\x1d\xdc\xec\xc6\x12\xb6\x5b\x6d\x6e\xfa\xba\x5f\x29\x0e\x57\x5c\x04\xd8\x37\x22\xd3\xe0\x8e\x30\x69\xc1\xce\xe6\x32\x54\xd9\x02\x8d\x9f\xde\xe8\x9d\x5c\xe1\xf9\xa0\x82\x56\x92\x74\x8b\xa6\x1c\x65\xf8\x0a\x75\xc3\x6a\x73\x43\x76\xe8\x40\x7c\x6d\x5a\xcd\xc2\xa4\xc1\xe1\x60\xaa\xcd\x7c\xbf\xd7\xd4\x13\x51\x7a\xc8\x9e\xeb\x21\x7a\x80\x2e\xb4\x8b\x2d\x3b\xac\xf0\x8f\x8f\x34\x11\x7e\x4d\x6f\xde\x6c\x0d\xd7\x87\x03\x90\x5f\x63\xca\x98\xd5\xd0\x55\xa1\x16\xf2\x09\xf6\xcb\x33\x07\x4a\xff\xac\x8c\x87\xbb\x86\x00\x36\x77\x54\x19\x1b\x0c\x15\x34\x0e\xbb\xb5\x56\x0f\x55\xe9\x13\x4e\x70\xd6\xeb\x72\x9d\x12\x6d\x5d\x54\x4d\x6f\x5e\x8a\x82\xca\x13\xab\xeb\x15\xa2\x4b\x78\x86\xc3\x01\x48\x91\x1e\x29\x62\x97\xc1\x67\x97\x3a\xe0\xdc\xcb\x11\x38\x22\xe3\xa9\xb0\x7d\xfc\x1d\x2f\x1f\x88\xee\x75\xb1\xbe\x14\x52\xa7\x34\x72\x5b\x17\xf4\x16\x1e\x7a\x5b\xde\x2b\x20\x01\x7e\x76\x32\x62\x44\x01\xb1\x52\x4e\x49\xee\x89\x69\x86\x17\x02\x49\x5e\xe5\x4d\xd2\x10\xb4\x19\x27\x56\x9e\xe2\x24\x0b\x76\xa8\xaf\x76\x5f\x42\x58\xd5\x84\x6f\x60\x7c\x7c\xd7\x47\x99\x9e\x38\x0f\x53\x4d\x38\xbe\xc0\xa2\x11\xe0\xcb\x97\xc7\x01\x75\x06\x9e\x0e\x66\xa3\x65\x6b\x34\x91\x65\xf5\x20\x03\xa1\x59\xea\x8b\xe3\xe0\x17\x35\x26\xb7\xe0\x6a\xcf\xc5\xd2\x84\x37\x36\xa1\x80\xbd\xd8\x08\xc7\xc6\x21\xbe\xe0\xcb\xb2\x4d\x1e\xaa\x32\xad\xa8\x64\x2f\xb7\x84\xb2\xaf\xa3\xf7\x98\xb0\x59\xf5\x3a\x3f\xb7\x24\x9e\x2b\xa9\xb5\x87\x9d\xb6\x35\xf4\xee\xd1\xb2\xdc\xf6\x1e\xee\x87\x0a\x45\x62\xd5\x7e\x75\x1a\x72\x1e\xec\xc7\xc8\x2c\x1a\x58\xfd\x72\x5c\xd6\x6d\xba\x7c\xe0\x19\x59\x56\x8e\x4b\xad\x94\x77\xc4\x2f\x15\x50\x55\x24\xc1\x15\x2e\x1c\x72\xe1\x76\xab\x03\x43\x27\x07\x7c\xc3\x4a\x65\x73\x5b\x59\x3a\xc1\x62\x07\xb2\x5d\xba\xd9\x29\x22\xbf\xbd\xc8\xe4\x37\x22\x0e\x49\xf7\x15\xc6\x65\xeb\x07\x81\x38\xfa\x0f\x9c\xaa\x02\xda\x79\x74\x43\x7b\x89\x55\x1b\x0a\x02\x4c\x61\x08\x64\xad\x57\xaa\x9b\x96\x1d\xa5\xc2\x0f\xe5\xbe\x8f\x18\x68\x4e\x90\x52\xdc\x29\xb8\xab\x30\xe8\x0c\x69\x92\x92\x66\x7a\xf0\xa4\x32\xc8\xd0\xe4\xfd\xb0\xd9\x7a\xd5\x39\xf0\x1f\x04\xb9\xcf\xe6\x92\x9f\x0e\xec\xdc\xde\x14\x32\xe1\xc8\x0c\xf6\x9a\x27\x52\x62\x17\x4a\x4c\x84\xdb\x31\x04\xc8\x18\xd6\x00\x72\xa7\x45\xe4\x70\x96\x7e\x2a\xf8\x1f\x97\x6a\x7f\x1c\x22\xd7\x9d\x05\x63\xb6\xa3\x49\xde\x2a\xc9\x47\x06\x98\x07\x5e\xbf\xcf\x06\x61\x53\x0e\x10\x04\x96\x83\xb3\xf5\x6b\xa7\xe6\x6a\x9f\xe0\x57\xda\xe7\x7e\x27\xc8\x32\xb7\x40\xbc\xdc\x23\x8a\xaa\x0b\x9c\x69\x2d\xe1\x82\x19\x6e\xb7\x2a\xb3\x12\x62\xda\xf5\x15\x1e\xed\x09\xb6\x7b\x38\x83\x1d\xea\xd8\x69\xba\x66\x63\xbf\x89\x84\xa5\x68\x4c\x2b\x25\x10\x28\xf6\xb9\x60\x43\xa5\x27\x97\x3f\xfd\x98\x30\x80\x71\xa4\x93\xe3\x97\x51\x99\x7b\x9d\x9c\x7d\xd3\xe7\x4b\x1a\x5a\x87\xd5\x40\x6e\xa7\xaa\x2c\x9a\xe0\x40\x6a\x30\xf9\xad\x40\x91\x43\x77\xf4\x9a\x88\x13\x6d\x34\xdb\x1c\xd4\x67\xf5\xe8\x6b\x2b\x39\x83\x34\xcc\xd8\xd1\x92\x19\x47\x99\x3b\x3f\x0f\x1b\x71\xe2\xfc\x4a\x4c\x31\x80\x32\xb8\x47\xbb\x9f\xe4\x70\xb4\x02\xee\x7e\xe8\x25\x5c\xa3\x32\x9e\x9b\x32\xda\x10\x25\x44\xe1\xb1\x6a\x3b\x81\x37\xe8\xab\x53\xa7\x40\x82\x27\x11\xf1\x9f\xd0\xf7\x9e\x89\x49\x31\x36\x42\x5c\xe9\x0d\x6b\x03\xc4\x85\x2b\x46\xef\x32\x4f\xd8\xe5\xe8\x8b\xc1\x88\xd6\x9c\x32\x00\xe9\x69\x0b\x08\x94\x22\x56\x1b\xc5\x92\xcd\x01\x57\x82\xa0\x47\xbc\xb9\x9d\x57\x30\xb9\x10\xde\xca\x50\x62\x35\x5a\x03\x8a\xae\xea\x7f\x5c\x75\xed\xf2\x56\x96\x85\xbc\x8c\xb7\xc1\xd2\x9c\x4f\x11\xd2\x1c\x45\x34\x8a\x1f\xc7\x7f\xa3\x8a\x89\x6a\x86\x0b\x98\xc1\x47\xed\x9b\x33\xd0\x10\x67\x2b\x53\x25\xf0\x52\xd1\x00\xfb\x2f\x2f\xc5\xb9\x37\x30\x60\xd1\x72\x94\x52\xe2\x0f\x69\x03\xc4\xac\x6e\xc0\x65\x16\x0f\x36\x11\xb9\x00\x98\x5f\xe3\x18\xfd\xa7\x7e\x43\xdf\x73\x0b\x86\x04\xc2\xc2\xf1\x1c\x9d\xd2\x44\x10\xb5\xb6\x79\xb8\x40\x22\x68\x9a\x97\x9b\xa2\x43\x3d\xdc\x06\x90\xa0\x4a\x2d\xa8\x73\x56\x5c\x84\x4f\xa8\xcb\xf8\x8e\x69\xef\x82\x08\x7b\xf2\x10\xde\x88\x0b\x81\x70\x27\xdb\x90\x4f\x2b\xa8\x05\x0e\x9f\x1b\x68\xa1\x27\xf4\x60\x77\xc1\x6e\x37\xac\x7a\x2d\x0f\xa4\xee\x0a\xed\xa0\xeb\xf0\x88\x7c\x82\x61\x94\xe0\x27\xe9\x2d\x56\x23\x54\x29\xe0\xa7\x79\xe8\xf7\xd8\xf3\x67\x2d\x8f\x58\x6e\x46\xb9\x50\xae\xb0\xb1\xa7\x43\x8a\x0a\x83\x8c\x79\x93\xec\x66\x66\xa7\x7c\x65\x12\x5e\x4c\x31\x7a\xba\x9a\xcf\xcf\x3d\x5e\x18\xda\x20\xf5\x68\x61\xad\xcd\x81\xb3\xef\x55\x23\x68\xa4\x63\xa0\xd8\x27\x54\xa1\xfc\x80\x39\x7a\x08\xaa\xb0\xed\x6b\x67\x53\x5b\xe3\x9f\x9f\x6d\x9b\xe3\x7f\x4b\x1b\xe8\xdf\x76\xf0\xd2\xe8\x48\x67\x3f\x26\xf2\x7f\x78\xd9\xb1\xc3\x36\xd2\x4b\x5f\x2a\x0d\xa0\xc4\x76\x8e\x81\xba\x82\x91\x25\xe0\x18\xab\x8a\x9d\x49\x06\x83\x6e\x2b\x66\xae\x72\xf8\xa9\x56\x0f\xb2\xab\x4a\x7a\x30\x31\xe9\x7a\x0f\xd0\x0e\xfa\xe7\xf2\x6a\x46\x1a\x89\x83\x0e\xb7\xe1\x9a\x7c\x9a\x0f\x85\x86\x72\x6c\xb1\x44\xff\x0e\x65\x04\xb6\x0a\x61\x02\xd1\x2c\x25\x79\x97\xe2\x68\x38\xf8\xa0\x1a\x3f\x25\xec\xa9\xff\x30\x8c\xdf\x27\x87\x59\x3f\x95\x7f\x02\x6c\x00\x47\x24\xaa\x98\xb9\x90\xd0\x67\x50\x7f\x2c\x28\x3f\xa9\x4a\x76\xe9\xe1\x74\x57\xd0\xfb\x00\x79\x9b\x17\xde\x6a\x76\xf0\x78\x30\xa5\x76\x6e\xa6\x5d\xf8\xf1\xfa\xbc\xbd\xc7\x8a\x5f\xbe\xe1\x7c\x4f\x5e\xe3\x2d\x66\xb2\x34\x81\x1f\x63\xd7\xcc\x38\x22\xa6\x1d\x6d\x93\xb3\xd8\xd7\x38\x5b\x56\x7d\x83\x35\x57\x52\x81\x72\x83\xb7\x6e\xba\x0e\x59\xe0\x33\xa4\x65\xe8\x06\xbd\x6c\x2a\x2f\xc8\x42\x8c\xcd\xca\x69\x2c\x16\x32\x3a\x7c\x20\x42\x54\x19\x32\xe7\x80\xe4\x3a\x11\xa9\x08\xc0\x76\xa8\x96\xf1\x9b\xed\x09\xb9\x27\xc6\xec\xdd\x6b\x00\xc0\x96\x1e\x4d\x9e\x9a\x31\x6f\x6b\xca\xb3\x34\x9c\x1f\x0b\xed\xff\xcf\xbe\x77\xd8\x76\xc6\x56\xf0\xce\xc0\x71\x90\x55\xf1\xf7\xfc\x56\xb6\x8d\x22\x23\x77\x21\xbc\x50\x93\xbc\xae\x34\x13\x5a\x9d\xcc\xc6\xff\xf0\xe5\x01\x45\x2b\x60\x03\x5f\x9f\xdb\x44\x72\x01\x80\xb2\x86\x12\x1b\x80\xb4\x5f\x0c\x8a\x7b\x86\x35\x7f\x93\x3b\x10\xc0\x0f\x36\xec\x21\x2a\xda\x94\xaf\x00\x48\xb6\x31\xeb\xac\xab\x8d\xcc\xb6\x6c\xaf\x96\xa8\xa3\x86\x26\xea\x8d\x1b\x10\x04\x94\x28\x6f\x84\x2d\xd6\x0d\x94\xb6\x48\x60\x08\x82\x8d\xae\xae\x08\x5d\xb7\x17\x6a\x59\xa4\x93\x8f\x41\xc1\xe6\xc0\x76\xb8\x2a\x98\x89\x1c\xe1\x04\xc9\xe1\x00\xcf\xe0\xbe\xb0\x39\x9c\xa7\x5c\xda\x04\x23\x0a\xf6\x14\xd7\xb2\xc9\x1f\x9a\x04\x8e\x22\x68\xc1\x49\x12\xf0\x8c\x0d\x09\xdd\x73\xbe\x2c\x52\x2d\x90\x7c\x2c\x85\xed\x12\x38\x99\xb6\x53\x3d\xdc\xf9\x82\x9a\x91\x85\x3d\x29\x3b\x94\x78\x94\x15\xf2\xad\xe2\xe8\x0c\x38\xc4\x90\x33\x53\x48\x74\x6d\x32\x20\x7f\x45\x9b\xb8\xa7\x36\x7c\xba\xf6\x77\xa5\x5d\x80\x35\xb3\xfa\x42\xfa\x92\xf7\xc9\xf9\x3e\x94\x9e\xbb\xc9\xd6\x9d\x4f\x1d\x4d\xeb\x67\xe4\x65\xe5\x40\xac\x19\x44\xfe\xd5\x13\xda\xbb\x3d\x02\xc2\x45\xbf\xf7\x65\x17\x7d\x49\x14\xc9\x46\x5c\x79\xbf\x1a\xd5\x51\x70\x9b\xac\x18\xc2\x04\xed\xe6\xc5\x07\xe3\xbc\x0c\x55\xd6\x0b\x2e\xe8\xd1\x9a\x74\xc8\xe7\x59\x89\x28\x59\x12\x1f\x7c\x01\x38\x9e\xc7\x92\xbd\xf3\xef\x36\xcc\x5d\x0c\x96\x21\xe1\x74\x7b\x5f\x39\x77\x0c\x36\x9b\xf9\x8f\x9c\xec\x91\x4a\x73\x26\x7a\x28\xd0\x19\xb1\x7e\x09\x38\x38\x7f\x1d\x0a\xec\x33\xfc\xda\x26\xc2\x9e\x22\x26\xa6\x77\x5e\x5a\xdd\x53\xb5\x55\xad\x82\xe7\xe2\x55\xe1\xf1\x27\x45\x6c\x4b\xdd\x7e\xdc\x3f\x82\xc7\x75\xaa\x61\x5b\x94\x63\xd6\x52\x2f\x9f\x84\x1c\xfa\xe2\x52\x1d\x5a\xdd\x92\x76\x0f\x4f\x9a\x46\xd8\x49\x3c\x01\xf6\x15\xa0\x9c\xac\x91\x33\x18\x3a\xd9\x46\x22\x21\xc8\xbe\x5a\x62\x8d\xaa\xaa\x36\x55\xea\x8f\x1b\xcb\xc4\x03\xf3\x0e\x0c\x80\x69\x95\x10\x36\x89\x36\xac\x29\x5a\x73\x28\x71\xf8\x47\xd0\xbd\x8c\x33\x10\x3c\x4f\xca\xf4\x0e\xf9\xd5\x00\x62\x31\x7e\x79\x93\x29\x82\x70\x49\x9a\xa6\xba\xd3\xaf\x65\xbd\xc7\xa8\x48\x5e\xb3\x25\x60\x46\xbc\x63\x64\x9a\x43\x46\x51\xd5\x2c\xc5\xe5\x81\x42\x86\x67\x4c\x90\x91\xb7\xf5\x13\xb4\xaf\x26\xed\xd1\x0c\x8d\x93\xfc\x39\x73\x1a\xa3\xd1\x34\x3b\xa5\xca\x42\x6e\x1a\x94\x1d\xbb\x21\x0d\x3f\xe2\x09\xf6\x18\xf2\xb6\x6a\xf3\xa2\x30\x8e\x06\xf3\x03\x97\x8c\x79\x6b\x81\xf8\xbf\xa9\x4b\x2e\xb1\xb1\x16\xdb\xb1\xcd\x4c\x48\xee\x2e\x19\xe7\xed\xff\xbd\xe8\x6c\xd3\x39\x08\x9f\x7f\xc7\x7a\xac\x2b\xa9\xf6\x50\x07\x64\xa2\x09\x2e\x96\x34\x40\x32\xbd\xe8\x43\x84\xe6\x73\xa6\xca\xbb\x5f\xe3\x75\xca\x80\xf4\x81\x44\x03\x02\x20\x23\x7e\x79\xfb\x0e\x1d\xfe\x68\xc2\x3a\x5e\x4c\xaa\xfd\xff\xee\xe8\x01\x95\xb7\xb1\x78\x0b\x19\x06\xbf\xb1\x5f\x24\xc1\xd9\x29\x89\xb0\x51\x66\xbe\x37\x58\x14\xe9\x3c\xcb\x23\x9e\xb7\x2a\xaa\xdb\xa6\xdd\xd6\xb5\x47\xa1\xf7\x6b\x2c\xe1\xeb\x43\xa9\xe6\xe3\x57\xf9\x6e\xfe\x95\x76\xc3\x30\x5c\x95\xb7\xd3\xcd\xd2\xf7\xa4\x31\xff\x96\xbb\x63\x71\xa1\x88\x54\x5b\xa5\xcc\x7a\xe0\x72\x48\x16\x66\x32\xcf\xb4\xf6\x5e\x8c\x7a\x0c\x8f\x89\xea\x3a\x96\xd3\x99\x60\x24\x19\xb3\x66\xca\x21\x27\x08\xf9\xd6\x56\x19\x17\xe2\xa2\xc1\x72\x00\xa4\xe1\x84\x4f\xef\x6b\x3c\x0b\xcf\xee\x0f\xcb\x4d\x40\x3d\x22\x09\x57\x2b\x40\x10\xef\xcf\xd7\xae\xcd\x30\xc1\x34\x20\xb4\xd1\xf9\xf0\x9a\x5a\x38\xdc\xb3\x89\x61\x7d\xe2\xc5\x39\xcf\x38\xa1\x23\xff\xc8\xc2\x36\x70\x47\x1c\xdb\xe7\x2e\x74\x82\x73\xb9\x98\x83\x5b\xbc\x0c\x45\xff\x6b\xab\x2a\xeb\xe6\xa8\x4d\xff\xdd\x7c\xd7\x7e\x81\x11\x5a\x04\xd7\x54\xe4\xc5\x64\x25\x56\x57\x8b\xd8\x73\x6f\xfe\xa5\xeb\x52\xf2\xb4\xa4\x94\x5e\x3c\xc7\x57\x70\x56\xf5\x32\xa5\x35\x8f\x2d\xd6\x1c\x5a\x20\xd2\x61\x37\xa6\x22\xc2\xc5\xbc\xbe\xd4\x1e\x74\xc3\x11\x4b\x9e\xce\x76\x38\x97\x03\x92\x0d\x83\x29\x3a\x3b\x0a\x86\x86\x11\xc7\xd9\x79\x2e\x8b\x9d\xf2\xda\x2c\xb1\xa5\xba\x3d\x71\x75\x0e\x39\x47\xca\xb9\xc0\x0f\x63\x64\x27\x2a\x0c\x7a\x30\x93\x73\x29\xce\x23\x66\x8f\x74\x4a\xe8\x1f\x1e\xf1\x23\x12\x73\xab\xbf\x2d\x0e\x93\xe4\x83\xa8\x98\x06\x41\xd7\xec\xf6\xb8\xe9\x6a\xcb\x4a\x81\xa9\xf3\x09\x7e\x41\xb2\x48\x45\xce\x0a\xfa\x7d\x10\x94\x76\x84\xf7\x04\x62\xb3\xd4\x26\x0a\x09\xb6\xc0\xaa\x30\x5f\x7e\xdb\xe6\xd4\x0b\xae\x64\x38\x1b\xe4\x66\xe4\x35\xff\xd5\x58\xd6\x84\x80\x3e\xa7\x7b\xf4\xe1\x43\xc4\x39\x99\x9d\xfa\x73\x2a\x32\x8b\xd1\x50\x90\x35\x5d\x36\x78\x7c\xaf\x1a\x0a\x83\x1e\x24\x94\xab\xad\x75\x3c\x52\x67\x56\x18\x30\xb8\x44\xe8\x30\xd5\x79\xbd\x94\x3a\x4f\x87\xa9\x3e\xf8\xf1\x30\x45\xf9\x08\x3e\x4e\x8a\x70\x2d\xa9\x50\x8a\x91\xa6\xbf\x2e\x90\x84\x8a\x6e\x15\xf8\xb9\x38\x85\x52\x98\x21\xa9\x4b\xce\x7f\x70\x6b\x3b\x17\x65\x1a\x3d\x62\x4f\x32\x96\xb0\xb5\xb4\x69\x04\x34\xd2\x21\x91\xc8\xb6\x04\x76\xb9\x05\xe2\xce\x8c\x9c\xc5\x53\xc2\xe2\x1d\xe8\x28\x32\x78\xb4\x8f\xc6\xe9\x07\xf4\x22\x03\x49\xf8\xa1\x19\xae\x7b\xcf\x5f\xb3\x9a\x11\x26\x5d\x3b\x28\x19\xef\x6e\x4f\xb1\xd2\x14\xbc\x11\xc4\xa7\xf5\xfe\x8e\xf7\x04\x29\xcd\xf8\xa4\x69\x59\x0c\xa2\xd8\x83\xa2\x4c\x8f\xc5\x42\xa7\x4c\x82\x52\x6b\x4d\xc6\x8f\x78\x72\xb7\x7a\x6b\xdc\xc1\xa2\x6c\x01\x67\x13\xbd\xf9\x6e\x4a\xe3\x1e\xb5\xeb\x21\x23\x18\xe9\x04\x03\x1c\xfd\xf8\x1d\x23\x3a\x9a\xc0\xba\x63\xf7\xac\x16\x31\x81\x09\x8d\x25\x4c\xd6\x3d\x45\xbe\x56\x18\x53\xbc\xef\x47\x21\x8b\xc6\x60\x01\x88\x7a\x81\x83\xd8\x16\x07\x51\xb1\x80\x19\xed\x9e\x10\x59\x5e\x10\xe2\xb1\x85\xda\xef\x7d\x09\x47\xda\x3c\xec\x1d\x2a\x64\xf8\x58\x61\xdf\x9e\x06\x23\x1f\x2f\x45\xcf\x4e\xee\xa6\xed\x12\x65\x33\x61\x31\xd9\xb5\xba\xbf\x8b\xd9\x68\xcf\x0c\xe1\xd3\xad\xa9\xbd\x86\x68\x29\x5b\x07\x68\xa8\x5f\xea\x73\xe9\xc1\x0d\xe8\xfa\x15\x68\x9b\x54\x48\xe3\x1f\x1c\xb2\x76\x8c\xac\xe6\x41\x6d\x50\x7e\x39\xd6\x67\xc7\xd1\x15\xb1\x32\x3f\x73\xc2\x82\x52\x9b\x4f\xdc\xf9\xea\x3f\x03\xb0\xf2\x34\x67\x99\x26\xbf\x4f\x48\xeb\x07\x2c\x1a\x72\xe8\x1f\x5e\x5d\x00\x29\xe4\x13\x5a\x1c\x06\x1e\x18\x2f\xf0\xc8\x4b\xa5\x29\xeb\x52\x23\x41\x5a\xb1\xbe\x4d\xec\x86\x89\x77\xef\x32\xa6\x16\x79\x9f\x27\x90\xa0\x91\xea\x7e\x19\x2c\xe6\xd1\xed\x4b\x18\xf9\x83\xc5\x3c\xac\x40\x33\x19\x42\x8d\xc1\xaa\x35\xfd\x6e\xe5\x06\x72\x66\xe0\xc0\x04\x06\x38\x4f\xae\xb8\x1a\x96\xc6\x42\x46\xf6\x5d\xa3\x7d\x44\x55\x15\xfc\x4b\xc7\x54\xae\x53\x42\x30\x22\x0d\x29\xa7\x2c\xff\x2f\x63\xa8\x81\x54\x91\x73\xa7\xfa\x01\xb3\xb5\xa4\x5f\xe5\x62\x63\xf4\x61\x41\x1e\x54\xb0\xe7\x95\xe5\x5a\x7b\xc2\xfc\x31\xc1\x8a\x3f\x1d\xe6\x8a\xce\x36\xc0\xf7\x6a\x38\x96\x8e\x41\x53\x82\x98\xfe\x2f\x7c\xb2\x1e\xbf\x00\x5d\xc6\x42\xd9\xff\xcc\x4c\x02\x5c\x8b\x08\x29\x2d\x88\x3d\xb0\x16\x1e\xaf\xb2\x3c\xfb\x07\xe3\x02\x86\x3b\xb4\xc5\x51\x14\x59\x39\xbf\x7b\x17\x49\x35\x8b\x31\x6e\xa1\x49\x50\x64\xdc\x98\x86\x4f\xd5\xea\x08\xc0\xd0\xbe\x80\x3b\x08\x94\xae\xcb\xc2\xaa\xed\x41\x8e\x21\x23\x87\x45\xb6\xfb\x29\x40\x3f\x6b\xc4\x49\xb5\x7b\x12\x8f\x95\x67\x01\xce\x9e\x88\x24\xd8\x54\x46\x01\xd4\x26\x0a\x6c\x5a\x18\x90\x63\x0c\x84\x1d\xab\xd3\xa6\xeb\x41\x9c\x1a\x24\x05\xac\x70\x85\x07\xcb\x22\x48\x49\x9a\xf1\x72\xf8\xf0\x38\xcf\x70\xb4\x3e\x07\xfc\x56\x89\xf0\x80\x3e\x0b\x95\x6c\xb7\xa1\xb3\xab\x78\xa4\x96\xa1\xcd\xae\x46\x79\x39\xcd\x2c\x0d\x54\xbb\x3e\x61\xdc\x01\x92\x83\xb3\x5b\xac\x2f\xfd\x64\xca\x85\x46\x54\xe5\x94\x31\xf0\x6a\x08\x28\x2b\xc4\xf7\x8c\xdc\xbf\xdd\x9e\x1d\x3d\x6a\x2f\x3f\x44\xce\x10\xb7\xa2\x9e\xde\xb0\xb1\xc5\xfd\x67\x59\x80\x02\x51\x50\x2c\xc4\xb9\x55\x80\xd9\x3d\x7c\xb8\xdc\x7f\x28\x3a\xee\xa2\x97\x80\xb3\x41\x7a\x9f\xfa\x82\x25\x89\xfe\x68\xd8\x5e\x13\x67\x0b\x27\xfd\xe4\x13\xbb\xfd\x19\x66\x05\x4b\x7f\x90\x57\x1f\x2f\x26\x60\xd7\xfa\x5d\xeb\xac\x74\xa8\xc4\x4b\x9b\x0c\x73\xfd\xdb\x1a\x1a\x51\xdb\xee\x4f\x65\x16\x62\xc9\x1f\x81\x22\x3c\x7a\xb8\xcb\xc2\x01\x3a\xd6\x09\xd4\x22\xd6\x26\x10\xc0\xf3\xa6\xfa\x56\x84\xd5\xba\x2d\x1d\xe8\xed\x21\x5f\x57\xcc\x5b\xa9\x3a\x57\x75\xae\xd7\xab\x43\x54\x6a\xab\x82\x5c\xc4\xa5\xfb\xd4\x3e\xef\xaa\x44\x15\x8d\x74\x5f\x16\x34\x44\x99\xcc\xcd\x6a\xd0\x92\xe5\x9e\x1f\xa0\xb2\xa2\x3f\xfd\x96\x28\x31\x88\x2a\xd8\xfc\x1c\x51\x04\x22\x83\x2a\x5f\xb4\xc8\x40\x60\x23\xa6\x74\x40\x10\xee\xf7\xf1\x6b\x63\xdf\xcb\x2f\xa5\x88\x27\xa4\xb3\x48\x5a\xca\x0e\xb6\xe6\x4d\x52\x86\xb5\x69\x07\x76\x93\x14\x7b\x9d\xa0\xcc\x3d\xdc\xf4\xae\x4e\x1e\x60\xa2\x0c\x05\x82\xe9\x8e\xbe\xa9\x0b\x2d\x9a\x37\x8d\xbc\x3b\xff\x68\x05\x42\x8f\x13\x08\x50\x7b\x5f\xd8\x12\x4e\x0c\x05\x7c\xba\x03\x0e\xe3\x41\x7f\x4c\x56\xaa\x61\xdf\x21\x91\xf8\xd3\x5b\xb4\x65\x62\x78\xbc\x56\x9d\xdc\xd1\xa3\xe3\x0f\x70\xe4\xcf\x72\x62\xf0\x42\x17\x81\x4e\xfe\xf3\xd4\xc9\x0f\x41\x94\xf6\x98\xb6\x37\xa2\xc2\xba\x40\x71\xe7\xf6\xc2\x50\x72\x7d\x42\x44\xa9\x32\x44\xa4\x34\x62\xb4\xe0\x64\x59\x0e\x4c\xb9\xeb\x52\x0d\x0e\x95\x13\x7e\x75\xfc\x0d\x39\x1d\xf7\xe3\x57\x7b\x5b\x27\x2c\x1a\x94\x7d\x90\xad\x6b\x8b\xd4\xf7\xbc\x29\x97\xd0\x5f\x6f\x2a\x0b\xbc\xc2\x9e\x8e\xdf\x40\xf2\x43\x45\x58\x29\x4d\x11\xe7\x01\xf4\xd6\x1d\x20\x49\xc7\x2f\x64\x22\x28\xe0\xd3\xe2\x87\xe1\xf8\x11\xa6\x53\x55\x87\x65\xc1\x38\xe4\xdd\x8a\x9c\xc3\xd6\x3b\x24\xe7\x93\x68\x8e\xea\x8b\x01\xf4\xcc\xc7\x7f\x9b\x78\x57\x71\x97\x7b\xb9\x64\x4c\x07\x43\xcd\x10\x0f\x68\x33\xac\xed\x44\x4e\xa8\xda\xd3\xbb\x9d\xd6\xd5\xdf\xc5\x27\x27\xea\x85\x3f\x67\xa3\x7a\x3d\xdc\xe1\x6e\x51\x63\x4b\x63\x46\x8a\xcd\x2d\x8f\x06\xd7\x96\xae\x53\x74\x67\x03\xe9\xa9\xdc\x3d\xf9\x9e\xd0\xe9\x7b\x40\x1f\x82\x23\xe6\x68\x3b\x45\x81\xab\xf8\x89\xd9\x40\x01\x8f\xc0\x3a\xca\x4c\x17\x75\x6a\x01\xcb\xde\xe8\xc1\xf7\x94\xdb\x49\xd2\xf9\x51\x55\x3f\x3c\x3d\xaf\xa3\x46\x8e\xd8\x45\x35\x57\xc9\xa1\xe6\x6c\xc4\x59\x4f\x70\x92\x8d\x39\x9c\xee\xf6\x81\x65\xc7\x20\xf8\x6f\x24\x3b\xd5\x65\x96\x7b\xa9\x83\x89\x2d\xca\xf4\xcb\x8d\x3f\x53\x76\x28\xa4\x03\x45\x6a\xca\x36\x93\x39\x5e\xa5\x63\x25\xa8\x92\x0a\xcf\x5e\xd4\x99\x4d\x12\x48\x19\x7d\x61\xc0\x5c\x85\x2c\xa5\x4f\xb1\xe0\xad\xc7\x01\x02\xc7\xfe\x83\x81\xef\x94\xac\xe1\xbf\x59\x54\x14\xb1\x39\x58\x1a\x90\x20\x9c\x3e\xf1\x24\x9f\x52\x45\xb8\x52\x83\x86\x5f\xfc\x5a\xf9\x96\x6c\x99\xb7\xc9\x5d\x0f\x7d\x0a\x84\x73\x24\x34\xe9\x52\x74\x15\x70\xdb\x28\xec\x05\x16\xfa\xe2\xae\x46\x37\x15\xca\x33\xad\xd1\x88\xcc\x82\x41\x4e\xf5\x56\xa5\x5e\xa9\xb1\xb0\x3e\x2b\x9b\xa5\xdd\x38\x3a\xd1\xef\x41\x97\xf5\x95\xca\xb9\xff\x24\xf9\xe0\x0f\xd2\x1f\x1f\xda\xed\x4e\xd0\x45\x53\x38\x63\x58\x29\x82\x7d\x3b\x3a\x80\x5c\x4e\xa2\x08\x8e\xf9\xdd\x9d\xbe\x8b\xdd\x69\xe1\x38\x50\x8a\x80\x78\x4b\xca\x48\xb7\x9a\x43\x0e\xad\x8e\x85\x15\x6f\xcc\x48\x5a\xbf\xa7\x66\xef\xdd\xa4\x15\x58\x81\x51\x70\xcd\xfb\x16\x5c\xec\x76\xcd\x4f\x92\x5f\xc0\x04\xd3\xf0\xa9\xa9\x67\x2d\xe9\x0b\x10\x49\x86\xf4\xee\x65\x19\xe4\x13\xdf\x38\xd6\x3d\x2a\x6c\x22\xd4\x94\xc7\x89\xa4\x70\x60\x4c\x36\x00\x7e\x9c\x7b\x85\xdf\xb1\x3f\xe1\x12\xdd\xb7\x32\x28\x2b\x0a\xb4\x77\xf2\x33\x73\xdb\x2e\xcd\x29\xc6\xed\xb9\xfc\x6e\xc2\xb9\xa9\x01\x67\x25\xd3\x47\xe5\xa9\x21\x9a\xe5\xd3\x67\x4b\xe8\x43\xb0\x5a\x44\x42\x10\xdf\xab\x8c\x68\x5c\x40\x1c\x53\x4e\x43\x1b\x45\xaa\xee\x35\x4c\xf2\x3a\xf2\x36\xb8\x77\xae\x82\x32\x88\x81\xaa\xa8\xb2\xcd\x76\xdf\xef\xc2\x4e\x86\x35\x89\x71\xb6\x57\x8d\xcd\x9e\xe8\xf1\xd0\xa5\x3a\x21\xd7\x86\x7f\x8a\x62\x34\x31\x89\x9b\xf3\x11\xb5\x4b\x61\xa4\x01\xaf\x36\x39\xf6\x4d\xb1\x88\xee\x76\xf9\x7c\x9d\xf0\x33\x6d\x01\xa5\xb2\xaa\x56\xe1\xbf\x11\xf1\x0c\xbe\x97\x40\x6b\x50\xcf\x09\x02\x85\x6c\x0c\x7c\x83\x96\x24\xe8\xa6\xf2\x80\xff\x27\x9b\xc3\xd3\xd2\x01\xcf\xaa\xb7\x15\xa4\xea\xe4\xc1\x72\xea\xab\xcc\x26\xa4\xf3\xf4\x11\x84\xf4\xfa\xe4\x54\xd4\x6d\x5b\x80\xa2\x06\xfb\x1a\x8b\xcf\x97\x49\xa0\x3f\xa6\xca\xac\x56\x27\xf3\xa8\xd6\x94\xd0\x51\x38\x45\xd6\x9e\x18\x00\x8c\x16\x56\xa4\x09\xb1\xf4\x32\x8f\xfb\x14\xc4\x8c\xb2\x63\x4a\x94\xce\x56\xbf\xda\xde\x15\x88\x3c\x97\xab\x82\xd3\x3f\x05\xde\x93\x2c\x4f\xaf\x59\x06\x8e\x0d\xc0\x1d\x04\x07\x77\xdc\x5d\xf2\xf5\xc2\x06\xad\x79\x58\xb2\x98\x26\x65\x79\xdd\x17\xa9\x03\x83\x31\xa3\x56\x0b\x9c\xd2\x57\x5d\x23\x57\x2d\x63\x7f\xf6\x54\x06\x6f\xdb\x9c\x91\x59\xd4\xf6\x45\x83\x43\xa8\x1f\xca\x2e\xf9\xb5\x76\x22\x64\x43\x82\x05\xf7\x17\x3d\x00\x20\x0e\xd7\x11\xd5\xf2\xe8\x70\x5a\xed\x65\x1e\x24\xed\x80\xb1\xd8\x71\x48\xe0\x5e\xd6\x5f\x2e\x77\x74\x8d\xdb\xf5\xf3\x0e\x2a\x18\x9d\x72\xb5\x02\x93\x41\xbc\x3b\x50\xc9\x21\xed\x10\x25\x2e\x6f\xbc\x2d\x2d\xe9\xc6\xc2\xf0\x07\x31\x4e\xc1\x04\x3a\x59\x72\xa5\x05\x6f\x44\x74\x7c\xf3\xbd\xb9\xa4\xda\x8e\xcd\xf5\xbc\x0a\x94\x6b\x2b\xf4\x54\x93\x7f\xf4\x15\x5a\xee\x12\x66\xa0\xdd\xab\xd7\xf1\x15\x48\x34\xfa\x90\x10\xa4\xe3\xfe\xfa\x2a\x56\xa9\x68\xe6\x8a\x6b\x27\x47\x67\xf3\x9e\x90\xe4\x7d\x69\xab\xa9\x3a\x01\x62\x60\x0b\x44\x9b\x26\x50\x6e\xa6\x67\x28\x43\x7b\x8b\x6d\xe5\x98\x89\x85\x22\x49\xc7\x87\x5e\x5f\xe8\xef\x97\xc3\x1a\xa3\xd5\x5e\x4c\x5e\x9c\x38\xea\x7a\x4f\x0b\x29\x64\xb8\xee\xc5\x03\x3d\xb0\x2b\xd6\x8d\xdf\xa0\xa6\x8e\x01\xee\x6b\xa6\x6a\x5f\xb5\x24\xf7\x9b\xc1\x41\x40\xa9\x8e\x07\x8f\x02\x14\x2e\x6e\xe4\xb7\xeb\x5c\x71\x02\x49\x30\x71\xb3\x93\xad\x17\xf3\x31\xef\xb1\xae\x47\xb0\xac\x37\xfd\x79\xe9\xd7\xa0\x97\x47\x5a\x2f\xb0\x94\x9e\x4d\x68\xee\x15\x3f\xa7\xb6\x5e\xbc\xf5\xe3\xbf\x38\xf0\xd2\x12\x42\xe1\x5a\x22\xec\xcd\x18\xd2\xce\x9d\x13\xdf\xb7\xd1\x1b\x1a\xb3\x7d\xe3\xc2\xb3\xeb\xb6\xe5\x44\x72\x5f\x2f\xc7\x02\x31\x33\x7e\xca\x27\x88\x4d\x04\x65\x81\x93\x6a\x7a\xcd\x98\x41\xf3\xbe\x87\xc0\x4f\x36\xf0\xb0\x4d\x38\x82\x21\x1a\x98\x90\xe0\x2b\xbf\x91\x9a\x34\x3e\x68\x63\x47\xbe\xda\x38\x49\xd9\x91\x4b\xe2\x21\xfd\xd3\x02\x62\x90\xdf\x0a\xa7\x0a\x49\x9d\xcc\x17\x65\x74\x24\xc3\x1a\xc7\x86\xe6\xe9\x0c\x16\x68\xfa\x3d\x78\x2a\x97\x9e\x00\xc8\xd9\x91\x47\x0b\x68\x41\x49\x1e\xb6\x6f\xce\x9b\xe8\x9b\x8c\x5e\xcf\x8d\x4f\xbc\x9f\xdc\xb8\xbb\x46\xdf\x4e\x2e\x4f\xdf\x59\x7a\x6a\xb2\xd6\x22\x3a\x43\xe3\x4d\x13\x68\xe6\xa2\xe8\x1e\xce\x0f\xf8\x76\x71\x1f\x8a\xb0\x25\x29\x27\x01\x21\xe8\x69\xf6\x32\x90\x47\x08\xec\x08\x24\x98\xc4\xa4\xc4\xb6\x04\xd5\x4a\x00\xfa\xff\xd2\x3f\xa9\xc4\x3f\x04\x62\xbb\x61\xd8\x9d\xf0\x72\x87\xb3\x93\xb8\x76\x44\xaa\xfb\x16\xf8\xf0\x67\x68\x1b\xd0\x96\x40\xd0\x9a\xd8\x53\x6b\x38\x35\x40\x72\xda\xd5\x62\x84\xff\x85\xf8\xce\x91\xe8\x3c\x6b\xba\x09\x1f\x37\xc8\x57\xf0\xcc\xc1\x1b\x60\x39\x9f\x45\x68\xa0\x48\x96\x93\x6d\xcf\xf5\x71\x8b\x9a\x9d\x2c\x09\xad\xda\x4d\xba\x40\x1b\x23\xb2\x8b\x83\xf2\x54\xa3\x4b\x53\x7e\x49\x56\x60\x82\xd7\x78\x5e\xab\xb2\x2b\x48\x48\xa2\x71\xd6\xa5\xf6\x23\x2a\x1e\x70\xd7\xee\xa8\x3c\x7f\xf2\x0e\xb9\xde\xc6\x18\x68\xdf\x36\x85\xc9\x45\x86\xec\xd7\x23\xa4\x12\x3e\xe1\x9a\x26\xbb\xc7\xf0\x6f\x37\xa3\x44\x24\x13\x82\x61\x8f\x10\x28\xe0\x38\x10\xa3\x18\xb9\xd9\xb3\x25\x1e\x23\x65\x07\x64\x05\xc8\x2c\xa4\x4d\x01\x0e\x8b\xde\x8f\x5f\xa1\xb8\x69\x3d\xfe\x3c\x81\xac\x6c\x2d\x71\xbb\x3d\x15\x77\xed\x67\xc5\x9d\x9f\x39\x56\xe2\x74\x26\xc6\x67\x53\xeb\x77\x0b\x31\xbf\x1f\x15\xfb\x8f\x24\x3e\x5b\xef\xa0\xc8\xf4\x9d\x8d\xa3\x82\xb6\x38\xb3\x2e\x0c\x62\x13\x8c\xcf\x73\xbf\x62\x62\x32\x96\x93\x03\xcb\x55\x31\xdd\x40\x5a\xd6\x91\x85\x20\x8a\xac\xc3\x24\x8b\x68\xa6\x0a\x29\xb8\x07\x4f\xab\x45\x74\xf4\x03\x0c\xc0\x43\x95\x87\xd8\x98\x13\x50\x38\x51\x07\x04\x49\xa6\x00\x21\xcd\x00\x3b\xd6\xc2\xc9\x53\x48\xd1\xaf\x45\x23\x7f\xb5\x81\x84\xe4\x52\x1d\x57\x25\x13\x51\xc7\xf5\x37\xb2\x28\x7a\xf1\x99\x2a\x3f\x44\xdb\xeb\x2c\x07\xef\x5a\x9f\x0e\xdd\x15\x0c\x5c\x74\x64\x88\x16\xa6\x1b\x8f\xd2\x0b\x65\xf0\x7c\xe1\xa1\x8b\x83\x00\x0f\x81\x75\x5a\xb2\x11\x98\x0e\xaf\x8d\x30\x08\x94\x35\x7d\x67\x91\x32\xae\x06\x73\x85\x2b\xb5\x48\xde\x3c\x48\xc7\xb5\x7a\x83\x8d\xc4\x47\xdd\xb3\x12\x83\x39\x7a\x12\x5b\xe2\xcb\x33\xdf\x77\x51\x18\x02\xb5\x6e\x57\x3d\x32\xb2\x7f\x93\x86\xd4\xd9\xcb\x3b\x85\xa1\xc1\x24\x71\xe4\xeb\x0a\x8a\xde\xc4\x4a\x53\xc0\x48\xfd\xa5\x8a\x9e\x9d\x73\x7e\x0c\x2a\x42\xe1\x1b\x74\x1a\x05\x3d\xc8\xc0\xbb\x1b\xe6\xf8\xf5\xc5\x77\xda\x8a\x45\x68\xbb\x93\x3b\xe2\xb0\xe6\x2f\x6d\x73\x2f\x6f\x26\x2d\x10\x2c\x84\x92\x73\x68\xeb\x8a\x48\xd1\x79\xa2\xf4\xe7\x8f\xff\x62\x54\x6f\x71\x91\x8f\xa8\xb6\x43\x62\x4e\x25\x64\x11\xd4\x57\x1f\x30\x08\xaf\x29\xcb\xb9\x54\x77\x52\xf9\x82\x1b\x1e\xdd\x11\xa0\x43\xd0\x5a\x8a\x3e\x3c\xba\x65\x29\x04\xbb\x6f\xb5\xb4\x01\xc8\xce\xd3\x00\x5f\xd6\xd9\xfd\xa5\x6b\xdb\xd1\xa9\x3f\xa2\x24\x9c\x67\x32\x5d\x5d\xff\xe5\xb1\x46\x4a\x88\x45\x6b\x14\xdf\xe6\x7e\xd5\x35\x4d\x0c\xcf\xdd\x2f\x1a\xcc\xae\x1c\xb0\x6d\x9b\xef\x2b\xdf\x02\x7b\x20\x54\xe5\xfd\xe6\xce\xc0\x8f\xb3\xa8\x2d\x31\x69\x13\x80\x88\x25\x5f\xb3\xec\x09\xce\xbd\xae\x3d\x12\xe7\x57\x01\x29\x3b\x2f\x3d\x7e\x98\xc6\x12\x33\xfb\xfd\xd4\x86\x53\x3b\x4f\xe9\x25\x77\xae\x94\xc2\x78\x40\xe1\xb1\xc1\xd3\xe1\x40\x4d\x4d\x82\x53\xac\x7e\x4f\xac\xbb\xef\x32\x6d\x18\x57\xf3\xbb\xbb\xea\x09\x92\x87\xd2\x4b\x6b\x96\xd7\xfa\x36\xf2\x58\xf9\xfa\xf2\xee\xf4\x3e\xaa\x78\x58\xfa\x15\x2b\x80\xd7\xb8\xd5\x83\x9b\x30\x53\xa2\x66\xbd\x2f\x8d\x6c\x07\xb9\xfe\x63\x3d\xbd\x1c\xdb\x3d\x0c\xa1\xd4\x91\x77\xd9\xc9\x41\xb7\xbb\xa9\xb7\x33\x63\x58\x7f\xee\x7d\xd2\x9c\x54\x92\x3c\x02\x41\xe1\x70\x0a\xd6\xc2\xda\x04\xde\x77\x1c\x33\x16\x02\x20\x5c\x05\x99\x94\x07\x56\xc4\x01\x6a\x22\x77\x53\x2a\x1a\x37\x5e\x1c\x7a\x30\x0f\x80\x81\x9f\x17\xe7\x62\x33\xad\xaf\x7b\x00\x07\x59\x63\x5e\x5f\xc7\x8a\x1f\x1e\x70\x07\x05\x24\xbb\xa8\x0d\xef\x4c\xe9\x44\x5b\x26\x1f\xf3\x93\x75\xb1\x9e\x14\x9c\x6d\x93\x89\x5b\x7e\x18\x89\xcd\xc2\xf7\xf3\x1b\x35\x56\xb2\x6c\xc2\x13\x40\xc1\xd8\x57\x13\x79\xdb\x9e\x40\x92\x21\xd4\x4b\x9d\x81\xf5\x13\x02\x28\x62\x95\x21\xad\x31\xb6\x06\xe8\x6e\x60\xde\x09\xa8\x92\xe4\x45\xbe\x43\xea\x74\x38\xa9\xd3\x48\x98\x07\x34\x02\x06\x63\x3e\x08\xda\x09\x85\x77\xb9\x9d\x06\xbd\x9a\x09\x84\x84\xbf\xbe\xd9\xe6\x8b\xf8\x1d\x8d\x1c\xd0\x56\xcc\x1e\xb0\xe1\xcb\x1b\x32\xf4\x5f\x92\x7d\x40\xfc\x70\xbc\x72\xe1\xc3\x05\x50\xa2\x16\xa3\x4d\x0b\x61\xf9\x76\xad\xe1\x6e\x78\x87\xe2\x6b\xa6\xb9\x51\x67\x78\x1c\xb7\xb3\xc3\x1f\x5f\xd1\xbf\xca\xf2\xf6\x48\xa4\x76\xa7\x50\x54\xa1\x8a\xb7\x10\x18\xd4\xfc\x7c\xd1\xfa\x38\x34\x09\xf0\xa7\xb9\x97\x2b\xe8\x26\x6a\x1e\xdd\x4b\xf0\x5e\xaf\x3a\x73\x9e\x32\x39\x02\xf5\xef\x2e\xce\x41\x90\x70\xb0\xed\x35\x49\x4f\x6e\xb5\xb5\x99\xdc\x39\x3d\xe4\x71\x59\xb9\xb3\x50\xfb\x4b\xb2\x32\x9a\x9c\xfe\x92\x75\x9e\x74\xa2\x35\x3e\x0b\x06\x95\x69\x57\x26\xed\x46\x22\x2a\x2e\xe4\x4e\xf2\x3c\x93\xd3\x72\x83\x64\xc7\xff\xb7\xde\xec\xbe\x8b\xe5\xb2\x7d\xde\x96\x91\x22\xa0\x3f\x4d\x59\xcf\x21\xb1\x41\xec\x87\x33\xbf\x03\x01\x2b\xcf\x76\x67\x08\xb4\x10\xd0\x96\x9f\x63\x35\x3a\x2e\x14\x1a\xce\x48\x6a\x0e\x6b\x56\x76\x00\x18\xe7\x13\xeb\xd4\x1c\xd8\x5f\xb5\xb4\xe3\x30\x72\x7d\x99\xdd\xe2\x1b\xa8\xe2\x5c\xab\x5f\x29\xdc\x7d\x69\x3d\x3e\x23\x37\x5a\x6e\xc3\x36\xd7\x83\x1c\x2a\x7c\xd3\xa0\xdd\x80\x7e\x4d\x4e\xa8\x99\x2a\x5b\xaa\x94\xba\xdd\xf5\x3a\x92\x77\x47\xf9\xd1\x8d\x7a\x7f\x81\x3b\x26\x1e\x8a\x99\xd6\x4a\x4d\xb0\xbc\x94\x1e\xe3\x54\xbf\x60\x15\xa7\x68\x10\x54\x95\xdb\x9a\x82\xf1\xde\xa3\xaa\x9b\xd5\x06\x32\xda\x6d\x50\x47\xab\xef\x78\x87\x23\xf5\x06\xef\x2e\x32\x79\x67\xb8\x43\x2f\xab\x3a\x51\x1c\x86\x86\x54\x11\x8a\x46\x3b\x1b\x8e\x49\xdb\xf3\x47\xcb\x97\xf1\x05\x46\x8c\xfc\x1d\x3f\x59\xac\xf9\x53\x66\x0e\xf9\xa4\x3d\x20\xa1\x04\xb6\x3d\x0b\x4d\x52\xed\xc5\x9c\x9e\x82\x9c\xba\x41\x06\xd9\x4d\x90\x88\xb0\xfe\x40\x5d\x4e\xb6\x9d\x2c\xea\x7b\x32\x7d\x76\x11\x99\xf0\xe6\x63\xee\xdd\x27\x29\x96\x50\x9a\x8e\x41\x46\x19\x85\x0d\x63\xf5\xd8\x52\x10\xbd\x20\x52\xba\xbd\xba\x3d\x4e\xbb\x1d\x12\xdf\x56\xb8\x53\x66\xa2\x63\x1d\x53\xc7\x0b\x17\xd8\x7a\x74\xb8\x77\xee\x31\xfe\xe2\xea\xbd\xb5\x84\xf2\xeb\xb5\xc5\x52\x43\x86\x09\xd5\x30\x37\x4e\xe8\xce\x58\x00\x60\x10\x49\xfb\xcc\x53\x4b\x7e\x99\x31\x14\x6f\xc0\x8f\x94\x08\xe6\xf0\xb2\xe4\x46\x2e\xa5\xb3\xbf\xda\x28\x84\x14\x06\x40\xcc\x62\xb2\xcf\x51\x2e\xee\x92\x77\xac\x4e\x1f\x23\xb7\xcb\xd9\x72\x4c\x72\xce\x05\x96\x15\xc6\x5e\x42\x0d\x20\x0d\x1c\x29\x7c\x06\x63\x4d\x1c\x88\x9e\xee\x67\x1d\x08\x51\x4e\xc8\xd8\xaa\x14\xf6\x68\x5e\xeb\x60\x4c\xc6\x4f\x54\xbc\xab\xc5\xbd\x2f\x46\x03\xb8\xbe\xe2\x26\x64\x81\xa6\xd2\x1a\xdf\xf1\xc9\x2f\x8b\xc1\xb4\x82\xd6\xa2\x06\x2e\xf0\x77\x05\xd0\x83\xd4\xa5\xce\x22\xc4\x68\xb9\x8c\x6a\xc7\x4a\x58\x34\xb8\x1e\xb4\xa7\xa5\x9f\x12\xc5\x18\xda\x31\xfd\x8d\x97\x2a\xe5\x1f\xa8\xc7\x5c\x17\x46\x18\x7f\x2c\xde\x48\xe0\x94\x9c\xc7\x70\x7d\x62\xf2\x1f\x95\x47\x6f\xff\x52\x8e\x0c\x3e\x09\xff\x6e\x3a\xd6\x56\xfd\xf7\xd1\xd1\x1f\x62\x7c\xb2\xb6\xd5\x8c\xa6\xf7\xcc\xc8\xda\x96\x3c\x8d\x04\xd5\x6c\x72\x07\x17\xd7\x19\x86\x49\x6f\x1f\xc7\xe2\x7d\x50\xfb\xa8\x4c\x0b\x17\x5c\xb1\x66\xb9\xc5\xec\x60\x8c\xff\x27\xc1\x6d\x19\xfa\x9c\xcd\x56\x0a\x40\x5d\x44\x02\x72\xb6\x1d\x4d\x33\x0e\x7a\x77\xa5\xb1\x9b\x8b\x37\xac\xca\xd5\x23\x2d\xdb\xec\xbd\xf3\xe9\xa8\x56\x72\x3b\x2e\x67\x3e\x24\x28\xb9\x7f\x03\xd5\x71\x04\xc3\x13\x13\x1c\x40\x10\xed\x8e\x27\x17\xfd\x64\x5b\x1b\x7f\xc2\xce\x9c\x3c\xa3\x44\x1c\x07\xca\x2e\xca\x25\x0c\x4d\x6e\x32\x28\x5f\xf7\x3a\x55\x06\x74\x5b\xef\xa4\xc4\x4c\xd7\x46\x72\x02\x38\xf5\x4f\x8e\xfe\x4c\x8e\x70\xbc\xda\x96\x0c\xe0\x2d\x17\x07\x73\x5f\xe1\x53\x8e\x7f\x75\x6f\x6e\x2b\x57\x62\x6c\x29\x24\x41\x17\xa7\x7c\x69\xab\x57\xba\xe7\x61\x67\x03\x8e\x10\x77\x2c\x4c\x3b\x69\x10\xfd\x32\x94\xd4\x73\x24\x7e\xcd\xfc\x75\x63\xcd\x4a\x02\xa3\xeb\xca\xec\x9a\xbb\xc3\x0c\xb4\x5e\x32\xd4\x1f\x95\xd0\x43\xb7\xe9\xe9\x7c\x48\xe5\x2c\x24\xb3\xd6\x1f\x07\x58\xe4\xa8\x3a\xfb\x0e\x46\x22\x1d\xb2\x66\x3f\xb6\x9d\x67\x9b\xa6\x5d\x9f\x44\x61\x01\x27\x49\xc0\x0d\xb1\xf7\xaa\x38\xb6\x44\xd0\xb8\x71\x73\x33\xaf\x86\xb4\x0f\x9c\x10\x4b\x63\xde\x92\x2b\x71\xbb\x56\x89\xbd\x01\xad\x67\x5b\x96\xbe\x07\x5f\xe5\x2d\x7b\x1a\x44\x9b\xa8\x4a\x7a\xd5\x98\x12\xec\x93\xc6\x6a\xa9\x8f\xd1\x89\x14\x3b\x77\xf3\x29\xf6\x15\x90\x7c\xd3\x69\x2f\xa7\x2f\xd3\xa2\x7a\x59\xad\x24\xfa\x70\xb9\x8d\xbc\xcf\xbd\x47\xd8\xd6\xe4\xf5\xc2\x77\x2c\xd8\xa2\xd0\x82\x75\x66\xcc\x1a\xb5\xdb\x9b\x0c\xf6\xdc\xb9\x11\x0f\x04\x51\x8d\xf4\x32\x00\xa5\x88\xc2\x0c\x50\x27\x6d\x68\xdf\xe8\xae\xac\xe1\xbe\x86\xf8\x45\x1a\xcb\x08\x98\xc6\x23\xbc\x7d\xe3\x52\x51\x6a\x65\x46\x6e\xf6\x38\x5f\x74\x6a\x8f\xe5\x2d\x96\xd3\xef\x49\x90\x28\x90\xbd\x23\x16\x5e\xa9\xca\xb1\x29\xba\x9f\xab\xc9\x59\xff\x10\xef\x63\xc4\x29\x1d\x18\x43\x71\xf2\xf9\x76\xf9\x0b\x9b\x40\xba\xf8\xe2\xf1\xe4\x83\xc5\xab\x6e\x07\xf2\xc9\x93\xb7\xf5\x2d\x58\x74\x19\x8d\x06\xde\xec\x83\xe8\x20\x15\x55\x4f\x2d\xa1\x9f\x55\x56\xa7\x05\xfd\x6f\xa2\xbf\x9b\x63\x17\xeb\xf5\x9a\x1c\x74\x9f\x4d\x3d\x6f\xcd\x7c\x4f\x8d\x73\xdd\x02\xc2\x80\x99\x49\xe4\x72\x76\x4e\x0c\x61\x5e\xf9\x45\x87\x79\x6a\xd4\x96\x2d\xff\x2b\x27\xe4\xf3\x94\xd9\x2e\x9c\x08\xbb\x7d\xb7\x40\xe6\x70\xfb\x11\x1d\x12\x08\x43\x91\xe0\xe0\xc7\xbb\x5d\x16\xe4\x0b\xe6\xda\xa0\x02\x06\xf4\x1f\xc5\xcb\xae\x01\x4b\x5e\xc2\x24\x7f\x50\x15\x2e\xf2\x71\x90\x61\xf2\x9f\xfd\xdc\x04\x13\x04\x41\x56\x71\x43\x6f\xff\x75\x62\xc3\xc7\x03\x98\x4a\x81\xef\xc3\x03\xc2\xcb\x79\xbd\x68\x5e\x9a\x3f\x26\xd7\xfd\xf7\x44\xad\x26\x55\x97\x0a\xa0\xa1\xa4\x21\x77\x8a\xde\x7a\x1f\x25\xb7\x98\x2b\xc9\xc0\x9c\x0b\xa6\x68\x08\x81\x5c\xf5\xad\xb0\x10\x5d\xfa\x20\x5c\x5d\x8e\x13\xe1\xe6\x6e\x16\x3c\xc5\x32\x1b\x21\xad\x90\x85\x59\xe3\x23\xb9\xe6\x77\x3f\x14\x17\xd9\xc2\x91\x59\x4a\xda\x4e\xe6\xcb\xd0\x22\x5d\x9f\xac\xde\xf2\x45\xae\x1c\x4f\x69\x1f\x63\xde\x87\x37\xd7\x92\xd2\x75\xe3\x04\xd3\xa2\xd5\x6e\xd0\x7f\xa9\x9e\x3d\x21\xa6\x85\x3b\x3f\xab\x43\xf8\x9e\x76\x13\x04\x1b\xeb\x70\x14\x2f\x48\x89\x9f\x2f\x64\xd0\x80\xfd\x88\xd4\x40\xd9\xf8\xf6\xd8\x06\xe7\x09\xf9\xd3\x15\x58\x0a\x6b\x7e\x0e\x48\xad\x0c\x83\xf0\xca\x26\xfb\xa2\xbb\x6d\xed\x90\xae\xaf\x36\xc5\x57\x64\x95\x6e\x82\xd0\x6c\x15\x7c\x0d\xce\x8b\xd1\x86\x62\x49\x35\xdf\x95\x0f\xc1\x20\x69\x46\x6f\xbd\x23\x8f\xd4\x01\xf4\xf2\x9f\x15\xda\x4c\xc7\xc8\xb9\x4e\x09\x17\xf4\x22\x01\x32\xd7\xf3\xc1\xe4\x7e\x96\xd8\x82\x40\x95\x7c\x45\xfc\x8e\x15\x2b\x95\x1a\x42\x3e\x29\x2d\x3e\xbd\x4b\x6b\x70\xdb\xc0\xae\xa8\x70\xa7\x7e\xda\xa5\xe8\xcc\x66\x61\xb3\x79\x52\xd9\x5b\x10\xe8\x66\xf2\x2c\x1e\xe8\xcf\x41\x61\x94\x72\x5e\x83\x93\x76\x81\x70\x2e\x4f\x37\x07\x83\xd6\x0d\x55\x52\xa7\x39\xcf\x16\xf6\xe1\x5d\xa6\xc9\xb4\x00\x08\x67\x0d\x26\xb7\x61\x53\x23\xab\x23\x79\xdc\x6e\x3b\x4e\x34\x3a\xe4\x20\x1d\x98\x27\xef\x7f\xee\xf1\x17\x07\x4b\x3e\x62\x7a\x21\x3b\xf4\x1d\xbb\x33\x94\xf4\x73\xe1\xce\x38\x1e\x8e\x58\xec\x5e\x45\x34\x7e\x02\xe5\x18\x96\x46\x51\x8d\xf7\x15\x6c\xe2\xa5\x94\xef\x84\xb4\x76\x3f\x49\xf5\xe5\xc3\x6c\x21\x92\xb6\xbb\xf3\x02\x44\x5e\xc2\xc4\x93\xdb\x66\x91\x2a\xdf\x92\x0c\x29\x09\xbd\x3a\xcf\xca\x12\x46\x8d\x8e\x61\xf9\xd9\x18\x40\x85\xe8\x87\xed\xea\x0b\x9d\xe8\x87\x8a\xa8\x3c\x1c\xe6\x48\x5c\xeb\x71\x99\x59\xff\x10\x38\xd3\xb2\x10\x2f\xe7\x25\x59\x78\x2d\x28\x8c\xb4\x85\xce\x8b\x2a\x26\x5e\xa2\x6a\x8f\xfd\xf2\x88\x17\xe3\xf3\x13\x1d\xe6\x33\xe0\x1c\xfa\xd7\xfe\xe3\xc6\x38\x2e\x66\xb3\xec\xed\xef\x1e\x1b\x5f\xff\x6f\x0f\x2f\x56\x52\xd0\x97\x27\xc8\xba\xaf\x9e\x9d\x1e\x2e\xa6\x66\xf6\x7a\x47\x35\x92\x3a\x75\x03\x0c\xf1\x1f\x49\xad\x5a\x3c\x34\x42\xbd\xf0\x95\xa3\x5f\x48\xa7\x97\xa4\x35\x61\xe9\x6f\xb0\x1e\xd7\x61\xb6\x4c\x41\xdd\x39\xa9\xd7\x92\x5b\x0f\xbf\xc9\x92\xfc\x55\x74\x8e\x9b\x1e\x3f\xf0\xe8\x38\x97\xdb\x5b\x32\x38\xd2\x32\xa8\x1d\x89\x7a\x86\xd4\xab\xb8\xfb\x6a\xc9\xd0\x25\x9f\x41\xb6\x2d\x94\x51\xaf\xef\x5b\x3c\x96\xe6\x7e\x47\x65\x41\xd0\xc0\x5c\xc3\x02\x84\x52\x26\x00\x38\xca\xcb\x0e\x64\x45\x62\x8c\x6e\xb0\xde\x41\x20\x3c\xff\xa7\xba\xa9\x3f\x98\xbf\xf6\x05\x51\x0d\xf1\x76\x1d\x64\xb0\xae\x51\x8f\xa1\x04\xd3\x1a\xf8\x35\x8f\xf6\x5f\x28\x1d\x0b\x04\xe6\x8f\x6c\x6b\x5a\xec\x9a\x5e\x07\x67\x41\x16\x33\x95\xcd\x2b\x8d\x44\x86\x35\x38\x50\x80\x7c\x60\x9c\x95\x42\xc3\xdc\x25\xe1\x46\x9b\xb2\x3c\x71\x48\xb6\xfd\xe5\x80\x68\x95\x05\xdb\xaf\x47\x14\xc7\x2f\x2a\xe2\x4c\x7e\xfc\x0f\x21\xd5\x8c\xb8\xbf\x04\x38\x57\x04\x4f\xc1\xdb\x56\x08\xed\xb1\xb6\x14\x8f\x79\xb8\x11\x36\x2e\x23\x2f\xbf\x4f\x20\x0b\xe7\x88\x4a\x19\xbf\x00\x79\xbb\x13\x2f\xab\x91\x43\x57\x06\xb7\x17\x9e\xfa\x77\xa3\xe1\x64\x32\x3c\xa0\x50\x67\x98\xfb\x2e\x72\x26\x66\xb6\xa8\xbf\xaa\x26\x85\x7a\xa3\xe1\x35\x98\x8a\xe8\x3e\xb5\x8c\x59\xbe\x2f\x17\xf4\xc5\xb8\x49\xbf\x1c\xd2\x17\x59\xca\x35\x9a\x3e\xe0\xe6\x76\xa5\xde\x97\xb0\x4a\x85\x62\x56\x36\x1a\xf2\x63\x93\xb8\x96\xa9\x33\x0a\x08\x62\x27\x6b\xd7\x6d\x55\x92\x8a\x1f\x86\xff\x0e\x9e\x3f\x9c\x47\x42\xe5\xf5\xcb\x40\x58\xf4\x64\x6e\xbc\x9f\x7f\xa5\x18\x63\x00\xce\xbb\x2b\xec\x6e\x05\x81\xbc\x10\x69\x57\x47\xb4\xcb\x35\xb4\x3e\xc9\xac\x80\x49\xc0\x06\x99\x79\x59\x65\x87\x00\xd7\xd7\x69\xb7\xed\xff\x05\x2b\x8d\xc9\xab\xe9\x7d\x4b\xad\x17\x13\xf5\x1f\x15\xd5\x42\x64\xd2\x54\x2b\x6b\xce\x61\x00\x56\xe9\x0d\x1d\x03\xc6\xba\xb9\x6a\xd9\x9e\x78\x7f\x77\x49\x8e\xb4\x42\xee\x74\xe5\xe0\xea\x05\x66\x1c\xa1\xcc\xe8\x47\x3a\x15\xf5\x0e\x5a\xf7\x9a\x92\xa8\x30\x04\x82\xe7\xd2\xfc\x12\xfc\xec\xc9\xc8\xb5\x23\x67\x97\x7e\xbf\x99\xd5\x0c\x76\x6e\x57\xe4\xf3\x43\xd2\x72\x1a\x58\x5b\xe3\x16\x40\xfe\x21\xeb\xec\x3d\x2a\x85\xa1\x38\xe8\xcc\x81\x35\xa0\x82\x0b\x05\xab\x48\x34\xd2\xea\x0e\xdf\xa8\xef\x24\xe0\x1c\xef\x3f\xb7\xab\x39\xd0\x73\xf4\x30\x5a\x62\x12\x18\x67\x58\x9d\x9a\x18\x44\x53\x6b\xfd\x44\xde\xfd\x47\x23\x57\x8a\x66\x3e\xe6\x5d\x4b\x82\xa1\x7f\xa4\xe5\xcc\xc6\xf0\x59\x75\x75\xc6\xe7\x29\x6f\xb9\x49\x1c\xc5\x9a\x90\x0e\x6d\x92\xb9\x3f\x7a\xa7\x1b\x99\x40\x74\xf6\x33\x02\x8f\x7e\xf3\x6f\xcd\xd4\x21\xdd\x7a\x04\xf4\xab\x09\x04\x62\x90\xca\xd8\xdd\x5c\x9b\xc2\x01\x54\x51\x91\xa4\x83\xfe\xe1\xdf\xff\x4e\x94\x90\x51\xc9\xbd\xb0\x65\x75\x5e\x80\xc1\xe4\xbe\x98\x24\x2e\xe8\xde\xf1\x01\x98\xc3\x77\x8f\x04\xe0\x7e\xf9\x43\x18\xa7\xad\x61\x3e\x54\x9e\xe5\x54\x58\x92\x0f\xf1\x50\x36\x75\x2f\x4e\x5e\xd0\xc0\x9f\x69\xee\x6c\x9c\x8e\x91\xd5\x10\x87\x78\xa6\x7f\xf0\xfb\xdb\x64\xd8\x2c\x20\x0e\x6b\x40\x5f\xdb\xbc\x77\x0c\x0d\x9f\xa4\x11\x48\x28\x73\xa4\x15\x1b\x31\x12\x52\x04\x18\xfc\x5a\xa6\x60\x6a\x7e\x42\x66\x49\xb8\x56\x59\xee\x8b\x80\x20\x2a\xce\xad\xbe\xaf\xfc\xaf\x7e\x4a\x39\x30\x24\x76\x24\x41\x10\x62\xb3\x7a\xbb\xcd\x10\x8e\x4f\xde\x40\x5a\x9a\x94\xf1\x4a\xf6\x36\x03\x23\x4a\x4d\xbb\x76\xd5\x69\x0b\x58\xb2\xbb\xa2\xe7\xa0\x30\xc1\xe7\x19\xf2\xc2\xae\xd2\x39\x71\xcd\xbb\x5a\x82\xb2\x00\xe6\x04\x69\x02\x61\xac\xcc\x98\xda\x6d\x4c\xb9\x7b\x61\x92\xc0\x6e\xff\x03\x76\x61\x2d\x4a\xdd\xb5\xf8\x39\x81\xd7\xaf\x24\x0a\x27\x41\xb7\xb6\x60\x09\xad\x18\x41\x12\x1c\x11\xa3\xb1\xa1\x96\x21\x1e\xf5\x84\x80\x92\x12\xca\x21\x11\x14\x46\x99\xf5\x57\x93\x88\xa3\xa6\x1a\xdd\xea\x76\x4f\x82\x23\xb1\xf7\x68\x61\x74\x60\xc9\x4c\xb6\x16\xf5\xc4\x60\xc3\x50\x22\x51\x5e\xc2\xc9\xc8\xe2\x83\x4a\xcb\x3b\xc5\xe2\xb3\x40\xce\x86\x14\xfc\x18\x10\x42\x7e\xaa\x9d\x26\xc3\x33\xd1\x43\xbb\x0c\xca\x2a\x0e\x6f\x77\x16\xe0\x27\x8a\x2d\x54\x94\x84\x24\x4e\xad\x9a\xe8\x3e\xd8\xd8\xad\x55\x01\xdc\xf6\x86\xf1\x80\x94\xe4\x8c\x5d\x0d\xda\xa7\xe2\xbc\xa5\x3e\x03\x3e\xfa\x4a\xb2\x5f\xb1\x8d\x48\x5f\xa5\x87\xb3\xed\x02\xf0\x70\x83\x6c\x7f\xcb\x59\xcc\x27\x64\x34\x4f\x45\xe3\x50\xb3\x13\x52\x9e\xa3\x68\x40\xf9\xd9\x43\xd6\x55\xf5\x88\x52\xad\x2b\xa1\x1e\x09\xd1\xce\x6b\xf7\xf1\xea\xfe\xc4\x4d\x1a\xeb\x10\x01\xe0\x55\xb2\xa2\x3b\x8e\xfe\xa9\x27\x0a\x85\x68\xd7\x5f\x9e\x82\x66\x65\x26\xe9\x94\x92\x6a\x41\x00\xa8\x8f\x0d\x30\x6f\xee\xd1\x15\x88\x5c\x0e\x3f\xfb\xe7\xf1\xb5\xcd\xfc\xd6\xc2\x60\x0f\x51\x03\xe1\xad\xb4\xac\xaf\x48\x6c\x13\x39\xfd\xe2\x12\xb3\x5e\xe7\x60\xdb\xff\xd4\x69\x67\x44\xb1\xc4\xf6\x24\xc4\x16\x0e\x4c\x87\x34\x11\x4c\xf4\x57\x71\xb3\x32\x0c\xbf\xae\xd5\x86\xeb\xf0\xb9\x5b\xd4\x82\x4c\xc6\xa4\xd2\x97\x5f\x9b\x57\x27\x74\x17\xb5\x9d\xc0\x67\x75\x21\xba\x14\xa6\xac\x3f\xc5\x02\x1d\x90\x88\x80\x2d\x37\x99\x14\x9b\xf8\x9c\xa0\xa2\xfd\xf9\x4c\xa7\x82\x5e\x3a\x8c\x57\x2c\x02\x9a\x28\x61\x08\x71\xc9\x71\xc3\xce\x7d\xac\x30\xa8\x8a\xb5\x4e\xa1\xcd\xb3\xc8\x75\x61\x2c\xcc\x20\x08\x03\x3c\x63\xe0\x9b\x8e\x5a\xa3\xd7\xca\x7d\xfc\x29\x8a\x0d\xd8\x62\x72\xb9\x34\xd7\x28\x48\x57\xea\xaa\x33\x61\x78\x72\x56\x51\x32\x42\xe0\x8c\x4d\x33\x70\xd1\xd5\xee\x7f\x60\x4e\x74\x42\x48\xe1\xf4\x36\x20\x6c\x28\xf8\x2d\x07\xac\x59\x46\x81\x05\xc3\xb0\x82\x5a\xb4\x45\x0d\xd6\x86\xde\x74\xc9\x3e\x8c\x8c\xc2\x0b\x54\x87\x6f\x57\x1a\xad\xe5\xe5\x87\xa5\xc0\x47\x5e\xdb\xb5\x0d\xe5\xae\xba\xfc\xb5\x84\xff\x9b\x55\xe3\x88\x24\xa0\xd8\x92\x07\x95\x28\xe2\x07\x54\xbf\x12\xde\x1e\xab\xb1\xc4\x2d\xa5\xa3\x04\x05\x37\x71\xe6\xe8\x48\x78\x50\xa4\x2d\xa9\x69\xa2\x6c\x93\xab\x48\xbe\x83\x12\x60\x1c\x0e\x0a\x49\x90\x8e\x40\x0f\x3e\xcf\xd4\x9b\x41\xb5\xaa\xd9\x80\xaf\x94\xb0\xc3\xd8\xe5\xe6\xb4\xa0\x1f\xe0\x53\x62\x08\x09\xe0\xf5\x10\x2d\xb3\x33\x84\x19\x9a\xc4\x3f\xa0\x73\xcc\xb1\xbe\x08\xa9\xad\x03\xb5\x9f\x49\x0b\x70\x05\xd4\xa3\x5c\x67\xe8\x4f\x19\x94\x67\x8d\xa9\x2b\xc8\xf3\x03\xa7\x8f\x35\x85\x08\xd6\xdb\x03\xff\xc8\x34\x54\x64\x4c\x34\xb9\xfe\x27\x2f\xa9\x84\x86\x95\x4c\x6d\x00\x69\x93\x07\x92\xe8\x6f\x84\xed\x53\xe7\x22\x9b\xb5\xb4\x92\x98\x2a\x5b\x1e\xb0\x4a\x86\x3e\x85\x78\xa0\xc3\x88\x20\x9b\x4a\x5e\x46\xb9\xb6\x4b\xfa\x5f\x1b\xbb\x6f\x66\x81\xc6\xb2\xf5\xd9\x1e\xab\xcd\x75\xfc\x40\x06\x33\x3d\x7b\x35\xef\x35\x13\xaa\xd8\x75\xe4\x98\x8c\xd1\x63\x19\x64\xd6\xd3\xd1\x9a\xf4\x3c\x8d\xa0\x89\x32\xd6\x05\x03\x56\xf9\x7e\xec\x82\x6a\x09\xe2\x1e\x2d\xd2\x3d\xb0\xbe\xbf\xa6\x61\xe2\x2c\x07\xb6\x41\x16\x98\x56\xd3\xfc\xd6\xeb\x18\x6f\xa4\xfe\x3a\x9c\xcf\xf9\xc3\x2d\xa7\x9e\xd7\xb1\x30\xcd\x8c\x10\x4f\x31\x66\x5f\xf8\xa3\x83\x77\xd7\x04\x6c\xa2\xaf\x04\x46\xee\x1e\x1a\xbf\x77\xd8\x63\xc4\x68\xff\x53\x2f\x3f\x8a\xf2\x76\x33\x4b\x4a\x5b\xdc\x0b\x12\x5f\x06\xa2\xcf\x9a\x9d\xc8\x1a\x08\x88\xaa\x01\xac\x65\xd7\x42\xc6\x8b\x26\x65\x2e\x6f\xe6\x15\x3b\x86\x87\xf8\xb8\x6c\xf7\x63\xf2\xc8\x8b\x82\xdb\xf3\x0a\xbd\xd4\x2a\x48\xda\x13\xfc\x49\x04\x29\x60\xc7\x6f\xbc\x49\x87\x32\x1c\xb9\x4a\x09\x98\x8c\x12\x01\x69\xac\x62\x00\x28\xf1\x76\xe0\x9e\x51\x37\x87\x04\x7d\xec\x35\xb1\x1a\xd6\x38\x85\xc5\x53\xbb\x61\x97\xd7\x39\xdb\x3f\xea\x24\x51\xe3\x19\x61\x17\xcf\x38\x5e\x17\x8c\x80\x49\x91\x9c\x18\x25\xe9\x74\x98\xaf\x26\x3c\x0d\x58\x11\x45\xe1\x32\x5b\x62\xaf\x39\x5f\xfb\x8d\x18\x35\xdb\x71\x3c\xff\xba\xc5\x80\x02\x0e\x7e\xba\xdd\x7c\xc1\x19\x8f\x72\x50\x8a\x10\x2f\xe8\x6a\xd8\x97\x4c\x8c\xe9\x8d\x63\x69\x90\x0d\x48\xbb\xf5\x1c\x31\xd6\x38\xe0\x4a\xf3\x0f\x0d\x79\x31\xe7\x59\x1a\xf0\x42\xe5\x90\x29\x1b\x12\x13\xf5\xee\x77\xc8\x20\x46\x89\x46\x92\xdc\xfe\x87\x99\xaa\x7e\x9c\xd2\xaf\x6d\x98\x5a\xe2\xea\x41\x42\x26\xd3\x4b\xeb\x0b\x69\xb2\x67\x68\x32\x38\x58\x30\xa3\x32\xd2\x3d\xa1\x62\x8f\x99\x38\x88\xaf\x58\x0b\x51\x3c\xce\xff\x62\xe1\xd8\x09\x65\x20\xce\xdd\x81\x26\xe9\xe7\x6c\xf5\xe5\x59\x01\xe8\xa6\xfc\x47\x96\x75\x07\xe5\x5c\x74\xd3\xe1\xc5\x4f\x4e\x7d\x96\x0f\x0f\x87\x1c\x54\xd2\x91\xba\xad\xca\xb4\x7f\xc6\xa5\x42\xf5\x1f\xd8\xc9\x45\x9a\x25\x8b\x27\xe8\xb7\x69\xbb\xfe\x53\x75\xe2\xae\x3f\xcd\x70\x9b\x59\x78\xef\x71\x2c\x00\x23\x69\xb5\xf1\x99\x93\xc3\x86\x61\xcc\xf4\x41\x2d\xc9\x9b\x54\x3a\x9e\xcf\x8d\xe2\x5e\xe6\xf8\xe5\xe6\x5b\x2d\xc8\x6f\x82\xdb\x3c\xbc\xf5\xbe\x4b\x37\xef\x37\x25\xfc\x60\x01\x60\x3b\x1b\x18\xc1\xf8\xd6\x86\xc1\xf9\x3e\xcb\x96\x81\xec\xb5\xcc\x1a\x9e\xe7\xd3\xd2\xf5\xfd\x9e\x56\x3d\xf9\x4d\xcf\x9a\x1f\x92\x4b\x17\x6f\xa0\x70\x2a\x09\x86\x39\x7d\x49\xbd\xfa\x16\x53\x50\xf4\x0f\xdf\xcf\xc3\x85\x86\x0d\xde\x91\xe3\xa4\x79\x1b\x4c\xaf\x80\xc0\xc1\x25\x38\x83\xa6\x6f\x6d\xbd\x4d\x65\x38\xac\x98\xd2\x96\xb0\xa0\x9c\xe7\xf6\xa4\x31\x46\xf5\xe8\xe6\xf1\x4b\x01\x89\x92\x52\xcf\x34\x3b\x10\x76\xf4\x4f\x57\x23\xd0\x12\x2d\x80\x4a\x94\xa1\xf0\x98\x2a\x7d\x76\xa7\x49\xdf\x24\xa7\x80\xe3\x87\x44\x90\xa0\x6b\x3a\x9e\xa5\x8a\x4a\xa6\x87\xfe\x2f\x75\x29\x63\x1e\xac\xf8\xa4\x28\x04\x82\x28\x33\x4c\x18\x10\x47\x46\x84\xcc\x59\xc1\x38\x5a\x94\x4e\x27\xd6\xfb\xe7\x00\x40\xdd\x3d\x39\x39\x45\x63\x9e\x0b\xed\xdf\xdd\xc0\x13\xcb\x42\x0f\x96\x47\x0d\x26\xa4\x6c\x4a\xcc\x5a\xc5\xee\x36\xcc\x40\x6a\x20\xe1\x3e\x5e\x6f\x7a\xcc\x61\x1f\x20\x57\x44\x79\x49\xbf\x30\x7e\x71\x5a\xca\x62\xa2\xde\x3e\xdc\x2f\x2e\x24\x51\x8c\xab\x79\x01\x77\x3a\xce\x59\x68\x94\x70\x68\x92\x65\x43\x0c\xe3\x2e\x20\x82\x8a\x6d\x6b\x1f\xff\x3b\x0e\x73\x20\x59\xc5\x60\xba\xfb\x78\x2e\xb3\xe0\xdf\xbc\x00\xe6\x49\xd7\x19\x41\x80\xd3\x3b\xc7\x50\x1c\xf3\xf0\xb4\x5c\x5a\xd8\x0d\xd0\x37\x0c\xa6\x20\x69\x5f\x14\x8d\x1d\x4f\xdf\x0e\xb3\xf4\xef\x99\x5b\xdd\x41\x6a\x4a\x0b\x56\x49\x50\x5f\x7b\xf7\xa4\x1c\x40\xc5\xfd\xa9\x25\x1c\x92\x6f\xb2\x12\xd7\x66\x3a\x08\x01\x40\xa7\x75\x8a\x7e\xc5\x20\x79\xbf\x7c\x67\xeb\x8b\xcd\xe7\x70\xed\xfc\xd2\xf9\x40\x01\x99\x09\x70\xe0\xfc\xe3\xa0\xfd\xd1\xad\x2e\x66\x7e\xf5\x22\xec\xff\xad\xfd\x0f\xc4\x86\x2a\xfc\xad\xcf\xdc\xf3\xb1\xd4\xb9\x77\x26\x34\x6c\x39\x38\x00\x75\xd6\xf4\x3d\x2c\x2c\x57\xf8\xf5\x03\x55\x64\x4c\xaf\x68\x58\x01\x88\x80\x08\xee\x53\x0b\x29\x9e\xe9\x5d\x86\xd8\xe4\xea\x02\x79\x9c\x8f\xad\xbd\xf0\x8d\xcd\xa9\x30\x2d\x69\x26\xc3\x0a\xe6\xcc\x32\xbd\x33\xf2\xe7\x65\xc1\x18\xc2\x82\x0d\xe2\xfd\xdd\xc8\x12\x02\x39\x57\xb9\xd3\xea\xef\x0e\x99\xe9\xba\x87\xe7\xb7\x05\x5b\x37\xa1\x50\x47\x00\x7e\x7d\x41\xaa\x72\x7d\xb0\x75\x43\x6a\xd3\x91\x46\x6a\x31\x2e\xcd\xe2\x8d\x19\x98\xe5\xda\xc0\xa6\x9f\x26\xd8\xd1\x3f\x0b\xfe\x0f\xa2\x38\x83\x3b\x0a\x52\x4c\xad\x93\x94\x69\xab\xa0\x2b\xdb\x50\x31\x7a\xa9\xe7\x95\xe8\xd2\x36\xa1\x0c\x4c\x1d\xc8\x00\x48\x9e\xb2\xe5\xf9\xb7\xff\x68\x73\x6f\x4a\xdd\xca\x13\xdf\x26\xf5\xd1\xd8\x7e\x0f\xd1\xad\x98\x73\x06\x22\x76\x8b\x03\x04\x18\x82\xef\xae\x89\x5c\x67\x50\xc6\xa6\xc8\x8e\xd6\x91\xe0\xa7\x29\x00\x54\x0c\x5f\x18\xd9\xf7\xfc\x15\x5a\xd2\x1c\x3d\xff\xdb\xc2\x19\x6d\x14\x95\x1d\x67\x4f\x06\x45\x92\x97\x85\x81\xe0\xbc\x3f\x0b\x67\xeb\x3e\x16\xa0\xc7\x28\xfe\x24\xa2\x9f\x1a\x43\x85\xdd\xda\xd2\x68\xfd\x5b\x27\xc4\xa7\xa6\x65\x5b\x9e\xab\x6c\xb2\x2b\x1b\x86\x25\xb5\xdd\xb0\x14\x35\xcd\xe2\xea\x3f\x8b\x10\xbe\x31\xd2\x72\xee\x83\x8d\x07\xf3\x1c\xaf\xa6\xd1\xe4\x84\x3f\xb9\x70\x84\x92\xc7\x35\xd6\x30\x82\x4d\x0f\xb9\x26\x31\xcc\x1c\x66\xe6\x62\x26\xc9\x87\x3a\xb4\xc7\xad\x1c\xfb\xb2\x8a\xc3\x28\xff\x25\x9f\xf7\xb6\x34\x91\xe0\x72\x6e\x29\x85\x51\x2b\xf1\x39\x2d\xdd\xbf\x6c\x94\x6c\x0c\x96\xcc\x39\x82\x9f\xd5\xff\xe4\x34\xfa\x2c\x66\x69\x92\xf1\x67\xc4\x36\x40\xd6\x3c\x35\x5e\x76\xc4\x08\x71\xf4\x94\xcb\x45\xcf\x23\x81\x42\xe4\xbc\x38\x8f\x1c\xae\x45\x0c\xec\xce\x60\x28\x33\x7d\x24\x6f\xac\xc3\xc4\x88\x71\x43\x3b\xcd\x1d\x30\x79\xd1\x96\xcb\x22\xc9\xdc\x00\xd0\xa2\x7f\x23\x9d\xc1\x00\x01\x40\xff\xae\x18\xb1\x4d\xe5\xb4\x5b\x0a\x59\x5a\x4a\xcf\x18\x52\x89\x4a\xbb\x68\x12\x84\x08\x7b\x29\x74\x24\xe7\x31\x43\xc1\xfb\x91\xa1\xc0\xe6\x5e\xe5\x86\xed\x7c\x3c\x1b\x2d\x6d\xfc\x3d\x3b\x18\xfa\x1c\xdb\x3f\xe5\x57\x6a\x8b\x98\xe2\xd8\xb2\x95\xc3\xc7\xb7\x6a\x5d\xef\x82\x7f\x80\x1e\xf4\x34\x09\x34\x40\x2b\x67\x29\xfe\x97\x1e\x32\xa4\x19\x90\x7c\x0b\xaf\xcc\x6a\x9e\xb1\x84\xd6\xd2\x71\xc5\x45\xb3\xa4\xe6\xbc\xb1\x6b\x5a\xb4\xc8\x34\x13\x3e\x37\xe5\x90\xf7\x03\x59\xc3\xaa\x77\x2a\x75\xf9\xb9\x49\xb7\xce\xc4\x62\x4d\x1a\xa6\x69\xd9\x33\x98\x09\x82\xc4\x58\x82\x9f\x02\x11\xbe\x0c\x1c\xbc\x9c\xd1\x81\xe4\xb5\xe7\x01\x5b\x19\x59\x8f\xa0\x64\x76\xe5\x42\x4c\x11\x0a\x50\x36\xe7\xb5\xf7\x6e\x78\x67\x1d\x9a\xb5\x73\x65\x69\xfd\xfb\xd1\x35\xe2\x75\xfa\xaa\x3d\x11\xba\x6c\x5f\x88\x95\x70\x46\x57\xf1\x3e\xb1\x0a\xc0\x43\x75\x98\xe6\xbd\x58\xf0\x57\xa9\xbf\x96\xbb\x66\xc8\xfa\xc8\x7b\xaa\x6d\x68\xc1\x97\xdf\x95\xd9\x73\x3f\x9b\x21\x71\x13\x61\xf9\x9e\x49\x3c\x96\x2f\xbf\x75\x71\x2a\xae\x2d\xf1\xb1\xc7\x16\x2e\x9f\x7b\x0e\x05\x2a\xa6\xe7\xd4\xbd\xdb\x32\xb9\xd9\xce\x23\xc8\x6d\x26\x95\x76\x17\x0d\x93\xfc\xec\x15\x69\xc6\xe4\xfb\x27\xc6\x63\x6e\x95\xf8\x7f\xe5\x16\x76\x92\x35\x34\x6e\x83\xec\x74\x0d\x5c\x78\xcd\x6d\x5c\x7c\xaf\x8a\x6c\xef\x4b\x20\x88\x89\x2f\x35\x60\x6a\x1b\x09\x4a\x75\xd8\x94\x31\x6c\xc0\x2f\x10\xf6\xb6\x9d\x36\xd4\x89\x07\x38\x8f\x9a\x2f\x39\xf0\xdf\xcc\xf8\xec\xdb\x7e\xc0\x51\x68\x00\x30\xcd\xb6\xf9\x65\x56\xfc\x87\x68\x17\x56\x64\x17\x36\x75\x26\x71\x2e\xf6\x0f\x31\xfa\xab\x6b\x4a\x45\x76\x34\xdf\xa8\xf2\xc6\xe7\xf7\x6c\xb0\x4d\x60\xde\x00\xe6\x8c\x30\xb8\xd3\xc7\x4d\xf5\x5b\x9f\xd2\x74\x62\x5b\xc1\xb1\xbc\x63\x4e\x48\x43\x20\xfd\xe6\xef\xa0\xa9\x2e\x60\xa1\xeb\x94\xcb\xd1\x64\xae\x88\x75\xe3\x2b\xa1\xa8\xcb\x0e\xc8\xb7\xa8\x0e\x8d\x2b\xd3\x34\x68\x29\x8d\x0b\x5a\x63\x6a\xd5\x5b\xa5\x2d\xc5\xb8\x5f\x97\x33\xd4\xe2\x86\xb8\xd4\x61\xd8\x01\xb2\x6c\xcd\xd5\x8f\x7c\xe4\xa9\x41\xbb\x97\x71\x44\x25\x55\x86\x8c\xdd\xdb\xa9\x91\xdc\x05\x62\x33\xbf\x7a\x05\xb2\xac\x05\x33\x10\xef\x2d\xe3\x77\xef\xb8\x20\xe0\x8f\x41\x1f\xb7\x23\x50\xa3\xf6\x2f\xe3\xc7\xfd\xe7\x65\xf7\xc0\xc3\x73\x3a\x57\xfd\xb5\x20\x38\x5d\x1b\x1e\xad\xd9\x8d\x83\xbb\xf4\x83\x5e\xf4\xb2\xde\x85\xcb\xe5\x2a\xe5\x41\xe2\x84\x93\x85\xf7\xdb\xd2\x61\xfe\xe7\x5b\xdf\x11\xc5\x6a\xd9\x0e\xd2\xe7\xba\x73\xd0\x2d\x06\x21\xe4\xe4\x0b\xc8\x2b\x58\x8a\x98\x81\x40\xd2\x7b\x6d\x4f\xa1\x7b\xd9\x4e\x0b\xfe\x1f\x8a\x54\xcc\x11\xaf\xd9\x51\x7f\x7f\x97\xb6\x80\xb5\x81\xdd\x5a\x13\x24\x3c\x33\x24\x20\xbf\x21\x2a\x0a\x07\x15\x8f\x79\x70\x99\x8d\xa1\x2e\x87\xcf\x41\xa7\x3e\x02\x62\xa5\xa6\xcb\x26\x02\xb4\x20\x1d\x1d\xe4\xf4\xf3\xd4\xd6\xd5\x88\x59\xd1\xc8\xa3\x17\xc8\xc9\xd8\xc7\x3d\x0a\x32\x67\x21\x18\xfc\x65\x4d\x1c\x3e\x83\x26\x6a\x65\x2f\x81\x89\x92\xfa\x41\xc8\x86\x7d\x60\x85\x40\x61\x52\xa0\x75\x07\x2a\x9a\x59\x1d\x55\xe6\x1b\xb0\x37\x2d\x91\x2b\xce\x64\xc1\x12\x1c\xae\x31\x90\x1f\xbe\x90\x22\x14\xfa\x1b\x57\xa2\x99\x36\x67\x72\x98\x95\xcc\xeb\x87\xe1\xcc\x45\x2f\xe9\x55\xad\x8f\x11\xa2\x1f\x2b\xe8\x36\xb5\xd6\x7a\xe1\xa7\x49\x0c\x4d\x28\x3f\x61\x88\x04\x22\x2c\x07\xc2\x04\x24\x68\xfe\xe6\xd6\x66\x01\xb1\x0a\x42\x38\x03\x60\x36\xe5\xe4\x90\x69\xd1\x9e\x18\x7c\xef\x8f\x5b\xf9\xb4\x59\x3c\x39\x2b\x2d\x7e\x78\x41\x82\xac\x0b\x05\xaf\x22\x55\xc6\xb8\x03\x41\xec\x50\xf2\x62\x2c\x19\xaa\x6c\xb3\xcc\x76\x7f\xe1\x22\xdb\x77\xaf\x5e\xb5\x0d\x2f\x3f\xb5\x3d\x2c\x93\x0d\x94\x2d\x11\x73\x41\x3f\x5d\x5e\xc6\xda\xf0\x5d\xa0\x88\x79\x1c\xee\x0a\xc9\x54\x54\xea\x50\xd3\x37\xbc\x04\x9f\x3b\x23\x80\x53\xdc\xbc\xee\x96\x08\xd9\xc0\xff\xf1\x90\xd7\xca\x63\x98\x6e\x47\x32\x83\x16\x69\x8c\x6a\xc5\x97\x5a\xc6\xe2\x8a\xf7\x6a\xe1\x16\x29\xc8\x35\xc0\x75\xb2\x38\xaf\x86\x95\x3e\x1c\xaa\xe3\x27\xf1\x23\x9c\x79\x04\xb9\x01\xbe\x4d\xf3\xa9\x74\xbc\xcd\x28\x2f\x29\x5e\x75\xd7\x06\xf7\xf6\x3e\xe0\xfc\x60\x73\x9f\x4e\x22\x95\x94\xbd\xff\x7d\xc8\x96\xd4\x14\xfe\x1a\xc1\xf8\x86\x62\x3c\xc7\x79\xd7\xe8\x2b\xb8\x09\x32\xd2\xa9\x8b\xec\x45\x2a\x51\x29\x3f\x5a\x3e\xa5\x6c\x03\x83\x3e\xb1\x91\x99\x9c\x7c\xac\xed\x7d\x45\xff\x2f\x85\x1a\x79\x47\xf0\x63\xba\x0a\x91\x6a\x63\x48\x14\xa5\xa1\x48\x79\x68\xbb\xd7\x99\x3b\x60\xe5\x3c\x8d\x61\x5e\x23\x20\x75\x1e\x9b\x7a\xfb\xbe\xba\x98\xcc\x13\xd5\x79\x84\x9a\x3a\xb5\xd4\xa9\xb9\xc7\xeb\x2f\x03\xf7\xf1\xfc\x02\x5b\xa1\x6e\x87\xb5\xd0\xed\x47\xc2\x8a\xf5\x77\xe8\x3a\xcb\xb6\x57\xfa\xe5\x83\xd9\x1f\xc4\x33\xee\x0d\xc5\xa8\xc7\xab\xfc\x2a\xf5\x07\x88\xc8\x9a\xaa\x52\xb3\xa3\x00\xd6\xb9\x87\xb8\x6d\xe0\xa3\xad\xa0\x7e\xcb\x24\x30\x34\x81\xa4\xa6\x96\xd6\x59\x4b\x3b\x7a\xea\x6b\x1e\xd7\x35\xad\x7c\x40\xea\x43\xdf\xe4\x52\xda\x14\xb6\xc8\xbe\x47\x10\xc2\x0d\xd1\x3e\xbb\xe6\x88\x09\xff\xee\x84\xc1\x46\x74\x8d\xac\xed\x1f\x6c\x37\xe4\x3d\xac\x0c\x89\xea\xd6\x6a\xbd\x71\xa3\xd4\x96\x64\x44\x5a\x11\x95\xa2\x7f\x0d\xfb\xe5\x83\xdb\x05\xb3\x73\xf0\xcb\x91\x58\x29\x24\xea\x41\x04\xaa\x47\xd3\x3e\x8e\x68\x19\xfe\x4a\x67\x73\x96\xc7\x36\x1a\x63\x91\xc7\x6b\x6e\x32\x8e\x17\x9d\x79\xd5\x7a\xd3\x1f\xf8\xa8\x48\x1e\x93\x67\x0f\x6c\x09\x86\xea\x6c\x91\xb9\x9d\x9a\x76\xba\x43\x2a\x82\x64\x00\x88\x37\xd9\xa5\xfb\x84\x5c\x9e\x12\xf7\x20\xee\x33\x9e\x7b\x64\x7a\x23\x01\xba\x22\x97\xe5\x9e\x1d\xb8\x1d\x1f\xc5\x72\xd2\x1a\x9f\x7e\x9d\xcb\x92\xa7\x31\xb7\x3f\x55\xe9\x3c\x50\xa3\x60\x0a\xc4\x81\xe8\x70\x50\x9e\x97\xdc\x8f\xa3\xf2\xd3\xfe\xc2\x4b\x9c\x29\x00\x76\x68\xf4\x10\x53\x07\x24\x62\x19\x52\x2c\xf4\x66\xf6\xcb\xa7\xdb\xa7\xb6\x05\xcc\xdb\x6a\xd7\x04\x1f\x77\xf7\xa0\x2e\x50\x98\x26\xa5\x54\x22\xc5\x63\x6e\x94\x79\x15\x77\x29\x5f\x78\x3d\x60\xb0\x58\xe3\x90\x6b\x2c\x7a\xf6\x76\x97\xaa\x14\x3e\x65\x2e\x5c\xb2\xad\xb6\xa2\xd3\xdf\xcc\x50\xc8\x33\x59\x8f\x3e\x02\xf9\x7d\x87\xfe\x2d\x53\xa2\x7e\xc1\x84\x81\xa5\x1e\xff\x53\xdc\x59\xd0\xdb\x2d\xff\x15\x41\x6d\xd1\x70\x8b\xe8\x77\xc1\x8a\x2d\xde\xb0\x9e\x49\xb8\xab\x80\x30\x79\x1c\xaf\xbe\x7c\x15\x10\x2c\x38\x34\x0d\x87\x38\xad\x51\x1e\x11\x11\x4c\x85\xb3\x42\xfc\x8a\xdd\x46\x62\x92\x74\xbc\xc3\xfd\x0d\x58\x92\xb8\xc9\x12\x06\x66\x1b\xf9\x5a\x52\x62\x74\xde\x38\x9a\xb9\xef\xb5\x55\x2e\xe9\x51\x9f\x2c\x9d\x30\x58\x0e\xeb\x72\xf6\x14\x80\x90\xde\x07\x1a\xa8\x0b\x62\xb6\x3f\xed\xd8\xad\x8f\x71\xfd\x03\xe8\xfd\x71\xca\x81\x1b\xf8\x02\x0b\x62\x76\x39\x59\xa8\x56\x47\xa9\x7c\x80\xc4\x3c\x3a\xbd\xaf\x58\x55\x16\x12\x31\xb1\x80\x2b\x35\x73\x7b\x08\x09\xf8\x0b\x15\x5a\xbd\xba\x9f\xf5\xd5\x3a\xbe\xba\x24\x87\xad\x15\x45\xd3\xf5\x72\x6c\xff\x79\x16\x30\xde\x2a\x1f\x7a\x4b\x53\x4a\x3d\xc1\xab\xac\x60\x13\xa3\x72\x6e\x63\x81\xa4\xbc\xdd\x6a\x8f\x6a\xac\xd0\xc4\x4c\x13\x20\x8f\x0b\x3c\xc5\x0e\x29\xbe\x28\xbc\xd9\x2a\x07\x2f\x1e\x48\x13\x75\x41\xa2\xf3\x89\x17\x8a\xe7\x0c\x1d\x86\xcd\x63\x83\xa0\x61\xb7\xe9\x89\x38\x94\xd7\x2f\xce\x61\x6e\x8a\xbb\x55\x52\xaf\xa1\x7b\xe3\x43\x06\x93\xf2\x09\x7a\x7f\x55\x37\x8b\x72\x75\xd8\x1f\x91\x19\x3c\xf8\x26\xf9\xbf\x20\x65\x7c\x01\xaa\x65\xe4\xa9\x2f\x39\xc9\x1c\x32\xf3\x56\xda\xbd\xee\x02\x61\x97\x18\x70\x70\x02\xba\x89\x68\x78\x21\xab\x17\x92\xb7\xb6\x35\x96\xc6\x83\x52\x5c\xee\x22\x79\x22\x0d\x29\x03\x5a\x3e\xca\x63\x0f\x4d\x7d\x6e\x51\x16\x4f\x1f\x65\x67\xfd\xec\x2c\xdf\xa8\x10\xb3\xf2\xca\xdb\x15\x38\xae\x79\x23\x10\x75\x5b\x53\xa9\xd7\x4f\x85\x42\x77\xd7\xef\xdc\xbf\x9c\x9a\x07\xa7\x60\xfe\xcc\x99\xb5\xfc\xe7\x2a\xe4\xac\x2c\x58\x0a\xfe\x01\x6b\x79\x3e\x72\x1c\xf3\xa7\x89\x78\x1d\xca\xac\xf4\xb4\x39\x9c\xf2\x66\x9e\x1c\x80\xf8\x43\x8e\x13\xcc\xd9\x39\xdd\xb6\x25\x5e\x3e\xfd\xab\xec\x06\x85\x73\xc0\xc2\x9a\xb6\xfb\x58\x36\x69\xde\xc2\xe0\x71\xe3\x07\x4a\xf9\x75\x2c\x43\xab\x73\x8b\xf9\x03\x5e\xcb\x34\x6b\x60\x3c\x10\xd5\x9c\xdc\xae\xc2\x13\x4d\xfd\xa0\x67\x42\x14\xaf\x47\xd8\x97\x7c\xa5\x4c\x48\xe3\xf4\x9f\x9f\xb7\x9c\x28\x10\xda\xe5\xc3\xfb\x8d\xea\xd5\x9c\x94\x36\x48\x26\xc6\x5a\xe0\xca\x7f\x36\x11\xdf\x19\x21\xe0\x18\xfe\x1b\x39\x5b\x92\x18\xe8\x5f\x2d\x08\xae\xc7\x2e\x53\xed\xbb\x04\x41\xbf\xa5\x80\xbd\xbe\x37\x1d\xa5\x77\xa4\x6d\x83\x28\xac\x1e\xae\x74\xf1\xb9\xe0\x9f\x06\x01\xf8\xe8\x82\x15\x02\x3f\xe7\x7f\xd6\x5a\x47\xcd\x14\x39\x47\x84\xd0\xad\xea\x1e\x44\x97\x6b\xe5\xa4\x48\x26\x93\x95\x07\xde\xaf\x49\x34\xa6\x6c\x7e\xf1\xd4\x7e\x3d\x86\x6c\xf1\x15\x27\x64\x89\x2b\xe4\x88\x90\x4b\x71\x21\x7d\xd8\x41\xf1\x74\x7e\xfc\x78\x78\x68\x41\x53\x80\x35\x79\x95\xcc\x8d\x9b\x11\x1a\x23\x3b\x1c\xbf\xaa\xec\x71\xf5\x40\xd3\xcb\xac\x93\x6f\x42\xb9\xfa\x9f\xee\x6e\xaf\x85\x77\x74\x9e\xe6\x3d\xf6\x92\xcc\x33\x57\xc3\xb7\x82\x8e\xd8\x4d\x93\x4d\xec\x22\xca\x4f\xb5\x0f\xe3\xb0\x50\x59\xa4\xe0\x95\x3b\xdb\xdf\x70\x1f\x80\x05\x95\x69\x1e\x23\x23\x3e\x1b\x78\xd6\x12\xdb\xfd\x3b\xa2\x82\x12\xc9\x73\x78\xba\x87\x04\x68\xc7\x4d\xbc\x3b\x59\x4e\x5a\xf3\xc6\x87\xf5\xe2\x59\x83\xae\xad\x97\x74\xd3\x59\x31\x52\x1c\x4f\xb8\x29\x2b\xd8\x50\x9e\xfa\x28\xb6\x02\xb6\xe2\x5b\xe6\x3f\x22\x45\xd7\xbd\xeb\xb2\x65\x03\xd6\x8d\x02\x08\x72\x13\xf8\xea\xd3\xe4\x2b\xf0\xb9\x8e\x5e\x68\x52\x6c\x75\x92\x35\xd6\x29\x37\x2f\x83\x07\x29\x9a\x8b\xc5\x1b\x3d\x9c\x51\xb6\x2c\x41\x72\x9d\xfc\xe9\x20\x4e\x6d\x89\x6a\xa2\x05\x9f\x58\x1a\x3c\x0e\x95\x5a\x94\x0c\xe8\xfd\x67\xa3\x15\x2f\x23\x9c\x26\xf4\xb3\x2b\x01\xee\x95\x74\x68\xfa\xd4\xe0\xf1\x1f\x18\xbf\x98\xb4\x1a\x21\xc9\xb4\x26\xb9\x9c\x6d\x31\x9a\x11\x2e\xda\x7e\x7f\x21\xa0\x61\x03\x86\xca\xdc\x11\xf2\x05\x62\xd6\x66\x3d\x93\x77\x09\xe5\x43\x28\x18\x9f\x4d\x35\x26\x70\x6d\x8e\xaa\x3c\xeb\xef\x8c\xe2\x2a\xaa\x3d\x82\x50\xf3\x22\x0e\x79\x01\x4b\x49\x13\x28\x53\xd8\xdb\x48\x66\x28\x55\x15\xd3\x59\x9f\x13\xd6\x3e\xc4\x33\x54\x0b\x00\x4b\xf7\xd2\x48\x3a\xb3\xf3\xe2\xde\x90\x3a\xff\xed\x6b\x91\xc8\x88\xf8\x75\x17\xec\x4e\xa1\xd4\x19\x96\xa5\x89\xcb\x39\x28\xd2\xd0\x8c\x6d\x48\x75\x17\x26\x22\xcb\x14\xc1\xb3\x56\x2a\x04\xef\x85\x81\x8d\x11\xb4\x79\xdf\xe7\xce\x91\xcc\xd8\x81\x8b\x31\x2c\x5c\x4f\x1f\xdd\xb5\x2b\xa9\x24\xd2\x26\x65\xaa\x57\x47\x3d\xc7\x42\x85\x76\x89\x94\xe5\x0c\x98\x74\x42\x94\x7b\xe9\xde\x9d\x41\x06\xbe\x97\x7b\x65\xde\xbb\x56\xce\xf6\x2b\x61\x7b\x4f\x3d\x2f\x20\xcc\xba\x80\xe0\x62\x01\x83\x65\xd3\x7a\xcd\xb0\x50\xd3\xe5\x60\xd0\x6e\x15\xb5\xc1\x10\x6c\x7d\xd7\xfa\xde\xe7\xdc\xf7\xaa\x48\x40\x38\x61\x1e\x52\xa8\x3a\x0b\x9e\xed\x85\x66\x9a\xea\x8f\xbd\x58\xaa\x83\x3c\x89\x1c\x9b\xab\xa0\xe6\xac\x89\x57\x50\x3a\xe9\xb9\x60\x8a\x4f\xfc\x06\x53\x01\x0f\xea\x10\x3a\xba\x73\xe7\x30\x79\x2f\xe5\x12\xc6\xad\x54\x90\xd7\xf9\x04\x18\x27\x48\x43\x43\x69\x9b\xf5\x70\xbf\x4f\xa2\xb5\xb7\xd5\x2f\xfd\x24\x08\x01\xda\xe7\x02\x09\x6c\xc1\xb8\x8a\x04\x38\x4c\x8f\x5a\xe5\x1b\xbc\x5d\xd3\xc2\x1e\xc7\x36\x63\x22\x11\x50\xf3\x3e\x51\x9a\x69\x0d\x09\x24\xb0\x2e\x96\xfd\x56\x55\x8d\x22\xdd\xd5\x2d\x7d\x9e\x46\x7d\xf9\xaa\xa0\xba\xd5\xaa\x10\xf8\x52\x91\xc9\xca\x62\x95\x9f\x64\x9c\x78\x53\x4b\xe7\xe4\xd6\xde\x7f\xc6\x08\x82\xb2\x90\x56\x2f\x6d\x42\x5f\x94\xc0\x6a\x8d\xaa\x12\x92\xc9\xc1\x70\x4c\x10\x46\x51\x70\x27\x54\xe2\xb6\x88\x59\x50\xea\x74\x03\x2d\xd3\x5f\xde\x87\x2f\x3d\x38\xd3\x67\x88\xbe\x42\x9c\xe9\xe9\xae\x10\x0f\x1b\x2a\x74\xec\xb3\x02\x6f\x87\xb3\x9f\x19\x25\x51\xd7\xe7\x36\xef\x9c\xee\x54\x9b\xc7\xe2\x7f\x70\xca\x8f\x7b\x04\x74\xd2\xd9\xf1\xd7\xb3\x29\xc8\x4f\x67\xe8\xd2\x31\x2c\xc4\x42\x63\x58\x9b\xa5\x11\x1e\xd3\xf2\xfb\x7b\x6e\x8d\x18\x1e\x53\xe1\x56\xf8\xf8\x40\x5e\x60\xa4\xda\x5b\xf1\x4e\xfc\xab\x7e\x3d\x1e\xb8\x41\x64\xde\xd5\xb5\x29\xa2\x14\x00\x0e\x5b\xf4\x7a\x85\x2d\xe9\x2c\x5f\x9f\x70\x6f\xb0\x28\x71\x85\xf4\x3d\x9f\x96\x68\xf8\x37\xb1\xd2\x6a\xc9\xe7\x70\x98\x56\xae\x0e\x54\xfd\x54\x3e\x43\xa1\xb2\x56\x85\xd7\x8c\xab\xef\x4f\xaf\x28\x41\x13\xaf\xb9\xb9\x83\x1a\x08\xd4\x66\x5f\x96\xb4\x7e\x6d\x12\x49\xd0\x45\xdc\x01\x34\xc8\xc2\x2a\x1c\xbd\x97\x96\x80\x9b\x00\x45\x0b\x23\x64\xbf\x7d\x42\x73\x76\xa2\xaf\xd1\xcb\xf2\x1e\x98\xab\x50\x9f\xd5\x18\x5f\x6b\x6a\x92\x02\x3e\x91\x6f\xe9\x79\xf7\x97\x87\x3f\x1b\xa7\xfc\x18\xa4\xf3\xfe\x07\xc1\xc6\x6d\x30\x76\x7e\xa0\xc4\x13\xfd\xf5\x07\x6c\x5c\xd7\xb6\x3e\xde\x20\xa3\x84\x13\xd7\x1c\x9e\xcc\x7f\xfc\x59\x80\xf3\x00\x52\x0e\x0c\x9d\x1c\x02\x56\xb3\x23\x5d\xbd\xad\x10\xa4\x01\xdd\x33\x6c\xba\x9f\x78\x43\x55\x46\xa4\xaa\xfd\xc2\x2a\xef\x6e\xc3\xc0\x7d\x78\x65\x96\xa9\x1c\xca\xf6\xe0\xb0\xad\x44\xaf\xef\x50\x22\xba\x56\xe8\xef\x1f\xa2\x4e\x88\x7c\xe6\x84\xc7\xad\x46\x87\x12\xb7\xdb\x1a\xfa\xe3\xd2\xeb\x6a\x05\xe2\x52\xf6\x3a\x07\xd3\x2e\x63\x59\xa7\x5d\x5c\xa1\x8a\x18\xaf\x37\xcb\xe2\x60\x01\x59\xad\xeb\xe9\xb8\xcc\xbe\x24\x46\x1d\x19\x5b\xa3\x30\x65\x92\x29\xd5\xa1\xaf\xb0\x4a\x2d\xde\x82\x40\x3c\x29\x0b\x58\x82\xa2\xaf\x43\xff\x1c\xd6\x5c\x97\xc0\xaa\x87\x85\x0a\x27\x7d\xa5\x1b\xf4\xec\xcc\xc9\x52\x55\x12\xbc\x62\xab\xa5\xa5\x65\xf2\xd8\xd0\x26\x6a\x03\x15\x14\xd0\xae\x04\x16\x6e\x27\xc6\x89\xf2\x7c\xc5\x1f\xfc\x6e\x42\x98\x5f\xb6\x5b\xdb\xf9\x07\x21\xe4\x9d\x9e\xbe\xc3\xd8\xbb\xfd\xa0\xa2\x1f\x92\x51\xf2\x5b\xb1\x28\xa8\x9e\xd6\x14\x85\x0e\x6e\x9c\x1f\x8e\x74\xf3\xf1\xd9\x1e\x3d\x01\xc9\x62\x1a\xe4\xfc\x02\xc8\x17\x2d\x66\x4b\x72\xe8\x23\x27\x20\x88\x41\xd2\x6d\xbe\x97\x8e\x20\x6d\x76\x53\x5a\xb8\xd2\x70\x7c\x01\xc4\xbd\x1e\x28\xa7\xbd\x51\x41\x23\x94\xef\xea\xfb\xb9\x07\x99\x17\xc5\x3f\xe8\xdc\x94\x1d\xc8\xb4\xe5\x1f\xe3\x1b\xb7\xe0\xac\xd0\x77\x03\x2c\xd9\xb1\x0d\xf6\x41\xf8\xd7\xda\x0c\x1f\x25\x6a\xd2\x6c\xac\x3b\x77\x78\x26\x4e\xc6\x39\x1c\xb1\x80\x4a\xf1\x4d\x25\x3e\x85\x15\x19\xb8\x5a\x5c\xeb\x10\x3c\x2a\x4b\x43\x2f\x54\xd2\x7b\x30\x71\x1b\xc3\xb7\x97\xeb\x66\x36\x4b\xab\x93\xe5\x2d\xa1\x1b\x35\x9d\xce\x84\xe2\x00\xca\xbc\x63\xc5\x1a\xad\xf8\x49\xfe\x5a\x43\xbf\x1d\x16\xe8\xb7\x61\x7d\xca\x3c\x74\x02\xb0\x38\xbd\x97\xe5\x99\xbf\x31\xd9\x03\x7e\xbf\x4d\xd0\xc8\xae\x8d\x4e\x76\x4e\x35\x20\xef\xa4\xd6\x47\xd3\xfd\xb3\xb2\x15\x2e\x77\x74\x06\x5c\x3f\x16\x6e\x72\x19\xa5\x41\x14\x31\x96\xb6\x98\x8a\xb7\x55\x7e\x19\xa7\x13\xf8\xe3\xd3\x97\xa4\xc8\xa3\x15\x00\x91\x9e\x8c\x8c\xd7\xf3\x00\xf6\x26\x0f\x73\x7d\xf6\xb4\xc6\xee\xc8\x8c\x9c\xce\x62\x39\x3b\x40\x59\x50\x89\x6c\x1c\x46\x52\x31\x1c\xdf\x67\x5a\x8a\xde\xc5\x64\x6a\x0d\x06\xcd\x0f\xd8\x3f\x62\x61\x82\xe4\x62\x82\x9b\x3c\xe2\xeb\xbc\xf4\x9e\xac\x02\x08\xdf\xdb\x48\xf3\x30\x1c\xf9\xaa\x34\xf6\x07\x06\xa2\xe1\xb4\x02\x83\x41\xb2\x23\x29\x5a\x43\x66\xb8\x89\x5c\xd3\x12\xb0\x57\xad\x68\x20\xde\x30\x6f\x2c\x1c\x26\xc4\xc0\x73\xa3\x8e\x35\xfb\xab\xd4\xf7\x91\x81\x7f\x80\x6d\x4b\xfa\xea\xe9\x83\x5c\x21\xbf\x7f\x20\xab\x80\x15\x39\x39\x8a\xc3\x66\xd5\x33\x54\x90\xde\x39\xca\xbd\xd7\x7c\x0a\xf7\x1a\x32\xab\x1d\xef\x6e\xef\x9a\x73\x70\x0d\x91\x7b\x77\x0f\xbc\xbb\x6f\xf6\x05\x82\x59\xb7\xb1\x4c\x36\xef\xa2\x2f\x84\x86\xf5\xf4\x80\x63\x44\xa6\x9e\xb8\x73\x30\xdf\x2e\xae\x08\xf0\x6d\x3a\x1d\xac\x41\x82\x56\x5c\xb7\x7b\x9e\x7e\x76\xf5\x43\x20\x09\x03\x50\xb8\x69\x6b\x00\xbf\x8d\x48\xff\xca\x36\x40\x08\xf5\x9b\x79\x2f\x16\x1d\x3c\xd6\x8a\x5d\xa3\x10\xe2\x75\x79\x05\x24\x7c\xe9\xce\x09\x3b\xdd\x31\x0b\x11\x26\xc9\xc7\x16\x01\xf0\x7a\x71\x25\x0e\x44\xcd\x74\xc8\xc6\x49\x56\x88\xce\xdd\xeb\x53\xcd\x0b\x3e\x89\x68\xa6\x86\x00\x72\x49\x9e\xa0\x8f\xe9\x08\x2b\x26\xaf\xb8\x9f\x34\x5b\x24\xaf\x7b\x77\xf9\x7e\x94\x1f\x85\xe4\x43\x35\x0c\x1b\x64\x37\x51\x33\x03\x68\x9c\xab\x6e\x00\x8f\x97\x26\xa6\x4d\x78\xdd\x45\x36\xb8\x70\x63\x5b\xad\xc7\xf5\x4d\xf1\x08\xd3\x7f\x1d\x27\x9d\x02\xda\xb4\x0d\x68\x13\x2d\x7c\xf2\xb5\x5d\x27\x76\x97\x5e\xb8\x3d\x7c\x13\x88\xd8\x64\x99\x7b\x7f\x8d\xe4\x6c\xfd\xd6\x00\x77\xbb\xde\x62\x91\x2d\x2d\xda\x6e\xed\x0d\x6c\xd8\x13\x5d\xd6\xb5\xc1\x68\xc8\xbe\xa3\x13\xee\x51\xfb\x1f\x4a\x88\x93\x19\xe3\x78\xae\xd6\x55\xdf\x9d\x52\x38\xe5\x85\x97\xeb\xa6\xee\x1b\x3d\x75\xaa\x55\x2c\x0e\xbc\xf1\x08\xcc\xf5\xdf\x97\xcb\x84\x3e\xa2\xa8\xb3\x7e\xa7\xac\x61\xf4\x7c\x60\x29\xef\xb8\xae\x8e\x19\xff\xe7\x98\xad\xb1\x0d\x20\xe0\x9b\x3c\x8d\xf9\xbe\xe7\x83\xbd\xc5\xd4\xde\xed\x01\x59\x40\xa5\x1b\x9c\xdf\x14\x72\xd7\x6b\x57\x6b\x07\x76\x4d\x6d\xad\x3f\x99\x91\xef\x36\xe8\x37\xa0\xd5\x93\x21\xb7\x12\x28\xaf\xf8\x57\xf3\xe2\x17\x58\x95\x16\x5a\xb6\x00\x3c\xa5\x93\x4a\xe6\xb3\x06\x27\xbf\x2e\x4e\xc1\x5c\xb4\xf2\xb7\xbc\x9c\x9e\x1a\x96\x25\x02\x9d\xed\x06\xd8\xff\x8e\x51\xc7\x44\x1d\xe7\x0e\x06\xe7\xab\x8f\x4c\x3a\x44\xfa\xf0\x22\xc9\xfc\x9e\xa3\x13\xb0\x03\xb0\x25\xf4\x67\x4f\xf8\x7f\x13\x17\x54\x75\x37\x1a\xfb\xf1\x5c\xda\x33\x86\x4a\x03\xef\xa9\xb3\x34\x6d\x33\xa6\x04\x57\x50\xf1\x33\x95\x4a\xc4\xe0\xca\x62\xc8\x63\x57\xb4\x28\xb6\x21\x4c\xbc\xcc\xa3\x6a\x8c\xbc\xc6\x5d\x29\x27\xdd\x12\xdb\x81\xec\x88\xcd\xe2\x36\x51\x1e\x79\x15\x97\xca\x39\xfd\x28\x87\x7a\xa1\x44\xb3\xb2\x79\x4e\x75\x94\xb6\xd5\x49\x26\x37\x12\xa2\xc9\xa4\x3e\x68\x36\xae\x94\xb1\xd7\xd9\x36\x7e\x70\x69\x25\xc1\x9c\xdc\x0d\xce\xac\xa2\x13\xa3\x04\x23\x78\xa5\x9b\x92\x5d\xf5\xce\xde\xfa\x7e\x9d\x0a\x15\xd5\x8b\xf6\x64\x40\xe3\x8a\x2b\x92\x69\xc1\xc3\xd4\xad\x2a\xff\x5d\xaf\xbe\xeb\x3d\xf0\x2d\x30\x1b\x36\x75\xe5\x1e\x93\xbd\x9b\x82\x11\x5d\x37\xf6\x4c\x95\x36\xba\x02\xb9\xfc\x95\x49\x3b\x74\x83\x54\xb7\x9b\xb6\x9f\xe2\x3d\xf4\xc6\x72\x9b\xea\xca\x4f\xd1\xa0\x94\xce\xef\x86\xca\x15\xdb\xd7\x3d\x63\x44\xbc\x25\xea\x1a\x5d\x95\xdb\xac\x92\x44\x11\xa3\x8a\xc2\x85\xb9\x6d\x72\xa0\x48\x9b\xf4\xce\xdd\xf6\x96\x8b\xd7\xd9\x39\x54\x5b\x34\x01\x03\x21\x5a\x2f\xa0\x80\x66\x14\x9c\xf3\x9f\x9a\x09\x29\x0f\xc3\xc7\x38\xc1\x37\xb5\xab\x87\xd9\x7c\xb4\x2b\x2e\xff\x1b\xb9\xc8\xf4\xb7\x8d\x92\xa4\x1b\xf9\x10\xc8\x9f\x4b\x8e\x31\x3c\x70\x9b\xe6\xe4\x1a\x1f\x11\x47\x9d\x5d\x91\x8c\x9c\x43\xad\xff\xc8\xcb\x9b\xc1\x46\x20\xf1\xab\xa7\x47\x9a\x50\x22\x9f\x50\x84\x85\xcb\x1b\x41\x6d\x42\x62\x6b\xba\x64\x8e\x58\xe8\xa2\x6f\x57\xdb\x4a\x6a\x3e\x4d\x27\x1b\x92\x08\xed\xc8\xac\x66\xd5\x3e\x63\x30\x78\x48\x2d\x2c\x17\xb7\xe5\xff\x09\xc1\x04\xc2\x23\x9b\x12\xb0\x1d\x8d\xab\x9b\xa3\x3c\xe8\xd6\x3b\x6e\x7f\x13\x72\x9f\xba\x7d\x5c\x69\xad\x00\xad\x5f\x92\xee\xff\x2c\x91\x0f\x06\x00\xca\x8a\x78\x33\xe4\x28\xd7\x12\x1c\x12\xe1\x51\x3c\x64\xa1\x0b\xd3\xd6\xf7\x93\x50\xac\x40\xb8\xfe\xe6\x0f\x39\x02\x6a\xc6\x94\xb2\xe8\x37\x75\x89\x50\xae\x71\xf4\xb0\xb1\x9f\xb3\x2f\x9a\x6f\xc0\xf6\x73\xcb\xbd\x93\xeb\x8c\x61\x3d\x37\xaf\x36\xda\x0f\xdc\xc3\xed\x6b\x15\x05\x35\xc0\xa9\xd7\x77\x48\xca\x4a\xee\x50\xc3\xab\xc2\x80\x77\x3e\xca\x38\x34\x52\x9c\x2a\xe6\x09\xe3\xc1\x6d\x97\xf7\x68\x43\xc8\x7e\xd3\xc6\xdd\x23\xe3\xd3\xc8\x2d\xb4\xa7\x09\x9e\x95\x43\xb4\xdc\xb1\x3b\x55\xe7\xd7\x54\xe8\xb8\xad\x26\x54\x1d\xc7\xc7\xfd\x29\x70\x3d\x38\x01\x26\xb8\xe8\x23\x0f\x54\xe1\xba\x95\x26\xce\x5f\xc7\x30\x3c\xe7\xcd\x16\xa0\x4c\x1d\x80\xed\xbc\xce\x04\x9d\x8e\x76\xeb\x14\x3c\x8b\xf5\xc0\x0b\xd8\xd7\xf4\xd9\x4c\xa9\xd7\xfa\x8f\xf7\x59\x67\xd3\x1d\x1e\x26\x76\x94\xbf\x7f\x82\xc3\x5e\x32\xf6\x12\x11\x7d\x05\xde\x2c\x2e\x14\xa2\x9b\x04\xac\xc5\xa6\x93\xd0\xed\x4c\x29\x18\x7c\xb2\x78\xa7\xf4\x54\x53\x2b\x16\x31\xab\x3b\xc3\xeb\xd8\xdc\x72\xf1\xb0\xd3\x0c\xac\x39\xbf\x21\x6f\xe0\x3c\x79\xb2\xaa\xfb\xc1\xb1\x20\xa5\x15\x94\xec\x59\xab\x60\x87\xe1\xb8\x27\xde\x49\x79\xa6\x1c\xc4\x07\x35\x9c\xc5\x5d\xba\x00\xbe\x66\x2d\x52\x69\xca\x1f\x2c\x22\x6a\x8a\xe2\x62\x34\x0d\xda\xb2\xd6\x8e\x52\xc6\x16\x87\x7e\x84\xb9\xd4\x9b\xb1\xfc\x2f\xdc\x8f\x25\xe9\xc5\x54\xe7\x60\xf5\x25\x7e\x47\x4b\xf3\x5b\x83\x99\xd7\xcf\x22\x89\x39\x32\xa2\x2c\x0b\xc9\x84\x42\xaf\x76\x26\xf7\xf4\x77\x30\x4c\x0a\x6a\xf8\xef\xa2\x87\xd7\x94\x5e\x29\xf3\xea\x41\x96\x84\xfc\x77\xdb\xa9\xda\x80\x69\x92\x9e\x11\x8a\xe7\x5e\xa3\x63\xde\x5e\x94\xd0\x2b\xb4\xef\x39\xac\x49\x77\xd7\x07\xd4\x84\x34\xb4\xc8\x54\x8d\xe6\xa4\xd6\x8e\x0f\xd1\x26\x5d\x9a\xbb\xa6\x75\x4b\x4d\x4c\xf9\xa1\xf1\x9d\xe1\xf5\x42\x37\x9d\xcd\xe4\x26\xc2\x4a\x22\xf8\x38\x5d\xf1\x20\x9c\x51\xde\xaf\x11\x4a\x90\xc9\x47\x74\xa1\xa7\x2b\xbe\xe0\xde\xf6\x89\x9b\x1f\xbc\xb5\xd2\xfc\xf4\xed\x0e\x7e\x80\xbd\xf1\xc0\xbc\x2f\x9e\x87\x7d\xe8\x06\x6a\x7f\x22\xcc\xeb\x89\xfd\x27\xd8\x5b\xe0\xca\xdc\xc5\x8d\xb8\xd7\x93\x32\xaf\x25\x55\x01\x01\x9a\xed\x1f\x63\x1e\xb3\x03\xb3\x6d\xbd\xde\xb6\x17\xd4\xd1\x31\x7d\x31\x9c\xcf\x99\xb6\xe8\x23\x18\x26\xb7\xb0\x4f\xbc\x10\xa7\x6f\x3e\xf9\x88\x22\xae\x72\x48\xc5\xb0\x3f\x3b\xe9\xb2\x2d\x3f\x12\x34\xa3\x2d\x6d\x1a\xdc\x2c\x13\x9b\x1d\x48\x68\xca\xd5\x6e\xaa\x47\xbb\x9f\x64\x44\x8e\x7c\x5c\x9f\x81\x31\xd3\x2b\xe7\x36\xdd\x74\x14\xd9\xa3\x22\x58\x95\x8a\x54\x5f\x52\x07\x94\x99\xd5\x1c\x06\xc2\x4d\x06\x06\xb2\x8b\x1d\x1f\x5c\xfb\x41\xeb\x74\x2b\x77\xb9\x9e\xa8\x61\x55\x53\x9e\x6b\x8d\xe9\xac\xcc\x06\xf5\x12\x71\x33\xb1\xbf\xc2\x1f\xc2\xa0\x68\x8e\x1c\x06\x50\x41\xa1\x45\xae\x30\x72\xe7\x9c\xc5\xea\xc5\xb4\xd3\xec\x10\x2e\x6a\xf2\x6b\x2b\x34\xd8\xe8\xed\x6b\x57\xc3\x6b\xf0\x2e\x59\xf4\x65\x00\x5e\x08\x11\x16\x5c\x62\x5f\x98\x61\x06\xfe\x38\x1d\x37\xd3\x85\x1e\x6e\x10\x38\xf9\x54\x8a\xbc\x20\xc6\x54\xca\x79\xba\xaa\x84\xb8\x13\x2d\x4f\x3e\x2e\x67\x77\x7a\x79\x91\xf7\xdb\xdc\x4c\xb3\x08\x7f\xb2\x7c\xdd\x81\x09\x88\xc4\xf7\xb4\x19\xa4\xf0\x87\x07\x62\xef\xdb\x10\x9f\x4e\xde\xb8\xc8\xf1\x43\x0f\x8d\x56\xda\x98\x41\x8a\x6a\x65\xfc\x33\x30\x0c\x76\xe8\xb0\xed\xe9\x12\xc5\x55\xd4\x5d\xf5\xab\x48\x58\x05\xed\x97\x11\x2d\xb4\x31\xf8\x2f\x0c\xea\x05\x40\x50\xf4\xe7\x2c\x8d\x10\x60\x36\x78\x4d\xbd\xad\xac\x99\x7b\xbe\x5e\x31\xd3\xb7\x11\x49\xec\x03\x70\x64\x8a\xcb\x57\x71\x6a\x9d\x75\xf5\xed\x15\x2d\x75\x84\x42\x13\xf2\x59\x5c\xf7\x4b\xb7\x2b\x34\x5f\xf4\x42\xc4\x60\x4c\x19\x36\x66\x75\x83\x06\xa1\x9e\xae\xc6\xa5\x36\x56\x62\x55\x63\xd1\xe9\x67\xcd\x39\x18\xf1\x3c\x84\xf9\x73\xbc\xc8\xfb\x2c\xda\x45\x36\xe0\x44\xe8\x1a\x12\x2e\xca\xf2\xc0\xaa\x5c\x1b\x39\x26\x0c\x02\x97\x67\x51\x82\x4f\xb5\x0d\x24\x69\xd1\x90\x88\x30\xd8\x7f\xaa\xfa\xe2\xe7\x0c\x99\xdd\x9e\x1f\x6c\x25\x36\x5b\x1e\x26\xc8\x9e\x28\xd1\x9c\xcd\x23\x6c\xec\x1e\xe0\x95\x96\xe6\x1f\xe1\xf8\x9b\x36\x7d\x83\x97\x2e\x9a\xb7\xf2\xba\xfd\xe9\xa9\xcf\xa4\xa7\x67\x55\xae\xc2\xc5\x4c\x30\x4a\xa1\x23\x39\xa5\xc4\xe4\x9e\x3f\x66\xaf\x5d\x2c\xb3\xc8\x37\xbc\x0f\xbc\xa1\x0d\xee\x7d\xdb\x1a\x0c\xc0\x35\x8f\x60\x43\xef\x40\xb2\xa7\x14\x6e\xd6\xee\x88\xff\x67\x8e\x56\x0d\x7c\xe4\x9b\x4e\xa6\x80\xf8\xa5\x78\xbc\x2d\xf3\x3c\x74\x61\xd5\x3e\xe8\x78\xaa\x94\xd8\x7f\xcd\x25\xea\x05\xe8\xe5\x1d\x19\xc1\x67\x9e\x34\xed\x1e\xce\x33\xc3\x6c\x72\x6f\x1f\x26\xe3\x46\xc1\xd2\xe1\xcb\xb8\xab\x35\xb4\x1b\xcd\xe7\x14\xed\x55\x91\x38\x32\xdb\xb7\x67\x89\xda\x1b\xdc\x89\x73\x6b\xff\xde\x81\x9b\xc6\x50\x2a\x37\x14\xf4\xf4\xcf\xbe\xcb\x4e\xa5\x61\xed\x1b\x39\x5d\x77\x04\xb6\x65\x2c\x2b\x24\x01\x55\xee\x05\x27\xf1\x26\x57\x27\x6b\xf1\x52\xf5\x7c\xf3\xe7\xad\xb0\x65\x17\x52\x3a\xc5\x31\xab\x8b\xe1\x24\x8e\x9d\x21\xdb\x3b\x09\xc6\x77\x77\xd5\xd5\x64\x3e\x4e\x29\x50\x01\x4d\x17\xd0\xe7\x12\x29\x69\x73\x9b\x0a\xdb\x80\xaf\x48\x36\xfd\xf4\x7f\xe9\x21\x9a\xc8\xb8\x01\x83\xce\x5b\x80\x54\x71\xe7\xcc\xd7\xc0\x71\xa6\x7a\xd3\x71\x25\xdb\x5a\x82\x81\x11\xcd\xff\x92\xf5\xb3\x68\x94\x46\xc8\x5f\xed\x77\x70\xe9\xe9\x71\x1b\x18\xa8\x8d\xf1\xf0\x39\xb0\x78\x37\x47\xab\x92\x71\xd2\x41\x4d\x6c\xb1\xbc\x27\x8e\x68\x68\xc1\x59\xe7\xfb\x27\x3f\x37\x76\x9c\x0a\x55\xbc\x39\x5a\xaf\x92\x48\xa3\x2c\x80\x43\xf8\x67\x11\xba\x25\x29\x81\xbd\xe1\xad\xbb\x3f\x52\x36\xd5\x77\xe2\xcc\x09\x95\xcf\xac\x1a\x86\x0d\x10\x3e\x9c\xb3\x49\xf9\x4d\x7b\xd0\x2e\xa5\x62\x24\x01\x68\xf6\x4c\xe5\xc0\x50\xe1\x38\x28\xd9\x9e\xe9\xd9\xee\x0c\x05\x83\xf8\x9c\x80\x28\x13\x9e\x06\x90\xf0\x48\x37\xc8\xfe\x84\x3c\xff\x72\x72\x32\x1c\x55\x39\x9c\xb8\x4a\x68\xcb\x84\x17\x3f\xa1\x0d\x91\xa4\x74\xbc\x3b\x35\x16\xec\x42\x59\x3f\xe0\xc0\xb6\x9d\x62\x27\xe9\x62\xc5\xce\xf8\xe5\x47\xc7\xa5\x77\x3b\xba\xc7\x51\xfa\xc7\x99\xd9\x31\xfe\xa6\x80\x87\xd6\xa3\xa1\xd3\xbf\x14\xcb\x8b\x62\x95\xca\x13\x3a\xbf\x63\x6a\x58\xb5\xff\x2e\xfd\x40\xd1\xb0\x17\x08\x36\xa1\xc2\x46\xfe\x65\xf2\x23\x7e\xde\x10\x5e\x23\x9b\x1b\x7e\x20\x9c\x9d\x50\xd9\xa3\x3b\x18\x8b\xe3\xa4\x99\x7f\x46\x6d\xb3\x68\xad\x9c\xf1\x9c\x8d\x05\xdf\xfb\xb7\x00\x8c\x56\xe8\xe0\x61\xd8\x90\x5b\x7a\xb6\x9c\xe0\x7b\x06\x20\x07\xe3\x26\x5c\xc3\xd5\xd5\x47\xff\xae\xce\xa1\x95\x86\x80\xc8\x53\x50\xaf\xe0\x80\x54\x6c\x2a\xb5\x39\xba\x08\x7e\x22\x9b\x83\xae\x08\x53\xab\x79\xeb\x75\x69\x50\x4e\x4f\x5c\xe6\x36\xd8\x27\x81\xc8\xd4\xf3\x09\x29\x57\xed\x65\xee\x4b\x88\x52\xf6\xd9\x2d\x9c\x06\xfc\x97\xda\x0c\x28\x93\x72\x0f\x44\xf5\xce\xf3\xf0\x03\xcf\xb7\x96\xe2\x30\xc9\x9a\x91\x0a\x8c\xb4\x90\x8c\x4f\x19\x63\x2d\x65\xfc\x70\x68\x06\xb1\x1d\x77\x4c\x62\x1e\x6a\x4f\xc0\x7d\x58\x75\x33\x8f\x4d\x64\xf9\x42\xee\x89\x68\x96\xdd\x52\xb5\xe5\x18\x04\x5d\x96\x3f\x1c\x06\xb2\x00\x24\xe9\x4f\x57\x0d\xd8\x63\x33\x83\x04\xce\xd7\xa4\xdb\x9d\xf8\x2f\x43\x8f\x61\x55\xe2\x97\x74\xf8\xa9\x41\xb5\xbb\x2c\xd9\x64\xbd\x17\x3d\x77\x85\xdf\x69\x5c\xcb\x26\x7f\x91\x1c\x20\x0a\x23\x3d\x75\xad\xdd\x54\x21\x0a\x8c\x3c\xdf\xec\xd7\x15\x9f\x78\x65\xcf\x4a\x59\xff\xec\x49\xe9\x8a\xe1\x50\x77\x88\x43\xf1\xf3\x50\xd0\x33\xb8\x09\x05\xa1\x55\xf4\xe5\x0c\x71\x8a\x12\xa8\x0f\xd5\x84\xde\x6d\x57\x62\xbb\x36\xb7\xbd\xcc\xf3\xe9\xea\x33\x35\xfc\x7f\x06\x50\xb6\x60\xfe\x5a\x36\x2e\x9b\x67\x2a\x33\x99\x20\x5b\x50\x11\xb2\xc6\x73\xf5\x77\x2b\xe1\x70\x5c\xec\x66\x49\xea\x46\xa3\x15\xd8\x9e\x19\x07\x91\x73\x0e\x18\x69\x42\xae\x58\x95\x31\xad\x36\x70\x83\xa3\x08\x3c\xe9\x18\x5e\xad\x7e\x3c\x84\x80\x6b\x74\xf2\xd1\x73\x68\x12\xd0\xbd\xc5\x9b\xff\x9d\x49\x47\x67\x07\xcb\x4e\xcf\x7c\x6d\x5d\xbf\x15\xc4\x1f\x31\xdb\x7a\xe7\xb4\x38\xa9\x2a\x31\x1f\xec\xc5\x75\xbd\x3a\xce\xa6\x35\x7e\x85\xd4\x33\x3c\xcf\x4d\x82\xb6\x15\x23\xc0\x2a\xd3\xac\xb0\x8d\xbc\x1d\xc7\xb1\xc2\xae\x26\x07\xd5\x28\x77\x8b\xac\x6e\xab\xfb\xd7\xd9\x32\x67\x84\x24\x92\x0d\x63\x27\x64\xa2\x98\xee\xed\x84\xa9\x46\xb7\x7a\xe4\xa2\xd8\xed\x06\xaf\xb4\x5c\x14\xaa\x82\x90\x19\xd7\xb7\x1b\xfb\xcb\xdb\x33\xe3\xdd\x51\x41\xc1\x57\xce\xe4\xee\x67\x41\xdc\xf9\x8a\xa3\x09\x40\xf2\xd0\x0c\x0d\xe2\x54\x5d\x1b\xfb\x59\x8b\xca\x3f\x7c\x90\xf3\xe6\x16\xc7\x9d\x7a\xce\xf8\x11\x0a\x3b\xde\x00\xed\x35\x8d\x58\x75\x2f\xc3\x7f\x95\xec\x5d\x82\x16\x6d\x7c\x34\xa1\x42\xf7\xf7\x61\x18\x55\xa9\x3e\x48\x05\x77\x89\xea\x01\x5f\x25\x96\x84\x64\x62\xf7\xf4\x55\xbe\x2c\x88\x10\x73\x57\xd5\x9e\xe9\x4e\xa4\x67\xa3\x5b\xee\x27\x25\x3b\x27\xbb\x0b\xe9\x93\xae\x41\x42\xdf\xf1\xda\x05\x7a\xae\xed\xf3\x4e\x90\x2a\xac\x70\x39\xd2\xd8\x29\x31\x27\x94\xb0\xd3\x3b\x9a\x71\x34\xb1\x3e\x12\x01\x6b\x3c\x95\x4d\x53\xe7\xe7\xbf\x40\x83\x5f\x38\x70\x7c\x33\x37\xd8\x4e\xa9\x99\x05\x87\xb1\x36\xa0\x9c\xdf\x2a\xfc\xa7\xa9\x8a\xeb\xbc\xe4\x48\xf7\xc4\x0e\xca\xe0\x11\x21\x9e\x70\xa9\xf7\xb4\x8b\x97\x70\xfb\xba\xfb\x24\x20\x42\x8f\x6f\xb3\x76\x8a\x72\xa2\x61\x52\xa7\x13\x49\x54\x2a\x07\x63\x1c\xb1\x3d\x93\x43\xce\x6b\xe4\x28\x90\x33\x32\x79\x8a\x82\x6c\xf2\xac\xcf\xf0\xbf\x01\x2b\xd3\xcf\xae\x0f\x7d\x3d\x86\xf5\x86\x7e\x16\x69\x52\x2f\x90\x04\x33\xe1\x37\x59\xae\xf9\x95\x5b\xce\x37\x83\x06\x03\x07\xc7\x54\xb3\xf3\xa2\x72\x2e\xc1\xfe\x72\xcf\x50\xe9\x93\x75\xf8\xa1\xa7\x66\x56\x2c\x28\x0d\xb0\xbf\xf1\xfb\xda\x64\x8d\x02\xca\xe9\xd8\x11\x55\xb4\xf5\xd4\x32\xfd\x2a\x0d\x9f\x77\x0c\xcb\xbb\x96\x04\x43\x27\xa5\x54\xe8\xb3\xc0\x92\x08\x28\x5a\x4a\x25\x49\x53\x2e\xe8\xa6\xec\xb5\x95\x1a\xd0\xed\x70\x31\x34\xc1\x0e\x16\x9d\x27\x19\x94\xf5\x58\xee\x43\x2f\xda\x75\x84\xcb\x78\x3a\xba\x8f\x6b\x84\xe4\x5e\x42\x08\x23\x40\x24\xc7\x35\xeb\xf9\xf2\x3f\xf1\x26\x62\x34\x9c\x03\x65\x98\x96\xae\x8f\x72\x72\x07\x68\x94\xfe\xea\x64\x9b\x42\x9b\x13\xde\x27\xa7\xa9\x44\x90\x81\x80\xe7\x04\x02\xe7\x0e\x00\xe7\xd3\x3b\x8f\x2e\xca\x06\x7e\xbc\x03\x6c\x7e\xb2\xd9\xbb\x50\x59\xb0\x0d\xcd\x1d\x63\x50\xe3\x47\xa5\x10\xab\xcb\xc4\xa8\x91\xb3\x64\xbd\x9d\xce\x54\xa7\x89\xbc\xbd\x31\xa6\x08\xb1\x2f\x5e\x07\xbd\xee\x84\x8b\x44\x34\xc2\x4d\x9f\x7a\xe0\xa9\x6e\xbb\x4a\x0f\x32\x17\x31\x21\x62\xa0\x5a\x04\x2e\x49\x60\x73\xe3\x6c\xe4\x5a\x9d\x79\x3a\x0e\x37\xa3\x72\x3e\x4c\xa1\xae\xb8\x67\x2c\xd6\xd6\xbe\x81\xd7\x48\xfa\xb5\xd6\xe1\x57\xbf\x08\xfb\xda\x51\xe2\x72\xa2\x95\x59\xcc\x31\x19\x50\xc9\xde\x51\x12\x8b\x07\xe5\x8f\x0f\x36\xa1\xae\x37\x5c\x17\x23\xec\xfd\x2d\x31\x68\x08\x87\x70\xbd\xaa\x3d\xd1\xbc\x44\x81\xbd\xa3\x56\x4e\xf7\xdf\x7f\xc1\x70\xaf\x2d\x49\xbb\x19\x3f\x37\x67\xd2\xa9\x76\x34\x59\x96\x87\x2b\xe1\xbe\x44\x00\xf1\x98\x78\x0b\x78\x20\x0c\xc8\x9f\x61\x4c\x01\x54\x14\x4d\x01\x9e\xf4\xc8\x79\x05\x87\x41\xf0\x5c\xeb\x00\xfd\x79\x06\xba\x65\x7b\xfa\x96\x16\xb6\xae\x62\x56\x77\xa9\x83\xbd\x5d\x79\x2a\x0d\xaf\xf8\xac\x0c\x2b\x0f\x1f\xfb\x1b\x55\xec\xd1\xee\x1b\x98\xb3\x2c\xec\x05\x7c\xe2\xee\x9d\xa3\x52\xfe\x71\xdc\xf4\xa8\x14\x83\xb2\x0a\x81\x2d\x23\x87\xeb\x04\x84\x36\xcd\x9e\x37\x2f\x91\x72\x35\xd8\x5e\x42\x8c\x6b\x96\x91\xc1\xe8\xa6\x08\xa1\x95\x4d\xdd\x10\x7c\x62\x4c\x6b\x28\x8b\x60\x67\xe8\xd3\x7a\x37\x5e\x16\x17\x5b\x13\x3c\xf3\x3d\xe6\xe0\x73\x5a\x20\xcd\x5a\x19\xfa\xe6\x5a\x19\x29\xd2\x07\x90\x0b\xdd\xc2\xe4\xcf\xd9\x09\x3f\x2a\xaa\x7b\xd8\x2c\xaf\x5b\x7b\xb7\x73\x41\x3e\x08\xa7\xb2\x01\xb7\x1e\x2f\xd3\x71\xad\x4a\x97\xb1\xbf\xf4\x9e\x51\xac\x4a\x5a\x8c\xec\x6b\xb7\x92\xb0\xce\xba\x7e\xb7\xce\xd6\x39\xcd\x17\xcc\x7c\x05\x6b\xc0\xef\x6c\x1b\x1d\xe5\x88\x5f\x3c\xfa\x70\xfd\x9f\x4b\x80\x58\x68\x45\x43\xe3\xac\x15\x40\x5c\xdd\xcf\x41\x9d\xef\x09\x5f\xb4\x9c\xbc\xe5\x58\x9d\x0d\x47\x65\xc0\xb9\x13\xe8\xf6\x58\xf1\x32\x5c\x83\x44\x1e\x4c\xe1\x59\x41\xd2\x60\x77\xcc\x89\x59\xfd\xec\x40\xe4\x1c\x13\x72\xd0\x89\xf4\x18\x3b\x97\xce\x61\x2c\x5d\x91\xb0\x7f\x25\x2d\x88\x83\xe6\x1b\xad\xfc\x2c\x5f\xde\x32\x88\x9c\xb0\x01\x53\x44\xc1\x91\x99\x39\x24\x9b\xe3\x0b\x96\x97\x2b\xca\x17\x86\x28\xb0\xfe\x15\x65\xd3\x99\xa9\x9e\x65\xde\xa9\xc2\x91\xca\x28\x2c\xd2\x05\xee\xec\x07\xb7\xe9\xad\x72\xa5\x4f\x49\xd8\x89\x19\x79\x92\x79\xfa\x84\x23\xab\xc8\xa1\xe2\x7b\x4f\xcd\x4a\x73\x8e\x6f\x11\xe8\x68\x35\x31\x74\x9b\xa6\x9f\x82\xed\xc0\x3b\xe4\x06\x8a\x39\x58\x88\x3c\x76\xdf\x84\x8a\x77\x87\x91\x0c\xec\x51\x5c\x5d\x47\xa3\xe6\xf2\xcf\x6c\x54\x26\x02\x91\xba\xc3\x96\x81\xb9\xe1\x57\x15\xe5\x50\x5a\x21\x6e\xcf\xa6\x97\x68\x1e\x14\x5d\x8f\xa8\xe3\xba\xac\x45\x26\xb0\x34\xc9\x76\xc7\x6d\x39\xa5\x45\x2e\x89\x77\x70\x25\xca\x88\x10\x08\xe5\x05\x57\x3f\x0d\x87\x99\xc6\x0b\x64\x4a\xb3\x78\xba\x8d\x24\x2d\xee\x05\x90\xcd\x72\x95\x10\x52\x07\x3c\x4b\x26\xdd\x5e\xa9\x36\x17\x10\xca\xf9\xc4\x8f\x8d\xee\x07\x4a\x36\xe2\x00\x52\x3e\x54\xf0\x0d\x93\x5a\xeb\xfd\x4d\xcc\xa5\x39\x35\xed\x64\x6a\x02\x7e\x91\x80\x6c\x8a\x3d\x8f\x47\xe0\xab\x55\x38\x6e\x37\x52\xa7\xbc\xaa\xe7\xc1\xd3\x52\x02\x87\xe6\x7c\x9a\x5d\x6d\x9c\x44\xa5\xcc\x9e\x9a\x1d\x02\x49\x08\x9f\xb3\x3e\xd3\xe8\x6b\xa5\x8d\x58\x1b\x25\x7b\x2f\x84\xbf\x5c\xae\x64\x23\xbc\x7e\x62\x76\x6b\x6a\x37\x6e\x94\xb0\xab\x9c\xf4\x8d\x9d\xcd\x3f\xf0\x3b\xff\xdb\xe2\x67\x04\x00\x7c\x44\xbc\xf5\xe7\x03\xc1\x81\x06\x76\xaa\xf7\x73\xd3\x5d\xee\xdd\x8e\xbb\x8c\xd7\x95\x3d\x81\x3d\x99\x22\xc2\x59\x45\x11\x18\x29\x82\x3a\x9f\x48\x91\x83\x8e\xb9\x36\xc3\x57\xa0\x5f\x14\xc2\x79\x22\xda\x03\xf2\x27\x09\x48\x5c\x87\x72\x4b\x66\x6a\x1c\x8a\x5a\x2b\x08\xa4\x40\xdd\x26\xa7\xdc\xb1\x34\x74\x30\x62\x87\x0c\xc4\xa0\x06\xda\x1a\x39\xd1\xc4\x34\xd7\xcb\x7d\xbb\xf2\xe4\x08\x5c\x72\x86\x3c\xc0\xee\x58\xe8\xe3\x7e\x91\xb4\x1d\x5a\x38\xee\x9f\xd4\x85\x34\xac\x9c\xc2\x50\x31\xcb\xd4\xaa\xee\x2c\x4e\xda\x85\x90\x52\x8c\x0f\x54\x07\xb9\x19\x72\x16\x99\x6a\xad\x81\x4d\x84\x54\xfe\xb8\xfc\xe6\x2d\x4d\x88\x15\x90\x98\x7c\xc4\xea\x64\x27\xf0\x1c\x52\x54\x4b\xdf\x01\xf7\xf5\xb5\x15\x59\x0c\x34\x87\x87\x8b\xb1\x88\x3d\x39\x53\xef\x92\x07\x61\x57\xc6\xf4\x58\x25\x70\xfe\x36\xef\xe1\x47\x30\xdd\x1d\x9f\x14\x72\x70\xfb\xcc\x61\xce\x95\x9c\x03\xd6\xd9\x31\x15\x8a\xa6\xf6\xa6\x30\xa7\x0f\x1a\xa1\x13\xb2\xa2\x0e\x89\xc3\x55\x77\x51\xb6\x14\xc8\x72\xe5\xbd\x2a\xc1\x72\x34\x69\x7b\x2e\x5b\xd8\xca\x09\x3f\xd5\x47\xe8\xf2\xc3\xa9\x99\x2a\x7b\x21\x77\xe8\x54\xd8\x48\x26\x0f\xab\xee\xb1\x72\x8b\x57\x3d\xba\xeb\xbf\x84\x65\x87\xbf\x04\x72\x46\xe3\x7c\x0a\x26\x82\x81\xac\x7e\x56\x60\xb1\xa1\x7f\x00\xab\x5a\x9f\xf5\x37\xa4\x3d\x48\x16\xbe\x8a\xab\x4e\x55\xd2\x96\x2e\xf9\x90\x36\xcf\x86\xfd\x18\x9d\x6d\x2f\x02\x97\x8e\x40\xad\x53\x91\x8e\x1f\xbf\xc9\x2b\xfa\x9e\x3d\x47\x2e\x1b\xd2\xb0\xd1\xd5\xf7\xa6\xb9\x35\xb7\x55\x46\xc0\x42\xa7\xfc\xe0\xda\xb4\xb9\x33\xaf\x17\x51\x73\x86\x40\xf5\xbd\xb5\x6a\x8c\x5d\xde\x64\xd6\xb9\xe9\x2a\x8c\xc1\xe3\x2f\x3a\x35\x91\xd5\x28\x32\xca\x3d\xdc\xf8\xde\x9c\x93\xdf\xd7\x8e\x23\xfd\x62\x3f\xb8\x24\x00\x51\x78\xa0\x81\xed\x96\x33\xb0\x87\x28\x07\x27\x31\xe7\x21\x24\x6a\x48\x85\x67\x9c\x3a\x4c\x76\xf8\xd8\xff\xdc\x77\x53\xfc\xf4\x11\x73\x30\x00\xc6\x0c\xb7\x9c\x9a\x00\xb3\x08\x92\x24\x68\x73\xc8\xd6\x80\xc4\x20\x00\xc7\xd4\x83\x03\xf5\x7d\x81\x15\x91\x5c\x0e\xa2\x07\x01\x9b\x3a\xab\x44\x46\x3c\x13\x56\x90\xc2\xa6\x31\x55\x6f\x40\x10\x82\xa4\x90\x72\xbf\x03\x72\xb8\x77\x20\xee\xc9\x71\x1e\xd8\x30\x99\x12\x85\xd2\xb4\x86\x4e\x74\xd4\x27\x1c\xe0\xa6\xdf\x4b\x31\xea\x53\x2b\x1b\xde\x87\xef\x2f\x0e\x6b\x15\xb4\x48\xf9\x9f\x67\x2e\x71\xf5\xe5\x6c\xda\x84\xaa\xc2\xfc\x8f\x16\x0b\xd9\x74\xbc\x7f\x77\x7d\x2b\x9c\xde\xed\xcd\x8d\x28\x73\xeb\x34\x20\xaa\x5c\x60\xb9\x96\x08\x5c\xaf\x42\xc2\x58\x2f\x84\x88\x9e\x85\xb1\xd2\x60\x25\xa1\x20\xc9\x6e\xa4\xc7\xca\x58\xd0\x19\x85\xf1\xb8\x0d\x5a\xa7\x4b\x15\x92\xd3\x97\xf9\x30\x7f\xdc\x47\xd3\xd2\x3f\xfc\x8d\xfa\x75\x26\x19\x37\xfa\xe1\xcb\xbb\xd5\xde\xeb\xf1\x12\xf0\x6c\x85\x18\xc0\x14\x26\xe4\x66\x0e\xe4\xf8\x71\xa7\x4e\x98\xa0\x75\x94\x92\xfe\x33\xf4\x23\x27\xca\x53\xd8\xa1\x90\x86\x36\xf1\xbe\x12\x64\xc3\xc0\x13\x68\xf6\x5b\x3f\xc5\xd6\x67\x71\xd8\xcf\xa4\x6e\xca\x51\xfa\x57\x9d\x41\xc0\x7c\xb3\xc0\x6f\xde\xdd\x5f\xc5\xaa\xc1\xb9\xd5\xbe\x4b\x2e\x0f\xcd\xfb\x63\x5e\xe9\x0c\x48\x51\x3f\x93\xeb\x8e\xf3\x6d\xaf\xcc\x1a\x2f\x16\xea\x20\x28\xd5\x47\xa6\xf9\xf3\x7a\xb2\x75\x4a\x51\x3a\x5c\x2e\xbd\x00\xbe\x1a\x6a\xbf\xd1\x8a\x1b\xa8\xef\x03\x5e\xae\x92\x47\x74\x10\xe4\xa7\x4a\x07\xd9\x1c\x0c\x37\x6b\x2c\xb0\x37\xb3\x85\xf5\x47\xb9\x33\xa0\x4d\x8a\xcc\xd0\x72\x1f\x46\xcc\x2a\x1e\x17\xbe\x77\x00\xfe\x13\xae\x8a\x0f\x2c\xff\x4c\x97\x42\x0a\xd2\xff\x42\xec\xda\xf3\xa7\xac\x3d\xc0\x12\xde\x25\xcf\x9b\x90\x62\xd7\xd7\x84\xe4\x12\xa9\xe5\x9d\xcc\x43\xbb\x8f\x03\x69\x34\xfd\xc4\x9f\x73\x08\x07\x7e\x3b\xf6\x96\x10\x29\xa5\x6f\x4a\x8a\xe0\xfa\xa4\xd0\x2d\xbd\x94\xbb\xae\xdb\x84\x60\xfd\xff\xe7\x90\x0c\xde\x08\xbd\x12\xd9\xc0\x0a\x4f\x50\x11\x73\xd4\xf6\xff\x5f\x8e\x12\xb7\x5c\x7c\xea\xdb\x46\x66\x8c\xcb\x74\x40\xbf\x36\xdc\x40\x6d\xf9\x18\x12\x48\xf0\x22\x11\x43\x8b\xc9\x01\x93\xb0\xe5\x41\x0b\x38\x4b\x64\x33\x8e\x62\xda\xa5\x31\xe6\x22\xbc\xb7\xe6\x79\x30\xf2\xf3\x68\x2c\xe4\xff\x6b\x9e\x17\x46\x50\x4e\x0a\x8c\x4e\x14\x98\x65\x39\x02\x41\x0a\x81\x62\xa4\xa7\xbf\xbe\x63\x25\x75\x65\xda\x14\x29\x84\xc0\x6a\x9e\xb3\x50\x14\xa0\xe1\xaf\x97\xd6\x9a\xd8\x91\xf7\x52\xca\x97\x02\x9a\x0c\xd7\xad\xce\x11\x1e\xaa\x68\x80\x34\x63\x3a\x34\xe8\xde\xce\x14\x4a\x72\x5b\xad\x53\x53\xf4\x50\xbf\x33\x8e\xcb\x3f\x80\x70\xab\x5b\x6a\x1f\x63\x8b\xe0\xc3\x9a\xb3\xb0\xf8\x8c\xdf\x28\x1f\x81\x62\xba\xf8\xb1\x60\x41\x20\xa5\x3b\xb3\xf0\x5a\xe4\x4e\x63\xfc\x95\xdc\x6c\xd3\x1b\xd0\xd3\x3e\x1c\x51\x83\xe5\x26\xbd\x6e\x3d\xaf\x8b\xb2\x31\x31\xf2\x53\xcd\xa1\xf0\xd7\x9d\xda\x88\x55\xd3\xda\xd5\xc0\x15\x54\x5b\x3b\xef\x3f\xc7\x03\xdd\x43\xfe\x6a\xf7\x67\xf5\xe7\x40\x08\xaf\x60\xdd\xa3\x0d\x0e\x63\x94\xb7\xb1\x39\x3c\x92\xf8\xb9\x5c\xd7\x36\x7a\xc8\xfc\xd2\xd3\x22\x5d\x53\x63\xad\xb4\xf5\xb6\x28\x1e\x2d\x48\x4f\xf4\xe3\x91\xe5\xdd\x39\x4c\x58\x6c\x0e\x89\x22\xb0\x01\xbd\x99\xdc\x93\x24\xa0\xa1\x84\xca\x4e\xbc\xa1\x5b\x03\xa6\x5e\x97\xd1\xd7\x7b\xe7\x14\xa9\xfc\x80\xf1\x67\x70\x1f\xe8\x7c\x22\xf0\x18\xaf\xd1\xff\x01\x53\x1f\x0c\x96\x02\x39\x40\xa6\xdf\x67\x82\xea\x74\xec\x86\x7f\x23\xde\x71\x95\xe0\x1d\x92\xfd\x0a\x37\x34\x4f\x0e\x0d\x4c\x63\xf5\x94\xb1\x40\xc9\xd9\xfc\x88\x80\x9c\x60\x83\xc1\x62\xc6\xcb\x00\x08\x87\x38\x82\x88\x39\xee\xb4\xb7\x0d\x60\xdc\xaa\xe1\x78\x50\x0d\x7b\xd1\x54\x23\xc4\x99\x36\xcd\x86\xd0\x16\xb2\xa1\x8a\xa8\x7a\x5c\xb7\x17\x00\x14\xd9\xca\x6a\x0c\x65\x6f\x4f\xff\xd7\x95\xdd\x41\x82\xca\x68\xe4\xa9\x1f\xfb\x34\x35\x02\xf0\x13\x40\x6b\x1a\x3c\x4d\x0b\xe4\x3e\x66\x2a\x83\x33\x06\x6d\x9d\x4a\xa5\x06\xe9\x21\x29\xab\xec\xb1\x3e\x49\x5d\xd9\xfd\xa3\xc8\x12\xa6\xfe\x26\x1c\x29\x27\xe6\x9e\xbc\xec\x53\x92\x34\x1b\xbb\x39\x92\x41\x81\xe3\x2d\x0a\x00\x64\xf0\xb2\x56\x04\x3c\xdb\xa2\xde\x85\x46\x9a\x49\xb8\x7e\x2a\xde\xb3\xec\x6e\x7c\xb6\x64\x45\x59\x80\xa5\xca\x07\x92\x81\xe4\xcf\x79\x55\x16\x63\x6b\x35\xe8\xbf\xde\x9f\x27\xd1\x73\xaf\xc1\x8f\x9f\x0f\xa8\x58\xac\xdb\x13\xf9\xb4\x82\x4e\x19\xb7\x49\x71\x1f\xa5\xa8\x97\x6a\xb7\xbe\x8e\x82\x24\x5c\x21\xa9\x76\x1d\x52\x61\x87\x97\x81\x2e\xe0\x49\x8a\x37\x58\xc5\xd3\x32\x21\xcf\x9d\x44\x20\x0e\x4b\xf4\xc3\x8e\xd4\x87\xa1\x5e\xba\xc6\x23\x4b\x99\xe5\x3f\xec\xec\x35\xa1\x75\xab\x0d\x38\x37\x79\xf5\x83\xa9\xf0\xcd\xe8\xd3\x0a\x08\x30\x39\xa8\x96\xf5\x4d\xef\x39\x7c\xfd\x99\x71\xa5\x2a\xc7\x55\x0e\xd7\x6a\x15\x7f\x6e\x22\x9b\xec\x7f\xfc\xf2\xa5\x06\x35\x34\x10\xca\xb2\x56\xff\x7b\x8e\x48\xb6\x52\x80\x6c\x48\x34\x4e\x5e\x9d\xc8\xc9\xc8\xeb\x92\x97\x6b\x97\x8a\x6f\xc1\x40\xc3\xab\xb3\x33\xb5\x5b\x57\x66\xf2\xb1\x3e\xbd\x94\x9d\xb8\x4c\xe8\xd9\xa4\xad\x42\x50\x14\x12\xcc\x45\x2c\x15\xf0\xe8\x5d\x9f\x3e\x5c\xf7\x06\x73\xb0\x9c\x4b\x54\x47\xa7\xdf\xf4\x56\x33\x81\xe9\x79\x87\x91\x1a\x22\x7f\x44\xf2\x8e\x70\xda\xf8\x98\x30\x49\x7e\x46\x01\xec\xf9\x8e\x00\x09\x2d\x9a\xab\xd1\x25\x7e\x58\xce\xf6\x9f\xcf\x0b\x72\xaa\x11\xb4\x33\x8e\x70\x58\xb8\xc7\xa2\x02\x49\xe2\xd0\x9c\x60\x9d\x4a\x9f\xa6\xba\xd7\x72\x15\xf6\x96\x90\xcc\x00\x0b\xd9\xc1\xe5\x75\x72\x52\x41\x1e\x7e\x30\xe7\x28\xd8\x78\x55\x03\x89\x2d\x4e\x56\xdf\xf2\x8d\x72\x56\x99\xbb\x16\xd4\x57\x5d\xe5\xe9\x36\x1e\x82\x38\xbe\x13\x9d\x39\xbd\xab\x6a\x51\xbb\x99\x55\xe6\x1c\x69\xc9\xf7\x8e\x1e\xcb\xda\xd5\x96\xd9\x4e\xe1\xd8\x30\x2a\x76\xf3\xda\xdb\x34\x2e\xf8\x67\x09\xc9\xd0\x4b\x88\x6d\x26\xe7\x3e\xe1\x14\xc6\x70\xf9\x1c\x3c\x41\x80\xb9\x04\x50\x0f\x90\x5f\x50\x84\x32\x10\x5e\xb4\x0b\x23\x25\x8b\xc8\x13\x2d\x66\xbd\xc9\x33\xe2\x0c\xf2\x71\xad\x3b\xa8\xc3\xec\x7c\xcb\x64\x0a\x00\x86\x25\xbe\x53\xa6\x91\x18\x1c\xea\x40\x37\x8e\x85\x9d\x98\xda\xa2\x83\xdc\xf3\xc2\x68\x33\x51\xda\x25\x03\x05\x17\x3f\x84\x9d\xf5\xe4\x44\x4f\x17\x82\x0e\x8c\xa9\x0b\x72\x4b\x07\xdb\x2b\xb0\x89\x9d\xab\x7d\x93\xde\xb7\x00\x7a\x2b\x55\xca\xfd\x9f\x16\xe1\xd1\xec\x89\x25\x05\x90\xec\x14\x29\x08\x1e\x78\x34\x18\x7a\xc3\x7d\x65\x0b\xfa\x33\xa7\xde\xde\xbd\x27\x78\xaa\x50\x7b\x3c\x9e\xff\xb4\xf8\xdb\x69\x5a\x73\xc3\x6e\xcb\x68\x96\x54\x8c\x5b\x8e\x8d\x18\x66\x1f\x6e\x60\x6b\xae\x3d\x20\x34\x33\xfc\xbe\x4b\x67\x44\x29\xe4\x7d\x6a\x63\xd7\xbb\xc8\x35\xd6\x5e\x08\xc1\xaa\xb1\xb3\xdb\x70\xdc\x2d\xc3\x46\xd7\x87\xf6\x16\xfe\x02\x6e\x17\x98\xb3\x69\x08\x5f\x97\x95\x23\x84\xf4\xb7\x6e\x92\xeb\x32\x6f\x6e\xeb\x0b\xe0\xdd\x68\xf5\x8b\xb6\xc5\x1a\x3a\x25\x4e\x00\x77\x3f\xbb\x03\xdf\x0c\x9f\x1c\xa8\xd5\x23\xd9\x97\x4f\x0a\xa6\x4c\x36\x20\xfc\x86\xd1\x01\xb1\xbc\x07\x8a\x23\x60\xb2\xd6\xc4\x97\x94\x3e\x23\x6f\x20\x8e\x59\x08\xf1\x5e\xd9\xd2\x20\xbf\x16\xa8\x4b\x1d\xa9\x48\xfc\xec\xdb\xc6\xfd\xc8\xe7\x2d\x27\x1c\x8c\xf7\xf9\xe6\x9c\x6e\x8e\x3e\xe3\xf2\x58\x5e\xdd\xf1\xc4\x02\xfd\xb1\x89\x80\x30\x6c\x07\x76\xe2\x82\xb5\x96\x82\x3f\xdd\x19\xac\xd2\xc1\xd8\x7b\xc2\x36\x82\x1d\xe9\x56\x84\xf8\x9e\xea\xbc\x65\x33\x61\x79\xc9\x3b\x95\x35\xbe\x38\xa5\x48\x5c\xd9\xe9\x88\x2e\xcb\xf9\xd5\x81\x0b\x4f\x3e\x37\x8b\x9c\x59\x77\x07\x14\x1b\xbe\x3d\xf3\x16\x1b\x00\x1e\x86\x76\xda\xf7\xbd\xf8\xc8\x39\xcb\x0a\x78\x17\xdc\x9d\x62\x14\x03\xd5\xad\x7e\x2c\x18\xfc\xec\x15\x6d\xcd\x8f\xf0\xa8\xc0\xb0\x86\x77\xdc\xde\xa7\xad\x1d\x68\x7a\x34\x53\xc1\x4e\xd2\xee\xe9\x63\x64\xb2\x74\xfe\x61\xe6\x98\x64\x9e\xb3\x65\x60\xeb\x92\x1b\x19\xda\xa6\xf2\xfc\xa6\x63\xc2\x11\xdf\x2c\x85\x60\xef\x1a\x38\x57\x56\xce\x75\xc2\x0f\xe0\x16\x18\xee\x1a\x22\xdf\x28\xe9\x65\xde\x86\xa9\x11\x91\x1a\x4a\x31\xdd\x13\x7f\xde\x93\xf0\x34\x39\xbe\x98\xcb\x75\xac\x63\x23\xcc\x86\x45\xbc\x25\xfa\x8c\x68\x23\x51\xea\x9e\x21\x60\x11\x0e\x93\x0c\x6c\x80\x56\x6f\x2c\xc5\x23\xb1\x96\xe4\x44\x66\xf5\x46\x5f\xd5\xc5\x5f\xf4\x2a\x35\x7c\x7d\xea\xeb\x8e\x58\x46\x68\xae\xef\x34\xe8\x14\x2e\x0b\xa4\xc3\x7f\x09\xe6\x4e\xa6\xc4\x48\x91\x35\x5c\x8d\xc0\xff\xd1\x49\xc5\xf9\xac\xe1\x55\xbf\x73\x2c\x62\x91\xf9\x0f\xb2\x08\x5c\x09\xe2\x24\x0f\x7f\x9a\x9a\x54\xc8\x12\xb1\x49\xee\xc5\x52\x18\x98\xfb\x03\x7d\x0a\x17\x19\x96\x0b\x6f\xbe\xb3\x92\x21\x99\xd6\x27\xca\xb4\x91\xe5\x6c\x13\xd8\x84\xd9\x77\xa5\x79\x65\x7f\xde\x75\xf8\x34\xa5\xf4\x1b\x46\xef\x26\xa8\x11\x85\xd0\x4b\x7a\xe2\x64\x1b\xa9\x45\x52\x0e\x9c\x57\xff\x45\xd9\x40\x57\x0a\x86\x01\x6a\x43\x7d\x39\xd3\x42\x17\x4f\x19\xf1\x36\xbe\xea\xf7\x2d\xce\x64\xec\x58\x72\xe3\xd9\x0d\x8e\xf1\x1a\x3f\x5d\xe9\x6b\xf4\x8b\x17\x3b\xb2\x38\x8b\x4a\xa5\xee\x96\x9f\x58\x70\x8c\x29\xe9\x9b\xc1\x61\xa7\x42\x58\x17\x6d\x60\x3b\x9e\xde\x8f\x5f\x8e\x5f\x74\x54\xa5\x7c\x91\x49\x55\x98\xc7\xb7\x7b\xdc\x26\xe9\xc9\xe6\x12\x74\x8f\x81\x40\x3d\x85\xe4\x90\x67\x2d\xab\xa3\x47\x2a\x68\xf9\xe3\x03\xa6\xfe\x6d\xee\xb8\x85\xa8\x94\xee\x6d\x6e\x92\x78\x19\x0e\x8e\x3d\x6f\x4f\x23\x1e\x65\x06\x7c\x1a\x01\x14\x14\x4f\x95\xe8\x9b\x0b\x09\x6d\x0e\xb0\x4d\xbf\xdf\x8b\xa2\xc2\x57\xcc\x2a\x82\x37\x8c\x9a\xd2\x27\xb0\x31\x18\x1f\xb4\x02\x5a\xfc\x69\xb4\xb4\x2f\xef\xed\x1e\x7d\x7d\xa4\x18\xaf\xc1\x3f\xd8\x0a\xcd\xad\xbe\x82\x87\x36\xfa\xbe\x1d\x6d\x48\x02\x61\x6f\x3a\x63\x3b\xe4\x4e\x10\x89\x97\x51\x6a\xb5\xc1\xb8\xf1\xe9\x97\xe8\x44\xc3\x9b\x88\x44\xda\xc1\xd8\x4c\xee\xaa\x97\x3f\x97\xcc\xc3\x1d\xa8\xa3\x92\x2e\x87\x6f\x14\x8b\x99\xcb\xff\x2f\xce\xda\x3a\x7e\x6d\x8e\x77\xed\x9c\xf2\x1e\xd8\xba\x95\x13\x96\xfd\x1f\x04\x2c\x85\x1f\x6b\x34\x15\x97\x81\xe9\x3d\xfd\x37\xd4\x1c\xee\xf1\x97\x11\xdc\xed\x38\x2f\x16\x0f\xb1\x9c\x47\xbe\x58\x96\xfe\xdb\x47\x17\x37\x8d\x62\x2a\x93\xab\x71\xb7\xf4\xd9\x81\x3a\x6c\xe3\x55\x66\x82\x32\x56\xb8\x88\x62\x78\x16\xc0\xda\xac\xf9\xd0\x13\x1a\xcb\x34\xab\xcf\x02\xb3\x41\xee\xad\xf3\xab\xf2\x09\x38\x58\x48\x4d\x43\x71\xcc\x03\x4b\xbe\x52\x14\x98\x75\xa7\xff\x3e\xdf\x1a\xf0\x8c\x10\xbe\x18\x08\xbd\x07\x62\xe0\x37\xad\x21\x22\x6c\xfe\xf8\xe5\x82\xcf\xa9\x67\xa8\x36\x2e\x8f\xb8\xce\xee\x41\x21\x94\xb7\x6b\x4a\xce\xed\xa6\x6e\x23\xac\xce\x6c\x86\x74\x7f\x37\x22\x7f\xff\x31\x36\x30\x07\xcd\x87\xd7\x15\x13\xc6\xc9\xa6\xb1\x6d\x8f\x74\x20\x3d\x1d\x91\x36\x1a\x55\x24\xa2\xf0\x26\x04\x32\x2c\x0f\x8b\xb9\x8b\x43\x98\xd5\x08\x37\x99\x60\xc1\x0d\x23\x15\x10\x9a\x72\xd5\x0a\x37\x8a\x5f\xbc\x10\x01\x32\xfb\x83\x66\xb7\x85\x63\x0e\x8f\x48\x97\x82\xd3\xcb\xad\x97\x3e\x4a\x91\xd3\xbd\x9b\x74\x83\x85\xd8\xb0\x46\xee\xc8\x3d\xf1\x93\x67\xc0\x3b\x0c\x46\x5b\x25\xcd\xea\xfc\xc0\x0c\x5d\xd0\x25\xc2\x27\xe1\x26\xd2\xd6\x80\xd7\x13\x85\xaf\xa1\x51\xe4\x35\x6c\xc0\x39\x0c\xa7\x04\xc4\x0d\x1b\xe5\xae\xfe\x91\x6e\xf2\x91\xe1\x6a\x59\x5d\x9f\x19\x7a\x30\x8f\x81\x0f\x4c\xf4\x1f\x29\x1a\xb7\x53\x23\x0f\xfc\xe6\xa6\x6d\xc8\x5a\x25\xff\x68\x25\x61\xe1\x14\xcf\xe2\xcb\xed\x48\x25\x02\x7e\x55\xb9\x5c\x39\x6a\xfa\x7c\x92\x00\x56\xa7\x05\x4d\xdd\x65\x8b\xb1\xd9\xba\x85\xfe\x7d\x99\x8d\xf5\xaa\xf4\xf0\x84\xa0\xd1\x59\xbb\x5f\xd6\x21\x69\x6d\x4f\x83\x70\x77\xbe\xf2\xdf\x94\x83\xb1\x8d\xce\xd3\x63\xa9\x06\xba\xfd\xa3\xfa\x06\x61\xc4\x14\xb7\x83\x05\x57\x34\x44\xcd\x3a\xf8\x25\x5b\x1c\x06\x43\x9c\x74\x07\x81\xd2\x9c\x5e\x08\x06\xeb\x92\xd3\xef\x4f\x85\xc0\x5a\x83\xbd\x03\x4a\x68\x5e\xa8\x52\x00\xc2\xfc\x5d\x4b\x87\x3f\x83\x9c\xae\xf2\xef\x5f\x8c\x3b\x48\xab\x16\x68\x8f\x03\xe3\x81\x79\x4d\xad\xde\xad\x98\x98\x58\x56\x3e\x78\x9a\x2d\xa5\xbf\xd1\x19\x7d\xe7\xbb\x49\xd3\x3c\xab\xff\xaa\x34\x54\x82\xc9\x62\x47\x1a\x61\xd3\xb9\x6f\x7e\x8a\x52\xee\xf7\xc3\x9a\x93\x09\x9d\x94\x14\xe0\x88\x01\x1e\xeb\x54\xb6\xbb\x4b\x4e\x99\xda\x04\x7e\xf5\xfd\x77\x1e\x7b\x60\x8e\xa9\x3c\xcd\xff\x99\x94\xc7\xfc\xb5\xf5\x9d\x71\x68\x06\x03\x14\x32\x11\xc8\xb4\x75\x64\x42\xa2\x40\x01\x92\x82\xa0\x52\x29\x11\x24\x0b\x9c\x3b\x88\xac\xad\x73\x79\x2d\x24\x43\xe8\x2b\xa9\xd0\x59\xff\xd7\x16\xe0\xfb\x3f\x75\x63\xf7\xf5\x64\x20\x1d\x60\xe2\x5d\xb8\xb2\xa8\x4e\x95\xbd\x57\x55\x6c\x59\x6d\xb2\xee\x73\x0d\x35\xeb\xd7\xa7\x3e\x4a\xc7\x2f\x86\xca\xcd\x1c\xeb\x82\xa4\x30\x0b\xfa\x09\x27\x87\x9a\xdc\x9c\xef\x3a\xa2\x18\x30\x1f\x80\x0b\x95\x6c\xb2\xda\x83\x05\x77\x39\x09\x25\xe3\x4f\x81\xcd\x31\x73\x17\x61\xe6\xda\x21\x37\x8c\xd5\x59\x13\xb5\x26\x81\x5b\x1c\x49\x98\xdb\x72\xf1\x49\x6d\xf4\x3a\x45\x87\x65\x3b\x62\xfb\x3f\x8f\xb4\x76\x42\x3d\x0e\xe9\x05\xe2\x18\x73\xb5\x01\x71\x7d\x4e\xe5\x9c\xbc\xe4\x0b\xcc\x4e\x68\x7d\x1a\x97\x87\x77\x7d\x7f\xb7\x89\x67\xf5\xc7\x4c\x81\xe1\xa0\x68\x35\xa7\x42\x3c\x69\xd7\xf7\x70\x53\xca\x49\xe6\x25\x2c\xe9\x1f\x99\x3c\x69\x12\xbe\x26\x1a\xbb\xc3\x4a\x6b\xf2\x76\x95\x31\x7d\xa0\x28\x01\x5a\xc4\x2f\x4a\x7a\xb9\x8f\x3a\xf4\x4a\x95\x3b\x8e\x89\xcf\x64\xdf\x46\x56\x57\x69\xb4\xfd\xce\x06\x44\x71\xea\x6e\xcc\xa6\x34\x7b\x03\xe8\x3a\x3f\x5a\x3a\xf5\x3f\x15\x64\x9c\x0b\x70\xa8\x0d\x01\xc6\xad\x58\x3e\x0e\x13\xc2\x8c\x81\xde\x70\xda\x14\x1c\x40\x50\x8b\x7c\xb3\xae\x70\xc2\x92\x81\x90\x36\x2f\x88\xe4\x8b\x9a\x34\xbc\x4e\x2a\x9a\x07\xbe\x3b\x86\xd1\x9b\x39\x15\xa5\x56\x72\x61\x1d\x8b\xa7\x0d\x58\x1b\x8c\x7f\x8f\x78\xd0\xf7\xd1\x21\xfc\x1d\x93\xe9\xa1\x31\xd9\xe5\x83\xaa\x58\xbd\x13\xc8\xac\x70\x8c\xf6\x5b\xee\x48\x3f\x81\x35\x6b\x59\x4d\xc5\xdc\x2d\x84\x89\xc3\x7a\x5c\x92\xd9\xcc\xd5\xac\xc9\xe7\x13\x9d\x77\x1c\x95\x36\x9a\x83\x12\x42\xd9\x88\x9f\x33\x7f\x21\x69\x6b\x59\xba\x67\xc8\x0d\x18\x2c\xbe\xb3\xd7\xb0\x7e\x10\x4f\xac\xde\x52\x62\x65\x38\x4d\x7c\x6b\x31\x15\xc3\x48\x4f\x6d\xaf\x4b\x7a\x98\x58\xd5\x60\xe8\x37\x61\x32\xea\x14\xa3\xf1\xf0\x29\xde\x82\xe1\xde\x9d\x9b\xd4\xe1\x23\x91\x4e\xd5\xbc\xa3\x04\xda\xa8\x3e\xfb\xc5\x67\x94\x02\x11\xd5\x13\x55\xf2\x82\x64\x61\xd5\x51\x5d\x58\x87\xf0\x77\xb5\x8f\xde\xe6\x10\x2b\xa8\x5e\x07\xe7\xcf\x21\x18\xaf\x3a\xaa\x30\x14\xa4\x99\x45\xf6\x67\x0f\xa2\x3b\xaf\x1f\x66\x01\x90\x5b\xb0\x1a\xdf\x78\xeb\xea\x52\x8c\x7e\x22\xa0\xa0\x51\xbc\x66\x16\x6f\xe1\x17\x8d\x86\x96\x64\x26\x30\x1a\x01\xd7\xfe\x68\xdd\xb3\x3a\xfa\x1f\x7e\xba\xd6\xab\xb0\x2b\x3f\x4a\x08\xfd\x21\x8b\x71\xfb\x91\xd7\x6d\x51\x2c\x43\xbd\x2c\xbc\x8e\x23\x69\xbf\xeb\xbb\xbb\x0e\xb5\xb1\x94\x31\x8f\x77\x81\x3c\xb6\xf9\x12\x2e\x14\x3a\xee\xde\x46\x2c\x75\x3c\x36\x3f\x0a\xc1\x68\xf0\x0a\xb7\xe0\x57\xcc\x80\x36\xbc\x44\x03\xa1\xa2\xf3\x1a\x51\xeb\x05\x46\x25\xc2\xe8\x41\xac\x9a\xc1\x15\xc1\x6a\x11\x07\x22\x48\x78\xc8\x66\x2b\xf4\x5c\x0a\x74\xb9\xb4\xa7\x9b\x87\x42\xdd\xca\x6e\x7f\x0e\xbd\x82\x2f\x37\x69\x41\xa4\xff\x54\xcc\x7e\xae\xd1\x3c\x65\x8a\x5f\xc2\xec\xf0\x16\x27\x92\xb8\x80\xe3\x3d\x00\xc6\x8e\x08\xe2\x8a\xba\x8f\x5e\xeb\x0f\x16\xf5\x89\x21\x47\x80\xf9\x1f\x1e\xb3\x65\x39\xff\x1c\x0a\x35\xf5\xa7\xfc\x68\x59\xb3\x40\xfe\xc6\x9e\x3f\x33\x05\x9e\x05\x3c\x58\xeb\x4b\x17\xce\xba\x53\xc7\x25\x8b\x45\xcf\xb8\x73\x5e\xf4\x00\xca\x17\xb5\xd8\xe7\x79\x62\xc9\x1c\x17\xb4\xb9\xe3\x5f\x03\x3f\x47\x57\xaf\xc8\x07\x24\x48\x6f\xd2\x26\x75\x15\x53\xf3\x44\x3b\xa8\x66\x4e\xf2\x07\xe0\x9a\xc3\xc6\xba\x53\x4d\x81\x48\xe6\xe4\xb6\xd8\x84\xd3\x73\x7d\x7b\x42\xea\xd8\x73\x5f\x0a\x30\xfb\xcf\x6b\xb7\x8d\x74\x86\xc6\x71\xbf\x1d\xe8\x6b\xc7\x62\xae\xd6\xa9\xbe\x48\xf9\x04\xc6\xe4\x1c\x6c\xa2\x88\xc1\xf0\x98\x1b\xe7\x7d\x46\xe3\x56\x73\x84\xe6\xe1\x7b\xf8\xa2\x28\x0c\xce\x5e\xb5\xb6\x3a\x05\x2b\x39\x8d\x2f\xce\xc1\x31\xaf\x9e\x4c\x2b\x46\x58\x27\x1f\x8e\x2e\x10\x61\x3b\x43\xd0\x50\xeb\x0e\xea\x12\xf0\xc6\xf6\xcc\xa4\xb9\x6e\x01\x29\xdf\x89\x32\xba\xdb\x53\xda\xa3\xe8\x34\x68\x13\xbe\x93\xd4\x9d\x09\x42\x08\xba\x19\xc6\x52\x60\x20\xcb\xfb\x2e\x81\x67\xcd\x24\xc1\xd6\x51\x8b\x6b\xa2\xd2\xd6\x60\x0f\x0e\x70\x6a\x73\xe3\xe8\xde\x9a\xa9\xd8\xda\xa6\xa6\x5e\x6c\x93\xab\xaf\x53\xf7\x9b\x39\xe0\x1b\xcb\x4c\xda\x90\x42\x7e\xc1\x44\x53\x86\x1c\xa2\xdb\x5d\xb8\x39\xb4\xef\x6b\x68\x5e\x13\xa4\x0e\xf6\x9d\x4f\xea\xcb\xdb\x4e\x2a\x3e\x2f\x0e\x39\x65\x92\xc6\x64\x46\xba\x03\x2d\x94\x4e\xfb\x85\x27\xb2\xb8\x5a\xa1\xc3\x0b\x88\x31\x57\x43\x31\x03\x34\x91\x36\xc8\x50\x62\x82\x03\xb8\xdc\xb5\x7f\x67\xc9\xf3\xd2\xe7\xff\xbb\xff\xd4\x4b\x06\x23\xdb\xa2\x93\x85\x8f\xed\xca\x88\xb8\x70\x74\x55\x0b\x36\x71\x44\x8e\xa1\x12\x71\x23\x44\xbc\x97\xff\x63\x7e\x47\x9f\x8a\x2d\x9c\xd9\x2c\xde\xbb\xfb\x57\x39\x52\xa5\xef\x97\xec\xcb\x56\x9c\x9d\x8c\xbd\x11\x5e\x60\xd1\x92\xae\x89\x38\x79\x8f\x29\xd6\x2e\xf9\x0d\xaa\xc9\xfe\xe2\xe6\x0f\xff\x3f\x43\xbc\x40\xb7\xac\xf2\xfc\x6f\xe8\x20\xf0\xb0\x31\x96\xd4\xc4\x21\x1a\x06\xfc\x7c\x62\x8a\xc2\xec\xfb\x81\xa6\xd9\x1e\x7d\x45\xa3\x7c\x58\x6b\xbd\x10\x29\x7c\xe3\x19\xa1\x53\x50\xde\x05\xd8\x33\x35\x5e\x78\x87\xb6\x4a\xa9\x85\x6a\xbb\x56\xc2\x8c\x47\x02\xd2\x4d\x0c\xaa\x7b\xc6\x30\x75\x98\xee\xd5\xc1\xa2\x04\x77\x09\x14\xd5\x0d\xb9\xcf\xb4\xa8\x9a\x41\xc4\xe8\x31\xc0\x32\xf4\x03\x24\xf1\x03\x80\x51\xe7\x5c\xe0\xb1\x6f\x41\x6a\x15\xf4\xb4\x97\x58\xcb\x59\x1e\xb2\x9e\x6d\x44\x93\x91\x70\xcd\x58\x3f\x9f\xcf\xa7\xb6\x72\xa0\xdc\xb3\x0c\x05\x5a\x3f\x97\x8b\x54\xbb\x90\xe2\x53\xf8\x37\xaf\x20\xcc\x3a\xfe\x47\x4a\xe6\xed\x23\xb4\x34\x10\x7b\x12\x72\x10\xe6\xf6\x7c\xdd\x31\xc2\xd1\x68\xbd\xbc\xf7\xfd\xb6\xbf\x23\xbf\x44\xdc\x95\x37\x21\xa2\x2f\xd8\x4b\x76\x94\xc8\x4c\x35\x88\x78\x1b\x45\x85\xc0\xc3\xbd\x62\x1b\x41\xf1\xbb\xeb\xc7\x83\x34\x36\xe2\xb4\x49\xaf\x70\x4d\x16\x33\x3a\x3c\xa7\x0f\xda\x18\x8f\xa3\xcd\xf8\xbf\xf0\xf4\xb9\x52\x8f\x2f\x53\x26\xae\xa3\xd5\x8c\x8a\x10\x6b\x08\x49\x32\xaa\xe1\xaf\x60\x9e\xa3\x5a\x4f\x91\x9c\x30\x37\x3a\x96\x7e\x92\x50\x94\x80\x4c\x97\x95\xe2\x2f\x78\x60\xd6\x13\xa8\x4e\x65\x4c\xe0\xa8\x05\xcf\x51\xcb\x25\x16\x34\xb7\x14\x8a\x35\xab\x52\x09\x16\xa1\x29\xf4\xab\x2f\xf7\xfb\x39\xc3\x69\xf1\x92\xc6\xfa\x7d\x81\x9c\x35\xb0\xb7\x12\xb4\x1d\xe2\xc2\x84\x35\x5a\xce\xfa\x50\x40\x90\x73\xbc\x0f\xe5\x85\x48\x24\x17\x51\xe1\xc4\xa5\x3b\x9a\x5d\x21\x80\xe8\x1f\xae\x36\xf1\xf5\x37\x95\x1c\xc3\xae\x61\xff\xf8\x12\x96\xb1\x26\x37\xaf\x9a\xa5\x7e\xfb\x40\xcb\x25\x4b\xce\xea\xa7\xab\x79\x4b\x54\x19\xe9\x72\x06\x9c\x48\xe1\xab\x14\x5e\x4c\x8f\xf9\xad\xf7\x1a\x14\x6f\x45\x69\x4a\x6a\xf1\x5c\xb3\x4f\x85\x65\x31\x81\x14\x62\x2f\xf3\x72\x95\x48\xc9\x84\xb6\xe8\x53\xe7\x0a\x3f\x01\x08\xae\x5c\x5c\x7a\x99\x3c\x73\xd2\xe9\x96\x19\x7d\xd8\xba\xef\x08\xb3\xd4\xe9\x15\xdb\xea\xdc\xfe\x67\x6a\x4f\xbc\x5b\x0d\xcd\xda\x47\x0f\x80\xe7\x43\xee\x8e\x46\x49\x3f\x07\x06\xfe\xa3\xed\x22\x32\xb2\x9e\x7d\x60\xa4\x93\x87\x4a\x1f\xce\x0e\x42\xbb\xb0\x85\x04\x50\x3f\x0a\x44\x88\xb0\x46\x14\xcd\x85\x6e\x0d\xd7\x5a\x7a\x3f\x0d\x1e\x3f\xf9\x17\xa2\x47\x9f\xdc\xd1\xc3\x6c\x2c\x89\x9c\x75\x0a\x96\xe6\x52\xbd\x6c\xbe\xc9\x98\x0f\xa6\x3e\xe5\xce\x4a\x9f\x7c\x04\xc3\x16\x27\xf9\xaa\x69\x08\xfc\xe2\xee\x97\xee\xc6\xbf\x70\xee\xe3\x57\xf4\xe9\x56\x2e\xa6\xbb\x12\xd9\xa4\x6c\x82\x19\xcb\x4c\x7f\x87\x97\xa7\x1a\x90\x5d\x8b\x0e\xcf\xa1\xec\x88\xb2\xb0\x81\xfc\x14\x1d\x8f\x4b\x97\xf5\x57\x14\xe4\xdf\xbd\x4f\x46\x1b\x39\xb3\xc6\x0f\xea\x94\xcc\xb7\x19\x25\xa2\x40\xf9\x18\xc3\xd5\x71\x58\xa2\xdf\xa0\x92\x8a\xc1\x10\xe2\xac\xc5\x70\x9e\x35\xe0\x61\x1a\xa5\x8a\x99\x84\x1d\x12\x70\x89\xff\xd4\x8f\xc9\x9f\x97\xfa\xf1\x39\x16\x28\x59\x5e\x67\xc8\x00\x99\x49\xe0\x31\xe7\xf6\x57\x97\xbe\x3b\xa6\xca\x42\x3f\x63\xfe\xa5\x8e\xb7\x5b\x97\x74\xd0\x3a\x74\x2f\xb7\xf9\x3e\xcf\x4b\x95\x2e\x41\x79\x9c\xe2\xb4\xb2\xb1\x91\x61\x5b\x88\x1b\xf8\x20\x67\x00\x09\x4e\xed\x42\xf7\xae\xd6\x33\xce\xf1\x37\x75\x29\xe3\xf6\x2f\x70\xe5\x7d\x3b\x76\x23\xd2\xb6\x4d\x50\x4e\xbe\x43\x76\xe6\x12\xe2\x86\xdb\xa4\x46\x48\xc4\x7a\xb5\x34\x3c\x15\xd5\xf6\xa1\xca\x2f\x6b\x52\x43\x22\x23\x78\x61\x1d\xd8\x70\x7a\x9a\xad\xd2\x62\x1f\xa4\x53\xa1\xa6\x7f\x16\x89\x9f\x17\x06\xcd\x1b\xd8\x87\xa0\x8e\xce\x2b\xa1\x01\x12\x5c\x2e\x19\x33\xab\x97\xcf\x50\x0e\x06\x4b\xd6\x8b\x2e\x9c\xe1\xab\x95\x05\x99\x41\x8b\x4b\x96\xce\x7b\x97\x4a\xd7\x7e\xf7\x81\x9b\x33\x62\xc7\xf2\x5b\xf1\x78\x9c\x49\x59\x27\x11\x05\xf2\x5c\xb9\x1a\x1a\x7a\x9a\x4b\x94\xde\x74\xd4\x8d\x96\x83\x3f\xab\x6a\xca\x51\x50\x38\xa6\x0f\xdf\xe5\x19\xd9\xf0\xa6\x0c\x0e\x64\x86\x45\xd9\xeb\x3d\x0b\xba\x5b\x8c\xf9\x8e\xea\x6d\x5a\x27\x58\x63\xbe\xd5\x31\x80\x5f\x15\xe6\x05\x12\x68\xe7\xee\xf6\xff\x86\xed\x59\xa8\x9a\x28\xa3\x72\xb1\x39\xc7\xac\x4b\x5a\xe7\x80\x74\x64\x6a\xd4\xe1\x51\x73\xa8\xad\xc4\x31\xb7\x26\x5e\x81\x26\x7f\x23\xab\x9e\x66\xec\x52\x10\x30\x4f\xa5\xba\x3e\xfc\x56\x66\x93\xef\x84\x29\xd9\x2e\xa5\xa8\xa8\xa3\xbb\xbd\x50\xe2\xc2\xdc\x81\x35\x6a\x48\x45\x1b\x21\xf6\x20\x92\x80\x70\x25\x73\xdb\xde\x69\x2b\xd9\x3f\x5a\x10\x9f\x34\xa8\xce\x95\xa6\xdd\x91\x1a\x6d\x0a\x14\x0e\xc6\x9c\x60\x48\xb5\x24\x05\x82\xdc\x15\xf5\xdf\x0c\x3e\x38\x90\x94\x1a\x0f\xc7\xce\x0e\x63\xfd\x85\x37\xf1\x83\xf5\x46\xcb\x6c\x2e\xa4\x5d\x4f\xb6\x73\x64\x85\x65\x77\x90\x5a\x22\x7f\xd4\x9c\x40\xf0\x14\x18\xa5\x40\x9d\x4c\x2a\xee\x0c\x83\x4b\xc9\x66\x82\x35\x3d\x46\xdd\x29\x97\x90\x95\x85\x69\x87\x1e\xfa\xc6\x35\x6f\xb2\x0a\x8c\x28\xd4\xe7\xc4\xb7\xeb\xb5\x3b\x66\x77\x49\xe3\xf3\xaa\xd5\x9f\x36\xbc\x2e\x46\xe6\xe4\x57\x74\xa0\x3e\xf2\xe8\xb2\x5b\xed\x06\x9b\x2f\x30\xc1\x28\xbb\x15\x80\x36\x80\x08\xab\xf4\xa8\x5b\xd4\xa1\xa9\x0c\xa9\x6e\xbb\xb3\xaf\xb0\x19\x19\x82\xc9\x19\x10\x26\x83\xcb\x4e\x6e\xf8\x87\x0c\xe9\xdb\x07\xe2\x56\xa0\x36\x69\x4b\xad\x68\x97\xdf\x86\xa0\x99\x64\xb2\x0b\xff\x40\x3c\x18\x90\x5b\x21\x87\xef\xb2\xf2\x1a\x24\x63\xdd\x06\x4a\x88\xd0\xf4\x7f\xaf\x2d\x30\xa5\x92\xbd\x0f\xbb\x85\x38\xca\xea\xde\xa2\xb2\x95\x34\x3a\x38\x49\xba\xef\x33\x7a\xe9\xb6\x69\xeb\x84\x59\x1e\x6f\x03\x57\x50\x5a\x8e\xf3\x8e\x30\xd3\x46\x0f\x7d\x3b\xa0\x30\xfe\x4d\xb8\xe5\xfd\x21\x10\x4d\x4a\xf0\xc4\xc8\xe6\x14\x6e\x8c\x77\x7b\xb2\xca\xcf\xcf\xb8\x81\xbe\x9f\x8e\xed\x1c\x16\x8d\x1c\x5b\x87\x70\x3e\xa6\x8c\x50\xd0\x7a\x64\x3e\x89\x48\x3a\xae\xd0\xb7\x78\x04\xc6\xa5\xe8\x3e\xe8\x6b\xd8\x5c\xec\xe3\x13\xcb\xb0\x67\x6e\xe6\x66\xf9\xc3\x93\xc2\xdc\x3b\xef\x6d\x5d\x13\x06\xda\xe1\xce\xcb\xbc\xf6\x11\xa5\x67\xb7\x4b\x79\x21\xe4\x40\xb3\xc3\xda\x6e\x70\xa2\x6d\x44\x75\xa5\x3b\x90\x6f\x75\x83\xe2\xc4\xe9\x92\x8f\x01\xff\x62\x6b\x62\x4e\x58\xc2\x68\xbc\x44\x2f\x95\x25\xdc\x9f\xb1\xa9\x83\xba\x1c\xc7\x64\xe2\x2f\x09\xa2\xd9\x68\x4d\xe4\xc9\x58\x5b\x47\xc3\xc1\x16\x2e\x61\x57\x37\x65\x84\xdb\x4c\x26\x5d\x70\xd0\x2c\x74\x98\x92\x31\x4b\x78\xd2\xb3\xa6\x89\x17\x7b\x27\xf2\xec\xe7\x2b\x50\x72\xa7\x0b\xa2\xb7\x0b\xfd\x33\xb7\x5f\xa9\xdf\x9c\x2f\xcf\xaf\x18\x9e\xb0\x01\x65\xbd\x80\xe3\x7b\x62\xc8\x3a\x13\xbb\x54\xce\xfe\xdc\x20\x7c\x11\x9a\x33\xa4\x3e\xe5\x63\xe6\x3b\xa3\x01\x99\x05\xeb\x45\xd8\xaa\xa3\x08\x1a\x83\x8e\xa4\x88\x18\x5a\x65\x93\x84\x9d\x39\xde\x8a\xab\x40\x8d\xc9\x5e\x32\x44\xab\xdd\x23\x4e\x2e\xc6\xfb\x94\x33\xc2\x51\x0b\x3e\x08\x4c\x01\xd2\xe0\x81\xb9\x20\x26\xb3\x43\xc1\x40\x67\x3c\x33\xc7\x1d\x6c\xca\x48\xa3\x02\x00\x28\x20\xc4\xbd\x39\x3e\x90\xcb\xc0\x77\x59\xa2\xd0\x3c\x86\x25\xe6\x91\x18\x47\xf9\x12\x7d\x55\xe8\x80\x15\x6f\xbf\xc6\x36\xeb\x96\xa3\x41\xcd\x22\xd3\xcf\x5e\x16\x54\x39\x7d\xe6\x5f\x2b\xb8\x62\x21\xe7\x26\xc3\x10\xa6\x4a\x73\xb4\x4d\x3b\x13\xd4\x39\xe2\x8c\xad\xb5\xa4\xb6\x2d\xcf\x7e\x85\xe4\x7d\xda\x8d\x1c\x2f\x33\x10\x09\x6c\xed\x23\x64\x25\xa3\x73\x48\x41\xe1\x32\x05\x83\x30\x1d\xd5\xee\x40\xb5\xc1\x98\xda\x2a\xc2\x85\x40\xd5\x5f\xa5\x74\x95\xe3\x8c\xe3\xac\x15\x29\x4e\x7f\x08\xca\xdd\xad\x1e\xa4\x77\x0e\x7a\x44\x11\x82\x5a\x6d\x4c\x83\xb3\xc8\x25\xed\x04\xc1\x7d\x8a\x3c\x66\xca\xfe\xa0\x33\xe7\x72\x0d\x85\xee\x82\xc2\xeb\x36\x72\x16\x4e\xfe\xfa\x80\x4d\x36\xc3\xbd\xc2\x1c\xca\x53\x22\x44\x74\x62\x09\x51\x26\x30\xbf\x29\x78\xbe\x35\xfc\x1b\xf0\x72\xe8\x2a\x1f\x74\x4c\xe5\x69\x1e\xfa\x17\x4a\x12\x72\xf8\x9b\xb9\x41\x0f\xb1\x2e\xba\xe4\xbc\xf5\x84\x4d\x25\x06\x70\x2b\x25\x49\x51\x38\xa0\xec\x3e\xec\xe2\xaf\xea\x1c\x4c\x91\x3f\x53\x74\xad\x3a\x0c\x9f\xc6\xa9\x21\x7c\x45\xf8\x7c\x4a\x7a\xfb\x89\x44\x0e\x0d\xdb\x7a\xf7\xd8\xb2\xec\xe6\xce\xe1\x3e\x5c\x99\x7b\x03\xee\x7e\x88\xe3\xdd\x9f\x47\xc8\x35\x48\xdc\xcf\x66\x3f\x6a\x4c\x44\xc8\xee\xd0\x28\xde\x6e\xdc\xeb\xcc\x55\x5b\x1f\xa3\xa4\xcb\xd3\xd9\xef\x3f\x86\xcf\xdd\xac\xd5\x39\xe8\x15\xf2\xa4\xe3\x76\xfb\x49\xdf\x73\x25\xf6\xf0\xf9\x19\x62\x54\x82\xd7\x12\xc7\x35\xbe\x22\x1c\x74\x6a\x8d\x99\x0e\xd4\x46\xd8\xab\x82\xb9\x25\x27\xec\x10\xc2\xf7\x37\xc7\xfa\x3f\xca\xfd\xaa\x8e\xca\x84\x07\x2e\x64\x9b\x24\x54\x5f\x6e\x70\x6a\xe1\x2d\x3f\xc5\xf7\xd1\xd8\x2a\x7b\xc9\xcb\x4b\x83\xf3\x05\xbc\x41\x45\xf1\xfd\x37\x05\x55\xdf\xca\xe5\x90\xae\x93\xf0\x4f\xc8\x0e\xbf\xc5\x90\x45\xa6\x01\x0b\xfd\x62\x5f\x94\xfa\xb9\xec\x2c\xe2\x3d\xb3\xe6\xb8\x91\xa8\x93\x8f\x2b\x22\xfe\xad\x28\xdc\xc0\x63\x72\x20\x5d\x3c\x0f\x30\x0b\xea\x02\x24\xaf\x73\x8b\xc4\x16\x70\xdd\x78\x29\xd4\x18\x27\x32\xd3\xde\xff\x8c\x4b\x7a\x8c\x0c\xdd\x52\xe6\x8d\xec\x41\xdf\xf9\xef\x4e\x8b\x60\x32\x40\x72\xf8\xea\xbf\xda\x79\x80\x1d\x85\xc6\xfd\xa3\x03\x65\x6e\x84\xb3\x43\xbc\xdc\x70\xe3\x53\xc3\x55\x3e\x89\x88\xcb\x80\x97\x48\x5f\x56\xc7\xd1\x82\x19\x94\x82\xf4\x89\xc9\x5b\x9f\xd5\xd1\xfa\x22\x18\xae\xf4\x8a\x21\xae\xb0\x65\x5f\x7e\x27\xa6\x70\xb8\x80\x15\xdd\x94\xb9\xbc\x9f\x15\x26\x61\x56\xea\xa0\x54\xef\x5f\x74\xe5\xc6\x7d\x44\x38\xde\xa5\x56\x24\x72\x07\x38\xb6\x5e\x80\x8a\xd5\xb9\x0e\xe8\xf9\x25\xbd\xaa\x4a\xd7\x5b\x14\xeb\x8d\xac\xb3\x28\xed\xa8\x81\x7d\x9d\x26\x8a\x9f\x7c\x41\x2a\xa7\xb0\x4c\x60\x58\x97\xff\x4f\xbb\xf9\x21\xda\x15\xc8\x76\x40\xba\x7c\x68\x8d\x08\x01\x90\x26\x30\x6d\x5f\x21\x74\xb7\x7e\x7b\x82\xb3\x89\x68\x2b\x93\x80\x36\x0a\x31\xc2\xe8\xcd\xd4\x76\xe1\x23\x60\x9d\x45\x66\xaf\x4f\x6f\x6a\x26\x01\x44\x96\x1d\x98\xe4\xca\xaf\x0c\xd1\x45\x58\x16\x0f\xaa\x0f\xee\x3d\x84\xf6\x58\xfb\xd6\xe9\xb9\xd7\x59\x6f\x01\x65\x04\x1e\x42\x37\x76\x5f\x58\xb3\x01\x6c\xee\xeb\x8b\x42\x3d\xfd\x13\x94\xb2\x6f\xf0\xe3\x53\xcb\x88\x27\x3b\x2a\x89\x71\xd3\x57\xb0\x4f\xb1\x74\x40\xba\x37\x3a\x13\xc3\x80\x8f\x0a\x99\xdd\xb7\x75\xc3\xa1\xca\xb7\xc6\xfc\x89\xc1\x0e\xc8\xba\xe5\x56\xa8\xde\x44\xa2\xcb\x84\x40\x63\x9e\x64\x99\x5c\x28\xa6\x4a\x18\x63\xef\x6a\x98\x96\x5d\x70\xdf\xc2\x54\xac\xad\x1e\xf9\x14\x5d\x68\xed\x37\x0c\xa7\xd4\x18\xfe\xc0\xe2\x79\xe4\xfd\xa0\x81\x7c\xbd\xab\xa5\x79\x3e\x09\xc1\xbb\xbd\xcc\x06\x9d\xec\xf8\xa5\xbb\x05\x57\x1a\xfe\x5d\x07\xbf\x65\x67\x40\xfd\x59\x2c\x18\xb7\xf1\x7e\xb0\x10\x66\xb8\x75\x10\xae\xbc\x76\x23\xbf\x9b\x5c\x3e\x4d\x8f\x70\xf3\x0c\x86\xd3\x8e\x47\x42\x38\x3b\x25\x26\x00\x10\x16\xbf\x07\x0e\xb8\xd4\xac\x13\xa3\xf1\x60\xdb\x42\xc9\x72\x3b\x3c\x00\x2a\xd0\x53\x96\xa1\xef\x23\xfe\x18\xd2\x3c\xce\xa8\xf0\x93\xc3\x02\x95\xa3\x7f\x58\x98\xd5\x5d\x30\xfc\x1c\xe1\x7e\x93\x6b\x39\xe7\xeb\x58\xfc\x91\x1b\x41\x62\x82\xed\x31\x21\xe2\xe0\x89\x82\xf1\x5b\x52\x06\x56\x94\x61\x1c\x15\x3f\x81\xcd\x3c\x6b\x3d\x53\x54\x3a\x05\x25\x4e\x6f\x28\xfd\x6a\xc5\xa0\x2c\x0d\x77\xec\xff\xbe\xde\xdb\xcd\x00\x2e\x95\xbf\xb5\x7f\x31\xfb\x2b\x65\xe4\xd8\xcb\x1f\x11\x94\x9f\xb5\x6e\x29\x76\xfd\x90\x0c\xb8\x27\x74\x10\x70\xb2\x2a\x6b\x44\x10\x9b\xa9\x34\x18\x7f\x5f\xe8\x95\xba\x51\x38\xe9\xd3\x9c\x47\x4a\x7e\x53\x83\x3e\x83\xd7\xb5\xbe\x56\xb0\x5e\x01\x87\xf4\x3a\x6f\x18\x73\xec\xd5\xfa\x0f\xc9\x32\x2e\x22\x4d\xa7\x99\x03\x9d\x10\x4c\xbb\x10\xb5\x23\xa8\xca\xa3\xd9\xb2\x77\x1e\xa3\xff\xbc\x14\x5d\x84\x9b\x3c\xb0\x16\x73\x3e\x65\xb7\xfb\x94\xc5\xcb\x01\x66\x91\x11\x88\x9a\xc7\x2b\x76\x2d\x9a\x0a\xa1\xb6\x11\x4b\x2c\xa5\x93\x0e\x51\x59\x3b\x15\x53\xca\x43\x8f\xdc\x51\x24\x33\x53\x65\x49\x5c\x44\xf7\x98\x2c\xf4\x0d\x38\xe6\x76\x33\x2c\x69\xa8\xfb\x8d\x7e\xba\x62\x49\x48\x8a\x13\xd5\x69\xd3\x5a\x7c\xec\x80\xea\x7f\x08\x1a\x2d\x06\xa4\x60\xec\xa3\x31\xd9\x57\xd6\x50\xe6\x35\xb0\x15\x84\xff\x9c\x59\x1f\xec\x58\xad\x67\xf7\x86\x10\x07\x24\xcb\xdd\xd8\x05\x89\xfd\xb7\x32\xfe\x63\x43\xa8\x4c\x03\xf9\x48\xe3\xf2\xac\x2c\x3b\xd3\x79\x79\x7e\x48\x71\xea\xcf\xea\xab\x47\x5a\x86\xc5\x04\xbd\x69\x7c\x82\x01\x71\x77\xdc\x87\x97\x78\x63\x52\x76\x2c\xd7\x5d\xe2\x92\x71\x9b\x37\x4f\x7b\x04\x5a\x72\x0c\xba\x1f\xb9\x29\x8a\xdf\xb2\xe5\x85\x3b\x14\xe1\x42\x92\x16\x56\xae\x3b\x5d\x63\x95\xbe\xac\x44\x72\x8a\xbd\x2e\x37\xb5\xe8\xdb\x18\x37\x0a\x59\x0d\xb6\x4e\x0c\xd7\xf3\xef\xb3\x73\xe1\xfe\xb6\x32\xd0\x98\xde\xff\x4a\x16\x9d\x4f\x51\xd7\x85\x08\xf4\x5e\xb7\xf9\x1a\xac\x95\x6c\x24\x83\x3c\x4e\x80\xbd\xbf\xbc\xe1\xb7\xf7\xfb\xe5\x41\x7a\x04\x54\xb2\xdb\xa3\x3c\x92\xed\xcb\x76\x3d\xc9\x32\x6f\x7a\x4d\x57\x2a\x89\xde\x44\x0b\x58\x5e\x54\xcb\x2c\x8e\xaf\x26\x79\x67\x65\x04\x55\xeb\xd1\x98\xe7\x50\x9e\x3a\x96\x8d\xd6\xbf\x49\x0b\x90\x39\xbd\x6e\xd5\x45\xba\x5e\x51\x36\x90\xf6\x03\xd3\x9b\x38\x88\xf4\xa0\x70\x09\x8e\x75\x94\x4e\xe8\x9a\x85\x4c\x70\xdd\xba\x4e\xbe\x21\x7e\x7c\x42\xbb\x85\x33\xa1\xb9\x84\x52\x49\x4e\x01\x9c\x37\x92\x7f\x29\xaf\x5e\x15\x85\x46\x11\x07\x15\x0c\x25\x6a\x32\xed\x83\xf6\x72\xff\xed\xae\x6e\xdf\x15\xfa\x68\x57\x73\x44\x67\x18\x69\xb7\x40\x06\x80\x29\x39\xaa\x04\xe5\x35\x1d\xcc\x26\x18\xfb\xd0\x62\xc7\xce\x10\xd5\x07\x9f\x54\x4d\x8f\xd9\x16\x05\x47\x3f\x2f\x94\xf0\xd2\xd4\x1d\xba\xfe\x94\xce\x43\x41\xb2\xf7\xcb\xfd\xe5\xb0\xe4\x46\x5b\xe7\xd8\xfb\x9c\xff\x53\x14\x3d\x26\x85\x8b\x50\x07\x5a\xa2\x55\xcf\x1b\x9e\xc8\x28\x48\x55\x69\xe2\x39\x56\xee\x0c\x22\x14\xe3\xbd\x98\x68\xa8\xc1\x2b\x7e\x95\x60\x62\x7f\x58\xe8\x5c\xed\x81\x9c\x08\xcd\xad\x8c\x80\x1e\x89\x4e\x7e\x80\x5d\x40\xd4\xd6\x10\xc8\x75\x8c\xb4\xa8\x8b\x10\xda\x38\x89\x1a\x06\x2f\xe4\xc3\x3f\x16\x8e\x0f\xf8\x30\x01\xa8\xc6\xd9\x86\xcb\x9e\x64\xae\xe0\xdb\xe0\xf6\xae\x94\x73\x60\xc9\xc0\x4b\x6a\xb9\xef\x4f\x6e\x90\xf5\x98\x00\x1f\xe6\xfa\xe7\x46\x9d\xc2\xaf\x3b\xfd\xa2\x03\xea\xd6\x21\x1e\xbd\x0f\x95\x57\x03\xde\x19\xd1\x4e\xa5\x4c\x83\x50\xae\x64\xe4\x2c\x4a\xbb\x9d\x30\x60\xc0\xb0\x06\x23\xe0\x2e\x86\x5a\x66\x8e\x2c\x39\x2e\x49\xc3\x6a\x94\xcc\x22\x82\xf8\x91\x87\x1a\x21\x14\x43\xab\x25\x88\x6f\x43\xaf\x61\x8b\xdb\x0c\x81\x80\x40\xab\xa8\xe4\x13\x73\xf0\x21\x56\xd4\xee\x45\xab\xf4\xc8\x8b\x63\x40\xf6\x8d\xb5\x96\x51\xe8\x24\xe1\x83\xb0\x69\xd8\xa8\x03\x3c\x70\x60\x6b\x8f\x3b\xb8\xde\xfc\x81\x3f\xf0\x32\x48\xc4\xe8\x4e\x15\x7e\x34\xe3\xee\x6b\x8f\xf3\xce\x68\xad\x9a\xa1\xb6\x42\x82\x3f\xd4\x66\xdc\xaf\x35\x8f\x5d\x67\x1c\x6f\xff\xc6\x31\xfc\x52\xcc\xd9\x2b\x85\x35\x3e\x06\x50\x76\x76\xc2\xc1\xf5\xbd\xe0\xc2\x7d\x96\x4c\x99\xf3\x53\xaf\x8a\xe5\x36\x66\x8d\x7b\x6a\x88\xb7\x03\x06\x5a\x3b\x04\x40\x98\x3e\xb1\x86\x42\x21\x96\xa1\x39\xab\x12\xf2\x06\x95\x6f\xbc\xf3\x9d\x65\xf8\x59\xc6\x54\x03\x7f\x42\xc9\x63\x4f\x6d\xda\x73\xfa\x4c\x24\x85\x60\x82\x5e\xd1\x52\xb7\x86\x29\x48\x3f\x35\x65\x08\x5b\xc0\x00\xb4\x24\x9c\x78\x42\xcc\xf7\x3f\xa9\xbf\x6d\x19\x52\x65\x49\xa5\xf1\x56\x14\xf8\x42\x2a\xba\x9a\xd0\x30\x8e\x1a\xfe\x16\x31\xfe\x9c\x14\x7e\x1d\x8a\xaa\xc2\x6e\x05\xef\xb1\x2f\xdb\x8e\xf4\xe7\xbc\x21\x3b\xd4\xc1\x6f\x08\xc6\x7e\x3c\xd7\x78\xe5\xaf\x44\xfc\xa1\x1a\x91\x5b\x6b\x80\xa9\x8f\x89\x4e\xbf\xfe\xa3\x87\x35\x80\x51\xc9\x95\x8d\x0f\xd2\xd0\x33\x41\x91\x98\x97\x98\x58\xe7\x39\xd7\xa4\xc0\xdd\xa3\x54\x1e\x15\x4e\x9c\x39\x2c\x3d\x97\xda\x82\x39\xc5\x15\x24\xb8\xfa\x59\x93\xec\x51\xec\x6a\x84\x4f\xdc\xf3\x55\x0c\xa8\x96\xec\xdc\x80\x0e\xfc\xc7\xa2\x6e\x7d\x91\x16\x77\x82\x89\xb9\x86\x6d\xa6\x18\x49\x83\xb3\x00\x8d\xd7\x37\x53\x41\xa3\x4f\xab\x08\x5d\x5f\xe3\x44\x68\xb9\x83\x43\x09\xf4\x04\x28\x78\x5f\x9d\xb1\xb9\x48\x72\xb2\x9b\xbc\xac\xc0\xee\x91\x1b\x8a\x96\x34\xf4\xc3\x8a\x4a\x99\xde\x5f\xac\x8f\xcc\xe2\x96\xfe\x29\xaa\x04\x1c\x2a\xd8\x39\x83\xf2\x5b\xbe\x6a\x0c\xce\x6a\x77\x1b\xaa\x31\xcf\x87\x0f\xd2\xbd\xbb\x93\xe6\xdc\xf7\xc3\xf1\x8b\x57\x0e\x14\x89\x57\x15\xa5\xf3\x84\xf6\x0e\x3b\xc9\xf4\x28\x0c\xc0\x15\x90\x9f\x73\x2e\xa3\xf4\x3a\x70\xb3\xe0\x88\x9e\x1f\x90\xa7\xb6\x37\x0f\x9b\xba\x72\x95\x27\x9c\x99\x37\xdb\x42\xab\xe5\x69\x6b\x12\xdb\xc1\x08\xfc\x1a\x2f\x15\x3c\x30\xf6\x40\x7b\xca\x0e\xc0\xb4\xcb\x04\x80\x91\x44\x8c\x1c\x67\x4e\xd3\x19\x18\x55\x1f\xa1\x2d\xbf\x7b\x44\xf3\xf5\x8b\x3b\x33\x06\xef\xc5\xb2\xa1\xb1\xe3\x87\xbc\x10\x66\xe8\xc4\x9b\x8d\x57\x40\x97\xdc\x3a\x6d\xfd\x43\xf8\x8d\xb2\xde\xf8\xf3\x92\x0e\x05\x49\x67\x3f\xca\x9f\xa5\x29\xa7\x13\x7b\x72\x02\x6c\xf4\x58\xac\x49\xfe\x0a\xb3\x8d\xa1\xfb\xf2\x95\x9a\x10\x03\xb2\x37\xb2\x09\x77\x4f\x0f\x1f\x39\x09\x0c\x57\xc3\x10\x18\x2a\x27\xa9\xf2\x8a\x51\x19\x28\x1f\xb2\xd6\x65\x06\xfc\xf8\x15\x31\x29\x8b\xf3\xf5\xc4\xc0\x0d\x3a\xec\x8e\xd9\x96\x30\xfe\xc9\x4e\x00\x18\x2e\x5a\x4c\x44\x13\x79\x80\x0f\xd1\xc7\xd1\xe0\xc1\xfe\xef\x71\x92\x2f\x42\xf3\x14\xe1\x9a\x82\x0f\xc1\x9c\x2a\x1b\x8c\x86\x97\xb9\x97\x31\xe0\xb1\x6a\x6f\x4e\x52\x3e\x65\x44\x65\x8b\xad\x35\xd1\xc1\x60\x45\xf5\xbf\xa2\x31\x92\x91\x4b\x45\xfe\x3f\xe5\xd3\xc0\x36\x05\x7b\x97\x27\xdc\xd4\xd9\x92\x6b\xf5\x51\x97\x2a\xf8\x06\x5a\x70\x53\x77\xd8\xa0\xe8\x21\x24\xfe\x36\x4e\x15\xa3\xff\x22\x44\x2f\xda\x25\x6b\x8f\x54\x3c\x78\x4e\x03\x58\x61\x9c\xbb\x91\x44\x53\x76\x2c\xa4\x2f\x0f\xff\x6e\x50\xd6\x04\x32\xe0\x23\xb3\x2f\xf3\xdd\x05\x34\xdd\x39\x26\x96\xaf\x07\x26\x4c\xb5\xf4\xbe\xc4\x2a\x07\xf1\x1d\xa5\xc8\x6d\xfe\x5a\xde\x26\xb2\x82\x02\x3e\x82\x3a\x4a\x93\xd7\xf6\x24\xf4\xb3\x54\xa0\x41\x13\x4d\xe9\x5f\xbe\x49\xee\x16\xd1\xa3\x38\x8d\xaa\x02\x50\x63\xd8\x2a\xef\xdd\xbd\x80\x30\x8d\x4a\x8f\x38\xe2\xf5\x29\x93\x05\x53\x45\xe0\xd9\x68\x81\x27\x3b\x2e\xad\x74\x73\x55\x13\xc8\x20\xd9\x3f\x49\xc8\xbc\x53\xdd\x93\x3b\xfd\x2f\x71\x3d\xf0\x57\x27\x09\x3f\xc9\xd4\xe8\xec\xbc\xb2\x94\x37\x36\x78\xb0\x95\xd4\x42\x84\x80\x50\xb6\x4e\x69\x5e\xef\xab\x7d\x8b\xb0\x00\xbc\x9d\x58\xeb\xa0\x35\x0e\x0a\xf0\x55\x89\xb7\xa4\x8a\x23\x51\x74\xe2\xd6\x8b\xb0\x77\x41\x54\x4b\x1c\x5f\x5b\xf6\xa1\x8e\xff\x87\x3e\xc9\x58\x0c\xc4\x15\xbb\x33\xd8\xc4\x91\x49\xd1\xf6\x18\xea\xa7\x76\x14\x54\xce\x95\x71\xa2\xf5\xdd\x30\x9e\x55\x0a\x3f\x1d\x35\xfd\x74\xc8\xbf\xa9\x8e\xa9\x81\xe5\x1f\x72\x66\xb2\x73\x71\xe8\x57\xd9\x44\xad\x55\x0e\x80\x09\xb1\x60\x02\xb4\x95\xf1\x9c\xd6\x9c\xc7\x16\x9a\xc4\x78\x11\xe3\xaf\x7c\xc2\x60\xc3\xeb\x5f\x9a\xa5\x63\x2a\x08\x2f\x9b\x9f\xdd\x3a\x8b\xad\xf5\x5c\xf0\x9f\x76\x0c\xea\x8e\x45\xc5\x63\x1d\x3f\x22\x6a\xe8\xd6\x68\x87\x1d\x8d\x5e\x0a\xe5\x62\xce\x79\x26\x44\x55\x2e\x6d\xfc\xcb\x19\x9d\x52\xfc\x3a\x9c\x41\x7e\x6b\xac\x8c\x65\x6d\xb4\x74\x6c\xc2\x3c\x7d\x12\xb0\x85\x96\x05\xa5\xc1\x5b\x1d\xd9\xe0\xb6\xc1\xc1\xbf\x08\x71\x2e\x78\xcc\x7a\x59\x4b\x77\x61\x4f\xfd\x19\x2f\x5a\xd4\x85\xb4\xce\x64\x32\x43\x24\x58\x6a\xb6\x84\xb5\x21\x8f\xee\x51\x32\x86\x76\x61\xfa\x06\x81\x80\xe1\x7d\x2a\x73\xf9\xdf\xb8\xde\x18\xc4\xae\xfe\x26\x0e\xfe\x31\x48\x46\x73\x06\xa0\xa4\x7b\x4c\xfa\xdf\x3d\xa2\xa9\xca\x5e\x27\x1d\xd4\x79\xd2\x0c\x18\xdc\xfd\xb8\x9b\x87\xda\x8e\x09\x0e\xb7\x74\x6d\x2c\x7a\x5b\x30\xa3\x44\x18\x18\xa1\x8e\xd6\xbc\x16\x7a\x84\xf9\xe4\x0b\x07\x14\x65\xf1\xa7\xa8\xa5\xa7\x18\x4f\x6a\xd0\xe0\xc6\x92\x13\x72\x85\x33\xe4\x8d\xa8\xe8\x2e\x45\xe4\x71\x07\xd5\x96\xc7\xfe\xf9\x54\xa3\xe5\x76\x7d\x49\x18\x94\xba\xe1\x17\x37\xa8\x41\xda\x7e\x84\x54\x03\xb7\xb7\xbc\x17\x73\x52\x8a\x1e\x05\x79\xd3\xbe\x96\x23\x00\x3f\x76\xe5\x64\xfa\xac\xc5\xdc\x79\x7c\x84\xa6\xb6\xfe\xe1\x8d\x65\xeb\xe4\x76\xc5\x59\xec\x1c\x42\xb9\x94\x0e\x3a\x1b\xe6\xfb\x13\x06\x48\x15\xba\x89\xe6\x2c\xbb\xb5\x5b\x37\x77\xa2\x12\x69\xa3\xa3\xfd\x25\x37\x0e\xc2\x3c\xf4\x49\xac\x5e\x7b\xc3\x0d\xd8\x47\x56\x74\xac\xb5\x63\x20\xd5\x4d\x23\xd9\xb3\x75\xd5\x5f\x54\x17\xb3\xda\xb4\xe8\x69\x3c\x72\xf0\x1f\x57\xf7\xb4\x1a\x4e\x62\x9d\xfe\x15\x49\x5a\x6a\xf3\xc7\x7b\x60\xc0\x51\x98\xc3\xf0\x0e\xce\x6d\xc5\xb0\x16\x17\xc7\x11\xfb\x2d\x00\x38\x24\x85\xcb\xd7\x3f\x83\x65\xdd\x33\xce\x65\x4b\xe4\xbe\x0a\xaf\x25\xda\x58\x1e\x1e\xa1\xad\x7f\x14\x20\x77\xa0\x86\x16\xe5\xe2\x54\x62\x00\xf7\xbc\xa4\x00\xa5\x32\xdc\xff\xd7\xc5\xb2\x9a\x91\xd9\x9b\x4d\x17\xde\x4a\x9a\xb8\x94\xfe\xec\x16\x7d\x28\x70\x2d\xb2\x8e\xed\x8a\x45\x87\x74\x8a\xf9\x8f\x69\x03\x8e\x7e\xc8\x08\x10\xed\xfc\x06\x3a\xa1\xbb\x4f\x49\x11\x45\x7a\x71\x14\x4b\xd3\x53\x36\x18\x9e\x1d\xae\xe1\x37\xe1\x56\x4b\x3d\x8c\xc3\x76\xdf\x46\xe7\x86\x86\xd2\xd3\x7e\xa4\x8c\x20\x33\x7d\x73\x13\xbc\x3b\x92\x18\x15\x21\xf6\xf8\xd4\xdf\xe2\x91\x28\x15\x56\xe2\x19\x8e\x45\xd9\xdb\x85\x8e\xf5\x5a\x22\x30\x26\xc9\x53\x2c\x0b\xdc\x45\x3b\xab\xf7\x4f\x09\x07\x2d\xed\x40\x7d\xd3\x16\x06\x2e\xdf\xd1\x1e\x40\x7b\x26\x64\x65\x95\x16\x57\x76\xf6\x1d\xfa\x57\x02\x47\x9c\x77\xd2\x6a\x9b\x88\xe6\x24\xe0\x43\xa7\xe0\xc1\x59\x63\x17\x76\xe1\xfe\x9e\xb9\x7a\x33\xea\x22\x95\x79\x2d\x9d\xc2\xe9\xfb\x0c\x25\x33\xc8\xb1\x4f\x41\x9b\xd3\x8f\xa7\xa2\xd9\x90\xa5\xbd\xd1\xfd\xa4\xc1\xb7\xf4\xdd\x4c\xb0\xc5\xf8\xba\xc6\xb4\x02\xdd\xbd\xc7\xa2\x7d\x09\xab\xb9\x63\x73\xcb\xbb\xd3\xae\x68\x35\x9e\xa0\xb4\x43\x52\x62\x4d\x08\xa6\xd9\xd4\x7a\xba\xd4\x3f\xf0\x33\x46\xb9\x92\xb6\xe6\x8d\xf4\xb8\xfa\x2c\xa6\x49\x81\x63\xb1\xa2\xa1\xb4\xef\xb3\x6f\xee\x68\xdf\x29\xfe\x30\x29\xf4\x02\xa4\xc9\xd5\xe3\xad\xf4\x55\x46\x5f\x67\xef\xe6\xe1\x45\xe2\xb4\x92\x0d\x05\xdc\x44\xa5\x95\x40\xb8\x8a\xc1\xba\x21\x93\xb9\x43\xc9\xc4\x27\xc5\x12\x20\xd0\x90\x0e\x41\x8e\x7d\x9e\x50\xe8\x1f\x54\x07\xc0\x4b\x95\x54\x31\x02\xa0\x27\x37\x84\xfa\xaa\x7a\x98\xe3\x8c\x57\x9a\x93\x80\x77\xa1\x69\xe3\x91\x08\xe3\xcc\x34\xf0\x98\x48\xc8\x35\x69\x09\x62\x92\xd4\xd2\x55\x5a\x19\x31\xf0\x10\xc2\x7c\x17\x60\x53\x9a\xfb\x51\x2f\x9a\xa0\x1d\x29\xb9\x2f\x74\x4d\x9e\x27\x0d\x18\x03\x23\xc4\xa1\x4d\xa2\xf5\x69\x9a\x57\xc5\xd7\x86\xd4\xa5\x93\xb4\xfa\xaa\xfa\x7e\xba\xd4\x83\xbd\xf4\x01\xbb\x90\x3b\x60\xe9\x27\xdf\x8e\x8a\xec\x75\x5f\x44\x94\x31\x70\xc9\x69\xc5\xc8\x58\x0e\x02\xbd\x4d\xa7\xd3\x76\x8c\xc6\xa4\x16\xeb\x64\x07\xd9\xa5\x31\xf3\xdb\x12\xe7\x25\xf2\x4e\xe0\x20\x98\xf8\x6f\x10\x36\xd2\x0c\x4a\xbf\x78\x66\xbd\x05\xf6\xa1\x6a\xde\x33\x61\xea\xfe\x4e\x88\xd0\xbe\x6d\x17\xde\xc5\x3b\x48\xb8\xa7\x1b\x3a\xda\x74\xc9\x63\xa1\x47\x8c\xe4\x28\x1d\x93\x4c\x17\xcf\x9d\x3a\x6c\x84\x28\x1a\xa3\x3b\xda\x7e\xe0\x87\x2c\xed\x00\x45\x67\x27\x19\xac\x49\xb6\xdf\x41\xfb\xc1\x3d\x04\xf4\xdc\x8b\xec\xdf\x76\x28\x31\x3e\x48\xac\x29\x05\xda\xb9\xad\xbb\x68\x5b\xa1\x3a\x7a\x9c\xac\xcc\xf7\x04\xf8\x4d\xd6\x01\x74\x87\x4f\xa2\x84\x0b\xf7\xd3\x8f\x0a\xce\xcb\xa3\xea\x70\xd1\xd0\xe1\xcf\x6f\x48\x1f\x8d\x21\x1a\xba\x2a\x16\x51\xab\xef\xb3\xc5\x6b\x03\xe7\x94\xb9\xba\x0d\x87\x6e\xef\x99\x4e\x9b\x30\xbc\xe2\x69\x5d\x26\x8a\xdb\x4a\xda\xf7\x16\xbe\x05\xe3\x37\x8a\xa4\x95\x6e\xd7\x52\xc3\x58\xc1\xa3\xd1\x5c\x2f\xe0\xd1\x75\xb2\xae\x5e\x0e\x4f\x65\x06\xef\xd0\x1a\x3d\x68\x57\x64\xde\x9c\xb8\x1b\xcd\x8e\x4a\x08\x7a\x18\x45\x7e\x3c\x4a\xfd\xcc\x4a\x47\xe6\x83\x37\xdf\xe6\x67\x60\xa4\xa0\xc0\x89\x87\xaf\xf2\x90\x22\x46\xbf\xe3\x50\x5c\x69\xde\x2b\x33\x74\x3d\xdf\x03\x21\x67\xab\x6b\x1a\xc0\xa4\xc3\xe9\x51\xed\x93\xbe\xcd\x5e\x24\x34\xde\xb8\xac\x92\x92\x5e\x03\x4a\x2c\x12\x16\x07\x1f\xeb\x5a\xbf\x33\x6c\xd0\x52\xe5\x2f\x81\x5c\xb5\xbf\x86\xbb\xbf\xb4\x2f\x2c\x55\x14\x8b\x23\x65\xff\xd9\x50\x1d\x14\x38\x6a\x04\xda\xa6\xa1\xf5\x43\xc9\xde\x3f\x37\x4d\x01\x01\xd0\x45\x31\x27\xa4\x0e\xd4\x0f\x2a\x0d\xc3\xc5\x7f\x16\xdc\xa8\xcf\x8c\x52\x75\xaa\xe0\xfc\xd3\xed\x8f\xce\x0f\x64\x6f\xce\x98\x82\xc5\xbd\x39\x9a\xfb\x80\x37\xfe\x5c\x8e\x45\x20\xff\x2e\x02\xac\x8f\xc8\x66\x19\xe5\x01\x5b\x4f\x1b\xf0\xf2\x46\x1c\x99\xe0\xff\xf1\x1d\x88\x02\xb4\x15\xba\xa3\x53\xc0\x64\x50\xe4\x88\xb2\xb7\x7d\x66\x4a\x8b\x4e\xa5\x35\xe9\xbf\x11\xe2\x50\xf9\x54\x6e\x20\x0f\x57\xe6\x39\x74\xb0\x4a\xee\x39\x38\x3f\x24\x29\x23\xfe\x7d\xae\xfb\x50\x30\xa5\x58\x55\x8a\xc0\xef\x90\x52\xd1\xac\xe8\x50\x04\xa3\x19\xd7\x16\xef\x6d\xc4\x81\x3a\x42\x25\xa0\x3e\x90\xbc\xb8\xd1\x39\x0a\x63\x1b\x94\x83\xf3\x3b\xb0\x46\x15\x3f\xfe\xae\xd9\x97\xd7\xa8\x8b\x9c\x8d\xb3\x62\xf7\x2f\x1b\x38\x82\xd0\x7a\x71\xaf\x10\x18\x78\xc2\xc5\x34\xfa\x62\xe1\x32\xf5\x55\xb4\xaa\x84\x46\xc2\xbd\xbc\x5e\xa0\x55\xb6\xc2\x0c\x93\x99\x5d\x13\x71\xe8\x67\x2d\x94\x07\x05\xa7\x11\x38\x43\x1c\x7e\x47\x17\xf2\x8a\x84\x1a\xc4\x60\xfa\xe1\xab\x52\xb1\x2a\xc7\x35\xdd\x62\x11\x78\xca\x3c\x77\x8f\x9a\xa7\xa7\x64\xf7\x4c\x04\x14\x98\x87\x0a\x61\x41\xb0\x3b\xb0\x0c\xf0\x50\x73\x29\x8a\x71\x27\xbc\xfc\x8b\xdd\x03\xbf\x37\x80\x54\xdb\xcb\x64\xd2\xe3\xf8\x84\xab\x6f\x34\x71\xa9\x04\x26\x65\xa5\x55\x62\x16\xce\xc3\x79\xd5\xd8\x0d\xee\x6a\xdc\xa6\x3e\xab\x9f\x1e\xc8\x67\xfb\x37\xfc\xe7\x8d\x8b\xce\x31\x55\xb1\xb7\x34\xc6\xdb\x7c\x6e\x60\x3a\x80\xd6\x45\x7d\xe2\x3c\xba\xc2\x08\x86\x05\x6f\xb6\x4b\x14\x05\xf3\xea\x5a\x72\xb1\xef\x55\x04\xdb\x70\x4f\x23\x34\xf3\x5b\x60\xf8\x2f\x95\xea\x12\x54\xbe\x0e\x50\xec\xbd\x5c\x30\x7f\x51\x58\x05\x40\x50\x5b\xfe\x45\x0c\x67\x60\xc1\xf1\x97\x43\xf3\x5a\x89\xfd\x7b\x05\xb9\x51\x53\x58\xe5\xd5\x00\x1a\xd0\x41\x86\xd4\xa7\x53\xad\xd9\x26\x32\x4f\xf1\x23\xea\x9b\x16\xc9\x1f\x18\x73\xfe\xae\xcf\x79\x9a\xda\x59\xfc\xb5\xe3\x6d\x06\x97\xce\x48\xba\x21\x16\x67\x67\x22\x24\x70\x70\x76\x0e\x3c\x4a\x33\x3f\x90\x2b\xa1\x8d\x41\x53\xa9\x23\x82\xc7\x18\xab\x19\x0e\x38\x2b\xcc\x5b\x06\xf2\x9b\x75\x74\x33\xfe\x69\x9a\xaf\x79\x3b\x93\x99\xbd\xbd\xe4\x5e\x45\xa0\x5b\x59\x9c\x78\xcb\xa0\x6f\xbf\x80\xfa\x07\xcc\x85\xda\xdd\x4c\xf2\x3d\x33\x57\xaf\x1b\x21\x62\xa8\x98\x58\x34\x0f\x29\xb6\xfe\x4b\xc0\xf5\x28\x1d\xcc\x31\x4c\x45\xe6\x47\xec\x10\xfc\x5f\xe5\x89\xa8\x72\x8c\xff\x8c\x77\x1e\x78\x48\x77\x2b\x5d\x92\x20\x19\x86\x93\x0a\xb5\x19\x9f\xa8\x2c\x6e\x50\xa1\x77\x28\xe8\x5d\x5a\xbf\x62\xee\x2f\x15\xfa\x07\xd3\x72\xd7\x15\x24\x11\xaf\xf5\x77\x7f\x8e\x2a\x7d\xa6\x9b\x9c\xa3\x72\x5c\x4e\x5a\x89\x38\xd1\x9b\x59\x07\xe4\xc4\xfe\xd2\x3f\x5c\x53\xa6\xf1\x1e\xf1\x52\x2e\x72\xb8\x0d\xa8\x44\x08\x1c\x8d\xdb\xe1\x3d\x96\x7d\x8a\x37\x11\x9e\xa2\xcd\x5c\x15\x01\xb9\xbf\xd9\x32\xba\x2d\x7a\xcf\xa1\x0c\xcd\x57\x96\xdb\x2a\x7c\x80\x3a\xcd\xe8\x1d\xc4\x39\xc5\x7c\xea\xe4\xb6\x2b\xf1\xd7\xcd\x0d\x81\x0a\xe2\xa3\xb7\x5e\xb9\xae\xaa\x56\x10\x4e\xa4\x13\xe2\xdd\x5f\xb1\xd2\xfd\xc3\x26\xe9\x20\xaa\x6e\x26\x3f\x8d\x7b\x6a\xd1\x90\xcf\xf0\x0d\x39\x0f\xff\x9f\x6c\x42\xe3\x20\x62\x62\x53\xf8\xb9\xe8\x86\x84\x4e\x5f\x96\xb4\x9d\xd9\x8c\x79\xf0\x4d\x59\x42\x51\x19\x1c\x85\x67\x8d\xbc\x71\x69\xdb\x6b\xd4\x24\x91\x20\x47\x39\xf1\xbf\xf6\xea\x4d\x05\x39\x02\x6b\xe0\xeb\x82\xd0\x15\x70\xec\xc3\xb8\x81\xc0\x14\x5b\xfb\x9a\xb9\xd0\xf3\x67\xa5\x9c\x6d\x5a\x41\x66\xe0\xc1\x0e\xf8\xdd\x7e\x44\x78\xe6\xab\x15\xe2\xed\x15\x1f\xc2\xc4\xe4\xad\x56\xb3\x6d\x0a\xd4\xec\xde\x4e\x56\x02\xf9\x1b\x5a\x4e\x9d\x78\x16\xdd\xb6\x58\xc5\x8f\xe1\x21\x5f\x6e\x20\xad\x1e\x45\xd9\xce\x4b\x00\x91\x71\xd2\x2a\xcb\x2a\xd4\x59\x23\x01\xc6\xc3\x18\x27\x70\x04\xc6\x0c\x95\x45\x3a\x1f\xa3\x76\x5e\x82\x64\xa0\x78\xfd\xcc\xd8\x24\x40\x3a\x94\x72\x6c\xcb\x2c\x0a\xbb\xb2\x5a\x06\x56\x84\xc3\x46\xa1\xa5\xa2\xb2\xee\xf2\xcd\x75\xd8\xf8\x36\x21\x39\x8f\x38\xfb\xd5\x0a\x2f\xf2\x08\x82\x9c\x97\x62\xf1\xab\xb4\x08\xc8\x16\x8f\x65\x5e\x6f\x0f\xa5\x7f\x74\xc8\x99\x16\x8f\xb7\x90\x0e\x48\x63\x3c\x51\xb5\xbe\xb5\x38\x04\x8a\x1a\x0d\x23\x96\x76\x50\xcc\x6c\x64\xcd\xe3\x3d\x69\x64\x36\xdb\x93\xa5\x0d\xbb\x70\xdb\xe2\x0d\x98\x55\x68\x91\x62\x6e\xeb\x17\x6e\x9c\x50\x57\xbe\x4f\xce\xbc\xc3\xc4\xe7\x76\xe9\xa5\xca\x47\x21\x20\x45\x82\xbf\x3f\xa2\xed\x51\x0b\x97\xf4\x72\x7e\x19\x5b\xca\xc5\xad\x9f\xed\xb0\xe3\x0b\x47\xb5\x3e\x5d\xdc\x98\xc8\xeb\x0d\xd3\x93\xfe\x8b\x24\xd9\x92\xcc\x7b\x4f\x94\x93\x7a\x93\x1f\x58\x40\x2f\x87\xd5\x01\xb8\x8d\x67\xd2\x86\x34\xde\xee\x8c\x88\x0c\x3f\xf6\x2a\x3c\x51\x2a\x6f\x17\x96\x4f\x7c\x0a\x17\xac\x8d\xd1\x81\x60\xea\x4b\x44\x9c\xf1\x18\x0b\x5c\xb1\x3a\x66\xcc\x37\xcf\x67\xd9\x8e\xaf\x32\x78\x1a\xaf\x5f\xb7\xff\xfc\x90\x54\xb3\x79\x8b\x9f\xa3\x04\xef\x32\x0c\x7e\x5c\x9e\xf9\xb6\x50\x6c\x66\xfc\xc6\x44\x80\x11\x3d\xa4\x60\xc4\xfd\xad\x27\x6d\x42\x64\x84\x98\xd0\xb7\xe5\x41\x17\x7d\x27\xf2\x23\x35\xcd\x3f\x12\x57\xc4\x31\xb5\xb1\xa5\x77\xe3\xb8\xb3\x16\xcf\x0c\x4b\xe2\x45\x41\xc4\x5d\x25\x3e\x1d\x2c\x1a\x88\x98\xd8\xe4\x36\x3f\x41\x62\x4e\xa9\x20\x45\x23\x94\xe9\x8d\x0e\x4c\xd2\x06\x07\x35\x87\xd2\xdc\x86\xca\xcf\x2a\x42\x06\x3d\xdc\xce\x7d\xb3\x2a\xef\xc7\x4b\x4b\x61\x15\x9c\x2f\xe7\x15\x22\x9d\xca\xcc\x76\x76\xfa\x82\x26\xf0\x34\x3c\x5c\x93\xe1\x38\xd1\xcc\x02\x5f\x0f\x1a\x96\x61\x94\xd3\x4d\x7a\x67\xa6\x96\x6d\x69\x68\x8c\x00\x4f\x35\x0a\x91\x26\x9f\x4e\xae\x28\x94\x25\x5b\x78\xba\x50\xda\x16\x90\xdc\xb9\xa7\x29\xbe\x64\x1a\x4d\x42\x6b\xa3\x77\x68\xc1\x86\xfa\x66\x2b\xa1\x2b\x17\x38\x00\x4f\x33\x64\x8c\xb3\x3a\x24\x1e\x24\x12\x0c\xc1\x0e\xad\xed\x9b\xfa\x06\x25\xd8\xb4\x6a\x06\x61\x0d\xaa\xba\x54\x61\x3c\x76\x80\x5a\x3b\x14\x0b\xb1\x2f\x8d\x46\x87\x11\xaf\x74\xd5\x48\x9e\x77\x9d\xb0\x5d\xb7\xcc\x62\x82\x14\x44\xb4\x01\x66\x53\xe7\xd6\xc4\xc2\x56\xca\xd8\x9a\x5e\x1b\x6c\xdd\x4c\xa8\xb6\x2e\x3d\xcf\xb5\xa5\xfb\xaf\x39\x28\x68\xed\xff\x44\x26\x84\x7f\xdd\x27\x6c\xef\xca\x63\x98\x91\xa6\xd6\x89\x20\xc4\x84\x7c\xd9\x92\x2c\x32\x7e\x00\xf7\xf5\x45\xd2\x1b\xb7\x1d\x20\x20\x42\x82\xfc\x36\x91\xde\xa4\x91\xb7\x61\xd0\x77\x3f\xf3\x56\x44\xd8\x00\x5a\xb3\x24\x3f\x23\x33\x6d\x4e\x21\x8f\xbd\xca\x33\x10\x19\x41\x50\x3f\x36\x6e\x03\xfe\x18\xc2\xb9\xe0\x4d\x4d\xa5\x7e\x0f\xdb\xb8\x8e\xeb\xf6\xea\x81\xbb\xd1\x62\x3f\x3b\x15\xbd\xe2\xcc\x10\x28\xfc\xae\x58\x46\xa9\x50\x29\x76\x53\x49\x9f\xb4\x6e\x1b\xf3\x61\x35\x39\xa4\x91\xd3\xf9\xce\x26\x8f\xa7\x76\xba\xcb\xe3\x73\x07\x10\xdf\xd3\x0b\xf1\x22\xc2\x7d\xdd\x2b\xb4\x27\xd8\xf8\x2f\x7b\x68\xf6\xe3\x76\xd1\x8e\xa0\x94\x1b\x38\x88\x40\x3b\xe0\x0e\x87\x09\x0b\xb3\x1f\x9a\xf3\xa5\x64\xcb\x03\x34\x07\xe1\x99\x74\xb2\xf0\x3b\xda\x51\x4f\xd4\x60\x27\xd6\xa2\x5d\xc2\xc0\x08\x7f\x8c\xee\xf9\x8e\x0e\x9c\xbc\xc7\xb4\x06\xc0\xe9\xec\x6c\x30\x64\xc9\x97\xa5\xc4\xb4\xb7\xea\x66\xbf\x43\x6a\xb6\xd1\x0b\xa0\x5d\x67\xe5\xb8\x85\xb0\xab\xd2\xd1\x13\x64\x00\xa3\xce\xf9\x11\x41\xd2\x39\x63\x71\xc8\x0a\x22\x51\x67\x5c\x33\x72\xec\x90\x22\x26\x23\x39\xa3\xed\x33\xce\xb4\xc6\x86\x62\x52\xec\x97\x43\xc7\x19\x32\x81\xc5\x3a\x8f\x8d\x56\x89\xeb\x1a\xca\xba\x9a\x1b\x03\xbf\x97\xd8\x3f\xe9\xf0\x07\x21\xc5\x56\xc1\xa7\x88\x6f\x56\x56\xaf\x36\x41\x9b\x1d\x93\x38\x90\x36\x56\x09\x8e\x03\x41\x1c\x29\x94\x5e\xd5\x29\x82\xf4\xe4\xba\x3c\x1a\xf2\xce\x85\xda\x7b\x4f\x59\x7d\xc8\xfc\x57\x97\x76\xb3\x3e\x56\x6f\x2f\x59\xa4\x48\xf3\x83\xe6\x9c\x32\x49\x0a\x57\xdb\xe5\x6f\xda\x1c\xe5\xfc\x5a\x87\x16\x36\xf4\x42\xe0\xad\x4a\xdd\x67\x7c\x75\xa7\xe6\x8d\xa8\x2f\xd5\x2a\xfc\x6b\x13\x28\x57\x16\xcb\x82\xc8\x7a\xb7\xdb\xff\xe8\x16\x8f\x9e\x1c\x07\xc1\x4a\x91\xbf\x6b\x33\x90\x2a\xc8\xba\x94\xf0\xa3\x18\xe7\x78\xe5\x0f\x2a\xbe\x67\xbc\xae\x92\x97\xa3\xdb\xf1\xa1\xf1\x60\x99\x83\xc3\x69\xe5\xc4\x32\x37\xce\x43\x3e\x1a\xa6\x3f\xf3\xad\xd1\xf0\x12\xa4\xd2\x5c\xd9\x2a\xdd\x3a\xb6\xfa\x5f\xa7\xc6\xa7\x1a\xbe\x56\x4e\x46\xe0\xcb\x42\x43\x50\x7d\xfe\xe3\x02\xd4\x36\x96\x21\x0d\x0e\xb0\x7e\x32\x61\x89\x58\xb0\x99\x98\xeb\x27\x4b\x07\xb7\x9b\x5c\x3a\x31\xa6\xd1\x03\xda\x4d\x40\xc1\xfa\x25\x12\xf5\x6a\x74\x8c\xf5\x3b\x50\x24\x5c\x44\x63\xdd\x8b\xa7\xcc\x90\x49\x44\xb5\x5d\x7a\x90\x58\xae\x50\xd5\x16\x0d\x12\x9a\xd6\xc6\x7b\x30\x0d\xae\xf9\x0a\xd7\x7e\xad\x07\x9a\x36\x39\xa4\x92\x5a\xb0\x71\x6c\x96\xf3\xf1\x6e\x57\xf0\x04\x08\x80\x61\xc4\x65\xfa\x23\x0f\xe3\x49\x60\xc2\xe9\x55\x5e\xf0\x18\xb7\x00\xe2\x3d\x5b\xf3\x5f\x36\x85\x56\x75\x97\x38\x0a\x67\xeb\x75\xfe\x46\x7f\xdb\xf0\x0e\x64\x4f\xdc\xe1\xcd\xf4\x2b\x0e\x57\xfd\x78\x07\xa2\x6c\x94\x1f\x38\x4c\x63\xd6\x35\xa4\xcf\x34\xe3\xfd\xfb\xf4\x23\x93\xf3\x69\xf5\x81\xe2\x1c\x4d\x12\xf0\xbb\x76\xdf\x8f\xb7\x9f\xf3\x5b\xca\xb9\x14\xb4\xb2\xd0\x33\xe3\x02\x20\x22\x23\xae\x56\x61\xe4\x48\x47\x7e\x53\x4e\xce\x3e\xea\x70\x87\x44\x55\x98\xf0\xe7\x9e\x5f\x1e\xe8\x97\x81\xbd\x2e\xd8\x8c\x94\x96\x45\x19\xb0\xb3\xd2\xeb\x5e\xfd\xbd\xf0\xbb\x2c\x06\xc2\xaf\x8e\x13\x88\x24\xfe\x07\x5f\xca\xdb\x16\xe1\xe8\x91\x0b\x74\xe2\x12\xb0\x5c\x8d\xae\x6f\xc6\xfa\xa6\xea\xff\x7c\x88\x75\x45\x0d\xf6\x9e\x51\x29\xca\xd0\x07\x23\x0e\x2e\x46\x77\x6a\x36\xd0\xef\xff\xe7\x3b\xc5\xfa\x55\x7a\x73\x0f\x05\xb1\x9a\xfd\xb2\x62\xf5\xe7\xa8\x07\x7d\xe2\xa4\x05\xd7\xc1\x6a\xf4\x9e\x3c\x8f\xb7\x97\x2b\x5e\xac\x17\x11\xa1\x42\x20\x44\x77\xd1\xcf\xe7\x03\xd9\x96\xe2\x4d\x48\x2f\xe8\x0a\xe0\xb0\xb1\xdb\x1b\x6d\x3f\x04\x2e\x3c\xd9\x2e\x1b\xd9\x45\x8a\x75\x8c\xd3\x5c\x01\x2a\xd2\xd0\xdb\xb4\x8c\x11\x19\x5b\xb3\x9d\xad\x6e\xa9\xf1\xcd\x16\x6e\x32\x2a\xba\x49\x61\x14\x82\x46\xe9\x40\x2d\x98\xad\xe2\x0b\xcd\x6d\x98\x3a\xb7\x1b\x87\x5b\x4d\x02\x08\x5c\x80\x3b\x74\x33\xaa\x66\xad\xed\xe9\xff\x92\x6f\xab\x28\xf8\x10\xf0\xd0\xae\x6d\x3d\x68\xb3\xcc\x76\x4b\x55\xe5\x6b\xca\x40\x17\x68\x9f\xa6\xfd\xa2\x61\xea\xd6\x7e\xc8\x54\x04\xa2\xbd\x57\xf9\xd6\x11\x6d\x6a\xc4\x93\x94\xee\x55\x57\xc4\x21\x90\xba\x06\xad\x15\x57\x07\x9b\xf8\x88\xef\xa5\xcc\x73\xd5\xa1\x5f\x46\xe3\xbd\x6d\x53\xd1\x40\xe4\x82\x6f\xe1\x17\x50\xcd\xb9\xa3\x45\x42\x1c\x01\x3c\x9b\x79\xb4\xf2\x01\xcf\x88\xd5\xbc\xca\xcd\x64\xf1\xb1\xd3\xb3\xdc\x0c\xd2\xf1\x4a\x9f\x1d\x39\x45\x38\x87\x23\xff\x86\xe9\xb9\x03\xe1\x15\x76\x99\x8e\x2a\xab\xda\x99\x71\x9e\x05\x3b\x89\xef\x4c\xbb\x6d\xc0\x82\xc8\x60\x5b\x49\xf4\x2f\xf6\x84\x49\x4b\xdf\xc0\xef\x09\x62\x38\xc3\xa9\x4a\xbd\xa5\x1e\xa4\x5b\xaa\xc8\xb7\xfc\x80\x9a\xc9\x0a\xf4\x54\x67\xfc\x04\xd0\x46\x00\xa1\x5e\x7b\x78\x6f\x80\xde\x41\xe0\xc7\xd7\x52\x6b\x45\x61\xfd\xa9\x14\x1b\xd9\x05\x00\x22\x29\x93\x68\x25\x9a\x82\x82\x74\x81\xfd\x03\xe6\x6a\x31\x2c\xdd\x48\x14\xc5\x44\x9a\x82\x58\x9b\xab\xfb\x27\x1f\x59\x06\xc4\x7d\x6d\xb2\x58\xc8\x46\x66\x0f\xb4\x1e\xb9\xc2\xdb\xea\x32\xb0\xa5\xd3\xbc\xbe\xd8\x43\x57\x54\x42\xd9\xc4\x4e\xef\x6e\xd2\x64\x88\xf8\xd5\x63\xe3\x1e\x91\x65\x4d\x45\x89\xf8\x01\xdb\x58\x7a\x29\xcd\x41\xef\x25\x8d\x1f\xce\x4e\x8d\x8a\x44\x3c\x62\x9e\xf0\x56\xfe\x85\x9b\xaa\x7b\xe6\x0d\x3c\x3d\x41\x8b\x9f\x0b\x6f\x30\xca\x06\xde\xca\x77\x88\x5b\xb4\x19\x5f\xd9\xc6\xfd\xa4\xe9\x34\xf6\x51\x6f\xfc\x97\x14\x03\x8e\x31\x03\x4e\x1f\x28\xa8\x72\xdf\x76\xa5\xfc\x73\x66\xa1\xd7\x96\xa6\x12\x61\x98\xdc\x35\xe8\x41\x37\x4e\x34\x78\x20\x7d\x34\xb1\x09\x6c\x52\x96\x2c\x1e\x0f\xc9\x7a\x24\x3d\x19\x17\x25\xea\xe2\x9d\xc4\xc3\xbb\x3a\x10\xb8\x47\x0d\x45\x0e\x4b\xc8\xfa\x57\x73\x9d\x4b\x86\x24\x3b\xc7\x61\xa5\xed\x35\x3f\x74\x2b\x86\x3b\x67\x96\x83\x1d\xb5\x49\xdc\x5e\x57\xc9\x04\xfa\xb9\x93\xdf\x6c\xa1\x55\x04\x2b\xa0\x63\xde\x14\x25\xed\x97\x07\x63\xa5\xf7\x9a\xec\x66\x10\xf7\xec\xe2\x2c\xeb\x71\x89\xec\x9b\xfb\xcf\xdd\x54\xf9\xf0\x79\x62\xc3\x37\x87\x3f\x7b\x23\xcd\xce\xff\x82\xc3\xfd\xa7\xa6\x9b\xd3\xb9\x1b\x3d\xf5\xe1\x00\xe5\x26\xed\x10\x6d\x4c\x77\x13\x46\x5f\x37\xe2\x64\xf9\x56\x24\x73\x9c\x40\x47\x68\x50\x89\x2d\x1d\x95\x69\x20\x64\xf3\x1c\x53\xd4\x10\xfe\x5f\xfb\xad\x38\x19\x4c\x38\x33\xc0\x91\xce\x6a\xc1\x14\x0a\x5e\x1b\xbf\xec\x25\xc1\xf5\x2a\x1c\x15\xae\xe2\x92\x8a\x8c\x5c\xb1\xb5\xfa\x62\x61\x62\x55\x53\x2d\x4c\xd1\x66\x88\xcd\xe6\x88\xf0\xd9\x49\x4f\x11\xa1\x4c\xb0\xc8\xc2\x92\x53\x06\x90\xa7\xd0\x6b\xd0\x9d\x72\xdd\xbb\x74\xb6\x26\xa1\x4d\x4c\xc1\x87\xcc\x5c\xf6\xbc\x27\x05\xb7\x15\x85\xbb\xfb\xac\x7c\x08\x0d\x38\x94\xc0\x63\xed\x96\x20\x67\xcd\x25\x8f\xc0\x31\x1b\x02\x68\x76\x9f\xba\xf8\xdf\xf8\xc2\x83\x91\x14\x3e\x16\x6e\x81\xe3\xb2\xf6\x2f\xbf\x9c\x0d\x47\x19\x8c\xae\x21\xc9\x96\xc6\x30\x99\x83\x75\xa3\x40\xd5\xd7\xf6\xbd\x7e\xe3\x45\x45\x52\x0f\x5c\xab\xe4\xcd\xc6\x23\x12\x3d\xe5\x48\x90\xf4\x8a\xe6\xe2\x29\x1c\x8f\x11\x7e\x88\xad\x5f\xc7\x4e\x4d\x3f\x46\x55\xd4\x7d\x54\x10\x00\x2f\x2e\x04\xc2\x2b\x07\x48\x62\xc7\x4e\x81\xe8\x64\xa4\xa4\x16\x9b\x11\xc0\x84\x40\xd4\x9d\x10\xe4\x02\x19\x77\x88\xca\x2f\x5f\x66\x7a\x66\x99\x72\xe0\x9a\x34\xe6\x68\x63\x50\x27\x4d\xe9\x07\x91\x44\xa5\x66\x6b\x8f\xb3\x40\x86\x10\xa4\xc0\xe2\x87\x50\x12\x0b\x0a\xb7\xcf\x1f\x44\xab\xd2\x08\xc8\x00\x68\x3c\x62\xe4\x7d\x35\x6c\x8b\xb6\x95\x90\xf3\xbc\x1d\x4b\xe4\x41\x6f\xbf\xd0\xbb\x5f\x01\xf0\xc5\xb0\x27\xaf\xfc\x53\xef\x63\xe5\xb4\xb4\xac\xdf\xd4\xe2\xb9\x83\x5a\xea\xb9\xce\x1d\xfc\xbc\x52\x49\xea\x8b\xc2\x77\x0c\x23\x98\x0c\x88\xf4\xeb\x49\x94\x99\x9b\x95\x7c\x8a\xf7\xbb\xcc\xf2\xff\x76\xa3\x4b\x70\x8f\x26\xf6\xba\x09\x87\x17\x65\xf4\xd0\x28\x78\x65\xfc\x65\xc5\x07\x4f\x9c\x00\x57\x94\x63\x81\x86\xb4\x1f\x30\xc4\x2a\x47\xc0\xf5\x21\x1c\x31\xdf\x24\x30\xbc\xe1\x05\x0d\x92\x7c\x03\x05\x78\xb5\x91\xae\x0a\x8f\x10\x4e\x75\x9f\xa5\xe6\xdf\x83\x52\x77\x71\x1e\x64\xab\x99\x3e\xb8\xbf\x0d\x87\x13\xfa\xc7\x4a\x27\xd0\x8e\x22\xa6\xbe\x48\x2e\x38\x0a\x76\x7f\xff\x59\x7f\x95\xee\x24\xf9\x49\xcf\x62\x14\xf3\xca\x24\x13\xd2\x94\x38\x8a\xdb\x15\x56\x45\x97\x3b\xcd\x16\x4e\x4f\x00\x16\x13\x47\x54\x80\xb7\x2a\xd8\x61\x2b\xe4\x67\x7a\x85\xe5\x6c\x23\x33\x9a\xb0\xd1\x04\xe6\x52\xca\xf6\x75\xbf\x15\x8d\xfd\x62\x41\x53\x75\x6d\x99\x81\x3c\x2e\xf2\x15\x93\x27\x04\x52\xb9\xb8\x86\xcf\x5d\xbd\x9e\x01\x72\x88\xf2\xe0\xa1\x20\x9a\xa6\x4f\x50\xbe\x1a\x21\x7a\x88\xb8\xee\xc3\xa1\xb9\xd3\x44\xf3\x86\xa6\xd6\xab\x65\x3d\xac\x40\x17\xba\x8e\x1a\xd8\x89\x95\x9f\xa0\x2c\x5f\x24\x3f\xb5\xbe\xc1\xec\xd5\xb9\x83\x1b\xa7\xe6\x90\x16\x36\xf7\x8e\x0c\x21\x97\x4b\xc9\x63\x1b\x11\x98\x38\x40\xbc\x0e\x75\x78\x83\xaa\xd4\x37\x18\x38\x5a\xbd\xe9\xa1\x87\x1b\x72\x07\x0e\x6b\x98\xa6\x8d\x2f\x4a\xae\xe7\x49\xbb\x50\x6b\x63\xa0\x0f\x01\x78\x93\x2c\x30\xff\xa7\x60\x4d\xc9\x41\x3e\x8a\x23\xd6\xc4\xac\xe2\x31\x56\xf1\xd5\x09\x4f\x97\x29\xf0\x60\x27\x2b\x32\xb8\x8e\x89\x0e\x07\x6a\xdb\x5e\x18\x7d\x02\xe4\x13\xa3\x4e\x05\xa4\xc0\x2e\x1a\xbd\xb1\xe7\x2a\x77\x59\x20\x02\xf7\x77\x72\xd5\xf0\xbb\xed\xa1\x75\x95\x44\x48\x58\xef\xbb\xb7\x71\x46\xb7\xe3\xcd\x54\x34\x66\x72\xb4\x86\x05\x87\xe5\x04\x16\xb3\xca\x35\x26\x6a\x15\xba\x03\x17\xf9\x4f\xce\x86\x91\x26\xa3\x1e\xa5\x1e\x4c\x39\x7d\x12\x1e\x4e\x1d\xfb\xea\xfd\x00\x2c\x52\x2c\x98\xce\x11\xbf\x2d\x6b\x87\x69\x20\x3d\x4b\x19\x87\x86\x62\x62\xde\x9a\x7e\xcc\xb2\xa7\x6c\xd5\x88\x87\x3c\x57\xff\x09\xe2\xa9\x35\xc2\xcb\x9c\x33\x56\x5d\x27\x12\x58\xfa\x98\x0b\xe3\xf4\xb4\x1a\xba\xe5\xd2\x31\xbb\x2a\x74\xae\x54\x72\x53\x2e\x46\x9c\xd9\x74\x36\x34\xc5\xae\x0b\xe5\xfd\xd5\xa7\xc9\x2d\x33\x37\xa6\x7f\x1e\x95\x54\x7f\xe5\x90\x66\x45\x78\x4b\x03\x1b\x3e\xc7\xf0\xf9\x21\xeb\x30\x80\xdd\x27\xed\x51\x20\x42\xfc\xf6\x9e\xbd\xf5\x37\x51\x88\x00\xb4\x92\x85\x1d\x66\x8c\x6a\x4e\xa0\x5e\xf7\x1e\x2a\x4d\x62\x30\x61\x5d\xcd\xca\x56\xe8\x4d\x7c\xf1\x8b\x18\x50\xf0\x0a\x73\x71\xca\x7e\x93\x7c\x76\x6b\x62\x5a\x35\x13\xd9\x18\x93\xf9\x91\xf8\x5e\x8b\x7b\xdb\xb6\xe0\x1f\x46\x16\xa8\x4b\xbc\x1b\x04\x80\x03\x66\x8f\x0e\x5f\xa2\xbe\x84\x1d\xbc\xba\x0e\x13\xc8\xbc\x9d\xc3\x11\x23\x18\x69\x0b\x40\x3c\x49\x5a\x10\x51\xa5\xc4\x55\x71\x3b\xe5\xe9\xb4\xe7\x59\x1a\x06\xa6\xc0\x07\xfb\x6a\x6c\xd0\xaf\xb8\x56\xf9\x36\x15\x28\x96\x3f\x1b\x2f\xb2\x57\x4e\xdb\x67\xec\xda\x30\x3d\xe3\xe6\x70\xb0\x5d\x72\x7d\xb6\x06\x95\x32\x1a\x0c\x34\x1a\xc8\xb2\x94\x07\xa9\x91\x0f\xa8\xf6\x6c\x33\x87\x2e\xb7\x1b\x29\x92\x06\x04\xaf\x18\x92\xd4\x6c\x57\xe4\x45\x1a\x8b\x4b\xd4\xf1\x4d\x06\xb9\x6f\xbf\x51\xf5\xf9\x79\xfa\x91\x59\x03\x24\x85\x30\x35\xba\x27\x03\x34\xf7\x01\xee\x32\xe1\x24\x8d\xfc\x0f\xc5\xb4\x02\xb5\x66\x2e\x96\xc6\xcb\x5a\x0d\x67\x97\x37\x56\x7a\xa9\x00\xdf\x3e\x8b\x25\xd9\x1d\xce\xad\x53\xd0\x37\xe8\xe5\x70\xe8\xfa\xd0\x63\x6f\xa3\x67\xc8\xdb\x8c\x00\x34\x09\xf9\x97\x59\xf4\x71\x86\x66\xd0\x4a\x5b\x16\x05\x40\x43\x58\xa9\x9d\x08\x05\x47\x63\xc2\xa3\x67\x26\xa7\x09\xc9\x7d\x1d\x6a\x1f\x9f\x5d\xa4\xcd\xaf\xa1\xa9\x2d\xa8\x20\x2e\x35\x4a\xe2\xee\x23\xe0\x88\xee\x5e\x58\x7a\x37\x7e\x94\x1a\xed\x7c\x89\xe1\x5c\x31\xf7\x56\xd6\x96\xc7\xd5\x83\x24\x34\xcd\x59\x32\x19\xe5\x05\x08\x21\xe3\x9e\x2c\xa9\x87\x13\x58\x6e\x7c\x87\x07\x4c\x9e\x35\xee\xde\x6b\x27\xd0\x6d\x11\xb2\x04\xbb\x9d\xa2\xaa\x56\x26\x3b\x30\x30\x47\x30\xe8\xae\xb8\x19\x2f\xf7\xc8\xc2\x59\x63\x7d\x98\x1f\x0b\x90\xa0\xbc\xe7\x50\xf7\x44\x20\xf1\x89\xd4\x37\xfc\xac\xca\xde\x3d\x2b\xeb\x3d\x0f\x50\xa8\x0a\x77\xee\x5a\x7e\x87\xd5\x3e\x51\xcc\xb5\x42\xfb\x1d\xdc\x8c\xcf\xb7\xb8\xb8\x75\x0f\xea\x83\xd9\xb6\x31\x18\xb8\x9b\x06\xe5\x71\x35\x47\x6f\x00\xb3\x52\x49\xe3\xdd\xb8\x62\x04\x83\x51\x15\x71\xa6\xaa\x52\xc0\x59\x4b\x3f\xe1\x57\x6f\x5c\xa2\xbe\x05\x33\x4b\x3f\x14\xbd\xde\xa2\x1e\x0a\x43\x87\xb3\x96\x59\x4f\x96\xd2\x01\xe9\x1f\x7e\x2d\x7d\xc2\x86\xf8\x34\x01\xc8\xe2\x34\x4a\x46\x47\xcc\x27\x8e\x86\xa0\x63\xb7\x0f\xec\x70\xe2\x04\xf2\x78\x04\x13\x59\x97\x0e\x04\x42\x41\x8b\xdf\xc7\x35\xdc\x3e\xeb\x40\xb0\xee\x78\x28\x5b\xc4\x00\xa5\x97\x5b\x96\x0e\xf0\xb4\xc5\xc7\xc1\x0c\x66\xf0\xbf\xdd\xef\xb8\x65\xdc\xc4\xee\x80\xe5\xe3\x05\x93\x1f\x89\xfb\x2b\xf5\xea\xc0\x87\x89\xeb\x52\xcd\xb3\x60\xfa\xbf\x4b\x53\xf6\xd7\xfd\xfb\x10\x58\x68\x42\x6e\xb2\xe8\xdd\x9a\xab\xd0\xe9\xda\x1d\x53\x05\xa8\xb4\xba\xc4\x11\xa9\x88\xe8\x13\x56\xf2\xcb\x12\x69\x31\x5e\x07\x38\xb1\x12\x3d\x76\xcd\x98\xce\x40\xda\x82\x65\xee\x01\xb8\x6c\x46\xcc\xa2\x55\x01\xe2\xf4\x7f\x7f\x5c\x80\x39\xb7\x3d\x3c\xb9\x9e\x26\xb0\x2a\x59\x4f\xfe\x8d\x88\xc8\x93\xda\x6e\x00\x45\x05\x5c\x91\x67\x4a\x91\xe6\xfe\xf2\xda\xb6\x35\x6f\x11\x61\x31\xc2\x61\x79\x59\xa2\x15\x60\x6f\x53\xee\x56\xd8\x1d\xb4\x7e\xcf\x38\x39\x8d\x02\x53\x24\xac\xa0\xab\x06\x2f\x96\xcc\xcd\x6a\xe0\xbe\x86\x5a\x89\xfd\xaf\x89\x26\x03\x26\xee\xf8\x9a\xca\x55\x86\x13\x47\x14\x5f\xb0\x12\xa6\xde\xd4\xd4\xab\x3b\xbb\x1e\xa8\xf7\xa3\x42\x98\x0c\xa5\xd3\x9d\xbc\x13\xee\xb0\xf7\x90\x8f\xc3\xf2\x11\xc8\xfb\x35\xb9\x4b\x1b\xb6\x1d\x9d\xcf\x70\xba\xe0\x4c\x96\xb9\x65\xa4\x25\xfb\x6e\x79\xee\x07\x17\x2b\x66\x4e\xad\x0c\xf3\x48\x66\xef\xfd\xfc\xd2\x95\xe9\x3b\xdf\x2a\xc4\x44\x95\x53\xd9\x2c\x91\xd8\x89\x6b\x5b\xb2\x2e\x39\xb0\xa4\x5b\x2b\x86\xe8\x5a\xf9\xc2\x10\x45\x36\xb1\x0c\xec\x90\xaa\xfd\x42\xdc\x28\xc3\x80\xff\x3c\x12\xc6\xf3\x77\x76\xee\x04\xe0\xb7\xae\x98\x13\xca\x0f\x17\xac\xe8\x58\x59\xcd\x64\x89\xa6\xc8\x5f\x0a\xdc\x26\x80\x3c\x1a\xd2\x9b\x44\x3d\x7d\xf3\x61\x99\xa2\x39\xa8\x53\x77\x2a\xe2\x98\xd2\xca\x78\x52\x2d\xba\x16\xe1\x37\x47\x32\x80\xd7\x95\x15\x24\x75\xff\x0f\xb6\x29\x0e\x87\xc2\x21\xb1\xb9\xc0\xd9\x45\x95\xe3\x91\x0f\xc5\xb1\xe6\x2d\x3f\x43\x7c\xb1\x57\xad\x8a\xd9\x14\xd5\x14\x6e\x82\xa3\x9b\x0f\xed\xeb\x9d\x8e\xb0\xb2\x18\x8a\x0c\x76\xfa\xbf\x12\xfb\x45\x07\xfc\x9a\xa5\xa2\x7b\xab\x5b\x44\x78\x7c\x47\x5f\x9b\xfc\x19\x75\x68\x24\x56\x35\x3c\xd1\x16\x9b\x40\x8d\xd6\xac\x50\x30\xf5\xa5\xcf\x0b\x13\x09\x3a\x64\x3c\xc2\x8f\x9d\x18\xae\x9b\xf0\xc2\x74\x96\x2d\x09\xf2\x21\x3a\xd5\x9b\xa6\x95\x40\x68\x6e\xdd\xd9\x0a\xe4\x59\x09\x5c\xd3\x7a\xc1\x61\x95\x93\x1d\x3b\x7b\xc2\x14\x0f\x2c\xca\x6a\x92\xa9\x92\xf1\x42\xe9\x3f\x80\x3c\x49\x09\x8e\x53\x2e\x52\x7a\xc0\xf5\xd7\x8c\x23\x24\xe6\x19\x5e\xfd\x0e\xfc\x9e\x9c\x32\xc5\x48\x38\x02\x66\xc0\xde\x88\x51\xde\xff\xc4\x4a\xe3\xe8\x77\xd8\x47\xe1\x90\x8a\x36\xe3\x91\x79\xa1\x52\x45\x78\xa8\xe9\xa8\xff\x1d\x49\xe0\xad\x9b\x55\x9a\x32\x03\xc0\xa9\x3f\xcd\x62\xba\x00\xc5\x2f\x06\x6d\x1a\x06\xda\x37\xab\x0a\x1c\x72\x62\xf8\x8b\xc2\x46\x4e\x84\xc3\xcc\xed\x27\xcf\x24\x0a\x34\x93\x68\x7b\xa6\x15\x7e\xda\x7a\xd7\x0d\x5b\xaf\xa9\x25\x19\xc5\xf4\x8a\xd4\x0e\x8c\x47\x2b\xa1\x8a\xd9\x0f\x6c\xa5\xf3\x43\x0c\x2e\x5b\xce\x62\x9d\x0c\x6e\xff\x36\xda\x8b\xd4\xa9\x32\x7b\xd3\xbc\xeb\xfd\xb9\x83\xc4\xa0\xa7\x02\xae\x9a\xa4\xbc\x09\x84\x1e\xbc\xcd\x47\xa7\xba\xfa\x76\x7d\x4e\x29\x97\xc7\x1c\xd2\x59\xa3\x70\x2c\x8a\xc9\xd0\x72\xb2\x76\x2a\xbf\x6c\xf5\x9b\xd0\xba\xce\xd9\x55\x58\x3c\x9a\x73\xd5\x6c\x5c\xdb\xf8\xbe\x16\x2f\xb2\x8b\x10\x15\x13\x6f\x40\x13\x51\xd3\xab\x4b\xf6\x24\x91\x52\xf4\x8b\xab\x43\x99\xd7\x17\xe0\xd6\x7e\xef\xf3\xa5\x5d\xbf\x63\x0d\xda\x63\xbd\x17\x81\x8e\xb3\x11\x50\xfe\x35\x86\xf3\x25\xc0\x96\x78\xd4\xc3\xdb\xf8\xe6\x6a\x6b\xd5\xda\x1f\x29\xf9\x45\x63\xa8\xe9\xff\x77\xd4\x38\x86\xb1\xf9\x27\x13\x7c\xa4\xce\x89\x3a\xdf\xdd\xc2\x58\x0b\x9e\x80\x26\x7f\x8c\xd4\xbe\x67\xc2\x4d\xa5\xf8\xf1\x4c\xf8\x08\xaa\xd8\x55\x08\xe1\xcd\x82\x57\xc9\xf2\xd2\x44\x60\xc0\x70\x36\x12\xf9\x67\x05\x11\x85\x70\xb9\x91\x75\x03\x8b\x2e\xc0\xea\xe7\x37\xd9\x94\xeb\xdf\xe7\x70\x73\x4e\x91\x27\x85\xca\x54\x18\x3e\x2e\x13\xbb\x71\xa3\xe9\xd0\x2f\xa7\x38\xcf\xaa\x08\x75\x5f\x8f\x32\x6b\x56\xc7\xbd\x1d\x4f\xfc\x4d\x70\xd0\x70\x63\x32\xa6\x3d\x57\xa6\x28\x85\xa1\xdc\xc7\x9e\x4c\xe7\xc5\x5c\xa4\xb4\x1e\x84\x35\x34\x27\x9c\x49\x6f\x8f\xd5\x35\x2e\x0d\xbb\x60\xe3\x80\x78\x94\x14\xb6\xdc\x1b\x54\x97\x17\x86\xdd\xea\x4b\x41\xba\x04\x4e\x2c\xab\x1d\x1c\xf2\x1b\x79\x4e\x26\x52\x73\x28\x6a\xb5\xe2\xe1\x57\xf4\x63\x0f\x31\x35\xfa\x85\x61\x79\x13\x96\x5c\xfe\xe3\xa1\x0c\x77\xde\x70\x18\x5f\x03\xf5\x61\xf0\xc3\x0c\x20\x20\x25\xa4\xcc\x44\x88\x7e\x54\x65\xa6\xb2\xdc\x80\x3b\x97\xee\x4d\x2b\x02\x57\xfa\xac\x62\x60\xd2\x5b\xde\x45\xe7\x75\xcd\xd1\x27\xf7\xf0\x07\xba\x20\x71\xf2\x0d\xae\x3a\x5b\x54\xd5\x45\x18\x58\x4a\x97\x4c\xed\xaa\x15\xb2\x6a\xe4\x81\x6b\x17\x3b\x04\x2b\x1c\xbd\xde\x95\x91\xd7\x67\x92\x9a\x05\x43\x5b\x6d\xdf\xfa\x25\x7e\x2b\x28\x4e\x06\x3b\x70\x0d\xc3\x83\x71\xdc\xbf\xae\xfa\x5b\x04\xca\xb7\xc0\xd0\x66\x4e\xd7\x4e\x44\x90\xe3\x6d\xc4\x87\xf2\xb4\xb7\x10\xf1\xe6\xec\x51\x50\x02\xc0\x21\x7f\x6e\xe2\x2a\x66\xc9\xe7\xc6\xa2\x53\xb9\xe3\xd1\x59\xbf\x65\x3b\x4d\x48\xc6\x49\x65\x46\x7d\xea\x80\x0f\x37\x4f\x3c\xef\x3a\x36\x20\xa9\x34\x91\x73\xd3\x7b\x27\x4b\xb2\xa9\x8e\x07\xc9\xa7\xbb\x68\x29\x7e\x76\x47\x54\x33\xf6\x48\x6e\x2e\x8e\x7e\x68\x91\x3b\x81\x76\xcf\x26\xa2\x05\x71\xb6\xcd\x76\xd7\xd3\xba\x0f\x31\x1c\xec\x35\xd5\xd2\xf8\x32\xc2\x76\x5d\xbe\xba\xdf\x4b\x22\xcd\xe0\x08\x8d\x6b\x44\x4b\x17\xd5\xe7\x0c\x49\x8b\xaa\x95\x27\x30\x96\xa4\x12\xd8\x0d\x54\xc6\x53\x7a\x79\xb3\x9b\x1f\x8d\xf0\x0a\x23\x1a\xcf\x0c\xd7\x70\xf1\x0b\x27\xdb\xc4\x1b\x4c\xca\x18\xa3\x3a\xdf\x5b\x27\x27\x9d\x10\xd1\x23\x6d\x94\x0b\x9b\x98\x3b\x57\x0b\x82\x0e\x9b\xf4\x5c\xda\xa8\xbb\x03\xe2\x3b\x00\xe6\x3a\x84\x8d\xf8\xb0\xb0\x52\x8b\xe9\xa2\x3e\x94\x3f\xa7\xfe\x36\x6e\x90\xf0\xee\x88\xa5\x69\x5e\x0b\x22\xe1\xaa\x88\x27\xc1\x07\x70\x52\x44\x45\x6e\x10\x62\xac\x26\xcb\xaa\x57\x85\x50\x8f\x53\x73\x5c\xba\xec\x1a\x61\xb5\xc8\xcc\xd1\x09\xd8\xaf\x42\xd0\x35\xc7\xcb\x23\xc1\xb7\xcd\x27\x3d\x7f\x5f\x40\x43\xe8\x7f\xf0\x97\xbf\xfe\x17\x88\xac\xfe\x0b\x8d\xd0\x9c\xce\x39\x98\x2c\x07\x4d\xd5\x08\x1f\x02\x67\xae\x7b\xa7\xbb\xe8\xf1\xf4\x33\xe5\xd4\xc3\x14\x2f\x71\xfb\x04\xbf\x10\xf1\xee\xf3\x8f\xaf\x09\xc4\xfa\xa3\x1a\x0b\x26\x16\x73\x70\x6e\x3f\x9e\x20\x0c\xc4\xe9\xc3\x58\x65\xfa\x73\x86\x51\xc5\x0b\xad\x62\x70\x55\x24\xaa\xf7\x07\x06\x82\xdd\x51\xe3\x42\x1b\xc9\x3e\x15\xe6\x34\x7a\x61\xf5\x56\x14\x95\x06\x5d\x62\x92\x90\xfd\x52\x95\xd6\xfe\xe5\x98\xd7\x94\x0a\x0d\x87\x2c\x7b\x50\x61\xbf\xb2\x8f\x4c\x08\x2a\xdd\x82\x06\xff\x55\xd2\xdd\x62\xf7\x34\x66\x81\x3d\xc7\xa4\x2b\xb3\xf6\x0a\x3a\xab\x92\xff\xff\xf7\x0f\xb9\x6a\xc3\xb9\xf4\xbe\x69\xf9\x02\x0d\x4d\xe6\xb7\xdc\x8e\xb9\x66\xaa\x84\xfb\x61\x70\x8b\xfc\x51\x67\xa6\x9c\x92\x8c\x24\x70\xf4\x38\xd0\x87\xb0\x37\x3c\x81\xce\xc5\x87\x53\x8a\xe9\x6b\x5d\xe3\xac\x0f\xe6\xa4\xf4\xb6\x14\xa9\xce\x44\x58\x68\x49\xd5\xe4\x82\xe4\x3b\x76\x28\xf0\xf1\x9b\x2c\xa9\xe4\xf8\xb6\xf1\xba\x31\x0b\x43\x84\x3e\x04\x12\xb9\xc6\x3f\xfa\x55\x98\x45\xcc\x6c\x47\x2e\x92\xca\x23\xb1\x70\xbf\x3f\x32\xed\x10\x42\xfa\x0a\xa4\x0f\xf3\x28\x26\xd9\xba\xdb\x9d\x2a\x76\x93\x8e\x60\x9a\xae\x9c\x71\x80\x6e\xe8\x1e\xc9\x42\x84\x64\xbd\xe7\x5a\xcb\xfb\x48\x0f\x6b\x9e\x5d\x5b\x73\x6d\x03\xbb\xc5\x1e\x45\x20\x66\x6b\xb2\x02\x19\xaa\x37\x62\xe1\xf3\xf1\x9a\x94\x36\x9d\xb5\xfb\x31\x15\x44\x0e\x2b\x4e\xa5\xc2\x38\x64\x2a\x54\x45\x85\x6f\x95\x92\xcf\xc7\x5b\x21\xba\x39\x07\x75\xa9\x5e\x39\x0a\x86\x9c\x24\xea\xa5\x0a\xda\xf5\xee\xf9\x43\x0a\xd0\x45\xfb\xd6\xb1\xbf\x53\x9e\x72\x08\xd6\xfa\x96\xdb\x52\x6e\xb4\x21\x87\xe7\x2c\x53\x2d\x7b\x5f\x0c\xab\x00\xf7\x56\xce\xe2\xfb\xd3\xcb\x71\x40\xb1\x08\xd7\x4b\x51\x69\xcb\x1d\xfa\x0e\x05\xf6\x16\x51\xfc\x50\x0d\x58\xf6\x38\x77\x93\xeb\x05\x91\x70\x9a\xa1\xdf\x88\x5c\xdc\xfa\xa6\xba\x21\x3e\x65\x65\xed\xbc\x1e\xd1\x80\x77\xa8\xd3\x1c\x8c\x68\xf3\x3a\xee\x4d\xfc\xf8\xf8\x6e\x42\x20\xc0\xf1\x80\xc8\x42\xef\xee\xba\x58\xe0\x99\x4c\x85\xc6\x1d\xc4\x04\x61\xa2\x6a\x27\x90\x55\xb0\x5c\x35\x56\x88\x26\x08\x60\x38\x38\xe0\x74\xcd\xdd\x23\xd0\xcf\xf9\x80\x99\x51\xb6\x22\x3e\x10\x8a\x4e\x6f\x91\xe5\x62\x05\x0c\x03\x75\xea\x42\x17\x2d\xa6\x65\x43\xb7\x90\x2c\x16\xdb\xca\x61\x91\x8d\x49\xaf\xf0\x1b\x25\x60\x66\xe0\xf8\x3b\xc7\x4b\x9f\xb5\xe6\x11\x83\x7a\x63\x81\xff\x2b\x25\xf1\x25\x89\x76\xf3\x7e\xcf\x0a\x3b\x13\x32\x6e\xe9\xaf\xc4\xe5\xd7\x58\x42\x12\x16\x29\x15\x7c\xe5\xd0\x87\xc4\xca\xb3\x6f\x48\x1f\xe7\xf6\x6f\x90\xfb\x97\xa8\x01\x8c\x61\x06\xf0\x57\xe9\x8d\xb1\xa3\x83\xae\xa2\x97\xbd\xca\xa3\x83\x5e\x44\xf8\x88\x82\x2d\x2d\x8d\x04\x13\x06\x43\x88\xa1\xcc\xfe\x29\x82\x67\x13\x5c\x20\x89\x0a\x64\x38\x89\x4e\x64\xe9\x6b\x13\x37\x9c\x7c\xde\x31\x3a\x7d\xf1\x46\x84\x81\x3b\x74\xac\x97\x24\xa7\x76\x04\xe1\x83\xb9\x21\xd8\xe5\x52\x13\xd6\x02\x95\x0d\x59\xf1\xf3\xa0\xa9\x92\xd9\x32\x6c\xf2\xda\x9e\x2c\xc9\x85\x07\x3e\x0e\xb6\x61\xc9\xdc\x40\xcb\x47\x85\x4f\x67\x86\x9e\x44\xe0\xda\x65\xbf\x00\xab\x55\xf4\xb2\x17\x5c\x7e\x8f\x37\xec\x8e\x35\xea\x24\xfa\x75\x80\xd6\xaf\xcf\x7d\xe1\xda\xb5\x3e\xb6\xa1\xf8\xae\x83\x5c\xa0\x52\x31\xeb\x55\x0b\x68\xad\x2e\x34\xd5\x75\x6a\x75\xca\x98\x45\x2e\xb6\x87\x50\xbd\xeb\x4d\xca\xd0\xde\x3d\x47\xd1\xe7\x56\x55\xea\xe3\x6e\xd5\x64\x98\x9c\x25\x25\xea\x37\x73\x83\x76\xea\xdd\xea\x78\x37\xac\x8d\x26\xc9\x40\xee\x9d\x21\x58\xe4\x6b\xdb\x33\x3d\xf7\x02\xae\xd6\xd3\xbd\xa5\x29\x8d\xf1\xee\xaf\x48\xc6\x41\xd5\x44\x37\x77\xff\xf4\x7e\x0a\xe9\x3b\x75\xde\x0e\x49\x68\xfd\x58\x46\x6f\xaf\xd9\x27\x66\xd3\x64\xd0\x33\x7d\x02\x12\x72\xbf\x02\x01\xbb\x33\xb1\xdb\x22\x7d\xff\x75\x00\xe8\x16\x6b\xcb\xdf\x91\xe3\xa0\x14\x34\xfe\xdb\x7a\x9e\xd0\x30\x05\xa7\xb1\x76\x0b\x41\xb2\xa5\x4b\x9b\x0b\xf9\x03\xdd\x65\xa3\x88\xe0\xa8\xa0\xb9\xfa\x7a\x9f\xae\xe1\x57\x92\xe2\x04\x7c\x32\xca\xe7\xeb\x4b\x03\x5a\x6e\xb5\xfd\xa6\x25\x43\x3e\x2a\x4a\xbc\x8b\x59\xc9\xca\xe9\x0e\x4b\xf9\x7e\xfa\x55\x65\x3b\x71\x6f\xf7\x03\x31\x72\x9e\x57\x49\xe7\x92\x16\xe6\xda\x96\x7c\x1c\x71\xfb\xb0\xeb\xab\xad\x71\x06\x8b\xab\xb6\x35\x1b\x7c\x77\x10\x79\x1f\x79\x61\xbb\xa2\xd5\x06\xef\x34\xe8\xf9\x09\x0b\x9c\x7d\xf7\xc8\xe7\x0d\xc6\x54\x68\x12\x13\x5f\x1f\x0f\x32\x8c\xda\x53\xed\x05\xb1\xa4\xdd\xd7\x06\x97\xfd\x4d\x2b\xf2\x9c\xd3\xc4\x8b\x26\xf9\xb7\x90\xb6\x63\xe4\xed\x4f\x08\x32\xc6\xbe\xc2\xf8\x2d\xec\x00\xa2\x1f\x04\xcb\x1b\xdc\xd2\x02\x26\xfd\xbf\xa1\x4e\x6a\x92\x67\xe5\x12\x62\x06\x8c\x6a\x7f\xd4\x92\x6c\xd9\x45\xb1\x6d\xb1\xe1\x6c\xbc\x2e\x61\x88\x44\x41\x14\x3e\x32\x31\x95\x5c\x77\x4b\xf5\x93\x7d\x21\x50\xe9\x23\xea\x0a\xec\x9a\xf9\xc0\x3c\xc9\xf8\x02\xfb\xf4\x8d\x1d\x45\x5b\xf4\xbf\x50\x94\x9a\xe4\x2b\x81\x0d\xd0\x8d\xa1\x9d\x6d\x6b\x95\x88\x74\xbd\xab\xcf\x63\x76\xb0\xce\x7a\x5e\xd7\x97\x34\x9c\xb8\x70\x35\x12\x5d\xb6\xec\x5e\xde\x37\x9c\x5d\xbf\xbb\x82\x1d\x8c\x1a\xd6\x4d\x44\xd5\x82\xf5\x72\x3c\x07\xab\x85\xb6\xf1\x7d\xa0\xb1\xfd\x69\x29\xc2\xd5\x2f\x65\xd8\x09\x0c\xf0\xe3\xd1\xf1\x0c\x90\xc4\x03\x10\xd1\xa0\x8c\x5a\x1c\x57\x36\xee\xc2\x1c\x2f\x74\x20\x30\x0e\x65\x8e\x57\xcd\x6d\xe6\x00\x1b\x6e\xd4\x69\xa3\xfd\x51\x12\xd5\x14\x78\x76\x5c\x91\x22\x2d\x4b\x2e\xd5\x97\xd0\xce\xbd\x40\xbc\xd0\x73\x93\x65\xde\xf1\xa2\xdc\x3b\xd2\x4c\x34\x9a\x75\xdb\x87\x23\x1b\x5a\x38\x83\x71\xa3\x89\x72\xf9\xc8\x7f\xf1\xf3\x2f\x29\xf7\x63\x36\xa4\xae\x9e\x20\x67\x3c\x5b\x1f\xf0\xf7\xec\xe7\x4f\xcb\xca\x3a\x72\x7a\x37\x70\x97\x8e\x05\x12\x6d\x2c\x57\x79\x80\xb2\x78\x19\x70\x74\xf6\x0b\x27\x9a\x09\xd9\xec\x8b\xef\xb3\x53\x3b\xf8\x3d\x42\xce\xbf\x52\xa2\x2d\x31\x66\x97\xe7\x27\x71\x06\xb0\xec\x71\x8c\x5f\xd9\x0e\xf3\x7f\xc8\xd1\xb8\xf5\x3c\x25\xe0\xb8\xa8\x1c\xa3\xb1\x73\xda\xee\xa0\x65\x8b\x10\x72\xbc\x41\xa6\x0a\x2b\xac\xe1\xa3\xfc\xc4\xbf\xcc\xbf\x76\xad\x06\x31\xcf\x15\x8e\xf9\xed\x2d\x27\xb2\x45\x25\x5f\x3a\x91\x18\xea\x05\x4b\xae\x9a\x1a\x92\xf8\xa8\x04\xd5\xa4\x82\x3c\xfe\xb8\xa0\x7d\x1e\xc5\xb4\x3a\x95\xba\x19\xa4\x16\x59\x3d\x7c\x34\xb0\x76\xe9\x57\x29\xb8\xd5\x15\xcf\xd9\x2d\x34\xde\x1b\x75\xc0\x59\x28\xff\x54\x87\x50\xeb\x81\x8e\x43\x56\x2a\x29\xbf\xad\x54\x9e\x5e\xc2\x3b\xa0\xc4\x2e\x21\xbd\xfe\xdf\xa8\x45\xda\x66\x22\x2a\x24\x0d\x55\xe7\x08\x68\x8c\xf6\xdd\x3a\x83\xd2\x2d\x5b\x85\x27\xde\x18\xe2\xba\xfd\xe1\xdb\xc2\x30\x31\xbf\x1b\x28\xa0\x05\x79\x61\x89\x6e\x25\xf4\xab\x7f\x44\x5f\x90\xb9\xaa\x0f\x93\xa4\x58\xd9\x52\x32\x7b\xee\x0d\x31\x90\xe0\x16\xbf\x2b\xc3\x9f\x21\x63\x44\x2f\xf1\x78\x8f\x66\x04\x31\xc9\xd4\x84\x6e\x15\x2e\x41\x82\xe0\x06\x68\x5a\x2f\x2a\xd6\x68\xf0\x7d\xa2\x6c\xf3\x01\x81\x3c\x46\xb4\x73\x3e\x2d\x09\x09\xc9\xe0\x8c\xd0\x80\x4d\x9b\x75\xa5\x6b\x99\x3d\xb1\xed\xa6\x3f\x49\x1d\x9a\xc2\xe3\x6c\x8b\xb7\x96\xda\x32\x39\xa1\x05\x36\x3d\x74\x35\x3f\xc6\x1e\x01\xb9\x1e\xf0\x89\x6b\x7e\xbb\xe9\x68\xb6\x81\x05\xd4\x1a\xa0\x23\x7f\x79\xa2\x8b\xc3\xd2\xd1\x01\x15\x85\x0b\xed\x5b\x0a\x12\xd6\xc1\x6f\x8d\x16\xdc\x13\x79\x78\x01\x1e\xae\xce\x98\x90\x85\x74\xa1\x50\x89\x17\x41\xd4\xd5\x50\xd2\x8c\x67\x05\x72\xfd\x0c\xa7\xe5\x9b\x1d\xa3\x8e\xcd\xe4\x66\xb5\xe7\x31\x1d\x50\x0e\xd6\x31\xa7\x35\xcb\x10\x31\xdd\xb7\x57\x2f\x1c\x53\x6d\xf9\xfc\x1b\x41\xe3\x25\xc7\x56\x94\xf2\x42\x2d\x49\xa4\x5f\x62\x25\xb5\x14\xfa\x2c\xd8\xa5\xf4\xb6\xda\x5e\x39\x4b\x1e\x33\x55\x75\x16\x38\x1a\xc0\xa9\x6f\xd4\x82\xa1\xb8\x8e\xc4\x93\xeb\x8a\xf7\xf6\xf0\xb1\xd0\xc8\x4c\x1a\xdd\x48\x2f\x8c\xc0\xde\xb7\x4d\x6d\x2f\x01\x3c\xca\xbb\xb9\xbd\x6e\x10\x93\xd6\x9e\x10\x2a\x87\xf6\x8c\x0c\x93\x32\xe0\xdb\xce\x14\x72\xc5\x1c\x8f\x13\x8b\x40\x7c\x13\xc4\x0a\xab\xc7\x1e\xf6\x6b\xa1\x00\xa7\x23\x63\x3b\x3b\x87\x02\xc4\x2a\x0f\xb6\xe3\xaa\xb3\x7a\x5b\xad\x27\xba\x4b\x91\x3b\x2c\xb9\xcd\x7b\xac\x5d\x80\x11\x96\x35\x2f\xb3\x19\xfb\xe2\xa0\x24\x42\x01\x0c\xeb\xfb\x6e\x48\x56\x18\x96\x68\x3a\x39\xe1\xa7\x9b\x0f\x83\x6d\x00\x6c\x31\x4f\xab\xda\x08\xc1\x8b\x9a\x7b\xd0\xc8\x6d\xb2\x57\xda\x92\xee\x7c\xfe\xdd\xb7\x4d\x51\x31\xf9\x8e\xbf\xcd\x09\xfe\x26\x38\x27\x23\x9a\x04\x7d\xd9\x65\x95\x65\x5f\x8a\xb1\x0e\x7e\xc5\x5b\x8f\xc1\xcc\xf1\x88\x53\x80\x5d\x97\x7d\x2e\x8e\x87\xe2\x5d\x21\xae\x2c\xad\xb0\x8e\x55\xdd\xb5\x28\x0e\x70\x9f\xbf\x22\xbf\x78\xf0\xfa\xfe\x31\x3e\x63\x6f\x76\x47\x72\x55\x52\x6a\x98\x6c\xc5\xd8\x3d\x18\x86\xc8\xe9\x9e\x2b\x51\x6e\xcc\x30\x78\x90\x71\x7f\x66\x00\x32\x05\xeb\xe4\x7f\xa9\x68\xd4\xed\xa0\xd7\x21\xd2\x38\x95\x04\xf9\xd8\xf4\x77\xab\x3c\x51\x44\x89\x47\x27\x7e\xa3\x03\x0d\x4a\x27\xb4\xd9\x3b\x10\x4e\x45\xfa\x4a\x52\x9d\x84\x31\x3a\x90\xb9\xae\x83\x16\x08\xb8\x1b\x60\xf2\x2a\xc5\x4c\xa8\x9e\xce\xd9\x09\x73\x2b\x21\x0d\x5e\xd3\xd2\xa2\x8c\x98\x4f\x59\x8b\x98\xdc\xdb\x1d\x41\x0d\x50\x18\xb5\xa2\xde\x33\x6c\xe4\xbd\xc7\xb4\x5e\x56\x57\xe4\xab\xae\x3a\x67\xa8\x06\x68\x1c\x51\x4e\x90\x57\xd2\x88\xbc\x5f\x7e\x13\x34\x57\x99\x56\xd0\x4f\x57\xeb\x6f\x2f\xb5\x8e\x37\x3d\x64\xfd\x32\xdc\x8e\x8c\xbc\x64\x3e\x8f\x2b\x34\x4a\x44\x4c\x24\xc4\x59\x6d\x67\xaf\x9f\x79\xa7\x7d\x0c\xa1\xb4\xe8\xf1\xf2\x6b\xb1\x8a\xc1\x1a\x3f\xed\xff\x2c\x19\x17\xc8\x13\x82\xcf\xe3\x37\x9c\x2e\x1b\x55\xd8\x79\x8b\x99\x92\x27\x0c\xf3\xaa\x7d\xfa\xd5\x2a\x70\x94\x95\x3b\xe5\x94\xd1\xd8\x6f\xe5\x96\xd1\x4b\x59\x82\x97\x5e\x26\x45\x50\xc4\xc1\xbf\x23\x30\x87\xa5\xda\x38\x6a\x86\x70\xc3\xe6\x80\xca\x18\x7f\x4f\xb2\xb2\xdf\xac\x0e\x8a\xaa\x0c\xaf\x52\xc0\x6b\x31\x49\xff\x74\x2c\x57\xf9\x2a\x48\xb7\xf1\x87\xb8\x5c\x45\x3b\xe4\xae\x92\x33\x57\xbc\xa4\x64\x38\xee\xdd\xd8\x3a\xd9\x33\x1d\xa7\xc6\xac\x3d\xcb\x4a\xda\x7f\xfe\x9e\x99\xf8\x2d\xd2\xdc\xf0\xc0\xef\xa4\x3a\x13\xd5\x23\x2b\x73\x52\x12\xb5\xb4\x26\x42\x5d\xbe\x3b\x23\x97\xea\x3c\x23\x9e\x84\x46\x2f\x39\xcb\xb7\x69\x4d\xe9\x80\x46\xbc\x4b\x5c\x32\x43\x62\xa6\xbc\x64\x0d\x01\xba\xbf\x0c\x11\x03\xea\x40\x17\xab\x8c\x4d\xd7\x85\x03\x90\x76\xeb\x7e\xed\x6a\xb1\x35\x4e\x1b\x24\xf3\x00\xe0\xe1\x35\xf1\x36\x2e\x72\x75\x80\x28\xd0\x25\xa0\xe9\xa4\xaf\xc1\x4b\x6b\x43\x3d\x86\x59\x3e\x13\xdf\x02\xbd\x0b\xc6\x2a\x51\xc7\x06\xc8\x2e\xc1\xd8\xd1\xa3\xe5\xad\x15\xc6\x32\xca\x81\xd2\xe2\xa9\xa1\x56\x57\xbc\x37\xf9\x72\xcf\x93\xec\x86\xde\x1a\x1e\xfd\x2d\x74\x64\x47\xcf\xbb\xd7\x1d\x7e\x65\x76\xe0\xc9\xc3\x4b\xbf\xdf\x91\x04\x23\x49\x03\xcb\xed\x2f\x20\x1d\x31\xbf\xf1\xe5\xd3\x1d\x0b\xc0\x29\x9b\xd6\xac\xae\xb7\xe9\xed\x21\x88\x47\x20\xe8\x27\xdd\xd8\x43\xec\xa4\xc6\x91\xeb\x4d\xb3\xbe\x4b\x17\xb0\xa3\xb9\x61\xc7\x8d\x00\xeb\xe7\xbb\x6f\xfa\x97\xeb\xff\xce\x3c\xd6\xa0\x24\x25\xc9\x83\x24\x6a\x9e\x5d\x54\x28\xaa\xd7\x12\x22\x6c\x14\xf4\xa6\xeb\x4b\x1b\xa5\x3b\x0f\x49\x2e\xab\x9d\x19\xb9\xed\x8f\xa3\x20\x2b\x1e\xf1\xc9\x15\x3f\x06\x8b\xc2\xbf\x9a\x37\x6b\xc9\x12\xd0\xc8\x01\x28\xc5\xc6\x0c\xcb\x00\xb0\x6d\x67\x77\x66\x03\x63\x9b\xa0\x41\xdb\xae\x42\x67\x28\x61\xfc\x36\x68\x43\x32\xea\x1e\xa1\xd6\xbe\x44\x88\xb5\x53\xd5\x97\x1d\x78\xb9\x7e\x02\xf3\x71\xad\x27\x44\x8e\x2f\xee\xde\xf5\xd0\x15\xa4\x8c\x38\x48\x7a\x8e\x2b\x7e\x27\xd6\xab\x89\x27\xb7\xf8\xa6\x23\xff\xfb\x82\x1e\xd4\x48\x39\xde\xb2\x27\xbe\x00\x39\xe6\x7c\xe7\xd7\x3d\x79\x78\x5c\xa5\x33\xec\x5f\xe0\xc1\x0d\xd3\x14\x64\xc2\x34\x0b\x82\x94\xc0\x07\x15\x5f\x3e\xaa\x85\x1a\xb1\xca\x7e\x5e\xdd\x9e\x73\x56\x72\x98\xb6\xce\x84\x3a\x7c\x68\x02\xa9\x32\xa4\x85\x79\x70\x15\x76\x7b\x14\x74\xf1\xa5\x00\x50\xbe\xc5\x0c\x07\x5d\x88\x33\x2d\xb7\x09\x63\x16\xb4\x5f\x31\x0d\xb8\x52\x1c\xea\x5b\x2d\x1b\x84\xea\x44\xda\x2f\x94\xb4\xc2\xbe\xad\xf2\x65\xbb\x91\x01\xc3\x4e\x17\x50\x7f\x4c\xe0\xff\x2e\x99\x20\x1b\x78\xa0\xb3\x11\x2b\xed\xf7\xb7\xe8\x49\x01\x59\xcf\x35\x51\x96\xb9\x15\x19\xd7\x55\xb4\x47\x25\xd8\x2f\x35\x39\x59\x66\x48\xf2\x8b\x82\x36\xf9\xf6\x86\xfb\xc9\x21\x63\x1e\xaa\xa8\x50\x14\xe9\x52\xcd\xb2\xb6\xcf\xa5\x82\x65\x98\x37\x31\x1d\x8e\xf2\x2e\x1f\x3b\xca\x2d\x90\x31\xa1\xe1\x22\xdb\x7d\x4c\x00\x17\x65\x6b\x44\x86\xf5\x0e\x65\x4a\x8f\x92\x98\x05\x78\x32\xb7\xf7\xb0\x0f\xcf\xfc\xc0\x54\xe1\x06\x0b\x92\xde\x4d\x44\x39\x96\x3c\x69\x4e\x93\x9a\x05\xc2\x8f\x9d\x1e\x7a\x5f\xb8\x56\x8a\xf5\x37\xc5\x81\x57\xad\x77\x80\x66\x87\xfd\x66\x63\x1b\x87\x94\x54\x7e\x09\x52\x66\xda\xf8\x0e\xde\x55\x08\x4e\x2a\x0b\x8e\x71\x2c\x5e\x2a\x17\x84\x14\xb9\x26\x05\x77\xea\xb6\xc6\x0d\xed\x1e\x04\xd0\x52\x1c\x70\x97\x76\xc3\x97\x0c\xfd\xf7\x2f\x2c\xa4\x0a\x0e\xc2\xb7\x02\xb2\x77\xdc\xd0\xcf\xa5\xcf\xce\xde\xa3\xa8\xdb\x0e\x25\x8d\x8b\x2f\xba\x7c\x6d\x60\xe3\x36\xe6\x37\x56\x80\x80\xe2\x55\x50\x18\xb2\xbd\xe8\xe9\x74\x50\xa8\xdc\x10\xdc\xf1\x21\x85\x23\xa4\x89\x95\x70\x01\xcc\x82\x87\x18\x57\x5e\xd2\xdd\x96\x3c\xcc\x04\x7c\x38\x96\x03\x87\x60\x00\x33\xd8\xa9\x97\xc0\xd6\x50\xa2\x0e\x7a\x8b\x09\xb6\x95\x79\x2c\x1b\x5f\x14\x33\xec\x36\x54\x23\xb8\xb8\x3f\x5b\x91\x9b\x72\xb0\x56\x36\x2e\xaf\x06\xfc\xaa\x44\x00\xef\xec\x55\x39\x3f\x00\x89\x18\x85\x04\x58\x54\x6d\x2a\x50\xa3\xa6\x27\xde\x0e\x18\x5c\x6c\x42\xc1\xfe\x8c\xe7\x39\x2b\xa6\xf8\xd8\xc9\xa4\x14\x59\x11\x1d\x90\xeb\xca\xa1\x4b\x9b\xd1\x45\x6c\xa4\x34\x8f\x58\x15\xaa\xc3\x1c\x7a\x61\xb9\x73\x31\xec\xbe\x86\x2c\x92\x4c\x53\x31\x88\x6b\x83\x81\x04\xbd\x90\x36\xa6\xa8\xa8\x56\xa1\xd9\xc2\xc0\x67\x0c\x83\x27\xe6\x09\x87\xce\xd2\x99\x06\xc4\xdc\xce\xfd\x5a\x8d\x9a\xc5\x8b\x74\xc2\x3a\x28\x29\x52\x94\x4a\x2e\x30\x9f\xde\x49\x77\x53\x21\x3b\x70\x1c\x62\x2e\x54\x8d\x6b\xa2\xa4\xd7\x2d\x45\x65\x9e\xd7\xef\x2c\xf6\xd9\x62\x0f\xb1\x98\x15\x65\x15\xd9\xc6\xea\x52\x87\xd2\x2e\x8a\xc0\x54\xf2\x6a\x42\x65\x39\x03\x6e\xbd\x87\x16\xfd\x59\x4e\xb0\xa8\x5d\xdd\x2c\xab\x72\xea\xd2\x53\x41\x3c\x49\xf4\x77\xea\x76\x5a\xc9\x5a\x50\x1a\x1e\xbd\x28\x66\xd9\x70\x6e\xaa\xeb\x30\xca\xd8\x63\xaa\x51\xba\x6e\xb6\xf2\x42\x91\xa6\x60\x33\x49\xcb\x96\xe0\x12\x56\x46\x31\xf9\xe5\x93\x33\x18\xf5\x87\x6d\xfa\xfb\x3b\x38\x43\x17\xdb\x77\x59\xff\x0e\xe5\xba\x58\x6d\xb9\xc3\xef\x78\xee\x3c\x9d\x48\x63\x77\xa9\x82\x44\xe4\xb1\x25\x23\x0b\x6d\xb0\xa4\x92\x24\x57\xda\x62\x39\x52\xd7\x31\xeb\x86\x39\xa8\x29\xa1\x7b\xd5\x79\x45\x4d\xf3\xec\x56\x45\x3f\x2d\x6c\xb4\x61\xd3\x35\xf5\x0d\x0d\xa5\x06\xa4\x70\x8d\x27\x8f\x09\x60\x98\x96\xbe\x85\x43\xc9\xf8\x35\xe8\x31\xdc\x6c\x4d\x5c\x44\x85\xef\x93\x52\x28\xab\x80\xfe\x3b\x3d\xbd\x79\x4c\xdd\x49\xbc\x55\xae\x14\x7f\x83\x02\xb3\xf1\x6f\x13\x0e\x7c\x44\xba\x64\x5d\x4a\xf2\x3e\xdf\xf0\x62\x1e\x69\xb0\x54\xb2\x31\xdc\x99\x31\x21\x23\x1a\x37\xaf\xd8\x29\xfd\xa9\x38\x6e\xca\x36\xd4\xb7\x81\x39\xc2\x96\x7f\x93\x9f\x0a\x83\x25\x51\x04\x8a\xf3\xf2\x08\x50\xc1\x41\x17\x3c\x01\x0c\xd4\x47\xe9\xb2\x75\xfa\xc1\xf1\x0a\xc9\x7c\x6a\xad\x59\x63\x47\xc9\x18\x74\x2b\x88\x62\x36\x8f\x5b\xf5\xba\xbd\x93\xcc\xf5\x1a\x56\xc2\x91\x3f\x8f\xb3\x8c\xcb\x55\x0e\xd1\xa8\x8a\xc7\xe8\x60\x3d\xfc\x13\xde\x80\x3d\x30\x16\x15\xbc\x99\x48\x89\x59\x39\x15\x4c\x28\xce\x0e\x09\xb6\x12\xeb\x58\xb4\x4a\x5d\x8e\x81\x5e\x4c\x88\xd3\x01\xd8\x30\x06\x85\x74\xca\x53\x23\x88\xc0\xc0\x93\x1a\x09\xec\x0c\xbe\xcd\x3c\x6b\x93\x54\xfc\x79\xc1\x46\xce\x81\x2f\xce\x46\x96\x63\x85\x64\x3a\x75\x39\x73\x9d\x78\xb5\xe5\xcc\x28\xa0\x0b\xd9\x09\x9f\x97\x2c\xeb\x7d\x8b\xef\x7a\x26\x77\x30\xae\xcf\x5d\xef\x17\x0a\xd4\x4b\x27\xba\x4b\x9b\x3b\xbe\x35\xdd\x9a\xc6\xa5\xa6\x88\x59\xe5\xd9\xcd\x92\x63\x45\x26\x2a\x86\xe6\xe7\x73\x30\xa8\x18\xc1\x05\xc6\x21\xcb\xf0\x50\xdf\x2b\xad\xb1\x33\x68\xb9\x47\x8e\xd3\x2d\x64\xfd\x7b\xa0\x80\x62\x4a\x92\xbd\x37\xd6\x77\x9f\x5f\x3c\x08\x0c\x78\x9f\xa7\x3b\x5e\xa5\x8c\x7a\xfd\x4f\x55\x5b\x70\x5d\x1d\x56\xda\xa7\x08\x32\xf5\xfc\x11\x7a\x21\x4b\x74\x36\x15\xc2\xea\x5a\x32\x9f\x2f\x9f\x2c\x5a\x38\x40\x93\xd0\xcd\xe0\x28\xbe\xb4\x19\x23\x61\x20\xaf\x65\x77\x58\x50\xf3\xb8\x54\x96\x7e\xc0\xa4\xdb\x41\x42\xc1\x58\x25\x8a\x0e\x09\x80\xef\x22\xa6\x1d\xaa\xc5\xa4\x51\x12\x31\x31\xcc\x73\xdd\xd9\x01\x3a\xe8\xdd\xbd\xbc\xfd\x4e\x6f\xfa\x33\xce\x45\xbc\x94\xb5\x7f\x10\xed\x13\x1a\x5c\xee\xaa\xd3\x2b\xf3\x4d\xfe\xe4\x92\xd4\x15\x23\x7c\x64\x04\x8d\x1c\xca\x8f\x8e\x85\xad\x36\x07\x2d\x8c\x84\x6b\x24\xdc\x92\xef\x42\x51\xe9\x53\x8c\x6e\x21\x35\xeb\x8d\xba\xa1\x30\x43\x5e\xcc\xc0\xbc\x65\xaf\x8b\x89\xa1\x29\xca\xa4\x5a\xc9\xeb\x6d\x72\x05\xb8\xb9\xa6\xb6\x46\xf4\xfe\xfe\x22\xda\xb0\x77\xac\xf2\xdd\xf4\x9f\x6a\x1d\xcd\xb0\xfb\x94\x65\x92\x75\x99\xd2\xd1\xe4\x0a\xc7\xd3\x55\xd5\xd1\xb2\x8c\xea\x7a\xc6\xba\x8e\xb5\xc8\xc9\x2d\xaa\x54\x25\xd1\x20\x93\xbd\x44\xd8\x33\x02\x9e\xc3\x74\x19\x6c\x9e\xa1\xdb\x8e\x6e\x5c\xc3\x7a\xb7\xc7\x9a\x66\x80\x76\xc2\xb3\x39\x38\x84\x3b\xc3\xb5\x17\x87\x56\xd3\xc3\xbc\x5a\x2e\x8e\x54\x4a\x03\x0e\x64\xd6\x81\xc9\x22\xe7\x08\x18\x11\xfa\xb4\x2b\xaf\x28\x0b\x98\x1f\xda\x0a\x2b\xfc\x5d\x38\xe0\x4c\xa3\xbd\x3a\xc2\xcc\x1b\x70\xb0\xca\xc8\x57\x3e\xbd\xd6\x54\x86\xdd\xe7\x29\x59\x8f\xd1\xa5\x8c\xdf\x65\xf4\x4d\x72\xc0\xb9\xbc\x01\x96\x56\x50\x58\x8c\xac\xfd\xca\x54\x9e\xda\xd3\xe2\x6b\x3c\x33\xd4\xb3\x7f\xa7\x6f\x31\x76\x3b\x90\x72\xdc\x5d\x4c\x8f\x5c\x54\x07\xfd\x8d\xf4\xaa\xd4\xb3\x19\x3b\x3c\xdc\xc5\x32\xdd\xc1\x95\xde\xe8\x0a\x26\xb3\xf2\xa9\xe8\x1f\xe5\x41\xe3\xc6\x25\xdf\xcf\x5a\x53\x9a\x15\x1a\x2e\x61\x1b\xf2\xa0\xbf\xab\x4d\x7e\x61\xbc\x64\xf7\x58\x8c\xc9\xdc\x2b\xdd\x6d\x1d\x9f\x2f\x9d\xc1\x6f\xb7\xff\x3a\x82\x54\x9a\x9c\x2a\x4d\x2c\xe7\xf4\xe1\xa8\xc2\xda\xc1\xc4\x60\xe6\xd4\x78\x8a\x4b\xae\xb9\x27\x11\x99\x30\x68\xb1\x8f\xa3\x61\xc7\xb3\x71\x4a\x32\xdf\x57\x8c\x95\x64\x1a\x04\x12\x7e\x80\xa0\x9b\x2c\x93\x93\x4f\x0b\x9e\xd1\x77\x70\xac\xde\x1f\x2b\x49\x39\x41\x46\x6a\x01\x13\xbc\xb9\x44\xa0\xfc\xf0\xe7\xce\xd4\xc5\xa8\x05\x28\xc1\xed\x28\x4f\x07\xee\x30\xc4\x9c\x5d\x38\xdb\x71\x02\x38\xc9\x87\x6e\x96\xbb\x19\x3f\xce\x0f\xe5\x48\x15\xa4\x3b\xbd\x73\x0d\x05\xeb\x14\xa2\xed\xc4\x6e\xa6\xb8\x03\x78\x2e\x43\x9e\xf2\xd0\x63\xdb\x60\xfd\x4e\xfd\x50\x55\x39\x15\xdc\x75\x7e\x5c\xd1\xb5\x01\x9f\xc5\xf6\x12\xe8\xa7\x26\xa5\x6d\xf0\xde\x4e\x32\xea\x35\xa9\x90\x9f\x63\x96\xe7\xaf\x74\x06\x1d\xc6\x91\xbb\x91\x0d\xb0\x85\x3f\x13\x53\x69\x51\xe3\x03\x4d\x53\x82\x0e\x0d\xf8\x61\x97\x09\x91\x7b\x6c\x37\xec\xfa\x94\xbe\xb8\xce\xbc\x8a\x3c\x92\x86\xdd\x09\x68\x80\x9e\x51\x8c\xdd\x11\x70\x7f\xf0\x89\x56\x03\x48\x30\x8f\xa0\x3d\x83\x0d\x71\x1a\x8f\x8f\x16\x60\x3e\x7e\xd2\x45\xd4\x62\xac\x91\x85\x49\x35\x1c\xac\xff\xf7\x34\x7c\x4d\xe1\x2a\x98\x37\xd9\x01\x08\xe3\x88\x90\xa5\x3c\x03\xf4\xaa\x48\x9d\xb3\x26\xc2\xe5\x55\x65\x56\x71\xa8\xf6\xad\x1b\xda\xff\x7c\x0a\x7e\xd3\x38\x2a\x57\x71\x6b\xb2\xde\x96\xd4\xae\x56\x56\xbb\xe4\x19\xee\xce\xf0\x81\x0b\x4c\xac\xea\xd2\x00\x2d\xb6\xca\x49\x95\xd6\x41\xd7\xd1\xdc\x8f\xb2\x25\x59\xc5\x31\x86\xff\xe6\xaa\xb0\x05\xea\x3f\xb3\x72\x09\xdf\x90\xa6\x9a\xd5\x50\x8d\x43\xd8\x17\xc6\x59\xb4\x38\xbb\x25\x55\x9a\x5f\x50\x57\x58\xd0\xc7\x62\x2c\xb5\x7a\xac\xd7\x68\x85\x9c\x74\xa2\xbd\xc9\x2b\xc2\x0c\xc4\x92\xb8\xa8\xfb\x96\x6e\x49\x76\xf7\x5e\xe8\x43\x7b\xf0\x57\x6c\x6e\x34\xd9\x22\x65\x63\x71\x80\xe9\xf8\xa3\x31\x6a\x66\x8f\x46\x8f\x65\x48\x6c\xd8\xc9\x5a\x6f\xfb\x0a\xdc\x62\x07\x2e\xc9\x31\xbf\xbc\xc2\x1b\xab\x1e\x48\xb7\x7b\x90\x43\xb9\x7a\x96\xaa\xac\xa3\x7d\x8e\xf9\x87\x08\x8a\xe5\x57\x4e\x22\x5e\x22\x3b\x14\xed\x26\xe3\x10\x32\x1d\x85\x16\x4c\x1f\xd1\x2c\x79\x6e\xbf\x5a\x9d\x31\x77\xf4\xba\x0e\x75\x31\xba\x2d\xc2\xe4\x00\x47\x90\x5b\x06\xee\x2e\x56\x5d\xbc\x39\xb8\xff\x64\x74\x3e\x5f\x32\xea\x0f\xa4\xce\x8c\x37\xe7\x1c\x31\xdf\x5f\xea\x9b\x1c\x13\x14\xea\x27\x57\x4a\x68\xef\x77\x08\xde\xe2\x79\x30\x40\xd6\x99\x23\x64\x25\xed\xa3\x5c\x4e\xdf\x75\x50\x9e\x0a\x8e\xfc\xeb\xc6\xb2\x6c\xff\xdb\xe2\xb1\xd1\xcc\x32\xb0\x31\x3a\xaa\x9a\x66\x46\x87\x2d\xc9\x5a\xb3\x08\x9e\xde\x37\x2e\xe3\x30\x43\xf6\xbe\x7a\x43\x78\x11\x9a\x0e\xcb\xaf\xd5\x22\x0c\x0c\x4d\x9c\xbf\x60\x1c\xa4\x78\x2d\x7a\x07\xca\xaa\xd4\x20\x1b\x20\x22\x76\x12\x33\xd9\x13\x0a\xfc\xc4\x34\xf9\x71\x19\x4d\xe3\x8b\xf2\xe9\x0d\x73\x52\x12\xbb\x04\x86\xe7\xf2\x7b\xf9\xca\xad\xe0\xdd\x02\x37\x40\x89\x07\x42\x65\x80\xe0\x65\xb3\xd8\xc3\xc1\xce\x24\xe7\x50\x35\xc4\x91\x6c\x73\x22\x0f\x8a\x5c\x88\x69\xba\xa3\x44\xa5\x57\xed\xae\x56\x90\x8a\x87\xcf\xc8\xf8\x3d\xde\xe7\x8f\xfe\x4b\x7f\x9e\x79\xae\x10\x4c\x5d\x97\x6e\x4e\xa8\x00\x39\xe4\xc8\xd5\xb5\x6a\xa1\x81\xb7\x7a\x00\x6f\x61\x0c\x1c\xea\x36\xce\x24\xfe\x90\xb5\x5e\xaf\x66\x01\x8e\x9f\x7c\x21\x12\xe8\xe1\x0c\x77\xf4\x58\xaa\xb4\x9e\xae\x68\x6a\xff\x0d\x06\xbd\x5a\x75\xc9\x36\xe7\x04\xb5\x50\xba\x84\x6d\xf0\x9d\x1f\xd1\x25\xb8\xfc\xe8\x4f\x09\x1f\x07\x0d\xf5\x4d\xe2\x61\x91\x17\x33\x42\xc0\x29\xef\x11\x45\x99\x39\xde\x1a\xc8\x9a\x53\x43\x39\x69\x91\xe2\xaf\x54\xe5\x74\x72\x42\xfb\x94\xdc\x95\xf6\x0d\x0a\x06\x3f\xca\x09\xbf\xc9\x45\x40\x6d\x1a\xca\xbe\x4d\x02\xc5\x0d\x6b\xd6\xea\x1f\xef\x46\xba\xd4\x24\x98\x8a\x1e\x3b\xd9\x91\xd2\xd2\x24\x0a\x62\x08\xb3\xe6\x36\x42\x3c\x3b\x01\x8b\xe2\xb8\xe9\x77\xb5\x2e\xd7\x52\xfa\xf3\x3b\xd9\xaa\xaf\x0d\x76\x93\x5f\xc6\x42\x45\xa7\x86\xfc\x29\xfa\xdf\x69\xe1\x22\x58\x0e\x23\xc3\xc3\x91\x62\xb9\x5e\x2d\x7c\x5e\xc7\x9d\x3b\x52\x82\xc2\x40\x1a\xf7\x76\x55\x6d\x32\x32\x76\x43\x65\x4f\xc2\x92\x97\x14\xf0\x16\xfe\x99\x3e\x8a\xbf\x3e\xb0\x1a\x00\xd2\x85\x28\x34\xd2\x0f\x3f\x0c\x57\x77\x00\xe0\xf0\xba\xbc\xb1\x6e\xfb\x1a\x50\xf3\x3a\x02\xc2\x96\x69\x4c\xd2\x24\x0d\x36\x56\xc8\x5a\x4d\x7e\x87\x43\x13\x3f\x40\xb0\x9f\x1a\x1f\xc8\x16\x6f\x52\xbd\x22\x91\x48\xe2\xf7\x0f\x3e\xc2\xc3\x99\x18\xc0\x7d\x58\xa8\xbe\xcd\xb9\x1d\xdf\x79\xb6\x39\xad\x5e\xed\x18\x58\x3a\x0d\xac\x8b\xcc\xf5\x48\x7a\x5d\xda\x7b\xdd\xc3\x63\x3d\x21\xe1\x0c\x5c\xc6\x88\x5f\x0d\x01\xef\x4b\x72\x5e\x00\x20\x81\x50\xa7\x9b\xbe\x73\x8f\x22\x45\x41\x91\xe9\xa3\x8e\x3c\xc3\x7e\xbb\xb6\x3c\xeb\x4d\x8f\x4d\x3f\x71\x5a\xa8\x3c\xd8\xb1\x6b\x42\xe4\x78\x21\x70\xa9\x08\x6e\x7a\x1a\x9e\x38\x85\x42\x6d\x88\x48\x29\xb3\x84\xe0\x82\x92\x50\x72\x22\x9e\x3b\x87\x04\x04\xb0\xfb\xb4\xb0\xe2\xbe\x79\x0b\x5d\x58\x22\x97\xea\x98\x42\x18\x6d\x9b\x2a\x0b\x76\xd5\xb7\x8d\xdd\xdc\xf0\x04\x0d\x4b\x58\x2c\xa8\x82\x19\xb5\x64\x8f\x9a\x84\x8d\x46\x46\xea\x21\xfd\x88\xc2\x9d\x85\x2f\xcc\xd7\x88\x52\x56\xb4\x25\xc2\xff\x6f\x31\xa3\x66\x8b\x6f\x1b\x77\xf1\x6f\x4d\x79\x20\x27\xbc\x4b\x8f\x95\xc9\x80\x24\x04\x95\x34\xa5\x67\x30\xdf\x22\x26\xb9\xc2\xf9\x44\x8b\x41\x36\xb7\x23\xa7\xf1\x73\xe5\x2f\x60\x8b\x26\x19\x75\x6b\x49\xae\x06\x2a\x45\x64"
+
+// pedersenPrecomputedTable holds the GePrecompute tables of PedCom.G encoded by CachedGroupElement.ToBytes
+const pedersenPrecomputedTable = "\x85\x3b\x8c\xf5\xc6\x93\xbc\x2f\x19\x0e\x8c\xfb\xc6\x2d\x93\xcf\xc2\x42\x3d\x64\x98\x48\x0b\x27\x65\xba\xd4\x33\x3a\x9d\xcf\x07\x3e\x91\x40\xd7\x05\x39\x10\x9d\xb3\xbe\x40\xd1\x05\x9f\x39\xfd\x09\x8a\x8f\x68\x34\x84\xc1\xa5\x67\x12\xf8\x98\x92\x2f\xfd\x44\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x68\xaa\x7a\x87\x05\x12\xc9\xab\x9e\xc4\xaa\xcc\x23\xe8\xd9\x26\x8c\x59\x43\xdd\xcb\x7d\x1b\x5a\xa8\x65\x0c\x9f\x68\x7b\x11\x6f\x49\x15\x98\xf1\x74\x61\xcb\xd2\x07\x30\xd0\xec\x8b\x34\x37\x43\x16\xc0\xd6\xff\x8f\xfc\x9b\x65\x38\x88\xa0\x8a\x64\x59\xb6\x71\x2d\x56\x76\xf8\x9a\x36\xa3\x67\x31\x45\xfa\x55\xf2\x6e\xd6\x07\x8d\x03\x30\xfc\xd9\xbb\x7d\x26\xea\x8a\xd4\x52\xb4\x21\x27\x62\xe4\x69\xba\x9f\x9d\xc5\x9b\x5f\x27\x23\xd5\x49\x04\x3c\xcf\x58\xc9\x02\x24\x9a\x15\x0c\x4e\xb9\x42\xce\x3a\x08\x45\x1f\x10\x50\xdb\x9b\x6d\x60\x6a\x30\x16\x61\xa3\x4e\x34\xef\x6d\x27\x19\xda\x06\x03\x22\x7f\xd9\x54\xec\x87\x44\xb0\x0e\xc8\xc5\xa6\x1e\x77\x7b\x81\xb6\xb1\x06\x67\x4f\x69\x50\xfb\xec\xcd\xcc\xb9\xb9\xc0\xd8\xb3\x49\x92\xf9\xcf\x95\xeb\x34\x05\x3b\x69\x33\x66\x82\x5b\x9a\xf4\x72\xc0\xbf\x81\x5b\x98\x58\xb1\x16\x4b\xec\xdf\x78\x36\xa6\xf5\xf4\xc1\xdd\xc2\x4a\xff\x09\xab\xe2\x6f\x85\xc2\x5d\x17\x0f\x44\x01\x5e\xea\x30\xc4\x35\xa2\x7f\x69\x71\x64\x62\x20\x8c\x25\x4e\xb1\x67\xff\x58\x84\x1d\x19\xf5\xad\x5c\x04\x0f\x3c\x37\xa2\x37\x11\x96\x54\x4c\xc3\x00\x5b\x02\xcb\x2a\x84\x1e\x71\x04\x01\x25\xcb\x0c\xf0\x60\xa5\x16\xa1\x13\x45\xab\x60\x0a\xd4\x43\xe9\x95\x13\x4b\x38\x14\xa0\xbe\xf0\x16\x9d\xd4\x17\xdb\x99\xc0\xbc\xc8\x5d\xba\xc4\x2a\xd0\xe2\x45\x24\xe9\x14\xca\xf0\x4e\x42\x6a\x6a\x94\xd3\x9b\xcc\xc6\xa4\x23\xd6\xac\xcd\x8a\xcf\x90\x0b\x25\x58\x6f\x14\x07\x3a\xa5\xcc\x0c\x0a\x1c\x46\xd5\xce\x4c\x5a\xe3\x84\x54\x0c\xd6\xf4\x2f\x74\x83\xf8\x78\x6f\x04\xb6\xdb\x83\xe1\x14\x7f\x04\xdc\x8f\xfb\x74\x12\x64\xdf\xa0\x2e\x6e\x07\x24\xbf\xfc\x65\x63\xf5\x8d\x60\x7f\xa0\x89\x6a\x02\x90\x8b\x43\x1b\x48\x44\x86\xfe\x6b\xa3\xf3\x1a\x44\xb7\xdf\x3d\xaf\x88\x4b\x63\xe9\x45\xeb\x81\x60\xb5\x31\x7d\xf0\x30\x0e\x9b\xd6\xc6\x7a\x28\x49\xb6\x62\x60\x79\x7a\x50\x9f\x37\x7c\x1c\x74\x3a\xc3\xee\x1e\x99\x4b\xcb\x2d\x35\xec\x55\x1b\xb5\x1f\xd8\xcb\x30\xd2\x2a\xbb\x77\x25\x1e\x5a\x5a\xf1\xc6\xff\x33\xd0\xde\xb4\x29\xaa\x07\x52\xdb\xb4\xba\xed\xd0\xa2\x21\xcf\x01\xbf\x40\x92\x85\xa8\x9b\x14\x6b\x9f\x36\xe1\x9f\x68\x22\xbf\x58\x8f\x1f\x00\xd4\x83\xe8\x5d\x23\x8b\xfa\x11\x25\x05\x87\x18\xdd\x62\x05\xd5\xe5\x35\x05\x9c\xed\xa9\x4d\xaf\x88\x2a\xb0\x25\xec\x27\x35\x1e\xad\x6f\xa6\x62\xdc\xc1\x39\xc7\x2b\xd2\x3c\x28\xf1\x4e\xa3\x69\xa2\x8d\x29\xef\x14\xbe\x2e\x19\x22\xb9\x7a\x1d\x67\x9a\x95\x2b\xf9\x37\x64\x7b\x2c\x40\xb4\x0f\x5b\x59\x29\xa3\x02\x24\x1d\x64\x2d\xa1\x3a\x70\x59\x70\x83\xf1\x59\x7a\x87\x6e\x43\x5e\x9f\x99\x59\xab\x5d\x59\xc4\x1e\x46\x5c\xba\x8a\x46\x26\x52\xa1\x20\x27\x95\x8e\x7e\xac\x83\xfe\x94\xff\xa0\x36\x7c\x8f\xf1\x61\x9d\x95\x12\x5d\xaa\x4f\xba\x45\x19\xdc\x25\x39\xe1\xa7\x93\xea\xfa\x8c\x2d\x1f\x95\x54\x77\x5f\x1c\xd5\xe0\x10\x7f\xb5\x52\x1e\x8d\x0f\xf1\xa6\xb4\x6d\x0f\x92\x7b\x5a\x63\xaf\x7c\xc3\xfe\xbe\x61\x11\xa9\x01\xd3\x57\xe0\xd7\x00\xde\x82\x38\x05\x5b\x36\xef\x0f\xa9\x85\xba\x78\x42\x7a\xee\xa6\x50\x19\x82\xbe\xdf\xc0\xdf\x8b\x40\xc1\xb0\x25\x43\xed\x44\x33\xef\x79\x69\x04\x28\xf1\xe4\xb4\xb0\xc0\xac\x60\x0b\x0f\x80\xad\x1b\x78\xc3\x12\x81\x30\x95\xc4\xb8\xb0\x7f\xa0\xcc\x51\xab\xbd\xc4\xc9\x5f\x10\x7d\x71\xa2\x95\xca\x71\x40\xde\x53\x49\x96\xa5\x42\x23\x53\x2b\x19\xed\xa0\x78\x9e\xfd\xed\x89\x0d\xef\x4e\xd8\x5b\xf3\x7c\x1c\x96\x02\xdd\xf4\x3e\x3e\xeb\x9a\x29\xa3\x4e\xfd\x68\xde\x8f\x2b\xdc\xa5\x68\x9b\x5a\x85\x00\xb3\xad\xcd\x6d\x5b\x01\x47\x7b\xd9\x19\x60\xfd\x0e\xbd\x3c\x41\x7e\x18\xd9\xdc\xc5\xb0\xfd\xf2\xa0\xc4\xbb\xdc\x5c\x0a\x55\x25\x5c\xc1\xa4\xe9\xbc\x6e\x85\xec\x73\x7d\x92\xf8\xc9\xa9\xcc\xcf\x8d\x55\x46\xc2\x9e\x39\x3e\x81\xcb\xac\xb0\xe3\x55\x45\x3e\x61\xfd\x4b\x8d\xaa\xd4\x61\x8e\x8e\x0c\x16\x1e\xde\x19\x1c\x00\x4e\x41\x06\x0d\x83\xaf\xe3\x6d\xa4\x48\x7a\x8e\x74\xa4\x04\xd0\x1d\xd0\xb3\xe9\xb4\x0c\x46\xb2\xca\xa0\xd9\x01\x19\x67\xf5\xd7\x43\x90\x04\xde\x9b\x00\x66\x51\xf6\x6a\x95\x0e\xe6\x21\xfb\x3a\x89\x8b\xac\x54\x88\xb8\x03\xb8\x80\xe4\xde\x52\x42\xe1\xe2\xc6\x75\xb8\x37\x77\x12\xd9\x96\x51\xdd\x58\xc7\x2f\x94\x69\x40\x74\x35\x35\x5c\x66\x79\x73\xe4\x81\x83\x0f\x43\x05\x4e\xdf\x74\x2b\x7d\x8d\x1d\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x20\xd8\x4e\x03\xb7\xc6\x53\xc0\xfe\x44\x9d\xa6\xb4\x09\xfc\xb5\x20\x37\x80\xa8\xb2\xe7\x65\xd3\xc7\x49\xcf\x81\xbf\xd9\x22\x66\x0f\x1a\x2a\xf2\xf5\x6d\xf3\x27\xc7\x3b\x04\x14\x71\xb3\x18\xcb\x6c\x17\x5c\x9f\x5a\x4a\xd5\x76\x2f\xb2\x01\x03\x47\xfd\xe6\x1e\xf2\xce\x40\x38\xab\xb6\xd8\xa1\x47\x5c\x94\xde\x15\x4a\x00\xf0\x10\x77\x40\x41\xe7\x17\x4b\x2b\x1c\x3d\x84\x05\xfe\xc9\x2a\x33\x52\x81\x80\xb3\xfc\xee\x73\xfb\xd7\x6e\xba\x62\xfc\x5a\xf5\x95\x2b\x14\x80\x95\x00\xb7\xe2\x36\x34\x62\x21\x3e\xfb\xad\xd2\x56\x4e\xb4\x13\x16\x00\x6b\xaf\x11\x42\xab\xcd\xc5\x8f\xe6\x3e\x6b\x0b\x62\xf0\xe2\x29\x39\x2d\x2e\xd4\x73\x4f\xaf\x59\xf7\xe2\x2f\x95\xd6\x7f\x1b\xad\x0f\x29\x5b\xa7\xe6\x95\x4f\xc1\x92\xdb\xe9\x33\x18\x23\xda\x3c\x0e\x41\x46\xeb\x4c\x66\xa9\xbf\xf5\x60\x1d\xad\x17\xfc\x26\xe8\x22\x17\x3c\x71\x7b\xc8\xf6\x81\xb3\x6f\x69\x66\x81\x78\xd0\xcd\x6d\x9e\x18\xa0\x4f\x98\x97\x7a\x43\x8a\x44\xc9\x65\x09\x4b\x52\x43\x8b\xfd\x41\xe2\x93\x5d\x14\x21\xf4\x0e\xc3\x35\x99\x2f\x3e\xd0\x54\xbc\xa3\xfc\xb2\xd9\x8b\x2a\xd1\x7d\x4c\x0c\x2e\xb5\x7d\xa8\x98\x28\x30\x83\xb3\xce\xf2\xea\xf1\x4e\xb3\x6d\x93\xf4\x8c\x91\xd4\xbf\x50\xdb\x61\xe7\xaa\x8b\x3f\x32\xcf\xaa\x5b\x7e\x72\xdd\x03\x87\x2a\x1e\xc4\xc2\x82\xef\xe1\x8e\x7d\xf2\x7f\xf6\xb4\x53\x76\x5b\xcf\x3d\xa4\x8b\xb6\x9b\x02\x37\xed\xa3\xd4\x10\xd7\xc1\xf5\xfe\x2d\xbc\x48\x93\xfc\x59\xb0\x0b\x49\x26\x1e\x83\x66\xdc\xee\xd1\xc8\xf2\xe7\xf0\x4f\x09\xcf\x2b\x4e\x4a\x84\x63\xcd\x2e\x9b\xe0\x8c\xca\xe2\x24\xd2\x37\x0d\x5a\x63\x8e\xc9\x07\x38\x79\x88\x19\x6a\xa1\x82\xc8\x92\xc2\x1a\x06\xa8\xab\x9c\x0c\x90\x9f\x08\xc8\x0d\x70\x69\x20\x4c\x7b\xe2\x72\x2f\x09\x4c\xd0\xa8\x2a\xc9\xef\x16\xc2\x18\xd9\x0a\x5d\x13\x09\x5d\x00\xc0\x62\xf6\x7b\x1c\xfc\x0a\x16\x0e\xd0\x65\x7a\x27\x90\x1f\xb9\x30\xa4\x75\x48\xec\x38\xd8\x6b\xab\xe0\xfe\x2f\xc1\x0a\x5f\xf5\xb0\x5e\x34\x72\xc8\xae\xce\x20\x16\x01\x1c\x4c\xc0\x3e\x94\x5b\x18\x58\xad\xa9\x98\x52\xda\x8e\x2c\x17\x57\xf2\x48\x6e\xe3\xe3\xe6\x8b\x75\xbf\xa3\xda\x18\xda\x7b\x4e\xe5\x52\xee\xb0\xc4\x92\x25\xca\x99\xd7\x8a\xed\xc9\x14\x7b\x1b\x6a\x4e\xf5\x6d\x70\xab\xce\x74\xf9\x7c\xb2\xc7\x1a\xa0\xfd\x16\xe2\x4f\x4c\x53\x34\x26\x84\xf4\xa5\x87\xd8\xe4\xe1\x1f\x14\x37\x32\x82\x65\x47\x6c\x6a\x0f\x3c\x15\xec\x54\x8f\xbd\x32\x0d\xed\xdf\xe9\x9d\xb6\x3e\x3f\x2b\xda\xcd\x8a\x1d\x40\x63\xe8\xa7\xec\x16\x35\x75\x57\x55\x69\x9e\x98\xfd\xb7\x20\x8f\xcc\x6e\xbb\x07\x52\x8b\x77\x24\x2f\x9f\x39\x35\x9a\xa8\x8e\xbe\xef\x3d\xc5\xaf\xa6\x0b\x28\x38\x41\xeb\x96\xc4\x1a\x7f\xda\x24\x72\xc4\x53\xd4\x1f\xd3\xf8\x3d\x8a\x96\x7f\x7a\xde\xde\x7c\xd2\xf9\x33\x03\xeb\xf6\x6c\x95\x63\xcb\x8e\xf2\x16\xc8\x7b\x7b\x95\x2c\xaa\xb9\x5a\xe8\xca\x45\x92\x4a\x25\x5f\x26\xbc\x90\x81\x48\x41\xcc\x60\xa8\x0b\x79\x48\x48\xc3\x5f\x2b\x4b\xba\x4c\xfa\x5c\xba\xcd\x21\xf6\x24\xf2\xb7\xdb\xe2\x55\x07\xfe\x39\x1f\x4e\x88\xb2\xdb\xc3\x3d\x85\x65\x88\x27\x6c\x3a\xef\x9f\x07\x45\xfd\x06\xd1\xa7\xd2\x99\xb8\x6f\x49\x20\x50\xa3\x28\xe0\x16\xe9\x92\x9d\x5a\x37\xfc\x92\x94\x20\x3b\x69\xf5\x87\xcd\xa2\x60\xae\x84\x9f\x47\xda\x7b\xca\x0e\x90\xcd\xe3\x2b\xc4\x7f\x33\xa8\xda\xcc\x4d\x29\x0e\x7a\x6a\x1b\x7e\x66\x2e\x8e\xd4\x9f\x68\x2c\x0f\xe9\x3d\xe0\xfa\xe8\x95\x95\xa1\xbe\x8b\x40\x4f\xc4\x23\xac\xe0\xe9\x50\xf9\xfd\x2e\xa8\x73\xe2\x6f\x5f\xde\xd9\xdf\x83\x89\x7f\x68\xb9\x65\xe0\x65\x5e\xbd\xfc\xeb\x5b\xaf\xe4\x1a\x52\xb3\xaf\x7b\xcd\xf0\x9d\xd1\x2b\x5d\x0d\x4a\x51\x3e\x0c\x1e\xbe\xde\xae\x52\x0a\x83\x7c\x24\x5e\x45\x6d\x67\xda\x26\xfe\x65\x89\x7d\x02\x45\x44\x20\x67\x2c\xa4\x85\xbc\xd7\x42\x64\xde\xda\xc8\x26\x37\xf5\x77\x62\x63\xd7\xd9\x49\x26\x37\x52\x02\x12\x8f\x2b\x31\x86\x6b\x58\x15\x44\x79\x4f\xda\xca\x53\x32\x22\x9e\xa9\xb7\x62\x1b\x65\x41\x6d\x4b\x4a\xb3\x15\x1e\x59\x8c\xe1\x84\x55\x98\xfe\x97\xad\xf5\x13\xf2\xa7\x6c\x11\x2d\x9a\x0d\x34\x7e\x90\x2d\x38\x09\x45\x22\xd3\x4d\x23\x59\x00\x74\xb0\x0f\x62\x5a\x95\x38\x1f\xc1\x92\xdf\x1d\x53\x5d\x88\x32\x67\x31\x12\xce\xf0\x27\x86\x0e\x87\x9f\x8a\x94\x27\x04\x43\x01\x40\x9b\xf6\x0b\x92\x9e\x77\x4a\x9a\xb6\x2e\x31\x2b\xda\x08\x89\x21\xab\x74\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xdd\xc2\x7a\xdf\xbb\x0f\x40\x0b\xf8\x64\xb3\x6c\x64\x5e\x1f\xc5\xdf\x12\xdb\x6f\x3d\x91\x88\x8d\x6c\x26\x53\x6e\x03\xa0\x3c\x5f\x96\xb4\xd4\xb1\x50\x06\x75\x34\x9e\x04\x11\x4a\x2c\xd9\x8d\x5b\x11\x9d\x09\x8d\x2b\x95\xff\x18\x73\x34\xe6\xd4\x3e\x90\x4b\x59\x9b\x6d\x52\x70\x17\x91\xbe\x03\x1a\x37\x1c\x21\x6d\x90\xce\x0b\xc5\xcf\x6a\x58\xe1\x73\xc6\x55\x9f\xca\x39\x2c\x60\x1c\x2e\x48\x66\x04\xf4\xba\xe8\x60\x27\xb1\x01\x0e\x93\x82\x3f\xe5\xa6\x81\x19\x16\xe2\xb8\x35\x07\x40\xaa\x98\x90\x5d\xca\xc5\x9f\x19\x24\xee\xb3\x50\x3f\xd6\x26\xfc\x5b\x15\xa2\x3e\x6d\xf9\x66\x3d\x16\xb4\xb1\xb3\x35\x7c\xf1\xb3\xd0\x7f\xc2\x10\x42\xb9\x9c\xf2\x16\x88\x28\x0c\x66\x86\xdb\xba\x68\x4c\xc5\xb8\x9b\x30\xea\x17\x1a\x8e\x87\x4e\x66\x76\x1d\x36\xd0\x3e\x29\xe5\xec\x8b\xfa\x91\x21\xd1\x3d\x8c\xb4\x17\xc7\x3c\x32\xfd\x3b\xa1\x39\xba\xab\x7d\xe3\x88\x8d\x1c\x1a\xfd\x42\x09\xde\xea\x77\x44\xb4\xe3\xc2\x86\x41\x9b\x0e\x47\x24\x1d\x03\xa3\xf2\x39\xec\x57\xfe\x35\x02\x8c\xad\x62\x68\x38\xbb\x62\xef\x4e\xe4\x12\xda\xb6\xba\x06\xe6\x90\x11\x96\xf5\xe1\xdd\x87\xa1\x58\x1e\x4d\x93\x80\x56\xb9\x1a\xaf\x86\xe4\x07\x6d\x16\x34\xbf\x1f\xa2\xcc\x7e\xa0\xd6\x6c\x29\xcf\x35\xc6\x23\x13\x17\xd4\xae\x01\x73\xbf\x34\x8e\x2d\xf2\x3f\xc2\x4a\xac\x08\x30\xd8\xee\xa9\xc8\xb3\x44\x29\xe1\x76\xb8\x82\x35\x4a\x19\xe1\x7a\x79\x10\xac\x49\xcd\x37\xae\x7a\x26\x02\x3b\x19\xc4\xeb\xdf\xa9\x2d\x65\xa3\x68\x73\x17\xb6\x1e\x05\x6a\x77\x46\x71\xfd\x4c\x17\xb2\x33\x89\xc2\xb0\x95\xf5\x5d\x57\x60\x1c\xa9\xe1\x2d\xd8\xf0\x80\xd4\x1e\xad\x83\xd9\x17\xb5\x7d\x70\x73\x78\x42\xf2\x31\xe5\xfb\xec\xbe\xd2\x1e\xa5\xd9\x7b\x8b\x23\xfc\xab\x81\x5b\x01\xd2\x3f\x08\xed\x20\xf4\xd7\xa6\x28\xa5\x7d\x83\xd2\x17\x4f\xf7\xa4\xe6\xff\x00\x40\x86\x2d\xa6\xcf\x18\xcd\xb8\xa5\xbd\xfe\x21\xc8\xe2\xf7\x81\xe2\xfd\x89\x0f\x5a\xc8\x27\xe6\x2d\x1a\x36\x6e\x37\xac\x17\xcd\xfb\x91\x7c\x52\xf1\x80\xe0\xb2\xc9\xc4\x29\x6a\x7b\xce\x62\x2f\x5e\xdb\x51\xa3\xe1\xe8\xf5\xd4\x23\x3f\xaa\xcc\x96\xa5\xb1\x81\xbc\x95\x6b\xe2\xd9\xe2\x51\x0b\xab\xd4\x78\xe9\x13\x17\xbc\x28\x3a\xc2\x06\x85\xad\x49\x12\xbd\x49\x27\x2e\x41\x91\x74\xff\x81\x1f\xcc\x5d\x76\xf4\xcb\x02\xa0\xd8\x6c\x37\xbe\x7c\xc6\x79\x43\x61\xf6\x69\x17\x04\xad\xf0\xcc\x4b\x1a\x15\xc2\x9e\x4f\xaa\x79\x51\x61\xec\xc3\xcb\x2c\xf6\xaa\x79\xfe\xde\x5a\x2b\x73\x43\xdc\x39\x54\xf8\x22\x75\xf1\x97\x61\x79\x4f\xc7\x05\x3b\x13\xdc\xea\xe4\x43\xc5\x19\xe5\x77\x23\x9f\x25\x5e\x55\x22\x13\x3a\xb1\xed\x37\x84\x56\x23\xfb\x6b\xf5\x31\x8a\x29\xa9\x99\x4f\x0a\xde\xc5\x26\xf5\x7a\x50\xbe\xec\x4c\xde\xdf\xb0\xc8\xb2\x76\x51\xcf\x45\x72\x4e\x38\x03\xb2\x70\x9d\xd8\xab\x07\x89\x2c\xb6\x0e\x83\xbb\xcd\x91\xc7\x93\xf2\x03\x76\xf1\x7e\xb5\x26\xf8\xad\xf1\x43\x3f\xaf\xb9\xba\x01\x83\xd0\x90\xe5\x14\x60\x4c\xef\x1a\x0d\xe9\x92\xb3\xf3\x61\x2e\xe6\xad\x6f\xef\x9c\xe6\xfb\xd9\xaa\xc6\xb7\x0e\x66\xf1\x9b\xfe\x22\x83\x35\xa7\x86\x1f\xfb\xac\xae\xf1\xbc\x13\x69\xfa\xb9\x77\x0b\x99\xb8\xb7\x65\xb6\x68\x2b\x45\x0c\x17\x55\xee\x47\xa9\x91\x4f\xe2\x1a\xbd\x73\x44\x41\x96\xd7\xa3\x96\x96\x34\x43\xd1\xcf\xa3\x19\xf6\x0b\xb6\xbc\xb8\x04\x74\xec\xf0\x39\x81\x79\x25\x0c\xec\x63\x43\x28\x45\x1f\xe6\x39\x7c\x07\x7d\xba\xc6\x06\xf8\x2f\x95\x42\x56\x89\x0b\x92\xee\x47\x32\x7d\x08\x57\xf9\x3d\x6e\x80\x80\xb0\x9c\x47\x30\x27\x61\x77\x42\x35\xb0\x8f\xc2\x61\x3a\x23\x77\x6b\x00\xf0\x29\x3c\x01\x81\x21\xb6\x9e\x3f\x37\x96\x40\xa9\x9a\x57\xd0\xaa\x2f\x66\x5d\xfa\x70\xa8\x54\xc4\x86\x58\x3a\xd6\xca\x5f\x05\x60\xdb\xa5\xc9\x61\xf6\x62\x32\xa7\x61\x5d\x63\x0a\x90\x65\x92\x86\x7f\x22\xe6\x94\xce\xcd\x35\xbf\x8e\xe4\x0f\x7e\x30\xca\x53\x51\x8f\xc6\x7f\x3e\x45\xf8\xac\x85\x4e\x47\x23\x40\x87\x4e\x8b\x92\x07\x05\x9c\x85\x58\x5d\x3e\x82\x4d\xf9\x8a\x29\x45\x57\xdf\x5e\x31\xc8\xfc\x85\x9e\x23\x94\x8d\x81\x8f\xa8\x55\x83\x44\x44\xce\xf9\x6f\x60\x92\xe0\xb5\xc0\x79\x75\x53\x6e\x5d\xba\x57\x8f\xbc\x37\xb5\xd1\xf0\x51\x35\xb9\xe1\x7e\xf4\xef\x1f\xd7\xd7\x8e\xb5\x40\x74\x4d\x61\xf8\xbe\xd5\xec\xbe\x11\xa4\x5e\xe9\x3e\x5b\xa3\x64\xb5\x87\x71\x35\x8a\xfe\xd9\xe5\xbc\x71\xeb\xb1\x18\xdf\x92\xb9\x32\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x56\x17\x40\x70\xc6\x00\x5c\x57\x1c\x2b\x3c\x48\x4c\xc8\xfd\x17\x6a\x05\x95\x76\x3a\xf1\x66\xf7\x2c\x4f\xdb\xd7\x31\xb0\xbf\x5b\x54\x47\xf0\x6d\xf8\x47\xf0\x50\x5c\xa5\x20\xf1\xba\x07\x58\x79\x97\x14\x1a\xaf\x65\xdc\x34\x68\x3a\xeb\xc7\x4d\x76\x43\x28\x30\x58\x60\xdd\x54\x9e\x08\xb8\x40\x14\x0a\x50\x8c\xc2\x5d\xcf\x70\x5c\xfd\x3a\xb8\x69\x58\x90\x2c\x26\x55\x60\x50\xeb\xe4\x50\x00\x43\xf5\x88\x96\xf1\x3b\xe3\xd7\xfc\x34\x87\x92\x01\x65\xad\x85\xd2\xee\x62\xea\xa1\x36\x36\xca\x95\xc8\x97\x75\xe4\xda\x19\x2f\xc4\x66\x47\x58\xd9\x0d\x76\xfd\xed\x24\x19\xe0\x78\x68\xae\xd8\x8e\xbe\x62\x0b\x14\x3b\xcb\xee\x64\x17\x95\x63\x99\xb8\x4a\x7e\x81\x8c\xce\x26\xa3\x45\x03\x69\x81\xc5\x40\x24\xac\x2d\x55\x9f\xbc\x91\x23\xb9\x6d\xd8\xd0\xf4\x78\x6b\xd8\xc5\x79\x50\xcf\x5d\xca\x60\x04\x44\xee\x9d\x16\x1c\x8b\xcd\x6d\x23\x24\xff\xed\x27\x75\x62\xd8\x54\x58\xda\xec\x22\xd9\xa7\xf0\x12\x47\x75\x91\x33\x02\x85\xb3\xcf\x28\x21\x32\x75\xd6\xcf\x4a\xd5\xd9\x69\x60\x23\x60\x11\x44\x71\x76\xb9\x5b\xfe\xa7\x98\x48\xc3\x07\x46\xd6\x69\xf2\x90\xbb\x0d\x0b\x05\xbb\xed\xd4\x1b\xb2\xa3\x84\x37\x0f\xf8\x07\xd4\x6e\x22\xab\xca\xb1\xb6\x4a\xd2\xf7\xeb\xb0\x54\x68\x45\xca\x04\x02\x3a\x2e\x67\x62\x3e\xe4\x99\x56\x4b\x1c\x63\xe8\x9c\x1f\x53\x02\x06\x3d\x45\x34\xf7\x63\x46\xb8\x45\x93\x79\x7e\x07\x28\xf0\x96\x1a\xc6\x35\x30\x66\xe8\x4b\x4b\xcf\x93\xe5\x44\xb9\xcd\xdb\xae\x04\xcf\xcc\x52\xfd\x70\x92\xc7\x39\xb8\x8c\x2f\x78\x3d\x32\xcf\xb4\x1e\xbd\xb5\xdd\x26\x3b\x97\xa0\x2a\x47\x3f\x49\xa6\x53\xac\x14\x35\xc3\x33\xc9\xcb\x5f\xbc\x3c\xb0\x51\xda\x0d\x8e\x8b\x5d\x1f\xbb\xeb\x17\x7a\x86\xda\x5d\xb4\x16\x91\x3b\xb9\x81\x60\x01\x88\xbb\x99\x0a\x45\xdd\xce\xda\xf8\x72\x0b\xc1\x73\x1b\x7b\x49\xba\xf5\xf9\x4d\x56\xf5\xdf\xe8\xaf\x91\x3b\xf9\x47\x1b\xa7\xc9\xee\xb3\xd5\x9c\x4e\xbe\x64\x0b\xd7\xa1\xdf\x94\x25\x42\x13\x87\xde\xc3\x84\xf7\x64\xe5\x72\x75\x1d\x45\x29\x54\x5e\xcb\x9e\x32\x27\xd3\x37\xe4\x5b\xd2\x4f\x18\x17\xa8\xa6\xd7\x73\x05\x3f\x3e\x4c\x5d\xb6\x0b\x43\x73\x38\x4b\x8f\x06\xa3\x06\x73\x7a\xe1\x11\x1e\xcb\x46\xf4\xa1\x92\x12\xd8\x8a\x52\x2c\xde\x61\x5f\x4f\x81\xee\xf0\x8b\xfe\xfb\x19\x8a\x34\xae\xdc\x92\xa4\xae\x7a\x02\xa4\x5b\x76\x71\xc3\x97\xfd\xa1\x64\xf0\x9d\x7c\x96\x75\x50\xac\x3e\x74\x59\x21\x12\xd4\xe2\x72\xa9\x9e\x13\x67\x5c\xf8\xb0\x2a\x62\xea\xef\x13\x0e\x5b\xd8\xb3\x3e\xf5\x4a\x38\xa8\x77\xd2\x95\x33\x8a\x47\xe5\xf4\xf1\xa4\xd0\xeb\x48\x62\xca\x0f\xcd\x38\xc8\x11\xfc\x2f\x01\x33\xfb\xc6\x4c\x16\x28\x4a\x00\x31\x61\x4d\x1a\x06\x73\xdc\xb3\x86\xf1\x3a\xfa\xe5\xf1\x67\xa7\x33\xb8\x8b\x75\x47\x91\x45\xbd\xe1\x2a\xfb\xc8\x7d\xd7\x63\xdf\xd1\x22\xff\xb0\xa0\xac\xdf\x10\x50\x8a\x79\x56\x85\x15\xe3\x40\x0a\xc7\xef\x8a\xaa\x04\xe9\x60\x4c\xfa\x22\x38\x95\x2a\x90\xff\xc1\x67\xc3\x84\x77\x47\xb9\x02\xe8\x68\x36\xa6\x34\x88\x30\x27\x83\x02\x91\xd8\xe0\xdd\xc9\xc4\x5f\xcc\x9d\x4b\x4a\x99\x4a\xd4\x0a\x01\xd3\xe5\x78\x54\x51\xc1\xe1\x15\x7f\xea\x1a\xe7\xf0\x23\xaf\x9d\x08\x62\xf2\x97\x3e\x13\x31\xce\x4f\x77\x5b\xd1\xc7\x9b\x05\x3f\xf7\xf5\x78\x87\xb4\x2e\x71\xb0\x2a\xb2\xc7\xe0\x65\x59\x84\x41\xac\x2e\x7c\x23\x5c\x55\x48\x0d\x37\x4c\x86\xb9\x2f\x06\x3b\x46\x29\x23\x98\x61\x58\x58\x25\x6b\x05\x0b\x74\x20\x1c\xd8\x18\x0f\x2e\x0b\xab\x5a\xcb\xa2\xc7\x94\xa3\x66\x17\x1d\x50\x22\xa9\x55\x01\x8e\xa0\xc9\x6d\xee\xe3\xd5\x40\xaa\xd5\xc8\x05\x24\x06\x30\xba\xf3\x78\xff\x00\xa5\x91\xe7\x8b\x3a\xe1\xbf\x8e\xad\x24\x13\x50\xa1\x97\x90\xbd\x66\xd6\x9c\xf3\xfe\x40\xe4\x0d\x16\x4a\xcb\x51\x1a\x0d\x46\x1f\xce\x2c\x65\xc2\xcd\xcf\xec\xa6\x40\x8b\x08\x10\x0b\xff\x6c\xff\x5a\xc9\x41\xf2\x2e\x9d\x83\x96\x0b\x9f\x9d\x8f\x8e\x04\x05\x08\xd2\xc3\x1c\x61\x6a\xd1\x87\xa1\xc5\x61\x37\x91\x20\x25\xa7\x57\xb6\x48\x12\xa9\xd4\xbf\xad\xe8\x6d\x2b\x31\xcc\xa0\x87\x5b\x64\xcb\x0d\xa9\x2e\xbf\x78\xc0\x4d\xc6\x23\x7c\xcb\x56\x18\x52\xee\xe5\x15\xac\x8a\xaf\x40\x57\xa2\xc2\xfd\x0b\xd9\x2a\x7e\xda\xdb\x65\xb5\xc0\x96\x6e\x5f\xc6\x83\xf7\x9a\x52\x1d\x76\xc4\xe3\x22\x02\x5f\x2d\xca\xf9\x7c\xdb\x6b\x45\x3c\x93\xa6\xdf\x1f\xf0\x3f\xb7\x29\x83\x7b\x17\xc8\xc4\x04\x3c\xcb\x61\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xe0\x03\xdb\xd8\xa2\x64\xf2\x70\xf3\xe8\x1a\x04\xe0\x03\x74\xaf\x79\x5d\xcf\xfb\xce\x6a\x14\x9f\x93\x78\x7e\x7b\x8b\xec\x54\x18\x35\xe5\x11\xba\xe5\xc2\xe6\xfb\x37\xd6\x12\x23\x1b\x51\x3c\xbd\xfc\x1d\x55\x02\x57\x2f\x03\x1d\x94\x8b\xcd\xb0\x52\xa3\x46\x50\xf9\x58\x2d\x1f\x04\x6d\xa3\xae\xf0\x95\x3e\x9f\xb5\x00\xaf\xa6\x45\x7a\x11\x71\x1c\x44\xc9\x98\xaa\x72\xe7\x97\x3b\xb8\xf6\x3d\x69\xb7\xf5\xcb\xcc\xc7\x48\xd8\x33\x0a\x37\xa2\xee\x5f\xb7\xfa\x5a\x6c\xe1\x6f\x7b\xa0\x62\xe7\x26\x14\x7d\x5b\x6f\xec\xc6\x7f\x82\x22\x67\x2d\xdd\x23\xd5\x4d\x04\x94\x71\xe7\x34\x2f\x74\xdf\xd8\x3d\xb9\x09\x67\x95\x4b\xfe\x24\x1c\x08\x34\x95\xb8\xb4\x2d\xe5\x63\x24\xe2\x94\x0b\xaf\x64\x66\xc5\x63\x3e\xdd\x0e\x53\x13\x56\xa8\x40\x8b\x3b\x00\xfa\x38\x92\xee\xb4\x3d\x28\x29\x63\x45\x4d\x94\xc3\xc6\x34\x35\x37\xc3\xe6\x09\x62\x7c\xd8\x37\xf6\x06\xa1\x4c\xec\x02\xb7\x43\x4b\xd6\x8b\xb4\x31\x5b\xa8\xea\x5b\x03\xe7\x8f\xe2\xbc\x8b\x34\x99\xd7\x65\x7c\x55\x60\x48\xcb\x57\x1a\xb0\x89\xa3\x3c\xcc\xa8\x71\x04\xb6\x2a\x81\x9f\xc2\xac\xb4\x69\x50\x5d\x87\xb8\x9f\x22\x7e\x15\xc1\xdd\xba\xdb\xb2\x46\xcb\xf5\x61\xae\x43\x88\xb6\xdb\x5b\xcb\xd6\x03\x77\x16\xf5\x40\x6e\x5c\x13\x53\x02\x21\x3f\x37\x7f\x75\xb9\xcd\x2a\x7b\x2c\xe3\x25\x66\x2f\xdd\x37\x38\xfc\x2a\x15\x3c\x8a\x78\x3c\x59\x57\xfd\xb2\x08\xf4\x31\xe7\x13\xcd\xd0\x30\x58\xf7\x0d\x4e\x02\xae\x6b\x6c\x36\xa6\xd7\x7f\x73\xfd\x1b\xb4\x5f\x0e\x3d\x2b\xe7\x36\x83\x5f\x6c\x82\x92\x96\xba\xe8\x50\xe1\x63\x3f\x2b\x06\xdb\x04\xfa\xeb\xc3\xc5\xa0\x46\x0b\xd5\x3f\x3a\x39\xf8\xc9\x69\x62\x9c\x7a\xa7\x0c\x85\x9f\x99\xfc\x04\x45\x41\xfa\x10\x72\xc5\xdd\x28\xac\x10\x96\x97\xc6\xaa\x64\xed\xf8\x82\xb6\xc9\x2e\xc6\x27\x3a\xef\xf7\x69\xf0\x2e\xf5\x6c\xaf\x96\x72\x30\x50\x4a\x67\xf8\xa0\x27\x81\x68\x5d\xf2\xdc\x73\xbf\x25\xd5\xc2\x22\x8b\xe9\x0d\x19\x99\x18\x3e\x41\x77\xc1\x2a\x3f\xaa\xd3\xa9\xc5\x5a\x34\x44\xca\xdf\x29\x6f\x08\xdf\xe4\x9b\xe7\xb8\x1c\x0c\xe6\xb4\x8a\x29\x15\x21\x1e\x09\xbb\x8c\xda\x79\x88\xab\x8f\xaf\x87\x3a\xf0\xd9\x2d\xa9\xe3\x1d\x6b\xc9\x2a\x01\xe4\xe5\xb9\xb4\xd6\x24\x00\xda\xf8\xb4\xd5\x0d\x50\xa4\x5f\x4a\x00\x00\x1c\xe4\x4a\x73\x39\xb8\x30\xd6\xe9\x6f\xd8\x26\x56\x43\xeb\x9a\x2a\xda\x50\xe9\xb1\x3a\x2e\xe3\x9c\x43\xea\x70\x9c\x20\xe6\x56\x21\xe5\x40\xfa\x09\x0e\xc9\xcd\x12\xc5\x05\x4e\xa5\x93\x18\xa2\x7a\xf7\x49\x7f\xeb\xcc\x02\x32\x01\x4a\x2e\xc3\x8c\x25\x3c\xc7\xb2\xed\x8e\x02\x07\xbb\x5f\xd2\x4b\x49\x7f\xac\x59\x4c\x9f\x46\xf2\xd2\xfc\x4a\x99\xea\x6a\xfe\xd1\x2c\x88\x47\x92\x7e\x31\xf0\x1e\x98\x7e\x30\x0c\x8b\x16\x88\xa4\x82\xcb\x8d\xc5\x31\xcb\x36\x90\x5b\x81\xdf\xf5\x86\xe3\x9b\x67\x49\x41\xd3\x32\xfd\x34\xc7\xc6\x60\x48\x2f\xff\x70\x94\xb5\xd1\xc2\x65\xc3\x7c\x69\xa2\x5d\x75\x37\x23\x5f\x22\xf9\x4c\xb3\x07\x31\xbe\xa6\x32\x60\x5b\xfb\xf2\x33\x5a\x8a\xb9\x8a\x47\xff\x84\xdf\x60\xaa\xe9\x72\x03\x6d\x63\x04\x3f\xeb\xc2\x4a\x21\xc8\xd3\x13\x10\x24\x5a\xcc\x85\x60\xb6\x25\x0d\xa6\xf3\x7f\x88\x09\x47\xe6\x3a\xa5\x88\x61\x48\x0a\xb7\x4d\x13\x59\xc5\x8a\x07\x4c\x6c\x27\x85\x5f\x8a\xff\x9d\x9e\x49\xff\x6e\x7f\xf1\x49\x12\xe3\x92\x84\xc6\x9e\x16\x73\xdc\xd0\xe7\xbb\xc6\x95\x54\x3c\xf4\x5f\x51\x46\xbd\x1a\x8f\x8c\xf3\x07\xb7\x8b\x8a\x67\x17\xbd\xb9\xc6\xaf\x2b\x55\x31\x8f\x9b\x8d\xdf\x64\x3a\x16\xd8\xaf\x4d\x2d\xba\x99\x31\xdf\x7b\x5a\x70\x65\x7b\x02\x57\xcc\x93\x90\x12\xf0\x93\x39\x85\xb1\x73\x08\xbf\x29\xa8\xcd\x59\x40\x47\x68\xc1\x8b\x92\x8d\x5e\x74\xed\x31\x94\xd6\xbd\x1a\x94\xfd\x03\x0b\xe9\x43\xf8\x91\xd1\x99\x19\xb1\x4a\xd1\xfd\x82\xb1\x10\x6b\xf4\x41\xbe\xd1\xec\x23\x89\xe5\x68\x62\x01\x76\xfd\xf1\xcb\x4e\x22\x33\x98\x0e\xc6\xc2\x42\x8a\xb7\x89\xb2\x4c\xc0\x4c\x15\x62\xc8\x3d\x35\xcc\xf3\x44\x9e\xcc\xbf\x04\xb7\xb4\x04\xc3\x88\x31\xb0\x40\x58\x4a\xc6\x80\x6b\xb7\x3a\x4e\x2e\xf5\x03\x9d\x2e\x56\xb9\x04\x8a\xe6\x29\x60"
@@ -0,0 +1,41 @@
+package privacy
+
+import (
+	"testing"
+
+	C25519 "github.com/0xkraken/incognito-wasm/incognito/privacy/curve25519"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratorFromIndex(t *testing.T) {
+	for i := int64(1); i <= numEmbeddedGenerators; i++ {
+		expected := HashToPointFromIndex(i, CStringBulletProof)
+		actual := GeneratorFromIndex(i)
+		assert.Equal(t, expected, actual, "generator %v differs from the hash-derived point", i)
+	}
+
+	// indices outside the table are hashed
+	for _, i := range []int64{numEmbeddedGenerators + 1, 16389, 32773} {
+		assert.Equal(t, HashToPointFromIndex(i, CStringBulletProof), GeneratorFromIndex(i))
+	}
+}
+
+func TestPedersenPrecomputedTable(t *testing.T) {
+	pre := PedCom.precomputed.get()
+	assert.Equal(t, len(PedCom.G), len(pre))
+
+	for i := range PedCom.G {
+		var point C25519.ExtendedGroupElement
+		var expected [8]C25519.CachedGroupElement
+		key := PedCom.G[i].GetKey()
+		point.FromBytes(&key)
+		C25519.GePrecompute(&expected, &point)
+
+		for j := range expected {
+			var expectedBytes, actualBytes [C25519.CachedGroupElementSize]byte
+			expected[j].ToBytes(&expectedBytes)
+			pre[i][j].ToBytes(&actualBytes)
+			assert.Equal(t, expectedBytes, actualBytes)
+		}
+	}
+}
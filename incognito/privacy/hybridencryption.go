@@ -1,20 +1,84 @@
 package privacy
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"github.com/0xkraken/incognito-wasm/incognito/common"
 	"github.com/0xkraken/incognito-wasm/incognito/base58"
+	"golang.org/x/crypto/hkdf"
+	"io"
 )
 
+const (
+	// HybridCipherTextVersion1 is the legacy format:
+	// the message is encrypted with unauthenticated AES-CTR keyed by a random point sent with ElGamal
+	HybridCipherTextVersion1 = 1
+	// HybridCipherTextVersion2 seals the message with AES-GCM
+	// keyed by HKDF over the point shared by the ElGamal key encapsulation
+	HybridCipherTextVersion2 = 2
+
+	// hybridHeaderSize is the size of the header a HybridCipherTextVersion2 ciphertext starts with
+	hybridHeaderSize = Ed25519KeySize
+)
+
+// hybridHeader returns the header of a ciphertext of version: 0xf0 | version followed by 30 bytes 0xff and 0x7f.
+// As a little endian y coordinate it is at least 2^255 - 16, which is not reduced modulo 2^255 - 19,
+// so it can not be the canonical encoding of c1 a legacy ciphertext starts with
+func hybridHeader(version int) []byte {
+	header := make([]byte, hybridHeaderSize)
+	for i := range header {
+		header[i] = 0xff
+	}
+	header[0] = 0xf0 | byte(version)
+	header[hybridHeaderSize-1] = 0x7f
+	return header
+}
+
+// parseHybridHeader returns the version of the header data starts with,
+// or HybridCipherTextVersion1 when it starts with the legacy ElGamal ciphertext.
+// Data whose first bytes are mostly the 0xff bytes of a header is marked with a version, it fails to parse
+// instead of being read as a legacy ciphertext when the header has been modified.
+// The encoding of a random c1 has that many 0xff bytes with negligible probability
+func parseHybridHeader(data []byte) (int, error) {
+	if len(data) < hybridHeaderSize {
+		return HybridCipherTextVersion1, nil
+	}
+	markerBytes := 0
+	for _, b := range data[1 : hybridHeaderSize-1] {
+		if b == 0xff {
+			markerBytes++
+		}
+	}
+	if markerBytes < (hybridHeaderSize-2)/2 {
+		return HybridCipherTextVersion1, nil
+	}
+
+	version := int(data[0] & 0x0f)
+	if version <= HybridCipherTextVersion1 || !bytes.Equal(data[:hybridHeaderSize], hybridHeader(version)) {
+		return 0, errors.New("invalid hybrid ciphertext header")
+	}
+	return version, nil
+}
+
 // hybridCipherText_Old represents to hybridCipherText_Old for Hybrid encryption
 // Hybrid encryption uses AES scheme to encrypt message with arbitrary size
 // and uses Elgamal encryption to encrypt AES key
 type HybridCipherText struct {
+	version         int
 	msgEncrypted    []byte
 	symKeyEncrypted []byte
 }
 
+// GetVersion returns the version of the ciphertext, HybridCipherTextVersion1 for legacy ciphertexts
+func (ciphertext HybridCipherText) GetVersion() int {
+	if ciphertext.version == 0 {
+		return HybridCipherTextVersion1
+	}
+	return ciphertext.version
+}
+
 func (ciphertext HybridCipherText) GetMsgEncrypted() []byte {
 	return ciphertext.msgEncrypted
 }
@@ -57,18 +121,30 @@ func (ciphertext HybridCipherText) Bytes() []byte {
 	}
 
 	res := make([]byte, 0)
+	if ciphertext.GetVersion() != HybridCipherTextVersion1 {
+		res = append(res, hybridHeader(ciphertext.version)...)
+	}
 	res = append(res, ciphertext.symKeyEncrypted...)
 	res = append(res, ciphertext.msgEncrypted...)
 
 	return res
 }
 
-// SetBytes reverts bytes array to hybridCipherText_Old
+// SetBytes reverts bytes array to hybridCipherText_Old,
+// bytes starting with the header of a version are parsed as a ciphertext of that version
 func (ciphertext *HybridCipherText) SetBytes(bytes []byte) error {
 	if len(bytes) == 0 {
 		return NewPrivacyErr(InvalidInputToSetBytesErr, nil)
 	}
 
+	version, err := parseHybridHeader(bytes)
+	if err != nil {
+		return err
+	}
+	ciphertext.version = version
+	if ciphertext.version != HybridCipherTextVersion1 {
+		bytes = bytes[hybridHeaderSize:]
+	}
 	if len(bytes) < elGamalCiphertextSize {
 		// out of range
 		return errors.New("out of range Parse ciphertext")
@@ -78,12 +154,33 @@ func (ciphertext *HybridCipherText) SetBytes(bytes []byte) error {
 	return nil
 }
 
-// hybridEncrypt_Old encrypts message with any size, using Publickey to encrypt
-// hybridEncrypt_Old generates AES key by randomize an elliptic point aesKeyPoint and get X-coordinate
+// HybridEncrypt encrypts message with any size, using Publickey to encrypt.
+// It produces a HybridCipherTextVersion1 ciphertext, the format fullnodes and other wallets decrypt,
+// HybridEncryptWithVersion produces the authenticated HybridCipherTextVersion2
+func HybridEncrypt(msg []byte, publicKey *Point) (ciphertext *HybridCipherText, err error) {
+	return HybridEncryptWithVersion(msg, publicKey, HybridCipherTextVersion1)
+}
+
+// HybridEncryptWithVersion encrypts message using the given hybrid ciphertext version.
+// A HybridCipherTextVersion1 ciphertext is a 64-byte ElGamal ciphertext followed by the encrypted message,
+// a HybridCipherTextVersion2 ciphertext starts with a 32-byte header holding its version
+func HybridEncryptWithVersion(msg []byte, publicKey *Point, version int) (ciphertext *HybridCipherText, err error) {
+	switch version {
+	case HybridCipherTextVersion1:
+		return hybridEncryptV1(msg, publicKey)
+	case HybridCipherTextVersion2:
+		return hybridEncryptV2(msg, publicKey)
+	default:
+		return nil, errors.New("unsupported hybrid ciphertext version")
+	}
+}
+
+// hybridEncryptV1 generates AES key by randomize an elliptic point aesKeyPoint
 // using AES key to encrypt message
 // After that, using ElGamal encryption encrypt aesKeyPoint using publicKey
-func HybridEncrypt(msg []byte, publicKey *Point) (ciphertext *HybridCipherText, err error) {
+func hybridEncryptV1(msg []byte, publicKey *Point) (ciphertext *HybridCipherText, err error) {
 	ciphertext = new(HybridCipherText)
+	ciphertext.version = HybridCipherTextVersion1
 
 	// Generate a AES key bytes
 	sKeyPoint := RandomPoint()
@@ -107,13 +204,58 @@ func HybridEncrypt(msg []byte, publicKey *Point) (ciphertext *HybridCipherText,
 	return ciphertext, nil
 }
 
-// hybridDecrypt_Old receives a ciphertext and privateKey
-// it decrypts aesKeyPoint, using ElGamal encryption with privateKey
-// Using X-coordinate of aesKeyPoint to decrypts message
+// hybridEncryptV2 encrypts a random point with ElGamal as hybridEncryptV1 does,
+// the AES-GCM key is derived from it with HKDF and the header and the ElGamal ciphertext
+// are authenticated along with the message
+func hybridEncryptV2(msg []byte, publicKey *Point) (ciphertext *HybridCipherText, err error) {
+	ciphertext = new(HybridCipherText)
+	ciphertext.version = HybridCipherTextVersion2
+
+	sKeyPoint := RandomPoint()
+	pubKey := new(elGamalPublicKey)
+	pubKey.h = publicKey
+	ciphertext.symKeyEncrypted = pubKey.encrypt(sKeyPoint).Bytes()
+
+	aesKey, err := deriveHybridKey(sKeyPoint, ciphertext.symKeyEncrypted)
+	if err != nil {
+		return nil, err
+	}
+	aesScheme := &common.AESGCM{
+		Key: aesKey,
+	}
+	ciphertext.msgEncrypted, err = aesScheme.Encrypt(msg, ciphertext.additionalData())
+	if err != nil {
+		return nil, err
+	}
+
+	return ciphertext, nil
+}
+
+// additionalData is the data AES-GCM authenticates along with the message: the header and the ElGamal ciphertext
+func (ciphertext HybridCipherText) additionalData() []byte {
+	res := hybridHeader(ciphertext.version)
+	return append(res, ciphertext.symKeyEncrypted...)
+}
+
+// HybridDecrypt receives a ciphertext of any version and privateKey,
+// a HybridCipherTextVersion2 ciphertext that has been modified fails to decrypt
 func HybridDecrypt(ciphertext *HybridCipherText, privateKey *Scalar) (msg []byte, err error) {
+	msg, _, err = HybridDecryptWithVersion(ciphertext, privateKey)
+	return msg, err
+}
+
+// HybridDecryptWithVersion decrypts a ciphertext and returns the version it was encrypted with.
+// The version is the one of the header of the ciphertext, a HybridCipherTextVersion2 ciphertext that fails
+// authentication is rejected, whatever byte has been modified.
+// Legacy ciphertexts are not authenticated, callers that need integrity must check the returned version
+func HybridDecryptWithVersion(ciphertext *HybridCipherText, privateKey *Scalar) (msg []byte, version int, err error) {
 	// Validate ciphertext
 	if ciphertext.IsNil() {
-		return []byte{}, errors.New("ciphertext must not be nil")
+		return []byte{}, 0, errors.New("ciphertext must not be nil")
+	}
+	version = ciphertext.GetVersion()
+	if version != HybridCipherTextVersion1 && version != HybridCipherTextVersion2 {
+		return []byte{}, 0, errors.New("unsupported hybrid ciphertext version")
 	}
 
 	// Get receiving key, which is a private key of ElGamal cryptosystem
@@ -124,13 +266,28 @@ func HybridDecrypt(ciphertext *HybridCipherText, privateKey *Scalar) (msg []byte
 	encryptedAESKey := new(elGamalCipherText)
 	err = encryptedAESKey.SetBytes(ciphertext.symKeyEncrypted)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, err
 	}
 
 	// Decrypt encryptedAESKey using recipient's receiving key
 	aesKeyPoint, err := privKey.decrypt(encryptedAESKey)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, err
+	}
+
+	if version == HybridCipherTextVersion2 {
+		aesKey, err := deriveHybridKey(aesKeyPoint, ciphertext.symKeyEncrypted)
+		if err != nil {
+			return []byte{}, 0, err
+		}
+		aesScheme := &common.AESGCM{
+			Key: aesKey,
+		}
+		msg, err = aesScheme.Decrypt(ciphertext.msgEncrypted, ciphertext.additionalData())
+		if err != nil {
+			return []byte{}, 0, err
+		}
+		return msg, HybridCipherTextVersion2, nil
 	}
 
 	// Get AES key
@@ -143,7 +300,18 @@ func HybridDecrypt(ciphertext *HybridCipherText, privateKey *Scalar) (msg []byte
 	// Decrypt encrypted coin randomness using AES keysatt
 	msg, err = aesScheme.Decrypt(ciphertext.msgEncrypted)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, err
+	}
+	return msg, HybridCipherTextVersion1, nil
+}
+
+// deriveHybridKey derives the AES-GCM key of a HybridCipherTextVersion2 ciphertext
+// from the point encrypted with ElGamal, salted with the ElGamal ciphertext
+func deriveHybridKey(sKeyPoint *Point, symKeyEncrypted []byte) ([]byte, error) {
+	kdf := hkdf.New(sha256.New, sKeyPoint.ToBytesS(), symKeyEncrypted, []byte(CStringHybridEncryption))
+	key := make([]byte, common.AESKeySize)
+	if _, err := io.ReadFull(kdf, key); err != nil {
+		return nil, err
 	}
-	return msg, nil
+	return key, nil
 }
@@ -45,6 +45,125 @@ func TestHybridEncryption(t *testing.T) {
 	}
 }
 
+func TestHybridEncryptionVersions(t *testing.T) {
+	for _, version := range []int{HybridCipherTextVersion1, HybridCipherTextVersion2} {
+		for i := 0; i < 100; i++ {
+			msg := randomMessage()
+			privKey := RandomScalar()
+			pubKey := new(Point).ScalarMultBase(privKey)
+
+			ciphertext, err := HybridEncryptWithVersion(msg, pubKey, version)
+			assert.Equal(t, nil, err)
+
+			ciphertext2 := new(HybridCipherText)
+			err = ciphertext2.SetBytes(ciphertext.Bytes())
+			assert.Equal(t, nil, err)
+
+			msg2, version2, err := HybridDecryptWithVersion(ciphertext2, privKey)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, msg, msg2)
+			assert.Equal(t, version, version2)
+
+			// with a wrong private key the ciphertext can not be recognized as version 2
+			msg3, version3, _ := HybridDecryptWithVersion(ciphertext2, RandomScalar())
+			assert.NotEqual(t, msg, msg3)
+			assert.NotEqual(t, HybridCipherTextVersion2, version3)
+		}
+	}
+
+	_, err := HybridEncryptWithVersion(randomMessage(), RandomPoint(), 3)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestHybridEncryptionTampered(t *testing.T) {
+	msg := randomMessage()
+	privKey := RandomScalar()
+	pubKey := new(Point).ScalarMultBase(privKey)
+
+	ciphertext, err := HybridEncryptWithVersion(msg, pubKey, HybridCipherTextVersion2)
+	assert.Equal(t, nil, err)
+	ciphertextBytes := ciphertext.Bytes()
+	assert.Equal(t, hybridHeaderSize+elGamalCiphertextSize+12+len(msg)+16, len(ciphertextBytes))
+
+	// flip one bit of any byte, the header, the ElGamal ciphertext and the encrypted message
+	// with its nonce and tag, the ciphertext fails to parse or to decrypt but never decrypts as version 1
+	for i := 0; i < len(ciphertextBytes); i++ {
+		for _, bit := range []byte{0x01, 0x80} {
+			tampered := make([]byte, len(ciphertextBytes))
+			copy(tampered, ciphertextBytes)
+			tampered[i] ^= bit
+
+			ciphertext2 := new(HybridCipherText)
+			if ciphertext2.SetBytes(tampered) != nil {
+				continue
+			}
+			_, _, err = HybridDecryptWithVersion(ciphertext2, privKey)
+			assert.NotEqual(t, nil, err, "tampered byte %v was not detected", i)
+		}
+	}
+
+	// replacing c2 with c2 + G is rejected instead of falling back to version 1
+	tampered := make([]byte, len(ciphertextBytes))
+	copy(tampered, ciphertextBytes)
+	c2, err := new(Point).FromBytesS(tampered[hybridHeaderSize+Ed25519KeySize : hybridHeaderSize+elGamalCiphertextSize])
+	assert.Equal(t, nil, err)
+	copy(tampered[hybridHeaderSize+Ed25519KeySize:], new(Point).Add(c2, PedCom.G[PedersenPrivateKeyIndex]).ToBytesS())
+	ciphertext2 := new(HybridCipherText)
+	assert.Equal(t, nil, ciphertext2.SetBytes(tampered))
+	_, _, err = HybridDecryptWithVersion(ciphertext2, privKey)
+	assert.NotEqual(t, nil, err)
+
+	// unknown versions are rejected
+	tampered = append(hybridHeader(3), ciphertextBytes[hybridHeaderSize:]...)
+	ciphertext2 = new(HybridCipherText)
+	assert.Equal(t, nil, ciphertext2.SetBytes(tampered))
+	_, _, err = HybridDecryptWithVersion(ciphertext2, privKey)
+	assert.NotEqual(t, nil, err)
+
+	// truncated ciphertexts are rejected without panicking
+	for _, size := range []int{1, hybridHeaderSize, hybridHeaderSize + elGamalCiphertextSize + 1, hybridHeaderSize + elGamalCiphertextSize + 27} {
+		ciphertext2 := new(HybridCipherText)
+		if ciphertext2.SetBytes(ciphertextBytes[:size]) != nil {
+			continue
+		}
+		_, err = HybridDecrypt(ciphertext2, privKey)
+		assert.NotEqual(t, nil, err)
+	}
+}
+
+func TestHybridEncryptLegacyVersion(t *testing.T) {
+	privKey := RandomScalar()
+	pubKey := new(Point).ScalarMultBase(privKey)
+
+	// coin details are read by fullnodes and other wallets, which only decrypt the legacy version
+	ciphertext, err := HybridEncrypt(randomMessage(), pubKey)
+	assert.Equal(t, nil, err)
+	_, version, err := HybridDecryptWithVersion(ciphertext, privKey)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, HybridCipherTextVersion1, version)
+
+	outputCoin := new(OutputCoin)
+	outputCoin.CoinDetails = new(Coin)
+	outputCoin.CoinDetails.SetRandomness(RandomScalar())
+	outputCoin.CoinDetails.SetValue(100)
+	assert.Equal(t, (*PrivacyError)(nil), outputCoin.Encrypt(pubKey.ToBytesS()))
+	_, version, err = HybridDecryptWithVersion(outputCoin.CoinDetailsEncrypted, privKey)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, HybridCipherTextVersion1, version)
+
+	// authenticated coin details are opt-in and survive the serialization of the output coin
+	assert.Equal(t, (*PrivacyError)(nil), outputCoin.EncryptWithVersion(pubKey.ToBytesS(), HybridCipherTextVersion2))
+	outputCoin2 := new(OutputCoin)
+	assert.Equal(t, nil, outputCoin2.SetBytes(outputCoin.Bytes()))
+	_, version, err = HybridDecryptWithVersion(outputCoin2.CoinDetailsEncrypted, privKey)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, HybridCipherTextVersion2, version)
+	viewingKey := ViewingKey{Rk: privKey.ToBytesS()}
+	assert.Equal(t, (*PrivacyError)(nil), outputCoin2.Decrypt(viewingKey))
+	assert.Equal(t, uint64(100), outputCoin2.CoinDetails.GetValue())
+	assert.Equal(t, HybridCipherTextVersion2, PaymentInfo{AuthenticateCoinDetails: true}.CoinDetailsVersion())
+}
+
 func randomMessage() []byte {
 	msg := make([]byte, 128)
 	rand.Read(msg)
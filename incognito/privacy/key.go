@@ -34,6 +34,18 @@ type PaymentInfo struct {
 	PaymentAddress PaymentAddress
 	Amount         uint64
 	Message        []byte // 512 bytes
+	EncryptMessage bool   // Message is encrypted to PaymentAddress.Tk, see EncryptMemo
+	// AuthenticateCoinDetails seals the coin details with HybridCipherTextVersion2,
+	// only wallets that decrypt that version can read the coin
+	AuthenticateCoinDetails bool
+}
+
+// CoinDetailsVersion returns the hybrid ciphertext version the coin details of the payment are encrypted with
+func (paymentInfo PaymentInfo) CoinDetailsVersion() int {
+	if paymentInfo.AuthenticateCoinDetails {
+		return HybridCipherTextVersion2
+	}
+	return HybridCipherTextVersion1
 }
 
 // GeneratePrivateKey generates a random 32-byte spending key
@@ -0,0 +1,92 @@
+package privacy
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A coin info holds either a plaintext memo or an encrypted memo.
+// An encrypted memo is encryptedMemoMarker || version || ciphertext,
+// plaintext memos are kept as they are and are not expected to start with a zero byte
+const (
+	encryptedMemoMarker     = byte(0)
+	encryptedMemoHeaderSize = 2
+
+	// EncryptedMemoVersion1 memos hold a HybridCipherTextVersion2 ciphertext encrypted to the recipient's transmission key
+	EncryptedMemoVersion1 = byte(1)
+
+	// hybridCipherTextV2Overhead is the size of the header, the ElGamal ciphertext, the AES-GCM nonce and the AES-GCM tag
+	hybridCipherTextV2Overhead = hybridHeaderSize + elGamalCiphertextSize + 12 + 16
+
+	// MaxSizeEncryptedMemo is the largest memo that can be encrypted into a coin info
+	MaxSizeEncryptedMemo = MaxSizeInfoCoin - encryptedMemoHeaderSize - hybridCipherTextV2Overhead
+)
+
+// IsEncryptedMemo checks whether a coin info holds an encrypted memo
+func IsEncryptedMemo(info []byte) bool {
+	return len(info) > encryptedMemoHeaderSize && info[0] == encryptedMemoMarker && info[1] == EncryptedMemoVersion1
+}
+
+// EncryptMemo encrypts memo to the recipient's transmission key and returns the coin info holding it
+func EncryptMemo(memo []byte, recipientTK TransmissionKey) ([]byte, error) {
+	if len(memo) == 0 {
+		return nil, errors.New("memo is empty")
+	}
+	if len(memo) > MaxSizeEncryptedMemo {
+		return nil, fmt.Errorf("Size of encrypted message %v should be less than %v", len(memo), MaxSizeEncryptedMemo)
+	}
+
+	pubKeyPoint, err := new(Point).FromBytesS(recipientTK)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := HybridEncryptWithVersion(memo, pubKeyPoint, HybridCipherTextVersion2)
+	if err != nil {
+		return nil, err
+	}
+
+	info := []byte{encryptedMemoMarker, EncryptedMemoVersion1}
+	info = append(info, ciphertext.Bytes()...)
+	return info, nil
+}
+
+// DecryptMemo returns the memo held by a coin info.
+// Plaintext memos are returned as they are, encrypted memos are decrypted with the recipient's viewing key
+func DecryptMemo(info []byte, viewingKey ViewingKey) ([]byte, error) {
+	if !IsEncryptedMemo(info) {
+		return info, nil
+	}
+
+	ciphertext := new(HybridCipherText)
+	err := ciphertext.SetBytes(info[encryptedMemoHeaderSize:])
+	if err != nil {
+		return nil, err
+	}
+	memo, version, err := HybridDecryptWithVersion(ciphertext, new(Scalar).FromBytesS(viewingKey.Rk))
+	if err != nil {
+		return nil, err
+	}
+	if version != HybridCipherTextVersion2 {
+		return nil, errors.New("encrypted memo can not be decrypted with this viewing key")
+	}
+	return memo, nil
+}
+
+// CoinInfo returns the info of the output coin paying paymentInfo,
+// the message is encrypted to the payee when EncryptMessage is set.
+// A plaintext message starting as an encrypted memo does would be read as one, it is rejected
+func (paymentInfo PaymentInfo) CoinInfo() ([]byte, error) {
+	if len(paymentInfo.Message) == 0 {
+		return nil, nil
+	}
+	if paymentInfo.EncryptMessage {
+		return EncryptMemo(paymentInfo.Message, paymentInfo.PaymentAddress.Tk)
+	}
+	if len(paymentInfo.Message) > MaxSizeInfoCoin {
+		return nil, fmt.Errorf("Size of message %v should be less than %v", len(paymentInfo.Message), MaxSizeInfoCoin)
+	}
+	if IsEncryptedMemo(paymentInfo.Message) {
+		return nil, errors.New("plaintext message must not start with the encrypted memo marker")
+	}
+	return paymentInfo.Message, nil
+}
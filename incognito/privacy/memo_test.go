@@ -0,0 +1,90 @@
+package privacy
+
+import (
+	"crypto/rand"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEncryptDecryptMemo(t *testing.T) {
+	privateKey := GeneratePrivateKey([]byte("encrypted memo"))
+	viewingKey := GenerateViewingKey(privateKey)
+	paymentAddress := GeneratePaymentAddress(privateKey)
+
+	for _, size := range []int{1, 32, MaxSizeEncryptedMemo} {
+		memo := make([]byte, size)
+		rand.Read(memo)
+
+		info, err := EncryptMemo(memo, paymentAddress.Tk)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, IsEncryptedMemo(info))
+		assert.Equal(t, true, len(info) <= MaxSizeInfoCoin)
+
+		// the memo survives the coin serialization
+		outputCoin := new(OutputCoin).Init()
+		outputCoin.CoinDetails.SetInfo(info)
+		outputCoin2 := new(OutputCoin)
+		err = outputCoin2.SetBytes(outputCoin.Bytes())
+		assert.Equal(t, nil, err)
+
+		memo2, err := outputCoin2.DecryptMemo(viewingKey)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, memo, memo2)
+
+		// another viewing key can not read it
+		otherViewingKey := GenerateViewingKey(GeneratePrivateKey([]byte("other")))
+		_, err = DecryptMemo(info, otherViewingKey)
+		assert.NotEqual(t, nil, err)
+
+		// tampering is detected
+		info[len(info)-1] ^= 0x01
+		_, err = DecryptMemo(info, viewingKey)
+		assert.NotEqual(t, nil, err)
+	}
+
+	_, err := EncryptMemo(make([]byte, MaxSizeEncryptedMemo+1), paymentAddress.Tk)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestPaymentInfoCoinInfo(t *testing.T) {
+	privateKey := GeneratePrivateKey([]byte("payment info"))
+	viewingKey := GenerateViewingKey(privateKey)
+
+	paymentInfo := PaymentInfo{
+		PaymentAddress: GeneratePaymentAddress(privateKey),
+		Amount:         10,
+		Message:        []byte("plaintext memo"),
+	}
+
+	// plaintext memos are kept for compatibility
+	info, err := paymentInfo.CoinInfo()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, paymentInfo.Message, info)
+	memo, err := DecryptMemo(info, viewingKey)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, paymentInfo.Message, memo)
+
+	paymentInfo.EncryptMessage = true
+	info, err = paymentInfo.CoinInfo()
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, paymentInfo.Message, info)
+	memo, err = DecryptMemo(info, viewingKey)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, paymentInfo.Message, memo)
+
+	paymentInfo.EncryptMessage = false
+	paymentInfo.Message = make([]byte, MaxSizeInfoCoin+1)
+	_, err = paymentInfo.CoinInfo()
+	assert.NotEqual(t, nil, err)
+
+	// a plaintext message starting with the encrypted memo marker would not decrypt, it is rejected
+	paymentInfo.Message = []byte{encryptedMemoMarker, EncryptedMemoVersion1, 'h', 'i'}
+	_, err = paymentInfo.CoinInfo()
+	assert.NotEqual(t, nil, err)
+	paymentInfo.EncryptMessage = true
+	info, err = paymentInfo.CoinInfo()
+	assert.Equal(t, nil, err)
+	memo, err = DecryptMemo(info, viewingKey)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, paymentInfo.Message, memo)
+}
@@ -0,0 +1,372 @@
+package privacy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+)
+
+// MuSig2 n-of-n Schnorr signatures on top of SchnorrPrivateKey.
+// Signer i holds sk_i with PK_i = G^sk_i, the shared public key is X = sum(a_i * PK_i)
+// with a_i = H(PK_1 || ... || PK_n || PK_i).
+// Round 1: every signer publishes two nonce commitments R_i1 = G^r_i1, R_i2 = G^r_i2.
+// Round 2: with R_j = sum(R_ij), b = H(X || R_1 || R_2 || msg), R = R_1 + b*R_2 and e = H(R || msg),
+// every signer publishes z_i = r_i1 + b*r_i2 - e*a_i*sk_i.
+// (e, sum(z_i)) is a Schnorr signature without privacy that SchnorrPublicKey.Verify accepts for X.
+
+const musigNumNonces = 2
+
+// MuSigKeyAgg holds the public keys of the signers and the aggregated public key
+type MuSigKeyAgg struct {
+	pubKeys       []*Point
+	coefficients  []*Scalar
+	aggregatedKey *Point
+}
+
+// NewMuSigKeyAgg aggregates the public keys of the signers, their order matters
+func NewMuSigKeyAgg(pubKeys []*Point) (*MuSigKeyAgg, error) {
+	if len(pubKeys) == 0 || len(pubKeys) > 255 {
+		return nil, errors.New("number of signers must be in [1, 255]")
+	}
+
+	keyAgg := new(MuSigKeyAgg)
+	keyAgg.pubKeys = make([]*Point, len(pubKeys))
+	keyAgg.coefficients = make([]*Scalar, len(pubKeys))
+
+	prefix := []byte(CStringMuSigKeyAgg)
+	for i, pk := range pubKeys {
+		if pk == nil || !pk.PointValid() {
+			return nil, errors.New("invalid public key")
+		}
+		keyAgg.pubKeys[i] = new(Point).Set(pk)
+		prefix = append(prefix, pk.ToBytesS()...)
+	}
+
+	coefficientPoints := make([]*Point, len(pubKeys))
+	for i, pk := range keyAgg.pubKeys {
+		msg := append(append([]byte{}, prefix...), pk.ToBytesS()...)
+		keyAgg.coefficients[i] = HashToScalar(msg)
+		coefficientPoints[i] = pk
+	}
+	keyAgg.aggregatedKey = new(Point).MultiScalarMult(keyAgg.coefficients, coefficientPoints)
+
+	return keyAgg, nil
+}
+
+// GetAggregatedKey returns the shared public key X
+func (keyAgg MuSigKeyAgg) GetAggregatedKey() *Point {
+	return keyAgg.aggregatedKey
+}
+
+// GetSchnorrPublicKey returns the Schnorr public key verifying the aggregated signatures
+func (keyAgg MuSigKeyAgg) GetSchnorrPublicKey() *SchnorrPublicKey {
+	pubKey := new(SchnorrPublicKey)
+	pubKey.Set(keyAgg.aggregatedKey)
+	return pubKey
+}
+
+// MuSigPublicNonce is the round 1 message of a signer
+type MuSigPublicNonce struct {
+	r [musigNumNonces]*Point
+}
+
+func (nonce MuSigPublicNonce) Bytes() []byte {
+	var res []byte
+	for _, r := range nonce.r {
+		res = append(res, r.ToBytesS()...)
+	}
+	return res
+}
+
+func (nonce *MuSigPublicNonce) SetBytes(bytes []byte) error {
+	if len(bytes) != musigNumNonces*Ed25519KeySize {
+		return errors.New("invalid MuSig public nonce size")
+	}
+	var err error
+	for j := range nonce.r {
+		nonce.r[j], err = new(Point).FromBytesS(bytes[j*Ed25519KeySize : (j+1)*Ed25519KeySize])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MuSigSession is the state of one signer signing one message.
+// It holds secret nonces: it must be kept private and must never be restored twice to sign
+type MuSigSession struct {
+	keyAgg       *MuSigKeyAgg
+	signerIndex  int
+	privateKey   *Scalar
+	msg          []byte
+	secretNonces [musigNumNonces]*Scalar
+	publicNonces []*MuSigPublicNonce
+}
+
+// NewMuSigSession starts a signing session for privateKey on msg, a 32-byte hash,
+// the public key of privateKey must be one of pubKeys
+func NewMuSigSession(privateKey *Scalar, pubKeys []*Point, msg []byte) (*MuSigSession, error) {
+	if len(msg) != common.HashSize {
+		return nil, errors.New("hash length must be 32 bytes")
+	}
+
+	keyAgg, err := NewMuSigKeyAgg(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	session := new(MuSigSession)
+	session.keyAgg = keyAgg
+	session.signerIndex = -1
+	pk := new(Point).ScalarMultBase(privateKey)
+	for i := range keyAgg.pubKeys {
+		if IsPointEqual(pk, keyAgg.pubKeys[i]) {
+			session.signerIndex = i
+			break
+		}
+	}
+	if session.signerIndex < 0 {
+		return nil, errors.New("private key does not belong to the signers")
+	}
+
+	session.privateKey = new(Scalar).Set(privateKey)
+	session.msg = append([]byte{}, msg...)
+
+	// the nonces are random, the private key and the message only matter when the random generator is weak
+	seed := append(RandomScalar().ToBytesS(), privateKey.ToBytesS()...)
+	seed = append(seed, keyAgg.aggregatedKey.ToBytesS()...)
+	seed = append(seed, msg...)
+	ownNonce := new(MuSigPublicNonce)
+	for j := range session.secretNonces {
+		session.secretNonces[j] = HashToScalar(append(append([]byte(CStringMuSigNonce), seed...), byte(j)))
+		ownNonce.r[j] = new(Point).ScalarMultBase(session.secretNonces[j])
+	}
+
+	session.publicNonces = make([]*MuSigPublicNonce, len(keyAgg.pubKeys))
+	session.publicNonces[session.signerIndex] = ownNonce
+	return session, nil
+}
+
+// GetKeyAgg returns the aggregated public keys of the session
+func (session MuSigSession) GetKeyAgg() *MuSigKeyAgg {
+	return session.keyAgg
+}
+
+// GetSignerIndex returns the index of the session's signer in the public keys
+func (session MuSigSession) GetSignerIndex() int {
+	return session.signerIndex
+}
+
+// PublicNonce returns the round 1 message to send to the other signers
+func (session MuSigSession) PublicNonce() *MuSigPublicNonce {
+	return session.publicNonces[session.signerIndex]
+}
+
+// SetPublicNonce records the round 1 message of the signer at index
+func (session *MuSigSession) SetPublicNonce(index int, nonce *MuSigPublicNonce) error {
+	if index < 0 || index >= len(session.publicNonces) {
+		return errors.New("signer index out of range")
+	}
+	if index == session.signerIndex {
+		return errors.New("can not replace own public nonce")
+	}
+	if nonce == nil || nonce.r[0] == nil || nonce.r[1] == nil {
+		return errors.New("invalid MuSig public nonce")
+	}
+	session.publicNonces[index] = nonce
+	return nil
+}
+
+// challenge returns the nonce coefficient b, the aggregated nonce R and the Schnorr challenge e
+func (session MuSigSession) challenge() (*Scalar, *Point, *Scalar, error) {
+	var aggNonces [musigNumNonces]*Point
+	for j := range aggNonces {
+		aggNonces[j] = new(Point).Identity()
+	}
+	for i, nonce := range session.publicNonces {
+		if nonce == nil {
+			return nil, nil, nil, fmt.Errorf("missing public nonce of signer %v", i)
+		}
+		for j := range aggNonces {
+			aggNonces[j].Add(aggNonces[j], nonce.r[j])
+		}
+	}
+
+	msg := append([]byte(CStringMuSigNonce), session.keyAgg.aggregatedKey.ToBytesS()...)
+	for j := range aggNonces {
+		msg = append(msg, aggNonces[j].ToBytesS()...)
+	}
+	msg = append(msg, session.msg...)
+	b := HashToScalar(msg)
+
+	R := new(Point).ScalarMult(aggNonces[1], b)
+	R.Add(R, aggNonces[0])
+
+	// same challenge as SchnorrPrivateKey.Sign
+	e := HashToScalar(append(R.ToBytesS(), session.msg...))
+	return b, R, e, nil
+}
+
+// PartialSign returns the round 2 message once the public nonces of all signers are set.
+// The secret nonces are erased, a session can only sign once
+func (session *MuSigSession) PartialSign() (*Scalar, error) {
+	if session.secretNonces[0] == nil {
+		return nil, errors.New("MuSig session has already signed")
+	}
+	b, _, e, err := session.challenge()
+	if err != nil {
+		return nil, err
+	}
+
+	// z_i = r_i1 + b*r_i2 - e*a_i*sk_i
+	ea := new(Scalar).Mul(e, session.keyAgg.coefficients[session.signerIndex])
+	z := new(Scalar).MulAdd(b, session.secretNonces[1], session.secretNonces[0])
+	z.Sub(z, new(Scalar).Mul(ea, session.privateKey))
+
+	for j := range session.secretNonces {
+		session.secretNonces[j] = nil
+	}
+	return z, nil
+}
+
+// VerifyPartialSignature checks the round 2 message of the signer at index
+func (session MuSigSession) VerifyPartialSignature(index int, partialSig *Scalar) bool {
+	if index < 0 || index >= len(session.publicNonces) || partialSig == nil {
+		return false
+	}
+	b, _, e, err := session.challenge()
+	if err != nil {
+		return false
+	}
+
+	// G^z_i == R_i1 + b*R_i2 - e*a_i*PK_i
+	ea := new(Scalar).Mul(e, session.keyAgg.coefficients[index])
+	lhs := new(Point).ScalarMultBase(partialSig)
+	lhs.Add(lhs, new(Point).ScalarMult(session.keyAgg.pubKeys[index], ea))
+	rhs := new(Point).ScalarMult(session.publicNonces[index].r[1], b)
+	rhs.Add(rhs, session.publicNonces[index].r[0])
+	return IsPointEqual(lhs, rhs)
+}
+
+// AggregateSignatures combines the round 2 messages of all signers, in signer order,
+// into a signature on the session's message for the aggregated public key
+func (session MuSigSession) AggregateSignatures(partialSigs []*Scalar) (*SchnSignature, error) {
+	if len(partialSigs) != len(session.publicNonces) {
+		return nil, errors.New("wrong number of partial signatures")
+	}
+	_, _, e, err := session.challenge()
+	if err != nil {
+		return nil, err
+	}
+
+	signature := new(SchnSignature)
+	signature.e = e
+	signature.z1 = new(Scalar).FromUint64(0)
+	for i, partialSig := range partialSigs {
+		if !session.VerifyPartialSignature(i, partialSig) {
+			return nil, errors.New("invalid partial signature")
+		}
+		signature.z1.Add(signature.z1, partialSig)
+	}
+	signature.z2 = nil
+	return signature, nil
+}
+
+// Bytes serializes the session to resume it between the rounds:
+// n || signer index || public keys || private key || msg || secret nonces || public nonces
+// A public nonce that is not set yet, or a secret nonce that has been used, is serialized as zero bytes
+func (session MuSigSession) Bytes() []byte {
+	n := len(session.keyAgg.pubKeys)
+	res := []byte{byte(n), byte(session.signerIndex)}
+	for _, pk := range session.keyAgg.pubKeys {
+		res = append(res, pk.ToBytesS()...)
+	}
+	res = append(res, session.privateKey.ToBytesS()...)
+	res = append(res, session.msg...)
+	for _, r := range session.secretNonces {
+		if r == nil {
+			res = append(res, make([]byte, Ed25519KeySize)...)
+		} else {
+			res = append(res, r.ToBytesS()...)
+		}
+	}
+	for _, nonce := range session.publicNonces {
+		if nonce == nil {
+			res = append(res, make([]byte, musigNumNonces*Ed25519KeySize)...)
+		} else {
+			res = append(res, nonce.Bytes()...)
+		}
+	}
+	return res
+}
+
+// SetBytes restores a session serialized by Bytes
+func (session *MuSigSession) SetBytes(b []byte) error {
+	if len(b) < 2 {
+		return errors.New("invalid MuSig session size")
+	}
+	n := int(b[0])
+	signerIndex := int(b[1])
+	expectedSize := 2 + n*Ed25519KeySize + Ed25519KeySize + common.HashSize + musigNumNonces*Ed25519KeySize + n*musigNumNonces*Ed25519KeySize
+	if n == 0 || signerIndex >= n || len(b) != expectedSize {
+		return errors.New("invalid MuSig session size")
+	}
+
+	offset := 2
+	pubKeys := make([]*Point, n)
+	var err error
+	for i := range pubKeys {
+		pubKeys[i], err = new(Point).FromBytesS(b[offset : offset+Ed25519KeySize])
+		if err != nil {
+			return err
+		}
+		offset += Ed25519KeySize
+	}
+	keyAgg, err := NewMuSigKeyAgg(pubKeys)
+	if err != nil {
+		return err
+	}
+
+	privateKey := new(Scalar).FromBytesS(b[offset : offset+Ed25519KeySize])
+	offset += Ed25519KeySize
+	if !IsPointEqual(new(Point).ScalarMultBase(privateKey), pubKeys[signerIndex]) {
+		return errors.New("private key does not match the signer's public key")
+	}
+	msg := append([]byte{}, b[offset:offset+common.HashSize]...)
+	offset += common.HashSize
+
+	var secretNonces [musigNumNonces]*Scalar
+	for j := range secretNonces {
+		r := new(Scalar).FromBytesS(b[offset : offset+Ed25519KeySize])
+		if !r.IsZero() {
+			secretNonces[j] = r
+		}
+		offset += Ed25519KeySize
+	}
+
+	zeroNonce := make([]byte, musigNumNonces*Ed25519KeySize)
+	publicNonces := make([]*MuSigPublicNonce, n)
+	for i := range publicNonces {
+		nonceBytes := b[offset : offset+musigNumNonces*Ed25519KeySize]
+		offset += musigNumNonces * Ed25519KeySize
+		if bytes.Equal(nonceBytes, zeroNonce) {
+			continue
+		}
+		publicNonces[i] = new(MuSigPublicNonce)
+		if err := publicNonces[i].SetBytes(nonceBytes); err != nil {
+			return err
+		}
+	}
+	if publicNonces[signerIndex] == nil {
+		return errors.New("missing own public nonce")
+	}
+
+	session.keyAgg = keyAgg
+	session.signerIndex = signerIndex
+	session.privateKey = privateKey
+	session.msg = msg
+	session.secretNonces = secretNonces
+	session.publicNonces = publicNonces
+	return nil
+}
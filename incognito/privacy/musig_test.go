@@ -0,0 +1,172 @@
+package privacy
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// runMuSigRound1 simulates the signers exchanging serialized messages and returns their sessions after round 1
+func runMuSigRound1(t *testing.T, privateKeys []*Scalar, pubKeys []*Point, msg []byte) []*MuSigSession {
+	sessions := make([]*MuSigSession, len(privateKeys))
+	for i, sk := range privateKeys {
+		var err error
+		sessions[i], err = NewMuSigSession(sk, pubKeys, msg)
+		assert.Equal(t, nil, err)
+	}
+
+	for i := range sessions {
+		nonceBytes := sessions[i].PublicNonce().Bytes()
+		for j := range sessions {
+			if i == j {
+				continue
+			}
+			nonce := new(MuSigPublicNonce)
+			assert.Equal(t, nil, nonce.SetBytes(nonceBytes))
+			assert.Equal(t, nil, sessions[j].SetPublicNonce(sessions[i].GetSignerIndex(), nonce))
+		}
+	}
+	return sessions
+}
+
+func newMuSigSigners(n int) ([]*Scalar, []*Point) {
+	privateKeys := make([]*Scalar, n)
+	pubKeys := make([]*Point, n)
+	for i := range privateKeys {
+		privateKeys[i] = RandomScalar()
+		pubKeys[i] = new(Point).ScalarMultBase(privateKeys[i])
+	}
+	return privateKeys, pubKeys
+}
+
+func TestMuSig(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7} {
+		privateKeys, pubKeys := newMuSigSigners(n)
+		msg := common.HashB([]byte("musig message"))
+
+		sessions := runMuSigRound1(t, privateKeys, pubKeys, msg)
+
+		partialSigs := make([]*Scalar, n)
+		for i, session := range sessions {
+			var err error
+			partialSigs[i], err = session.PartialSign()
+			assert.Equal(t, nil, err)
+		}
+
+		for _, session := range sessions {
+			signature, err := session.AggregateSignatures(partialSigs)
+			assert.Equal(t, nil, err)
+
+			// the signature is an ordinary Schnorr signature for the aggregated key
+			signature2 := new(SchnSignature)
+			assert.Equal(t, nil, signature2.SetBytes(signature.Bytes()))
+			pubKey := session.GetKeyAgg().GetSchnorrPublicKey()
+			assert.Equal(t, true, pubKey.Verify(signature2, msg))
+			assert.Equal(t, false, pubKey.Verify(signature2, common.HashB([]byte("other message"))))
+		}
+
+		// a session signs only once
+		_, err := sessions[0].PartialSign()
+		assert.NotEqual(t, nil, err)
+	}
+}
+
+func TestMuSigInvalidPartialSignature(t *testing.T) {
+	privateKeys, pubKeys := newMuSigSigners(3)
+	msg := common.HashB([]byte("musig message"))
+	sessions := runMuSigRound1(t, privateKeys, pubKeys, msg)
+
+	partialSigs := make([]*Scalar, len(sessions))
+	for i, session := range sessions {
+		var err error
+		partialSigs[i], err = session.PartialSign()
+		assert.Equal(t, nil, err)
+	}
+	partialSigs[1] = new(Scalar).Add(partialSigs[1], new(Scalar).FromUint64(1))
+
+	assert.Equal(t, true, sessions[0].VerifyPartialSignature(0, partialSigs[0]))
+	assert.Equal(t, false, sessions[0].VerifyPartialSignature(1, partialSigs[1]))
+	assert.Equal(t, true, sessions[0].VerifyPartialSignature(2, partialSigs[2]))
+	_, err := sessions[0].AggregateSignatures(partialSigs)
+	assert.NotEqual(t, nil, err)
+
+	_, err = sessions[0].AggregateSignatures(partialSigs[:2])
+	assert.NotEqual(t, nil, err)
+}
+
+func TestMuSigSessionErrors(t *testing.T) {
+	privateKeys, pubKeys := newMuSigSigners(3)
+	msg := common.HashB([]byte("musig message"))
+
+	_, err := NewMuSigSession(RandomScalar(), pubKeys, msg)
+	assert.NotEqual(t, nil, err)
+	_, err = NewMuSigSession(privateKeys[0], pubKeys, msg[:31])
+	assert.NotEqual(t, nil, err)
+	_, err = NewMuSigSession(privateKeys[0], nil, msg)
+	assert.NotEqual(t, nil, err)
+
+	// signing needs the nonces of every signer
+	session, err := NewMuSigSession(privateKeys[0], pubKeys, msg)
+	assert.Equal(t, nil, err)
+	_, err = session.PartialSign()
+	assert.NotEqual(t, nil, err)
+	assert.NotEqual(t, nil, session.SetPublicNonce(0, new(MuSigPublicNonce)))
+	assert.NotEqual(t, nil, session.SetPublicNonce(3, session.PublicNonce()))
+}
+
+func TestMuSigSessionBytes(t *testing.T) {
+	privateKeys, pubKeys := newMuSigSigners(3)
+	msg := common.HashB([]byte("musig message"))
+	sessions := runMuSigRound1(t, privateKeys, pubKeys, msg)
+
+	// a signer stores its session between the rounds
+	for i := range sessions {
+		restored := new(MuSigSession)
+		assert.Equal(t, nil, restored.SetBytes(sessions[i].Bytes()))
+		assert.Equal(t, sessions[i].Bytes(), restored.Bytes())
+		assert.Equal(t, true, IsPointEqual(sessions[i].GetKeyAgg().GetAggregatedKey(), restored.GetKeyAgg().GetAggregatedKey()))
+		sessions[i] = restored
+	}
+
+	partialSigs := make([]*Scalar, len(sessions))
+	for i, session := range sessions {
+		var err error
+		partialSigs[i], err = session.PartialSign()
+		assert.Equal(t, nil, err)
+	}
+	signature, err := sessions[2].AggregateSignatures(partialSigs)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, sessions[2].GetKeyAgg().GetSchnorrPublicKey().Verify(signature, msg))
+
+	// the used secret nonces are not serialized
+	restored := new(MuSigSession)
+	assert.Equal(t, nil, restored.SetBytes(sessions[0].Bytes()))
+	_, err = restored.PartialSign()
+	assert.NotEqual(t, nil, err)
+
+	// a session before round 1 completes keeps the missing nonces missing
+	session, err := NewMuSigSession(privateKeys[1], pubKeys, msg)
+	assert.Equal(t, nil, err)
+	restored = new(MuSigSession)
+	assert.Equal(t, nil, restored.SetBytes(session.Bytes()))
+	_, err = restored.PartialSign()
+	assert.NotEqual(t, nil, err)
+
+	assert.NotEqual(t, nil, restored.SetBytes(session.Bytes()[:10]))
+	wrongKey := session.Bytes()
+	copy(wrongKey[2+3*Ed25519KeySize:], RandomScalar().ToBytesS())
+	assert.NotEqual(t, nil, restored.SetBytes(wrongKey))
+}
+
+func TestMuSigKeyAggOrder(t *testing.T) {
+	_, pubKeys := newMuSigSigners(2)
+	keyAgg1, err := NewMuSigKeyAgg(pubKeys)
+	assert.Equal(t, nil, err)
+	keyAgg2, err := NewMuSigKeyAgg([]*Point{pubKeys[1], pubKeys[0]})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, IsPointEqual(keyAgg1.GetAggregatedKey(), keyAgg2.GetAggregatedKey()))
+
+	// the aggregated key is not the plain sum of the keys
+	sum := new(Point).Add(pubKeys[0], pubKeys[1])
+	assert.Equal(t, false, IsPointEqual(keyAgg1.GetAggregatedKey(), sum))
+}
@@ -22,6 +22,8 @@ type PedersenCommitment struct {
 	// G[2]: SNDerivator
 	// G[3]: ShardID
 	// G[4]: Randomness
+
+	precomputed *pedersenPrecomputed
 }
 
 func newPedersenParams() PedersenCommitment {
@@ -31,8 +33,9 @@ func newPedersenParams() PedersenCommitment {
 	pcm.G[0] = new(Point).ScalarMultBase(new(Scalar).FromUint64(1))
 
 	for i := 1; i < len(pcm.G); i++ {
-		pcm.G[i] = HashToPointFromIndex(int64(i), CStringBulletProof)
+		pcm.G[i] = GeneratorFromIndex(int64(i))
 	}
+	pcm.precomputed = new(pedersenPrecomputed)
 	GBase = new(Point).Set(pcm.G[1])
 	HBase = new(Point).Set(pcm.G[4])
 	return pcm
@@ -61,5 +64,9 @@ func (com PedersenCommitment) CommitAtIndex(value, rand *Scalar, index byte) *Po
 	//commitment.Add(commitment, new(Point).ScalarMult(com.G[PedersenRandomnessIndex], rand))
 	//
 	//return commitment
-	return new(Point).AddPedersen(value, com.G[index], rand, com.G[PedersenRandomnessIndex])
+	if com.precomputed == nil {
+		return new(Point).AddPedersen(value, com.G[index], rand, com.G[PedersenRandomnessIndex])
+	}
+	pre := com.precomputed.get()
+	return new(Point).AddPedersenCached(value, pre[index], rand, pre[PedersenRandomnessIndex])
 }
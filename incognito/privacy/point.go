@@ -136,8 +136,11 @@ func (p *Point) ScalarMult(pa *Point, a *Scalar) *Point {
 	if p == nil {
 		p = new(Point)
 	}
-	key := C25519.ScalarMultKey(&pa.key, &a.key)
-	p.key = *key
+	var point C25519.ExtendedGroupElement
+	point.FromBytes(&pa.key)
+	var res C25519.ProjectiveGroupElement
+	C25519.GeScalarMult(&res, &a.key, &point)
+	res.ToBytes(&p.key)
 	return p
 }
 
@@ -153,8 +156,11 @@ func (p *Point) MultiScalarMultCached(scalarLs []*Scalar, pointPreComputedLs [][
 		scalarKeyLs[i] = &scalarLs[i].key
 	}
 	key := C25519.MultiScalarMultKeyCached(pointPreComputedLs, scalarKeyLs)
-	res, _ := new(Point).SetKey(key)
-	return res
+	if p == nil {
+		p = new(Point)
+	}
+	p.key = *key
+	return p
 }
 
 func (p *Point) MultiScalarMult(scalarLs []*Scalar, pointLs []*Point) *Point {
@@ -172,8 +178,11 @@ func (p *Point) MultiScalarMult(scalarLs []*Scalar, pointLs []*Point) *Point {
 		pointKeyLs[i] = &pointLs[i].key
 	}
 	key := C25519.MultiScalarMultKey(pointKeyLs, scalarKeyLs)
-	res, _ := new(Point).SetKey(key)
-	return res
+	if p == nil {
+		p = new(Point)
+	}
+	p.key = *key
+	return p
 }
 
 func (p *Point) InvertScalarMultBase(a *Scalar) *Point {
@@ -213,14 +222,14 @@ func (p *Point) AddPedersen(a *Scalar, A *Point, b *Scalar, B *Point) *Point {
 	}
 
 	var A_Precomputed [8]C25519.CachedGroupElement
-	Ae := new(C25519.ExtendedGroupElement)
+	var Ae C25519.ExtendedGroupElement
 	Ae.FromBytes(&A.key)
-	C25519.GePrecompute(&A_Precomputed, Ae)
+	C25519.GePrecompute(&A_Precomputed, &Ae)
 
 	var B_Precomputed [8]C25519.CachedGroupElement
-	Be := new(C25519.ExtendedGroupElement)
+	var Be C25519.ExtendedGroupElement
 	Be.FromBytes(&B.key)
-	C25519.GePrecompute(&B_Precomputed, Be)
+	C25519.GePrecompute(&B_Precomputed, &Be)
 
 	var key C25519.Key
 	C25519.AddKeys3_3(&key, &a.key, &A_Precomputed, &b.key, &B_Precomputed)
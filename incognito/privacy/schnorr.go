@@ -1,9 +1,12 @@
 package privacy
 
 import (
+	"crypto/hmac"
+	"crypto/sha512"
 	"crypto/subtle"
 	"errors"
 	"github.com/0xkraken/incognito-wasm/incognito/common"
+	C25519 "github.com/0xkraken/incognito-wasm/incognito/privacy/curve25519"
 )
 
 // SchnorrPublicKey represents Schnorr Publickey
@@ -53,7 +56,19 @@ func (publicKey *SchnorrPublicKey) Set(pk *Point) {
 }
 
 //Sign is function which using for signing on hash array by private key
+// The nonces are derived from the private key and data and hedged with fresh randomness,
+// so a weak random generator can not make two signatures share a nonce
 func (privateKey SchnorrPrivateKey) Sign(data []byte) (*SchnSignature, error) {
+	return privateKey.sign(data, RandomScalar().ToBytesS())
+}
+
+// SignDeterministic signs on hash array with nonces derived from the private key and data only,
+// signing the same data twice gives the same signature
+func (privateKey SchnorrPrivateKey) SignDeterministic(data []byte) (*SchnSignature, error) {
+	return privateKey.sign(data, nil)
+}
+
+func (privateKey SchnorrPrivateKey) sign(data []byte, extraEntropy []byte) (*SchnSignature, error) {
 	if len(data) != common.HashSize {
 		return nil, NewPrivacyErr(UnexpectedErr, errors.New("hash length must be 32 bytes"))
 	}
@@ -62,10 +77,10 @@ func (privateKey SchnorrPrivateKey) Sign(data []byte) (*SchnSignature, error) {
 
 	// has privacy
 	if !privateKey.randomness.IsZero() {
-		// generates random numbers s1, s2 in [0, Curve.Params().N - 1]
+		// derives nonces s1, s2 in [0, Curve.Params().N - 1]
 
-		s1 := RandomScalar()
-		s2 := RandomScalar()
+		s1 := privateKey.nonce(data, extraEntropy, 1)
+		s2 := privateKey.nonce(data, extraEntropy, 2)
 
 		// t = s1*G + s2*H
 		t := new(Point).ScalarMult(privateKey.publicKey.g, s1)
@@ -85,8 +100,8 @@ func (privateKey SchnorrPrivateKey) Sign(data []byte) (*SchnSignature, error) {
 		return signature, nil
 	}
 
-	// generates random numbers s, k2 in [0, Curve.Params().N - 1]
-	s := RandomScalar()
+	// derives nonce s in [0, Curve.Params().N - 1]
+	s := privateKey.nonce(data, extraEntropy, 1)
 
 	// t = s*G
 	t := new(Point).ScalarMult(privateKey.publicKey.g, s)
@@ -104,6 +119,30 @@ func (privateKey SchnorrPrivateKey) Sign(data []byte) (*SchnSignature, error) {
 	return signature, nil
 }
 
+// nonce derives the signing nonce number index in the manner of RFC 6979:
+// HMAC-SHA512 keyed by the private key over the signed hash and extraEntropy, reduced modulo the group order.
+// The counter only changes in the negligible case the result is zero
+func (privateKey SchnorrPrivateKey) nonce(data []byte, extraEntropy []byte, index byte) *Scalar {
+	key := append(privateKey.privateKey.ToBytesS(), privateKey.randomness.ToBytesS()...)
+	mac := hmac.New(sha512.New, key)
+
+	res := new(Scalar)
+	var digest [64]byte
+	for counter := byte(0); ; counter++ {
+		mac.Reset()
+		mac.Write([]byte(CStringSchnorrNonce))
+		mac.Write([]byte{index, counter})
+		mac.Write(data)
+		mac.Write(extraEntropy)
+		copy(digest[:], mac.Sum(nil))
+
+		C25519.ScReduce(&res.key, &digest)
+		if !res.IsZero() {
+			return res
+		}
+	}
+}
+
 //Verify is function which using for verify that the given signature was signed by by privatekey of the public key
 func (publicKey SchnorrPublicKey) Verify(signature *SchnSignature, data []byte) bool {
 	if signature == nil {
@@ -120,6 +159,45 @@ func (publicKey SchnorrPublicKey) Verify(signature *SchnSignature, data []byte)
 	return subtle.ConstantTimeCompare(ev.ToBytesS(), signature.e.ToBytesS()) == 1
 }
 
+// VerifySchnorrMany verifies sigs[i] on msgs[i] against pubKeys[i] one after another
+// and returns the index of the first invalid signature.
+// It is not a batch verification: a signature carries the challenge e = H(t || msg) instead of the commitment t,
+// so t has to be recovered for every signature to check e. The signatures share the precomputed tables
+// of the bases G and H, so only e*PK is a full scalar multiplication
+func VerifySchnorrMany(pubKeys []*SchnorrPublicKey, sigs []*SchnSignature, msgs [][]byte) (bool, error, int) {
+	if len(pubKeys) != len(sigs) || len(sigs) != len(msgs) {
+		return false, errors.New("public keys, signatures and messages must have the same length"), -1
+	}
+
+	pre := PedCom.precomputed.get()
+	gPrecomputed := pre[PedersenPrivateKeyIndex]
+	hPrecomputed := pre[PedersenRandomnessIndex]
+
+	zero := new(Scalar)
+	rv := new(Point)
+	tmp := new(Point)
+	for i, signature := range sigs {
+		if signature == nil || signature.e == nil || signature.z1 == nil || pubKeys[i] == nil || pubKeys[i].publicKey == nil {
+			return false, nil, i
+		}
+
+		// rv = e*PK + z1*G + z2*H, z2 is nil when has no privacy
+		z2 := signature.z2
+		if z2 == nil {
+			z2 = zero
+		}
+		rv.AddPedersenCached(signature.z1, gPrecomputed, z2, hPrecomputed)
+		rv.Add(rv, tmp.ScalarMult(pubKeys[i].publicKey, signature.e))
+
+		msg := append(rv.ToBytesS(), msgs[i]...)
+		ev := HashToScalar(msg)
+		if subtle.ConstantTimeCompare(ev.ToBytesS(), signature.e.ToBytesS()) != 1 {
+			return false, nil, i
+		}
+	}
+	return true, nil, -1
+}
+
 func (sig SchnSignature) Bytes() []byte {
 	bytes := append(sig.e.ToBytesS(), sig.z1.ToBytesS()...)
 	// Z2 is nil when has no privacy
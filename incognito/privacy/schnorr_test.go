@@ -1,8 +1,12 @@
 package privacy
 
 import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
 	"testing"
 
+	C25519 "github.com/0xkraken/incognito-wasm/incognito/privacy/curve25519"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -59,3 +63,171 @@ func TestSchnorrSignatureWithoutZ2(t *testing.T) {
 		assert.Equal(t, true, res)
 	}
 }
+
+func TestSchnorrSignatureDeterministic(t *testing.T) {
+	sk := HashToScalar([]byte("schnorr private key"))
+	r := HashToScalar([]byte("schnorr randomness"))
+	data, _ := hex.DecodeString("aa23a6208bd9aa8a11040754028ed584a0d784148cbe0b7780c471cdd69bc001")
+	assert.Equal(t, HashToScalar([]byte("schnorr message")).ToBytesS(), data)
+
+	testcases := []struct {
+		randomness *Scalar
+		signature  string
+	}{
+		{r, "e5bd531c6b1386ceb9fb72138652ae74d87906ab45e658a3ddcb431b1f37f1097ee65e034d99085b2ec63b389e97d2f9b1c60e18a70d5b91687cdece0c263d0d093beb09ecbaa1153d510a3afce73c42f8c7411f2b36393ed12c702159a9420d"},
+		{new(Scalar).FromUint64(0), "28d3a0ddd57b3aacef9a8f921c06e6e02249294562a668d060d57218702410026d3a82dae5b09c1c37bdf248c6c7ee6c2408fc29b6a14cb56e995cb23393df01"},
+	}
+
+	for _, tc := range testcases {
+		privKey := new(SchnorrPrivateKey)
+		privKey.Set(sk, tc.randomness)
+
+		signature, err := privKey.SignDeterministic(data)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, tc.signature, hex.EncodeToString(signature.Bytes()))
+		assert.Equal(t, true, privKey.publicKey.Verify(signature, data))
+
+		// the nonce s1 = z1 + e*sk is HMAC-SHA512(sk || r, domain || 1 || 0 || data) mod l
+		mac := hmac.New(sha512.New, append(sk.ToBytesS(), tc.randomness.ToBytesS()...))
+		mac.Write([]byte(CStringSchnorrNonce))
+		mac.Write([]byte{1, 0})
+		mac.Write(data)
+		var digest [64]byte
+		copy(digest[:], mac.Sum(nil))
+		expectedNonce := new(Scalar)
+		C25519.ScReduce(&expectedNonce.key, &digest)
+
+		nonce := new(Scalar).MulAdd(signature.e, sk, signature.z1)
+		assert.Equal(t, expectedNonce, nonce)
+
+		// signing again gives the same signature, signing other data gives another nonce
+		signature2, _ := privKey.SignDeterministic(data)
+		assert.Equal(t, signature, signature2)
+		otherData := RandomScalar().ToBytesS()
+		signature3, _ := privKey.SignDeterministic(otherData)
+		assert.NotEqual(t, nonce, new(Scalar).MulAdd(signature3.e, sk, signature3.z1))
+		assert.Equal(t, true, privKey.publicKey.Verify(signature3, otherData))
+	}
+}
+
+func TestSchnorrSignatureHedged(t *testing.T) {
+	privKey := new(SchnorrPrivateKey)
+	privKey.Set(RandomScalar(), RandomScalar())
+	data := RandomScalar().ToBytesS()
+
+	// hedged signatures on the same data use different nonces but verify against the same public key
+	signature1, err := privKey.Sign(data)
+	assert.Equal(t, nil, err)
+	signature2, err := privKey.Sign(data)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, signature1.Bytes(), signature2.Bytes())
+
+	pubKey := new(SchnorrPublicKey)
+	pubKey.Set(privKey.GetPublicKey().GetPublicKey())
+	assert.Equal(t, true, pubKey.Verify(signature1, data))
+	assert.Equal(t, true, pubKey.Verify(signature2, data))
+
+	_, err = privKey.SignDeterministic(data[:16])
+	assert.NotEqual(t, nil, err)
+}
+
+func newSchnorrBatch(n int, hasPrivacy bool) ([]*SchnorrPublicKey, []*SchnSignature, [][]byte) {
+	pubKeys := make([]*SchnorrPublicKey, n)
+	sigs := make([]*SchnSignature, n)
+	msgs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		// mix both signature modes when hasPrivacy is set, as Tx.signTx does for txs with and without privacy
+		r := new(Scalar).FromUint64(0)
+		if hasPrivacy && i%2 == 0 {
+			r = RandomScalar()
+		}
+		privKey := new(SchnorrPrivateKey)
+		privKey.Set(RandomScalar(), r)
+
+		msgs[i] = RandomScalar().ToBytesS()
+		sigs[i], _ = privKey.Sign(msgs[i])
+
+		// verifiers only know the public key
+		pubKeys[i] = new(SchnorrPublicKey)
+		pubKeys[i].Set(privKey.GetPublicKey().GetPublicKey())
+	}
+	return pubKeys, sigs, msgs
+}
+
+func TestVerifySchnorrMany(t *testing.T) {
+	for _, hasPrivacy := range []bool{false, true} {
+		pubKeys, sigs, msgs := newSchnorrBatch(20, hasPrivacy)
+		for i := range sigs {
+			if hasPrivacy && i%2 == 0 {
+				assert.Equal(t, 3*Ed25519KeySize, len(sigs[i].Bytes()))
+			} else {
+				assert.Equal(t, 2*Ed25519KeySize, len(sigs[i].Bytes()))
+			}
+		}
+
+		res, err, index := VerifySchnorrMany(pubKeys, sigs, msgs)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, true, res)
+		assert.Equal(t, -1, index)
+
+		// the bad signature is located
+		for _, bad := range []int{0, 7, 19} {
+			msgs[bad][0] ^= 0x01
+			res, err, index = VerifySchnorrMany(pubKeys, sigs, msgs)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, false, res)
+			assert.Equal(t, bad, index)
+			assert.Equal(t, false, pubKeys[bad].Verify(sigs[bad], msgs[bad]))
+			msgs[bad][0] ^= 0x01
+		}
+
+		// a signature checked against another public key
+		pubKeys[3], pubKeys[4] = pubKeys[4], pubKeys[3]
+		res, _, index = VerifySchnorrMany(pubKeys, sigs, msgs)
+		assert.Equal(t, false, res)
+		assert.Equal(t, 3, index)
+		pubKeys[3], pubKeys[4] = pubKeys[4], pubKeys[3]
+
+		// a privacy signature stripped of z2
+		if hasPrivacy {
+			stripped := new(SchnSignature)
+			stripped.SetBytes(sigs[0].Bytes()[:2*Ed25519KeySize])
+			sigs[0], stripped = stripped, sigs[0]
+			res, _, index = VerifySchnorrMany(pubKeys, sigs, msgs)
+			assert.Equal(t, false, res)
+			assert.Equal(t, 0, index)
+			sigs[0] = stripped
+		}
+
+		sigs[5] = nil
+		res, _, index = VerifySchnorrMany(pubKeys, sigs, msgs)
+		assert.Equal(t, false, res)
+		assert.Equal(t, 5, index)
+	}
+
+	res, err, _ := VerifySchnorrMany(make([]*SchnorrPublicKey, 2), make([]*SchnSignature, 1), make([][]byte, 2))
+	assert.Equal(t, false, res)
+	assert.NotEqual(t, nil, err)
+
+	res, err, _ = VerifySchnorrMany(nil, nil, nil)
+	assert.Equal(t, true, res)
+	assert.Equal(t, nil, err)
+}
+
+func BenchmarkSchnorrVerify(b *testing.B) {
+	pubKeys, sigs, msgs := newSchnorrBatch(100, true)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range sigs {
+			pubKeys[i].Verify(sigs[i], msgs[i])
+		}
+	}
+}
+
+func BenchmarkVerifySchnorrMany(b *testing.B) {
+	pubKeys, sigs, msgs := newSchnorrBatch(100, true)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		VerifySchnorrMany(pubKeys, sigs, msgs)
+	}
+}
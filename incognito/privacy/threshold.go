@@ -0,0 +1,345 @@
+package privacy
+
+import (
+	"errors"
+	"fmt"
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"math/big"
+	"sort"
+)
+
+// t-of-n threshold keys with Feldman verifiable secret sharing.
+// The dealer picks f(x) = sk + a_1*x + ... + a_(t-1)*x^(t-1) mod L, sends the share f(i) to the signer i
+// and publishes the commitments C_j = G^a_j, C_0 = G^sk is the public key of the shared key.
+// Any t signers sign for G^sk in two rounds, the partial signature of signer i uses lambda_i * f(i)
+// where lambda_i is its Lagrange coefficient at 0.
+
+// MaxThresholdSigners is the largest number of shares of a threshold key
+const MaxThresholdSigners = 255
+
+// SecretShare is the share f(Index) of a threshold key, Index is in [1, MaxThresholdSigners]
+type SecretShare struct {
+	Index int
+	Value *Scalar
+}
+
+func (share SecretShare) Bytes() []byte {
+	return append([]byte{byte(share.Index)}, share.Value.ToBytesS()...)
+}
+
+func (share *SecretShare) SetBytes(b []byte) error {
+	if len(b) != 1+Ed25519KeySize || b[0] == 0 {
+		return errors.New("invalid secret share")
+	}
+	share.Index = int(b[0])
+	share.Value = new(Scalar).FromBytesS(b[1:])
+	return nil
+}
+
+// SplitSecret splits secret into n shares, any threshold of them recover it.
+// It returns the shares of the signers 1 to n and the commitments to the polynomial coefficients
+func SplitSecret(secret *Scalar, threshold int, n int) ([]*SecretShare, []*Point, error) {
+	if threshold < 1 || threshold > n || n > MaxThresholdSigners {
+		return nil, nil, fmt.Errorf("invalid threshold %v of %v signers", threshold, n)
+	}
+
+	coefficients := make([]*Scalar, threshold)
+	coefficients[0] = new(Scalar).Set(secret)
+	for j := 1; j < threshold; j++ {
+		coefficients[j] = RandomScalar()
+	}
+
+	poly := make(Poly, threshold)
+	commitments := make([]*Point, threshold)
+	for j, coefficient := range coefficients {
+		poly[j] = ScalarToBigInt(coefficient)
+		commitments[j] = new(Point).ScalarMultBase(coefficient)
+	}
+
+	shares := make([]*SecretShare, n)
+	for i := 1; i <= n; i++ {
+		shares[i-1] = &SecretShare{
+			Index: i,
+			Value: BigIntToScalar(poly.eval(big.NewInt(int64(i)), LInt)),
+		}
+	}
+	return shares, commitments, nil
+}
+
+// ThresholdPublicKey returns the public key of the shared secret
+func ThresholdPublicKey(commitments []*Point) *Point {
+	if len(commitments) == 0 {
+		return nil
+	}
+	return commitments[0]
+}
+
+// PublicShare returns G^f(index) computed from the commitments
+func PublicShare(index int, commitments []*Point) *Point {
+	powers := make([]*Scalar, len(commitments))
+	x := big.NewInt(int64(index))
+	power := big.NewInt(1)
+	for j := range commitments {
+		powers[j] = BigIntToScalar(power)
+		power = new(big.Int).Mod(new(big.Int).Mul(power, x), LInt)
+	}
+	return new(Point).MultiScalarMult(powers, commitments)
+}
+
+// VerifyShare checks a share received from the dealer against the published commitments
+func VerifyShare(share *SecretShare, commitments []*Point) bool {
+	if share == nil || share.Value == nil || share.Index < 1 || share.Index > MaxThresholdSigners || len(commitments) == 0 {
+		return false
+	}
+	for _, commitment := range commitments {
+		if commitment == nil || !commitment.PointValid() {
+			return false
+		}
+	}
+	return IsPointEqual(new(Point).ScalarMultBase(share.Value), PublicShare(share.Index, commitments))
+}
+
+// LagrangeCoefficient returns the Lagrange coefficient at 0 of index among the distinct indices,
+// prod(x_j / (x_j - x_i)) mod L
+func LagrangeCoefficient(index int, indices []int) (*Scalar, error) {
+	num := big.NewInt(1)
+	den := big.NewInt(1)
+	found := false
+	for _, j := range indices {
+		if j == index {
+			if found {
+				return nil, errors.New("duplicate share index")
+			}
+			found = true
+			continue
+		}
+		num.Mul(num, big.NewInt(int64(j)))
+		num.Mod(num, LInt)
+		den.Mul(den, big.NewInt(int64(j-index)))
+		den.Mod(den, LInt)
+	}
+	if !found {
+		return nil, errors.New("share index is not among the indices")
+	}
+	if den.Sign() == 0 {
+		return nil, errors.New("duplicate share index")
+	}
+	den.ModInverse(den, LInt)
+	return BigIntToScalar(num.Mul(num, den).Mod(num, LInt)), nil
+}
+
+// CombineShares recovers the secret from at least threshold shares
+func CombineShares(shares []*SecretShare) (*Scalar, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no secret shares")
+	}
+	indices := make([]int, len(shares))
+	for i, share := range shares {
+		if share == nil || share.Value == nil {
+			return nil, errors.New("invalid secret share")
+		}
+		indices[i] = share.Index
+	}
+
+	secret := new(Scalar).FromUint64(0)
+	for _, share := range shares {
+		lambda, err := LagrangeCoefficient(share.Index, indices)
+		if err != nil {
+			return nil, err
+		}
+		secret.MulAdd(lambda, share.Value, secret)
+	}
+	return secret, nil
+}
+
+// ThresholdSession is the state of one signer signing one message with the other signers in signers.
+// Every signer publishes two nonce commitments D_i, E_i; with rho_i = H(X || i || msg || all nonces),
+// R = sum(D_i + rho_i*E_i) and e = H(R || msg), the signer i publishes z_i = d_i + rho_i*e_i - e*lambda_i*f(i)
+type ThresholdSession struct {
+	share        *SecretShare
+	commitments  []*Point
+	signers      []int
+	msg          []byte
+	secretNonces [musigNumNonces]*Scalar
+	publicNonces []*MuSigPublicNonce
+}
+
+// NewThresholdSession starts a signing session of share on msg, a 32-byte hash,
+// signers are the indices of the at least threshold signers including share.Index
+func NewThresholdSession(share *SecretShare, commitments []*Point, signers []int, msg []byte) (*ThresholdSession, error) {
+	if len(msg) != common.HashSize {
+		return nil, errors.New("hash length must be 32 bytes")
+	}
+	if !VerifyShare(share, commitments) {
+		return nil, errors.New("secret share does not match the commitments")
+	}
+	if len(signers) < len(commitments) {
+		return nil, fmt.Errorf("%v signers are less than the threshold %v", len(signers), len(commitments))
+	}
+
+	session := new(ThresholdSession)
+	session.signers = append([]int{}, signers...)
+	sort.Ints(session.signers)
+	for i := 1; i < len(session.signers); i++ {
+		if session.signers[i] == session.signers[i-1] {
+			return nil, errors.New("duplicate signer index")
+		}
+	}
+	if session.signers[0] < 1 || session.signers[len(session.signers)-1] > MaxThresholdSigners {
+		return nil, errors.New("signer index out of range")
+	}
+	pos := session.position(share.Index)
+	if pos < 0 {
+		return nil, errors.New("share index is not among the signers")
+	}
+
+	session.share = &SecretShare{Index: share.Index, Value: new(Scalar).Set(share.Value)}
+	session.commitments = commitments
+	session.msg = append([]byte{}, msg...)
+
+	// the nonces are random, the share and the message only matter when the random generator is weak
+	seed := append(RandomScalar().ToBytesS(), share.Bytes()...)
+	seed = append(seed, msg...)
+	ownNonce := new(MuSigPublicNonce)
+	for j := range session.secretNonces {
+		session.secretNonces[j] = HashToScalar(append(append([]byte(CStringThresholdNonce), seed...), byte(j)))
+		ownNonce.r[j] = new(Point).ScalarMultBase(session.secretNonces[j])
+	}
+	session.publicNonces = make([]*MuSigPublicNonce, len(session.signers))
+	session.publicNonces[pos] = ownNonce
+	return session, nil
+}
+
+func (session ThresholdSession) position(index int) int {
+	pos := sort.SearchInts(session.signers, index)
+	if pos == len(session.signers) || session.signers[pos] != index {
+		return -1
+	}
+	return pos
+}
+
+// GetSchnorrPublicKey returns the Schnorr public key verifying the aggregated signatures
+func (session ThresholdSession) GetSchnorrPublicKey() *SchnorrPublicKey {
+	pubKey := new(SchnorrPublicKey)
+	pubKey.Set(ThresholdPublicKey(session.commitments))
+	return pubKey
+}
+
+// PublicNonce returns the round 1 message to send to the other signers
+func (session ThresholdSession) PublicNonce() *MuSigPublicNonce {
+	return session.publicNonces[session.position(session.share.Index)]
+}
+
+// SetPublicNonce records the round 1 message of the signer index
+func (session *ThresholdSession) SetPublicNonce(index int, nonce *MuSigPublicNonce) error {
+	pos := session.position(index)
+	if pos < 0 {
+		return errors.New("index is not among the signers")
+	}
+	if index == session.share.Index {
+		return errors.New("can not replace own public nonce")
+	}
+	if nonce == nil || nonce.r[0] == nil || nonce.r[1] == nil {
+		return errors.New("invalid public nonce")
+	}
+	session.publicNonces[pos] = nonce
+	return nil
+}
+
+// challenge returns the binding factors rho_i of the signers and the Schnorr challenge e
+func (session ThresholdSession) challenge() ([]*Scalar, *Scalar, error) {
+	prefix := append([]byte(CStringThresholdNonce), ThresholdPublicKey(session.commitments).ToBytesS()...)
+	prefix = append(prefix, session.msg...)
+	for pos, nonce := range session.publicNonces {
+		if nonce == nil {
+			return nil, nil, fmt.Errorf("missing public nonce of signer %v", session.signers[pos])
+		}
+		prefix = append(prefix, byte(session.signers[pos]))
+		prefix = append(prefix, nonce.Bytes()...)
+	}
+
+	rhos := make([]*Scalar, len(session.signers))
+	R := new(Point).Identity()
+	for pos, nonce := range session.publicNonces {
+		rhos[pos] = HashToScalar(append(append([]byte{}, prefix...), byte(session.signers[pos])))
+		R.Add(R, nonce.r[0])
+		R.Add(R, new(Point).ScalarMult(nonce.r[1], rhos[pos]))
+	}
+
+	// same challenge as SchnorrPrivateKey.Sign
+	e := HashToScalar(append(R.ToBytesS(), session.msg...))
+	return rhos, e, nil
+}
+
+// PartialSign returns the round 2 message once the public nonces of all signers are set.
+// The secret nonces are erased, a session can only sign once
+func (session *ThresholdSession) PartialSign() (*Scalar, error) {
+	if session.secretNonces[0] == nil {
+		return nil, errors.New("threshold session has already signed")
+	}
+	rhos, e, err := session.challenge()
+	if err != nil {
+		return nil, err
+	}
+	lambda, err := LagrangeCoefficient(session.share.Index, session.signers)
+	if err != nil {
+		return nil, err
+	}
+
+	// z_i = d_i + rho_i*e_i - e*lambda_i*f(i)
+	pos := session.position(session.share.Index)
+	z := new(Scalar).MulAdd(rhos[pos], session.secretNonces[1], session.secretNonces[0])
+	z.Sub(z, new(Scalar).Mul(new(Scalar).Mul(e, lambda), session.share.Value))
+
+	for j := range session.secretNonces {
+		session.secretNonces[j] = nil
+	}
+	return z, nil
+}
+
+// VerifyPartialSignature checks the round 2 message of the signer index
+func (session ThresholdSession) VerifyPartialSignature(index int, partialSig *Scalar) bool {
+	pos := session.position(index)
+	if pos < 0 || partialSig == nil {
+		return false
+	}
+	rhos, e, err := session.challenge()
+	if err != nil {
+		return false
+	}
+	lambda, err := LagrangeCoefficient(index, session.signers)
+	if err != nil {
+		return false
+	}
+
+	// G^z_i + e*lambda_i*Y_i == D_i + rho_i*E_i
+	lhs := new(Point).ScalarMultBase(partialSig)
+	lhs.Add(lhs, new(Point).ScalarMult(PublicShare(index, session.commitments), new(Scalar).Mul(e, lambda)))
+	rhs := new(Point).ScalarMult(session.publicNonces[pos].r[1], rhos[pos])
+	rhs.Add(rhs, session.publicNonces[pos].r[0])
+	return IsPointEqual(lhs, rhs)
+}
+
+// AggregateSignatures combines the round 2 messages of the signers, in increasing index order,
+// into a signature on the session's message for the threshold public key
+func (session ThresholdSession) AggregateSignatures(partialSigs []*Scalar) (*SchnSignature, error) {
+	if len(partialSigs) != len(session.signers) {
+		return nil, errors.New("wrong number of partial signatures")
+	}
+	_, e, err := session.challenge()
+	if err != nil {
+		return nil, err
+	}
+
+	signature := new(SchnSignature)
+	signature.e = e
+	signature.z1 = new(Scalar).FromUint64(0)
+	for pos, partialSig := range partialSigs {
+		if !session.VerifyPartialSignature(session.signers[pos], partialSig) {
+			return nil, fmt.Errorf("invalid partial signature of signer %v", session.signers[pos])
+		}
+		signature.z1.Add(signature.z1, partialSig)
+	}
+	signature.z2 = nil
+	return signature, nil
+}
@@ -0,0 +1,136 @@
+package privacy
+
+import (
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSplitCombineSecret(t *testing.T) {
+	secret := RandomScalar()
+	shares, commitments, err := SplitSecret(secret, 3, 5)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 5, len(shares))
+	assert.Equal(t, 3, len(commitments))
+	assert.Equal(t, true, IsPointEqual(new(Point).ScalarMultBase(secret), ThresholdPublicKey(commitments)))
+
+	for _, share := range shares {
+		assert.Equal(t, true, VerifyShare(share, commitments))
+
+		share2 := new(SecretShare)
+		assert.Equal(t, nil, share2.SetBytes(share.Bytes()))
+		assert.Equal(t, share.Index, share2.Index)
+		assert.Equal(t, true, VerifyShare(share2, commitments))
+	}
+
+	// any 3 shares recover the secret
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		subsetShares := make([]*SecretShare, len(subset))
+		for i, j := range subset {
+			subsetShares[i] = shares[j]
+		}
+		recovered, err := CombineShares(subsetShares)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, secret.ToBytesS(), recovered.ToBytesS())
+	}
+
+	// 2 shares do not
+	recovered, err := CombineShares(shares[:2])
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, secret.ToBytesS(), recovered.ToBytesS())
+
+	_, err = CombineShares([]*SecretShare{shares[0], shares[0]})
+	assert.NotEqual(t, nil, err)
+
+	// a wrong share is detected
+	badShare := &SecretShare{Index: 2, Value: new(Scalar).Add(shares[1].Value, new(Scalar).FromUint64(1))}
+	assert.Equal(t, false, VerifyShare(badShare, commitments))
+	assert.Equal(t, false, VerifyShare(&SecretShare{Index: 1, Value: shares[1].Value}, commitments))
+
+	_, _, err = SplitSecret(secret, 0, 5)
+	assert.NotEqual(t, nil, err)
+	_, _, err = SplitSecret(secret, 6, 5)
+	assert.NotEqual(t, nil, err)
+	_, _, err = SplitSecret(secret, 2, MaxThresholdSigners+1)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestThresholdSign(t *testing.T) {
+	secret := RandomScalar()
+	shares, commitments, err := SplitSecret(secret, 3, 5)
+	assert.Equal(t, nil, err)
+	msg := common.HashB([]byte("threshold message"))
+
+	for _, signers := range [][]int{{1, 2, 3}, {5, 3, 1}, {2, 3, 4, 5}} {
+		sessions := make([]*ThresholdSession, len(signers))
+		for i, index := range signers {
+			sessions[i], err = NewThresholdSession(shares[index-1], commitments, signers, msg)
+			assert.Equal(t, nil, err)
+		}
+
+		// round 1
+		for i := range sessions {
+			nonceBytes := sessions[i].PublicNonce().Bytes()
+			for j := range sessions {
+				if i == j {
+					continue
+				}
+				nonce := new(MuSigPublicNonce)
+				assert.Equal(t, nil, nonce.SetBytes(nonceBytes))
+				assert.Equal(t, nil, sessions[j].SetPublicNonce(signers[i], nonce))
+			}
+		}
+
+		// round 2, partial signatures are aggregated in increasing index order
+		partialSigs := make([]*Scalar, len(signers))
+		for _, session := range sessions {
+			partialSig, err := session.PartialSign()
+			assert.Equal(t, nil, err)
+			partialSigs[session.position(session.share.Index)] = partialSig
+		}
+
+		signature, err := sessions[0].AggregateSignatures(partialSigs)
+		assert.Equal(t, nil, err)
+		pubKey := sessions[0].GetSchnorrPublicKey()
+		assert.Equal(t, true, pubKey.Verify(signature, msg))
+
+		// the signature verifies as the one of the whole secret
+		privKey := new(SchnorrPrivateKey)
+		privKey.Set(secret, new(Scalar).FromUint64(0))
+		assert.Equal(t, true, privKey.GetPublicKey().Verify(signature, msg))
+
+		// a wrong partial signature is detected
+		partialSigs[0] = new(Scalar).Add(partialSigs[0], new(Scalar).FromUint64(1))
+		_, err = sessions[0].AggregateSignatures(partialSigs)
+		assert.NotEqual(t, nil, err)
+
+		_, err = sessions[0].PartialSign()
+		assert.NotEqual(t, nil, err)
+	}
+}
+
+func TestThresholdSessionErrors(t *testing.T) {
+	shares, commitments, err := SplitSecret(RandomScalar(), 3, 5)
+	assert.Equal(t, nil, err)
+	msg := common.HashB([]byte("threshold message"))
+
+	// not enough signers
+	_, err = NewThresholdSession(shares[0], commitments, []int{1, 2}, msg)
+	assert.NotEqual(t, nil, err)
+	// the share is not among the signers
+	_, err = NewThresholdSession(shares[0], commitments, []int{2, 3, 4}, msg)
+	assert.NotEqual(t, nil, err)
+	// duplicate signers
+	_, err = NewThresholdSession(shares[0], commitments, []int{1, 2, 2}, msg)
+	assert.NotEqual(t, nil, err)
+	// the share does not match the commitments
+	_, err = NewThresholdSession(&SecretShare{Index: 1, Value: RandomScalar()}, commitments, []int{1, 2, 3}, msg)
+	assert.NotEqual(t, nil, err)
+
+	session, err := NewThresholdSession(shares[0], commitments, []int{1, 2, 3}, msg)
+	assert.Equal(t, nil, err)
+	_, err = session.PartialSign()
+	assert.NotEqual(t, nil, err)
+	assert.NotEqual(t, nil, session.SetPublicNonce(4, session.PublicNonce()))
+	assert.NotEqual(t, nil, session.SetPublicNonce(1, session.PublicNonce()))
+}
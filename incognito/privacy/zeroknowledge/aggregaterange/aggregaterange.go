@@ -201,9 +201,10 @@ func (wit AggregatedRangeWitness) ProveOld() (*AggregatedRangeProof, error) {
 	}
 	numValuePad := pad(numValue)
 	aggParam := new(bulletproofParams)
-	aggParam.g = AggParam.g[0 : numValuePad*maxExp]
-	aggParam.h = AggParam.h[0 : numValuePad*maxExp]
-	aggParam.u = AggParam.u
+	aggParamAll := GetAggParam()
+	aggParam.g = aggParamAll.g[0 : numValuePad*maxExp]
+	aggParam.h = aggParamAll.h[0 : numValuePad*maxExp]
+	aggParam.u = aggParamAll.u
 	csByteH := []byte{}
 	csByteG := []byte{}
 	for i := 0; i < len(aggParam.g); i++ {
@@ -472,9 +473,10 @@ func (proof AggregatedRangeProof) VerifyOld() (bool, error) {
 	}
 	numValuePad := pad(numValue)
 	aggParam := new(bulletproofParams)
-	aggParam.g = AggParam.g[0 : numValuePad*maxExp]
-	aggParam.h = AggParam.h[0 : numValuePad*maxExp]
-	aggParam.u = AggParam.u
+	aggParamAll := GetAggParam()
+	aggParam.g = aggParamAll.g[0 : numValuePad*maxExp]
+	aggParam.h = aggParamAll.h[0 : numValuePad*maxExp]
+	aggParam.u = aggParamAll.u
 	csByteH := []byte{}
 	csByteG := []byte{}
 	for i := 0; i < len(aggParam.g); i++ {
@@ -586,9 +588,10 @@ func VerifyBatchOld(proofs []*AggregatedRangeProof) (bool, error, int) {
 		}
 		numValuePad := pad(numValue)
 		aggParam := new(bulletproofParams)
-		aggParam.g = AggParam.g[0 : numValuePad*maxExp]
-		aggParam.h = AggParam.h[0 : numValuePad*maxExp]
-		aggParam.u = AggParam.u
+		aggParamAll := GetAggParam()
+		aggParam.g = aggParamAll.g[0 : numValuePad*maxExp]
+		aggParam.h = aggParamAll.h[0 : numValuePad*maxExp]
+		aggParam.u = aggParamAll.u
 		csByteH := []byte{}
 		csByteG := []byte{}
 		for i := 0; i < len(aggParam.g); i++ {
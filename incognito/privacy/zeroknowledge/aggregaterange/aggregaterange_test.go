@@ -212,10 +212,10 @@ func TestInnerProductProveVerify(t *testing.T) {
 		numValue := rand.Intn(maxOutputNumber)
 		numValuePad := pad(numValue)
 		aggParam := new(bulletproofParams)
-		aggParam.g = AggParam.g[0 : numValuePad*maxExp]
-		aggParam.h = AggParam.h[0 : numValuePad*maxExp]
-		aggParam.u = AggParam.u
-		aggParam.cs = AggParam.cs
+		aggParam.g = GetAggParam().g[0 : numValuePad*maxExp]
+		aggParam.h = GetAggParam().h[0 : numValuePad*maxExp]
+		aggParam.u = GetAggParam().u
+		aggParam.cs = GetAggParam().cs
 
 		wit := new(InnerProductWitness)
 		n := maxExp * numValuePad
@@ -266,10 +266,10 @@ func TestInnerProductProveVerifyUltraFast(t *testing.T) {
 		numValue := rand.Intn(maxOutputNumber)
 		numValuePad := pad(numValue)
 		aggParam := new(bulletproofParams)
-		aggParam.g = AggParam.g[0 : numValuePad*maxExp]
-		aggParam.h = AggParam.h[0 : numValuePad*maxExp]
-		aggParam.u = AggParam.u
-		aggParam.cs = AggParam.cs
+		aggParam.g = GetAggParam().g[0 : numValuePad*maxExp]
+		aggParam.h = GetAggParam().h[0 : numValuePad*maxExp]
+		aggParam.u = GetAggParam().u
+		aggParam.cs = GetAggParam().cs
 
 		wit := new(InnerProductWitness)
 		n := maxExp * numValuePad
@@ -343,10 +343,10 @@ func TestAnStrictInnerProductProveVerifyUltraFast(t *testing.T) {
 		numValue := rand.Intn(maxOutputNumber)
 		numValuePad := pad(numValue)
 		aggParam := new(bulletproofParams)
-		aggParam.g = AggParam.g[0 : numValuePad*maxExp]
-		aggParam.h = AggParam.h[0 : numValuePad*maxExp]
-		aggParam.u = AggParam.u
-		aggParam.cs = AggParam.cs
+		aggParam.g = GetAggParam().g[0 : numValuePad*maxExp]
+		aggParam.h = GetAggParam().h[0 : numValuePad*maxExp]
+		aggParam.u = GetAggParam().u
+		aggParam.cs = GetAggParam().cs
 		wit := new(InnerProductWitness)
 		n := maxExp * numValuePad
 		wit.a = make([]*privacy.Scalar, n)
@@ -375,10 +375,10 @@ func TestAnStrictInnerProductProveVerifyUltraFast(t *testing.T) {
 	res := VerifyBatchingInnerProductProofs(proofs, csList)
 	assert.Equal(t, true, res)
 	for j := 0; j < 50; j += 1 {
-		i := common.RandInt() % len(proofs)
-		r := common.RandInt() % 5
+		i := rand.Int() % len(proofs)
+		r := rand.Int() % 5
 		if r == 0 {
-			ran := common.RandInt() % len(proofs[i].l)
+			ran := rand.Int() % len(proofs[i].l)
 			remember := proofs[i].l[ran]
 			proofs[i].l[ran] = obfuscatePoint(proofs[i].l[ran])
 			assert.NotEqual(t, remember, proofs[i].l[ran])
@@ -386,7 +386,7 @@ func TestAnStrictInnerProductProveVerifyUltraFast(t *testing.T) {
 			assert.Equal(t, false, res)
 			proofs[i].l[ran] = remember
 		} else if r == 1 {
-			ran := common.RandInt() % len(proofs[i].r)
+			ran := rand.Int() % len(proofs[i].r)
 			remember := proofs[i].r[ran]
 			proofs[i].r[ran] = obfuscatePoint(proofs[i].r[ran])
 			assert.NotEqual(t, remember, proofs[i].r[ran])
@@ -422,8 +422,8 @@ func TestAnStrictInnerProductProveVerifyUltraFast(t *testing.T) {
 func obfuscatePoint(value *privacy.Point) *privacy.Point {
 	for {
 		k := value.GetKey()
-		r := common.RandInt() % len(k)
-		i := common.RandInt() % 8
+		r := rand.Int() % len(k)
+		i := rand.Int() % 8
 		k[r] ^= (1 << uint8(i))
 		after, err := new(privacy.Point).SetKey(&k)
 		if err == nil {
@@ -434,8 +434,8 @@ func obfuscatePoint(value *privacy.Point) *privacy.Point {
 func obfuscateScalar(value *privacy.Scalar) *privacy.Scalar {
 	for {
 		k := value.GetKey()
-		r := common.RandInt() % len(k)
-		i := common.RandInt() % 8
+		r := rand.Int() % len(k)
+		i := rand.Int() % 8
 		k[r] ^= (1 << uint8(i))
 		after, err := new(privacy.Scalar).SetKey(&k)
 		if err == nil {
@@ -450,7 +450,7 @@ func benchmarkAggRangeProof_Verify(numberofOutput int, b *testing.B) {
 	rands := make([]*privacy.Scalar, numberofOutput)
 
 	for i := range values {
-		values[i] = uint64(common.RandInt64())
+		values[i] = uint64(rand.Int63())
 		rands[i] = privacy.RandomScalar()
 	}
 	wit.Set(values, rands)
@@ -469,7 +469,7 @@ func benchmarkAggRangeProof_VerifyFaster(numberofOutput int, b *testing.B) {
 	rands := make([]*privacy.Scalar, numberofOutput)
 
 	for i := range values {
-		values[i] = uint64(common.RandInt64())
+		values[i] = uint64(rand.Int63())
 		rands[i] = privacy.RandomScalar()
 	}
 	wit.Set(values, rands)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/0xkraken/incognito-wasm/incognito/privacy"
 	"math"
+	"sync"
 )
 
 const (
@@ -22,7 +23,18 @@ type bulletproofParams struct {
 	cs []byte
 }
 
-var AggParam = newBulletproofParams(numOutputParam)
+var (
+	aggParamAll     *bulletproofParams
+	aggParamAllOnce sync.Once
+)
+
+// GetAggParam returns the generators for numOutputParam values, loading them on first use
+func GetAggParam() *bulletproofParams {
+	aggParamAllOnce.Do(func() {
+		aggParamAll = newBulletproofParams(numOutputParam)
+	})
+	return aggParamAll
+}
 
 func newBulletproofParams(m int) *bulletproofParams {
 	gen := new(bulletproofParams)
@@ -33,14 +45,14 @@ func newBulletproofParams(m int) *bulletproofParams {
 	csByteH := []byte{}
 	csByteG := []byte{}
 	for i := 0; i < capacity; i++ {
-		gen.g[i] = privacy.HashToPointFromIndex(int64(numCommitValue+i), privacy.CStringBulletProof)
-		gen.h[i] = privacy.HashToPointFromIndex(int64(numCommitValue+i+maxOutputNumberParam*maxExp), privacy.CStringBulletProof)
+		gen.g[i] = privacy.GeneratorFromIndex(int64(numCommitValue + i))
+		gen.h[i] = privacy.GeneratorFromIndex(int64(numCommitValue + i + maxOutputNumberParam*maxExp))
 		csByteG = append(csByteG, gen.g[i].ToBytesS()...)
 		csByteH = append(csByteH, gen.h[i].ToBytesS()...)
 	}
 
 	gen.u = new(privacy.Point)
-	gen.u = privacy.HashToPointFromIndex(int64(numCommitValue+2*maxOutputNumberParam*maxExp), privacy.CStringBulletProof)
+	gen.u = privacy.GeneratorFromIndex(int64(numCommitValue + 2*maxOutputNumberParam*maxExp))
 
 	gen.cs = append(gen.cs, csByteG...)
 	gen.cs = append(gen.cs, csByteH...)
@@ -5,6 +5,7 @@ import (
 	"github.com/0xkraken/incognito-wasm/incognito/privacy/privacy_util"
 	"github.com/pkg/errors"
 	"math"
+	"sync"
 )
 
 type bulletproofParams struct {
@@ -31,7 +32,18 @@ type AggregatedRangeProof struct {
 	innerProductProof *InnerProductProof
 }
 
-var AggParam = newBulletproofParams(privacy_util.MaxOutputCoin)
+var (
+	aggParamAll     *bulletproofParams
+	aggParamAllOnce sync.Once
+)
+
+// GetAggParam returns the generators for MaxOutputCoin values, loading them on first use
+func GetAggParam() *bulletproofParams {
+	aggParamAllOnce.Do(func() {
+		aggParamAll = newBulletproofParams(privacy_util.MaxOutputCoin)
+	})
+	return aggParamAll
+}
 
 func (proof AggregatedRangeProof) ValidateSanity() bool {
 	for i := 0; i < len(proof.cmsValue); i++ {
@@ -241,18 +253,19 @@ func (wit AggregatedRangeWitness) Prove() (*AggregatedRangeProof, error) {
 		proof.cmsValue[i] = privacy.PedCom.CommitAtIndex(new(privacy.Scalar).FromUint64(values[i]), rands[i], privacy.PedersenValueIndex)
 	}
 	// Convert values to binary array
-	aL := make([]*privacy.Scalar, N)
-	aR := make([]*privacy.Scalar, N)
-	sL := make([]*privacy.Scalar, N)
-	sR := make([]*privacy.Scalar, N)
+	aL := newScalarVector(N)
+	aR := newScalarVector(N)
+	sL := newScalarVector(N)
+	sR := newScalarVector(N)
 
+	oneNumber := new(privacy.Scalar).FromUint64(1)
 	for i, value := range values {
-		tmp := ConvertUint64ToBinary(value, maxExp)
 		for j := 0; j < maxExp; j++ {
-			aL[i*maxExp+j] = tmp[j]
-			aR[i*maxExp+j] = new(privacy.Scalar).Sub(tmp[j], new(privacy.Scalar).FromUint64(1))
-			sL[i*maxExp+j] = privacy.RandomScalar()
-			sR[i*maxExp+j] = privacy.RandomScalar()
+			aL[i*maxExp+j].FromUint64(value % 2)
+			aR[i*maxExp+j].Sub(aL[i*maxExp+j], oneNumber)
+			sL[i*maxExp+j].Set(privacy.RandomScalar())
+			sR[i*maxExp+j].Set(privacy.RandomScalar())
+			value = value / 2
 		}
 	}
 	// LINE 40-50
@@ -284,26 +297,27 @@ func (wit AggregatedRangeWitness) Prove() (*AggregatedRangeProof, error) {
 
 	// l(X) = (aL -z*1^n) + sL*X; r(X) = y^n hada (aR +z*1^n + sR*X) + z^2 * 2^n
 	yVector := powerVector(y, N)
-	hadaProduct, err := hadamardProduct(yVector, vectorAddScalar(aR, z))
-	if err != nil {
-		return nil, err
-	}
-	vectorSum := make([]*privacy.Scalar, N)
+	vectorSum := newScalarVector(N)
 	zTmp := new(privacy.Scalar).Set(z)
 	for j := 0; j < numValuePad; j++ {
 		zTmp.Mul(zTmp, z)
 		for i := 0; i < maxExp; i++ {
-			vectorSum[j*maxExp+i] = new(privacy.Scalar).Mul(twoVectorN[i], zTmp)
+			vectorSum[j*maxExp+i].Mul(twoVectorN[i], zTmp)
 		}
 	}
 	zNeg := new(privacy.Scalar).Sub(new(privacy.Scalar).FromUint64(0), z)
-	l0 := vectorAddScalar(aL, zNeg)
+	l0 := vectorAddScalar(nil, aL, zNeg)
 	l1 := sL
-	var r0, r1 []*privacy.Scalar
-	if r0, err = vectorAdd(hadaProduct, vectorSum); err != nil {
+	r0 := vectorAddScalar(nil, aR, z)
+	r0, err := hadamardProduct(r0, yVector, r0)
+	if err != nil {
+		return nil, err
+	}
+	var r1 []*privacy.Scalar
+	if r0, err = vectorAdd(r0, r0, vectorSum); err != nil {
 		return nil, err
 	} else {
-		if r1, err = hadamardProduct(yVector, sR); err != nil {
+		if r1, err = hadamardProduct(nil, yVector, sR); err != nil {
 			return nil, err
 		}
 	}
@@ -331,22 +345,15 @@ func (wit AggregatedRangeWitness) Prove() (*AggregatedRangeProof, error) {
 	x := generateChallenge(z.ToBytesS(), []*privacy.Point{proof.t1, proof.t2})
 	xSquare := new(privacy.Scalar).Mul(x, x)
 
-	// lVector = aL - z*1^n + sL*x
-	// rVector = y^n hada (aR +z*1^n + sR*x) + z^2*2^n
+	// lVector = aL - z*1^n + sL*x = l0 + l1*x
+	// rVector = y^n hada (aR +z*1^n + sR*x) + z^2*2^n = r0 + r1*x
 	// tHat = <lVector, rVector>
-	lVector, err := vectorAdd(vectorAddScalar(aL, zNeg), vectorMulScalar(sL, x))
-	if err != nil {
-		return nil, err
-	}
-	tmpVector, err := vectorAdd(vectorAddScalar(aR, z), vectorMulScalar(sR, x))
+	// l1 and r1 are not used anymore, so lVector and rVector are computed in their place
+	lVector, err := vectorAdd(l1, l0, vectorMulScalar(l1, l1, x))
 	if err != nil {
 		return nil, err
 	}
-	rVector, err := hadamardProduct(yVector, tmpVector)
-	if err != nil {
-		return nil, err
-	}
-	rVector, err = vectorAdd(rVector, vectorSum)
+	rVector, err := vectorAdd(r1, r0, vectorMulScalar(r1, r1, x))
 	if err != nil {
 		return nil, err
 	}
@@ -427,7 +434,8 @@ func (proof AggregatedRangeProof) Verify() (bool, error) {
 	RHS := new(privacy.Point).ScalarMult(proof.t2, xSquare)
 	RHS.Add(RHS, new(privacy.Point).AddPedersen(deltaYZ, privacy.PedCom.G[privacy.PedersenValueIndex], x, proof.t1))
 
-	expVector := vectorMulScalar(powerVector(z, numValuePad), zSquare)
+	expVector := powerVector(z, numValuePad)
+	expVector = vectorMulScalar(expVector, expVector, zSquare)
 	RHS.Add(RHS, new(privacy.Point).MultiScalarMult(expVector, cmsValue))
 
 	if !privacy.IsPointEqual(LHS, RHS) {
@@ -438,13 +446,13 @@ func (proof AggregatedRangeProof) Verify() (bool, error) {
 	// verify eq (66)
 	uPrime := new(privacy.Point).ScalarMult(aggParam.u, privacy.HashToScalar(x.ToBytesS()))
 
-	vectorSum := make([]*privacy.Scalar, N)
+	vectorSum := newScalarVector(N)
 	zTmp := new(privacy.Scalar).Set(z)
 	for j := 0; j < numValuePad; j++ {
 		zTmp.Mul(zTmp, z)
 		for i := 0; i < maxExp; i++ {
-			vectorSum[j*maxExp+i] = new(privacy.Scalar).Mul(twoVectorN[i], zTmp)
-			vectorSum[j*maxExp+i].Add(vectorSum[j*maxExp+i], new(privacy.Scalar).Mul(z, yVector[j*maxExp+i]))
+			vectorSum[j*maxExp+i].Mul(twoVectorN[i], zTmp)
+			vectorSum[j*maxExp+i].MulAdd(z, yVector[j*maxExp+i], vectorSum[j*maxExp+i])
 		}
 	}
 	tmpHPrime := new(privacy.Point).MultiScalarMult(vectorSum, HPrime)
@@ -513,7 +521,8 @@ func (proof AggregatedRangeProof) VerifyFaster() (bool, error) {
 	LHS := privacy.PedCom.CommitAtIndex(proof.tHat, proof.tauX, privacy.PedersenValueIndex)
 	RHS := new(privacy.Point).ScalarMult(proof.t2, xSquare)
 	RHS.Add(RHS, new(privacy.Point).AddPedersen(deltaYZ, privacy.PedCom.G[privacy.PedersenValueIndex], x, proof.t1))
-	expVector := vectorMulScalar(powerVector(z, numValuePad), zSquare)
+	expVector := powerVector(z, numValuePad)
+	expVector = vectorMulScalar(expVector, expVector, zSquare)
 	RHS.Add(RHS, new(privacy.Point).MultiScalarMult(expVector, cmsValue))
 	if !privacy.IsPointEqual(LHS, RHS) {
 		privacy.Logger.Log.Errorf("verify aggregated range proof statement 1 failed")
@@ -521,13 +530,13 @@ func (proof AggregatedRangeProof) VerifyFaster() (bool, error) {
 	}
 
 	// Verify eq (66)
-	vectorSum := make([]*privacy.Scalar, N)
+	vectorSum := newScalarVector(N)
 	zTmp := new(privacy.Scalar).Set(z)
 	for j := 0; j < numValuePad; j++ {
 		zTmp.Mul(zTmp, z)
 		for i := 0; i < maxExp; i++ {
-			vectorSum[j*maxExp+i] = new(privacy.Scalar).Mul(twoVectorN[i], zTmp)
-			vectorSum[j*maxExp+i].Add(vectorSum[j*maxExp+i], new(privacy.Scalar).Mul(z, yVector[j*maxExp+i]))
+			vectorSum[j*maxExp+i].Mul(twoVectorN[i], zTmp)
+			vectorSum[j*maxExp+i].MulAdd(z, yVector[j*maxExp+i], vectorSum[j*maxExp+i])
 		}
 	}
 	tmpHPrime := new(privacy.Point).MultiScalarMult(vectorSum, HPrime)
@@ -550,33 +559,25 @@ func (proof AggregatedRangeProof) VerifyFaster() (bool, error) {
 	hashCache := x.ToBytesS()
 	L := proof.innerProductProof.l
 	R := proof.innerProductProof.r
-	s := make([]*privacy.Scalar, N)
-	sInverse := make([]*privacy.Scalar, N)
+	s := newScalarVector(N)
+	sInverse := newScalarVector(N)
 	logN := int(math.Log2(float64(N)))
-	vSquareList := make([]*privacy.Scalar, logN)
-	vInverseSquareList := make([]*privacy.Scalar, logN)
+	vSquareList := newScalarVector(logN)
+	vInverseSquareList := newScalarVector(logN)
 
 	for i := 0; i < N; i++ {
-		s[i] = new(privacy.Scalar).Set(proof.innerProductProof.a)
-		sInverse[i] = new(privacy.Scalar).Set(proof.innerProductProof.b)
+		s[i].Set(proof.innerProductProof.a)
+		sInverse[i].Set(proof.innerProductProof.b)
 	}
 
+	vInverse := new(privacy.Scalar)
 	for i := range L {
 		v := generateChallenge(hashCache, []*privacy.Point{L[i], R[i]})
 		hashCache = v.ToBytesS()
-		vInverse := new(privacy.Scalar).Invert(v)
-		vSquareList[i] = new(privacy.Scalar).Mul(v, v)
-		vInverseSquareList[i] = new(privacy.Scalar).Mul(vInverse, vInverse)
-
-		for j := 0; j < N; j++ {
-			if j&int(math.Pow(2, float64(logN-i-1))) != 0 {
-				s[j] = new(privacy.Scalar).Mul(s[j], v)
-				sInverse[j] = new(privacy.Scalar).Mul(sInverse[j], vInverse)
-			} else {
-				s[j] = new(privacy.Scalar).Mul(s[j], vInverse)
-				sInverse[j] = new(privacy.Scalar).Mul(sInverse[j], v)
-			}
-		}
+		vInverse.Invert(v)
+		vSquareList[i].Mul(v, v)
+		vInverseSquareList[i].Mul(vInverse, vInverse)
+		foldChallenge(s, sInverse, v, vInverse, 1<<uint(logN-i-1))
 	}
 
 	c := new(privacy.Scalar).Mul(proof.innerProductProof.a, proof.innerProductProof.b)
@@ -669,7 +670,8 @@ func VerifyBatch(proofs []*AggregatedRangeProof) (bool, error, int) {
 		list_x_alpha = append(list_x_alpha, new(privacy.Scalar).Mul(x, alpha))
 		list_x_beta = append(list_x_beta, new(privacy.Scalar).Mul(x, beta))
 		list_xSquare = append(list_xSquare, new(privacy.Scalar).Mul(xSquare, alpha))
-		tmp := vectorMulScalar(powerVector(z, numValuePad), new(privacy.Scalar).Mul(zSquare, alpha))
+		tmp := powerVector(z, numValuePad)
+		tmp = vectorMulScalar(tmp, tmp, new(privacy.Scalar).Mul(zSquare, alpha))
 		list_zSquare = append(list_zSquare, tmp...)
 
 		list_V = append(list_V, cmsValue...)
@@ -680,58 +682,49 @@ func VerifyBatch(proofs []*AggregatedRangeProof) (bool, error, int) {
 		hashCache := x.ToBytesS()
 		L := proof.innerProductProof.l
 		R := proof.innerProductProof.r
-		s := make([]*privacy.Scalar, N)
-		sInverse := make([]*privacy.Scalar, N)
+		s := newScalarVector(N)
+		sInverse := newScalarVector(N)
 		logN := int(math.Log2(float64(N)))
-		vSquareList := make([]*privacy.Scalar, logN)
-		vInverseSquareList := make([]*privacy.Scalar, logN)
+		vSquareList := newScalarVector(logN)
+		vInverseSquareList := newScalarVector(logN)
 
 		for i := 0; i < N; i++ {
-			s[i] = new(privacy.Scalar).Set(proof.innerProductProof.a)
-			sInverse[i] = new(privacy.Scalar).Set(proof.innerProductProof.b)
+			s[i].Set(proof.innerProductProof.a)
+			sInverse[i].Set(proof.innerProductProof.b)
 		}
 
+		vInverse := new(privacy.Scalar)
 		for i := range L {
 			v := generateChallenge(hashCache, []*privacy.Point{L[i], R[i]})
 			hashCache = v.ToBytesS()
-			vInverse := new(privacy.Scalar).Invert(v)
-			vSquareList[i] = new(privacy.Scalar).Mul(v, v)
-			vInverseSquareList[i] = new(privacy.Scalar).Mul(vInverse, vInverse)
-
-			for j := 0; j < N; j++ {
-				if j&int(math.Pow(2, float64(logN-i-1))) != 0 {
-					s[j] = new(privacy.Scalar).Mul(s[j], v)
-					sInverse[j] = new(privacy.Scalar).Mul(sInverse[j], vInverse)
-				} else {
-					s[j] = new(privacy.Scalar).Mul(s[j], vInverse)
-					sInverse[j] = new(privacy.Scalar).Mul(sInverse[j], v)
-				}
-			}
+			vInverse.Invert(v)
+			vSquareList[i].Mul(v, v)
+			vInverseSquareList[i].Mul(vInverse, vInverse)
+			foldChallenge(s, sInverse, v, vInverse, 1<<uint(logN-i-1))
 		}
 
-		lVector := make([]*privacy.Scalar, N)
-		rVector := make([]*privacy.Scalar, N)
+		// s and sInverse are not used anymore, so lVector and rVector are computed in their place
+		lVector := s
+		rVector := sInverse
 
-		vectorSum := make([]*privacy.Scalar, N)
 		zTmp := new(privacy.Scalar).Set(z)
+		twoZ := new(privacy.Scalar)
+		yInverse := new(privacy.Scalar).Invert(y)
+		yTmp := new(privacy.Scalar).Set(y)
 		for j := 0; j < numValuePad; j++ {
 			zTmp.Mul(zTmp, z)
 			for i := 0; i < maxExp; i++ {
-				vectorSum[j*maxExp+i] = new(privacy.Scalar).Mul(twoVectorN[i], zTmp)
+				k := j*maxExp + i
+				yTmp.Mul(yTmp, yInverse)
+				lVector[k].Add(s[k], z)
+				rVector[k].Sub(sInverse[k], twoZ.Mul(twoVectorN[i], zTmp))
+				rVector[k].Mul(rVector[k], yTmp)
+				rVector[k].Sub(rVector[k], z)
+
+				lVector[k].Mul(lVector[k], beta)
+				rVector[k].Mul(rVector[k], beta)
 			}
 		}
-		yInverse := new(privacy.Scalar).Invert(y)
-		yTmp := new(privacy.Scalar).Set(y)
-		for j := 0; j < N; j++ {
-			yTmp.Mul(yTmp, yInverse)
-			lVector[j] = new(privacy.Scalar).Add(s[j], z)
-			rVector[j] = new(privacy.Scalar).Sub(sInverse[j], vectorSum[j])
-			rVector[j].Mul(rVector[j], yTmp)
-			rVector[j].Sub(rVector[j], z)
-
-			lVector[j].Mul(lVector[j], beta)
-			rVector[j].Mul(rVector[j], beta)
-		}
 
 		list_lVector = append(list_lVector, lVector...)
 		list_rVector = append(list_rVector, rVector...)
@@ -754,7 +747,7 @@ func VerifyBatch(proofs []*AggregatedRangeProof) (bool, error, int) {
 
 	tmp1 := new(privacy.Point).MultiScalarMult(list_lVector, list_gVector)
 	tmp2 := new(privacy.Point).MultiScalarMult(list_rVector, list_hVector)
-	tmp3 := new(privacy.Point).ScalarMult(AggParam.u, sum_absubthat)
+	tmp3 := new(privacy.Point).ScalarMult(GetAggParam().u, sum_absubthat)
 	tmp4 := new(privacy.Point).ScalarMult(baseH, sum_mu)
 	LHSPrime := new(privacy.Point).Add(tmp1, tmp2)
 	LHSPrime.Add(LHSPrime, tmp3)
@@ -6,20 +6,31 @@ import (
 	"github.com/pkg/errors"
 )
 
-// ConvertIntToBinary represents a integer number in binary
-func ConvertUint64ToBinary(number uint64, n int) []*privacy.Scalar {
-	if number == 0 {
-		res := make([]*privacy.Scalar, n)
-		for i := 0; i < n; i++ {
-			res[i] = new(privacy.Scalar).FromUint64(0)
-		}
-		return res
-	}
+// newScalarVector returns n zero scalars backed by a single allocation
+func newScalarVector(n int) []*privacy.Scalar {
+	backing := make([]privacy.Scalar, n)
+	res := make([]*privacy.Scalar, n)
+	for i := range res {
+		res[i] = &backing[i]
+	}
+	return res
+}
 
-	binary := make([]*privacy.Scalar, n)
+// newPointVector returns n points backed by a single allocation
+func newPointVector(n int) []*privacy.Point {
+	backing := make([]privacy.Point, n)
+	res := make([]*privacy.Point, n)
+	for i := range res {
+		res[i] = &backing[i]
+	}
+	return res
+}
 
+// ConvertIntToBinary represents a integer number in binary
+func ConvertUint64ToBinary(number uint64, n int) []*privacy.Scalar {
+	binary := newScalarVector(n)
 	for i := 0; i < n; i++ {
-		binary[i] = new(privacy.Scalar).FromUint64(number % 2)
+		binary[i].FromUint64(number % 2)
 		number = number / 2
 	}
 	return binary
@@ -27,10 +38,10 @@ func ConvertUint64ToBinary(number uint64, n int) []*privacy.Scalar {
 
 func computeHPrime(y *privacy.Scalar, N int, H []*privacy.Point) []*privacy.Point {
 	yInverse := new(privacy.Scalar).Invert(y)
-	HPrime := make([]*privacy.Point, N)
+	HPrime := newPointVector(N)
 	expyInverse := new(privacy.Scalar).FromUint64(1)
 	for i := 0; i < N; i++ {
-		HPrime[i] = new(privacy.Point).ScalarMult(H[i], expyInverse)
+		HPrime[i].ScalarMult(H[i], expyInverse)
 		expyInverse.Mul(expyInverse, yInverse)
 	}
 	return HPrime
@@ -77,23 +88,28 @@ func innerProduct(a []*privacy.Scalar, b []*privacy.Scalar) (*privacy.Scalar, er
 	return result, nil
 }
 
-func vectorAdd(a []*privacy.Scalar, b []*privacy.Scalar) ([]*privacy.Scalar, error) {
+// vectorAdd sets result = a + b and returns it.
+// result may be a or b; a nil result is allocated
+func vectorAdd(result []*privacy.Scalar, a []*privacy.Scalar, b []*privacy.Scalar) ([]*privacy.Scalar, error) {
 	if len(a) != len(b) {
 		return nil, errors.New("Incompatible sizes of a and b")
 	}
-	result := make([]*privacy.Scalar, len(a))
+	if result == nil {
+		result = newScalarVector(len(a))
+	}
 	for i := range a {
-		result[i] = new(privacy.Scalar).Add(a[i], b[i])
+		result[i].Add(a[i], b[i])
 	}
 	return result, nil
 }
 
 func setAggregateParams(N int) *bulletproofParams {
+	aggParamAll := GetAggParam()
 	aggParam := new(bulletproofParams)
-	aggParam.g = AggParam.g[0:N]
-	aggParam.h = AggParam.h[0:N]
-	aggParam.u = AggParam.u
-	aggParam.cs = AggParam.cs
+	aggParam.g = aggParamAll.g[0:N]
+	aggParam.h = aggParamAll.h[0:N]
+	aggParam.u = aggParamAll.u
+	aggParam.cs = aggParamAll.cs
 	return aggParam
 }
 
@@ -112,44 +128,54 @@ func roundUpPowTwo(v int) int {
 	}
 }
 
-func hadamardProduct(a []*privacy.Scalar, b []*privacy.Scalar) ([]*privacy.Scalar, error) {
+// hadamardProduct sets result = a o b and returns it.
+// result may be a or b; a nil result is allocated
+func hadamardProduct(result []*privacy.Scalar, a []*privacy.Scalar, b []*privacy.Scalar) ([]*privacy.Scalar, error) {
 	if len(a) != len(b) {
 		return nil, errors.New("Invalid input")
 	}
-	result := make([]*privacy.Scalar, len(a))
-	for i := 0; i < len(result); i++ {
-		result[i] = new(privacy.Scalar).Mul(a[i], b[i])
+	if result == nil {
+		result = newScalarVector(len(a))
+	}
+	for i := 0; i < len(a); i++ {
+		result[i].Mul(a[i], b[i])
 	}
 	return result, nil
 }
 
 // powerVector calculates base^n
 func powerVector(base *privacy.Scalar, n int) []*privacy.Scalar {
-	result := make([]*privacy.Scalar, n)
-	result[0] = new(privacy.Scalar).FromUint64(1)
+	result := newScalarVector(n)
+	result[0].FromUint64(1)
 	if n > 1 {
-		result[1] = new(privacy.Scalar).Set(base)
+		result[1].Set(base)
 		for i := 2; i < n; i++ {
-			result[i] = new(privacy.Scalar).Mul(result[i-1], base)
+			result[i].Mul(result[i-1], base)
 		}
 	}
 	return result
 }
 
-// vectorAddScalar adds a vector to a big int, returns big int array
-func vectorAddScalar(v []*privacy.Scalar, s *privacy.Scalar) []*privacy.Scalar {
-	result := make([]*privacy.Scalar, len(v))
+// vectorAddScalar adds a scalar to every element of v and returns result.
+// result may be v; a nil result is allocated
+func vectorAddScalar(result []*privacy.Scalar, v []*privacy.Scalar, s *privacy.Scalar) []*privacy.Scalar {
+	if result == nil {
+		result = newScalarVector(len(v))
+	}
 	for i := range v {
-		result[i] = new(privacy.Scalar).Add(v[i], s)
+		result[i].Add(v[i], s)
 	}
 	return result
 }
 
-// vectorMulScalar mul a vector to a big int, returns a vector
-func vectorMulScalar(v []*privacy.Scalar, s *privacy.Scalar) []*privacy.Scalar {
-	result := make([]*privacy.Scalar, len(v))
+// vectorMulScalar multiplies every element of v by a scalar and returns result.
+// result may be v; a nil result is allocated
+func vectorMulScalar(result []*privacy.Scalar, v []*privacy.Scalar, s *privacy.Scalar) []*privacy.Scalar {
+	if result == nil {
+		result = newScalarVector(len(v))
+	}
 	for i := range v {
-		result[i] = new(privacy.Scalar).Mul(v[i], s)
+		result[i].Mul(v[i], s)
 	}
 	return result
 }
@@ -177,14 +203,14 @@ func newBulletproofParams(m int) *bulletproofParams {
 	csByte := []byte{}
 
 	for i := 0; i < capacity; i++ {
-		param.g[i] = privacy.HashToPointFromIndex(int64(numCommitValue+i), privacy.CStringBulletProof)
-		param.h[i] = privacy.HashToPointFromIndex(int64(numCommitValue+i+maxOutputCoin*maxExp), privacy.CStringBulletProof)
+		param.g[i] = privacy.GeneratorFromIndex(int64(numCommitValue + i))
+		param.h[i] = privacy.GeneratorFromIndex(int64(numCommitValue + i + maxOutputCoin*maxExp))
 		csByte = append(csByte, param.g[i].ToBytesS()...)
 		csByte = append(csByte, param.h[i].ToBytesS()...)
 	}
 
 	param.u = new(privacy.Point)
-	param.u = privacy.HashToPointFromIndex(int64(numCommitValue+2*maxOutputCoin*maxExp), privacy.CStringBulletProof)
+	param.u = privacy.GeneratorFromIndex(int64(numCommitValue + 2*maxOutputCoin*maxExp))
 	csByte = append(csByte, param.u.ToBytesS()...)
 
 	param.cs = privacy.HashToPoint(csByte)
@@ -200,3 +226,17 @@ func generateChallenge(hashCache []byte, values []*privacy.Point) *privacy.Scala
 	hash := privacy.HashToScalar(bytes)
 	return hash
 }
+
+// foldChallenge multiplies s and sInverse in place by the challenge of one inner product round:
+// s[j] gets x and sInverse[j] gets xInverse when j has the round bit set, and the other way around otherwise
+func foldChallenge(s []*privacy.Scalar, sInverse []*privacy.Scalar, x *privacy.Scalar, xInverse *privacy.Scalar, bit int) {
+	for j := range s {
+		if j&bit != 0 {
+			s[j].Mul(s[j], x)
+			sInverse[j].Mul(sInverse[j], xInverse)
+		} else {
+			s[j].Mul(s[j], xInverse)
+			sInverse[j].Mul(sInverse[j], x)
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 var _ = func() (_ struct{}) {
 	fmt.Println("This runs before init()!")
 	Logger.Init(common.NewBackend(nil).Logger("test", true))
+	privacy.Logger.Init(common.NewBackend(nil).Logger("test", true))
 	return
 }()
 
@@ -45,6 +46,37 @@ func TestPowerVector(t *testing.T) {
 	assert.Equal(t, 5, len(twoVector))
 }
 
+func TestVectorOpsInPlace(t *testing.T) {
+	n := privacy_util.MaxExp
+	a := newScalarVector(n)
+	b := newScalarVector(n)
+	for i := 0; i < n; i++ {
+		a[i].Set(privacy.RandomScalar())
+		b[i].Set(privacy.RandomScalar())
+	}
+	x := privacy.RandomScalar()
+
+	sum, _ := vectorAdd(nil, a, b)
+	product, _ := hadamardProduct(nil, a, b)
+	shifted := vectorAddScalar(nil, a, x)
+	scaled := vectorMulScalar(nil, a, x)
+	for i := 0; i < n; i++ {
+		assert.Equal(t, new(privacy.Scalar).Add(a[i], b[i]), sum[i])
+		assert.Equal(t, new(privacy.Scalar).Mul(a[i], b[i]), product[i])
+		assert.Equal(t, new(privacy.Scalar).Add(a[i], x), shifted[i])
+		assert.Equal(t, new(privacy.Scalar).Mul(a[i], x), scaled[i])
+	}
+
+	// the result may be one of the operands
+	expected, _ := hadamardProduct(nil, sum, b)
+	res, _ := vectorAdd(a, a, b)
+	assert.Equal(t, sum, res)
+	res, _ = hadamardProduct(b, res, b)
+	assert.Equal(t, expected, res)
+	expected = vectorMulScalar(nil, shifted, x)
+	assert.Equal(t, expected, vectorMulScalar(shifted, shifted, x))
+}
+
 func TestInnerProduct(t *testing.T) {
 	for j := 0; j < 5; j++ {
 		n := privacy_util.MaxExp
@@ -102,10 +134,10 @@ func TestInnerProductProveVerify(t *testing.T) {
 		numValue := rand.Intn(privacy_util.MaxOutputCoin)
 		numValuePad := roundUpPowTwo(numValue)
 		aggParam := new(bulletproofParams)
-		aggParam.g = AggParam.g[0 : numValuePad*privacy_util.MaxExp]
-		aggParam.h = AggParam.h[0 : numValuePad*privacy_util.MaxExp]
-		aggParam.u = AggParam.u
-		aggParam.cs = AggParam.cs
+		aggParam.g = GetAggParam().g[0 : numValuePad*privacy_util.MaxExp]
+		aggParam.h = GetAggParam().h[0 : numValuePad*privacy_util.MaxExp]
+		aggParam.u = GetAggParam().u
+		aggParam.cs = GetAggParam().cs
 
 		wit := new(InnerProductWitness)
 		n := privacy_util.MaxExp * numValuePad
@@ -416,6 +448,7 @@ func benchmarkAggRangeProof_Proof(numberofOutput int, b *testing.B) {
 	}
 	wit.Set(values, rands)
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -429,12 +462,13 @@ func benchmarkAggRangeProof_Verify(numberofOutput int, b *testing.B) {
 	rands := make([]*privacy.Scalar, numberofOutput)
 
 	for i := range values {
-		values[i] = uint64(common.RandInt64())
+		values[i] = uint64(rand.Int63())
 		rands[i] = privacy.RandomScalar()
 	}
 	wit.Set(values, rands)
 	proof, _ := wit.Prove()
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -448,12 +482,13 @@ func benchmarkAggRangeProof_VerifyFaster(numberofOutput int, b *testing.B) {
 	rands := make([]*privacy.Scalar, numberofOutput)
 
 	for i := range values {
-		values[i] = uint64(common.RandInt64())
+		values[i] = uint64(rand.Int63())
 		rands[i] = privacy.RandomScalar()
 	}
 	wit.Set(values, rands)
 	proof, _ := wit.Prove()
 
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -490,3 +525,35 @@ func BenchmarkAggregatedRangeProof_Verify16(b *testing.B)  { benchmarkAggRangePr
 func BenchmarkAggregatedRangeProof_VerifyFaster16(b *testing.B) {
 	benchmarkAggRangeProof_VerifyFaster(16, b)
 }
+
+func TestInnerProductProveReusesScratch(t *testing.T) {
+	newWitness := func(n int) *InnerProductWitness {
+		wit := new(InnerProductWitness)
+		wit.a = make([]*privacy.Scalar, n)
+		wit.b = make([]*privacy.Scalar, n)
+		for i := range wit.a {
+			wit.a[i] = privacy.RandomScalar()
+			wit.b[i] = privacy.RandomScalar()
+		}
+		c, _ := innerProduct(wit.a, wit.b)
+		wit.p, _ = encodeVectors(wit.a, wit.b, GetAggParam().g[:n], GetAggParam().h[:n])
+		wit.p.Add(wit.p, new(privacy.Point).ScalarMult(GetAggParam().u, c))
+		return wit
+	}
+
+	aggParam := GetAggParam()
+	small := newWitness(privacy_util.MaxExp)
+	large := newWitness(4 * privacy_util.MaxExp)
+
+	proof1, err := small.Prove(aggParam.g[:privacy_util.MaxExp], aggParam.h[:privacy_util.MaxExp], aggParam.u, aggParam.cs.ToBytesS())
+	assert.Equal(t, nil, err)
+	_, err = large.Prove(aggParam.g[:4*privacy_util.MaxExp], aggParam.h[:4*privacy_util.MaxExp], aggParam.u, aggParam.cs.ToBytesS())
+	assert.Equal(t, nil, err)
+	proof2, err := small.Prove(aggParam.g[:privacy_util.MaxExp], aggParam.h[:privacy_util.MaxExp], aggParam.u, aggParam.cs.ToBytesS())
+	assert.Equal(t, nil, err)
+
+	// the proof does not depend on the state of the pooled scratch
+	assert.Equal(t, proof1.Bytes(), proof2.Bytes())
+	assert.Equal(t, true, proof2.Verify(aggParam.g[:privacy_util.MaxExp], aggParam.h[:privacy_util.MaxExp], aggParam.u, aggParam.cs.ToBytesS()))
+	assert.Equal(t, true, proof2.VerifyFaster(aggParam.g[:privacy_util.MaxExp], aggParam.h[:privacy_util.MaxExp], aggParam.u, aggParam.cs.ToBytesS()))
+}
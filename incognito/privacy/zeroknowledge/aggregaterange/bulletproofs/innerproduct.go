@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/0xkraken/incognito-wasm/incognito/privacy"
 	"math"
+	"sync"
 )
 
 type InnerProductWitness struct {
@@ -20,6 +21,41 @@ type InnerProductProof struct {
 	p *privacy.Point
 }
 
+// innerProductScratch holds the vectors folded in place by the inner product argument
+type innerProductScratch struct {
+	a []*privacy.Scalar
+	b []*privacy.Scalar
+	g []*privacy.Point
+	h []*privacy.Point
+}
+
+var innerProductScratchPool = sync.Pool{
+	New: func() interface{} {
+		return new(innerProductScratch)
+	},
+}
+
+// getInnerProductScratch takes a scratch with vectors of length n from the pool,
+// it must be given back with putInnerProductScratch once nothing refers to its vectors
+func getInnerProductScratch(n int) *innerProductScratch {
+	scratch := innerProductScratchPool.Get().(*innerProductScratch)
+	if cap(scratch.a) < n {
+		scratch.a = newScalarVector(n)
+		scratch.b = newScalarVector(n)
+		scratch.g = newPointVector(n)
+		scratch.h = newPointVector(n)
+	}
+	scratch.a = scratch.a[:n]
+	scratch.b = scratch.b[:n]
+	scratch.g = scratch.g[:n]
+	scratch.h = scratch.h[:n]
+	return scratch
+}
+
+func putInnerProductScratch(scratch *innerProductScratch) {
+	innerProductScratchPool.Put(scratch)
+}
+
 func (inner *InnerProductProof) Init() *InnerProductProof {
 	if inner == nil {
 		inner = new(InnerProductProof)
@@ -130,90 +166,80 @@ func (wit InnerProductWitness) Prove(GParam []*privacy.Point, HParam []*privacy.
 
 	N := len(wit.a)
 
-	a := make([]*privacy.Scalar, N)
-	b := make([]*privacy.Scalar, N)
+	// a, b, G, H are folded in place, the first half of each vector holds the next round's values
+	scratch := getInnerProductScratch(N)
+	defer putInnerProductScratch(scratch)
+	a, b, G, H := scratch.a, scratch.b, scratch.g, scratch.h
 
 	for i := range wit.a {
-		a[i] = new(privacy.Scalar).Set(wit.a[i])
-		b[i] = new(privacy.Scalar).Set(wit.b[i])
+		a[i].Set(wit.a[i])
+		b[i].Set(wit.b[i])
+		G[i].Set(GParam[i])
+		H[i].Set(HParam[i])
 	}
 
 	p := new(privacy.Point).Set(wit.p)
-	G := make([]*privacy.Point, N)
-	H := make([]*privacy.Point, N)
-	for i := range G {
-		G[i] = new(privacy.Point).Set(GParam[i])
-		H[i] = new(privacy.Point).Set(HParam[i])
-	}
 
 	proof := new(InnerProductProof)
 	proof.l = make([]*privacy.Point, 0)
 	proof.r = make([]*privacy.Point, 0)
 	proof.p = new(privacy.Point).Set(wit.p)
 
+	xInverse := new(privacy.Scalar)
+	xSquare := new(privacy.Scalar)
+	xSquareInverse := new(privacy.Scalar)
+	tmpPoint := new(privacy.Point)
 	for N > 1 {
 		nPrime := N / 2
 
-		cL, err := innerProduct(a[:nPrime], b[nPrime:])
+		cL, err := innerProduct(a[:nPrime], b[nPrime:N])
 		if err != nil {
 			return nil, err
 		}
-		cR, err := innerProduct(a[nPrime:], b[:nPrime])
+		cR, err := innerProduct(a[nPrime:N], b[:nPrime])
 		if err != nil {
 			return nil, err
 		}
 
-		L, err := encodeVectors(a[:nPrime], b[nPrime:], G[nPrime:], H[:nPrime])
+		L, err := encodeVectors(a[:nPrime], b[nPrime:N], G[nPrime:N], H[:nPrime])
 		if err != nil {
 			return nil, err
 		}
-		L.Add(L, new(privacy.Point).ScalarMult(uParam, cL))
+		L.Add(L, tmpPoint.ScalarMult(uParam, cL))
 		proof.l = append(proof.l, L)
 
-		R, err := encodeVectors(a[nPrime:], b[:nPrime], G[:nPrime], H[nPrime:])
+		R, err := encodeVectors(a[nPrime:N], b[:nPrime], G[:nPrime], H[nPrime:N])
 		if err != nil {
 			return nil, err
 		}
-		R.Add(R, new(privacy.Point).ScalarMult(uParam, cR))
+		R.Add(R, tmpPoint.ScalarMult(uParam, cR))
 		proof.r = append(proof.r, R)
 
 		x := generateChallenge(hashCache, []*privacy.Point{L, R})
-		hashCache = new(privacy.Scalar).Set(x).ToBytesS()
+		hashCache = x.ToBytesS()
 
-		xInverse := new(privacy.Scalar).Invert(x)
-		xSquare := new(privacy.Scalar).Mul(x, x)
-		xSquareInverse := new(privacy.Scalar).Mul(xInverse, xInverse)
+		xInverse.Invert(x)
+		xSquare.Mul(x, x)
+		xSquareInverse.Mul(xInverse, xInverse)
 
 		// calculate GPrime, HPrime, PPrime for the next loop
-		GPrime := make([]*privacy.Point, nPrime)
-		HPrime := make([]*privacy.Point, nPrime)
-
-		for i := range GPrime {
-			GPrime[i] = new(privacy.Point).AddPedersen(xInverse, G[i], x, G[i+nPrime])
-			HPrime[i] = new(privacy.Point).AddPedersen(x, H[i], xInverse, H[i+nPrime])
+		for i := 0; i < nPrime; i++ {
+			G[i].AddPedersen(xInverse, G[i], x, G[i+nPrime])
+			H[i].AddPedersen(x, H[i], xInverse, H[i+nPrime])
 		}
 
 		// x^2 * l + P + xInverse^2 * r
-		PPrime := new(privacy.Point).AddPedersen(xSquare, L, xSquareInverse, R)
-		PPrime.Add(PPrime, p)
+		p.Add(tmpPoint.AddPedersen(xSquare, L, xSquareInverse, R), p)
 
 		// calculate aPrime, bPrime
-		aPrime := make([]*privacy.Scalar, nPrime)
-		bPrime := make([]*privacy.Scalar, nPrime)
-
-		for i := range aPrime {
-			aPrime[i] = new(privacy.Scalar).Mul(a[i], x)
-			aPrime[i] = new(privacy.Scalar).MulAdd(a[i+nPrime], xInverse, aPrime[i])
+		for i := 0; i < nPrime; i++ {
+			a[i].Mul(a[i], x)
+			a[i].MulAdd(a[i+nPrime], xInverse, a[i])
 
-			bPrime[i] = new(privacy.Scalar).Mul(b[i], xInverse)
-			bPrime[i] = new(privacy.Scalar).MulAdd(b[i+nPrime], x, bPrime[i])
+			b[i].Mul(b[i], xInverse)
+			b[i].MulAdd(b[i+nPrime], x, b[i])
 		}
 
-		a = aPrime
-		b = bPrime
-		p.Set(PPrime)
-		G = GPrime
-		H = HPrime
 		N = nPrime
 	}
 
@@ -229,42 +255,40 @@ func (proof InnerProductProof) Verify(GParam []*privacy.Point, HParam []*privacy
 	p.Set(proof.p)
 
 	n := len(GParam)
-	G := make([]*privacy.Point, n)
-	H := make([]*privacy.Point, n)
+	scratch := getInnerProductScratch(n)
+	defer putInnerProductScratch(scratch)
+	G, H := scratch.g, scratch.h
 	for i := range G {
-		G[i] = new(privacy.Point).Set(GParam[i])
-		H[i] = new(privacy.Point).Set(HParam[i])
+		G[i].Set(GParam[i])
+		H[i].Set(HParam[i])
 	}
 
+	xInverse := new(privacy.Scalar)
+	xSquare := new(privacy.Scalar)
+	xSquareInverse := new(privacy.Scalar)
+	tmpPoint := new(privacy.Point)
 	for i := range proof.l {
 		nPrime := n / 2
 		x := generateChallenge(hashCache, []*privacy.Point{proof.l[i], proof.r[i]})
-		hashCache = new(privacy.Scalar).Set(x).ToBytesS()
-		xInverse := new(privacy.Scalar).Invert(x)
-		xSquare := new(privacy.Scalar).Mul(x, x)
-		xSquareInverse := new(privacy.Scalar).Mul(xInverse, xInverse)
+		hashCache = x.ToBytesS()
+		xInverse.Invert(x)
+		xSquare.Mul(x, x)
+		xSquareInverse.Mul(xInverse, xInverse)
 
 		// calculate GPrime, HPrime, PPrime for the next loop
-		GPrime := make([]*privacy.Point, nPrime)
-		HPrime := make([]*privacy.Point, nPrime)
-
-		for j := 0; j < len(GPrime); j++ {
-			GPrime[j] = new(privacy.Point).AddPedersen(xInverse, G[j], x, G[j+nPrime])
-			HPrime[j] = new(privacy.Point).AddPedersen(x, H[j], xInverse, H[j+nPrime])
+		for j := 0; j < nPrime; j++ {
+			G[j].AddPedersen(xInverse, G[j], x, G[j+nPrime])
+			H[j].AddPedersen(x, H[j], xInverse, H[j+nPrime])
 		}
 		// calculate x^2 * l + P + xInverse^2 * r
-		PPrime := new(privacy.Point).AddPedersen(xSquare, proof.l[i], xSquareInverse, proof.r[i])
-		PPrime.Add(PPrime, p)
+		p.Add(tmpPoint.AddPedersen(xSquare, proof.l[i], xSquareInverse, proof.r[i]), p)
 
-		p = PPrime
-		G = GPrime
-		H = HPrime
 		n = nPrime
 	}
 
 	c := new(privacy.Scalar).Mul(proof.a, proof.b)
 	rightPoint := new(privacy.Point).AddPedersen(proof.a, G[0], proof.b, H[0])
-	rightPoint.Add(rightPoint, new(privacy.Point).ScalarMult(uParam, c))
+	rightPoint.Add(rightPoint, tmpPoint.ScalarMult(uParam, c))
 	res := privacy.IsPointEqual(rightPoint, p)
 	if !res {
 		Logger.Log.Error("Inner product argument failed:")
@@ -277,54 +301,41 @@ func (proof InnerProductProof) Verify(GParam []*privacy.Point, HParam []*privacy
 
 func (proof InnerProductProof) VerifyFaster(GParam []*privacy.Point, HParam []*privacy.Point, uParam *privacy.Point, hashCache []byte) bool {
 	//var aggParam = newBulletproofParams(1)
-	p := new(privacy.Point)
-	p.Set(proof.p)
 	n := len(GParam)
-	G := make([]*privacy.Point, n)
-	H := make([]*privacy.Point, n)
-	s := make([]*privacy.Scalar, n)
-	sInverse := make([]*privacy.Scalar, n)
+	scratch := getInnerProductScratch(n)
+	defer putInnerProductScratch(scratch)
+	s, sInverse := scratch.a, scratch.b
 
-	for i := range G {
-		G[i] = new(privacy.Point).Set(GParam[i])
-		H[i] = new(privacy.Point).Set(HParam[i])
-		s[i] = new(privacy.Scalar).FromUint64(1)
-		sInverse[i] = new(privacy.Scalar).FromUint64(1)
+	for i := range s {
+		s[i].FromUint64(1)
+		sInverse[i].FromUint64(1)
 	}
 	logN := int(math.Log2(float64(n)))
-	xList := make([]*privacy.Scalar, logN)
-	xInverseList := make([]*privacy.Scalar, logN)
-	xSquareList := make([]*privacy.Scalar, logN)
-	xInverseSquare_List := make([]*privacy.Scalar, logN)
+	xList := newScalarVector(logN)
+	xInverseList := newScalarVector(logN)
+	xSquareList := newScalarVector(logN)
+	xInverseSquare_List := newScalarVector(logN)
 
 	//a*s ; b*s^-1
 
 	for i := range proof.l {
 		// calculate challenge x = hash(hash(G || H || u || p) || x || l || r)
-		xList[i] = generateChallenge(hashCache, []*privacy.Point{proof.l[i], proof.r[i]})
-		hashCache = new(privacy.Scalar).Set(xList[i]).ToBytesS()
+		xList[i].Set(generateChallenge(hashCache, []*privacy.Point{proof.l[i], proof.r[i]}))
+		hashCache = xList[i].ToBytesS()
 
-		xInverseList[i] = new(privacy.Scalar).Invert(xList[i])
-		xSquareList[i] = new(privacy.Scalar).Mul(xList[i], xList[i])
-		xInverseSquare_List[i] = new(privacy.Scalar).Mul(xInverseList[i], xInverseList[i])
+		xInverseList[i].Invert(xList[i])
+		xSquareList[i].Mul(xList[i], xList[i])
+		xInverseSquare_List[i].Mul(xInverseList[i], xInverseList[i])
 
 		//Update s, s^-1
-		for j := 0; j < n; j++ {
-			if j&int(math.Pow(2, float64(logN-i-1))) != 0 {
-				s[j] = new(privacy.Scalar).Mul(s[j], xList[i])
-				sInverse[j] = new(privacy.Scalar).Mul(sInverse[j], xInverseList[i])
-			} else {
-				s[j] = new(privacy.Scalar).Mul(s[j], xInverseList[i])
-				sInverse[j] = new(privacy.Scalar).Mul(sInverse[j], xList[i])
-			}
-		}
+		foldChallenge(s, sInverse, xList[i], xInverseList[i], 1<<uint(logN-i-1))
 	}
 
 	// Compute (g^s)^a (h^-s)^b u^(ab) = p l^(x^2) r^(-x^2)
 	c := new(privacy.Scalar).Mul(proof.a, proof.b)
-	rightHSPart1 := new(privacy.Point).MultiScalarMult(s, G)
+	rightHSPart1 := new(privacy.Point).MultiScalarMult(s, GParam)
 	rightHSPart1.ScalarMult(rightHSPart1, proof.a)
-	rightHSPart2 := new(privacy.Point).MultiScalarMult(sInverse, H)
+	rightHSPart2 := new(privacy.Point).MultiScalarMult(sInverse, HParam)
 	rightHSPart2.ScalarMult(rightHSPart2, proof.b)
 
 	rightHS := new(privacy.Point).Add(rightHSPart1, rightHSPart2)
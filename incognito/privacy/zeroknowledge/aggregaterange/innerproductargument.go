@@ -280,9 +280,10 @@ func VerifyBatchingInnerProductProofs(proofs []*InnerProductProof, csList [][]by
 	nXInverseSquareList := make([]*privacy.Scalar, 0)
 
 	maxN := 0
-	asAlphaList := make([]*privacy.Scalar, len(AggParam.g))
-	bsInverseAlphaList := make([]*privacy.Scalar, len(AggParam.g))
-	for k := 0; k < len(AggParam.g); k++ {
+	aggParamAll := GetAggParam()
+	asAlphaList := make([]*privacy.Scalar, len(aggParamAll.g))
+	bsInverseAlphaList := make([]*privacy.Scalar, len(aggParamAll.g))
+	for k := 0; k < len(aggParamAll.g); k++ {
 		asAlphaList[k] = new(privacy.Scalar).FromUint64(0)
 		bsInverseAlphaList[k] = new(privacy.Scalar).FromUint64(0)
 	}
@@ -351,10 +352,10 @@ func VerifyBatchingInnerProductProofs(proofs []*InnerProductProof, csList [][]by
 		nXInverseSquareList = append(nXInverseSquareList, xInverseSquareAlphaList...)
 	}
 
-	gAlphaAS := new(privacy.Point).MultiScalarMult(asAlphaList[0:maxN], AggParam.g[0:maxN])
-	hAlphaBSInverse := new(privacy.Point).MultiScalarMult(bsInverseAlphaList[0:maxN], AggParam.h[0:maxN])
+	gAlphaAS := new(privacy.Point).MultiScalarMult(asAlphaList[0:maxN], aggParamAll.g[0:maxN])
+	hAlphaBSInverse := new(privacy.Point).MultiScalarMult(bsInverseAlphaList[0:maxN], aggParamAll.h[0:maxN])
 	LHS := new(privacy.Point).Add(gAlphaAS, hAlphaBSInverse)
-	LHS.Add(LHS, new(privacy.Point).ScalarMult(AggParam.u, sum_abAlpha))
+	LHS.Add(LHS, new(privacy.Point).ScalarMult(aggParamAll.u, sum_abAlpha))
 	//fmt.Println("LHS:", LHS )
 
 	prod_PAlpha := new(privacy.Point).MultiScalarMult(alphaList, pList)
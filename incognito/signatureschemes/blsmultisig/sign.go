@@ -0,0 +1,78 @@
+package blsmultisig
+
+import (
+	"errors"
+	"math/big"
+
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/cloudflare"
+)
+
+// Sign take data, secret key, index of signer in committee and committee, return BLS signature
+// sig = H0(data)^(a_i * sk) with a_i = AiGen(committee, selfIdx)
+func Sign(data, skBytes []byte, selfIdx int, committee []PublicKey) ([]byte, error) {
+	if len(skBytes) != CSKSz {
+		return nil, NewBLSSignatureError(InvalidPrivateKeyErr, errors.New(CErr+CErrInps))
+	}
+	if selfIdx < 0 || selfIdx >= len(committee) {
+		return nil, NewBLSSignatureError(InvalidCommitteeInfoErr, errors.New(CErr+CErrInps))
+	}
+	sk := B2I(skBytes)
+	dataPn := B2G1P(data)
+	aiSk := AiGen(committee, selfIdx)
+	aiSk.Mul(aiSk, sk)
+	aiSk.Mod(aiSk, bn256.Order)
+	sig := new(bn256.G1).ScalarMult(dataPn, aiSk)
+	return CmprG1(sig), nil
+}
+
+// Verify check BLS signature on data for signers in signersIdx of committee,
+// a combined signature is checked against APKGen(committee, signersIdx)
+func Verify(sig, data []byte, signersIdx []int, committee []PublicKey) (bool, error) {
+	if len(signersIdx) == 0 {
+		return false, NewBLSSignatureError(InvalidCommitteeInfoErr, errors.New(CErr+CErrInps))
+	}
+	for _, idx := range signersIdx {
+		if idx < 0 || idx >= len(committee) {
+			return false, NewBLSSignatureError(InvalidCommitteeInfoErr, errors.New(CErr+CErrInps))
+		}
+	}
+	for _, pk := range committee {
+		if _, err := DecmprG2(pk); err != nil {
+			return false, NewBLSSignatureError(InvalidPublicKeyErr, err)
+		}
+	}
+	sigPn, err := DecmprG1(sig)
+	if err != nil {
+		return false, err
+	}
+
+	// e(sig, g2) == e(H0(data), apk)
+	gG2Pn := new(bn256.G2).ScalarBaseMult(big.NewInt(1))
+	lPair := bn256.Pair(sigPn, gG2Pn)
+	apk := APKGen(committee, signersIdx)
+	dataPn := B2G1P(data)
+	rPair := bn256.Pair(dataPn, apk)
+	if lPair.String() != rPair.String() {
+		return false, nil
+	}
+	return true, nil
+}
+
+// Combine take list of BLS signatures on the same data and return the combined signature
+func Combine(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, NewBLSSignatureError(InvalidInputParamsSizeErr, errors.New(CErr+CErrInps))
+	}
+	cSigPn, err := DecmprG1(sigs[0])
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(sigs); i++ {
+		tmp, err := DecmprG1(sigs[i])
+		if err != nil {
+			return nil, err
+		}
+		cSigPn.Add(cSigPn, tmp)
+	}
+	return CmprG1(cSigPn), nil
+}
@@ -0,0 +1,112 @@
+package blsmultisig
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/0xkraken/incognito-wasm/incognito/common"
+)
+
+func genCommittee(size int) ([]SecretKey, []PublicKey) {
+	sks := make([]SecretKey, size)
+	pks := make([]PublicKey, size)
+	for i := 0; i < size; i++ {
+		sk, pk := KeyGen(common.HashB([]byte{byte(i), 1, 2, 3}))
+		sks[i] = SKBytes(sk)
+		pks[i] = PKBytes(pk)
+	}
+	return sks, pks
+}
+
+func TestSignVerifyCombine(t *testing.T) {
+	sks, committee := genCommittee(8)
+	data := common.HashB([]byte("block hash"))
+
+	sigs := make([][]byte, len(sks))
+	for i := range sks {
+		sig, err := Sign(data, sks[i], i, committee)
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+		if len(sig) != CCmprPnSz {
+			t.Fatalf("Sign() signature size = %v, want %v", len(sig), CCmprPnSz)
+		}
+		valid, err := Verify(sig, data, []int{i}, committee)
+		if err != nil || !valid {
+			t.Fatalf("Verify() of signer %v = %v, %v", i, valid, err)
+		}
+		valid, err = Verify(sig, data, []int{(i + 1) % len(sks)}, committee)
+		if err != nil || valid {
+			t.Fatalf("Verify() of signer %v with wrong index = %v, %v", i, valid, err)
+		}
+		sigs[i] = sig
+	}
+
+	tests := []struct {
+		name       string
+		signersIdx []int
+	}{
+		{name: "all signers", signersIdx: []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		{name: "2/3 of committee", signersIdx: []int{0, 2, 3, 5, 6, 7}},
+		{name: "unordered subset", signersIdx: []int{7, 1, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subSigs := [][]byte{}
+			for _, idx := range tt.signersIdx {
+				subSigs = append(subSigs, sigs[idx])
+			}
+			cSig, err := Combine(subSigs)
+			if err != nil {
+				t.Fatalf("Combine() error = %v", err)
+			}
+			valid, err := Verify(cSig, data, tt.signersIdx, committee)
+			if err != nil || !valid {
+				t.Fatalf("Verify() combined = %v, %v", valid, err)
+			}
+
+			// a signer missing from the index list fails
+			valid, err = Verify(cSig, data, tt.signersIdx[1:], committee)
+			if err != nil || valid {
+				t.Fatalf("Verify() with missing signer = %v, %v", valid, err)
+			}
+			// other data fails
+			valid, err = Verify(cSig, common.HashB(data), tt.signersIdx, committee)
+			if err != nil || valid {
+				t.Fatalf("Verify() other data = %v, %v", valid, err)
+			}
+		})
+	}
+}
+
+func TestSignVerifyErrors(t *testing.T) {
+	sks, committee := genCommittee(4)
+	data := make([]byte, 32)
+	rand.Read(data)
+
+	if _, err := Sign(data, sks[0], 4, committee); err == nil {
+		t.Error("Sign() with index out of committee should fail")
+	}
+	if _, err := Sign(data, sks[0][:31], 0, committee); err == nil {
+		t.Error("Sign() with short secret key should fail")
+	}
+	sig, err := Sign(data, sks[0], 0, committee)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if _, err := Verify(sig, data, []int{}, committee); err == nil {
+		t.Error("Verify() without signers should fail")
+	}
+	if _, err := Verify(sig, data, []int{0, 4}, committee); err == nil {
+		t.Error("Verify() with index out of committee should fail")
+	}
+	if _, err := Verify(sig[:31], data, []int{0}, committee); err == nil {
+		t.Error("Verify() with short signature should fail")
+	}
+	if _, err := Combine(nil); err == nil {
+		t.Error("Combine() without signatures should fail")
+	}
+	if _, err := Combine([][]byte{sig, {1, 2}}); err == nil {
+		t.Error("Combine() with invalid signature should fail")
+	}
+}
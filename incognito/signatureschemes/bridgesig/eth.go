@@ -0,0 +1,91 @@
+package bridgesig
+
+import (
+	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// CETHSigVOffset is added to the recovery id of Ethereum signatures, V is 27 or 28
+	CETHSigVOffset = 27
+	// CPersonalMessagePrefix is the EIP-191 prefix of personal messages
+	CPersonalMessagePrefix = "\x19Ethereum Signed Message:\n"
+)
+
+// PKBytesToETHAddress take a bridge public key (compressed, as PKBytes) and return its Ethereum address
+func PKBytesToETHAddress(pkBytes []byte) (ethcommon.Address, error) {
+	pk, err := ethcrypto.DecompressPubkey(pkBytes)
+	if err != nil {
+		return ethcommon.Address{}, NewBriSignatureError(InvalidPublicKeyErr, err)
+	}
+	return ethcrypto.PubkeyToAddress(*pk), nil
+}
+
+// PersonalMessageHash return the EIP-191 hash of a personal message,
+// keccak256("\x19Ethereum Signed Message:\n" + len(msg) + msg)
+func PersonalMessageHash(msg []byte) []byte {
+	prefix := fmt.Sprintf("%s%d", CPersonalMessagePrefix, len(msg))
+	return ethcrypto.Keccak256([]byte(prefix), msg)
+}
+
+// SignHash take a secret key and a 32-byte hash, return Ethereum signature [R || S || V] with V in {27, 28}
+func SignHash(keyBytes []byte, hash []byte) ([]byte, error) {
+	if len(keyBytes) != CSKSz {
+		return nil, NewBriSignatureError(InvalidPrivateKeyErr, nil)
+	}
+	if len(hash) != ethcommon.HashLength {
+		return nil, NewBriSignatureError(InvalidInputParamsSizeErr, nil)
+	}
+	sk, err := ethcrypto.ToECDSA(keyBytes)
+	if err != nil {
+		return nil, NewBriSignatureError(InvalidPrivateKeyErr, err)
+	}
+	sig, err := ethcrypto.Sign(hash, sk)
+	if err != nil {
+		return nil, NewBriSignatureError(SignDataErr, err)
+	}
+	sig[CBridgeSigSz-1] += CETHSigVOffset
+	return sig, nil
+}
+
+// SignPersonalMessage sign msg with the EIP-191 prefix, as eth_sign and personal_sign do
+func SignPersonalMessage(keyBytes []byte, msg []byte) ([]byte, error) {
+	return SignHash(keyBytes, PersonalMessageHash(msg))
+}
+
+// RecoverPubKey return the compressed public key signing hash, V of sig can be 0, 1, 27 or 28
+func RecoverPubKey(hash []byte, sig []byte) ([]byte, error) {
+	if len(hash) != ethcommon.HashLength || len(sig) != CBridgeSigSz {
+		return nil, NewBriSignatureError(InvalidInputParamsSizeErr, nil)
+	}
+	sigTmp := make([]byte, CBridgeSigSz)
+	copy(sigTmp, sig)
+	if sigTmp[CBridgeSigSz-1] >= CETHSigVOffset {
+		sigTmp[CBridgeSigSz-1] -= CETHSigVOffset
+	}
+	pk, err := ethcrypto.SigToPub(hash, sigTmp)
+	if err != nil {
+		return nil, NewBriSignatureError(InvalidSignatureErr, err)
+	}
+	return ethcrypto.CompressPubkey(pk), nil
+}
+
+// RecoverETHAddress return the Ethereum address signing hash, as ecrecover in contracts
+func RecoverETHAddress(hash []byte, sig []byte) (ethcommon.Address, error) {
+	pkBytes, err := RecoverPubKey(hash, sig)
+	if err != nil {
+		return ethcommon.Address{}, err
+	}
+	return PKBytesToETHAddress(pkBytes)
+}
+
+// VerifyPersonalMessage check that address signed msg with the EIP-191 prefix
+func VerifyPersonalMessage(address ethcommon.Address, msg []byte, sig []byte) (bool, error) {
+	signer, err := RecoverETHAddress(PersonalMessageHash(msg), sig)
+	if err != nil {
+		return false, err
+	}
+	return signer == address, nil
+}
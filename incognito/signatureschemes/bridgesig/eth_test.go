@@ -0,0 +1,94 @@
+package bridgesig
+
+import (
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSignPersonalMessage(t *testing.T) {
+	// test vector of web3.eth.accounts.sign
+	skBytes := hexutil.MustDecode("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	address := ethcommon.HexToAddress("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23")
+	msg := []byte("Some data")
+	wantHash := "0x1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655"
+	wantSig := "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"
+
+	sk, err := ethcrypto.ToECDSA(skBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotAddress, err := PKBytesToETHAddress(PKBytes(&sk.PublicKey))
+	if err != nil || gotAddress != address {
+		t.Fatalf("PKBytesToETHAddress() = %v, %v, want %v", gotAddress.Hex(), err, address.Hex())
+	}
+
+	if got := hexutil.Encode(PersonalMessageHash(msg)); got != wantHash {
+		t.Errorf("PersonalMessageHash() = %v, want %v", got, wantHash)
+	}
+	sig, err := SignPersonalMessage(skBytes, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hexutil.Encode(sig); got != wantSig {
+		t.Errorf("SignPersonalMessage() = %v, want %v", got, wantSig)
+	}
+
+	valid, err := VerifyPersonalMessage(address, msg, sig)
+	if err != nil || !valid {
+		t.Errorf("VerifyPersonalMessage() = %v, %v", valid, err)
+	}
+	valid, err = VerifyPersonalMessage(address, []byte("Other data"), sig)
+	if err != nil || valid {
+		t.Errorf("VerifyPersonalMessage() other message = %v, %v", valid, err)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	sk, pk := KeyGen([]byte{0, 1, 2, 3, 4})
+	skBytes := SKBytes(&sk)
+	pkBytes := PKBytes(&pk)
+	address, err := PKBytesToETHAddress(pkBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := ethcrypto.Keccak256([]byte{1, 2, 3, 4})
+
+	sig, err := SignHash(skBytes, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sig) != CBridgeSigSz || (sig[64] != 27 && sig[64] != 28) {
+		t.Fatalf("SignHash() = %v, want 65 bytes with V in {27, 28}", sig)
+	}
+	gotPK, err := RecoverPubKey(hash, sig)
+	if err != nil || hexutil.Encode(gotPK) != hexutil.Encode(pkBytes) {
+		t.Errorf("RecoverPubKey() = %v, %v, want %v", gotPK, err, pkBytes)
+	}
+	gotAddress, err := RecoverETHAddress(hash, sig)
+	if err != nil || gotAddress != address {
+		t.Errorf("RecoverETHAddress() = %v, %v, want %v", gotAddress.Hex(), err, address.Hex())
+	}
+
+	// signatures of Sign have V in {0, 1} and recover the same key
+	sig, err = Sign(skBytes, []byte{1, 2, 3, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotAddress, err = RecoverETHAddress(hash, sig)
+	if err != nil || gotAddress != address {
+		t.Errorf("RecoverETHAddress() of Sign = %v, %v, want %v", gotAddress.Hex(), err, address.Hex())
+	}
+
+	if _, err := RecoverPubKey(hash, sig[:64]); err == nil {
+		t.Error("RecoverPubKey() with short signature should fail")
+	}
+	if _, err := SignHash(skBytes, hash[:31]); err == nil {
+		t.Error("SignHash() with short hash should fail")
+	}
+	if _, err := PKBytesToETHAddress(pkBytes[1:]); err == nil {
+		t.Error("PKBytesToETHAddress() with invalid key should fail")
+	}
+}
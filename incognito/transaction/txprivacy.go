@@ -120,7 +120,34 @@ func (param *TxPrivacyInitParamsForASM) SetMetaData(meta metadata.Metadata) {
 	param.txParam.metaData = meta
 }
 
+// validateTxMetadata checks the metadata by itself and against the payments of the tx to the burning address, by token ID
+func validateTxMetadata(meta metadata.Metadata, payments map[common.Hash][]*privacy.PaymentInfo) error {
+	if meta == nil {
+		return nil
+	}
+	if err := meta.ValidateSanityData(); err != nil {
+		return fmt.Errorf("Metadata is invalid: %v", err)
+	}
+	if err := metadata.ValidateBurnedAmounts(meta, payments); err != nil {
+		return fmt.Errorf("Metadata is invalid: %v", err)
+	}
+	return nil
+}
+
 func (tx *Tx) InitForASM(params *TxPrivacyInitParamsForASM, serverTime int64) error {
+	tokenID := common.PRVCoinID
+	if params.txParam.tokenID != nil {
+		tokenID = *params.txParam.tokenID
+	}
+	err := validateTxMetadata(params.txParam.metaData, map[common.Hash][]*privacy.PaymentInfo{tokenID: params.txParam.paymentInfo})
+	if err != nil {
+		return err
+	}
+	return tx.initForASM(params, serverTime)
+}
+
+// initForASM creates the tx without validating its metadata
+func (tx *Tx) initForASM(params *TxPrivacyInitParamsForASM, serverTime int64) error {
 	//Logger.log.Debugf("CREATING TX........\n")
 	tx.Version = common.TxVersion
 	var err error
@@ -250,10 +277,11 @@ func (tx *Tx) InitForASM(params *TxPrivacyInitParamsForASM, serverTime int64) er
 		outputCoins[i].CoinDetails = new(privacy.Coin)
 		outputCoins[i].CoinDetails.SetValue(pInfo.Amount)
 		if len(pInfo.Message) > 0 {
-			if len(pInfo.Message) > privacy.MaxSizeInfoCoin {
-				return errors.New(fmt.Sprintf("Size of message %v should be less than %+v", len(pInfo.Message), privacy.MaxSizeInfoCoin))
+			info, err := pInfo.CoinInfo()
+			if err != nil {
+				return err
 			}
-			outputCoins[i].CoinDetails.SetInfo(pInfo.Message)
+			outputCoins[i].CoinDetails.SetInfo(info)
 		}
 
 		PK, err := new(privacy.Point).FromBytesS(pInfo.PaymentAddress.Pk)
@@ -316,7 +344,8 @@ func (tx *Tx) InitForASM(params *TxPrivacyInitParamsForASM, serverTime int64) er
 		// encrypt coin details (Randomness)
 		// hide information of output coins except coin commitments, public key, snDerivators
 		for i := 0; i < len(tx.Proof.GetOutputCoins()); i++ {
-			err = tx.Proof.GetOutputCoins()[i].Encrypt(params.txParam.paymentInfo[i].PaymentAddress.Tk)
+			paymentInfo := params.txParam.paymentInfo[i]
+			err = tx.Proof.GetOutputCoins()[i].EncryptWithVersion(paymentInfo.PaymentAddress.Tk, paymentInfo.CoinDetailsVersion())
 			if err.(*privacy.PrivacyError) != nil {
 				return err
 			}
@@ -256,9 +256,20 @@ func (txCustomTokenPrivacy *TxCustomTokenPrivacy) Hash() *common.Hash {
 // Init -  build normal tx component and privacy custom token data
 func (txCustomTokenPrivacy *TxCustomTokenPrivacy) InitForASM(params *TxPrivacyTokenInitParamsForASM, serverTime int64) error {
 	var err error
+	payments := map[common.Hash][]*privacy.PaymentInfo{common.PRVCoinID: params.txParam.paymentInfo}
+	if params.txParam.tokenParams.TokenTxType == common.CustomTokenTransfer {
+		if propertyID, err := (common.Hash{}).NewHashFromStr(params.txParam.tokenParams.PropertyID); err == nil {
+			payments[*propertyID] = params.txParam.tokenParams.Receiver
+		}
+	}
+	err = validateTxMetadata(params.txParam.metaData, payments)
+	if err != nil {
+		return err
+	}
+
 	// init data for tx PRV for fee
 	normalTx := Tx{}
-	err = normalTx.InitForASM(NewTxPrivacyInitParamsForASM(
+	err = normalTx.initForASM(NewTxPrivacyInitParamsForASM(
 		params.txParam.senderKey,
 		params.txParam.paymentInfo,
 		params.txParam.inputCoin,
@@ -312,11 +323,11 @@ func (txCustomTokenPrivacy *TxCustomTokenPrivacy) InitForASM(params *TxPrivacyTo
 
 			// set info coin for output coin
 			if len(params.txParam.tokenParams.Receiver[0].Message) > 0 {
-				if len(params.txParam.tokenParams.Receiver[0].Message) > privacy.MaxSizeInfoCoin {
-					return errors.New(fmt.Sprintf("Size of message %v should be less than %v",
-						len(params.txParam.tokenParams.Receiver[0].Message), privacy.MaxSizeInfoCoin))
+				info, err := params.txParam.tokenParams.Receiver[0].CoinInfo()
+				if err != nil {
+					return err
 				}
-				tempOutputCoin[0].CoinDetails.SetInfo(params.txParam.tokenParams.Receiver[0].Message)
+				tempOutputCoin[0].CoinDetails.SetInfo(info)
 			}
 
 			sndOut := privacy.RandomScalar()
@@ -94,6 +94,15 @@ func staking(_ js.Value, args []js.Value) interface{} {
 	return result
 }
 
+func unStakingTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.UnStakingTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
 func initPrivacyTokenTx(_ js.Value, args []js.Value) interface{} {
 	result, err := gomobile.InitPrivacyTokenTx(args[0].String(), int64(args[1].Int()))
 	if err != nil {
@@ -157,6 +166,24 @@ func initPTokenTradeTx(_ js.Value, args []js.Value) interface{} {
 	return result
 }
 
+func initPRVCrossPoolTradeTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPRVCrossPoolTradeTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPTokenCrossPoolTradeTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPTokenCrossPoolTradeTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
 func withdrawDexTx(_ js.Value, args []js.Value) interface{} {
 	result, err := gomobile.WithdrawDexTx(args[0].String(), int64(args[1].Int()))
 	if err != nil {
@@ -166,6 +193,42 @@ func withdrawDexTx(_ js.Value, args []js.Value) interface{} {
 	return result
 }
 
+func withdrawDexFeeTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.WithdrawDexFeeTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func planPDEContribution(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.PlanPDEContribution(args[0].String())
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPDEContributionTxs(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPDEContributionTxs(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func getPDEWithdrawalAmounts(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.GetPDEWithdrawalAmounts(args[0].String())
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
 func hybridEncryptionASM(_ js.Value, args []js.Value) interface{} {
 	result, err := gomobile.HybridEncryptionASM(args[0].String())
 	if err != nil {
@@ -175,6 +238,15 @@ func hybridEncryptionASM(_ js.Value, args []js.Value) interface{} {
 	return result
 }
 
+func hybridEncryptionASMWithVersion(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.HybridEncryptionASMWithVersion(args[0].String(), args[1].Int())
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
 func hybridDecryptionASM(_ js.Value, args []js.Value) interface{} {
 	result, err := gomobile.HybridDecryptionASM(args[0].String())
 	if err != nil {
@@ -184,6 +256,15 @@ func hybridDecryptionASM(_ js.Value, args []js.Value) interface{} {
 	return result
 }
 
+func decryptMemo(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.DecryptMemo(args[0].String())
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
 func getSignPublicKey(_ js.Value, args []js.Value) interface{} {
 	result, err := gomobile.GetSignPublicKey(args[0].String())
 	if err != nil {
@@ -238,6 +319,105 @@ func parsePrivacyTokenRawTx(_ js.Value, args []js.Value) interface{} {
 	return result
 }
 
+func generateValidatorKey(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.GenerateValidatorKey(args[0].String())
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func getValidatorKeyInfo(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.GetValidatorKeyInfo(args[0].String())
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func generateUnshieldCalldata(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.GenerateUnshieldCalldata(args[0].String())
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPortalPortingRequestTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPortalPortingRequestTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPortalRequestPTokenTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPortalRequestPTokenTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPortalRedeemRequestTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPortalRedeemRequestTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPortalRedeemFromLiquidationPoolTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPortalRedeemFromLiquidationPoolTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPortalCustodianDepositTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPortalCustodianDepositTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPortalCustodianWithdrawRequestTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPortalCustodianWithdrawRequestTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPortalCustodianTopupTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPortalCustodianTopupTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func initPortalRequestWithdrawRewardTx(_ js.Value, args []js.Value) interface{} {
+	result, err := gomobile.InitPortalRequestWithdrawRewardTx(args[0].String(), int64(args[1].Int()))
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
 func main() {
 	c := make(chan struct{}, 0)
 	println("Hello WASM")
@@ -247,6 +427,7 @@ func main() {
 	js.Global().Set("initPrivacyTx", js.FuncOf(initPrivacyTx))
 	js.Global().Set("staking", js.FuncOf(staking))
 	js.Global().Set("stopAutoStaking", js.FuncOf(stopAutoStaking))
+	js.Global().Set("unStakingTx", js.FuncOf(unStakingTx))
 	js.Global().Set("initPrivacyTokenTx", js.FuncOf(initPrivacyTokenTx))
 	js.Global().Set("initBurningRequestTx", js.FuncOf(initBurningRequestTx))
 	js.Global().Set("initWithdrawRewardTx", js.FuncOf(initWithdrawRewardTx))
@@ -256,21 +437,41 @@ func main() {
 	js.Global().Set("scalarMultBase", js.FuncOf(scalarMultBase))
 	js.Global().Set("randomScalars", js.FuncOf(randomScalars))
 	js.Global().Set("generateBLSKeyPairFromSeed", js.FuncOf(generateBLSKeyPairFromSeed))
+	js.Global().Set("generateValidatorKey", js.FuncOf(generateValidatorKey))
+	js.Global().Set("getValidatorKeyInfo", js.FuncOf(getValidatorKeyInfo))
 
 	js.Global().Set("initPRVContributionTx", js.FuncOf(initPRVContributionTx))
 	js.Global().Set("initPTokenContributionTx", js.FuncOf(initPTokenContributionTx))
 	js.Global().Set("initPRVTradeTx", js.FuncOf(initPRVTradeTx))
 	js.Global().Set("initPTokenTradeTx", js.FuncOf(initPTokenTradeTx))
+	js.Global().Set("initPRVCrossPoolTradeTx", js.FuncOf(initPRVCrossPoolTradeTx))
+	js.Global().Set("initPTokenCrossPoolTradeTx", js.FuncOf(initPTokenCrossPoolTradeTx))
 	js.Global().Set("withdrawDexTx", js.FuncOf(withdrawDexTx))
+	js.Global().Set("withdrawDexFeeTx", js.FuncOf(withdrawDexFeeTx))
+	js.Global().Set("planPDEContribution", js.FuncOf(planPDEContribution))
+	js.Global().Set("initPDEContributionTxs", js.FuncOf(initPDEContributionTxs))
+	js.Global().Set("getPDEWithdrawalAmounts", js.FuncOf(getPDEWithdrawalAmounts))
 
 	js.Global().Set("hybridEncryptionASM", js.FuncOf(hybridEncryptionASM))
+	js.Global().Set("hybridEncryptionASMWithVersion", js.FuncOf(hybridEncryptionASMWithVersion))
 	js.Global().Set("hybridDecryptionASM", js.FuncOf(hybridDecryptionASM))
+	js.Global().Set("decryptMemo", js.FuncOf(decryptMemo))
 
 	js.Global().Set("getSignPublicKey", js.FuncOf(getSignPublicKey))
 	js.Global().Set("signPoolWithdraw", js.FuncOf(signPoolWithdraw))
 	js.Global().Set("verifySign", js.FuncOf(verifySign))
 
 	js.Global().Set("initIssuingEVMReqTx", js.FuncOf(initIssuingEVMReqTx))
+	js.Global().Set("generateUnshieldCalldata", js.FuncOf(generateUnshieldCalldata))
+
+	js.Global().Set("initPortalPortingRequestTx", js.FuncOf(initPortalPortingRequestTx))
+	js.Global().Set("initPortalRequestPTokenTx", js.FuncOf(initPortalRequestPTokenTx))
+	js.Global().Set("initPortalRedeemRequestTx", js.FuncOf(initPortalRedeemRequestTx))
+	js.Global().Set("initPortalRedeemFromLiquidationPoolTx", js.FuncOf(initPortalRedeemFromLiquidationPoolTx))
+	js.Global().Set("initPortalCustodianDepositTx", js.FuncOf(initPortalCustodianDepositTx))
+	js.Global().Set("initPortalCustodianWithdrawRequestTx", js.FuncOf(initPortalCustodianWithdrawRequestTx))
+	js.Global().Set("initPortalCustodianTopupTx", js.FuncOf(initPortalCustodianTopupTx))
+	js.Global().Set("initPortalRequestWithdrawRewardTx", js.FuncOf(initPortalRequestWithdrawRewardTx))
 
 	js.Global().Set("parseNativeRawTx", js.FuncOf(parseNativeRawTx))
 	js.Global().Set("parsePrivacyTokenRawTx", js.FuncOf(parsePrivacyTokenRawTx))